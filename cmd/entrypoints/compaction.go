@@ -0,0 +1,67 @@
+package entrypoints
+
+import (
+	"context"
+
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/lyft/flyteadmin/pkg/compaction"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	"github.com/lyft/flyteadmin/pkg/runtime"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/spf13/cobra"
+)
+
+var parentCompactionCmd = &cobra.Command{
+	Use:   "compaction",
+	Short: "This command administers the CompactionController. Please choose a subcommand.",
+}
+
+func getCompactionController() compaction.Controller {
+	configuration := runtime.NewConfigurationProvider()
+	applicationConfiguration := configuration.ApplicationConfiguration()
+	scope := promutils.NewScope(applicationConfiguration.GetTopLevelConfig().MetricsScope).NewSubScope("compaction")
+	dbConfigValues := applicationConfiguration.GetDbConfig()
+	dbConfig := repositoryConfig.DbConfig{
+		Host:         dbConfigValues.Host,
+		Port:         dbConfigValues.Port,
+		DbName:       dbConfigValues.DbName,
+		User:         dbConfigValues.User,
+		Password:     dbConfigValues.Password,
+		ExtraOptions: dbConfigValues.ExtraOptions,
+	}
+	db := repositories.GetRepository(
+		repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+	return compaction.NewCompactionController(db, applicationConfiguration, scope)
+}
+
+var compactionRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "This command will start a compaction controller to periodically compact the events of old executions",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		compactionController := getCompactionController()
+		logger.Infof(ctx, "CompactionController started successfully")
+		compactionController.Run()
+	},
+}
+
+var compactionCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "This command will compact the events of old executions once",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		err := getCompactionController().Compact(ctx)
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to compact the events of old executions [%+v]", err)
+		}
+		logger.Infof(ctx, "Compacted the events of old executions successfully")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentCompactionCmd)
+	parentCompactionCmd.AddCommand(compactionRunCmd)
+	parentCompactionCmd.AddCommand(compactionCompactCmd)
+}
@@ -0,0 +1,70 @@
+package entrypoints
+
+import (
+	"context"
+
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/lyft/flyteadmin/pkg/async/notifications"
+	"github.com/lyft/flyteadmin/pkg/digest"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	"github.com/lyft/flyteadmin/pkg/runtime"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/spf13/cobra"
+)
+
+var parentDigestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "This command administers the DigestController. Please choose a subcommand.",
+}
+
+func getDigestController() digest.Controller {
+	configuration := runtime.NewConfigurationProvider()
+	applicationConfiguration := configuration.ApplicationConfiguration()
+	scope := promutils.NewScope(applicationConfiguration.GetTopLevelConfig().MetricsScope).NewSubScope("digest")
+	dbConfigValues := applicationConfiguration.GetDbConfig()
+	dbConfig := repositoryConfig.DbConfig{
+		Host:         dbConfigValues.Host,
+		Port:         dbConfigValues.Port,
+		DbName:       dbConfigValues.DbName,
+		User:         dbConfigValues.User,
+		Password:     dbConfigValues.Password,
+		ExtraOptions: dbConfigValues.ExtraOptions,
+	}
+	db := repositories.GetRepository(
+		repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+	publisher := notifications.NewNotificationsPublisher(
+		*applicationConfiguration.GetNotificationsConfig(), db, scope.NewSubScope("notifications"))
+	return digest.NewDigestController(db, applicationConfiguration, publisher, scope)
+}
+
+var digestRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "This command will start a digest controller to email the configured digests as their periods end",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		digestController := getDigestController()
+		logger.Infof(ctx, "DigestController started successfully")
+		digestController.Run()
+	},
+}
+
+var digestSendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "This command will email the configured digests for their latest ended period once",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		err := getDigestController().Send(ctx)
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to send execution digests [%+v]", err)
+		}
+		logger.Infof(ctx, "Sent execution digests successfully")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentDigestCmd)
+	parentDigestCmd.AddCommand(digestRunCmd)
+	parentDigestCmd.AddCommand(digestSendCmd)
+}
@@ -0,0 +1,87 @@
+package entrypoints
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lyft/flyteadmin/pkg/loadgen"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+var (
+	loadgenTarget      string
+	loadgenProject     string
+	loadgenDomain      string
+	loadgenRate        float64
+	loadgenDuration    time.Duration
+	loadgenConcurrency int
+	loadgenSendEvents  bool
+	loadgenLaunchPlans []string
+)
+
+var loadgenCmd = &cobra.Command{
+	Use:   "loadgen",
+	Short: "Generates synthetic execution load against a running flyteadmin instance and reports request latencies",
+	Long: `
+Creates executions (and optionally the workflow events propeller would send for them) at a fixed rate for a range of
+launch plans, for instance:
+
+    flyteadmin loadgen --target localhost:8089 --project flytesnacks --domain development \
+        --launchPlans workflows.hello:v1:3 --launchPlans workflows.batch:v1:1 --rate 10 --duration 5m
+
+The launch plans must already be registered and must not require inputs. Generated executions are real executions
+and will be scheduled on the target's clusters.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		launchPlans := make([]loadgen.LaunchPlanMix, 0, len(loadgenLaunchPlans))
+		for _, value := range loadgenLaunchPlans {
+			launchPlan, err := loadgen.ParseLaunchPlanMix(value)
+			if err != nil {
+				return err
+			}
+			launchPlans = append(launchPlans, launchPlan)
+		}
+
+		conn, err := grpc.Dial(loadgenTarget, grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		generator, err := loadgen.NewGenerator(service.NewAdminServiceClient(conn), loadgen.Config{
+			Project:     loadgenProject,
+			Domain:      loadgenDomain,
+			Rate:        loadgenRate,
+			Duration:    loadgenDuration,
+			Concurrency: loadgenConcurrency,
+			SendEvents:  loadgenSendEvents,
+			LaunchPlans: launchPlans,
+		})
+		if err != nil {
+			return err
+		}
+		logger.Infof(ctx, "Generating %v executions per second against %s for %v", loadgenRate, loadgenTarget,
+			loadgenDuration)
+		fmt.Print(generator.Run(ctx).String())
+		return nil
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(loadgenCmd)
+	loadgenCmd.Flags().StringVar(&loadgenTarget, "target", "localhost:8089", "gRPC address of the flyteadmin instance to load")
+	loadgenCmd.Flags().StringVar(&loadgenProject, "project", "", "Project to create executions in")
+	loadgenCmd.Flags().StringVar(&loadgenDomain, "domain", "", "Domain to create executions in")
+	loadgenCmd.Flags().Float64Var(&loadgenRate, "rate", 1, "Executions to create per second")
+	loadgenCmd.Flags().DurationVar(&loadgenDuration, "duration", time.Minute, "How long to generate load for")
+	loadgenCmd.Flags().IntVar(&loadgenConcurrency, "concurrency", 50, "Maximum number of executions in flight at once")
+	loadgenCmd.Flags().BoolVar(&loadgenSendEvents, "sendEvents", true,
+		"Whether to follow each execution with the workflow events propeller would send")
+	loadgenCmd.Flags().StringSliceVar(&loadgenLaunchPlans, "launchPlans", nil,
+		"Launch plans to execute as name:version[:weight], may be repeated")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/lyft/flytestdlib/promutils"
 
 	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/storage"
 
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
@@ -125,9 +126,48 @@ var seedProjectsCmd = &cobra.Command{
 	},
 }
 
+// This offloads the closures of node executions stored before the configured threshold to blob storage
+var offloadNodeExecutionClosuresCmd = &cobra.Command{
+	Use:   "offload-node-execution-closures",
+	Short: "Offload node execution closures larger than the configured threshold to blob storage.",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
+		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
+		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
+			Host:         databaseConfig.Host,
+			Port:         databaseConfig.Port,
+			DbName:       databaseConfig.DbName,
+			User:         databaseConfig.User,
+			Password:     databaseConfig.Password,
+			ExtraOptions: databaseConfig.ExtraOptions,
+		}, migrateScope)
+		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
+		if err != nil {
+			logger.Fatal(ctx, err)
+		}
+		defer db.Close()
+		db.LogMode(true)
+		dataStorageClient, err := storage.NewDataStore(storage.GetConfig(), migrateScope.NewSubScope("storage"))
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to initialize storage config with err: %v", err)
+		}
+
+		offloaded, err := config.OffloadNodeExecutionClosures(ctx, db, dataStorageClient,
+			applicationConfiguration.MetadataStoragePrefix,
+			applicationConfiguration.ClosureOffloading.NodeExecutionThresholdBytes)
+		if err != nil {
+			logger.Fatalf(ctx, "Could not offload node execution closures with err: %v", err)
+		}
+		logger.Infof(ctx, "Successfully offloaded %d node execution closures", offloaded)
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(parentMigrateCmd)
 	parentMigrateCmd.AddCommand(migrateCmd)
 	parentMigrateCmd.AddCommand(rollbackCmd)
 	parentMigrateCmd.AddCommand(seedProjectsCmd)
+	parentMigrateCmd.AddCommand(offloadNodeExecutionClosuresCmd)
 }
@@ -0,0 +1,148 @@
+package entrypoints
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/lyft/flyteadmin/pkg/operator"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+var (
+	operatorTarget string
+
+	terminateProject string
+	terminateDomain  string
+	terminateFilters string
+	terminateCause   string
+	terminateDryRun  bool
+
+	applyProjectsFile string
+)
+
+var executionsCmd = &cobra.Command{
+	Use:   "executions",
+	Short: "Operational tasks on the executions of a running flyteadmin instance",
+}
+
+var terminateExecutionsCmd = &cobra.Command{
+	Use:   "terminate",
+	Short: "Terminates all running executions in a project and domain matching the given filters",
+	Long: `
+Lists the executions matching the given filters and terminates those which haven't yet reached a terminal phase,
+for instance:
+
+    flyteadmin executions terminate --target localhost:8089 --project flytesnacks --domain development \
+        --filter "eq(launch_plan.name,workflows.hello)" --cause "bad release"
+
+Use --dryRun to list the matching executions without terminating them.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		return withAdminClient(func(client service.AdminServiceClient) error {
+			result, err := operator.TerminateExecutions(ctx, client, operator.TerminateExecutionsConfig{
+				Project: terminateProject,
+				Domain:  terminateDomain,
+				Filters: terminateFilters,
+				Cause:   terminateCause,
+				DryRun:  terminateDryRun,
+			})
+			if err != nil {
+				return err
+			}
+			for _, id := range result.Matched {
+				fmt.Printf("%s/%s/%s\n", id.Project, id.Domain, id.Name)
+			}
+			if terminateDryRun {
+				fmt.Printf("%d executions would be terminated\n", len(result.Matched))
+				return nil
+			}
+			fmt.Printf("Terminated %d of %d executions\n", len(result.Terminated), len(result.Matched))
+			for name, err := range result.Failed {
+				fmt.Printf("Failed to terminate %s: %v\n", name, err)
+			}
+			if len(result.Failed) > 0 {
+				return fmt.Errorf("failed to terminate %d executions", len(result.Failed))
+			}
+			return nil
+		})
+	},
+}
+
+var projectsCmd = &cobra.Command{
+	Use:   "projects",
+	Short: "Operational tasks on the projects of a running flyteadmin instance",
+}
+
+var applyProjectsCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Registers the projects listed in a file which aren't already registered",
+	Long: `
+Reads a JSON list of projects and registers any which don't exist yet, for instance:
+
+    flyteadmin projects apply --target localhost:8089 -f projects.json
+
+where projects.json contains:
+
+    {"projects": [{"id": "flytesnacks", "name": "Flyte Snacks", "description": "Example workflows"}]}
+
+Projects which are already registered are left unchanged.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		file, err := os.Open(applyProjectsFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		projects, err := operator.ReadProjects(file)
+		if err != nil {
+			return err
+		}
+		return withAdminClient(func(client service.AdminServiceClient) error {
+			result, err := operator.ApplyProjects(ctx, client, projects)
+			for _, id := range result.Registered {
+				fmt.Printf("Registered %s\n", id)
+			}
+			for _, id := range result.Unchanged {
+				fmt.Printf("Unchanged %s\n", id)
+			}
+			return err
+		})
+	},
+}
+
+func withAdminClient(do func(client service.AdminServiceClient) error) error {
+	conn, err := grpc.Dial(operatorTarget, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return do(service.NewAdminServiceClient(conn))
+}
+
+func init() {
+	RootCmd.AddCommand(executionsCmd)
+	RootCmd.AddCommand(projectsCmd)
+	executionsCmd.AddCommand(terminateExecutionsCmd)
+	projectsCmd.AddCommand(applyProjectsCmd)
+
+	for _, cmd := range []*cobra.Command{executionsCmd, projectsCmd} {
+		cmd.PersistentFlags().StringVar(&operatorTarget, "target", "localhost:8089",
+			"gRPC address of the flyteadmin instance to operate on")
+	}
+
+	terminateExecutionsCmd.Flags().StringVar(&terminateProject, "project", "", "Project of the executions to terminate")
+	terminateExecutionsCmd.Flags().StringVar(&terminateDomain, "domain", "", "Domain of the executions to terminate")
+	terminateExecutionsCmd.Flags().StringVar(&terminateFilters, "filter", "",
+		"Filters selecting the executions to terminate, e.g. eq(launch_plan.name,my.launch.plan)")
+	terminateExecutionsCmd.Flags().StringVar(&terminateCause, "cause", "", "Cause recorded on terminated executions")
+	terminateExecutionsCmd.Flags().BoolVar(&terminateDryRun, "dryRun", false,
+		"List the matching executions without terminating them")
+
+	applyProjectsCmd.Flags().StringVarP(&applyProjectsFile, "file", "f", "", "JSON file listing the projects to apply")
+	_ = applyProjectsCmd.MarkFlagRequired("file")
+}
@@ -0,0 +1,73 @@
+package entrypoints
+
+import (
+	"context"
+
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/lyft/flyteadmin/pkg/pruning"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	"github.com/lyft/flyteadmin/pkg/runtime"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/spf13/cobra"
+)
+
+var parentPruningCmd = &cobra.Command{
+	Use:   "pruning",
+	Short: "This command administers the PruningController. Please choose a subcommand.",
+}
+
+func getPruningController() pruning.Controller {
+	configuration := runtime.NewConfigurationProvider()
+	applicationConfiguration := configuration.ApplicationConfiguration()
+	scope := promutils.NewScope(applicationConfiguration.GetTopLevelConfig().MetricsScope).NewSubScope("pruning")
+	dbConfigValues := applicationConfiguration.GetDbConfig()
+	dbConfig := repositoryConfig.DbConfig{
+		Host:         dbConfigValues.Host,
+		Port:         dbConfigValues.Port,
+		DbName:       dbConfigValues.DbName,
+		User:         dbConfigValues.User,
+		Password:     dbConfigValues.Password,
+		ExtraOptions: dbConfigValues.ExtraOptions,
+	}
+	db := repositories.GetRepository(
+		repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+	// Workflow closures are read to tell which task versions are still referenced.
+	dataStorageClient, err := storage.NewDataStore(storage.GetConfig(), scope.NewSubScope("storage"))
+	if err != nil {
+		logger.Fatalf(context.Background(), "Failed to initialize storage config with err: %v", err)
+	}
+	return pruning.NewPruningController(db, applicationConfiguration, dataStorageClient, scope)
+}
+
+var pruningRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "This command will start a pruning controller to periodically prune old entity versions",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		pruningController := getPruningController()
+		logger.Infof(ctx, "PruningController started successfully")
+		pruningController.Run()
+	},
+}
+
+var pruningPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "This command will prune old task, workflow and launch plan versions once",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		err := getPruningController().Prune(ctx)
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to prune old versions [%+v]", err)
+		}
+		logger.Infof(ctx, "Pruned old versions successfully")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentPruningCmd)
+	parentPruningCmd.AddCommand(pruningRunCmd)
+	parentPruningCmd.AddCommand(pruningPruneCmd)
+}
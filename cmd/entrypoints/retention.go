@@ -0,0 +1,91 @@
+package entrypoints
+
+import (
+	"context"
+
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/lyft/flyteadmin/pkg/data"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	"github.com/lyft/flyteadmin/pkg/retention"
+	"github.com/lyft/flyteadmin/pkg/runtime"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/spf13/cobra"
+)
+
+const retentionRemoteDataRetries = 3
+
+var dryRun bool
+
+var parentRetentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "This command administers the RetentionController. Please choose a subcommand.",
+}
+
+func getRetentionController(ctx context.Context) retention.Controller {
+	configuration := runtime.NewConfigurationProvider()
+	applicationConfiguration := configuration.ApplicationConfiguration()
+	if dryRun {
+		applicationConfiguration.GetRetentionConfig().DryRun = true
+	}
+	scope := promutils.NewScope(applicationConfiguration.GetTopLevelConfig().MetricsScope).NewSubScope("retention")
+	dbConfigValues := applicationConfiguration.GetDbConfig()
+	dbConfig := repositoryConfig.DbConfig{
+		Host:         dbConfigValues.Host,
+		Port:         dbConfigValues.Port,
+		DbName:       dbConfigValues.DbName,
+		User:         dbConfigValues.User,
+		Password:     dbConfigValues.Password,
+		ExtraOptions: dbConfigValues.ExtraOptions,
+	}
+	db := repositories.GetRepository(
+		repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+
+	dataStorageClient, err := storage.NewDataStore(storage.GetConfig(), scope.NewSubScope("storage"))
+	if err != nil {
+		logger.Fatalf(ctx, "Failed to initialize storage config with err: %v", err)
+	}
+	remoteDataConfig := applicationConfiguration.GetRemoteDataConfig()
+	remover := data.GetRemoteDataHandler(data.RemoteDataHandlerConfig{
+		CloudProvider:            remoteDataConfig.Scheme,
+		SignedURLDurationMinutes: remoteDataConfig.SignedURL.DurationMinutes,
+		Region:                   remoteDataConfig.Region,
+		Retries:                  retentionRemoteDataRetries,
+		RemoteDataStoreClient:    dataStorageClient,
+	}).GetRemoteDataRemover()
+	return retention.NewRetentionController(db, applicationConfiguration, dataStorageClient, remover, scope)
+}
+
+var retentionRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "This command will start a retention controller to periodically remove the data of expired executions",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		retentionController := getRetentionController(ctx)
+		logger.Infof(ctx, "RetentionController started successfully")
+		retentionController.Run()
+	},
+}
+
+var retentionSweepCmd = &cobra.Command{
+	Use:   "sweep",
+	Short: "This command will remove the data of expired executions once",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		err := getRetentionController(ctx).Sweep(ctx)
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to remove the data of expired executions [%+v]", err)
+		}
+		logger.Infof(ctx, "Removed the data of expired executions successfully")
+	},
+}
+
+func init() {
+	parentRetentionCmd.PersistentFlags().BoolVar(&dryRun, "dryRun", false,
+		"Only report the data which would be removed, overriding the retention.dryRun config value")
+	RootCmd.AddCommand(parentRetentionCmd)
+	parentRetentionCmd.AddCommand(retentionRunCmd)
+	parentRetentionCmd.AddCommand(retentionSweepCmd)
+}
@@ -9,6 +9,15 @@ import (
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/auth/interfaces"
+	"github.com/lyft/flyteadmin/pkg/logging"
+	"github.com/lyft/flyteadmin/pkg/preflight"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	runtimeConfig "github.com/lyft/flyteadmin/pkg/runtime"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
 
 	"net"
 	"net/http"
@@ -35,6 +44,50 @@ import (
 	"google.golang.org/grpc"
 )
 
+var skipPreflight bool
+
+// Paths of the endpoints served over plain HTTP next to the grpc-gateway, see newHTTPServer.
+const loggingPath = "/logging"
+const backfillExecutionsPath = "/api/v1/backfill_executions"
+const updateExecutionPath = "/api/v1/update_execution"
+const workflowEventsPath = "/api/v1/events/batch"
+const nodeExecutionChildrenPath = "/api/v1/node_execution_children"
+const nodeExecutionChildRollupPath = "/api/v1/node_execution_child_rollup"
+const nodeExecutionsByTaskPath = "/api/v1/node_executions_by_task"
+const dynamicNodeWorkflowPath = "/api/v1/dynamic_node_workflow"
+const executionFullDataPath = "/api/v1/full_data/executions"
+const executionTimelinePath = "/api/v1/execution_timeline"
+const rerunExecutionNodePath = "/api/v1/rerun_execution_node"
+const inlineExecutionPath = "/api/v1/inline_execution"
+const taskStatePath = "/api/v1/task_state"
+const launchPlanScheduleStatePath = "/api/v1/launch_plan_schedule_state"
+const launchPlanStateHistoryPath = "/api/v1/launch_plan_state_history"
+const launchPlanInputsPath = "/api/v1/launch_plan_inputs"
+const launchPlanVariantsPath = "/api/v1/launch_plan_variants"
+const entityUsagePath = "/api/v1/entity_usage"
+const searchEntitiesPath = "/api/v1/search_entities"
+const namedEntityStatePath = "/api/v1/named_entity_state"
+const taskDiffPath = "/api/v1/task_diff"
+const workflowGraphPath = "/api/v1/workflow_graph"
+const compileWorkflowPath = "/api/v1/compile_workflow"
+const nodeExecutionFullDataPath = "/api/v1/full_data/node_executions"
+const nodeExecutionCacheMetadataPath = "/api/v1/node_execution_cache_metadata"
+const nodeExecutionArtifactsPath = "/api/v1/node_execution_artifacts"
+const projectDomainAttributesSyncPath = "/api/v1/project_domain_attributes/sync"
+const projectDomainAttributesAckPath = "/api/v1/project_domain_attributes/ack"
+const taskExecutionLogsPath = "/api/v1/task_execution_logs"
+const nodeExecutionAttemptDataPath = "/api/v1/node_execution_attempt_data"
+const nodeExecutionMetricsPath = "/api/v1/node_execution_metrics"
+const projectOnboardingPath = "/api/v1/project_onboarding"
+const projectUpdatePath = "/api/v1/project_update"
+const projectDetailsPath = "/api/v1/project_details"
+const projectUsagePath = "/api/v1/project_usage"
+const domainsPath = "/api/v1/domains"
+const resourceAttributesPath = "/api/v1/resource_attributes"
+const notificationPreferencesPath = "/api/v1/notification_preferences"
+const readOnlyModePath = "/api/v1/read_only_mode"
+const versionPath = "/api/v1/version"
+
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
 	Use:   "serve",
@@ -43,16 +96,29 @@ var serveCmd = &cobra.Command{
 		ctx := context.Background()
 		serverConfig := config.GetConfig()
 
+		if !skipPreflight {
+			if err := runPreflight(ctx, serverConfig); err != nil {
+				return err
+			}
+		}
+
+		readOnly, err := checkSchemaCompatibility(ctx)
+		if err != nil {
+			return err
+		}
+
 		if serverConfig.Security.Secure {
-			return serveGatewaySecure(ctx, serverConfig)
+			return serveGatewaySecure(ctx, serverConfig, readOnly)
 		}
-		return serveGatewayInsecure(ctx, serverConfig)
+		return serveGatewayInsecure(ctx, serverConfig, readOnly)
 	},
 }
 
 func init() {
 	// Command information
 	RootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().BoolVar(&skipPreflight, "skipPreflight", false,
+		"Skip verifying the database, storage, notifications and auth dependencies before serving")
 
 	// Set Keys
 	labeled.SetMetricKeys(contextutils.AppNameKey, contextutils.ProjectKey, contextutils.DomainKey,
@@ -60,22 +126,95 @@ func init() {
 		contextutils.TaskTypeKey, common.RuntimeTypeKey, common.RuntimeVersionKey)
 }
 
+// Verifies admin's external dependencies, reporting every misconfiguration at once.
+func runPreflight(ctx context.Context, cfg *config.ServerConfig) error {
+	configuration := runtimeConfig.NewConfigurationProvider()
+	applicationConfiguration := configuration.ApplicationConfiguration()
+	scope := promutils.NewScope(applicationConfiguration.GetTopLevelConfig().MetricsScope).NewSubScope("preflight")
+
+	dbConfigValues := applicationConfiguration.GetDbConfig()
+	checks := []preflight.Check{
+		preflight.NewDatabaseCheck(repositoryConfig.NewPostgresConfigProvider(repositoryConfig.DbConfig{
+			Host:         dbConfigValues.Host,
+			Port:         dbConfigValues.Port,
+			DbName:       dbConfigValues.DbName,
+			User:         dbConfigValues.User,
+			Password:     dbConfigValues.Password,
+			ExtraOptions: dbConfigValues.ExtraOptions,
+		}, scope.NewSubScope("database"))),
+	}
+	dataStore, err := storage.NewDataStore(storage.GetConfig(), scope.NewSubScope("storage"))
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize storage config")
+	}
+	checks = append(checks, preflight.NewStorageCheck(dataStore))
+	checks = append(checks, preflight.NewNotificationsChecks(*applicationConfiguration.GetNotificationsConfig())...)
+	if cfg.Security.UseAuth {
+		checks = append(checks, preflight.NewOAuthIssuerCheck(cfg.Security.Oauth, auth.MetadataEndpoint, http.DefaultClient))
+	}
+	return preflight.Run(ctx, checks)
+}
+
+// Compares the database schema with the migrations this binary knows of, failing when migrations are missing. Returns
+// whether to serve in read-only mode because the schema was already migrated by a newer release, as happens while
+// replicas are rolled over to it.
+func checkSchemaCompatibility(ctx context.Context) (bool, error) {
+	applicationConfiguration := runtimeConfig.NewConfigurationProvider().ApplicationConfiguration()
+	scope := promutils.NewScope(applicationConfiguration.GetTopLevelConfig().MetricsScope).NewSubScope("schema_check")
+	dbConfigValues := applicationConfiguration.GetDbConfig()
+	dbConfigProvider := repositoryConfig.NewPostgresConfigProvider(repositoryConfig.DbConfig{
+		Host:         dbConfigValues.Host,
+		Port:         dbConfigValues.Port,
+		DbName:       dbConfigValues.DbName,
+		User:         dbConfigValues.User,
+		Password:     dbConfigValues.Password,
+		ExtraOptions: dbConfigValues.ExtraOptions,
+	}, scope)
+	db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+	if err != nil {
+		return false, errors.Wrap(err, "failed to connect to the database to check its schema")
+	}
+	defer db.Close()
+	status, err := repositoryConfig.GetSchemaStatus(db)
+	if err != nil {
+		return false, err
+	}
+	switch status.Compatibility {
+	case repositoryConfig.SchemaBehind:
+		return false, fmt.Errorf("database schema is older than the expected version [%s] and is missing "+
+			"migrations [%s], run `flyteadmin migrate run` before serving",
+			repositoryConfig.GetExpectedSchemaVersion(), strings.Join(status.Missing, ", "))
+	case repositoryConfig.SchemaAhead:
+		logger.Warningf(ctx, "database schema is newer than the expected version [%s] with unknown migrations [%s], "+
+			"serving in read-only mode", repositoryConfig.GetExpectedSchemaVersion(), strings.Join(status.Unknown, ", "))
+		return true, nil
+	}
+	logger.Infof(ctx, "database schema matches the expected version [%s]", repositoryConfig.GetExpectedSchemaVersion())
+	return false, nil
+}
+
 // Creates a new gRPC Server with all the configuration
 func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authContext interfaces.AuthenticationContext,
-	opts ...grpc.ServerOption) (*grpc.Server, error) {
+	adminServer *adminservice.AdminService, readOnly bool, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	// Not yet implemented for streaming
-	var chainedUnaryInterceptors grpc.UnaryServerInterceptor
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
 	if cfg.Security.UseAuth {
 		logger.Infof(ctx, "Creating gRPC server with authentication")
-		chainedUnaryInterceptors = grpc_middleware.ChainUnaryServer(grpc_prometheus.UnaryServerInterceptor,
+		unaryInterceptors = append(unaryInterceptors,
 			auth.GetAuthenticationCustomMetadataInterceptor(authContext),
 			grpcauth.UnaryServerInterceptor(auth.GetAuthenticationInterceptor(authContext)),
 			auth.AuthenticationLoggingInterceptor,
+			server.ProjectScopeUnaryServerInterceptor,
 		)
 	} else {
 		logger.Infof(ctx, "Creating gRPC server without authentication")
-		chainedUnaryInterceptors = grpc_middleware.ChainUnaryServer(grpc_prometheus.UnaryServerInterceptor)
 	}
+	if readOnly {
+		logger.Infof(ctx, "Creating gRPC server in read-only mode")
+		unaryInterceptors = append(unaryInterceptors, server.ReadOnlyUnaryServerInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, server.GetReadOnlyModeUnaryServerInterceptor(adminServer.ReadOnlyMode))
+	chainedUnaryInterceptors := grpc_middleware.ChainUnaryServer(unaryInterceptors...)
 	serverOpts := []grpc.ServerOption{
 		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
 		grpc.UnaryInterceptor(chainedUnaryInterceptors),
@@ -83,7 +222,7 @@ func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authContext in
 	serverOpts = append(serverOpts, opts...)
 	grpcServer := grpc.NewServer(serverOpts...)
 	grpc_prometheus.Register(grpcServer)
-	flyteService.RegisterAdminServiceServer(grpcServer, adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master))
+	flyteService.RegisterAdminServiceServer(grpcServer, adminServer)
 	return grpcServer, nil
 }
 
@@ -107,8 +246,62 @@ func healthCheckFunc(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// The endpoints through which only operators may change anything when auth is enabled, see auth.RequireOperator.
+var operatorPaths = map[string]bool{
+	loggingPath:      true,
+	domainsPath:      true,
+	readOnlyModePath: true,
+}
+
+// The endpoints served over plain HTTP, by path. They're only served to authenticated callers when auth is enabled.
+func getHTTPRoutes(ctx context.Context, adminServer *adminservice.AdminService) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		loggingPath:                     logging.GetLogLevelHandler(ctx),
+		backfillExecutionsPath:          adminServer.GetBackfillExecutionsHandler(ctx),
+		updateExecutionPath:             adminServer.GetUpdateExecutionHandler(ctx),
+		workflowEventsPath:              adminServer.GetCreateWorkflowEventsHandler(ctx),
+		nodeExecutionChildrenPath:       adminServer.GetListNodeExecutionChildrenHandler(ctx),
+		nodeExecutionChildRollupPath:    adminServer.GetNodeExecutionChildRollupHandler(ctx),
+		nodeExecutionsByTaskPath:        adminServer.GetListNodeExecutionsByTaskHandler(ctx),
+		dynamicNodeWorkflowPath:         adminServer.GetDynamicNodeWorkflowHandler(ctx),
+		executionFullDataPath:           adminServer.GetExecutionFullDataHandler(ctx),
+		executionTimelinePath:           adminServer.GetExportExecutionTimelineHandler(ctx),
+		rerunExecutionNodePath:          adminServer.GetRerunExecutionNodeHandler(ctx),
+		inlineExecutionPath:             adminServer.GetCreateInlineExecutionHandler(ctx),
+		taskStatePath:                   adminServer.GetUpdateTaskStateHandler(ctx),
+		launchPlanScheduleStatePath:     adminServer.GetUpdateLaunchPlanScheduleStateHandler(ctx),
+		launchPlanStateHistoryPath:      adminServer.GetListLaunchPlanStateHistoryHandler(ctx),
+		launchPlanInputsPath:            adminServer.GetLaunchPlanInputsHandler(ctx),
+		launchPlanVariantsPath:          adminServer.GetCreateLaunchPlanVariantsHandler(ctx),
+		entityUsagePath:                 adminServer.GetEntityUsageHandler(ctx),
+		searchEntitiesPath:              adminServer.GetSearchEntitiesHandler(ctx),
+		namedEntityStatePath:            adminServer.GetUpdateNamedEntityStateHandler(ctx),
+		taskDiffPath:                    adminServer.GetDiffTaskHandler(ctx),
+		workflowGraphPath:               adminServer.GetWorkflowGraphHandler(ctx),
+		compileWorkflowPath:             adminServer.GetCompileWorkflowHandler(ctx),
+		nodeExecutionFullDataPath:       adminServer.GetNodeExecutionFullDataHandler(ctx),
+		nodeExecutionCacheMetadataPath:  adminServer.GetNodeExecutionCacheMetadataHandler(ctx),
+		nodeExecutionArtifactsPath:      adminServer.GetNodeExecutionArtifactsHandler(ctx),
+		projectDomainAttributesSyncPath: adminServer.GetSyncProjectDomainAttributesHandler(ctx),
+		projectDomainAttributesAckPath:  adminServer.GetAckProjectDomainAttributesHandler(ctx),
+		taskExecutionLogsPath:           adminServer.GetTaskExecutionLogsHandler(ctx),
+		nodeExecutionAttemptDataPath:    adminServer.GetNodeExecutionAttemptDataHandler(ctx),
+		nodeExecutionMetricsPath:        adminServer.GetNodeExecutionMetricsHandler(ctx),
+		projectOnboardingPath:           adminServer.GetProjectOnboardingStatusHandler(ctx),
+		projectUpdatePath:               adminServer.GetUpdateProjectHandler(ctx),
+		projectDetailsPath:              adminServer.GetListProjectDetailsHandler(ctx),
+		projectUsagePath:                adminServer.GetProjectUsageReportHandler(ctx),
+		domainsPath:                     adminServer.GetDomainsHandler(ctx),
+		resourceAttributesPath:          adminServer.GetResourceAttributesHandler(ctx),
+		notificationPreferencesPath:     adminServer.GetNotificationPreferencesHandler(ctx),
+		readOnlyModePath:                server.GetReadOnlyModeSwitchHandler(ctx, adminServer.ReadOnlyMode),
+		versionPath:                     adminServer.GetVersionHandler(ctx),
+	}
+}
+
 func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authContext interfaces.AuthenticationContext,
-	grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
+	adminServer *adminservice.AdminService, grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (
+	*http.ServeMux, error) {
 
 	// Register the server that will serve HTTP/REST Traffic
 	mux := http.NewServeMux()
@@ -120,9 +313,20 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authContext in
 	// This endpoint will serve the OpenAPI2 spec generated by the swagger protoc plugin, and bundled by go-bindata
 	mux.HandleFunc("/api/v1/openapi", GetHandleOpenapiSpec(ctx))
 
+	for path, handler := range getHTTPRoutes(ctx, adminServer) {
+		if cfg.Security.UseAuth {
+			if operatorPaths[path] {
+				handler = auth.RequireOperator(ctx, authContext, handler)
+			}
+			handler = auth.RequireAuthentication(ctx, authContext, handler)
+		}
+		mux.HandleFunc(path, handler)
+	}
+
 	var gwmuxOptions = make([]runtime.ServeMuxOption, 0)
 	// This option means that http requests are served with protobufs, instead of json. We always want this.
 	gwmuxOptions = append(gwmuxOptions, runtime.WithMarshalerOption("application/octet-stream", &runtime.ProtoMarshaller{}))
+	gwmuxOptions = append(gwmuxOptions, runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher))
 
 	if cfg.Security.UseAuth {
 		// Add HTTP handlers for OAuth2 endpoints
@@ -135,7 +339,6 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authContext in
 		}
 		// The metadata endpoint is an RFC-defined constant, but we need a leading / for the handler to pattern match correctly.
 		mux.HandleFunc(fmt.Sprintf("/%s", auth.MetadataEndpoint), auth.GetMetadataEndpointRedirectHandler(ctx, authContext))
-
 		// This option translates HTTP authorization data (cookies) into a gRPC metadata field
 		gwmuxOptions = append(gwmuxOptions, runtime.WithMetadata(auth.GetHTTPRequestCookieToMetadataHandler(authContext)))
 
@@ -173,7 +376,7 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authContext in
 	return mux, nil
 }
 
-func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig) error {
+func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, readOnly bool) error {
 	logger.Infof(ctx, "Serving Flyte Admin Insecure")
 
 	// This will parse configuration and create the necessary objects for dealing with auth
@@ -191,7 +394,8 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig) error {
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authContext)
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master, readOnly)
+	grpcServer, err := newGRPCServer(ctx, cfg, authContext, adminServer, readOnly)
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
 	}
@@ -208,11 +412,11 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig) error {
 	}()
 
 	logger.Infof(ctx, "Starting HTTP/1 Gateway server on %s", cfg.GetHostAddress())
-	httpServer, err := newHTTPServer(ctx, cfg, authContext, cfg.GetGrpcHostAddress(), grpc.WithInsecure())
+	httpServer, err := newHTTPServer(ctx, cfg, authContext, adminServer, cfg.GetGrpcHostAddress(), grpc.WithInsecure())
 	if err != nil {
 		return err
 	}
-	err = http.ListenAndServe(cfg.GetHostAddress(), httpServer)
+	err = http.ListenAndServe(cfg.GetHostAddress(), getHTTPHandler(httpServer, adminServer, readOnly))
 	if err != nil {
 		return errors.Wrapf(err, "failed to Start HTTP Server")
 	}
@@ -220,6 +424,17 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig) error {
 	return nil
 }
 
+// In read-only mode, rejects HTTP requests which write to the database. Log levels can still be changed, the read-only
+// mode can still be switched, tasks can still be diffed and workflows can still be compiled.
+func getHTTPHandler(mux *http.ServeMux, adminServer *adminservice.AdminService, readOnly bool) http.Handler {
+	handler := server.GetReadOnlyModeHandler(mux, adminServer.ReadOnlyMode, loggingPath, readOnlyModePath,
+		taskDiffPath, compileWorkflowPath)
+	if !readOnly {
+		return handler
+	}
+	return server.GetReadOnlyHandler(handler, loggingPath, readOnlyModePath, taskDiffPath, compileWorkflowPath)
+}
+
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
 // connections or otherHandler otherwise.
 // See https://github.com/philips/grpc-gateway-example/blob/master/cmd/serve.go for reference
@@ -234,7 +449,7 @@ func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Ha
 	})
 }
 
-func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig) error {
+func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, readOnly bool) error {
 	certPool, cert, err := server.GetSslCredentials(ctx, cfg.Security.Ssl.CertificateFile, cfg.Security.Ssl.KeyFile)
 	if err != nil {
 		return err
@@ -249,7 +464,8 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig) error {
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authContext,
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master, readOnly)
+	grpcServer, err := newGRPCServer(ctx, cfg, authContext, adminServer, readOnly,
 		grpc.Creds(credentials.NewServerTLSFromCert(cert)))
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
@@ -260,7 +476,8 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig) error {
 		ServerName: cfg.GetHostAddress(),
 		RootCAs:    certPool,
 	})
-	httpServer, err := newHTTPServer(ctx, cfg, authContext, cfg.GetHostAddress(), grpc.WithTransportCredentials(dialCreds))
+	httpServer, err := newHTTPServer(ctx, cfg, authContext, adminServer, cfg.GetHostAddress(),
+		grpc.WithTransportCredentials(dialCreds))
 	if err != nil {
 		return err
 	}
@@ -272,7 +489,7 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig) error {
 
 	srv := &http.Server{
 		Addr:    cfg.GetHostAddress(),
-		Handler: grpcHandlerFunc(grpcServer, httpServer),
+		Handler: grpcHandlerFunc(grpcServer, getHTTPHandler(httpServer, adminServer, readOnly)),
 		TLSConfig: &tls.Config{
 			Certificates: []tls.Certificate{*cert},
 			NextProtos:   []string{"h2"},
@@ -0,0 +1,67 @@
+package cloudevent
+
+import (
+	"context"
+
+	gizmoConfig "github.com/NYTimes/gizmo/pubsub/aws"
+	gizmoGCP "github.com/NYTimes/gizmo/pubsub/gcp"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+
+	"github.com/lyft/flyteadmin/pkg/async/cloudevent/implementations"
+	"github.com/lyft/flyteadmin/pkg/async/cloudevent/interfaces"
+	"github.com/lyft/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const defaultSource = "flyteadmin"
+
+func NewCloudEventPublisher(config runtimeInterfaces.CloudEventsConfig, scope promutils.Scope) interfaces.Publisher {
+	if !config.Enable {
+		return implementations.NewNoopPublisher()
+	}
+	source := config.Source
+	if len(source) == 0 {
+		source = defaultSource
+	}
+	switch config.Type {
+	case common.AWS:
+		snsConfig := gizmoConfig.SNSConfig{
+			Topic: config.TopicName,
+		}
+		snsConfig.Region = config.Region
+		publisher, err := gizmoConfig.NewPublisher(snsConfig)
+		// Any errors initiating Publisher with Amazon configurations results in a failed start up.
+		if err != nil {
+			panic(err)
+		}
+		return implementations.NewCloudEventPublisher(publisher, source, scope)
+	case common.GCP:
+		publisher, err := gizmoGCP.NewPublisher(context.Background(), gizmoGCP.Config{
+			ProjectID: config.GCPConfig.ProjectID,
+			Topic:     config.TopicName,
+		})
+		// Any errors initiating Publisher with GCP configurations results in a failed start up.
+		if err != nil {
+			panic(err)
+		}
+		return implementations.NewCloudEventPublisher(publisher, source, scope)
+	case common.Kafka:
+		publisher, err := implementations.NewKafkaPublisher(
+			config.KafkaConfig.Brokers, config.KafkaConfig.Version, config.TopicName)
+		// Any errors connecting to the Kafka brokers results in a failed start up.
+		if err != nil {
+			panic(err)
+		}
+		return implementations.NewCloudEventPublisher(publisher, source, scope)
+	case common.Webhook:
+		return implementations.NewCloudEventPublisher(
+			implementations.NewWebhookPublisher(config.Webhook), source, scope)
+	case common.Local:
+		fallthrough
+	default:
+		logger.Infof(context.Background(),
+			"Using default noop cloud event publisher implementation for config type [%s]", config.Type)
+		return implementations.NewNoopPublisher()
+	}
+}
@@ -0,0 +1,149 @@
+package implementations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/lyft/flyteadmin/pkg/async/cloudevent/interfaces"
+	"github.com/lyft/flyteadmin/pkg/logging"
+)
+
+const cloudEventsSpecVersion = "1.0"
+const jsonContentType = "application/json"
+
+// The structured-mode JSON encoding of a CloudEvent as defined by
+// https://github.com/cloudevents/spec/blob/v1.0/json-format.md
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+type publisherMetrics struct {
+	Scope        promutils.Scope
+	PublishTotal prometheus.Counter
+	PublishError prometheus.Counter
+}
+
+type CloudEventPublisher struct {
+	pub     pubsub.Publisher
+	source  string
+	metrics publisherMetrics
+}
+
+func getExecutionSubject(id *core.WorkflowExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", id.GetProject(), id.GetDomain(), id.GetName())
+}
+
+func getNodeExecutionSubject(id *core.NodeExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s", getExecutionSubject(id.GetExecutionId()), id.GetNodeId())
+}
+
+// Returns the subject and occurrence time of the events mirrored to the topic, the subject identifies the execution
+// the event was reported for.
+func getSubjectAndTime(msg proto.Message) (string, *time.Time) {
+	var subject string
+	var occurredAt *time.Time
+	switch e := msg.(type) {
+	case *event.WorkflowExecutionEvent:
+		subject = getExecutionSubject(e.GetExecutionId())
+		if timestamp, err := ptypes.Timestamp(e.GetOccurredAt()); err == nil {
+			occurredAt = &timestamp
+		}
+	case *event.NodeExecutionEvent:
+		subject = getNodeExecutionSubject(e.GetId())
+		if timestamp, err := ptypes.Timestamp(e.GetOccurredAt()); err == nil {
+			occurredAt = &timestamp
+		}
+	case *event.TaskExecutionEvent:
+		subject = fmt.Sprintf("%s/%s/%d", getNodeExecutionSubject(e.GetParentNodeExecutionId()),
+			e.GetTaskId().GetName(), e.GetRetryAttempt())
+		if timestamp, err := ptypes.Timestamp(e.GetOccurredAt()); err == nil {
+			occurredAt = &timestamp
+		}
+	}
+	return subject, occurredAt
+}
+
+func (p *CloudEventPublisher) newCloudEvent(eventType string, msg proto.Message) (cloudEvent, error) {
+	serialized, err := proto.Marshal(msg)
+	if err != nil {
+		return cloudEvent{}, err
+	}
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(msg)
+	if err != nil {
+		return cloudEvent{}, err
+	}
+	// The id is derived from the event itself so that consumers can drop the duplicates of retried event requests.
+	id := sha256.Sum256(serialized)
+	subject, occurredAt := getSubjectAndTime(msg)
+	envelope := cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              hex.EncodeToString(id[:]),
+		Source:          p.source,
+		Type:            eventType,
+		Subject:         subject,
+		DataContentType: jsonContentType,
+		Data:            json.RawMessage(data),
+	}
+	if occurredAt != nil {
+		envelope.Time = occurredAt.UTC().Format(time.RFC3339Nano)
+	}
+	return envelope, nil
+}
+
+func (p *CloudEventPublisher) Publish(ctx context.Context, eventType string, msg proto.Message) error {
+	p.metrics.PublishTotal.Inc()
+	envelope, err := p.newCloudEvent(eventType, msg)
+	if err != nil {
+		p.metrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to wrap event of type [%s] in a cloud event with err: %v", eventType, err)
+		return err
+	}
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		p.metrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to serialize cloud event [%s] with err: %v", envelope.ID, err)
+		return err
+	}
+	logging.Debugf(ctx, logging.Executions, "Publishing cloud event [%s] of type [%s] for [%s]",
+		envelope.ID, eventType, envelope.Subject)
+	if err := p.pub.PublishRaw(ctx, eventType, body); err != nil {
+		p.metrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to publish cloud event [%s] of type [%s] with err: %v", envelope.ID, eventType, err)
+		return err
+	}
+	return nil
+}
+
+func NewCloudEventPublisher(pub pubsub.Publisher, source string, scope promutils.Scope) interfaces.Publisher {
+	publisherScope := scope.NewSubScope("cloud_event_publisher")
+	return &CloudEventPublisher{
+		pub:    pub,
+		source: source,
+		metrics: publisherMetrics{
+			Scope:        publisherScope,
+			PublishTotal: publisherScope.MustNewCounter("publish_total", "overall count of published cloud events"),
+			PublishError: publisherScope.MustNewCounter("publish_errors", "count of cloud event publish errors"),
+		},
+	}
+}
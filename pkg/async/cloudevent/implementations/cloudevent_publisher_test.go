@@ -0,0 +1,122 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var nodeExecutionID = &core.NodeExecutionIdentifier{
+	NodeId: "node-id",
+	ExecutionId: &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	},
+}
+
+var sampleTime = time.Date(2019, time.November, 29, 10, 0, 0, 0, time.UTC)
+
+func getPublishedCloudEvent(t *testing.T, testPublisher *pubsubtest.TestPublisher) map[string]interface{} {
+	assert.Len(t, testPublisher.Published, 1)
+	var published map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testPublisher.Published[0].Body, &published))
+	return published
+}
+
+func TestPublish_NodeExecutionEvent(t *testing.T) {
+	var testPublisher pubsubtest.TestPublisher
+	publisher := NewCloudEventPublisher(&testPublisher, "flyteadmin", promutils.NewTestScope())
+	occurredAt, _ := ptypes.TimestampProto(sampleTime)
+	nodeEvent := &event.NodeExecutionEvent{
+		Id:         nodeExecutionID,
+		Phase:      core.NodeExecution_RUNNING,
+		OccurredAt: occurredAt,
+	}
+
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(nodeEvent), nodeEvent))
+	assert.Equal(t, "flyteidl.event.NodeExecutionEvent", testPublisher.Published[0].Key)
+	published := getPublishedCloudEvent(t, &testPublisher)
+	assert.Equal(t, "1.0", published["specversion"])
+	assert.Equal(t, "flyteadmin", published["source"])
+	assert.Equal(t, "flyteidl.event.NodeExecutionEvent", published["type"])
+	assert.Equal(t, "project/domain/name/node-id", published["subject"])
+	assert.Equal(t, "2019-11-29T10:00:00Z", published["time"])
+	assert.Equal(t, "application/json", published["datacontenttype"])
+	assert.NotEmpty(t, published["id"])
+	assert.Equal(t, "RUNNING", published["data"].(map[string]interface{})["phase"])
+}
+
+func TestPublish_WorkflowExecutionEvent(t *testing.T) {
+	var testPublisher pubsubtest.TestPublisher
+	publisher := NewCloudEventPublisher(&testPublisher, "flyteadmin", promutils.NewTestScope())
+	occurredAt, _ := ptypes.TimestampProto(sampleTime)
+	workflowEvent := &event.WorkflowExecutionEvent{
+		ExecutionId: nodeExecutionID.ExecutionId,
+		Phase:       core.WorkflowExecution_SUCCEEDED,
+		OccurredAt:  occurredAt,
+	}
+
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(workflowEvent), workflowEvent))
+	published := getPublishedCloudEvent(t, &testPublisher)
+	assert.Equal(t, "flyteidl.event.WorkflowExecutionEvent", published["type"])
+	assert.Equal(t, "project/domain/name", published["subject"])
+	assert.Equal(t, "2019-11-29T10:00:00Z", published["time"])
+	assert.Equal(t, "SUCCEEDED", published["data"].(map[string]interface{})["phase"])
+}
+
+func TestPublish_TaskExecutionEvent(t *testing.T) {
+	var testPublisher pubsubtest.TestPublisher
+	publisher := NewCloudEventPublisher(&testPublisher, "flyteadmin", promutils.NewTestScope())
+	taskEvent := &event.TaskExecutionEvent{
+		TaskId: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Name:         "task",
+		},
+		ParentNodeExecutionId: nodeExecutionID,
+		RetryAttempt:          2,
+		Phase:                 core.TaskExecution_SUCCEEDED,
+	}
+
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(taskEvent), taskEvent))
+	published := getPublishedCloudEvent(t, &testPublisher)
+	assert.Equal(t, "project/domain/name/node-id/task/2", published["subject"])
+	assert.Nil(t, published["time"])
+}
+
+// Retried event requests are published with the same id so that consumers can drop the duplicates.
+func TestPublish_DeterministicID(t *testing.T) {
+	var testPublisher pubsubtest.TestPublisher
+	publisher := NewCloudEventPublisher(&testPublisher, "flyteadmin", promutils.NewTestScope())
+	nodeEvent := &event.NodeExecutionEvent{
+		Id:    nodeExecutionID,
+		Phase: core.NodeExecution_RUNNING,
+	}
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(nodeEvent), nodeEvent))
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(nodeEvent), nodeEvent))
+	var first, second map[string]interface{}
+	assert.NoError(t, json.Unmarshal(testPublisher.Published[0].Body, &first))
+	assert.NoError(t, json.Unmarshal(testPublisher.Published[1].Body, &second))
+	assert.Equal(t, first["id"], second["id"])
+}
+
+func TestPublish_Error(t *testing.T) {
+	var testPublisher pubsubtest.TestPublisher
+	publishError := errors.New("publish() returns an error")
+	testPublisher.GivenError = publishError
+	publisher := NewCloudEventPublisher(&testPublisher, "flyteadmin", promutils.NewTestScope())
+	nodeEvent := &event.NodeExecutionEvent{
+		Id: nodeExecutionID,
+	}
+	assert.Equal(t, publishError, publisher.Publish(context.Background(), proto.MessageName(nodeEvent), nodeEvent))
+}
@@ -0,0 +1,66 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/lyft/flyteadmin/pkg/logging"
+)
+
+// The subset of sarama.SyncProducer the Kafka publisher depends on.
+type kafkaProducer interface {
+	SendMessage(msg *sarama.ProducerMessage) (partition int32, offset int64, err error)
+}
+
+// Publishes raw messages to a Kafka topic, implementing gizmo's pubsub.Publisher. Messages are keyed by the execution
+// the context was tagged with so that the events of an execution land on the same partition and are consumed in order.
+type KafkaPublisher struct {
+	producer kafkaProducer
+	topic    string
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, key string, msg proto.Message) error {
+	message, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.PublishRaw(ctx, key, message)
+}
+
+func (p *KafkaPublisher) PublishRaw(ctx context.Context, key string, message []byte) error {
+	if executionID := logging.GetExecutionID(ctx); len(executionID) > 0 {
+		key = executionID
+	}
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(message),
+	})
+	return err
+}
+
+// Connects a synchronous producer to the brokers, which waits for all in-sync replicas to acknowledge each message.
+// The version defaults to sarama's when empty.
+func NewKafkaPublisher(brokers []string, version, topic string) (*KafkaPublisher, error) {
+	config := sarama.NewConfig()
+	if len(version) > 0 {
+		kafkaVersion, err := sarama.ParseKafkaVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		config.Version = kafkaVersion
+	}
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaPublisher{
+		producer: producer,
+		topic:    topic,
+	}, nil
+}
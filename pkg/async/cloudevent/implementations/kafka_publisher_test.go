@@ -0,0 +1,44 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/logging"
+)
+
+type fakeKafkaProducer struct {
+	messages []*sarama.ProducerMessage
+	err      error
+}
+
+func (p *fakeKafkaProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.messages = append(p.messages, msg)
+	return 0, int64(len(p.messages)), p.err
+}
+
+func TestKafkaPublisher_PublishRaw(t *testing.T) {
+	producer := &fakeKafkaProducer{}
+	publisher := &KafkaPublisher{producer: producer, topic: "events"}
+	ctx := logging.WithExecutionID(context.Background(), nodeExecutionID.ExecutionId)
+
+	assert.NoError(t, publisher.PublishRaw(ctx, "flyteidl.event.NodeExecutionEvent", []byte("event")))
+	// Events which aren't tagged with an execution are keyed by their type.
+	assert.NoError(t, publisher.PublishRaw(
+		context.Background(), "flyteidl.event.NodeExecutionEvent", []byte("event")))
+	assert.Len(t, producer.messages, 2)
+	assert.Equal(t, "events", producer.messages[0].Topic)
+	assert.Equal(t, sarama.StringEncoder("project/domain/name"), producer.messages[0].Key)
+	assert.Equal(t, sarama.ByteEncoder("event"), producer.messages[0].Value)
+	assert.Equal(t, sarama.StringEncoder("flyteidl.event.NodeExecutionEvent"), producer.messages[1].Key)
+}
+
+func TestKafkaPublisher_PublishRawError(t *testing.T) {
+	producer := &fakeKafkaProducer{err: errors.New("leader not available")}
+	publisher := &KafkaPublisher{producer: producer, topic: "events"}
+	assert.EqualError(t, publisher.PublishRaw(context.Background(), "key", []byte("event")), "leader not available")
+}
@@ -0,0 +1,22 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/lyft/flyteadmin/pkg/async/cloudevent/interfaces"
+	"github.com/lyft/flyteadmin/pkg/logging"
+)
+
+// Publisher to use when mirroring events is disabled.
+type NoopPublisher struct{}
+
+func (n *NoopPublisher) Publish(ctx context.Context, eventType string, msg proto.Message) error {
+	logging.Debugf(ctx, logging.Executions, "call to noop publish with event type [%s]", eventType)
+	return nil
+}
+
+func NewNoopPublisher() interfaces.Publisher {
+	return &NoopPublisher{}
+}
@@ -0,0 +1,65 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const webhookRequestTimeout = 10 * time.Second
+
+// The media type of a CloudEvent in structured mode, see
+// https://github.com/cloudevents/spec/blob/v1.0/http-protocol-binding.md#32-structured-content-mode
+const cloudEventsContentType = "application/cloudevents+json"
+
+// POSTs raw messages to a webhook, implementing gizmo's pubsub.Publisher. Messages are expected to be structured-mode
+// CloudEvents.
+type WebhookPublisher struct {
+	config runtimeInterfaces.CloudEventsWebhookConfig
+	client *http.Client
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, key string, msg proto.Message) error {
+	message, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.PublishRaw(ctx, key, message)
+}
+
+func (p *WebhookPublisher) PublishRaw(ctx context.Context, _ string, message []byte) error {
+	request, err := http.NewRequest(http.MethodPost, p.config.URL, bytes.NewReader(message))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", cloudEventsContentType)
+	for name, value := range p.config.Headers {
+		request.Header.Set(name, value)
+	}
+	response, err := p.client.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("cloud events webhook responded with status [%s]", response.Status)
+	}
+	return nil
+}
+
+func NewWebhookPublisher(config runtimeInterfaces.CloudEventsWebhookConfig) *WebhookPublisher {
+	return &WebhookPublisher{
+		config: config,
+		client: &http.Client{Timeout: webhookRequestTimeout},
+	}
+}
@@ -0,0 +1,46 @@
+package implementations
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+func TestWebhookPublisher_PublishRaw(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodPost, request.Method)
+		assert.Equal(t, "/events", request.URL.Path)
+		assert.Equal(t, "application/cloudevents+json", request.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer secret", request.Header.Get("Authorization"))
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		assert.NoError(t, err)
+		writer.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	publisher := NewWebhookPublisher(runtimeInterfaces.CloudEventsWebhookConfig{
+		URL:     server.URL + "/events",
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	})
+
+	assert.NoError(t, publisher.PublishRaw(context.Background(), "flyteidl.event.NodeExecutionEvent", []byte("{}")))
+	assert.Equal(t, "{}", string(body))
+}
+
+func TestWebhookPublisher_PublishRawError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	publisher := NewWebhookPublisher(runtimeInterfaces.CloudEventsWebhookConfig{URL: server.URL})
+
+	assert.EqualError(t, publisher.PublishRaw(context.Background(), "key", []byte("{}")),
+		"cloud events webhook responded with status [503 Service Unavailable]")
+}
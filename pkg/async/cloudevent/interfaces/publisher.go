@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Mirrors workflow, node and task execution events to an external topic so that downstream systems, e.g. lineage and
+// monitoring, receive them as they're recorded without polling admin. Events are published wrapped in a CloudEvents
+// (https://cloudevents.io) envelope.
+type Publisher interface {
+	// The event type is the name of the published proto message, e.g. flyteidl.event.WorkflowExecutionEvent.
+	Publish(ctx context.Context, eventType string, msg proto.Message) error
+}
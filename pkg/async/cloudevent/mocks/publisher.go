@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+)
+
+type PublishFunc func(ctx context.Context, eventType string, msg proto.Message) error
+
+type MockPublisher struct {
+	publishFunc PublishFunc
+}
+
+func (m *MockPublisher) SetPublishCallback(publishFunction PublishFunc) {
+	m.publishFunc = publishFunction
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, eventType string, msg proto.Message) error {
+	if m.publishFunc != nil {
+		return m.publishFunc(ctx, eventType, msg)
+	}
+	return nil
+}
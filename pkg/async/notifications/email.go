@@ -7,11 +7,15 @@ import (
 
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 type GetTemplateValue func(admin.WorkflowExecutionEventRequest, *admin.Execution) string
 
 const executionError = " The execution failed with error: [%s]."
+const executionAborted = " The execution was aborted"
+const executionAbortedBy = " by [%s]"
+const executionAbortCause = ": [%s]"
 
 const substitutionParam = "{{ %s }}"
 const substitutionParamNoSpaces = "{{%s}}"
@@ -46,13 +50,32 @@ func getPhase(request admin.WorkflowExecutionEventRequest, _ *admin.Execution) s
 	return strings.ToLower(request.Event.Phase.String())
 }
 
-func getError(request admin.WorkflowExecutionEventRequest, _ *admin.Execution) string {
+func getError(request admin.WorkflowExecutionEventRequest, exec *admin.Execution) string {
 	if request.Event.GetError() != nil {
 		return fmt.Sprintf(executionError, request.Event.GetError().Message)
 	}
+	if request.Event.Phase == core.WorkflowExecution_ABORTED {
+		message := executionAborted
+		if abortedBy := getAbortedBy(request); len(abortedBy) > 0 {
+			message += fmt.Sprintf(executionAbortedBy, abortedBy)
+		}
+		if abortCause := exec.GetClosure().GetAbortCause(); len(abortCause) > 0 {
+			message += fmt.Sprintf(executionAbortCause, abortCause)
+		}
+		return message + "."
+	}
 	return ""
 }
 
+// Returns who aborted an execution, empty unless the event is an ABORTED one. Admin produces the ABORTED events of
+// executions terminated through it on behalf of the principal which terminated them.
+func getAbortedBy(request admin.WorkflowExecutionEventRequest) string {
+	if request.Event.GetPhase() != core.WorkflowExecution_ABORTED {
+		return ""
+	}
+	return request.Event.GetProducerId()
+}
+
 func getWorkflowProject(_ admin.WorkflowExecutionEventRequest, exec *admin.Execution) string {
 	return exec.Closure.WorkflowId.Project
 }
@@ -109,6 +132,46 @@ func substituteEmailParameters(message string, request admin.WorkflowExecutionEv
 	return message
 }
 
+func substituteProjectParameters(message, executionProject, executionDomain string) string {
+	for template, value := range map[string]string{project: executionProject, domain: executionDomain} {
+		message = strings.Replace(message, fmt.Sprintf(substitutionParam, template), value, replaceAllInstances)
+		message = strings.Replace(message, fmt.Sprintf(substitutionParamNoSpaces, template), value, replaceAllInstances)
+	}
+	return message
+}
+
+// Returns the emailer config of the emails of a project and domain: the overrides of the project, then those of the
+// domain, are applied to the global sender, reply-to and console URL, whose project and domain references are then
+// substituted.
+func GetEmailerConfig(config runtimeInterfaces.NotificationsEmailerConfig,
+	executionProject, executionDomain string) runtimeInterfaces.NotificationsEmailerConfig {
+	var projectOverrides, domainOverrides []runtimeInterfaces.NotificationEmailerOverrideConfig
+	for _, override := range config.Overrides {
+		if override.Project != executionProject {
+			continue
+		}
+		if len(override.Domain) == 0 {
+			projectOverrides = append(projectOverrides, override)
+		} else if override.Domain == executionDomain {
+			domainOverrides = append(domainOverrides, override)
+		}
+	}
+	for _, override := range append(projectOverrides, domainOverrides...) {
+		if len(override.Sender) > 0 {
+			config.Sender = override.Sender
+		}
+		if len(override.ReplyTo) > 0 {
+			config.ReplyTo = override.ReplyTo
+		}
+		if len(override.ConsoleURL) > 0 {
+			config.ConsoleURL = override.ConsoleURL
+		}
+	}
+	config.Sender = substituteProjectParameters(config.Sender, executionProject, executionDomain)
+	config.ConsoleURL = substituteProjectParameters(config.ConsoleURL, executionProject, executionDomain)
+	return config
+}
+
 // Converts a terminal execution event and existing execution model to an admin.EmailMessage proto, substituting parameters
 // in customizable email fields set in the flyteadmin application notifications config.
 func ToEmailMessageFromWorkflowExecutionEvent(
@@ -116,11 +179,12 @@ func ToEmailMessageFromWorkflowExecutionEvent(
 	emailNotification admin.EmailNotification,
 	request admin.WorkflowExecutionEventRequest,
 	execution *admin.Execution) *admin.EmailMessage {
-
+	emailerConfig := GetEmailerConfig(
+		config.NotificationsEmailerConfig, execution.GetId().GetProject(), execution.GetId().GetDomain())
 	return &admin.EmailMessage{
-		SubjectLine:     substituteEmailParameters(config.NotificationsEmailerConfig.Subject, request, execution),
-		SenderEmail:     config.NotificationsEmailerConfig.Sender,
+		SubjectLine:     substituteEmailParameters(emailerConfig.Subject, request, execution),
+		SenderEmail:     emailerConfig.Sender,
 		RecipientsEmail: emailNotification.GetRecipientsEmail(),
-		Body:            substituteEmailParameters(config.NotificationsEmailerConfig.Body, request, execution),
+		Body:            substituteEmailParameters(emailerConfig.Body, request, execution),
 	}
 }
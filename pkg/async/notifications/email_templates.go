@@ -0,0 +1,207 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"io/ioutil"
+	"strings"
+	textTemplate "text/template"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const consoleExecutionPath = "/console/projects/%s/domains/%s/executions/%s"
+
+type EmailTemplateIdentifier struct {
+	Project string
+	Domain  string
+	Name    string
+	Version string
+}
+
+// The execution fields email templates can reference.
+type EmailTemplateData struct {
+	Project string
+	Domain  string
+	Name    string
+	// The lower-case phase the execution reached, e.g. failed.
+	Phase string
+	// The message of the error the execution failed with, empty unless it failed.
+	Error string
+	// Why and by whom the execution was aborted, empty unless it was aborted or, for the latter, unless it was
+	// terminated through admin by an authenticated principal.
+	AbortCause string
+	AbortedBy  string
+	Workflow   EmailTemplateIdentifier
+	LaunchPlan EmailTemplateIdentifier
+	// Links the execution in the console, empty unless the console URL is configured.
+	ConsoleURL string
+}
+
+// Renders templates from data, which html/template and text/template templates both do.
+type executableTemplate interface {
+	Execute(writer io.Writer, data interface{}) error
+}
+
+type emailTemplate struct {
+	subject executableTemplate
+	body    executableTemplate
+}
+
+// Sample data which templates are rendered with as they're loaded, so that references to unknown fields are caught
+// then rather than when notifications are sent.
+var sampleEmailTemplateData = EmailTemplateData{
+	Project:    "project",
+	Domain:     "domain",
+	Name:       "name",
+	Phase:      "failed",
+	Error:      "error",
+	AbortCause: "abort cause",
+	AbortedBy:  "someone@example.com",
+	Workflow:   EmailTemplateIdentifier{Project: "project", Domain: "domain", Name: "workflow", Version: "version"},
+	LaunchPlan: EmailTemplateIdentifier{Project: "project", Domain: "domain", Name: "launch_plan", Version: "version"},
+	ConsoleURL: "https://flyte.example.com/console/projects/project/domains/domain/executions/name",
+}
+
+// Asserts that a parsed template renders the sample data. Errors name the template which failed.
+func validateTemplate(template executableTemplate, parseErr error) (executableTemplate, error) {
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if err := template.Execute(ioutil.Discard, sampleEmailTemplateData); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func newEmailTemplate(config runtimeInterfaces.NotificationTemplateConfig) (emailTemplate, error) {
+	var template emailTemplate
+	var err error
+	if len(config.Subject) > 0 {
+		if template.subject, err = validateTemplate(textTemplate.New("subject").Parse(config.Subject)); err != nil {
+			return emailTemplate{}, err
+		}
+	}
+	if len(config.TextBody) > 0 {
+		if template.body, err = validateTemplate(textTemplate.New("textBody").Parse(config.TextBody)); err != nil {
+			return emailTemplate{}, err
+		}
+	}
+	if len(config.HTMLBody) > 0 {
+		if template.body, err = validateTemplate(htmlTemplate.New("htmlBody").Parse(config.HTMLBody)); err != nil {
+			return emailTemplate{}, err
+		}
+	}
+	return template, nil
+}
+
+// Returns the link to an execution in the console at consoleURL, empty unless the console URL is configured.
+func GetConsoleExecutionURL(consoleURL string, id *core.WorkflowExecutionIdentifier) string {
+	if len(consoleURL) == 0 {
+		return ""
+	}
+	return strings.TrimSuffix(consoleURL, "/") +
+		fmt.Sprintf(consoleExecutionPath, id.GetProject(), id.GetDomain(), id.GetName())
+}
+
+func toEmailTemplateIdentifier(identifier *core.Identifier) EmailTemplateIdentifier {
+	return EmailTemplateIdentifier{
+		Project: identifier.GetProject(),
+		Domain:  identifier.GetDomain(),
+		Name:    identifier.GetName(),
+		Version: identifier.GetVersion(),
+	}
+}
+
+func getEmailTemplateData(consoleURL string, request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) EmailTemplateData {
+	data := EmailTemplateData{
+		Project:    execution.GetId().GetProject(),
+		Domain:     execution.GetId().GetDomain(),
+		Name:       execution.GetId().GetName(),
+		Phase:      strings.ToLower(request.GetEvent().GetPhase().String()),
+		Error:      request.GetEvent().GetError().GetMessage(),
+		AbortCause: execution.GetClosure().GetAbortCause(),
+		AbortedBy:  getAbortedBy(request),
+		Workflow:   toEmailTemplateIdentifier(execution.GetClosure().GetWorkflowId()),
+		LaunchPlan: toEmailTemplateIdentifier(execution.GetSpec().GetLaunchPlan()),
+	}
+	data.ConsoleURL = GetConsoleExecutionURL(consoleURL, execution.GetId())
+	return data
+}
+
+func renderTemplate(template executableTemplate, data EmailTemplateData) (string, error) {
+	var rendered bytes.Buffer
+	if err := template.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+// Renders notification emails with the Go templates configured per project. Projects without templates of their own
+// use the default templates, if any, and otherwise the subject and body of the emailer config.
+type EmailTemplates struct {
+	config           runtimeInterfaces.NotificationsConfig
+	defaultTemplate  *emailTemplate
+	projectTemplates map[string]emailTemplate
+}
+
+// Converts a terminal execution event and the execution to an admin.EmailMessage, rendering the templates of the
+// execution's project.
+func (t *EmailTemplates) ToEmailMessage(emailNotification admin.EmailNotification,
+	request admin.WorkflowExecutionEventRequest, execution *admin.Execution) (*admin.EmailMessage, error) {
+	emailMessage := ToEmailMessageFromWorkflowExecutionEvent(t.config, emailNotification, request, execution)
+	template, ok := t.projectTemplates[execution.GetId().GetProject()]
+	if !ok {
+		if t.defaultTemplate == nil {
+			return emailMessage, nil
+		}
+		template = *t.defaultTemplate
+	}
+	emailerConfig := GetEmailerConfig(
+		t.config.NotificationsEmailerConfig, execution.GetId().GetProject(), execution.GetId().GetDomain())
+	data := getEmailTemplateData(emailerConfig.ConsoleURL, request, execution)
+	var err error
+	if template.subject != nil {
+		if emailMessage.SubjectLine, err = renderTemplate(template.subject, data); err != nil {
+			return nil, err
+		}
+	}
+	if template.body != nil {
+		if emailMessage.Body, err = renderTemplate(template.body, data); err != nil {
+			return nil, err
+		}
+	}
+	return emailMessage, nil
+}
+
+// Parses and validates the email templates of the notifications config, failing on the first invalid one.
+func NewEmailTemplates(config runtimeInterfaces.NotificationsConfig) (*EmailTemplates, error) {
+	templates := &EmailTemplates{
+		config:           config,
+		projectTemplates: make(map[string]emailTemplate),
+	}
+	for _, templateConfig := range config.NotificationsEmailerConfig.Templates {
+		template, err := newEmailTemplate(templateConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid email templates for project [%s]: %v", templateConfig.Project, err)
+		}
+		if len(templateConfig.Project) == 0 {
+			if templates.defaultTemplate != nil {
+				return nil, fmt.Errorf("default email templates are configured more than once")
+			}
+			templates.defaultTemplate = &template
+			continue
+		}
+		if _, ok := templates.projectTemplates[templateConfig.Project]; ok {
+			return nil, fmt.Errorf("email templates for project [%s] are configured more than once",
+				templateConfig.Project)
+		}
+		templates.projectTemplates[templateConfig.Project] = template
+	}
+	return templates, nil
+}
@@ -0,0 +1,138 @@
+package notifications
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+var failedExecutionEventRequest = admin.WorkflowExecutionEventRequest{
+	Event: &event.WorkflowExecutionEvent{
+		Phase: core.WorkflowExecution_FAILED,
+		OutputResult: &event.WorkflowExecutionEvent_Error{
+			Error: &core.ExecutionError{
+				Message: "<oops>",
+			},
+		},
+	},
+}
+
+func getEmailTemplatesConfig(
+	templates ...runtimeInterfaces.NotificationTemplateConfig) runtimeInterfaces.NotificationsConfig {
+	return runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Subject:    "Execution {{ name }} {{ phase }}",
+			Sender:     "no-reply@example.com",
+			Body:       "Execution {{ name }} of {{ workflow.name }} {{ phase }}",
+			ConsoleURL: "https://flyte.example.com/",
+			Templates:  templates,
+		},
+	}
+}
+
+func TestEmailTemplates_ToEmailMessage(t *testing.T) {
+	emailTemplates, err := NewEmailTemplates(getEmailTemplatesConfig(
+		runtimeInterfaces.NotificationTemplateConfig{
+			Project:  executionProjectValue,
+			Subject:  "[{{ .Project }}/{{ .Domain }}] {{ .Workflow.Name }} {{ .Phase }}",
+			HTMLBody: `<a href="{{ .ConsoleURL }}">{{ .Name }}</a> failed with {{ .Error }}`,
+			TextBody: "{{ .Name }} failed",
+		},
+		runtimeInterfaces.NotificationTemplateConfig{
+			Project:  "other",
+			TextBody: "{{ .LaunchPlan.Name }}: {{ .Error }}",
+		}))
+	assert.NoError(t, err)
+	emailNotification := admin.EmailNotification{RecipientsEmail: []string{"a@example.com"}}
+
+	emailMessage, err := emailTemplates.ToEmailMessage(emailNotification, failedExecutionEventRequest, workflowExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, &admin.EmailMessage{
+		RecipientsEmail: []string{"a@example.com"},
+		SenderEmail:     "no-reply@example.com",
+		SubjectLine:     "[proj/prod] wf_name failed",
+		Body: `<a href="https://flyte.example.com/console/projects/proj/domains/prod/executions/e124">e124</a> ` +
+			"failed with &lt;oops&gt;",
+	}, emailMessage)
+
+	otherExecution := *workflowExecution
+	otherExecution.Id = &core.WorkflowExecutionIdentifier{Project: "other", Domain: "prod", Name: "e125"}
+	emailMessage, err = emailTemplates.ToEmailMessage(emailNotification, failedExecutionEventRequest, &otherExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, "Execution e125 failed", emailMessage.SubjectLine)
+	assert.Equal(t, "lp_name: <oops>", emailMessage.Body)
+}
+
+func TestEmailTemplates_ToEmailMessageAborted(t *testing.T) {
+	emailTemplates, err := NewEmailTemplates(getEmailTemplatesConfig(
+		runtimeInterfaces.NotificationTemplateConfig{
+			TextBody: "{{ .Name }} was aborted by {{ .AbortedBy }}: {{ .AbortCause }}",
+		}))
+	assert.NoError(t, err)
+	abortedExecution := *workflowExecution
+	abortedExecution.Closure = &admin.ExecutionClosure{
+		OutputResult: &admin.ExecutionClosure_AbortCause{AbortCause: "wrong inputs"},
+	}
+	emailMessage, err := emailTemplates.ToEmailMessage(admin.EmailNotification{}, admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:      core.WorkflowExecution_ABORTED,
+			ProducerId: "someone@example.com",
+		},
+	}, &abortedExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, "e124 was aborted by someone@example.com: wrong inputs", emailMessage.Body)
+}
+
+func TestEmailTemplates_ToEmailMessageConsoleURLOverride(t *testing.T) {
+	config := getEmailTemplatesConfig(runtimeInterfaces.NotificationTemplateConfig{
+		HTMLBody: `<a href="{{ .ConsoleURL }}">{{ .Name }}</a>`,
+	})
+	config.NotificationsEmailerConfig.Overrides = []runtimeInterfaces.NotificationEmailerOverrideConfig{
+		{Project: executionProjectValue, Domain: executionDomainValue, ConsoleURL: "https://{{ domain }}.example.com"},
+	}
+	emailTemplates, err := NewEmailTemplates(config)
+	assert.NoError(t, err)
+
+	emailMessage, err := emailTemplates.ToEmailMessage(
+		admin.EmailNotification{}, failedExecutionEventRequest, workflowExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, `<a href="https://prod.example.com/console/projects/proj/domains/prod/executions/e124">e124</a>`,
+		emailMessage.Body)
+}
+
+func TestEmailTemplates_ToEmailMessageDefaults(t *testing.T) {
+	emailTemplates, err := NewEmailTemplates(getEmailTemplatesConfig())
+	assert.NoError(t, err)
+	emailMessage, err := emailTemplates.ToEmailMessage(
+		admin.EmailNotification{}, failedExecutionEventRequest, workflowExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, "Execution e124 failed", emailMessage.SubjectLine)
+	assert.Equal(t, "Execution e124 of wf_name failed", emailMessage.Body)
+
+	emailTemplates, err = NewEmailTemplates(getEmailTemplatesConfig(runtimeInterfaces.NotificationTemplateConfig{
+		Subject: "{{ .Name }} {{ .Phase }}",
+	}))
+	assert.NoError(t, err)
+	emailMessage, err = emailTemplates.ToEmailMessage(
+		admin.EmailNotification{}, failedExecutionEventRequest, workflowExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, "e124 failed", emailMessage.SubjectLine)
+	assert.Equal(t, "Execution e124 of wf_name failed", emailMessage.Body)
+}
+
+func TestNewEmailTemplates_Invalid(t *testing.T) {
+	for _, templates := range [][]runtimeInterfaces.NotificationTemplateConfig{
+		{{Project: "project", Subject: "{{ .Name "}},
+		{{Project: "project", TextBody: "{{ .Unknown }}"}},
+		{{Project: "project", HTMLBody: "{{ .Workflow.Unknown }}"}},
+		{{Project: "project", Subject: "{{ .Name }}"}, {Project: "project", Subject: "{{ .Domain }}"}},
+		{{Subject: "{{ .Name }}"}, {Subject: "{{ .Domain }}"}},
+	} {
+		_, err := NewEmailTemplates(getEmailTemplatesConfig(templates...))
+		assert.Error(t, err, "%+v should be rejected", templates)
+	}
+}
@@ -69,6 +69,24 @@ func TestSubstituteEmailParameters(t *testing.T) {
 		substituteEmailParameters(message, request, workflowExecution))
 }
 
+func TestSubstituteEmailParameters_Aborted(t *testing.T) {
+	message := "{{ name }} ended up in {{ phase }}.{{ error }}"
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_ABORTED,
+		},
+	}
+	assert.Equal(t, "e124 ended up in aborted. The execution was aborted.",
+		substituteEmailParameters(message, request, workflowExecution))
+	request.Event.ProducerId = "someone@example.com"
+	abortedExecution := *workflowExecution
+	abortedExecution.Closure = &admin.ExecutionClosure{
+		OutputResult: &admin.ExecutionClosure_AbortCause{AbortCause: "wrong inputs"},
+	}
+	assert.Equal(t, "e124 ended up in aborted. The execution was aborted by [someone@example.com]: [wrong inputs].",
+		substituteEmailParameters(message, request, &abortedExecution))
+}
+
 func TestSubstituteAllTemplates(t *testing.T) {
 	templateVars := map[string]string{
 		fmt.Sprintf(substitutionParam, project):           executionProjectValue,
@@ -159,3 +177,46 @@ func TestToEmailMessageFromWorkflowExecutionEvent(t *testing.T) {
 			"https://example.com/executions/proj/prod/e124</a>.",
 	}), fmt.Sprintf("%+v", emailMessage))
 }
+
+func TestGetEmailerConfig(t *testing.T) {
+	emailerConfig := runtimeInterfaces.NotificationsEmailerConfig{
+		Sender:     "no-reply@example.com",
+		ReplyTo:    []string{"flyte@example.com"},
+		ConsoleURL: "https://flyte.example.com",
+		Overrides: []runtimeInterfaces.NotificationEmailerOverrideConfig{
+			{Project: "proj", Domain: "prod", ReplyTo: []string{"oncall@example.com"}},
+			{Project: "proj", Sender: "flyte+{{ project }}-{{domain}}@example.com", ReplyTo: []string{"team@example.com"}},
+			{Project: "other", ConsoleURL: "https://{{ project }}.flyte.example.com"},
+		},
+	}
+
+	// Domain overrides take precedence over those of their project.
+	config := GetEmailerConfig(emailerConfig, "proj", "prod")
+	assert.Equal(t, "flyte+proj-prod@example.com", config.Sender)
+	assert.Equal(t, []string{"oncall@example.com"}, config.ReplyTo)
+	assert.Equal(t, "https://flyte.example.com", config.ConsoleURL)
+
+	config = GetEmailerConfig(emailerConfig, "proj", "dev")
+	assert.Equal(t, "flyte+proj-dev@example.com", config.Sender)
+	assert.Equal(t, []string{"team@example.com"}, config.ReplyTo)
+
+	config = GetEmailerConfig(emailerConfig, "other", "prod")
+	assert.Equal(t, "no-reply@example.com", config.Sender)
+	assert.Equal(t, []string{"flyte@example.com"}, config.ReplyTo)
+	assert.Equal(t, "https://other.flyte.example.com", config.ConsoleURL)
+}
+
+func TestToEmailMessageFromWorkflowExecutionEvent_SenderOverride(t *testing.T) {
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender:  "no-reply@example.com",
+			Subject: "{{ name }}",
+			Overrides: []runtimeInterfaces.NotificationEmailerOverrideConfig{
+				{Project: executionProjectValue, Sender: "{{ project }}@example.com"},
+			},
+		},
+	}
+	emailMessage := ToEmailMessageFromWorkflowExecutionEvent(
+		notificationsConfig, admin.EmailNotification{}, failedExecutionEventRequest, workflowExecution)
+	assert.Equal(t, "proj@example.com", emailMessage.SenderEmail)
+}
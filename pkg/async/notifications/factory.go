@@ -2,6 +2,8 @@ package notifications
 
 import (
 	"context"
+	"net/http"
+	"sync"
 
 	"github.com/lyft/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
@@ -10,16 +12,36 @@ import (
 
 	"github.com/NYTimes/gizmo/pubsub"
 	gizmoConfig "github.com/NYTimes/gizmo/pubsub/aws"
+	gizmoGCP "github.com/NYTimes/gizmo/pubsub/gcp"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ses"
 
 	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flytestdlib/promutils"
 )
 
 const maxRetries = 3
 
+const defaultInProcessQueueSize = 1000
+
+// Notifications published in-process are processed by the processor of the same process, which consumes them from the
+// queue they're published to.
+var inProcessQueue *implementations.InProcessQueue
+var inProcessQueueOnce sync.Once
+
+func getInProcessQueue(config runtimeInterfaces.InProcessNotificationsConfig) *implementations.InProcessQueue {
+	inProcessQueueOnce.Do(func() {
+		size := config.QueueSize
+		if size <= 0 {
+			size = defaultInProcessQueueSize
+		}
+		inProcessQueue = implementations.NewInProcessQueue(size)
+	})
+	return inProcessQueue
+}
+
 var enable64decoding = false
 
 type PublisherConfig struct {
@@ -36,10 +58,21 @@ type EmailerConfig struct {
 	BaseURL     string
 }
 
+// Returns an HTTP client keeping a connection to SES open for each of the processor workers, rather than the two per
+// host of the default transport.
+func getEmailerHTTPClient(workers int) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = workers
+	return &http.Client{Transport: transport}
+}
+
 func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Emailer {
 	switch config.Type {
 	case common.AWS:
 		awsConfig := aws.NewConfig().WithRegion(config.Region).WithMaxRetries(maxRetries)
+		if workers := config.NotificationsProcessorConfig.Workers; workers > http.DefaultMaxIdleConnsPerHost {
+			awsConfig = awsConfig.WithHTTPClient(getEmailerHTTPClient(workers))
+		}
 		awsSession, err := session.NewSession(awsConfig)
 		if err != nil {
 			panic(err)
@@ -78,6 +111,27 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 		}
 		sub = process
 		emailer = GetEmailer(config, scope)
+	case common.GCP:
+		// Pub/Sub delivers messages as they were published, so notifications are consumed in an Envelope rather than
+		// in the SNS message format.
+		process, err := gizmoGCP.NewSubscriber(context.Background(), config.GCPConfig.ProjectID,
+			config.NotificationsProcessorConfig.SubscriptionName)
+		if err != nil {
+			panic(err)
+		}
+		return implementations.NewEnvelopeProcessor(process, GetEmailer(config, scope),
+			config.NotificationsProcessorConfig, scope)
+	case common.Kafka:
+		process, err := implementations.NewKafkaSubscriber(config.KafkaConfig.Brokers, config.KafkaConfig.Version,
+			config.KafkaConfig.ConsumerGroup, config.NotificationsPublisherConfig.TopicName)
+		if err != nil {
+			panic(err)
+		}
+		return implementations.NewEnvelopeProcessor(process, GetEmailer(config, scope),
+			config.NotificationsProcessorConfig, scope)
+	case common.InProcess:
+		return implementations.NewEnvelopeProcessor(getInProcessQueue(config.InProcessConfig), GetEmailer(config, scope),
+			config.NotificationsProcessorConfig, scope)
 	case common.Local:
 		fallthrough
 	default:
@@ -85,10 +139,20 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			"Using default noop notifications processor implementation for config type [%s]", config.Type)
 		return implementations.NewNoopProcess()
 	}
-	return implementations.NewProcessor(sub, emailer, scope)
+	return implementations.NewProcessor(sub, emailer, config.NotificationsProcessorConfig, scope)
 }
 
-func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Publisher {
+// Rate limits the notifications published by pub, per recipient and per channel. Microsoft Teams and generic webhook
+// notifications are delivered to the configured webhooks rather than published by pub.
+func wrapPublisher(pub interfaces.Publisher, config runtimeInterfaces.NotificationsConfig,
+	db repositories.RepositoryInterface, scope promutils.Scope) interfaces.Publisher {
+	return implementations.NewRateLimitedPublisher(
+		implementations.NewWebhookPublisher(pub, config, scope), db, config.RateLimits, scope)
+}
+
+// Notifications held back by rate limits are kept in db until they're published in a digest.
+func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	scope promutils.Scope) interfaces.Publisher {
 	switch config.Type {
 	case common.AWS:
 		snsConfig := gizmoConfig.SNSConfig{
@@ -100,7 +164,28 @@ func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, sco
 		if err != nil {
 			panic(err)
 		}
-		return implementations.NewPublisher(publisher, scope)
+		return wrapPublisher(implementations.NewPublisher(publisher, scope), config, db, scope)
+	case common.GCP:
+		publisher, err := gizmoGCP.NewPublisher(context.Background(), gizmoGCP.Config{
+			ProjectID: config.GCPConfig.ProjectID,
+			Topic:     config.NotificationsPublisherConfig.TopicName,
+		})
+		// Any errors initiating Publisher with GCP configurations results in a failed start up.
+		if err != nil {
+			panic(err)
+		}
+		return wrapPublisher(implementations.NewEnvelopePublisher(publisher, scope), config, db, scope)
+	case common.Kafka:
+		publisher, err := implementations.NewKafkaPublisher(config.KafkaConfig.Brokers, config.KafkaConfig.Version,
+			config.NotificationsPublisherConfig.TopicName, scope)
+		// Any errors connecting to the Kafka brokers results in a failed start up.
+		if err != nil {
+			panic(err)
+		}
+		return wrapPublisher(publisher, config, db, scope)
+	case common.InProcess:
+		return wrapPublisher(
+			implementations.NewEnvelopePublisher(getInProcessQueue(config.InProcessConfig), scope), config, db, scope)
 	case common.Local:
 		fallthrough
 	default:
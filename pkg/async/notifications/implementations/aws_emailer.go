@@ -2,7 +2,10 @@ package implementations
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
@@ -31,10 +34,62 @@ func newEmailMetrics(scope promutils.Scope) emailMetrics {
 	}
 }
 
+// The reply-to addresses of the emails sent from the senders matching a pattern.
+type senderReplyTo struct {
+	sender  *regexp.Regexp
+	replyTo []string
+}
+
 type AwsEmailer struct {
 	config        runtimeInterfaces.NotificationsConfig
 	systemMetrics emailMetrics
 	awsEmail      sesiface.SESAPI
+	// Overrides of the reply-to addresses, the most specific first.
+	replyToOverrides []senderReplyTo
+}
+
+// Returns the pattern of the senders an override's emails are sent from. Its project and domain references are
+// substituted with those of the override, while domain references of project overrides match any domain.
+func getOverrideSenderPattern(override runtimeInterfaces.NotificationEmailerOverrideConfig) *regexp.Regexp {
+	pattern := regexp.QuoteMeta(override.Sender)
+	domainPattern := ".+"
+	if len(override.Domain) > 0 {
+		domainPattern = regexp.QuoteMeta(override.Domain)
+	}
+	for _, placeholder := range []string{"{{ project }}", "{{project}}"} {
+		pattern = strings.Replace(pattern, regexp.QuoteMeta(placeholder), regexp.QuoteMeta(override.Project), -1)
+	}
+	for _, placeholder := range []string{"{{ domain }}", "{{domain}}"} {
+		pattern = strings.Replace(pattern, regexp.QuoteMeta(placeholder), domainPattern, -1)
+	}
+	return regexp.MustCompile("^" + pattern + "$")
+}
+
+// The email message has no reply-to, so the reply-to addresses of an override apply to the emails sent from its
+// sender. Overrides without a sender of their own can't be told apart from the global config and are ignored.
+func getReplyToOverrides(config runtimeInterfaces.NotificationsEmailerConfig) []senderReplyTo {
+	var domainOverrides, projectOverrides []senderReplyTo
+	for _, override := range config.Overrides {
+		if len(override.Sender) == 0 || len(override.ReplyTo) == 0 {
+			continue
+		}
+		replyTo := senderReplyTo{sender: getOverrideSenderPattern(override), replyTo: override.ReplyTo}
+		if len(override.Domain) > 0 {
+			domainOverrides = append(domainOverrides, replyTo)
+		} else {
+			projectOverrides = append(projectOverrides, replyTo)
+		}
+	}
+	return append(domainOverrides, projectOverrides...)
+}
+
+func (e *AwsEmailer) getReplyTo(sender string) []string {
+	for _, override := range e.replyToOverrides {
+		if override.sender.MatchString(sender) {
+			return override.replyTo
+		}
+	}
+	return e.config.NotificationsEmailerConfig.ReplyTo
 }
 
 func (e *AwsEmailer) SendEmail(ctx context.Context, email admin.EmailMessage) error {
@@ -50,7 +105,8 @@ func (e *AwsEmailer) SendEmail(ctx context.Context, email admin.EmailMessage) er
 		// Currently use the senderEmail specified apart of the Emailer instead of the body.
 		// Once a more generic way of setting the emailNotification is defined, remove this
 		// workaround and defer back to email.SenderEmail
-		Source: &email.SenderEmail,
+		Source:           &email.SenderEmail,
+		ReplyToAddresses: aws.StringSlice(e.getReplyTo(email.SenderEmail)),
 		Message: &ses.Message{
 			Body: &ses.Body{
 				Html: &ses.Content{
@@ -79,8 +135,9 @@ func (e *AwsEmailer) SendEmail(ctx context.Context, email admin.EmailMessage) er
 
 func NewAwsEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope, awsEmail sesiface.SESAPI) interfaces.Emailer {
 	return &AwsEmailer{
-		config:        config,
-		systemMetrics: newEmailMetrics(scope.NewSubScope("aws_ses")),
-		awsEmail:      awsEmail,
+		config:           config,
+		systemMetrics:    newEmailMetrics(scope.NewSubScope("aws_ses")),
+		awsEmail:         awsEmail,
+		replyToOverrides: getReplyToOverrides(config.NotificationsEmailerConfig),
 	}
 }
@@ -66,6 +66,32 @@ func TestAwsEmailer_SendEmail(t *testing.T) {
 	assert.Nil(t, testEmail.SendEmail(context.Background(), emailNotification))
 }
 
+func TestAwsEmailer_SendEmailReplyTo(t *testing.T) {
+	config := getNotificationsConfig()
+	config.NotificationsEmailerConfig.ReplyTo = []string{"flyte@example.com"}
+	config.NotificationsEmailerConfig.Overrides = []runtimeInterfaces.NotificationEmailerOverrideConfig{
+		{Project: "proj", Sender: "flyte+{{ project }}-{{ domain }}@example.com", ReplyTo: []string{"team@example.com"}},
+		{Project: "proj", Domain: "prod", Sender: "flyte+proj-prod@example.com", ReplyTo: []string{"oncall@example.com"}},
+	}
+	mockAwsEmail := mocks.SESClient{}
+	var replyTo []string
+	mockAwsEmail.SetSendEmailFunc(func(input *ses.SendEmailInput) (*ses.SendEmailOutput, error) {
+		replyTo = aws.StringValueSlice(input.ReplyToAddresses)
+		return &ses.SendEmailOutput{}, nil
+	})
+	testEmail := NewAwsEmailer(config, promutils.NewTestScope(), &mockAwsEmail)
+
+	for sender, expected := range map[string][]string{
+		"flyte+proj-prod@example.com": {"oncall@example.com"},
+		"flyte+proj-dev@example.com":  {"team@example.com"},
+		"flyte+other-dev@example.com": {"flyte@example.com"},
+		"no-reply@example.com":        {"flyte@example.com"},
+	} {
+		assert.Nil(t, testEmail.SendEmail(context.Background(), admin.EmailMessage{SenderEmail: sender}))
+		assert.Equal(t, expected, replyTo, sender)
+	}
+}
+
 func TestAwsEmailer_SendEmailError(t *testing.T) {
 	mockAwsEmail := mocks.SESClient{}
 	var awsSES sesiface.SESAPI
@@ -0,0 +1,42 @@
+package implementations
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+const digestSeparator = "<br/><hr/><br/>"
+
+// Combines email messages into a single digest per set of recipients, in the order each set was first seen. The digest
+// subject is built from the number of messages it combines.
+func BuildEmailDigests(emails []*admin.EmailMessage, subject func(count int) string) []*admin.EmailMessage {
+	groupedEmails := make(map[string][]*admin.EmailMessage)
+	var recipientKeys []string
+	for _, email := range emails {
+		recipients := append([]string{}, email.RecipientsEmail...)
+		sort.Strings(recipients)
+		key := strings.Join(recipients, ",")
+		if _, ok := groupedEmails[key]; !ok {
+			recipientKeys = append(recipientKeys, key)
+		}
+		groupedEmails[key] = append(groupedEmails[key], email)
+	}
+	digests := make([]*admin.EmailMessage, 0, len(recipientKeys))
+	for _, key := range recipientKeys {
+		group := groupedEmails[key]
+		bodies := make([]string, len(group))
+		for idx, email := range group {
+			bodies[idx] = fmt.Sprintf("<b>%s</b><br/>%s", email.SubjectLine, email.Body)
+		}
+		digests = append(digests, &admin.EmailMessage{
+			RecipientsEmail: group[0].RecipientsEmail,
+			SenderEmail:     group[0].SenderEmail,
+			SubjectLine:     subject(len(group)),
+			Body:            strings.Join(bodies, digestSeparator),
+		})
+	}
+	return digests
+}
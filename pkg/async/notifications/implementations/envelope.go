@@ -0,0 +1,77 @@
+package implementations
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Wraps notifications published to messaging services which, unlike SNS, deliver message bodies as they were
+// published, e.g. GCP Pub/Sub. The notification type is kept alongside the serialized notification so that consumers
+// don't depend on service-specific message attributes.
+type Envelope struct {
+	NotificationType string `json:"notificationType"`
+	// The serialized notification, which is Base64 encoded in JSON.
+	Notification []byte `json:"notification"`
+}
+
+// Serializes a notification in an Envelope.
+func newEnvelope(notificationType string, msg proto.Message) ([]byte, error) {
+	notification, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(Envelope{
+		NotificationType: notificationType,
+		Notification:     notification,
+	})
+}
+
+// Raised for messages which are well-formed but don't carry a notification where expected.
+type messageDataError struct {
+	error
+}
+
+// Unwraps the serialized notification from a message consumed by the processor.
+type MessageUnwrapper func(message []byte) ([]byte, error)
+
+// At Lyft, SNS populates SQS. This results in the message body of SQS having the SNS message format.
+// The message format is documented here: https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html
+// The notification published is stored in the message field after unmarshalling the SQS message.
+func UnwrapSNSMessage(message []byte) ([]byte, error) {
+	// Amazon doesn't provide a struct that can be used to unmarshall into. A generic JSON struct is used in its place.
+	var snsJSONFormat map[string]interface{}
+	if err := json.Unmarshal(message, &snsJSONFormat); err != nil {
+		return nil, fmt.Errorf("failed to unmarshall JSON message: %v", err)
+	}
+	value, ok := snsJSONFormat["Message"]
+	if !ok {
+		return nil, messageDataError{fmt.Errorf("failed to retrieve message from unmarshalled JSON object")}
+	}
+	valueString, ok := value.(string)
+	if !ok {
+		return nil, messageDataError{
+			fmt.Errorf("failed to retrieve notification message (in string format) from unmarshalled JSON object")}
+	}
+	// The Publish method for SNS Encodes the notification using Base64 then stringifies it before
+	// setting that as the message body for SNS. Do the inverse to retrieve the notification.
+	notification, err := base64.StdEncoding.DecodeString(valueString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Base64 decode from message string [%s] with err: %v", valueString, err)
+	}
+	return notification, nil
+}
+
+func UnwrapEnvelope(message []byte) ([]byte, error) {
+	var envelope Envelope
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshall envelope: %v", err)
+	}
+	if len(envelope.Notification) == 0 {
+		return nil, messageDataError{fmt.Errorf("envelope of type [%s] carries no notification",
+			envelope.NotificationType)}
+	}
+	return envelope.Notification, nil
+}
@@ -0,0 +1,36 @@
+package implementations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnwrapSNSMessage(t *testing.T) {
+	message, err := json.Marshal(testSubscriberMessage)
+	assert.Nil(t, err)
+	notification, err := UnwrapSNSMessage(message)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, notification)
+
+	_, err = UnwrapSNSMessage([]byte(`{"Type": "Notification"}`))
+	assert.IsType(t, messageDataError{}, err)
+	_, err = UnwrapSNSMessage([]byte(`{"Message": "NotBase64encoded"}`))
+	assert.Error(t, err)
+	_, isDataError := err.(messageDataError)
+	assert.False(t, isDataError)
+}
+
+func TestUnwrapEnvelope(t *testing.T) {
+	message, err := json.Marshal(Envelope{NotificationType: "flyteidl.admin.EmailNotification", Notification: msg})
+	assert.Nil(t, err)
+	notification, err := UnwrapEnvelope(message)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, notification)
+
+	_, err = UnwrapEnvelope([]byte(`{"notificationType": "flyteidl.admin.EmailNotification"}`))
+	assert.IsType(t, messageDataError{}, err)
+	_, err = UnwrapEnvelope([]byte("not json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/golang/protobuf/proto"
+)
+
+var errInProcessQueueFull = errors.New("in-process notifications queue is full")
+var errInProcessQueueStopped = errors.New("in-process notifications queue is stopped")
+
+type inProcessMessage struct {
+	message []byte
+}
+
+func (m *inProcessMessage) Message() []byte {
+	return m.message
+}
+
+func (m *inProcessMessage) ExtendDoneDeadline(time.Duration) error {
+	return nil
+}
+
+// Messages are dropped from the queue as they're consumed, so there's nothing to acknowledge.
+func (m *inProcessMessage) Done() error {
+	return nil
+}
+
+// Delivers the messages published within a process to the subscriber of the same process through a buffered channel,
+// implementing both gizmo's pubsub.Publisher and pubsub.Subscriber. This gives single-binary deployments, e.g. the
+// sandbox, working notifications without a messaging service. Messages which weren't processed yet are lost when the
+// process exits, and publishing fails rather than blocks once the queue is full.
+type InProcessQueue struct {
+	messages chan pubsub.SubscriberMessage
+	mutex    sync.RWMutex
+	stopped  bool
+}
+
+func (q *InProcessQueue) Publish(ctx context.Context, key string, msg proto.Message) error {
+	message, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return q.PublishRaw(ctx, key, message)
+}
+
+func (q *InProcessQueue) PublishRaw(_ context.Context, _ string, message []byte) error {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	if q.stopped {
+		return errInProcessQueueStopped
+	}
+	select {
+	case q.messages <- &inProcessMessage{message: message}:
+		return nil
+	default:
+		return errInProcessQueueFull
+	}
+}
+
+func (q *InProcessQueue) Start() <-chan pubsub.SubscriberMessage {
+	return q.messages
+}
+
+// Consuming from the queue can't fail.
+func (q *InProcessQueue) Err() error {
+	return nil
+}
+
+// Closes the queue once the messages published so far have been consumed. Messages published afterwards are rejected.
+func (q *InProcessQueue) Stop() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.stopped {
+		return errInProcessQueueStopped
+	}
+	q.stopped = true
+	close(q.messages)
+	return nil
+}
+
+// Creates a queue buffering up to size messages which haven't been consumed yet.
+func NewInProcessQueue(size int) *InProcessQueue {
+	return &InProcessQueue{
+		messages: make(chan pubsub.SubscriberMessage, size),
+	}
+}
@@ -0,0 +1,47 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+func TestInProcessQueue_PublishAndProcess(t *testing.T) {
+	queue := NewInProcessQueue(2)
+	publisher := NewEnvelopePublisher(queue, promutils.NewTestScope())
+	assert.Nil(t, publisher.Publish(context.Background(), emailNotificationType, &testEmail))
+	assert.Nil(t, publisher.Publish(context.Background(), emailNotificationType, &testEmail))
+	assert.EqualError(t, publisher.Publish(context.Background(), emailNotificationType, &testEmail),
+		"in-process notifications queue is full")
+
+	var emailer mocks.MockEmailer
+	var sent []admin.EmailMessage
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		sent = append(sent, email)
+		return nil
+	})
+	processor := NewEnvelopeProcessor(
+		queue, &emailer, runtimeInterfaces.NotificationsProcessorConfig{}, promutils.NewTestScope())
+	// Messages published before the queue was stopped are still processed.
+	assert.Nil(t, queue.Stop())
+	assert.Nil(t, processor.StartProcessing())
+	assert.Len(t, sent, 2)
+	assert.Equal(t, testEmail.SubjectLine, sent[0].SubjectLine)
+	assert.Equal(t, testEmail.RecipientsEmail, sent[1].RecipientsEmail)
+}
+
+func TestInProcessQueue_Stopped(t *testing.T) {
+	queue := NewInProcessQueue(1)
+	assert.Nil(t, queue.Stop())
+	assert.EqualError(t, queue.PublishRaw(context.Background(), emailNotificationType, msg),
+		"in-process notifications queue is stopped")
+	assert.Error(t, queue.Stop())
+	_, ok := <-queue.Start()
+	assert.False(t, ok)
+}
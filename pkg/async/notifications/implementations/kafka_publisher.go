@@ -0,0 +1,123 @@
+package implementations
+
+import (
+	"context"
+	"time"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/logging"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The subset of sarama.SyncProducer the Kafka publisher depends on.
+type kafkaProducer interface {
+	SendMessage(msg *sarama.ProducerMessage) (partition int32, offset int64, err error)
+}
+
+type kafkaPublisherMetrics struct {
+	Scope           promutils.Scope
+	PublishTotal    prometheus.Counter
+	PublishError    prometheus.Counter
+	PublishSuccess  prometheus.Counter
+	DeliveryLatency prometheus.Summary
+}
+
+// Publishes notifications to a Kafka topic in an Envelope. Messages are keyed by the execution the notification was
+// raised for so that the notifications of an execution land on the same partition and are consumed in order.
+type KafkaPublisher struct {
+	producer kafkaProducer
+	topic    string
+	metrics  kafkaPublisherMetrics
+}
+
+// Returns the key messages are partitioned by: the project/domain/name of the execution the context was tagged with,
+// otherwise the notification type.
+func getKafkaMessageKey(ctx context.Context, notificationType string) string {
+	if executionID := logging.GetExecutionID(ctx); len(executionID) > 0 {
+		return executionID
+	}
+	return notificationType
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	p.metrics.PublishTotal.Inc()
+	logging.Debugf(ctx, logging.Notifications, "Publishing the following message [%s]", msg.String())
+	envelope, err := newEnvelope(notificationType, msg)
+	if err != nil {
+		p.metrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to serialize a message with key [%s] and message [%s] and error: %v",
+			notificationType, msg.String(), err)
+		return err
+	}
+	key := getKafkaMessageKey(ctx, notificationType)
+	start := time.Now()
+	partition, offset, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(envelope),
+	})
+	if err != nil {
+		p.metrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to publish a message with key [%s] to topic [%s] and message [%s] and error: %v",
+			key, p.topic, msg.String(), err)
+		return err
+	}
+	p.metrics.DeliveryLatency.Observe(time.Since(start).Seconds())
+	p.metrics.PublishSuccess.Inc()
+	logging.Debugf(ctx, logging.Notifications, "Published message with key [%s] to partition [%d] at offset [%d]",
+		key, partition, offset)
+	return nil
+}
+
+func newKafkaPublisherMetrics(scope promutils.Scope) kafkaPublisherMetrics {
+	return kafkaPublisherMetrics{
+		Scope:          scope,
+		PublishTotal:   scope.MustNewCounter("publish_total", "overall count of publish messages"),
+		PublishError:   scope.MustNewCounter("publish_errors", "count of publish errors"),
+		PublishSuccess: scope.MustNewCounter("publish_ok", "count of messages acknowledged by the brokers"),
+		DeliveryLatency: scope.MustNewSummary("delivery_latency_seconds",
+			"time taken for published messages to be acknowledged by the brokers"),
+	}
+}
+
+func newKafkaPublisher(producer kafkaProducer, topic string, scope promutils.Scope) *KafkaPublisher {
+	return &KafkaPublisher{
+		producer: producer,
+		topic:    topic,
+		metrics:  newKafkaPublisherMetrics(scope.NewSubScope("kafka_publisher")),
+	}
+}
+
+// Returns the sarama config shared by the Kafka publisher and subscriber. The version defaults to sarama's when empty.
+func newKafkaConfig(version string) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	if len(version) > 0 {
+		kafkaVersion, err := sarama.ParseKafkaVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		config.Version = kafkaVersion
+	}
+	return config, nil
+}
+
+// Connects a synchronous producer to the brokers, which waits for all in-sync replicas to acknowledge each message.
+func NewKafkaPublisher(brokers []string, version, topic string, scope promutils.Scope) (interfaces.Publisher, error) {
+	config, err := newKafkaConfig(version)
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	return newKafkaPublisher(producer, topic, scope), nil
+}
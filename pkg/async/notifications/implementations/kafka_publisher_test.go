@@ -0,0 +1,72 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/lyft/flyteadmin/pkg/logging"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type testKafkaProducer struct {
+	sent       []*sarama.ProducerMessage
+	givenError error
+}
+
+func (p *testKafkaProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	if p.givenError != nil {
+		return 0, 0, p.givenError
+	}
+	p.sent = append(p.sent, msg)
+	return 0, int64(len(p.sent)), nil
+}
+
+func TestKafkaPublisher_Publish(t *testing.T) {
+	producer := testKafkaProducer{}
+	publisher := newKafkaPublisher(&producer, "notifications", promutils.NewTestScope())
+	ctx := logging.WithExecutionID(context.Background(), &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	})
+	assert.Nil(t, publisher.Publish(ctx, "flyteidl.admin.EmailNotification", &testEmail))
+	assert.Nil(t, publisher.Publish(context.Background(), "flyteidl.admin.EmailNotification", &testEmail))
+
+	assert.Len(t, producer.sent, 2)
+	assert.Equal(t, "notifications", producer.sent[0].Topic)
+	assert.Equal(t, sarama.StringEncoder("project/domain/name"), producer.sent[0].Key)
+	assert.Equal(t, sarama.StringEncoder("flyteidl.admin.EmailNotification"), producer.sent[1].Key)
+
+	value, err := producer.sent[0].Value.Encode()
+	assert.Nil(t, err)
+	var envelope Envelope
+	assert.Nil(t, json.Unmarshal(value, &envelope))
+	assert.Equal(t, "flyteidl.admin.EmailNotification", envelope.NotificationType)
+	var email admin.EmailMessage
+	assert.Nil(t, proto.Unmarshal(envelope.Notification, &email))
+	assert.True(t, proto.Equal(&testEmail, &email))
+}
+
+func TestKafkaPublisher_PublishError(t *testing.T) {
+	producer := testKafkaProducer{givenError: errors.New("brokers unavailable")}
+	publisher := newKafkaPublisher(&producer, "notifications", promutils.NewTestScope())
+	assert.EqualError(t, publisher.Publish(context.Background(), "flyteidl.admin.EmailNotification", &testEmail),
+		"brokers unavailable")
+}
+
+func TestNewKafkaConfig(t *testing.T) {
+	config, err := newKafkaConfig("2.1.0")
+	assert.Nil(t, err)
+	assert.Equal(t, sarama.V2_1_0_0, config.Version)
+
+	_, err = newKafkaConfig("not a version")
+	assert.Error(t, err)
+}
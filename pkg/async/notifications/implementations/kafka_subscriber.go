@@ -0,0 +1,136 @@
+package implementations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/Shopify/sarama"
+)
+
+// The subset of sarama.ConsumerGroup the Kafka subscriber depends on.
+type kafkaConsumerGroup interface {
+	Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error
+	Close() error
+}
+
+type kafkaSubscriberMessage struct {
+	message *sarama.ConsumerMessage
+	mark    func(message *sarama.ConsumerMessage)
+}
+
+func (m *kafkaSubscriberMessage) Message() []byte {
+	return m.message.Value
+}
+
+// Kafka has no per-message deadline: messages are redelivered only when the consumer group rebalances before their
+// offset is marked.
+func (m *kafkaSubscriberMessage) ExtendDoneDeadline(time.Duration) error {
+	return nil
+}
+
+// Marks the offset of the message as consumed, which the consumer group commits periodically.
+func (m *kafkaSubscriberMessage) Done() error {
+	m.mark(m.message)
+	return nil
+}
+
+// Consumes notifications from a Kafka topic as a member of a consumer group, implementing gizmo's pubsub.Subscriber so
+// that the Processor can consume from Kafka like from any other messaging service.
+type KafkaSubscriber struct {
+	group    kafkaConsumerGroup
+	topic    string
+	messages chan pubsub.SubscriberMessage
+	ctx      context.Context
+	cancel   context.CancelFunc
+	errLock  sync.Mutex
+	err      error
+}
+
+func (s *KafkaSubscriber) setErr(err error) {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	s.err = err
+}
+
+// Forwards the messages of a claim until it's revoked or the subscriber is stopped.
+func (s *KafkaSubscriber) consumeMessages(ctx context.Context, messages <-chan *sarama.ConsumerMessage,
+	mark func(message *sarama.ConsumerMessage)) {
+	for message := range messages {
+		select {
+		case s.messages <- &kafkaSubscriberMessage{message: message, mark: mark}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *KafkaSubscriber) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (s *KafkaSubscriber) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (s *KafkaSubscriber) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	s.consumeMessages(session.Context(), claim.Messages(), func(message *sarama.ConsumerMessage) {
+		session.MarkMessage(message, "")
+	})
+	return nil
+}
+
+// Joins the consumer group and returns the channel consumed messages are delivered on. The channel is closed once the
+// subscriber is stopped or consuming fails, in which case Err returns the failure.
+func (s *KafkaSubscriber) Start() <-chan pubsub.SubscriberMessage {
+	go func() {
+		defer close(s.messages)
+		for s.ctx.Err() == nil {
+			// Consume returns whenever the consumer group rebalances, after which the new claims are consumed.
+			if err := s.group.Consume(s.ctx, []string{s.topic}, s); err != nil {
+				s.setErr(err)
+				return
+			}
+		}
+	}()
+	return s.messages
+}
+
+func (s *KafkaSubscriber) Err() error {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	return s.err
+}
+
+func (s *KafkaSubscriber) Stop() error {
+	s.cancel()
+	return s.group.Close()
+}
+
+func newKafkaSubscriber(group kafkaConsumerGroup, topic string) *KafkaSubscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &KafkaSubscriber{
+		group:    group,
+		topic:    topic,
+		messages: make(chan pubsub.SubscriberMessage),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Joins the consumer group on the brokers, starting from the newest offset the first time the group consumes the
+// topic.
+func NewKafkaSubscriber(brokers []string, version, consumerGroup, topic string) (pubsub.Subscriber, error) {
+	config, err := newKafkaConfig(version)
+	if err != nil {
+		return nil, err
+	}
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	config.Consumer.Return.Errors = false
+	group, err := sarama.NewConsumerGroup(brokers, consumerGroup, config)
+	if err != nil {
+		return nil, err
+	}
+	return newKafkaSubscriber(group, topic), nil
+}
@@ -0,0 +1,72 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// Delivers the given messages as a single claim, then fails the next time it's consumed from.
+type testKafkaConsumerGroup struct {
+	messages []*sarama.ConsumerMessage
+	marked   []*sarama.ConsumerMessage
+	consumed bool
+	closed   bool
+}
+
+func (g *testKafkaConsumerGroup) Consume(
+	ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	if g.consumed {
+		return errors.New("consumer group closed")
+	}
+	g.consumed = true
+	claim := make(chan *sarama.ConsumerMessage, len(g.messages))
+	for _, message := range g.messages {
+		claim <- message
+	}
+	close(claim)
+	handler.(*KafkaSubscriber).consumeMessages(ctx, claim, func(message *sarama.ConsumerMessage) {
+		g.marked = append(g.marked, message)
+	})
+	return nil
+}
+
+func (g *testKafkaConsumerGroup) Close() error {
+	g.closed = true
+	return nil
+}
+
+func TestKafkaSubscriber_Start(t *testing.T) {
+	group := testKafkaConsumerGroup{
+		messages: []*sarama.ConsumerMessage{{Value: []byte("first")}, {Value: []byte("second")}},
+	}
+	subscriber := newKafkaSubscriber(&group, "notifications")
+
+	var received []string
+	for message := range subscriber.Start() {
+		received = append(received, string(message.Message()))
+		assert.Nil(t, message.Done())
+	}
+	assert.Equal(t, []string{"first", "second"}, received)
+	assert.Equal(t, group.messages, group.marked)
+	assert.EqualError(t, subscriber.Err(), "consumer group closed")
+
+	assert.Nil(t, subscriber.Stop())
+	assert.True(t, group.closed)
+}
+
+func TestKafkaSubscriber_Stop(t *testing.T) {
+	group := testKafkaConsumerGroup{messages: []*sarama.ConsumerMessage{{Value: []byte("first")}}}
+	subscriber := newKafkaSubscriber(&group, "notifications")
+	assert.Nil(t, subscriber.Stop())
+
+	messages := subscriber.Start()
+	for range messages {
+		t.Fatal("no messages should be delivered once stopped")
+	}
+	assert.Nil(t, subscriber.Err())
+	assert.False(t, group.consumed)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/logging"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flytestdlib/logger"
@@ -17,7 +18,7 @@ import (
 type NoopEmail struct{}
 
 func (n *NoopEmail) SendEmail(ctx context.Context, email admin.EmailMessage) error {
-	logger.Debugf(ctx, "received noop SendEmail request with subject [%s] and recipient [%s]",
+	logging.Debugf(ctx, logging.Notifications, "received noop SendEmail request with subject [%s] and recipient [%s]",
 		email.SubjectLine, strings.Join(email.RecipientsEmail, ","))
 	return nil
 }
@@ -29,7 +30,7 @@ func NewNoopEmail() interfaces.Emailer {
 type NoopPublish struct{}
 
 func (n *NoopPublish) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
-	logger.Debugf(ctx, "call to noop publish with notification type [%s] and proto message [%s]", notificationType, msg.String())
+	logging.Debugf(ctx, logging.Notifications, "call to noop publish with notification type [%s] and proto message [%s]", notificationType, msg.String())
 	return nil
 }
 
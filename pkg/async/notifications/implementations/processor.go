@@ -2,11 +2,11 @@ package implementations
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
-
-	"encoding/base64"
-	"encoding/json"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 
 	"github.com/NYTimes/gizmo/pubsub"
 	"github.com/golang/protobuf/proto"
@@ -26,12 +26,34 @@ type processorSystemMetrics struct {
 	MessageSuccess        prometheus.Counter
 	ChannelClosedError    prometheus.Counter
 	StopError             prometheus.Counter
+	MessageMerged         prometheus.Counter
+	QueueDepth            prometheus.Gauge
+	QueueFull             prometheus.Counter
+	QueueBlockedDuration  promutils.StopWatch
+}
+
+// SES rejects emails with more recipients than this, so merged emails are kept below it.
+const maxMergedRecipients = 50
+
+// A decoded notification and the message it was consumed from, which is marked done once the notification was sent.
+type queuedEmail struct {
+	message pubsub.SubscriberMessage
+	email   admin.EmailMessage
+}
+
+// An email sent on behalf of the messages of a batch whose notifications were merged into it.
+type mergedEmail struct {
+	email    admin.EmailMessage
+	messages []pubsub.SubscriberMessage
 }
 
 // TODO: Add a counter that encompasses the publisher stats grouped by project and domain.
 type Processor struct {
 	sub           pubsub.Subscriber
+	unwrap        MessageUnwrapper
 	email         interfaces.Emailer
+	workers       int
+	batchSize     int
 	systemMetrics processorSystemMetrics
 }
 
@@ -39,71 +61,48 @@ type Processor struct {
 // email client to trigger those notifications.
 // When Pagerduty and other notifications are supported, a publisher per type should be created.
 func (p *Processor) StartProcessing() error {
-	var emailMessage admin.EmailMessage
 	var err error
+	queue := make(chan queuedEmail, p.workers*p.batchSize)
+	var workers sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			p.sendEmails(queue)
+		}()
+	}
 	for msg := range p.sub.Start() {
 
 		p.systemMetrics.MessageTotal.Inc()
 		// Currently this is safe because Gizmo takes a string and casts it to a byte array.
 		var stringMsg = string(msg.Message())
-		// Amazon doesn't provide a struct that can be used to unmarshall into. A generic JSON struct is used in its place.
-		var snsJSONFormat map[string]interface{}
-
-		// At Lyft, SNS populates SQS. This results in the message body of SQS having the SNS message format.
-		// The message format is documented here: https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html
-		// The notification published is stored in the message field after unmarshalling the SQS message.
-		if err := json.Unmarshal(msg.Message(), &snsJSONFormat); err != nil {
-			p.systemMetrics.MessageDecodingError.Inc()
-			logger.Errorf(context.Background(), "failed to unmarshall JSON message [%s] from processor with err: %v", stringMsg, err)
-			p.markMessageDone(msg)
-			continue
-		}
-
-		var value interface{}
-		var ok bool
-		var valueString string
-
-		if value, ok = snsJSONFormat["Message"]; !ok {
-			logger.Errorf(context.Background(), "failed to retrieve message from unmarshalled JSON object [%s]", stringMsg)
-			p.systemMetrics.MessageDataError.Inc()
-			p.markMessageDone(msg)
-			continue
-		}
-
-		if valueString, ok = value.(string); !ok {
-			p.systemMetrics.MessageDataError.Inc()
-			logger.Errorf(context.Background(), "failed to retrieve notification message (in string format) from unmarshalled JSON object for message [%s]", stringMsg)
-			p.markMessageDone(msg)
-			continue
-		}
-
-		// The Publish method for SNS Encodes the notification using Base64 then stringifies it before
-		// setting that as the message body for SNS. Do the inverse to retrieve the notification.
-		notificationBytes, err := base64.StdEncoding.DecodeString(valueString)
+		notificationBytes, err := p.unwrap(msg.Message())
 		if err != nil {
-			logger.Errorf(context.Background(), "failed to Base64 decode from message string [%s] from message [%s] with err: %v", valueString, stringMsg, err)
-			p.systemMetrics.MessageDecodingError.Inc()
+			if _, ok := err.(messageDataError); ok {
+				p.systemMetrics.MessageDataError.Inc()
+			} else {
+				p.systemMetrics.MessageDecodingError.Inc()
+			}
+			logger.Errorf(context.Background(), "failed to unwrap notification from message [%s] with err: %v",
+				stringMsg, err)
 			p.markMessageDone(msg)
 			continue
 		}
 
+		var emailMessage admin.EmailMessage
 		if err = proto.Unmarshal(notificationBytes, &emailMessage); err != nil {
-			logger.Debugf(context.Background(), "failed to unmarshal to notification object from decoded string[%s] from message [%s] with err: %v", valueString, stringMsg, err)
+			logger.Debugf(context.Background(),
+				"failed to unmarshal to notification object from message [%s] with err: %v", stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
 			p.markMessageDone(msg)
 			continue
 		}
 
-		if err = p.email.SendEmail(context.Background(), emailMessage); err != nil {
-			p.systemMetrics.MessageProcessorError.Inc()
-			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
-		} else {
-			p.systemMetrics.MessageSuccess.Inc()
-		}
-
-		p.markMessageDone(msg)
-
+		p.enqueue(queue, queuedEmail{message: msg, email: emailMessage})
 	}
+	// Emails already consumed are still sent before returning.
+	close(queue)
+	workers.Wait()
 
 	// According to https://github.com/NYTimes/gizmo/blob/f2b3deec03175b11cdfb6642245a49722751357f/pubsub/pubsub.go#L36-L39,
 	// the channel backing the subscriber will just close if there is an error. The call to Err() is needed to identify
@@ -117,6 +116,109 @@ func (p *Processor) StartProcessing() error {
 	return err
 }
 
+// Queues an email for the workers, blocking while the queue is full so that no more messages are consumed than can be
+// sent.
+func (p *Processor) enqueue(queue chan<- queuedEmail, email queuedEmail) {
+	select {
+	case queue <- email:
+	default:
+		p.systemMetrics.QueueFull.Inc()
+		timer := p.systemMetrics.QueueBlockedDuration.Start()
+		queue <- email
+		timer.Stop()
+	}
+	p.systemMetrics.QueueDepth.Set(float64(len(queue)))
+}
+
+// Sends the queued emails in batches of those already waiting, up to the batch size, until the queue is closed.
+func (p *Processor) sendEmails(queue <-chan queuedEmail) {
+	for email := range queue {
+		batch := []queuedEmail{email}
+	fill:
+		for len(batch) < p.batchSize {
+			select {
+			case next, ok := <-queue:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, next)
+			default:
+				break fill
+			}
+		}
+		p.systemMetrics.QueueDepth.Set(float64(len(queue)))
+		for _, merged := range mergeEmails(batch) {
+			p.sendEmail(merged)
+		}
+	}
+}
+
+func (p *Processor) sendEmail(merged mergedEmail) {
+	err := p.email.SendEmail(context.Background(), merged.email)
+	if err != nil {
+		logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v",
+			merged.email.String(), err)
+	}
+	p.systemMetrics.MessageMerged.Add(float64(len(merged.messages) - 1))
+	for _, message := range merged.messages {
+		if err != nil {
+			p.systemMetrics.MessageProcessorError.Inc()
+		} else {
+			p.systemMetrics.MessageSuccess.Inc()
+		}
+		p.markMessageDone(message)
+	}
+}
+
+// Returns the recipients of both, without duplicates.
+func mergeRecipients(recipients, others []string) []string {
+	merged := append([]string{}, recipients...)
+	for _, other := range others {
+		var found bool
+		for _, recipient := range recipients {
+			if recipient == other {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, other)
+		}
+	}
+	return merged
+}
+
+// Merges the emails of a batch which only differ in their recipients, as long as the merged email doesn't have more
+// than maxMergedRecipients recipients.
+func mergeEmails(batch []queuedEmail) []mergedEmail {
+	var merged []mergedEmail
+	for _, queued := range batch {
+		var found bool
+		for i := range merged {
+			email := &merged[i].email
+			if email.SenderEmail != queued.email.SenderEmail || email.SubjectLine != queued.email.SubjectLine ||
+				email.Body != queued.email.Body {
+				continue
+			}
+			recipients := mergeRecipients(email.RecipientsEmail, queued.email.RecipientsEmail)
+			if len(recipients) > maxMergedRecipients {
+				continue
+			}
+			email.RecipientsEmail = recipients
+			merged[i].messages = append(merged[i].messages, queued.message)
+			found = true
+			break
+		}
+		if !found {
+			merged = append(merged, mergedEmail{
+				email:    queued.email,
+				messages: []pubsub.SubscriberMessage{queued.message},
+			})
+		}
+	}
+	return merged
+}
+
 func (p *Processor) markMessageDone(message pubsub.SubscriberMessage) {
 	if err := message.Done(); err != nil {
 		p.systemMetrics.MessageDoneError.Inc()
@@ -148,13 +250,44 @@ func newProcessorSystemMetrics(scope promutils.Scope) processorSystemMetrics {
 			"count of messages successfully processed by underlying notification mechanism"),
 		ChannelClosedError: scope.MustNewCounter("channel_closed_error", "count of channel closing errors"),
 		StopError:          scope.MustNewCounter("stop_error", "count of errors in Stop() method"),
+		MessageMerged: scope.MustNewCounter("message_merged",
+			"count of messages whose notification was merged into the email of another message"),
+		QueueDepth: scope.MustNewGauge("queue_depth", "number of emails waiting to be sent by the workers"),
+		QueueFull: scope.MustNewCounter("queue_full",
+			"count of messages which waited for the workers before they could be queued"),
+		QueueBlockedDuration: scope.MustNewStopWatch("queue_blocked",
+			"time consuming was blocked waiting for the workers", time.Millisecond),
 	}
 }
 
-func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+func newProcessor(sub pubsub.Subscriber, unwrap MessageUnwrapper, emailer interfaces.Emailer,
+	config runtimeInterfaces.NotificationsProcessorConfig, scope promutils.Scope) *Processor {
+	workers := config.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
 	return &Processor{
 		sub:           sub,
+		unwrap:        unwrap,
 		email:         emailer,
+		workers:       workers,
+		batchSize:     batchSize,
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("processor")),
 	}
 }
+
+// Processes the notifications published to SNS and consumed from SQS.
+func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer,
+	config runtimeInterfaces.NotificationsProcessorConfig, scope promutils.Scope) interfaces.Processor {
+	return newProcessor(sub, UnwrapSNSMessage, emailer, config, scope)
+}
+
+// Processes the notifications published in an Envelope by an envelope publisher, e.g. to GCP Pub/Sub.
+func NewEnvelopeProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer,
+	config runtimeInterfaces.NotificationsProcessorConfig, scope promutils.Scope) interfaces.Processor {
+	return newProcessor(sub, UnwrapEnvelope, emailer, config, scope)
+}
@@ -3,14 +3,20 @@ package implementations
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	"encoding/base64"
 
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flytestdlib/promutils"
 
 	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -44,6 +50,108 @@ func TestProcessor_StartProcessing(t *testing.T) {
 	assert.Nil(t, testProcessor.StartProcessing())
 }
 
+func TestEnvelopeProcessor_StartProcessing(t *testing.T) {
+	initializeProcessor()
+	testSubscriber.JSONMessages = append(testSubscriber.JSONMessages, Envelope{
+		NotificationType: "flyteidl.admin.EmailNotification",
+		Notification:     msg,
+	})
+	var sent []admin.EmailMessage
+	mockEmailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		sent = append(sent, email)
+		return nil
+	})
+	envelopeProcessor := NewEnvelopeProcessor(mockSub, &mockEmailer, runtimeInterfaces.NotificationsProcessorConfig{},
+		promutils.NewTestScope())
+	assert.Nil(t, envelopeProcessor.StartProcessing())
+	assert.Len(t, sent, 1)
+	assert.Equal(t, testEmail.SubjectLine, sent[0].SubjectLine)
+	assert.Equal(t, testEmail.RecipientsEmail, sent[0].RecipientsEmail)
+}
+
+func TestProcessor_StartProcessingWorkers(t *testing.T) {
+	initializeProcessor()
+	for i := 0; i < 10; i++ {
+		testSubscriber.JSONMessages = append(testSubscriber.JSONMessages, Envelope{
+			NotificationType: "flyteidl.admin.EmailNotification",
+			Notification:     msg,
+		})
+	}
+	var mutex sync.Mutex
+	var sent []admin.EmailMessage
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		sent = append(sent, email)
+		return nil
+	})
+	processor := NewEnvelopeProcessor(mockSub, &emailer, runtimeInterfaces.NotificationsProcessorConfig{
+		Workers:   3,
+		BatchSize: 4,
+	}, promutils.NewTestScope())
+	// Every email was sent by the time processing returns, though identical ones may have been merged.
+	assert.Nil(t, processor.StartProcessing())
+	assert.NotEmpty(t, sent)
+	assert.True(t, len(sent) <= 10)
+	for _, email := range sent {
+		assert.Equal(t, testEmail.SubjectLine, email.SubjectLine)
+		assert.Equal(t, testEmail.RecipientsEmail, email.RecipientsEmail)
+	}
+}
+
+func TestMergeEmails(t *testing.T) {
+	newQueuedEmail := func(subject string, recipients ...string) queuedEmail {
+		return queuedEmail{
+			message: &pubsubtest.TestSubscriberMessage{},
+			email: admin.EmailMessage{
+				RecipientsEmail: recipients,
+				SenderEmail:     "flyte@example.com",
+				SubjectLine:     subject,
+				Body:            "body",
+			},
+		}
+	}
+	var manyRecipients []string
+	for i := 0; i < maxMergedRecipients; i++ {
+		manyRecipients = append(manyRecipients, fmt.Sprintf("%d@example.com", i))
+	}
+	merged := mergeEmails([]queuedEmail{
+		newQueuedEmail("succeeded", "a@example.com"),
+		newQueuedEmail("failed", "a@example.com"),
+		newQueuedEmail("succeeded", "b@example.com", "a@example.com"),
+		newQueuedEmail("succeeded", manyRecipients...),
+		newQueuedEmail("failed", "c@example.com"),
+	})
+	assert.Len(t, merged, 3)
+	assert.Equal(t, "succeeded", merged[0].email.SubjectLine)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, merged[0].email.RecipientsEmail)
+	assert.Len(t, merged[0].messages, 2)
+	assert.Equal(t, "failed", merged[1].email.SubjectLine)
+	assert.Equal(t, []string{"a@example.com", "c@example.com"}, merged[1].email.RecipientsEmail)
+	assert.Len(t, merged[1].messages, 2)
+	// Merging would exceed the recipients an email may have.
+	assert.Equal(t, manyRecipients, merged[2].email.RecipientsEmail)
+	assert.Len(t, merged[2].messages, 1)
+}
+
+func TestProcessor_SendEmailMarksMergedMessagesDone(t *testing.T) {
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		return errors.New("error sending email")
+	})
+	processor := newProcessor(mockSub, UnwrapEnvelope, &emailer, runtimeInterfaces.NotificationsProcessorConfig{},
+		promutils.NewTestScope())
+	messages := []*pubsubtest.TestSubscriberMessage{{}, {}}
+	processor.sendEmail(mergedEmail{
+		email:    testEmail,
+		messages: []pubsub.SubscriberMessage{messages[0], messages[1]},
+	})
+	// Messages aren't redelivered after failing to be sent, just like before they were merged.
+	assert.True(t, messages[0].Doned)
+	assert.True(t, messages[1].Doned)
+}
+
 func TestProcessor_StartProcessingNoMessages(t *testing.T) {
 	initializeProcessor()
 	// Expect no errors are returned.
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/logging"
 
 	"github.com/NYTimes/gizmo/pubsub"
 	"github.com/golang/protobuf/proto"
@@ -22,13 +23,28 @@ type publisherSystemMetrics struct {
 type Publisher struct {
 	pub           pubsub.Publisher
 	systemMetrics publisherSystemMetrics
+	// Whether messages are published in an Envelope rather than as serialized notifications.
+	envelope bool
+}
+
+func (p *Publisher) publishEnvelope(ctx context.Context, notificationType string, msg proto.Message) error {
+	envelope, err := newEnvelope(notificationType, msg)
+	if err != nil {
+		return err
+	}
+	return p.pub.PublishRaw(ctx, notificationType, envelope)
 }
 
 // The key is the notification type as defined as an enum.
 func (p *Publisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
 	p.systemMetrics.PublishTotal.Inc()
-	logger.Debugf(ctx, "Publishing the following message [%s]", msg.String())
-	err := p.pub.Publish(ctx, notificationType, msg)
+	logging.Debugf(ctx, logging.Notifications, "Publishing the following message [%s]", msg.String())
+	var err error
+	if p.envelope {
+		err = p.publishEnvelope(ctx, notificationType, msg)
+	} else {
+		err = p.pub.Publish(ctx, notificationType, msg)
+	}
 	if err != nil {
 		p.systemMetrics.PublishError.Inc()
 		logger.Errorf(ctx, "Failed to publish a message with key [%s] and message [%s] and error: %v", notificationType, msg.String(), err)
@@ -50,3 +66,12 @@ func NewPublisher(pub pubsub.Publisher, scope promutils.Scope) interfaces.Publis
 		systemMetrics: newPublisherSystemMetrics(scope.NewSubScope("publisher")),
 	}
 }
+
+// Publishes notifications in an Envelope, for messaging services which don't wrap them like SNS, e.g. GCP Pub/Sub.
+func NewEnvelopePublisher(pub pubsub.Publisher, scope promutils.Scope) interfaces.Publisher {
+	return &Publisher{
+		pub:           pub,
+		systemMetrics: newPublisherSystemMetrics(scope.NewSubScope("publisher")),
+		envelope:      true,
+	}
+}
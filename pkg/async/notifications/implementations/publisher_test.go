@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 
 	"encoding/base64"
 
@@ -48,7 +49,8 @@ var testSubscriberMessage = map[string]interface{}{
 var testSubscriber pubsubtest.TestSubscriber
 var mockSub pubsub.Subscriber = &testSubscriber
 var mockEmail mocks.MockEmailer
-var testProcessor = NewProcessor(mockSub, &mockEmail, promutils.NewTestScope())
+var testProcessor = NewProcessor(
+	mockSub, &mockEmail, runtimeInterfaces.NotificationsProcessorConfig{}, promutils.NewTestScope())
 
 // This method should be invoked before every test around Publisher.
 func initializePublisher() {
@@ -74,3 +76,16 @@ func TestPublisher_PublishError(t *testing.T) {
 	testPublisher.GivenError = publishError
 	assert.Equal(t, publishError, currentPublisher.Publish(context.Background(), "test", &testEmail))
 }
+
+func TestEnvelopePublisher_Publish(t *testing.T) {
+	initializePublisher()
+	envelopePublisher := NewEnvelopePublisher(mockPublisher, promutils.NewTestScope())
+	assert.Nil(t, envelopePublisher.Publish(context.Background(), proto.MessageName(&testEmail), &testEmail))
+	assert.Equal(t, 1, len(testPublisher.Published))
+	assert.Equal(t, proto.MessageName(&testEmail), testPublisher.Published[0].Key)
+	notification, err := UnwrapEnvelope(testPublisher.Published[0].Body)
+	assert.Nil(t, err)
+	var publishedEmail admin.EmailMessage
+	assert.Nil(t, proto.Unmarshal(notification, &publishedEmail))
+	assert.True(t, proto.Equal(&testEmail, &publishedEmail))
+}
@@ -0,0 +1,393 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const rateLimitDigestSubject = "Flyte notification digest (%d notifications held back by rate limits)"
+const recipientRateLimitDigestSubject = "Flyte notification digest (%d notifications for launch plan %s)"
+
+// How often spilled notifications are checked for digests which are due.
+const rateLimitDigestFlushInterval = time.Minute
+
+// The channels notifications may be spilled for, in the order their digests are published.
+var rateLimitedNotificationTypes = []string{
+	proto.MessageName(&admin.EmailNotification{}),
+	proto.MessageName(&admin.SlackNotification{}),
+	proto.MessageName(&admin.PagerDutyNotification{}),
+}
+
+type rateLimitedPublisherMetrics struct {
+	Scope            promutils.Scope
+	Deduplicated     prometheus.Counter
+	Spilled          prometheus.Counter
+	SpillErrors      prometheus.Counter
+	DigestsPublished prometheus.Counter
+	DigestErrors     prometheus.Counter
+}
+
+// Tracks the notifications of one channel published within the current interval.
+type rateLimitWindow struct {
+	notificationType string
+	limit            int
+	interval         time.Duration
+	start            time.Time
+	count            int
+}
+
+func (w *rateLimitWindow) expired(now time.Time) bool {
+	return !now.Before(w.start.Add(w.interval))
+}
+
+// Notifications are rate limited per recipient for each channel and launch plan.
+type recipientRateLimitKey struct {
+	notificationType string
+	recipient        string
+	launchPlan       string
+}
+
+// Tracks the notifications of one recipient rate limit key published within the current interval.
+type recipientRateLimitWindow struct {
+	start time.Time
+	count int
+	// The subjects and bodies of the notifications published or spilled within the interval, to drop duplicates.
+	seen map[string]bool
+}
+
+// Enforces per-recipient and per-channel rate limits on top of another publisher. Channels are told apart by the
+// notification type the messages are published with.
+// Within an interval, a recipient gets notified of a launch plan on a channel up to the per-recipient limit, while
+// notifications identical to one they already got within the interval are dropped. Notifications are grouped by the
+// launch plan the publishing context is tagged with, those without one aren't limited per recipient. The notifications
+// left are then limited per channel.
+// Notifications over a limit are spilled into the database and published as digests, one per set of recipients, once
+// the oldest of them was spilled an interval ago. Digests are flushed periodically rather than by later Publish calls,
+// so that they're sent even when a channel goes quiet.
+type RateLimitedPublisher struct {
+	pub interfaces.Publisher
+	db  repositories.RepositoryInterface
+	// Rate limited channels in a fixed order so that digests are published deterministically.
+	windows           []*rateLimitWindow
+	recipientLimit    int
+	recipientInterval time.Duration
+	recipientWindows  map[recipientRateLimitKey]*recipientRateLimitWindow
+	mutex             sync.Mutex
+	metrics           rateLimitedPublisherMetrics
+	_clock            clock.Clock
+}
+
+// Returns whether the message may be published now, otherwise it should be spilled into the channel's next digest.
+func (p *RateLimitedPublisher) allow(notificationType string, msg proto.Message, now time.Time) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, window := range p.windows {
+		if window.notificationType != notificationType {
+			continue
+		}
+		if window.expired(now) {
+			window.start = now
+			window.count = 0
+		}
+		if window.count < window.limit {
+			window.count++
+			return true
+		}
+		// Only email messages can be combined into digests so anything else is published regardless.
+		_, ok := msg.(*admin.EmailMessage)
+		return !ok
+	}
+	return true
+}
+
+func withRecipients(email *admin.EmailMessage, recipients []string) *admin.EmailMessage {
+	return &admin.EmailMessage{
+		RecipientsEmail: recipients,
+		SenderEmail:     email.SenderEmail,
+		SubjectLine:     email.SubjectLine,
+		Body:            email.Body,
+	}
+}
+
+// Splits the recipients of an email into those it may be sent to now and those it should be spilled for, dropping the
+// recipients who already got an identical notification within the interval.
+func (p *RateLimitedPublisher) allowRecipients(notificationType, launchPlan string, email *admin.EmailMessage,
+	now time.Time) (allowed, spilled []string, deduplicated int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	content := email.SubjectLine + "\n" + email.Body
+	for _, recipient := range email.RecipientsEmail {
+		key := recipientRateLimitKey{notificationType: notificationType, recipient: recipient, launchPlan: launchPlan}
+		window, ok := p.recipientWindows[key]
+		if !ok || !now.Before(window.start.Add(p.recipientInterval)) {
+			window = &recipientRateLimitWindow{start: now, seen: make(map[string]bool)}
+			p.recipientWindows[key] = window
+		}
+		if window.seen[content] {
+			deduplicated++
+			continue
+		}
+		window.seen[content] = true
+		if window.count < p.recipientLimit {
+			window.count++
+			allowed = append(allowed, recipient)
+			continue
+		}
+		spilled = append(spilled, recipient)
+	}
+	return allowed, spilled, deduplicated
+}
+
+// Drops the per-recipient windows whose interval has ended, so that recipients who stopped getting notifications
+// aren't tracked forever.
+func (p *RateLimitedPublisher) pruneRecipientWindows(now time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for key, window := range p.recipientWindows {
+		if !now.Before(window.start.Add(p.recipientInterval)) {
+			delete(p.recipientWindows, key)
+		}
+	}
+}
+
+func (p *RateLimitedPublisher) spill(
+	ctx context.Context, notificationType, launchPlan string, msg proto.Message) error {
+	message, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.db.SpilledNotificationRepo().Create(ctx, models.SpilledNotification{
+		NotificationType: notificationType,
+		LaunchPlan:       launchPlan,
+		Message:          message,
+	})
+}
+
+// Applies the per-recipient limit to an email, returning the email to publish to the recipients left, if any.
+func (p *RateLimitedPublisher) limitRecipients(ctx context.Context, notificationType string,
+	email *admin.EmailMessage, now time.Time) *admin.EmailMessage {
+	launchPlan := common.GetLaunchPlan(ctx)
+	if len(launchPlan) == 0 {
+		return email
+	}
+	allowed, spilled, deduplicated := p.allowRecipients(notificationType, launchPlan, email, now)
+	p.metrics.Deduplicated.Add(float64(deduplicated))
+	for _, recipient := range spilled {
+		// Each recipient gets a digest of the notifications spilled for them.
+		if err := p.spill(ctx, notificationType, launchPlan, withRecipients(email, []string{recipient})); err != nil {
+			p.metrics.SpillErrors.Inc()
+			logger.Errorf(ctx, "Failed to spill notification with key [%s] for launch plan [%s] into a digest, "+
+				"publishing it instead: %v", notificationType, launchPlan, err)
+			allowed = append(allowed, recipient)
+			continue
+		}
+		p.metrics.Spilled.Inc()
+	}
+	if len(allowed) == 0 {
+		logger.Debugf(ctx, "Rate limited notification with key [%s] for launch plan [%s] for all its recipients",
+			notificationType, launchPlan)
+		return nil
+	}
+	if len(allowed) < len(email.RecipientsEmail) {
+		return withRecipients(email, allowed)
+	}
+	return email
+}
+
+func (p *RateLimitedPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	now := p._clock.Now()
+	if email, ok := msg.(*admin.EmailMessage); ok && p.recipientLimit > 0 {
+		email = p.limitRecipients(ctx, notificationType, email, now)
+		if email == nil {
+			return nil
+		}
+		msg = email
+	}
+	if p.allow(notificationType, msg, now) {
+		return p.pub.Publish(ctx, notificationType, msg)
+	}
+	if err := p.spill(ctx, notificationType, "", msg); err != nil {
+		// Going over the rate limit is preferable to losing the notification.
+		p.metrics.SpillErrors.Inc()
+		logger.Errorf(ctx, "Failed to spill notification with key [%s] into a digest, publishing it instead: %v",
+			notificationType, err)
+		return p.pub.Publish(ctx, notificationType, msg)
+	}
+	p.metrics.Spilled.Inc()
+	logger.Debugf(ctx, "Rate limit reached for notifications with key [%s], spilling message into digest",
+		notificationType)
+	return nil
+}
+
+// Returns the interval after which notifications spilled for a launch plan, or for the channel's limit when empty,
+// are published in a digest.
+func (p *RateLimitedPublisher) getDigestInterval(notificationType, launchPlan string) time.Duration {
+	if len(launchPlan) > 0 {
+		return p.recipientInterval
+	}
+	for _, window := range p.windows {
+		if window.notificationType == notificationType {
+			return window.interval
+		}
+	}
+	// The channel is no longer rate limited, whatever was spilled for it is due.
+	return 0
+}
+
+func getDigestSubject(launchPlan string) func(count int) string {
+	return func(count int) string {
+		if len(launchPlan) > 0 {
+			return fmt.Sprintf(recipientRateLimitDigestSubject, count, launchPlan)
+		}
+		return fmt.Sprintf(rateLimitDigestSubject, count)
+	}
+}
+
+// Publishes the digests of a channel, for its own limit and for each launch plan limited per recipient, when their
+// oldest spilled notification was spilled at least an interval ago. The spilled notifications stay locked until
+// they're published, so that replicas flushing at once don't publish them twice, and are only removed once every
+// digest was published.
+func (p *RateLimitedPublisher) flushDigests(ctx context.Context, notificationType string, now time.Time) error {
+	return p.db.Transaction(ctx, func(ctx context.Context) error {
+		spilled, err := p.db.SpilledNotificationRepo().ListForUpdate(ctx, notificationType)
+		if err != nil {
+			return err
+		}
+		var launchPlans []string
+		groups := make(map[string][]models.SpilledNotification)
+		for _, notification := range spilled {
+			if _, ok := groups[notification.LaunchPlan]; !ok {
+				launchPlans = append(launchPlans, notification.LaunchPlan)
+			}
+			groups[notification.LaunchPlan] = append(groups[notification.LaunchPlan], notification)
+		}
+		var ids []uint
+		for _, launchPlan := range launchPlans {
+			group := groups[launchPlan]
+			if now.Before(group[0].CreatedAt.Add(p.getDigestInterval(notificationType, launchPlan))) {
+				continue
+			}
+			emails := make([]*admin.EmailMessage, 0, len(group))
+			for _, notification := range group {
+				ids = append(ids, notification.ID)
+				var email admin.EmailMessage
+				if err := proto.Unmarshal(notification.Message, &email); err != nil {
+					// The notification can never be published, so it's dropped rather than holding up the others.
+					logger.Errorf(ctx, "Failed to unmarshal spilled notification [%d] with key [%s]: %v",
+						notification.ID, notificationType, err)
+					continue
+				}
+				emails = append(emails, &email)
+			}
+			for _, digest := range BuildEmailDigests(emails, getDigestSubject(launchPlan)) {
+				if err := p.pub.Publish(ctx, notificationType, digest); err != nil {
+					return err
+				}
+				p.metrics.DigestsPublished.Inc()
+			}
+		}
+		return p.db.SpilledNotificationRepo().Delete(ctx, ids)
+	})
+}
+
+// Publishes the digests which are due for every channel.
+func (p *RateLimitedPublisher) FlushDigests(ctx context.Context) error {
+	now := p._clock.Now()
+	p.pruneRecipientWindows(now)
+	var errs = make([]error, 0)
+	for _, notificationType := range rateLimitedNotificationTypes {
+		if err := p.flushDigests(ctx, notificationType, now); err != nil {
+			p.metrics.DigestErrors.Inc()
+			logger.Errorf(ctx, "Failed to publish rate limited notification digest with key [%s] and error: %v",
+				notificationType, err)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (p *RateLimitedPublisher) run() {
+	ctx := context.Background()
+	wait.Forever(func() {
+		if err := p.FlushDigests(ctx); err != nil {
+			logger.Warningf(ctx, "Failed to flush rate limited notification digests with: %v", err)
+		}
+	}, rateLimitDigestFlushInterval)
+}
+
+func newRateLimitedPublisherMetrics(scope promutils.Scope) rateLimitedPublisherMetrics {
+	return rateLimitedPublisherMetrics{
+		Scope: scope,
+		Deduplicated: scope.MustNewCounter("deduplicated",
+			"count of notifications dropped per recipient as duplicates of one already raised within the interval"),
+		Spilled: scope.MustNewCounter("spilled",
+			"count of notifications held back for a digest after reaching a rate limit"),
+		SpillErrors: scope.MustNewCounter("spill_errors",
+			"count of notifications published over a rate limit as they couldn't be held back"),
+		DigestsPublished: scope.MustNewCounter("digests_published",
+			"count of digests published for notifications held back by rate limits"),
+		DigestErrors: scope.MustNewCounter("digest_errors",
+			"count of errors publishing digests for notifications held back by rate limits"),
+	}
+}
+
+func newRateLimitedPublisher(pub interfaces.Publisher, db repositories.RepositoryInterface,
+	config runtimeInterfaces.NotificationRateLimitsConfig, scope promutils.Scope) *RateLimitedPublisher {
+	windows := make([]*rateLimitWindow, 0, 3)
+	// In the order of rateLimitedNotificationTypes.
+	for idx, limit := range []runtimeInterfaces.RateLimit{config.Email, config.Slack, config.PagerDuty} {
+		if limit.Limit <= 0 || limit.Interval.Duration <= 0 {
+			continue
+		}
+		windows = append(windows, &rateLimitWindow{
+			notificationType: rateLimitedNotificationTypes[idx],
+			limit:            limit.Limit,
+			interval:         limit.Interval.Duration,
+		})
+	}
+	publisher := &RateLimitedPublisher{
+		pub:              pub,
+		db:               db,
+		windows:          windows,
+		recipientWindows: make(map[recipientRateLimitKey]*recipientRateLimitWindow),
+		metrics:          newRateLimitedPublisherMetrics(scope.NewSubScope("rate_limited_publisher")),
+		_clock:           clock.New(),
+	}
+	if config.PerRecipient.Limit > 0 && config.PerRecipient.Interval.Duration > 0 {
+		publisher.recipientLimit = config.PerRecipient.Limit
+		publisher.recipientInterval = config.PerRecipient.Interval.Duration
+	}
+	return publisher
+}
+
+// Wraps pub with the rate limits in config and starts flushing digests in the background. Limits without a positive
+// limit and interval don't apply.
+func NewRateLimitedPublisher(pub interfaces.Publisher, db repositories.RepositoryInterface,
+	config runtimeInterfaces.NotificationRateLimitsConfig, scope promutils.Scope) interfaces.Publisher {
+	publisher := newRateLimitedPublisher(pub, db, config, scope)
+	if len(publisher.windows) > 0 || publisher.recipientLimit > 0 {
+		go publisher.run()
+	}
+	return publisher
+}
@@ -0,0 +1,309 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/config"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/lyft/flyteadmin/pkg/common"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+var emailNotificationType = proto.MessageName(&admin.EmailNotification{})
+var slackNotificationType = proto.MessageName(&admin.SlackNotification{})
+
+type publishedMessage struct {
+	key   string
+	email *admin.EmailMessage
+}
+
+// Stands in for the spilled notifications table.
+type spilledNotifications struct {
+	notifications []models.SpilledNotification
+	nextID        uint
+}
+
+var testChannelRateLimits = runtimeInterfaces.NotificationRateLimitsConfig{
+	Email: runtimeInterfaces.RateLimit{
+		Limit:    2,
+		Interval: config.Duration{Duration: time.Hour},
+	},
+}
+
+var testRecipientRateLimits = runtimeInterfaces.NotificationRateLimitsConfig{
+	PerRecipient: runtimeInterfaces.RateLimit{
+		Limit:    1,
+		Interval: config.Duration{Duration: time.Hour},
+	},
+}
+
+func newTestRateLimitedPublisher(published *[]publishedMessage, publishErr error, spilled *spilledNotifications,
+	rateLimits runtimeInterfaces.NotificationRateLimitsConfig) (*RateLimitedPublisher, *clock.Mock) {
+	var pub mocks.MockPublisher
+	pub.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		*published = append(*published, publishedMessage{
+			key:   key,
+			email: msg.(*admin.EmailMessage),
+		})
+		return publishErr
+	})
+	mockClock := clock.NewMock()
+	repository := repositoryMocks.NewMockRepository()
+	spilledNotificationRepo := repository.SpilledNotificationRepo().(*repositoryMocks.MockSpilledNotificationRepo)
+	spilledNotificationRepo.CreateFunction = func(ctx context.Context, input models.SpilledNotification) error {
+		spilled.nextID++
+		input.ID = spilled.nextID
+		input.CreatedAt = mockClock.Now()
+		spilled.notifications = append(spilled.notifications, input)
+		return nil
+	}
+	spilledNotificationRepo.ListForUpdateFunction = func(
+		ctx context.Context, notificationType string) ([]models.SpilledNotification, error) {
+		var notifications []models.SpilledNotification
+		for _, notification := range spilled.notifications {
+			if notification.NotificationType == notificationType {
+				notifications = append(notifications, notification)
+			}
+		}
+		return notifications, nil
+	}
+	spilledNotificationRepo.DeleteFunction = func(ctx context.Context, ids []uint) error {
+		deleted := make(map[uint]bool)
+		for _, id := range ids {
+			deleted[id] = true
+		}
+		var remaining []models.SpilledNotification
+		for _, notification := range spilled.notifications {
+			if !deleted[notification.ID] {
+				remaining = append(remaining, notification)
+			}
+		}
+		spilled.notifications = remaining
+		return nil
+	}
+	publisher := newRateLimitedPublisher(&pub, repository, rateLimits, promutils.NewTestScope())
+	publisher._clock = mockClock
+	return publisher, mockClock
+}
+
+func withTestLaunchPlan(name string) context.Context {
+	return common.WithLaunchPlan(context.Background(), &core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         name,
+		Version:      "version",
+	})
+}
+
+func newTestEmail(subject string, recipients ...string) *admin.EmailMessage {
+	return &admin.EmailMessage{
+		RecipientsEmail: recipients,
+		SenderEmail:     "no-reply@example.com",
+		SubjectLine:     subject,
+		Body:            "body",
+	}
+}
+
+func TestRateLimitedPublisher_SpillToDigest(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publisher, mockClock := newTestRateLimitedPublisher(&published, nil, &spilled, testChannelRateLimits)
+	ctx := context.Background()
+
+	for _, subject := range []string{"first", "second", "third", "fourth"} {
+		assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail(subject, "a@example.com")))
+	}
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("fifth", "b@example.com")))
+	// Channels without a rate limit aren't affected.
+	assert.NoError(t, publisher.Publish(ctx, slackNotificationType, newTestEmail("slack", "a@example.com")))
+	assert.Len(t, published, 3)
+	assert.Equal(t, "first", published[0].email.SubjectLine)
+	assert.Equal(t, "second", published[1].email.SubjectLine)
+	assert.Equal(t, slackNotificationType, published[2].key)
+	assert.Len(t, spilled.notifications, 3)
+
+	// Spilled notifications are held until the interval ends.
+	mockClock.Add(59 * time.Minute)
+	assert.NoError(t, publisher.FlushDigests(ctx))
+	assert.Len(t, published, 3)
+
+	// Digests are flushed without waiting for another notification to be published.
+	mockClock.Add(time.Minute)
+	published = nil
+	assert.NoError(t, publisher.FlushDigests(ctx))
+	assert.Len(t, published, 2)
+	assert.Equal(t, emailNotificationType, published[0].key)
+	assert.Equal(t, []string{"a@example.com"}, published[0].email.RecipientsEmail)
+	assert.Equal(t, "Flyte notification digest (2 notifications held back by rate limits)",
+		published[0].email.SubjectLine)
+	assert.Contains(t, published[0].email.Body, "third")
+	assert.Contains(t, published[0].email.Body, "fourth")
+	assert.Equal(t, []string{"b@example.com"}, published[1].email.RecipientsEmail)
+	assert.Equal(t, "Flyte notification digest (1 notifications held back by rate limits)",
+		published[1].email.SubjectLine)
+	assert.Empty(t, spilled.notifications)
+
+	published = nil
+	assert.NoError(t, publisher.FlushDigests(ctx))
+	assert.Empty(t, published)
+}
+
+func TestRateLimitedPublisher_SurvivesRestart(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publisher, _ := newTestRateLimitedPublisher(&published, nil, &spilled, testChannelRateLimits)
+	ctx := context.Background()
+	for _, subject := range []string{"first", "second", "third"} {
+		assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail(subject, "a@example.com")))
+	}
+	assert.Len(t, spilled.notifications, 1)
+
+	// Another publisher sharing the database publishes what the first one spilled.
+	published = nil
+	restarted, mockClock := newTestRateLimitedPublisher(&published, nil, &spilled, testChannelRateLimits)
+	mockClock.Add(time.Hour)
+	assert.NoError(t, restarted.FlushDigests(ctx))
+	assert.Len(t, published, 1)
+	assert.Contains(t, published[0].email.Body, "third")
+	assert.Empty(t, spilled.notifications)
+}
+
+func TestRateLimitedPublisher_DigestError(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publishErr := errors.New("foo")
+	publisher, mockClock := newTestRateLimitedPublisher(&published, publishErr, &spilled, testChannelRateLimits)
+	ctx := context.Background()
+
+	for _, subject := range []string{"first", "second"} {
+		assert.Equal(t, publishErr,
+			publisher.Publish(ctx, emailNotificationType, newTestEmail(subject, "a@example.com")))
+	}
+	// Spilled notifications aren't published so they can't fail.
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("third", "a@example.com")))
+	assert.Len(t, published, 2)
+
+	// Spilled notifications are kept for the next flush when their digest fails to publish.
+	mockClock.Add(time.Hour)
+	assert.Error(t, publisher.FlushDigests(ctx))
+	assert.Len(t, published, 3)
+	assert.Len(t, spilled.notifications, 1)
+}
+
+func TestRateLimitedPublisher_SpillError(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publisher, _ := newTestRateLimitedPublisher(&published, nil, &spilled, testChannelRateLimits)
+	publisher.db.SpilledNotificationRepo().(*repositoryMocks.MockSpilledNotificationRepo).CreateFunction = func(
+		ctx context.Context, input models.SpilledNotification) error {
+		return errors.New("database unavailable")
+	}
+	ctx := context.Background()
+	for _, subject := range []string{"first", "second", "third"} {
+		assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail(subject, "a@example.com")))
+	}
+	// Notifications which can't be held back are published over the limit.
+	assert.Len(t, published, 3)
+}
+
+func TestRateLimitedPublisher_SpillToRecipientDigest(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publisher, mockClock := newTestRateLimitedPublisher(&published, nil, &spilled, testRecipientRateLimits)
+	ctx := withTestLaunchPlan("lp")
+
+	for _, subject := range []string{"first", "second", "third"} {
+		assert.NoError(t, publisher.Publish(ctx, emailNotificationType,
+			newTestEmail(subject, "a@example.com", "b@example.com")))
+	}
+	// Other launch plans and channels are limited separately.
+	assert.NoError(t, publisher.Publish(withTestLaunchPlan("other"), emailNotificationType,
+		newTestEmail("other", "a@example.com")))
+	assert.NoError(t, publisher.Publish(ctx, slackNotificationType, newTestEmail("slack", "a@example.com")))
+	// Notifications published for executions without a launch plan aren't limited per recipient.
+	assert.NoError(t, publisher.Publish(context.Background(), emailNotificationType,
+		newTestEmail("untagged", "a@example.com")))
+	assert.Len(t, published, 4)
+	assert.Equal(t, "first", published[0].email.SubjectLine)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, published[0].email.RecipientsEmail)
+	assert.Equal(t, "other", published[1].email.SubjectLine)
+	assert.Equal(t, slackNotificationType, published[2].key)
+	assert.Equal(t, "untagged", published[3].email.SubjectLine)
+	assert.Len(t, spilled.notifications, 4)
+	assert.Equal(t, "project/domain/lp", spilled.notifications[0].LaunchPlan)
+
+	mockClock.Add(time.Hour)
+	published = nil
+	assert.NoError(t, publisher.FlushDigests(ctx))
+	assert.Len(t, published, 2)
+	// Each recipient gets a digest of the notifications spilled for them.
+	for idx, recipient := range []string{"a@example.com", "b@example.com"} {
+		assert.Equal(t, emailNotificationType, published[idx].key)
+		assert.Equal(t, []string{recipient}, published[idx].email.RecipientsEmail)
+		assert.Equal(t, "Flyte notification digest (2 notifications for launch plan project/domain/lp)",
+			published[idx].email.SubjectLine)
+		assert.Contains(t, published[idx].email.Body, "second")
+		assert.Contains(t, published[idx].email.Body, "third")
+	}
+	assert.Empty(t, spilled.notifications)
+
+	published = nil
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("fourth", "a@example.com")))
+	assert.Len(t, published, 1)
+	assert.Equal(t, "fourth", published[0].email.SubjectLine)
+}
+
+func TestRateLimitedPublisher_Deduplicate(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publisher, mockClock := newTestRateLimitedPublisher(&published, nil, &spilled, testRecipientRateLimits)
+	ctx := withTestLaunchPlan("lp")
+
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("failed", "a@example.com")))
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType,
+		newTestEmail("failed", "a@example.com", "b@example.com")))
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("failed", "b@example.com")))
+	// Only b@example.com gets the duplicate, as a@example.com already did.
+	assert.Len(t, published, 2)
+	assert.Equal(t, []string{"a@example.com"}, published[0].email.RecipientsEmail)
+	assert.Equal(t, []string{"b@example.com"}, published[1].email.RecipientsEmail)
+	assert.Empty(t, spilled.notifications)
+
+	// Duplicates are no longer dropped once the interval has ended.
+	mockClock.Add(time.Hour)
+	published = nil
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("failed", "a@example.com")))
+	assert.Len(t, published, 1)
+	assert.Equal(t, "failed", published[0].email.SubjectLine)
+}
+
+func TestRateLimitedPublisher_RecipientSpillError(t *testing.T) {
+	var published []publishedMessage
+	var spilled spilledNotifications
+	publisher, _ := newTestRateLimitedPublisher(&published, nil, &spilled, testRecipientRateLimits)
+	publisher.db.SpilledNotificationRepo().(*repositoryMocks.MockSpilledNotificationRepo).CreateFunction = func(
+		ctx context.Context, input models.SpilledNotification) error {
+		return errors.New("database unavailable")
+	}
+	ctx := withTestLaunchPlan("lp")
+	for _, subject := range []string{"first", "second"} {
+		assert.NoError(t, publisher.Publish(ctx, emailNotificationType,
+			newTestEmail(subject, "a@example.com", "b@example.com")))
+	}
+	// Recipients whose notification can't be held back get it over the limit.
+	assert.Len(t, published, 2)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, published[1].email.RecipientsEmail)
+}
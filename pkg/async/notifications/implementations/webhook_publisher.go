@@ -0,0 +1,214 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/logging"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const webhookRequestTimeout = 10 * time.Second
+
+const (
+	defaultWebhookWorkers   = 4
+	defaultWebhookQueueSize = 1000
+)
+
+type webhookPublisherMetrics struct {
+	Scope        promutils.Scope
+	RequestTotal prometheus.Counter
+	RequestError prometheus.Counter
+	QueueDepth   prometheus.Gauge
+	QueueFull    prometheus.Counter
+}
+
+// A Microsoft Teams connector card, see
+// https://docs.microsoft.com/en-us/outlook/actionable-messages/message-card-reference.
+type teamsMessageCard struct {
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Text    string `json:"text"`
+}
+
+// The JSON body generic webhooks are notified with.
+type webhookMessage struct {
+	// The project/domain/name of the execution the notification was raised for.
+	Execution string `json:"execution,omitempty"`
+	// The project/domain/name of the launch plan the execution was launched from.
+	LaunchPlan string `json:"launchPlan,omitempty"`
+	Subject    string `json:"subject"`
+	Body       string `json:"body"`
+}
+
+// A webhook notification waiting to be POSTed to the webhooks it was raised for.
+type webhookDelivery struct {
+	notificationType string
+	webhooks         []string
+	body             []byte
+}
+
+// Delivers Microsoft Teams and generic webhook notifications by POSTing them to the configured webhooks, and publishes
+// every other notification with another publisher. Webhook notifications are queued and POSTed by a pool of workers
+// rather than by Publish, so that slow webhooks don't hold up the events raising the notifications.
+type WebhookPublisher struct {
+	pub      interfaces.Publisher
+	webhooks map[string]runtimeInterfaces.NotificationWebhookConfig
+	client   *http.Client
+	queue    chan webhookDelivery
+	// Tracks the queued deliveries which haven't been POSTed yet.
+	pending sync.WaitGroup
+	metrics webhookPublisherMetrics
+}
+
+func (p *WebhookPublisher) post(ctx context.Context, webhook runtimeInterfaces.NotificationWebhookConfig,
+	body []byte) error {
+	request, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range webhook.Headers {
+		request.Header.Set(name, value)
+	}
+	response, err := p.client.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook [%s] responded with status [%s]", webhook.Name, response.Status)
+	}
+	return nil
+}
+
+func newWebhookBody(ctx context.Context, notificationType string, email *admin.EmailMessage) ([]byte, error) {
+	if notificationType == interfaces.TeamsNotificationType {
+		return json.Marshal(teamsMessageCard{
+			Type:    "MessageCard",
+			Context: "http://schema.org/extensions",
+			Summary: email.SubjectLine,
+			Title:   email.SubjectLine,
+			Text:    email.Body,
+		})
+	}
+	return json.Marshal(webhookMessage{
+		Execution:  logging.GetExecutionID(ctx),
+		LaunchPlan: common.GetLaunchPlan(ctx),
+		Subject:    email.SubjectLine,
+		Body:       email.Body,
+	})
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	if notificationType != interfaces.TeamsNotificationType && notificationType != interfaces.WebhookNotificationType {
+		return p.pub.Publish(ctx, notificationType, msg)
+	}
+	email, ok := msg.(*admin.EmailMessage)
+	if !ok {
+		return fmt.Errorf("unexpected message [%s] for notifications with key [%s]",
+			proto.MessageName(msg), notificationType)
+	}
+	body, err := newWebhookBody(ctx, notificationType, email)
+	if err != nil {
+		return err
+	}
+	p.pending.Add(1)
+	select {
+	case p.queue <- webhookDelivery{notificationType: notificationType, webhooks: email.RecipientsEmail, body: body}:
+		p.metrics.QueueDepth.Set(float64(len(p.queue)))
+		return nil
+	default:
+		p.pending.Done()
+		p.metrics.QueueFull.Inc()
+		return fmt.Errorf("failed to queue notification with key [%s] for webhooks %v as the queue is full",
+			notificationType, email.RecipientsEmail)
+	}
+}
+
+// POSTs the queued notifications to their webhooks until the queue is closed. Failing webhooks don't keep the others
+// from being notified.
+func (p *WebhookPublisher) deliver() {
+	for delivery := range p.queue {
+		p.metrics.QueueDepth.Set(float64(len(p.queue)))
+		for _, name := range delivery.webhooks {
+			p.metrics.RequestTotal.Inc()
+			var err error
+			webhook, ok := p.webhooks[name]
+			if !ok {
+				err = fmt.Errorf("webhook [%s] isn't configured", name)
+			} else {
+				err = p.post(context.Background(), webhook, delivery.body)
+			}
+			if err != nil {
+				p.metrics.RequestError.Inc()
+				logger.Errorf(context.Background(), "Failed to notify webhook [%s] with key [%s] and error: %v",
+					name, delivery.notificationType, err)
+			}
+		}
+		p.pending.Done()
+	}
+}
+
+func newWebhookPublisherMetrics(scope promutils.Scope) webhookPublisherMetrics {
+	return webhookPublisherMetrics{
+		Scope:        scope,
+		RequestTotal: scope.MustNewCounter("request_total", "overall count of webhook notification requests"),
+		RequestError: scope.MustNewCounter("request_errors", "count of failed webhook notification requests"),
+		QueueDepth:   scope.MustNewGauge("queue_depth", "number of webhook notifications waiting to be delivered"),
+		QueueFull: scope.MustNewCounter("queue_full",
+			"count of webhook notifications dropped as the queue was full"),
+	}
+}
+
+func newWebhookPublisher(pub interfaces.Publisher, config runtimeInterfaces.NotificationsConfig,
+	scope promutils.Scope) *WebhookPublisher {
+	webhooks := make(map[string]runtimeInterfaces.NotificationWebhookConfig, len(config.Webhooks))
+	for _, webhook := range config.Webhooks {
+		webhooks[webhook.Name] = webhook
+	}
+	queueSize := config.WebhookDelivery.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultWebhookQueueSize
+	}
+	return &WebhookPublisher{
+		pub:      pub,
+		webhooks: webhooks,
+		client:   &http.Client{Timeout: webhookRequestTimeout},
+		queue:    make(chan webhookDelivery, queueSize),
+		metrics:  newWebhookPublisherMetrics(scope.NewSubScope("webhook_publisher")),
+	}
+}
+
+// Wraps pub with the delivery of Microsoft Teams and generic webhook notifications to the webhooks in config.
+func NewWebhookPublisher(pub interfaces.Publisher, config runtimeInterfaces.NotificationsConfig,
+	scope promutils.Scope) interfaces.Publisher {
+	publisher := newWebhookPublisher(pub, config, scope)
+	workers := config.WebhookDelivery.Workers
+	if workers <= 0 {
+		workers = defaultWebhookWorkers
+	}
+	for i := 0; i < workers; i++ {
+		go publisher.deliver()
+	}
+	return publisher
+}
@@ -0,0 +1,139 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/lyft/flyteadmin/pkg/logging"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+type webhookRequest struct {
+	path          string
+	authorization string
+	body          map[string]interface{}
+}
+
+func newTestWebhookServer(t *testing.T, requests *[]webhookRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := ioutil.ReadAll(request.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		var decoded map[string]interface{}
+		assert.NoError(t, json.Unmarshal(body, &decoded))
+		*requests = append(*requests, webhookRequest{
+			path:          request.URL.Path,
+			authorization: request.Header.Get("Authorization"),
+			body:          decoded,
+		})
+		if request.URL.Path == "/broken" {
+			writer.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+}
+
+func newTestWebhookPublisher(url string, published *[]publishedMessage) *WebhookPublisher {
+	var pub mocks.MockPublisher
+	pub.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		*published = append(*published, publishedMessage{key: key, email: msg.(*admin.EmailMessage)})
+		return nil
+	})
+	return NewWebhookPublisher(&pub, runtimeInterfaces.NotificationsConfig{
+		Webhooks: []runtimeInterfaces.NotificationWebhookConfig{
+			{Name: "teams", URL: url + "/teams"},
+			{Name: "audit", URL: url + "/audit", Headers: map[string]string{"Authorization": "Bearer secret"}},
+			{Name: "broken", URL: url + "/broken"},
+		},
+		WebhookDelivery: runtimeInterfaces.NotificationWebhookDeliveryConfig{
+			// A single worker delivers the notifications in the order they were published.
+			Workers: 1,
+		},
+	}, promutils.NewTestScope()).(*WebhookPublisher)
+}
+
+func TestWebhookPublisher_Publish(t *testing.T) {
+	var requests []webhookRequest
+	server := newTestWebhookServer(t, &requests)
+	defer server.Close()
+	var published []publishedMessage
+	publisher := newTestWebhookPublisher(server.URL, &published)
+	ctx := logging.WithExecutionID(context.Background(), &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	})
+
+	assert.NoError(t, publisher.Publish(ctx, interfaces.TeamsNotificationType, newTestEmail("failed", "teams")))
+	assert.NoError(t, publisher.Publish(ctx, interfaces.WebhookNotificationType, newTestEmail("failed", "audit")))
+	publisher.pending.Wait()
+	assert.Equal(t, []webhookRequest{
+		{
+			path: "/teams",
+			body: map[string]interface{}{
+				"@type":    "MessageCard",
+				"@context": "http://schema.org/extensions",
+				"summary":  "failed",
+				"title":    "failed",
+				"text":     "body",
+			},
+		},
+		{
+			path:          "/audit",
+			authorization: "Bearer secret",
+			body: map[string]interface{}{
+				"execution": "project/domain/name",
+				"subject":   "failed",
+				"body":      "body",
+			},
+		},
+	}, requests)
+
+	// Every other notification is published as is.
+	assert.NoError(t, publisher.Publish(ctx, emailNotificationType, newTestEmail("failed", "a@example.com")))
+	assert.Len(t, published, 1)
+	assert.Equal(t, emailNotificationType, published[0].key)
+	assert.Len(t, requests, 2)
+}
+
+func TestWebhookPublisher_PublishErrors(t *testing.T) {
+	var requests []webhookRequest
+	server := newTestWebhookServer(t, &requests)
+	defer server.Close()
+	var published []publishedMessage
+	publisher := newTestWebhookPublisher(server.URL, &published)
+
+	// Failing webhooks don't keep the others from being notified, nor do they fail publishing.
+	assert.NoError(t, publisher.Publish(context.Background(), interfaces.WebhookNotificationType,
+		newTestEmail("failed", "broken", "unknown", "audit")))
+	publisher.pending.Wait()
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "/audit", requests[1].path)
+	assert.Empty(t, published)
+}
+
+func TestWebhookPublisher_QueueFull(t *testing.T) {
+	// Without workers, nothing is taken off the queue.
+	publisher := newWebhookPublisher(nil, runtimeInterfaces.NotificationsConfig{
+		WebhookDelivery: runtimeInterfaces.NotificationWebhookDeliveryConfig{
+			QueueSize: 1,
+		},
+	}, promutils.NewTestScope())
+	assert.NoError(t, publisher.Publish(context.Background(), interfaces.WebhookNotificationType,
+		newTestEmail("failed", "audit")))
+	err := publisher.Publish(context.Background(), interfaces.WebhookNotificationType,
+		newTestEmail("failed", "audit"))
+	assert.EqualError(t, err, "failed to queue notification with key [flyteadmin.WebhookNotification] for "+
+		"webhooks [audit] as the queue is full")
+}
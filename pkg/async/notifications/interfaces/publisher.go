@@ -19,6 +19,13 @@ import (
 // the notification using the desired delivery method (ex: email). There is one processor per
 // notification type.
 
+// flyteidl has no notification types for Microsoft Teams and generic webhooks at this version, so their messages are
+// published with these keys. The recipients of their email messages are the names of the configured webhooks.
+const (
+	TeamsNotificationType   = "flyteadmin.TeamsNotification"
+	WebhookNotificationType = "flyteadmin.WebhookNotification"
+)
+
 // Publish a notification will differ between different types of notifications using the key
 // The contract requires one subscription per type i.e. one for email one for slack, etc...
 type Publisher interface {
@@ -0,0 +1,302 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const quietHoursTimeLayout = "15:04"
+const minutesPerDay = 24 * 60
+const digestSubject = "Flyte notification digest for project %s and domain %s (%d notifications)"
+
+// How often deferred notifications are checked for quiet windows which have ended.
+const quietHoursDigestInterval = time.Minute
+
+type QuietHoursAction int
+
+const (
+	// The notification should be published immediately.
+	QuietHoursActionPublish QuietHoursAction = iota
+	// The notification should be dropped.
+	QuietHoursActionSuppress
+	// The notification should be held back and sent as part of a digest.
+	QuietHoursActionDefer
+)
+
+type quietWindow struct {
+	startMinute    int
+	endMinute      int
+	location       *time.Location
+	mode           string
+	criticalPhases map[core.WorkflowExecution_Phase]bool
+}
+
+// Returns whether the wall-clock time of t, in the window's timezone, falls within the window.
+func (w quietWindow) contains(t time.Time) bool {
+	local := t.In(w.location)
+	minute := local.Hour()*60 + local.Minute()
+	if w.startMinute <= w.endMinute {
+		return minute >= w.startMinute && minute < w.endMinute
+	}
+	// The window wraps around midnight.
+	return minute >= w.startMinute || minute < w.endMinute
+}
+
+// Returns when the window containing t ends.
+func (w quietWindow) end(t time.Time) time.Time {
+	local := t.In(w.location)
+	end := time.Date(local.Year(), local.Month(), local.Day(), w.endMinute/60, w.endMinute%60, 0, 0, w.location)
+	if !end.After(local) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+func parseMinuteOfDay(value string) (int, error) {
+	parsed, err := time.Parse(quietHoursTimeLayout, value)
+	if err != nil {
+		return 0, err
+	}
+	return (parsed.Hour()*60 + parsed.Minute()) % minutesPerDay, nil
+}
+
+func newQuietWindow(config managerInterfaces.QuietHours) (quietWindow, error) {
+	startMinute, err := parseMinuteOfDay(config.Start)
+	if err != nil {
+		return quietWindow{}, fmt.Errorf("invalid start [%s]: %v", config.Start, err)
+	}
+	endMinute, err := parseMinuteOfDay(config.End)
+	if err != nil {
+		return quietWindow{}, fmt.Errorf("invalid end [%s]: %v", config.End, err)
+	}
+	location := time.UTC
+	if len(config.Timezone) > 0 {
+		location, err = time.LoadLocation(config.Timezone)
+		if err != nil {
+			return quietWindow{}, fmt.Errorf("invalid timezone [%s]: %v", config.Timezone, err)
+		}
+	}
+	mode := config.Mode
+	if len(mode) == 0 {
+		mode = managerInterfaces.QuietHoursModeSuppress
+	}
+	if mode != managerInterfaces.QuietHoursModeSuppress && mode != managerInterfaces.QuietHoursModeDefer {
+		return quietWindow{}, fmt.Errorf("unrecognized mode [%s]", config.Mode)
+	}
+	criticalPhases := make(map[core.WorkflowExecution_Phase]bool, len(config.CriticalPhases))
+	for _, phaseName := range config.CriticalPhases {
+		phaseValue, ok := core.WorkflowExecution_Phase_value[strings.ToUpper(phaseName)]
+		if !ok {
+			return quietWindow{}, fmt.Errorf("unrecognized critical phase [%s]", phaseName)
+		}
+		criticalPhases[core.WorkflowExecution_Phase(phaseValue)] = true
+	}
+	return quietWindow{
+		startMinute:    startMinute,
+		endMinute:      endMinute,
+		location:       location,
+		mode:           mode,
+		criticalPhases: criticalPhases,
+	}, nil
+}
+
+// Returns an error describing why the quiet hours attributes are invalid, if they are.
+func ValidateQuietHours(attributes managerInterfaces.QuietHours) error {
+	_, err := newQuietWindow(attributes)
+	return err
+}
+
+type quietHoursKey struct {
+	project string
+	domain  string
+}
+
+type quietHoursMetrics struct {
+	Scope            promutils.Scope
+	DigestsPublished prometheus.Counter
+	DigestErrors     prometheus.Counter
+}
+
+// Decides whether notifications should be published, suppressed or deferred according to the quiet hours attributes
+// matching the project and domain of their execution. Deferred notifications are kept in the database and combined
+// into digests per project and domain, which are published in the background once the quiet window the latest of them
+// was deferred by has ended. A nil *QuietHours publishes everything.
+type QuietHours struct {
+	pub     interfaces.Publisher
+	db      repositories.RepositoryInterface
+	metrics quietHoursMetrics
+	_clock  clock.Clock
+}
+
+// Parses the quiet hours attributes, which are validated when set, so that attributes which are no longer valid, e.g.
+// for a timezone missing from the host, are logged and ignored rather than holding back notifications.
+func getQuietWindow(attributes *managerInterfaces.QuietHours) (quietWindow, bool) {
+	if attributes == nil {
+		return quietWindow{}, false
+	}
+	window, err := newQuietWindow(*attributes)
+	if err != nil {
+		logger.Errorf(context.Background(), "ignoring invalid quiet hours [%+v]: %v", *attributes, err)
+		return quietWindow{}, false
+	}
+	return window, true
+}
+
+// Returns the action to take for a notification raised for an execution phase at time now, given the quiet hours
+// attributes matching the execution, which are nil when none are set.
+func (q *QuietHours) GetAction(attributes *managerInterfaces.QuietHours, notification *admin.Notification,
+	phase core.WorkflowExecution_Phase, now time.Time) QuietHoursAction {
+	if q == nil {
+		return QuietHoursActionPublish
+	}
+	window, ok := getQuietWindow(attributes)
+	if !ok || !window.contains(now) {
+		return QuietHoursActionPublish
+	}
+	if notification.GetPagerDuty() != nil || window.criticalPhases[phase] {
+		return QuietHoursActionPublish
+	}
+	if window.mode == managerInterfaces.QuietHoursModeDefer {
+		return QuietHoursActionDefer
+	}
+	return QuietHoursActionSuppress
+}
+
+// Holds back an email message to be sent as part of the project and domain's next digest, once the quiet hours it was
+// deferred by end.
+func (q *QuietHours) Defer(ctx context.Context, project, domain string, attributes *managerInterfaces.QuietHours,
+	email *admin.EmailMessage) error {
+	window, ok := getQuietWindow(attributes)
+	if !ok {
+		return fmt.Errorf("no valid quiet hours to defer the notification by")
+	}
+	message, err := proto.Marshal(email)
+	if err != nil {
+		return err
+	}
+	return q.db.DeferredNotificationRepo().Create(ctx, models.DeferredNotification{
+		Project: project,
+		Domain:  domain,
+		DueAt:   window.end(q._clock.Now()),
+		Message: message,
+	})
+}
+
+// Publishes the digests of the projects and domains whose latest deferred notification is due at time now, grouping
+// their notifications by recipients so that each set of recipients receives a single digest. The deferred
+// notifications stay locked until they're published, so that replicas publishing at once don't publish them twice, and
+// are only removed once every digest was published.
+func (q *QuietHours) publishDigests(ctx context.Context, now time.Time) error {
+	return q.db.Transaction(ctx, func(ctx context.Context) error {
+		deferred, err := q.db.DeferredNotificationRepo().ListForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+		var keys []quietHoursKey
+		groups := make(map[quietHoursKey][]models.DeferredNotification)
+		for _, notification := range deferred {
+			key := quietHoursKey{project: notification.Project, domain: notification.Domain}
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], notification)
+		}
+		var ids []uint
+		for _, key := range keys {
+			group := groups[key]
+			// The latest attributes apply, in case they were updated since the first notification was deferred.
+			if now.Before(group[len(group)-1].DueAt) {
+				continue
+			}
+			emails := make([]*admin.EmailMessage, 0, len(group))
+			for _, notification := range group {
+				ids = append(ids, notification.ID)
+				var email admin.EmailMessage
+				if err := proto.Unmarshal(notification.Message, &email); err != nil {
+					// The notification can never be published, so it's dropped rather than holding up the others.
+					logger.Errorf(ctx, "Failed to unmarshal deferred notification [%d] for [%s/%s]: %v",
+						notification.ID, key.project, key.domain, err)
+					continue
+				}
+				emails = append(emails, &email)
+			}
+			for _, digest := range buildDigests(key, emails) {
+				if err := q.pub.Publish(ctx, proto.MessageName(&admin.EmailNotification{}), digest); err != nil {
+					return err
+				}
+				q.metrics.DigestsPublished.Inc()
+			}
+		}
+		return q.db.DeferredNotificationRepo().Delete(ctx, ids)
+	})
+}
+
+// Publishes the digests of the notifications whose quiet window has ended. Digests which fail to publish are retried
+// on the next call.
+func (q *QuietHours) PublishDigests(ctx context.Context) error {
+	if err := q.publishDigests(ctx, q._clock.Now()); err != nil {
+		q.metrics.DigestErrors.Inc()
+		return err
+	}
+	return nil
+}
+
+// Digests are published periodically rather than when later notifications are raised, so that they're sent even when
+// no execution changes phase after the quiet window ends.
+func (q *QuietHours) run() {
+	ctx := context.Background()
+	wait.Forever(func() {
+		if err := q.PublishDigests(ctx); err != nil {
+			logger.Warningf(ctx, "Failed to publish quiet hours notification digests with: %v", err)
+		}
+	}, quietHoursDigestInterval)
+}
+
+func buildDigests(key quietHoursKey, emails []*admin.EmailMessage) []*admin.EmailMessage {
+	return implementations.BuildEmailDigests(emails, func(count int) string {
+		return fmt.Sprintf(digestSubject, key.project, key.domain, count)
+	})
+}
+
+func newQuietHoursMetrics(scope promutils.Scope) quietHoursMetrics {
+	return quietHoursMetrics{
+		Scope: scope,
+		DigestsPublished: scope.MustNewCounter("digests_published",
+			"count of digests of notifications deferred during quiet hours published"),
+		DigestErrors: scope.MustNewCounter("digest_errors",
+			"count of digests of notifications deferred during quiet hours which failed to publish"),
+	}
+}
+
+func newQuietHours(pub interfaces.Publisher, db repositories.RepositoryInterface, scope promutils.Scope) *QuietHours {
+	return &QuietHours{
+		pub:     pub,
+		db:      db,
+		metrics: newQuietHoursMetrics(scope.NewSubScope("quiet_hours")),
+		_clock:  clock.New(),
+	}
+}
+
+// Builds the quiet hours policy keeping deferred notifications in db and publishing them through pub, and starts
+// publishing their digests in the background.
+func NewQuietHours(pub interfaces.Publisher, db repositories.RepositoryInterface, scope promutils.Scope) *QuietHours {
+	quietHours := newQuietHours(pub, db, scope)
+	go quietHours.run()
+	return quietHours
+}
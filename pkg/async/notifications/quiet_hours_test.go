@@ -0,0 +1,273 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var emailNotification = &admin.Notification{
+	Type: &admin.Notification_Email{
+		Email: &admin.EmailNotification{
+			RecipientsEmail: []string{"a@example.com"},
+		},
+	},
+}
+
+var pagerDutyNotification = &admin.Notification{
+	Type: &admin.Notification_PagerDuty{
+		PagerDuty: &admin.PagerDutyNotification{
+			RecipientsEmail: []string{"oncall@example.com"},
+		},
+	},
+}
+
+func getQuietHoursAttributesForTest(mode string) *managerInterfaces.QuietHours {
+	return &managerInterfaces.QuietHours{
+		Start:          "22:00",
+		End:            "07:00",
+		Timezone:       "America/New_York",
+		Mode:           mode,
+		CriticalPhases: []string{"failed"},
+	}
+}
+
+func TestQuietHours_GetAction(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	quietTime := time.Date(2019, 6, 1, 23, 30, 0, 0, newYork)
+	earlyMorning := time.Date(2019, 6, 1, 3, 0, 0, 0, newYork)
+	activeTime := time.Date(2019, 6, 1, 12, 0, 0, 0, newYork)
+
+	quietHours := newQuietHours(&mocks.MockPublisher{}, repositoryMocks.NewMockRepository(), promutils.NewTestScope())
+	attributes := getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeSuppress)
+	assert.Equal(t, QuietHoursActionSuppress,
+		quietHours.GetAction(attributes, emailNotification, core.WorkflowExecution_SUCCEEDED, quietTime))
+	assert.Equal(t, QuietHoursActionSuppress,
+		quietHours.GetAction(attributes, emailNotification, core.WorkflowExecution_SUCCEEDED, earlyMorning))
+	assert.Equal(t, QuietHoursActionPublish,
+		quietHours.GetAction(attributes, emailNotification, core.WorkflowExecution_SUCCEEDED, activeTime))
+	// Equivalent instant expressed in a different timezone.
+	assert.Equal(t, QuietHoursActionSuppress,
+		quietHours.GetAction(attributes, emailNotification, core.WorkflowExecution_SUCCEEDED, quietTime.UTC()))
+
+	// Critical notifications are always published.
+	assert.Equal(t, QuietHoursActionPublish,
+		quietHours.GetAction(attributes, emailNotification, core.WorkflowExecution_FAILED, quietTime))
+	assert.Equal(t, QuietHoursActionPublish,
+		quietHours.GetAction(attributes, pagerDutyNotification, core.WorkflowExecution_SUCCEEDED, quietTime))
+
+	// Executions without quiet hours are unaffected.
+	assert.Equal(t, QuietHoursActionPublish,
+		quietHours.GetAction(nil, emailNotification, core.WorkflowExecution_SUCCEEDED, quietTime))
+
+	assert.Equal(t, QuietHoursActionDefer, quietHours.GetAction(
+		getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeDefer), emailNotification,
+		core.WorkflowExecution_SUCCEEDED, quietTime))
+}
+
+func TestQuietHours_NilAndInvalid(t *testing.T) {
+	attributes := getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeSuppress)
+	var nilQuietHours *QuietHours
+	assert.Equal(t, QuietHoursActionPublish,
+		nilQuietHours.GetAction(attributes, emailNotification, core.WorkflowExecution_SUCCEEDED, time.Now()))
+
+	// Invalid attributes hold nothing back.
+	quietHours := newQuietHours(&mocks.MockPublisher{}, repositoryMocks.NewMockRepository(), promutils.NewTestScope())
+	newYork, _ := time.LoadLocation("America/New_York")
+	quietTime := time.Date(2019, 6, 1, 23, 30, 0, 0, newYork)
+	assert.Equal(t, QuietHoursActionPublish, quietHours.GetAction(&managerInterfaces.QuietHours{
+		Start:    "00:00",
+		End:      "07:00",
+		Timezone: "Not/AZone",
+	}, emailNotification, core.WorkflowExecution_SUCCEEDED, quietTime))
+}
+
+func TestValidateQuietHours(t *testing.T) {
+	assert.NoError(t, ValidateQuietHours(*getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeDefer)))
+	assert.EqualError(t, ValidateQuietHours(managerInterfaces.QuietHours{
+		Start: "25:00",
+		End:   "07:00",
+	}), "invalid start [25:00]: parsing time \"25:00\": hour out of range")
+	assert.EqualError(t, ValidateQuietHours(managerInterfaces.QuietHours{
+		Start: "22:00",
+		End:   "07:00",
+		Mode:  "later",
+	}), "unrecognized mode [later]")
+}
+
+// Stands in for the deferred notifications table.
+type deferredNotifications struct {
+	notifications []models.DeferredNotification
+	nextID        uint
+}
+
+func newTestQuietHours(published *[]*admin.EmailMessage, publishErr *error,
+	deferred *deferredNotifications) (*QuietHours, *clock.Mock) {
+	var pub mocks.MockPublisher
+	pub.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		if *publishErr != nil {
+			return *publishErr
+		}
+		*published = append(*published, msg.(*admin.EmailMessage))
+		return nil
+	})
+	repository := repositoryMocks.NewMockRepository()
+	deferredNotificationRepo := repository.DeferredNotificationRepo().(*repositoryMocks.MockDeferredNotificationRepo)
+	deferredNotificationRepo.CreateFunction = func(ctx context.Context, input models.DeferredNotification) error {
+		deferred.nextID++
+		input.ID = deferred.nextID
+		deferred.notifications = append(deferred.notifications, input)
+		return nil
+	}
+	deferredNotificationRepo.ListForUpdateFunction = func(ctx context.Context) ([]models.DeferredNotification, error) {
+		return deferred.notifications, nil
+	}
+	deferredNotificationRepo.DeleteFunction = func(ctx context.Context, ids []uint) error {
+		removed := make(map[uint]bool)
+		for _, id := range ids {
+			removed[id] = true
+		}
+		var remaining []models.DeferredNotification
+		for _, notification := range deferred.notifications {
+			if !removed[notification.ID] {
+				remaining = append(remaining, notification)
+			}
+		}
+		deferred.notifications = remaining
+		return nil
+	}
+	quietHours := newQuietHours(&pub, repository, promutils.NewTestScope())
+	mockClock := clock.NewMock()
+	quietHours._clock = mockClock
+	return quietHours, mockClock
+}
+
+func TestQuietHours_Digests(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	var published []*admin.EmailMessage
+	var publishErr error
+	var deferred deferredNotifications
+	quietHours, mockClock := newTestQuietHours(&published, &publishErr, &deferred)
+	mockClock.Set(time.Date(2019, 6, 1, 23, 30, 0, 0, newYork))
+	ctx := context.Background()
+
+	attributes := getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeDefer)
+	assert.NoError(t, quietHours.Defer(ctx, "project", "domain", attributes, &admin.EmailMessage{
+		RecipientsEmail: []string{"b@example.com", "a@example.com"},
+		SenderEmail:     "flyte@example.com",
+		SubjectLine:     "first",
+		Body:            "first body",
+	}))
+	assert.NoError(t, quietHours.Defer(ctx, "project", "domain", attributes, &admin.EmailMessage{
+		RecipientsEmail: []string{"a@example.com", "b@example.com"},
+		SenderEmail:     "flyte@example.com",
+		SubjectLine:     "second",
+		Body:            "second body",
+	}))
+	assert.NoError(t, quietHours.Defer(ctx, "project", "domain", attributes, &admin.EmailMessage{
+		RecipientsEmail: []string{"c@example.com"},
+		SenderEmail:     "flyte@example.com",
+		SubjectLine:     "third",
+		Body:            "third body",
+	}))
+	assert.Len(t, deferred.notifications, 3)
+	assert.Equal(t, time.Date(2019, 6, 2, 7, 0, 0, 0, newYork), deferred.notifications[0].DueAt)
+
+	// Nothing is released while the window is still active.
+	assert.NoError(t, quietHours.PublishDigests(ctx))
+	assert.Empty(t, published)
+
+	mockClock.Set(time.Date(2019, 6, 2, 8, 0, 0, 0, newYork))
+	assert.NoError(t, quietHours.PublishDigests(ctx))
+	assert.Len(t, published, 2)
+	assert.Equal(t, "Flyte notification digest for project project and domain domain (2 notifications)",
+		published[0].SubjectLine)
+	assert.Contains(t, published[0].Body, "first body")
+	assert.Contains(t, published[0].Body, "second body")
+	assert.Equal(t, "flyte@example.com", published[0].SenderEmail)
+	assert.Equal(t, []string{"c@example.com"}, published[1].RecipientsEmail)
+	assert.Contains(t, published[1].Body, "third body")
+	assert.Empty(t, deferred.notifications)
+
+	// Digests are only sent once.
+	published = nil
+	assert.NoError(t, quietHours.PublishDigests(ctx))
+	assert.Empty(t, published)
+}
+
+func TestQuietHours_DigestsSurviveRestart(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	var published []*admin.EmailMessage
+	var publishErr error
+	var deferred deferredNotifications
+	quietHours, mockClock := newTestQuietHours(&published, &publishErr, &deferred)
+	mockClock.Set(time.Date(2019, 6, 1, 23, 30, 0, 0, newYork))
+	ctx := context.Background()
+
+	attributes := getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeDefer)
+	for _, domain := range []string{"development", "production"} {
+		assert.NoError(t, quietHours.Defer(ctx, "project", domain, attributes, &admin.EmailMessage{
+			RecipientsEmail: []string{"a@example.com"},
+			SubjectLine:     domain,
+			Body:            domain + " body",
+		}))
+	}
+
+	// Another replica sharing the database publishes what the first one deferred once the window ends, without
+	// waiting for another notification to be raised. Each domain gets its own digest.
+	restarted, restartedClock := newTestQuietHours(&published, &publishErr, &deferred)
+	restartedClock.Set(time.Date(2019, 6, 2, 7, 0, 0, 0, newYork))
+	assert.NoError(t, restarted.PublishDigests(ctx))
+	assert.Len(t, published, 2)
+	assert.Contains(t, published[0].Body, "development body")
+	assert.Contains(t, published[1].Body, "production body")
+}
+
+func TestQuietHours_DigestError(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	var published []*admin.EmailMessage
+	var publishErr error
+	var deferred deferredNotifications
+	quietHours, mockClock := newTestQuietHours(&published, &publishErr, &deferred)
+	mockClock.Set(time.Date(2019, 6, 1, 23, 30, 0, 0, newYork))
+	ctx := context.Background()
+
+	attributes := getQuietHoursAttributesForTest(managerInterfaces.QuietHoursModeDefer)
+	assert.NoError(t, quietHours.Defer(ctx, "project", "domain", attributes, &admin.EmailMessage{
+		RecipientsEmail: []string{"a@example.com"},
+		SubjectLine:     "first",
+		Body:            "first body",
+	}))
+
+	// Digests which failed to publish are kept and retried.
+	mockClock.Add(8 * time.Hour)
+	publishErr = errors.New("foo")
+	assert.Error(t, quietHours.PublishDigests(ctx))
+	assert.Len(t, deferred.notifications, 1)
+
+	publishErr = nil
+	assert.NoError(t, quietHours.PublishDigests(ctx))
+	assert.Len(t, published, 1)
+	assert.Empty(t, deferred.notifications)
+}
+
+func TestQuietHours_DeferInvalid(t *testing.T) {
+	var published []*admin.EmailMessage
+	var publishErr error
+	var deferred deferredNotifications
+	quietHours, _ := newTestQuietHours(&published, &publishErr, &deferred)
+	assert.Error(t, quietHours.Defer(context.Background(), "project", "domain", nil, &admin.EmailMessage{}))
+	assert.Empty(t, deferred.notifications)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/lyft/flyteadmin/pkg/async/schedule/aws/interfaces"
 	scheduleInterfaces "github.com/lyft/flyteadmin/pkg/async/schedule/interfaces"
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flytestdlib/logger"
 	"google.golang.org/grpc/codes"
@@ -31,6 +33,9 @@ const (
 	rateExpression = "rate(%v %s)"
 )
 
+// Fires at every minute of every hour matching the minutes field.
+const everyHourCronExpression = "%s * * * ? *"
+
 const timePlaceholder = "time"
 
 var timeValue = "$.time"
@@ -53,6 +58,9 @@ type cloudWatchSchedulerMetrics struct {
 	RemoveTargetDoesntExist prometheus.Counter
 	RemovedSchedules        prometheus.Counter
 
+	PauseRuleFailures  prometheus.Counter
+	ResumeRuleFailures prometheus.Counter
+
 	ActiveSchedules prometheus.Gauge
 }
 
@@ -78,7 +86,37 @@ func getScheduleDescription(identifier admin.NamedEntityIdentifier) string {
 		identifier.Project, identifier.Domain, identifier.Name)
 }
 
+// Returns whether the location's UTC offset is a whole number of hours throughout the year.
+func hasWholeHourOffsets(location *time.Location) bool {
+	year := time.Now().Year()
+	for _, month := range []time.Month{time.January, time.July} {
+		_, offset := time.Date(year, month, 1, 0, 0, 0, 0, location).Zone()
+		if offset%int(time.Hour/time.Second) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// CloudWatch only evaluates cron expressions in UTC, and a fixed conversion to UTC breaks whenever daylight saving time
+// starts or ends. Cron expressions with a timezone are instead registered to fire every hour at the minutes they
+// could match, and the workflow executor drops the events which don't match the expression in its timezone.
+func getTimezoneCronExpression(expression string) (string, error) {
+	expression, location, err := executions.ParseCronTimezone(expression)
+	if err != nil {
+		return "", err
+	}
+	minutes := "*"
+	if fields := strings.Fields(expression); len(fields) > 0 && hasWholeHourOffsets(location) {
+		minutes = fields[0]
+	}
+	return fmt.Sprintf(cronExpression, fmt.Sprintf(everyHourCronExpression, minutes)), nil
+}
+
 func getScheduleExpression(schedule admin.Schedule) (string, error) {
+	if executions.HasCronTimezone(schedule.GetCronExpression()) {
+		return getTimezoneCronExpression(schedule.GetCronExpression())
+	}
 	if schedule.GetCronExpression() != "" {
 		return fmt.Sprintf(cronExpression, schedule.GetCronExpression()), nil
 	}
@@ -223,6 +261,36 @@ func (s *cloudWatchScheduler) RemoveSchedule(ctx context.Context, identifier adm
 	return nil
 }
 
+func (s *cloudWatchScheduler) PauseSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+	name := getScheduleName(identifier)
+	// Output from the call to DisableRule is an empty struct.
+	_, err := s.cloudWatchEventClient.DisableRule(&cloudwatchevents.DisableRuleInput{
+		Name: &name,
+	})
+	if err != nil {
+		s.metrics.PauseRuleFailures.Inc()
+		logger.Errorf(ctx, "failed to disable cloudwatch rule %s with err: %v", name, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to disable cloudwatch rule %s with err: %v", name, err)
+	}
+	logger.Debugf(ctx, "Paused schedule %s for identifier [%+v]", name, identifier)
+	return nil
+}
+
+func (s *cloudWatchScheduler) ResumeSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+	name := getScheduleName(identifier)
+	// Output from the call to EnableRule is an empty struct.
+	_, err := s.cloudWatchEventClient.EnableRule(&cloudwatchevents.EnableRuleInput{
+		Name: &name,
+	})
+	if err != nil {
+		s.metrics.ResumeRuleFailures.Inc()
+		logger.Errorf(ctx, "failed to enable cloudwatch rule %s with err: %v", name, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to enable cloudwatch rule %s with err: %v", name, err)
+	}
+	logger.Debugf(ctx, "Resumed schedule %s for identifier [%+v]", name, identifier)
+	return nil
+}
+
 // Initializes a new set of metrics specific to the cloudwatch scheduler implementation.
 func newCloudWatchSchedulerMetrics(scope promutils.Scope) cloudWatchSchedulerMetrics {
 	return cloudWatchSchedulerMetrics{
@@ -244,6 +312,10 @@ func newCloudWatchSchedulerMetrics(scope promutils.Scope) cloudWatchSchedulerMet
 			"count of attempts to remove a cloudwatch target that doesn't exist"),
 		RemovedSchedules: scope.MustNewCounter("schedules_removed",
 			"count of all schedules successfully removed from cloudwatch"),
+		PauseRuleFailures: scope.MustNewCounter("disable_rule_failures",
+			"count of attempts to disable a cloudwatch rule that have failed"),
+		ResumeRuleFailures: scope.MustNewCounter("enable_rule_failures",
+			"count of attempts to enable a cloudwatch rule that have failed"),
 		ActiveSchedules: scope.MustNewGauge("active_schedules",
 			"count of all active schedules currently in cloudwatch"),
 	}
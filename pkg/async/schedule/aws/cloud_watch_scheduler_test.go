@@ -83,6 +83,32 @@ func TestGetScheduleExpression(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
 }
 
+func TestGetScheduleExpression_CronTimezone(t *testing.T) {
+	expression, err := getScheduleExpression(admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronExpression{
+			CronExpression: "CRON_TZ=America/New_York 0/15 9 ? * MON-FRI *",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "cron(0/15 * * * ? *)", expression)
+
+	// The minutes of the expression don't carry over to UTC for timezones offset by a fraction of an hour.
+	expression, err = getScheduleExpression(admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronExpression{
+			CronExpression: "CRON_TZ=Asia/Kolkata 0 9 ? * MON-FRI *",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "cron(* * * * ? *)", expression)
+
+	_, err = getScheduleExpression(admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronExpression{
+			CronExpression: "CRON_TZ=Mars/Olympus_Mons 0 9 ? * MON-FRI *",
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestFormatEventScheduleInputs(t *testing.T) {
 	inputTransformer := formatEventScheduleInputs(&testSerializedPayload)
 	assert.EqualValues(t, map[string]*string{
@@ -270,3 +296,47 @@ func TestRemoveSchedule_InvalidRule(t *testing.T) {
 	err := scheduler.RemoveSchedule(context.Background(), testSchedulerIdentifier)
 	assert.Nil(t, err)
 }
+
+func TestPauseSchedule(t *testing.T) {
+	mockCloudWatchEventClient := mocks.NewMockCloudWatchEventClient()
+	mockCloudWatchEventClient.(*mocks.MockCloudWatchEventClient).SetDisableRuleFunc(func(
+		input *cloudwatchevents.DisableRuleInput) (*cloudwatchevents.DisableRuleOutput, error) {
+		assert.Equal(t, testScheduleName, *input.Name)
+		return &cloudwatchevents.DisableRuleOutput{}, nil
+	})
+	scheduler := getCloudWatchSchedulerForTest(mockCloudWatchEventClient)
+	assert.Nil(t, scheduler.PauseSchedule(context.Background(), testSchedulerIdentifier))
+}
+
+func TestPauseSchedule_DisableRuleError(t *testing.T) {
+	mockCloudWatchEventClient := mocks.NewMockCloudWatchEventClient()
+	mockCloudWatchEventClient.(*mocks.MockCloudWatchEventClient).SetDisableRuleFunc(func(
+		input *cloudwatchevents.DisableRuleInput) (*cloudwatchevents.DisableRuleOutput, error) {
+		return nil, expectedError
+	})
+	scheduler := getCloudWatchSchedulerForTest(mockCloudWatchEventClient)
+	err := scheduler.PauseSchedule(context.Background(), testSchedulerIdentifier)
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestResumeSchedule(t *testing.T) {
+	mockCloudWatchEventClient := mocks.NewMockCloudWatchEventClient()
+	mockCloudWatchEventClient.(*mocks.MockCloudWatchEventClient).SetEnableRuleFunc(func(
+		input *cloudwatchevents.EnableRuleInput) (*cloudwatchevents.EnableRuleOutput, error) {
+		assert.Equal(t, testScheduleName, *input.Name)
+		return &cloudwatchevents.EnableRuleOutput{}, nil
+	})
+	scheduler := getCloudWatchSchedulerForTest(mockCloudWatchEventClient)
+	assert.Nil(t, scheduler.ResumeSchedule(context.Background(), testSchedulerIdentifier))
+}
+
+func TestResumeSchedule_EnableRuleError(t *testing.T) {
+	mockCloudWatchEventClient := mocks.NewMockCloudWatchEventClient()
+	mockCloudWatchEventClient.(*mocks.MockCloudWatchEventClient).SetEnableRuleFunc(func(
+		input *cloudwatchevents.EnableRuleInput) (*cloudwatchevents.EnableRuleOutput, error) {
+		return nil, expectedError
+	})
+	scheduler := getCloudWatchSchedulerForTest(mockCloudWatchEventClient)
+	err := scheduler.ResumeSchedule(context.Background(), testSchedulerIdentifier)
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -8,4 +8,6 @@ type CloudWatchEventClient interface {
 	PutTargets(input *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error)
 	DeleteRule(input *cloudwatchevents.DeleteRuleInput) (*cloudwatchevents.DeleteRuleOutput, error)
 	RemoveTargets(input *cloudwatchevents.RemoveTargetsInput) (*cloudwatchevents.RemoveTargetsOutput, error)
+	DisableRule(input *cloudwatchevents.DisableRuleInput) (*cloudwatchevents.DisableRuleOutput, error)
+	EnableRule(input *cloudwatchevents.EnableRuleInput) (*cloudwatchevents.EnableRuleOutput, error)
 }
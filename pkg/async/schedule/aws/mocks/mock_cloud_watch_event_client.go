@@ -9,6 +9,8 @@ type putRuleFunc func(input *cloudwatchevents.PutRuleInput) (*cloudwatchevents.P
 type putTargetsFunc func(input *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error)
 type deleteRuleFunc func(input *cloudwatchevents.DeleteRuleInput) (*cloudwatchevents.DeleteRuleOutput, error)
 type removeTargetsFunc func(input *cloudwatchevents.RemoveTargetsInput) (*cloudwatchevents.RemoveTargetsOutput, error)
+type disableRuleFunc func(input *cloudwatchevents.DisableRuleInput) (*cloudwatchevents.DisableRuleOutput, error)
+type enableRuleFunc func(input *cloudwatchevents.EnableRuleInput) (*cloudwatchevents.EnableRuleOutput, error)
 
 // A mock implementation of CloudWatchEventClient for use in tests.
 type MockCloudWatchEventClient struct {
@@ -16,6 +18,8 @@ type MockCloudWatchEventClient struct {
 	putTargets    putTargetsFunc
 	deleteRule    deleteRuleFunc
 	removeTargets removeTargetsFunc
+	disableRule   disableRuleFunc
+	enableRule    enableRuleFunc
 }
 
 func (c *MockCloudWatchEventClient) SetPutRuleFunc(putRule putRuleFunc) {
@@ -66,6 +70,30 @@ func (c *MockCloudWatchEventClient) RemoveTargets(input *cloudwatchevents.Remove
 	return nil, nil
 }
 
+func (c *MockCloudWatchEventClient) SetDisableRuleFunc(disableRule disableRuleFunc) {
+	c.disableRule = disableRule
+}
+
+func (c *MockCloudWatchEventClient) DisableRule(input *cloudwatchevents.DisableRuleInput) (
+	*cloudwatchevents.DisableRuleOutput, error) {
+	if c.disableRule != nil {
+		return c.disableRule(input)
+	}
+	return nil, nil
+}
+
+func (c *MockCloudWatchEventClient) SetEnableRuleFunc(enableRule enableRuleFunc) {
+	c.enableRule = enableRule
+}
+
+func (c *MockCloudWatchEventClient) EnableRule(input *cloudwatchevents.EnableRuleInput) (
+	*cloudwatchevents.EnableRuleOutput, error) {
+	if c.enableRule != nil {
+		return c.enableRule(input)
+	}
+	return nil, nil
+}
+
 func NewMockCloudWatchEventClient() interfaces.CloudWatchEventClient {
 	return &MockCloudWatchEventClient{}
 }
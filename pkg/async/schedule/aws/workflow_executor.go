@@ -16,6 +16,7 @@ import (
 	"github.com/lyft/flytestdlib/promutils/labeled"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	scheduleInterfaces "github.com/lyft/flyteadmin/pkg/async/schedule/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
@@ -23,6 +24,8 @@ import (
 	"github.com/NYTimes/gizmo/pubsub"
 	"github.com/NYTimes/gizmo/pubsub/aws"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flytestdlib/logger"
@@ -37,6 +40,9 @@ type workflowExecutorMetrics struct {
 	FailedMarkMessageAsDone             prometheus.Counter
 	FailedResolveKickoffTimeArg         prometheus.Counter
 	FailedKickoffExecution              prometheus.Counter
+	FailedRenderExecutionName           prometheus.Counter
+	ExecutionNameCollisions             prometheus.Counter
+	ScheduledEventsSkipped              prometheus.Counter
 	ScheduledEventsProcessed            prometheus.Counter
 	ScheduledExecutionSystemDelay       labeled.StopWatch
 	MessageReceivedDelay                labeled.StopWatch
@@ -53,6 +59,11 @@ type workflowExecutor struct {
 
 const workflowIdentifierFmt = "%s_%s_%s"
 
+// Bounds how many sequence numbers are tried for an execution name template before falling back to a generated name.
+const maxExecutionNameTemplateAttempts = 10
+
+const executionNameField = "name"
+
 var activeLaunchPlanFilter = fmt.Sprintf("eq(state,%d)", int32(admin.LaunchPlanState_ACTIVE))
 
 var timeout = int64(5)
@@ -132,6 +143,85 @@ func generateExecutionName(launchPlan admin.LaunchPlan, kickoffTime time.Time) s
 	return common.GetExecutionName(randomSeed)
 }
 
+// A scheduled event may be delivered more than once, so a name collision with an execution launched by this launch
+// plan for the same kickoff time means the execution was already created rather than that the name is taken.
+func (e *workflowExecutor) isDuplicateExecution(
+	ctx context.Context, executionRequest admin.ExecutionCreateRequest) (bool, error) {
+	execution, err := e.executionManager.GetExecution(ctx, admin.WorkflowExecutionGetRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: executionRequest.Project,
+			Domain:  executionRequest.Domain,
+			Name:    executionRequest.Name,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	launchPlan := execution.GetSpec().GetLaunchPlan()
+	return launchPlan.GetProject() == executionRequest.Spec.LaunchPlan.Project &&
+		launchPlan.GetDomain() == executionRequest.Spec.LaunchPlan.Domain &&
+		launchPlan.GetName() == executionRequest.Spec.LaunchPlan.Name &&
+		proto.Equal(execution.GetSpec().GetMetadata().GetScheduledAt(), executionRequest.Spec.Metadata.ScheduledAt), nil
+}
+
+// Creates a scheduled execution named after the launch plan execution name template, if it defines one. Names which
+// collide with other executions are disambiguated using the template sequence. Should no valid, unused name be found
+// the execution is created using the generated name instead.
+func (e *workflowExecutor) createExecution(
+	ctx context.Context, launchPlan admin.LaunchPlan, executionRequest admin.ExecutionCreateRequest,
+	kickoffTime time.Time) (*admin.ExecutionCreateResponse, error) {
+	template := common.GetExecutionNameTemplate(launchPlan.Spec)
+	if template == "" {
+		return e.executionManager.CreateExecution(ctx, executionRequest, kickoffTime)
+	}
+	generatedName := executionRequest.Name
+	for sequence := 1; sequence <= maxExecutionNameTemplateAttempts; sequence++ {
+		name, err := common.RenderExecutionNameTemplate(template, common.ExecutionNameTemplateInput{
+			LaunchPlan:  launchPlan.Id,
+			KickoffTime: kickoffTime,
+			Sequence:    sequence,
+		})
+		if err == nil {
+			err = validation.CheckValidExecutionID(name, executionNameField)
+		}
+		if err != nil {
+			e.metrics.FailedRenderExecutionName.Inc()
+			logger.Warningf(ctx, "failed to render execution name template [%s] for launch plan [%+v] with err: %v",
+				template, launchPlan.Id, err)
+			break
+		}
+		executionRequest.Name = name
+		response, err := e.executionManager.CreateExecution(ctx, executionRequest, kickoffTime)
+		if ec, ok := err.(errors.FlyteAdminError); !ok || ec.Code() != codes.AlreadyExists {
+			return response, err
+		}
+		duplicate, getErr := e.isDuplicateExecution(ctx, executionRequest)
+		if getErr != nil || duplicate {
+			return nil, err
+		}
+		e.metrics.ExecutionNameCollisions.Inc()
+		logger.Debugf(ctx, "execution name [%s] for launch plan [%+v] is already taken", name, launchPlan.Id)
+	}
+	executionRequest.Name = generatedName
+	return e.executionManager.CreateExecution(ctx, executionRequest, kickoffTime)
+}
+
+// Cron schedules with a timezone fire more often in CloudWatch than their expression does (see
+// getTimezoneCronExpression), so only kickoff times which match the expression in its timezone launch executions.
+func (e *workflowExecutor) isScheduledKickoffTime(launchPlan admin.LaunchPlan, kickoffTime time.Time) bool {
+	expression := launchPlan.GetSpec().GetEntityMetadata().GetSchedule().GetCronExpression()
+	if !executions.HasCronTimezone(expression) {
+		return true
+	}
+	isTick, err := executions.IsCronScheduleTick(expression, kickoffTime)
+	if err != nil {
+		logger.Warningf(context.Background(), "failed to evaluate cron expression [%s] for launch plan [%+v] with err: %v",
+			expression, launchPlan.Id, err)
+		return false
+	}
+	return isTick
+}
+
 func (e *workflowExecutor) formulateExecutionCreateRequest(
 	launchPlan admin.LaunchPlan, kickoffTime time.Time) admin.ExecutionCreateRequest {
 	// Deterministically assign a name based on the schedule kickoff time/launch plan definition.
@@ -197,6 +287,18 @@ func (e *workflowExecutor) Run() {
 			}
 			continue
 		}
+		if !e.isScheduledKickoffTime(launchPlan, scheduledWorkflowExecutionRequest.KickoffTime) {
+			logger.Debugf(context.Background(),
+				"kickoff time [%v] doesn't match the schedule of launch plan [%+v], removing scheduled event message "+
+					"without triggering execution", scheduledWorkflowExecutionRequest.KickoffTime, launchPlan.Id)
+			e.metrics.ScheduledEventsSkipped.Inc()
+			if err = message.Done(); err != nil {
+				e.metrics.FailedMarkMessageAsDone.Inc()
+				logger.Warningf(context.Background(),
+					"failed to delete skipped scheduled event from the queue with err: %v", err)
+			}
+			continue
+		}
 		executionRequest := e.formulateExecutionCreateRequest(launchPlan, scheduledWorkflowExecutionRequest.KickoffTime)
 
 		ctx = contextutils.WithWorkflowID(ctx, fmt.Sprintf(workflowIdentifierFmt, executionRequest.Project,
@@ -210,8 +312,8 @@ func (e *workflowExecutor) Run() {
 		e.metrics.ScheduledEventProcessingDelay.Observe(ctx, scheduledWorkflowExecutionRequest.KickoffTime, time.Now())
 		var response *admin.ExecutionCreateResponse
 		e.metrics.CreateExecutionDuration.Time(ctx, func() {
-			response, err = e.executionManager.CreateExecution(
-				context.Background(), executionRequest, scheduledWorkflowExecutionRequest.KickoffTime)
+			response, err = e.createExecution(
+				context.Background(), launchPlan, executionRequest, scheduledWorkflowExecutionRequest.KickoffTime)
 		})
 
 		if err != nil {
@@ -270,6 +372,12 @@ func newWorkflowExecutorMetrics(scope promutils.Scope) workflowExecutorMetrics {
 			"count of failures resolving the kickoff time argument"),
 		FailedKickoffExecution: scope.MustNewCounter("workflow_execution_kickoff_failures",
 			"count of failures kicking-off workflow execution"),
+		FailedRenderExecutionName: scope.MustNewCounter("execution_name_render_failures",
+			"count of failures rendering a valid execution name from a launch plan execution name template"),
+		ExecutionNameCollisions: scope.MustNewCounter("execution_name_collisions",
+			"count of rendered execution names already taken by other executions"),
+		ScheduledEventsSkipped: scope.MustNewCounter("scheduled_events_skipped",
+			"count of schedule events dropped because their kickoff time doesn't match a cron schedule with a timezone"),
 		ScheduledEventsProcessed: scope.MustNewCounter("scheduled_events_processed",
 			"total number of schedule events successfully processed"),
 		ScheduledExecutionSystemDelay: labeled.NewStopWatch("schedule_execution_delay",
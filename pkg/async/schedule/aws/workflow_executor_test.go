@@ -13,6 +13,7 @@ import (
 
 	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
 	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/common"
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/mocks"
 	"github.com/stretchr/testify/assert"
@@ -127,6 +128,36 @@ func TestResolveKickoffTimeArg_NoKickoffTimeArg(t *testing.T) {
 	assert.NotContains(t, executionRequest.Inputs.Literals, testKickoffTime)
 }
 
+func getLaunchPlanWithCronSchedule(expression string) admin.LaunchPlan {
+	return admin.LaunchPlan{
+		Spec: &admin.LaunchPlanSpec{
+			EntityMetadata: &admin.LaunchPlanMetadata{
+				Schedule: &admin.Schedule{
+					ScheduleExpression: &admin.Schedule_CronExpression{
+						CronExpression: expression,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIsScheduledKickoffTime(t *testing.T) {
+	testExecutor := newWorkflowExecutorForTest(nil, nil, nil)
+	// Schedules without a timezone only fire when CloudWatch says they do.
+	assert.True(t, testExecutor.isScheduledKickoffTime(
+		getLaunchPlanWithCronSchedule("0 0 1 1 ? 2000"), testKickoffTimestamp))
+	assert.True(t, testExecutor.isScheduledKickoffTime(admin.LaunchPlan{}, testKickoffTimestamp))
+
+	// The test kickoff time is 13:43 in New York.
+	assert.True(t, testExecutor.isScheduledKickoffTime(
+		getLaunchPlanWithCronSchedule("CRON_TZ=America/New_York 43 13 * * ? *"), testKickoffTimestamp))
+	assert.False(t, testExecutor.isScheduledKickoffTime(
+		getLaunchPlanWithCronSchedule("CRON_TZ=America/New_York 43 18 * * ? *"), testKickoffTimestamp))
+	assert.False(t, testExecutor.isScheduledKickoffTime(
+		getLaunchPlanWithCronSchedule("CRON_TZ=America/New_York 43 13 L * ? *"), testKickoffTimestamp))
+}
+
 func TestGetActiveLaunchPlanVersion(t *testing.T) {
 	launchPlanNamedIdentifier := &admin.NamedEntityIdentifier{
 		Project: "project",
@@ -209,6 +240,110 @@ func TestFormulateExecutionCreateRequest(t *testing.T) {
 	assert.Equal(t, int64(1543607788), executionRequest.Spec.Metadata.ScheduledAt.Seconds)
 }
 
+func getLaunchPlanWithExecutionNameTemplate(template string) admin.LaunchPlan {
+	return admin.LaunchPlan{
+		Id: &core.Identifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "report",
+			Version: "version",
+		},
+		Spec: &admin.LaunchPlanSpec{
+			Annotations: &admin.Annotations{
+				Values: map[string]string{
+					common.ExecutionNameTemplateAnnotation: template,
+				},
+			},
+		},
+	}
+}
+
+func TestCreateExecution_ExecutionNameTemplate(t *testing.T) {
+	launchPlan := getLaunchPlanWithExecutionNameTemplate("{lp_name}-{kickoff_date}-{seq}")
+	testExecutor := newWorkflowExecutorForTest(nil, nil, nil)
+	executionRequest := testExecutor.formulateExecutionCreateRequest(launchPlan, testKickoffTimestamp)
+	otherKickoffTime, _ := ptypes.TimestampProto(testKickoffTimestamp.Add(-time.Hour))
+
+	testExecutionManager := mocks.MockExecutionManager{}
+	var createdNames []string
+	testExecutionManager.SetCreateCallback(func(
+		ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+		*admin.ExecutionCreateResponse, error) {
+		createdNames = append(createdNames, request.Name)
+		if request.Name == "report-20171222-1" {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+		}
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+	testExecutionManager.SetGetCallback(func(
+		ctx context.Context, request admin.WorkflowExecutionGetRequest) (*admin.Execution, error) {
+		assert.Equal(t, "report-20171222-1", request.Id.Name)
+		return &admin.Execution{
+			Spec: &admin.ExecutionSpec{
+				LaunchPlan: launchPlan.Id,
+				Metadata: &admin.ExecutionMetadata{
+					ScheduledAt: otherKickoffTime,
+				},
+			},
+		}, nil
+	})
+	testExecutor.executionManager = &testExecutionManager
+
+	_, err := testExecutor.createExecution(context.Background(), launchPlan, executionRequest, testKickoffTimestamp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"report-20171222-1", "report-20171222-2"}, createdNames)
+}
+
+func TestCreateExecution_ExecutionNameTemplateDuplicate(t *testing.T) {
+	launchPlan := getLaunchPlanWithExecutionNameTemplate("{lp_name}-{kickoff_date}")
+	testExecutor := newWorkflowExecutorForTest(nil, nil, nil)
+	executionRequest := testExecutor.formulateExecutionCreateRequest(launchPlan, testKickoffTimestamp)
+
+	testExecutionManager := mocks.MockExecutionManager{}
+	var createdNames []string
+	testExecutionManager.SetCreateCallback(func(
+		ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+		*admin.ExecutionCreateResponse, error) {
+		createdNames = append(createdNames, request.Name)
+		return nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+	})
+	testExecutionManager.SetGetCallback(func(
+		ctx context.Context, request admin.WorkflowExecutionGetRequest) (*admin.Execution, error) {
+		return &admin.Execution{
+			Spec: &admin.ExecutionSpec{
+				LaunchPlan: launchPlan.Id,
+				Metadata: &admin.ExecutionMetadata{
+					ScheduledAt: protoTestTimestamp,
+				},
+			},
+		}, nil
+	})
+	testExecutor.executionManager = &testExecutionManager
+
+	_, err := testExecutor.createExecution(context.Background(), launchPlan, executionRequest, testKickoffTimestamp)
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Equal(t, []string{"report-20171222"}, createdNames)
+}
+
+func TestCreateExecution_InvalidExecutionNameTemplate(t *testing.T) {
+	launchPlan := getLaunchPlanWithExecutionNameTemplate("{project}-{domain}-{lp_name}-{kickoff_date}")
+	testExecutor := newWorkflowExecutorForTest(nil, nil, nil)
+	executionRequest := testExecutor.formulateExecutionCreateRequest(launchPlan, testKickoffTimestamp)
+	generatedName := executionRequest.Name
+
+	testExecutionManager := mocks.MockExecutionManager{}
+	testExecutionManager.SetCreateCallback(func(
+		ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+		*admin.ExecutionCreateResponse, error) {
+		assert.Equal(t, generatedName, request.Name)
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+	testExecutor.executionManager = &testExecutionManager
+
+	_, err := testExecutor.createExecution(context.Background(), launchPlan, executionRequest, testKickoffTimestamp)
+	assert.Nil(t, err)
+}
+
 func TestRun(t *testing.T) {
 	launchPlanIdentifier := &admin.NamedEntityIdentifier{
 		Project: "project",
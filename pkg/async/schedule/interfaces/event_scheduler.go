@@ -22,4 +22,10 @@ type EventScheduler interface {
 
 	// Removes an existing schedule.
 	RemoveSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error
+
+	// Stops an existing schedule from firing events until it's resumed.
+	PauseSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error
+
+	// Resumes firing events for a paused schedule.
+	ResumeSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error
 }
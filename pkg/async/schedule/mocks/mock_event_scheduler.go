@@ -10,9 +10,13 @@ import (
 
 type AddScheduleFunc func(ctx context.Context, input interfaces.AddScheduleInput) error
 type RemoveScheduleFunc func(ctx context.Context, identifier admin.NamedEntityIdentifier) error
+type PauseScheduleFunc func(ctx context.Context, identifier admin.NamedEntityIdentifier) error
+type ResumeScheduleFunc func(ctx context.Context, identifier admin.NamedEntityIdentifier) error
 type MockEventScheduler struct {
 	addScheduleFunc    AddScheduleFunc
 	removeScheduleFunc RemoveScheduleFunc
+	pauseScheduleFunc  PauseScheduleFunc
+	resumeScheduleFunc ResumeScheduleFunc
 }
 
 func (s *MockEventScheduler) AddSchedule(ctx context.Context, input interfaces.AddScheduleInput) error {
@@ -37,6 +41,28 @@ func (s *MockEventScheduler) SetRemoveScheduleFunc(removeScheduleFunc RemoveSche
 	s.removeScheduleFunc = removeScheduleFunc
 }
 
+func (s *MockEventScheduler) PauseSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+	if s.pauseScheduleFunc != nil {
+		return s.pauseScheduleFunc(ctx, identifier)
+	}
+	return nil
+}
+
+func (s *MockEventScheduler) SetPauseScheduleFunc(pauseScheduleFunc PauseScheduleFunc) {
+	s.pauseScheduleFunc = pauseScheduleFunc
+}
+
+func (s *MockEventScheduler) ResumeSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+	if s.resumeScheduleFunc != nil {
+		return s.resumeScheduleFunc(ctx, identifier)
+	}
+	return nil
+}
+
+func (s *MockEventScheduler) SetResumeScheduleFunc(resumeScheduleFunc ResumeScheduleFunc) {
+	s.resumeScheduleFunc = resumeScheduleFunc
+}
+
 func NewMockEventScheduler() interfaces.EventScheduler {
 	return &MockEventScheduler{}
 }
@@ -25,6 +25,18 @@ func (s *EventScheduler) RemoveSchedule(ctx context.Context, identifier admin.Na
 	return nil
 }
 
+func (s *EventScheduler) PauseSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+	logger.Debugf(ctx, "Received call to pause schedule [%+v]", identifier)
+	logger.Debug(ctx, "Not scheduling anything")
+	return nil
+}
+
+func (s *EventScheduler) ResumeSchedule(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+	logger.Debugf(ctx, "Received call to resume schedule [%+v]", identifier)
+	logger.Debug(ctx, "Not scheduling anything")
+	return nil
+}
+
 func NewNoopEventScheduler() interfaces.EventScheduler {
 	return &EventScheduler{}
 }
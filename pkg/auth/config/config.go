@@ -51,9 +51,17 @@ type OAuthOptions struct {
 	// name. Instead, there is a gRPC interceptor, GetAuthenticationCustomMetadataInterceptor, that will translate
 	// incoming metadata headers with this config setting's name, into that standard header
 	GrpcAuthorizationHeader string `json:"grpcAuthorizationHeader"`
+
+	// The subjects of the callers allowed to change how the service itself runs, e.g. to register domains or switch
+	// read-only mode on. No caller is allowed to when none are listed.
+	Operators []string `json:"operators"`
 }
 
 type Claims struct {
 	Audience string `json:"aud"`
 	Issuer   string `json:"iss"`
+	// The name of the token claim listing the projects the caller is entitled to, either as an array or as a
+	// comma-separated string. When set, list APIs only serve the projects the caller is entitled to. Otherwise any
+	// authenticated caller can list every project.
+	Projects string `json:"projects"`
 }
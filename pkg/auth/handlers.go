@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/handlers"
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -20,9 +21,10 @@ import (
 )
 
 const (
-	LoginRedirectURLParameter                  = "redirect_url"
-	bearerTokenContextKey     contextutils.Key = "bearer"
-	emailContextKey           contextutils.Key = "email"
+	LoginRedirectURLParameter                     = "redirect_url"
+	bearerTokenContextKey        contextutils.Key = "bearer"
+	emailContextKey              contextutils.Key = "email"
+	authorizedProjectsContextKey contextutils.Key = "authorized_projects"
 )
 
 type HTTPRequestToMetadataAnnotator func(ctx context.Context, request *http.Request) metadata.MD
@@ -159,13 +161,13 @@ func GetAuthenticationInterceptor(authContext interfaces.AuthenticationContext)
 		tokenStr, err := grpcauth.AuthFromMD(ctx, BearerScheme)
 		if err != nil {
 			logger.Debugf(ctx, "Could not retrieve bearer token from metadata %v", err)
-			return ctx, nil
+			return withoutTokenProjects(ctx, authContext.Claims()), nil
 		}
 
 		// Currently auth is optional...
 		if tokenStr == "" {
 			logger.Debugf(ctx, "Bearer token is empty, skipping parsing")
-			return ctx, nil
+			return withoutTokenProjects(ctx, authContext.Claims()), nil
 		}
 
 		// ...however, if there _is_ a bearer token, but there are additional errors downstream, then we return an
@@ -180,6 +182,10 @@ func GetAuthenticationInterceptor(authContext interfaces.AuthenticationContext)
 			return ctx, status.Errorf(codes.Unauthenticated, "no email or empty email found")
 		} else {
 			newCtx := WithUserEmail(context.WithValue(ctx, bearerTokenContextKey, tokenStr), token.Subject)
+			newCtx, err = withTokenProjects(newCtx, authContext.Claims(), token)
+			if err != nil {
+				return ctx, status.Errorf(codes.Unauthenticated, "invalid projects claim in token: %s", err)
+			}
 			return newCtx, nil
 		}
 	}
@@ -189,6 +195,12 @@ func WithUserEmail(ctx context.Context, email string) context.Context {
 	return context.WithValue(ctx, emailContextKey, email)
 }
 
+// Returns the authenticated identity attached to the request context, empty when the request wasn't authenticated.
+func GetUserEmail(ctx context.Context) string {
+	email, _ := ctx.Value(emailContextKey).(string)
+	return email
+}
+
 // This is effectively middleware for the grpc gateway, it allows us to modify the translation between HTTP request
 // and gRPC request. There are two potential sources for bearer tokens, it can come from an authorization header (not
 // yet implemented), or encrypted cookies. Note that when deploying behind Envoy, you have the option to look for a
@@ -209,6 +221,39 @@ func GetHTTPRequestCookieToMetadataHandler(authContext interfaces.Authentication
 	}
 }
 
+// Wraps an HTTP handler so that it's only served to requests carrying a valid access token, either as a bearer token in
+// the authorization header or in the encrypted token cookies set by the login flow.
+func RequireAuthentication(ctx context.Context, authCtx interfaces.AuthenticationContext,
+	handlerFunc http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var accessToken string
+		authorizationHeader := request.Header.Get(DefaultAuthorizationHeader)
+		if strings.HasPrefix(authorizationHeader, BearerScheme+" ") {
+			accessToken = strings.TrimPrefix(authorizationHeader, BearerScheme+" ")
+		} else {
+			accessToken, _, _ = authCtx.CookieManager().RetrieveTokenValues(ctx, request)
+		}
+		if accessToken == "" {
+			http.Error(writer, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+		token, err := ParseAndValidate(ctx, authCtx.Claims(), accessToken, authCtx.OidcProvider())
+		if err != nil || token == nil || token.Subject == "" {
+			logger.Infof(ctx, "Rejecting request to %s with invalid access token: %v", request.RequestURI, err)
+			http.Error(writer, "Invalid access token", http.StatusUnauthorized)
+			return
+		}
+		authenticatedCtx, err := withTokenProjects(
+			WithUserEmail(request.Context(), token.Subject), authCtx.Claims(), token)
+		if err != nil {
+			logger.Infof(ctx, "Rejecting request to %s with invalid projects claim: %v", request.RequestURI, err)
+			http.Error(writer, "Invalid access token", http.StatusUnauthorized)
+			return
+		}
+		handlerFunc(writer, request.WithContext(authenticatedCtx))
+	}
+}
+
 // TODO: Add this to the Admin service IDL in Flyte IDL so that this can be exposed from gRPC as well.
 // This returns a handler that will retrieve user info, from the OAuth2 authorization server.
 // See the OpenID Connect spec at https://openid.net/specs/openid-connect-core-1_0.html#UserInfoResponse for more information.
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/auth/interfaces"
+	"github.com/lyft/flytestdlib/logger"
+)
+
+// Wraps an HTTP handler, which must itself be wrapped by RequireAuthentication, so that requests changing anything
+// are only served to the configured operators. Requests with safe methods are served to any caller.
+func RequireOperator(ctx context.Context, authCtx interfaces.AuthenticationContext,
+	handlerFunc http.HandlerFunc) http.HandlerFunc {
+	operators := make(map[string]bool, len(authCtx.Options().Operators))
+	for _, operator := range authCtx.Options().Operators {
+		operators[operator] = true
+	}
+	return func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			if subject := GetUserEmail(request.Context()); !operators[subject] {
+				logger.Infof(ctx, "Rejecting %s request to %s by [%s] who isn't an operator",
+					request.Method, request.RequestURI, subject)
+				http.Error(writer, "Only operators are allowed to do this", http.StatusForbidden)
+				return
+			}
+		}
+		handlerFunc(writer, request)
+	}
+}
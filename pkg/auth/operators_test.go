@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lyft/flyteadmin/pkg/auth/config"
+	"github.com/lyft/flyteadmin/pkg/auth/interfaces/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireOperator(t *testing.T) {
+	mockAuthCtx := mocks.AuthenticationContext{}
+	mockAuthCtx.On("Options").Return(config.OAuthOptions{
+		Operators: []string{"operator@example.com"},
+	})
+	var served bool
+	handler := RequireOperator(context.Background(), &mockAuthCtx, func(http.ResponseWriter, *http.Request) {
+		served = true
+	})
+	serve := func(method, subject string) int {
+		served = false
+		request := httptest.NewRequest(method, "/api/v1/read_only_mode", nil)
+		request = request.WithContext(WithUserEmail(request.Context(), subject))
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		return writer.Code
+	}
+
+	t.Run("operator", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodPut, "operator@example.com"))
+		assert.True(t, served)
+	})
+	t.Run("non-operator", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPut, "user@example.com"))
+		assert.False(t, served)
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "user@example.com"))
+		assert.False(t, served)
+	})
+	t.Run("non-operator reading", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "user@example.com"))
+		assert.True(t, served)
+	})
+}
+
+func TestRequireOperator_NoneConfigured(t *testing.T) {
+	mockAuthCtx := mocks.AuthenticationContext{}
+	mockAuthCtx.On("Options").Return(config.OAuthOptions{})
+	handler := RequireOperator(context.Background(), &mockAuthCtx, func(http.ResponseWriter, *http.Request) {
+		assert.Fail(t, "request mustn't be served")
+	})
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/read_only_mode", nil)
+	request = request.WithContext(WithUserEmail(request.Context(), ""))
+	writer := httptest.NewRecorder()
+	handler(writer, request)
+	assert.Equal(t, http.StatusForbidden, writer.Code)
+}
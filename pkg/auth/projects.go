@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-oidc"
+
+	"github.com/lyft/flyteadmin/pkg/auth/config"
+)
+
+// Attaches the projects the caller is entitled to, which scope the results of list APIs.
+func WithAuthorizedProjects(ctx context.Context, projects []string) context.Context {
+	authorized := make(map[string]bool, len(projects))
+	for _, project := range projects {
+		authorized[project] = true
+	}
+	return context.WithValue(ctx, authorizedProjectsContextKey, authorized)
+}
+
+// Whether the caller is entitled to a project. Callers are entitled to every project unless the projects claim is
+// configured.
+func IsProjectAuthorized(ctx context.Context, project string) bool {
+	authorized, ok := ctx.Value(authorizedProjectsContextKey).(map[string]bool)
+	if !ok {
+		return true
+	}
+	return authorized[project]
+}
+
+// Reads the projects listed in a claim, either as an array of strings or as a comma-separated string. A missing claim
+// entitles the caller to no project.
+func getClaimedProjects(claims map[string]interface{}, name string) ([]string, error) {
+	switch value := claims[name].(type) {
+	case nil:
+		return []string{}, nil
+	case string:
+		projects := make([]string, 0)
+		for _, project := range strings.Split(value, ",") {
+			if project = strings.TrimSpace(project); len(project) > 0 {
+				projects = append(projects, project)
+			}
+		}
+		return projects, nil
+	case []interface{}:
+		projects := make([]string, len(value))
+		for idx, item := range value {
+			project, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("claim [%s] lists %v which isn't a project name", name, item)
+			}
+			projects[idx] = project
+		}
+		return projects, nil
+	default:
+		return nil, fmt.Errorf("claim [%s] of type %T doesn't list projects", name, value)
+	}
+}
+
+// Attaches the projects claimed by a validated token, when the projects claim is configured.
+func withTokenProjects(ctx context.Context, claims config.Claims, token *oidc.IDToken) (context.Context, error) {
+	if len(claims.Projects) == 0 {
+		return ctx, nil
+	}
+	var tokenClaims map[string]interface{}
+	if err := token.Claims(&tokenClaims); err != nil {
+		return ctx, err
+	}
+	projects, err := getClaimedProjects(tokenClaims, claims.Projects)
+	if err != nil {
+		return ctx, err
+	}
+	return WithAuthorizedProjects(ctx, projects), nil
+}
+
+// Unauthenticated callers aren't entitled to any project when the projects claim is configured.
+func withoutTokenProjects(ctx context.Context, claims config.Claims) context.Context {
+	if len(claims.Projects) == 0 {
+		return ctx
+	}
+	return WithAuthorizedProjects(ctx, nil)
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/auth/config"
+)
+
+func TestIsProjectAuthorized(t *testing.T) {
+	assert.True(t, IsProjectAuthorized(context.Background(), "project"))
+
+	ctx := WithAuthorizedProjects(context.Background(), []string{"project"})
+	assert.True(t, IsProjectAuthorized(ctx, "project"))
+	assert.False(t, IsProjectAuthorized(ctx, "other"))
+
+	ctx = withoutTokenProjects(context.Background(), config.Claims{Projects: "projects"})
+	assert.False(t, IsProjectAuthorized(ctx, "project"))
+	ctx = withoutTokenProjects(context.Background(), config.Claims{})
+	assert.True(t, IsProjectAuthorized(ctx, "project"))
+}
+
+func TestGetClaimedProjects(t *testing.T) {
+	projects, err := getClaimedProjects(map[string]interface{}{
+		"projects": []interface{}{"flytesnacks", "flytekit"},
+	}, "projects")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"flytesnacks", "flytekit"}, projects)
+
+	projects, err = getClaimedProjects(map[string]interface{}{"projects": "flytesnacks, flytekit,"}, "projects")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"flytesnacks", "flytekit"}, projects)
+
+	projects, err = getClaimedProjects(map[string]interface{}{}, "projects")
+	assert.NoError(t, err)
+	assert.Empty(t, projects)
+
+	_, err = getClaimedProjects(map[string]interface{}{"projects": []interface{}{"flytesnacks", 1}}, "projects")
+	assert.Error(t, err)
+	_, err = getClaimedProjects(map[string]interface{}{"projects": true}, "projects")
+	assert.Error(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
@@ -45,6 +46,9 @@ const replaceAllInstancesOfString = -1
 // in the execution kubernetes cluster.
 type Controller interface {
 	Sync(ctx context.Context) error
+	// Applies the resource templates to the namespaces of a single project, e.g. as soon as it's registered rather
+	// than on the next sync.
+	SyncProject(ctx context.Context, project string) error
 	Run()
 }
 
@@ -77,6 +81,8 @@ type controller struct {
 	lastAppliedTemplateDir string
 	// Map of [namespace -> [templateFileName -> last modified time]]
 	appliedTemplates NamespaceCache
+	// Guards appliedTemplates since single projects may be synced concurrently with the sync loop.
+	syncLock sync.Mutex
 }
 
 var descCreatedAtSortParam, _ = common.NewSortParameter(admin.Sort{
@@ -322,6 +328,53 @@ func (c *controller) syncNamespace(ctx context.Context, namespace NamespaceName,
 	return nil
 }
 
+// Resolves the configured template values and the domain-specific defaults.
+func (c *controller) getTemplateValues(ctx context.Context) (
+	templateValuesType, map[string]templateValuesType, []error) {
+	var errs = make([]error, 0)
+	templateValues, err := populateTemplateValues(c.config.ClusterResourceConfiguration().GetTemplateData())
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get templatized values specified in config: %v", err)
+		errs = append(errs, err)
+	}
+	domainTemplateValues, err := populateDefaultTemplateValues(c.config.ClusterResourceConfiguration().GetCustomTemplateData())
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get domain-specific templatized values specified in config: %v", err)
+		errs = append(errs, err)
+	}
+	return templateValues, domainTemplateValues, errs
+}
+
+func (c *controller) syncProject(ctx context.Context, project string, templateValues templateValuesType,
+	domainTemplateValues map[string]templateValuesType) []error {
+	domains, err := repositories.GetDomains(ctx, c.db, c.config.ApplicationConfiguration())
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get the domains to sync project [%s] in with err: %v", project, err)
+		return []error{err}
+	}
+	var errs = make([]error, 0)
+	for _, domain := range domains {
+		namespace := common.GetNamespaceName(c.config.NamespaceMappingConfiguration().GetNamespaceMappingConfig(), project, domain.Name)
+		customTemplateValues, err := c.getCustomTemplateValues(
+			ctx, project, domain.ID, domainTemplateValues[domain.ID])
+		if err != nil {
+			logger.Warningf(ctx, "Failed to get custom template values for %s with err: %v", namespace, err)
+			errs = append(errs, err)
+		}
+		err = c.syncNamespace(ctx, namespace, templateValues, customTemplateValues)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to create cluster resources for namespace [%s] with err: %v", namespace, err)
+			c.metrics.ResourceAddErrors.Inc()
+			errs = append(errs, err)
+		} else {
+			logger.Infof(ctx, "Created cluster resources for namespace [%s] in kubernetes", namespace)
+			c.metrics.ResourcesAdded.Inc()
+			logger.Debugf(ctx, "Successfully created kubernetes resources for [%s-%s]", project, domain.ID)
+		}
+	}
+	return errs
+}
+
 func (c *controller) Sync(ctx context.Context) error {
 	defer func() {
 		if err := recover(); err != nil {
@@ -329,46 +382,29 @@ func (c *controller) Sync(ctx context.Context) error {
 			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
 		}
 	}()
+	c.syncLock.Lock()
+	defer c.syncLock.Unlock()
 
 	// Prefer to sync projects most newly created to ensure their resources get created first when other resources exist.
 	projects, err := c.db.ProjectRepo().ListAll(ctx, descCreatedAtSortParam)
 	if err != nil {
 		return err
 	}
-	domains := c.config.ApplicationConfiguration().GetDomainsConfig()
-	var errs = make([]error, 0)
-	templateValues, err := populateTemplateValues(c.config.ClusterResourceConfiguration().GetTemplateData())
-	if err != nil {
-		logger.Warningf(ctx, "Failed to get templatized values specified in config: %v", err)
-		errs = append(errs, err)
+	templateValues, domainTemplateValues, errs := c.getTemplateValues(ctx)
+	for _, project := range projects {
+		errs = append(errs, c.syncProject(ctx, project.Identifier, templateValues, domainTemplateValues)...)
 	}
-	domainTemplateValues, err := populateDefaultTemplateValues(c.config.ClusterResourceConfiguration().GetCustomTemplateData())
-	if err != nil {
-		logger.Warningf(ctx, "Failed to get domain-specific templatized values specified in config: %v", err)
-		errs = append(errs, err)
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
 	}
+	return nil
+}
 
-	for _, project := range projects {
-		for _, domain := range *domains {
-			namespace := common.GetNamespaceName(c.config.NamespaceMappingConfiguration().GetNamespaceMappingConfig(), project.Identifier, domain.Name)
-			customTemplateValues, err := c.getCustomTemplateValues(
-				ctx, project.Identifier, domain.ID, domainTemplateValues[domain.ID])
-			if err != nil {
-				logger.Warningf(ctx, "Failed to get custom template values for %s with err: %v", namespace, err)
-				errs = append(errs, err)
-			}
-			err = c.syncNamespace(ctx, namespace, templateValues, customTemplateValues)
-			if err != nil {
-				logger.Warningf(ctx, "Failed to create cluster resources for namespace [%s] with err: %v", namespace, err)
-				c.metrics.ResourceAddErrors.Inc()
-				errs = append(errs, err)
-			} else {
-				logger.Infof(ctx, "Created cluster resources for namespace [%s] in kubernetes", namespace)
-				c.metrics.ResourcesAdded.Inc()
-				logger.Debugf(ctx, "Successfully created kubernetes resources for [%s-%s]", project.Identifier, domain.ID)
-			}
-		}
-	}
+func (c *controller) SyncProject(ctx context.Context, project string) error {
+	c.syncLock.Lock()
+	defer c.syncLock.Unlock()
+	templateValues, domainTemplateValues, errs := c.getTemplateValues(ctx)
+	errs = append(errs, c.syncProject(ctx, project, templateValues, domainTemplateValues)...)
 	if len(errs) > 0 {
 		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
 	}
@@ -6,5 +6,12 @@ type CloudProvider = string
 
 const (
 	AWS   CloudProvider = "aws"
+	GCP   CloudProvider = "gcp"
 	Local CloudProvider = "local"
+	// Not a cloud provider: notifications are published to and consumed from a Kafka cluster.
+	Kafka CloudProvider = "kafka"
+	// Not a cloud provider: notifications are published to and processed from a queue within the process.
+	InProcess CloudProvider = "inprocess"
+	// Not a cloud provider: cloud events are POSTed to a webhook.
+	Webhook CloudProvider = "webhook"
 )
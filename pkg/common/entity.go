@@ -8,9 +8,13 @@ type Entity = string
 
 const (
 	Execution          = "e"
+	ExecutionEvent     = "ee"
+	ExecutionExtension = "ex"
+	ExecutionTag       = "et"
 	LaunchPlan         = "l"
 	NodeExecution      = "ne"
 	NodeExecutionEvent = "nee"
+	Project            = "p"
 	Task               = "t"
 	TaskExecution      = "te"
 	Workflow           = "w"
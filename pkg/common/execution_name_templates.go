@@ -0,0 +1,88 @@
+package common
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+)
+
+// LaunchPlanSpec doesn't (yet) expose a field for naming scheduled executions, so launch plans define an execution
+// name template, e.g. "{lp_name}-{kickoff_date}-{seq}", using this reserved launch plan annotation.
+const ExecutionNameTemplateAnnotation = "flyte.lyft.com/execution-name-template"
+
+// Variables which may be referenced in an execution name template.
+const (
+	executionNameProjectVariable     = "project"
+	executionNameDomainVariable      = "domain"
+	executionNameLaunchPlanVariable  = "lp_name"
+	executionNameVersionVariable     = "lp_version"
+	executionNameKickoffDateVariable = "kickoff_date"
+	executionNameKickoffTimeVariable = "kickoff_time"
+	executionNameSequenceVariable    = "seq"
+)
+
+const kickoffDateFormat = "20060102"
+const kickoffTimeFormat = "150405"
+
+var executionNameTemplateVariableRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+var disallowedExecutionNameCharsRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Values substituted for the variables referenced in an execution name template.
+type ExecutionNameTemplateInput struct {
+	LaunchPlan  *core.Identifier
+	KickoffTime time.Time
+	// Disambiguates executions whose rendered names would otherwise collide, starting at 1.
+	Sequence int
+}
+
+// Returns the execution name template defined by a launch plan spec, if any.
+func GetExecutionNameTemplate(spec *admin.LaunchPlanSpec) string {
+	return spec.GetAnnotations().GetValues()[ExecutionNameTemplateAnnotation]
+}
+
+// Renders an execution name template. Templates which don't reference the sequence have it appended as a suffix
+// once names start colliding. Rendered names are lowercased and characters which execution names can't contain are
+// replaced with dashes, however it's up to the caller to validate the rendered name, e.g. its length.
+func RenderExecutionNameTemplate(template string, input ExecutionNameTemplateInput) (string, error) {
+	var unknownVariable string
+	var referencesSequence bool
+	name := executionNameTemplateVariableRegex.ReplaceAllStringFunc(template, func(match string) string {
+		variable := strings.TrimSpace(match[1 : len(match)-1])
+		switch variable {
+		case executionNameProjectVariable:
+			return input.LaunchPlan.GetProject()
+		case executionNameDomainVariable:
+			return input.LaunchPlan.GetDomain()
+		case executionNameLaunchPlanVariable:
+			return input.LaunchPlan.GetName()
+		case executionNameVersionVariable:
+			return input.LaunchPlan.GetVersion()
+		case executionNameKickoffDateVariable:
+			return input.KickoffTime.UTC().Format(kickoffDateFormat)
+		case executionNameKickoffTimeVariable:
+			return input.KickoffTime.UTC().Format(kickoffTimeFormat)
+		case executionNameSequenceVariable:
+			referencesSequence = true
+			return strconv.Itoa(input.Sequence)
+		}
+		if unknownVariable == "" {
+			unknownVariable = variable
+		}
+		return match
+	})
+	if unknownVariable != "" {
+		return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"unknown variable [%s] in execution name template [%s]", unknownVariable, template)
+	}
+	if !referencesSequence && input.Sequence > 1 {
+		name = name + "-" + strconv.Itoa(input.Sequence)
+	}
+	name = disallowedExecutionNameCharsRegex.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(name, "-"), nil
+}
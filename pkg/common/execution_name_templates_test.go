@@ -0,0 +1,59 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var templateInput = ExecutionNameTemplateInput{
+	LaunchPlan: &core.Identifier{
+		Project: "flytekit",
+		Domain:  "production",
+		Name:    "Daily_Report",
+		Version: "v1",
+	},
+	KickoffTime: time.Date(2019, time.November, 17, 6, 30, 0, 0, time.UTC),
+	Sequence:    1,
+}
+
+func TestGetExecutionNameTemplate(t *testing.T) {
+	assert.Empty(t, GetExecutionNameTemplate(nil))
+	assert.Equal(t, "{lp_name}-{seq}", GetExecutionNameTemplate(&admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{
+			Values: map[string]string{
+				ExecutionNameTemplateAnnotation: "{lp_name}-{seq}",
+			},
+		},
+	}))
+}
+
+func TestRenderExecutionNameTemplate(t *testing.T) {
+	name, err := RenderExecutionNameTemplate("{lp_name}-{kickoff_date}-{seq}", templateInput)
+	assert.NoError(t, err)
+	assert.Equal(t, "daily-report-20191117-1", name)
+
+	name, err = RenderExecutionNameTemplate("{project}.{domain}.{lp_version}.{kickoff_time}", templateInput)
+	assert.NoError(t, err)
+	assert.Equal(t, "flytekit-production-v1-063000", name)
+}
+
+func TestRenderExecutionNameTemplate_Sequence(t *testing.T) {
+	input := templateInput
+	input.Sequence = 3
+	name, err := RenderExecutionNameTemplate("{lp_name}-{seq}", input)
+	assert.NoError(t, err)
+	assert.Equal(t, "daily-report-3", name)
+
+	name, err = RenderExecutionNameTemplate("{lp_name}", input)
+	assert.NoError(t, err)
+	assert.Equal(t, "daily-report-3", name)
+}
+
+func TestRenderExecutionNameTemplate_UnknownVariable(t *testing.T) {
+	_, err := RenderExecutionNameTemplate("{lp_name}-{hour}", templateInput)
+	assert.EqualError(t, err, "unknown variable [hour] in execution name template [{lp_name}-{hour}]")
+}
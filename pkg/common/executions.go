@@ -2,12 +2,17 @@ package common
 
 import (
 	"math/rand"
+	"strings"
 
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 const ExecutionIDLength = 10
 
+// The maximum length of execution names, whether requested or generated.
+const MaxExecutionNameLength = 20
+
 // In kubernetes, resource names must comply with this regex: '[a-z]([-a-z0-9]*[a-z0-9])?'
 const AllowedExecutionIDStartCharStr = "abcdefghijklmnopqrstuvwxyz"
 const AllowedExecutionIDStr = "abcdefghijklmnopqrstuvwxyz1234567890"
@@ -25,6 +30,17 @@ func GetExecutionName(seed int64) string {
 	return string(executionName)
 }
 
+// Generates an execution name starting with prefix and a dash, followed by as much of a random name as fits in the
+// maximum execution name length.
+func GetPrefixedExecutionName(prefix string, seed int64) string {
+	prefix += "-"
+	name := GetExecutionName(seed)
+	if suffixLength := MaxExecutionNameLength - len(prefix); suffixLength < len(name) {
+		name = name[:suffixLength]
+	}
+	return prefix + name
+}
+
 var terminalExecutionPhases = map[core.WorkflowExecution_Phase]bool{
 	core.WorkflowExecution_SUCCEEDED: true,
 	core.WorkflowExecution_FAILED:    true,
@@ -46,6 +62,156 @@ var terminalTaskExecutionPhases = map[core.TaskExecution_Phase]bool{
 	core.TaskExecution_ABORTED:   true,
 }
 
+// ExecutionSpec doesn't (yet) expose a dedicated field for overriding where execution data is written, so callers
+// request one using this reserved execution annotation.
+const OutputDataPrefixAnnotation = "flyte.lyft.com/output-data-prefix"
+
+// Returns the output data prefix override requested in an execution spec, if any.
+func GetOutputDataPrefix(spec *admin.ExecutionSpec) string {
+	return spec.GetAnnotations().GetValues()[OutputDataPrefixAnnotation]
+}
+
+// Operators pin an execution to a specific execution cluster, e.g. to debug a data plane, using this reserved
+// execution annotation. Executions without it are assigned a cluster configured for their domain.
+const ClusterAssignmentAnnotation = "flyte.lyft.com/cluster"
+
+// Returns the execution cluster requested in an execution spec, if any.
+func GetClusterAssignment(spec *admin.ExecutionSpec) string {
+	return spec.GetAnnotations().GetValues()[ClusterAssignmentAnnotation]
+}
+
+// Executions are classified by how sensitive their inputs and outputs are using this reserved execution annotation.
+const DataClassificationAnnotation = "flyte.lyft.com/data-classification"
+
+const (
+	DataClassificationPublic     = "public"
+	DataClassificationInternal   = "internal"
+	DataClassificationRestricted = "restricted"
+)
+
+var dataClassifications = map[string]bool{
+	DataClassificationPublic:     true,
+	DataClassificationInternal:   true,
+	DataClassificationRestricted: true,
+}
+
+// Returns the data classification requested in an execution spec. Unclassified executions are considered internal.
+func GetDataClassification(spec *admin.ExecutionSpec) string {
+	classification, ok := spec.GetAnnotations().GetValues()[DataClassificationAnnotation]
+	if !ok {
+		return DataClassificationInternal
+	}
+	return classification
+}
+
+func IsValidDataClassification(classification string) bool {
+	return dataClassifications[classification]
+}
+
+// Environment variables to set in every task container of an execution are likewise requested through execution
+// annotations, keyed by this prefix followed by the variable name, e.g. "env.flyte.lyft.com/EXPERIMENT_ID".
+const EnvironmentVariableAnnotationPrefix = "env.flyte.lyft.com/"
+
+// Returns the environment variable overrides requested in an execution spec, if any.
+func GetEnvironmentVariables(spec *admin.ExecutionSpec) map[string]string {
+	return getPrefixedAnnotations(spec, EnvironmentVariableAnnotationPrefix)
+}
+
+func getPrefixedAnnotations(spec *admin.ExecutionSpec, prefix string) map[string]string {
+	var values map[string]string
+	for key, value := range spec.GetAnnotations().GetValues() {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]string)
+		}
+		values[strings.TrimPrefix(key, prefix)] = value
+	}
+	return values
+}
+
+// ExecutionSpec has no field for attaching opaque (e.g. Any-typed) extensions at this flyteidl version, so platform
+// teams attach extension metadata, such as ticket or experiment IDs, as execution annotations keyed by this prefix.
+// Because they're part of the spec they're returned with the execution and carried over on relaunch.
+const ExtensionAnnotationPrefix = "ext.flyte.lyft.com/"
+
+// Returns the extension metadata attached to an execution spec, keyed without the annotation prefix.
+func GetExtensions(spec *admin.ExecutionSpec) map[string]string {
+	return getPrefixedAnnotations(spec, ExtensionAnnotationPrefix)
+}
+
+// ExecutionSpec has no field for tags at this flyteidl version either, so free-form tags for organizing executions are
+// requested as a comma-separated list using this reserved execution annotation. Unlike labels, tags aren't subject to
+// kubernetes naming constraints.
+const TagsAnnotation = "flyte.lyft.com/tags"
+
+const tagSeparator = ","
+
+// Returns the tags requested in an execution spec, if any, in the order requested and without duplicates.
+func GetTags(spec *admin.ExecutionSpec) []string {
+	value, ok := spec.GetAnnotations().GetValues()[TagsAnnotation]
+	if !ok {
+		return nil
+	}
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range strings.Split(value, tagSeparator) {
+		tag = strings.TrimSpace(tag)
+		if len(tag) == 0 || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// Replaces the tags recorded in an execution spec, removing the tags annotation altogether when there are none.
+func SetTags(spec *admin.ExecutionSpec, tags []string) {
+	if len(tags) == 0 {
+		if spec.Annotations != nil {
+			delete(spec.Annotations.Values, TagsAnnotation)
+		}
+		return
+	}
+	if spec.Annotations == nil {
+		spec.Annotations = &admin.Annotations{}
+	}
+	if spec.Annotations.Values == nil {
+		spec.Annotations.Values = make(map[string]string)
+	}
+	spec.Annotations.Values[TagsAnnotation] = strings.Join(tags, tagSeparator)
+}
+
+// Classifies why an execution didn't succeed, e.g. to separate user bugs from platform failures in reliability reports.
+const (
+	FailureClassificationUser    = "USER"
+	FailureClassificationSystem  = "SYSTEM"
+	FailureClassificationAborted = "ABORTED"
+	FailureClassificationTimeout = "TIMEOUT"
+)
+
+// Errors raised from user code are reported with codes scoped by this prefix, e.g. "USER:ValueError".
+const userErrorCodePrefix = "USER:"
+
+// Returns the failure classification for an execution transitioning to phase, or an empty string when the execution
+// hasn't failed. Errors which can't be attributed to user code are assumed to be system failures.
+func GetFailureClassification(phase core.WorkflowExecution_Phase, executionError *core.ExecutionError) string {
+	switch phase {
+	case core.WorkflowExecution_ABORTED:
+		return FailureClassificationAborted
+	case core.WorkflowExecution_TIMED_OUT:
+		return FailureClassificationTimeout
+	case core.WorkflowExecution_FAILED, core.WorkflowExecution_FAILING:
+		if strings.HasPrefix(executionError.GetCode(), userErrorCodePrefix) {
+			return FailureClassificationUser
+		}
+		return FailureClassificationSystem
+	}
+	return ""
+}
+
 func IsExecutionTerminal(phase core.WorkflowExecution_Phase) bool {
 	return terminalExecutionPhases[phase]
 }
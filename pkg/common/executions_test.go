@@ -1,9 +1,12 @@
 package common
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +18,46 @@ func TestGetExecutionName(t *testing.T) {
 		assert.Contains(t, AllowedExecutionIDChars, rune(randString[i]))
 	}
 }
+
+func TestGetPrefixedExecutionName(t *testing.T) {
+	name := GetPrefixedExecutionName("ml-20191213", time.Now().UnixNano())
+	assert.Len(t, name, MaxExecutionNameLength)
+	assert.True(t, strings.HasPrefix(name, "ml-20191213-"))
+
+	name = GetPrefixedExecutionName("ml", time.Now().UnixNano())
+	assert.Len(t, name, len("ml-")+ExecutionIDLength)
+}
+
+func TestGetFailureClassification(t *testing.T) {
+	assert.Empty(t, GetFailureClassification(core.WorkflowExecution_RUNNING, nil))
+	assert.Empty(t, GetFailureClassification(core.WorkflowExecution_SUCCEEDED, nil))
+	assert.Equal(t, FailureClassificationAborted, GetFailureClassification(core.WorkflowExecution_ABORTED, nil))
+	assert.Equal(t, FailureClassificationTimeout, GetFailureClassification(core.WorkflowExecution_TIMED_OUT, nil))
+	assert.Equal(t, FailureClassificationUser, GetFailureClassification(core.WorkflowExecution_FAILED,
+		&core.ExecutionError{Code: "USER:ValueError"}))
+	assert.Equal(t, FailureClassificationSystem, GetFailureClassification(core.WorkflowExecution_FAILED,
+		&core.ExecutionError{Code: "SYSTEM:Unknown"}))
+	assert.Equal(t, FailureClassificationSystem, GetFailureClassification(core.WorkflowExecution_FAILED, nil))
+}
+
+func TestGetTags(t *testing.T) {
+	assert.Empty(t, GetTags(nil))
+	assert.Equal(t, []string{"nightly", "team: data", "v2"}, GetTags(&admin.ExecutionSpec{
+		Annotations: &admin.Annotations{
+			Values: map[string]string{
+				TagsAnnotation: "nightly, team: data,,v2,nightly",
+			},
+		},
+	}))
+}
+
+func TestSetTags(t *testing.T) {
+	spec := admin.ExecutionSpec{}
+	SetTags(&spec, []string{"nightly", "v2"})
+	assert.Equal(t, "nightly,v2", spec.Annotations.Values[TagsAnnotation])
+	assert.Equal(t, []string{"nightly", "v2"}, GetTags(&spec))
+
+	SetTags(&spec, nil)
+	assert.NotContains(t, spec.Annotations.Values, TagsAnnotation)
+	assert.Empty(t, GetTags(&spec))
+}
@@ -80,6 +80,18 @@ var executionIdentifierFields = map[string]bool{
 	"name":    true,
 }
 
+// Task executions only record their duration once they terminate, so the durations of those still running are computed
+// from when they started. Formatted with the (possibly empty) table qualifier of its columns.
+const taskExecutionDurationFormat = "(CASE WHEN %[1]sduration > 0 THEN %[1]sduration " +
+	"ELSE CAST(EXTRACT(EPOCH FROM now() - %[1]sstarted_at) * 1000000000 AS BIGINT) END)"
+
+// Fields which are computed from (rather than stored as) columns of an entity.
+var computedFields = map[Entity]map[string]string{
+	TaskExecution: {
+		"duration": taskExecutionDurationFormat,
+	},
+}
+
 const unrecognizedFilterFunction = "unrecognized filter function: %s"
 const unsupportedFilterExpression = "unsupported filter expression: %s"
 const invalidSingleValueFilter = "invalid single value filter expression: %s"
@@ -142,11 +154,13 @@ type InlineFilter interface {
 
 // FilterInterface implementation. Only one of value or repeatedValue should ever be populated (based on the function).
 type inlineFilterImpl struct {
-	entity        Entity
-	function      FilterExpression
-	field         string
-	value         interface{}
-	repeatedValue interface{}
+	entity   Entity
+	function FilterExpression
+	field    string
+	// Formats the expression the field is computed from (if any) with its table qualifier.
+	computedFieldFormat string
+	value               interface{}
+	repeatedValue       interface{}
 }
 
 func (f *inlineFilterImpl) GetEntity() Entity {
@@ -214,13 +228,33 @@ func (f *inlineFilterImpl) GetGormQueryExpr() (GormQueryExpr, error) {
 }
 
 func (f *inlineFilterImpl) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
-	f.field = fmt.Sprintf(joinArgsFormat, tableName, f.field)
+	if len(f.computedFieldFormat) > 0 {
+		f.field = fmt.Sprintf(f.computedFieldFormat, tableName+".")
+	} else {
+		f.field = fmt.Sprintf(joinArgsFormat, tableName, f.field)
+	}
 	return f.GetGormQueryExpr()
 }
 
+func newInlineFilter(entity Entity, function FilterExpression, field string) *inlineFilterImpl {
+	if computedFieldFormat, ok := computedFields[entity][field]; ok {
+		return &inlineFilterImpl{
+			entity:              entity,
+			function:            function,
+			field:               fmt.Sprintf(computedFieldFormat, ""),
+			computedFieldFormat: computedFieldFormat,
+		}
+	}
+	return &inlineFilterImpl{
+		entity:   entity,
+		function: function,
+		field:    customizeField(field, entity),
+	}
+}
+
 func customizeField(field string, entity Entity) string {
 	// Execution identifier fields have to be customized because we differ from convention in those column names.
-	if entity == Execution && executionIdentifierFields[field] {
+	if (entity == Execution || entity == ExecutionEvent) && executionIdentifierFields[field] {
 		return fmt.Sprintf("execution_%s", field)
 	}
 	return field
@@ -231,13 +265,9 @@ func NewSingleValueFilter(entity Entity, function FilterExpression, field string
 	if _, ok := singleValueFilters[function]; !ok {
 		return nil, GetInvalidSingleValueFilterErr(function)
 	}
-	customizedField := customizeField(field, entity)
-	return &inlineFilterImpl{
-		entity:   entity,
-		function: function,
-		field:    customizedField,
-		value:    value,
-	}, nil
+	filter := newInlineFilter(entity, function, field)
+	filter.value = value
+	return filter, nil
 }
 
 // Returns a filter which uses a repeated argument value.
@@ -245,13 +275,9 @@ func NewRepeatedValueFilter(entity Entity, function FilterExpression, field stri
 	if _, ok := repeatedValueFilters[function]; !ok {
 		return nil, GetInvalidRepeatedValueFilterErr(function)
 	}
-	customizedField := customizeField(field, entity)
-	return &inlineFilterImpl{
-		entity:        entity,
-		function:      function,
-		field:         customizedField,
-		repeatedValue: repeatedValue,
-	}, nil
+	filter := newInlineFilter(entity, function, field)
+	filter.repeatedValue = repeatedValue
+	return filter, nil
 }
 
 func NewInlineFilter(entity Entity, function string, field string, value interface{}) (InlineFilter, error) {
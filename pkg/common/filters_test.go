@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -61,6 +62,30 @@ func TestNewSingleValueCustomizedFilter(t *testing.T) {
 	assert.Equal(t, "node_executions.execution_project = ?", expression.Query)
 }
 
+func TestNewSingleValueComputedFilter(t *testing.T) {
+	filter, err := NewSingleValueFilter(TaskExecution, GreaterThanOrEqual, "duration", time.Hour)
+	assert.NoError(t, err)
+
+	expression, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "(CASE WHEN duration > 0 THEN duration "+
+		"ELSE CAST(EXTRACT(EPOCH FROM now() - started_at) * 1000000000 AS BIGINT) END) >= ?", expression.Query)
+	assert.Equal(t, time.Hour, expression.Args)
+
+	expression, err = filter.GetGormJoinTableQueryExpr("task_executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "(CASE WHEN task_executions.duration > 0 THEN task_executions.duration "+
+		"ELSE CAST(EXTRACT(EPOCH FROM now() - task_executions.started_at) * 1000000000 AS BIGINT) END) >= ?",
+		expression.Query)
+
+	// Other entities' durations are stored as they are.
+	filter, err = NewSingleValueFilter(NodeExecution, GreaterThanOrEqual, "duration", time.Hour)
+	assert.NoError(t, err)
+	expression, err = filter.GetGormJoinTableQueryExpr("node_executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "node_executions.duration >= ?", expression.Query)
+}
+
 func TestNewRepeatedValueFilter(t *testing.T) {
 	_, err := NewRepeatedValueFilter(Workflow, ValueIn, "project", []string{"SuperAwesomeProject", "AnotherAwesomeProject"})
 	assert.NoError(t, err)
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/contextutils"
+	"google.golang.org/grpc/codes"
+)
+
+// LaunchPlanSpec has no field for an activation policy at this flyteidl version, so launch plans opt in to being
+// activated as soon as they're registered, deactivating the formerly active version, by setting this reserved launch
+// plan annotation to "true". Launch plans in projects which auto-activate by default opt out by setting it to "false".
+const AutoActivateAnnotation = "flyte.lyft.com/auto-activate"
+
+// Returns whether a launch plan spec is activated when it's registered, given the default of its project.
+func IsAutoActivated(spec *admin.LaunchPlanSpec, projectDefault bool) (bool, error) {
+	value, ok := spec.GetAnnotations().GetValues()[AutoActivateAnnotation]
+	if !ok {
+		return projectDefault, nil
+	}
+	autoActivate, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"annotation [%s] must be true or false, not [%s]", AutoActivateAnnotation, value)
+	}
+	return autoActivate, nil
+}
+
+// How the executions of a launch plan proceed once one of their nodes fails.
+type OnFailurePolicy string
+
+const (
+	// The execution fails as soon as a node fails, aborting the nodes which are still running. This is the default.
+	OnFailurePolicyFailImmediately OnFailurePolicy = "FAIL_IMMEDIATELY"
+	// The execution keeps running the nodes which don't depend on the failed node, and fails once they completed.
+	OnFailurePolicyFailAfterExecutableNodesComplete OnFailurePolicy = "FAIL_AFTER_EXECUTABLE_NODES_COMPLETE"
+)
+
+// WorkflowMetadata has no on-failure policy at this flyteidl version, so launch plans choose one by setting this
+// reserved launch plan annotation to the name of an OnFailurePolicy. The policy is annotated on the workflows launched
+// for the launch plan's executions under the same key.
+const OnFailureAnnotation = "flyte.lyft.com/on-failure"
+
+// Returns the on-failure policy a launch plan spec sets, which is empty when it doesn't set one.
+func GetOnFailurePolicy(spec *admin.LaunchPlanSpec) (OnFailurePolicy, error) {
+	value, ok := spec.GetAnnotations().GetValues()[OnFailureAnnotation]
+	if !ok {
+		return "", nil
+	}
+	switch policy := OnFailurePolicy(value); policy {
+	case OnFailurePolicyFailImmediately, OnFailurePolicyFailAfterExecutableNodesComplete:
+		return policy, nil
+	}
+	return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		"annotation [%s] must be %s or %s, not [%s]", OnFailureAnnotation, OnFailurePolicyFailImmediately,
+		OnFailurePolicyFailAfterExecutableNodesComplete, value)
+}
+
+const launchPlanKey contextutils.Key = "launch_plan"
+
+// Tags a context with the launch plan an execution was launched from, regardless of its version, so that notifications
+// published for the execution can be grouped by launch plan.
+func WithLaunchPlan(ctx context.Context, id *core.Identifier) context.Context {
+	return context.WithValue(ctx, launchPlanKey, fmt.Sprintf("%s/%s/%s", id.GetProject(), id.GetDomain(), id.GetName()))
+}
+
+// Returns the project/domain/name of the launch plan the context was tagged with, empty if it wasn't.
+func GetLaunchPlan(ctx context.Context) string {
+	launchPlan, _ := ctx.Value(launchPlanKey).(string)
+	return launchPlan
+}
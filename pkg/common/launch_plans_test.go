@@ -0,0 +1,79 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getAutoActivateSpec(value string) *admin.LaunchPlanSpec {
+	return &admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{
+			Values: map[string]string{
+				AutoActivateAnnotation: value,
+			},
+		},
+	}
+}
+
+func TestIsAutoActivated(t *testing.T) {
+	autoActivate, err := IsAutoActivated(&admin.LaunchPlanSpec{}, false)
+	assert.NoError(t, err)
+	assert.False(t, autoActivate)
+
+	autoActivate, err = IsAutoActivated(&admin.LaunchPlanSpec{}, true)
+	assert.NoError(t, err)
+	assert.True(t, autoActivate)
+
+	autoActivate, err = IsAutoActivated(getAutoActivateSpec("true"), false)
+	assert.NoError(t, err)
+	assert.True(t, autoActivate)
+
+	autoActivate, err = IsAutoActivated(getAutoActivateSpec("false"), true)
+	assert.NoError(t, err)
+	assert.False(t, autoActivate)
+
+	_, err = IsAutoActivated(getAutoActivateSpec("sometimes"), true)
+	assert.EqualError(t, err, "annotation [flyte.lyft.com/auto-activate] must be true or false, not [sometimes]")
+}
+
+func TestGetOnFailurePolicy(t *testing.T) {
+	policy, err := GetOnFailurePolicy(&admin.LaunchPlanSpec{})
+	assert.NoError(t, err)
+	assert.Empty(t, policy)
+
+	policy, err = GetOnFailurePolicy(&admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{
+			Values: map[string]string{
+				OnFailureAnnotation: "FAIL_AFTER_EXECUTABLE_NODES_COMPLETE",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, OnFailurePolicyFailAfterExecutableNodesComplete, policy)
+
+	_, err = GetOnFailurePolicy(&admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{
+			Values: map[string]string{
+				OnFailureAnnotation: "retry",
+			},
+		},
+	})
+	assert.EqualError(t, err, "annotation [flyte.lyft.com/on-failure] must be FAIL_IMMEDIATELY or "+
+		"FAIL_AFTER_EXECUTABLE_NODES_COMPLETE, not [retry]")
+}
+
+func TestWithLaunchPlan(t *testing.T) {
+	ctx := WithLaunchPlan(context.Background(), &core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "name",
+		Version:      "version",
+	})
+	assert.Equal(t, "project/domain/name", GetLaunchPlan(ctx))
+	assert.Empty(t, GetLaunchPlan(context.Background()))
+}
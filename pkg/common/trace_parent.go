@@ -0,0 +1,41 @@
+package common
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Clients creating executions join them to a distributed trace by passing a W3C trace context traceparent header,
+// see https://www.w3.org/TR/trace-context/#traceparent-header.
+const TraceParentHeader = "traceparent"
+
+// The traceparent an execution was created with is passed to the workflow engine using this reserved annotation so
+// task pods and downstream services can join the original trace.
+const TraceParentAnnotation = "flyte.lyft.com/traceparent"
+
+var traceParentRegex = regexp.MustCompile("^[0-9a-f]{2}-([0-9a-f]{32})-([0-9a-f]{16})-[0-9a-f]{2}$")
+
+const invalidTraceParentVersion = "ff"
+
+// Returns the traceparent a request was made with, empty when the request has none or an invalid one. As required by
+// the trace context spec, invalid traceparents are ignored rather than rejected.
+func GetTraceParent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(TraceParentHeader)
+	if len(values) != 1 {
+		return ""
+	}
+	traceParent := strings.TrimSpace(values[0])
+	matches := traceParentRegex.FindStringSubmatch(traceParent)
+	if matches == nil || strings.HasPrefix(traceParent, invalidTraceParentVersion) ||
+		strings.Trim(matches[1], "0") == "" || strings.Trim(matches[2], "0") == "" {
+		return ""
+	}
+	return traceParent
+}
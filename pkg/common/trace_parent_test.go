@@ -0,0 +1,39 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+const traceParent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+func getTraceParentContext(values ...string) context.Context {
+	md := metadata.MD{}
+	for _, value := range values {
+		md.Append(TraceParentHeader, value)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestGetTraceParent(t *testing.T) {
+	assert.Equal(t, traceParent, GetTraceParent(getTraceParentContext(traceParent)))
+	assert.Equal(t, traceParent, GetTraceParent(getTraceParentContext(" "+traceParent+" ")))
+}
+
+func TestGetTraceParent_Invalid(t *testing.T) {
+	assert.Empty(t, GetTraceParent(context.Background()))
+	assert.Empty(t, GetTraceParent(getTraceParentContext()))
+	assert.Empty(t, GetTraceParent(getTraceParentContext(traceParent, traceParent)))
+	assert.Empty(t, GetTraceParent(getTraceParentContext("not a traceparent")))
+	assert.Empty(t, GetTraceParent(getTraceParentContext(
+		"00-0AF7651916CD43DD8448EB211C80319C-B7AD6B7169203331-01")))
+	assert.Empty(t, GetTraceParent(getTraceParentContext(
+		"ff-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")))
+	assert.Empty(t, GetTraceParent(getTraceParentContext(
+		"00-00000000000000000000000000000000-b7ad6b7169203331-01")))
+	assert.Empty(t, GetTraceParent(getTraceParentContext(
+		"00-0af7651916cd43dd8448eb211c80319c-0000000000000000-01")))
+}
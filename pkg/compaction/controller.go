@@ -0,0 +1,281 @@
+// Compacts the events of old executions into per-execution summaries so the event tables don't grow without bound.
+package compaction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const defaultBatchSize = 100
+const defaultRefreshInterval = time.Hour
+const eventsPageSize = 1000
+
+const createdAtColumn = "created_at"
+const updatedAtColumn = "updated_at"
+const phaseColumn = "phase"
+const eventsCompactedAtColumn = "events_compacted_at"
+
+var terminalExecutionPhases = []string{
+	core.WorkflowExecution_SUCCEEDED.String(),
+	core.WorkflowExecution_FAILED.String(),
+	core.WorkflowExecution_ABORTED.String(),
+	core.WorkflowExecution_TIMED_OUT.String(),
+}
+
+// Executions are listed joined with their launch plans and workflows so the sort key must be qualified.
+var ascUpdatedAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "executions.updated_at",
+})
+
+var ascExecutionEventOccurredAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "execution_events.occurred_at",
+})
+
+var ascNodeExecutionEventOccurredAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "node_execution_events.occurred_at",
+})
+
+// The compaction Controller summarizes the execution and node execution events of terminated executions once they
+// haven't been updated for the configured age and removes the summarized events.
+type Controller interface {
+	Compact(ctx context.Context) error
+	Run()
+}
+
+type controllerMetrics struct {
+	Scope               promutils.Scope
+	ExecutionsCompacted prometheus.Counter
+	EventsCompacted     prometheus.Counter
+	CompactErrors       prometheus.Counter
+	Panics              prometheus.Counter
+}
+
+type controller struct {
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.ApplicationConfiguration
+	metrics controllerMetrics
+	_clock  clock.Clock
+}
+
+func (c *controller) summarizeExecutionEvents(
+	ctx context.Context, key models.ExecutionKey, compactedAt time.Time,
+	builder *util.ExecutionEventSummaryBuilder) error {
+	filters := make([]common.InlineFilter, 0, 4)
+	for _, identifier := range [][2]string{
+		{shared.Project, key.Project},
+		{shared.Domain, key.Domain},
+		{shared.Name, key.Name},
+	} {
+		filter, err := common.NewSingleValueFilter(common.ExecutionEvent, common.Equal, identifier[0], identifier[1])
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+	createdAtFilter, err := common.NewSingleValueFilter(
+		common.ExecutionEvent, common.LessThan, createdAtColumn, compactedAt)
+	if err != nil {
+		return err
+	}
+	filters = append(filters, createdAtFilter)
+	for offset := 0; ; offset += eventsPageSize {
+		output, err := c.db.ExecutionRepo().ListEvents(ctx, repoInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         eventsPageSize,
+			Offset:        offset,
+			SortParameter: ascExecutionEventOccurredAtSortParam,
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range output.ExecutionEvents {
+			builder.AddExecutionEvent(event)
+		}
+		if len(output.ExecutionEvents) < eventsPageSize {
+			return nil
+		}
+	}
+}
+
+func (c *controller) summarizeNodeExecutionEvents(
+	ctx context.Context, key models.ExecutionKey, compactedAt time.Time,
+	builder *util.ExecutionEventSummaryBuilder) error {
+	filters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, core.WorkflowExecutionIdentifier{
+		Project: key.Project,
+		Domain:  key.Domain,
+		Name:    key.Name,
+	})
+	if err != nil {
+		return err
+	}
+	createdAtFilter, err := common.NewSingleValueFilter(
+		common.NodeExecutionEvent, common.LessThan, createdAtColumn, compactedAt)
+	if err != nil {
+		return err
+	}
+	filters = append(filters, createdAtFilter)
+	for offset := 0; ; offset += eventsPageSize {
+		output, err := c.db.NodeExecutionRepo().ListEvents(ctx, repoInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         eventsPageSize,
+			Offset:        offset,
+			SortParameter: ascNodeExecutionEventOccurredAtSortParam,
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range output.NodeExecutionEvents {
+			builder.AddNodeExecutionEvent(event)
+		}
+		if len(output.NodeExecutionEvents) < eventsPageSize {
+			return nil
+		}
+	}
+}
+
+// Summarizes and removes the events stored for a single execution. Events stored while the execution is compacted,
+// e.g. late node execution events, are left as they are and read alongside the summary.
+func (c *controller) compactExecution(ctx context.Context, key models.ExecutionKey) error {
+	compactedAt := c._clock.Now()
+	builder := util.NewExecutionEventSummaryBuilder()
+	if err := c.summarizeExecutionEvents(ctx, key, compactedAt, builder); err != nil {
+		return err
+	}
+	if err := c.summarizeNodeExecutionEvents(ctx, key, compactedAt, builder); err != nil {
+		return err
+	}
+	summary, err := json.Marshal(builder.Build())
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to marshal event summary of execution [%+v] with err: %v", key, err)
+	}
+	if err := c.db.ExecutionRepo().CompactEvents(ctx, models.ExecutionEventSummary{
+		ExecutionKey: key,
+		CompactedAt:  compactedAt,
+		EventCount:   builder.EventCount(),
+		Summary:      summary,
+	}); err != nil {
+		return err
+	}
+	c.metrics.ExecutionsCompacted.Inc()
+	c.metrics.EventsCompacted.Add(float64(builder.EventCount()))
+	return nil
+}
+
+func (c *controller) Compact(ctx context.Context) error {
+	defer func() {
+		if err := recover(); err != nil {
+			c.metrics.Panics.Inc()
+			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+
+	compactionConfig := c.config.GetEventCompactionConfig()
+	if compactionConfig.MaxAge.Duration <= 0 {
+		logger.Debugf(ctx, "Skipping event compaction as no max age is configured")
+		return nil
+	}
+	batchSize := compactionConfig.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	cutoff := c._clock.Now().Add(-compactionConfig.MaxAge.Duration)
+	updatedAtFilter, err := common.NewSingleValueFilter(common.Execution, common.LessThan, updatedAtColumn, cutoff)
+	if err != nil {
+		return err
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, phaseColumn,
+		terminalExecutionPhases)
+	if err != nil {
+		return err
+	}
+	output, err := c.db.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         batchSize,
+		InlineFilters: []common.InlineFilter{updatedAtFilter, phaseFilter},
+		MapFilters: []common.MapFilter{
+			common.NewMapFilter(map[string]interface{}{eventsCompactedAtColumn: nil}),
+		},
+		SortParameter: ascUpdatedAtSortParam,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Debugf(ctx, "Found %d executions to compact last updated before %v", len(output.Executions), cutoff)
+	var errs = make([]error, 0)
+	for _, execution := range output.Executions {
+		if err := c.compactExecution(ctx, execution.ExecutionKey); err != nil {
+			c.metrics.CompactErrors.Inc()
+			logger.Warningf(ctx, "Failed to compact events of execution [%s/%s/%s] with err: %v",
+				execution.Project, execution.Domain, execution.Name, err)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (c *controller) Run() {
+	ctx := context.Background()
+	logger.Infof(ctx, "Running CompactionController")
+	interval := c.config.GetEventCompactionConfig().RefreshInterval.Duration
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	wait.Forever(func() {
+		err := c.Compact(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed event compaction with: %v", err)
+		}
+	}, interval)
+}
+
+func newMetrics(scope promutils.Scope) controllerMetrics {
+	return controllerMetrics{
+		Scope: scope,
+		ExecutionsCompacted: scope.MustNewCounter("executions_compacted",
+			"overall count of executions whose events were compacted"),
+		EventsCompacted: scope.MustNewCounter("events_compacted",
+			"overall count of execution and node execution events summarized and removed"),
+		CompactErrors: scope.MustNewCounter("compact_errors",
+			"overall count of errors encountered compacting the events of an execution"),
+		Panics: scope.MustNewCounter("panics",
+			"overall count of panics encountered in primary CompactionController loop"),
+	}
+}
+
+func NewCompactionController(
+	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration,
+	scope promutils.Scope) Controller {
+	return &controller{
+		db:      db,
+		config:  config,
+		metrics: newMetrics(scope),
+		_clock:  clock.New(),
+	}
+}
@@ -0,0 +1,150 @@
+package compaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/lyft/flytestdlib/config"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+var compactionTime = time.Date(2019, time.November, 28, 0, 0, 0, 0, time.UTC)
+
+var compactedExecutionKey = models.ExecutionKey{
+	Project: "project",
+	Domain:  "development",
+	Name:    "name",
+}
+
+func getTestController(
+	repository *repositoryMocks.MockRepository, compactionConfig runtimeInterfaces.EventCompactionConfig) Controller {
+	applicationConfig := runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetEventCompactionConfig(compactionConfig)
+	mockClock := clock.NewMock()
+	mockClock.Set(compactionTime)
+	return &controller{
+		db:      repository,
+		config:  &applicationConfig,
+		metrics: newMetrics(mockScope.NewTestScope()),
+		_clock:  mockClock,
+	}
+}
+
+func getCompactionConfig() runtimeInterfaces.EventCompactionConfig {
+	return runtimeInterfaces.EventCompactionConfig{
+		MaxAge: config.Duration{Duration: 24 * time.Hour},
+	}
+}
+
+func setListCallbacks(t *testing.T, repository *repositoryMocks.MockRepository) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, defaultBatchSize, input.Limit)
+			assert.Len(t, input.MapFilters, 1)
+			assert.Len(t, input.InlineFilters, 2)
+			updatedAtQuery, err := input.InlineFilters[0].GetGormQueryExpr()
+			assert.NoError(t, err)
+			assert.Equal(t, compactionTime.Add(-24*time.Hour), updatedAtQuery.Args)
+			return repoInterfaces.ExecutionCollectionOutput{
+				Executions: []models.Execution{{ExecutionKey: compactedExecutionKey}},
+			}, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListEventsCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionEventCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 4)
+			assert.Equal(t, common.ExecutionEvent, input.InlineFilters[3].GetEntity())
+			createdAtQuery, err := input.InlineFilters[3].GetGormQueryExpr()
+			assert.NoError(t, err)
+			assert.Equal(t, compactionTime, createdAtQuery.Args)
+			return repoInterfaces.ExecutionEventCollectionOutput{
+				ExecutionEvents: []models.ExecutionEvent{
+					{ExecutionKey: compactedExecutionKey, Phase: "RUNNING", OccurredAt: compactionTime.Add(-48 * time.Hour)},
+					{ExecutionKey: compactedExecutionKey, Phase: "SUCCEEDED", OccurredAt: compactionTime.Add(-47 * time.Hour)},
+				},
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListEventCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.NodeExecutionEventCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 4)
+			assert.Equal(t, common.NodeExecutionEvent, input.InlineFilters[3].GetEntity())
+			return repoInterfaces.NodeExecutionEventCollectionOutput{
+				NodeExecutionEvents: []models.NodeExecutionEvent{
+					{
+						NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: compactedExecutionKey, NodeID: "node"},
+						Phase:            "RUNNING",
+						OccurredAt:       compactionTime.Add(-48 * time.Hour),
+					},
+				},
+			}, nil
+		})
+}
+
+func TestCompact(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallbacks(t, repository)
+	var compacted bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCompactEventsCallback(
+		func(ctx context.Context, summary models.ExecutionEventSummary) error {
+			assert.Equal(t, compactedExecutionKey, summary.ExecutionKey)
+			assert.Equal(t, compactionTime, summary.CompactedAt)
+			assert.Equal(t, 3, summary.EventCount)
+			var eventSummary util.ExecutionEventSummary
+			assert.NoError(t, json.Unmarshal(summary.Summary, &eventSummary))
+			assert.Len(t, eventSummary.Events, 2)
+			assert.Len(t, eventSummary.NodeExecutions, 1)
+			assert.Equal(t, "node", eventSummary.NodeExecutions[0].NodeID)
+			compacted = true
+			return nil
+		})
+
+	err := getTestController(repository, getCompactionConfig()).Compact(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, compacted)
+}
+
+func TestCompact_Disabled(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionCollectionOutput, error) {
+			t.Fatal("unexpected list")
+			return repoInterfaces.ExecutionCollectionOutput{}, nil
+		})
+
+	err := getTestController(repository, runtimeInterfaces.EventCompactionConfig{}).Compact(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestCompact_ListEventsError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallbacks(t, repository)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListEventCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.NodeExecutionEventCollectionOutput, error) {
+			return repoInterfaces.NodeExecutionEventCollectionOutput{}, errors.New("connection reset")
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCompactEventsCallback(
+		func(ctx context.Context, summary models.ExecutionEventSummary) error {
+			t.Fatal("events must not be compacted unless all of them were summarized")
+			return nil
+		})
+
+	err := getTestController(repository, getCompactionConfig()).Compact(context.Background())
+	assert.Error(t, err)
+}
@@ -27,23 +27,30 @@ type RemoteDataHandlerConfig struct {
 
 type RemoteDataHandler interface {
 	GetRemoteURLInterface() interfaces.RemoteURLInterface
+	GetRemoteDataRemover() interfaces.RemoteDataRemover
 }
 
 type remoteDataHandler struct {
-	remoteURL interfaces.RemoteURLInterface
+	remoteURL         interfaces.RemoteURLInterface
+	remoteDataRemover interfaces.RemoteDataRemover
 }
 
 func (r *remoteDataHandler) GetRemoteURLInterface() interfaces.RemoteURLInterface {
 	return r.remoteURL
 }
 
+func (r *remoteDataHandler) GetRemoteDataRemover() interfaces.RemoteDataRemover {
+	return r.remoteDataRemover
+}
+
 func GetRemoteDataHandler(cfg RemoteDataHandlerConfig) RemoteDataHandler {
 	switch cfg.CloudProvider {
 	case common.AWS:
 		awsConfig := aws.NewConfig().WithRegion(cfg.Region).WithMaxRetries(cfg.Retries)
 		presignedURLDuration := time.Minute * time.Duration(cfg.SignedURLDurationMinutes)
 		return &remoteDataHandler{
-			remoteURL: implementations.NewAWSRemoteURL(awsConfig, presignedURLDuration),
+			remoteURL:         implementations.NewAWSRemoteURL(awsConfig, presignedURLDuration),
+			remoteDataRemover: implementations.NewAWSRemoteDataRemover(awsConfig),
 		}
 	case common.Local:
 		logger.Infof(context.TODO(), "setting up local signer ----- ")
@@ -73,13 +80,15 @@ func GetRemoteDataHandler(cfg RemoteDataHandlerConfig) RemoteDataHandler {
 			WithS3ForcePathStyle(true)
 		presignedURLDuration := time.Minute * time.Duration(cfg.SignedURLDurationMinutes)
 		return &remoteDataHandler{
-			remoteURL: implementations.NewAWSRemoteURL(awsConfig, presignedURLDuration),
+			remoteURL:         implementations.NewAWSRemoteURL(awsConfig, presignedURLDuration),
+			remoteDataRemover: implementations.NewAWSRemoteDataRemover(awsConfig),
 		}
 	default:
 		logger.Infof(context.Background(),
 			"Using default noop remote url implementation for cloud provider type [%s]", cfg.CloudProvider)
 		return &remoteDataHandler{
-			remoteURL: implementations.NewNoopRemoteURL(*cfg.RemoteDataStoreClient),
+			remoteURL:         implementations.NewNoopRemoteURL(*cfg.RemoteDataStoreClient),
+			remoteDataRemover: implementations.NewNoopRemoteDataRemover(),
 		}
 	}
 }
@@ -0,0 +1,51 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/lyft/flyteadmin/pkg/data/interfaces"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Defines the subset of the s3.S3 interface used to delete objects.
+type s3DeleteInterface interface {
+	DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+}
+
+// AWS-specific implementation of RemoteDataRemover
+type AWSRemoteDataRemover struct {
+	s3Client s3DeleteInterface
+}
+
+func (a *AWSRemoteDataRemover) Remove(ctx context.Context, uri string) error {
+	logger.Debugf(ctx, "Removing remote data at - %s", uri)
+	s3URI, err := splitS3URI(ctx, uri)
+	if err != nil {
+		logger.Debugf(ctx, "failed to extract s3 bucket and key from uri: %s", uri)
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid uri: %s", uri)
+	}
+	_, err = a.s3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: &s3URI.bucket,
+		Key:    &s3URI.key,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to delete %s with %v", uri, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to delete %s with %v", uri, err)
+	}
+	return nil
+}
+
+func NewAWSRemoteDataRemover(config *aws.Config) interfaces.RemoteDataRemover {
+	sesh, err := session.NewSession(config)
+	if err != nil {
+		panic(err)
+	}
+	return &AWSRemoteDataRemover{
+		s3Client: s3.New(sesh),
+	}
+}
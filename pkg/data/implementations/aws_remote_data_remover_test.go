@@ -0,0 +1,46 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockS3DeleteImpl struct {
+	deleteObjectFunc func(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+}
+
+func (m *mockS3DeleteImpl) DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	return m.deleteObjectFunc(input)
+}
+
+func TestRemove(t *testing.T) {
+	var deleted bool
+	remover := AWSRemoteDataRemover{
+		s3Client: &mockS3DeleteImpl{
+			deleteObjectFunc: func(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+				assert.Equal(t, "bucket", *input.Bucket)
+				assert.Equal(t, "metadata/inputs", *input.Key)
+				deleted = true
+				return &s3.DeleteObjectOutput{}, nil
+			},
+		},
+	}
+	assert.NoError(t, remover.Remove(context.Background(), "s3://bucket/metadata/inputs"))
+	assert.True(t, deleted)
+}
+
+func TestRemove_Error(t *testing.T) {
+	remover := AWSRemoteDataRemover{
+		s3Client: &mockS3DeleteImpl{
+			deleteObjectFunc: func(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+				return nil, errors.New("access denied")
+			},
+		},
+	}
+	assert.Error(t, remover.Remove(context.Background(), "s3://bucket/metadata/inputs"))
+	assert.Error(t, remover.Remove(context.Background(), "gs://bucket/metadata/inputs"))
+}
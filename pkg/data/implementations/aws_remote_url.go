@@ -37,6 +37,10 @@ type AWSS3Object struct {
 }
 
 func (a *AWSRemoteURL) splitURI(ctx context.Context, uri string) (AWSS3Object, error) {
+	return splitS3URI(ctx, uri)
+}
+
+func splitS3URI(ctx context.Context, uri string) (AWSS3Object, error) {
 	scheme, container, key, err := storage.DataReference(uri).Split()
 	if err != nil {
 		return AWSS3Object{}, err
@@ -0,0 +1,21 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/data/interfaces"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// No-op implementation of a RemoteDataRemover for storage backends which don't support deletes.
+type NoopRemoteDataRemover struct{}
+
+func (n *NoopRemoteDataRemover) Remove(ctx context.Context, uri string) error {
+	return errors.NewFlyteAdminErrorf(codes.Unimplemented,
+		"removing remote data is not supported for the configured cloud provider, failed to remove: %s", uri)
+}
+
+func NewNoopRemoteDataRemover() interfaces.RemoteDataRemover {
+	return &NoopRemoteDataRemover{}
+}
@@ -11,3 +11,8 @@ type RemoteURLInterface interface {
 	// TODO: Refactor for URI to be of type DataReference. We should package a FromString-like function in flytestdlib
 	Get(ctx context.Context, uri string) (admin.UrlBlob, error)
 }
+
+// Defines an interface for permanently deleting offloaded data.
+type RemoteDataRemover interface {
+	Remove(ctx context.Context, uri string) error
+}
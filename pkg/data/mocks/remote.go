@@ -22,3 +22,19 @@ func (m *MockRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, err
 func NewMockRemoteURL() interfaces.RemoteURLInterface {
 	return &MockRemoteURL{}
 }
+
+// Mock implementation of a RemoteDataRemover
+type MockRemoteDataRemover struct {
+	RemoveCallback func(ctx context.Context, uri string) error
+}
+
+func (m *MockRemoteDataRemover) Remove(ctx context.Context, uri string) error {
+	if m.RemoveCallback != nil {
+		return m.RemoveCallback(ctx, uri)
+	}
+	return nil
+}
+
+func NewMockRemoteDataRemover() interfaces.RemoteDataRemover {
+	return &MockRemoteDataRemover{}
+}
@@ -0,0 +1,335 @@
+// Periodically emails summaries of the executions which terminated within each period, as an alternative to
+// per-execution notifications for high-volume schedules.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications"
+	notificationInterfaces "github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// How often the controller checks whether a period has ended.
+const refreshInterval = time.Minute
+const executionsPageSize = 1000
+
+const phaseColumn = "phase"
+const executionUpdatedAtColumn = "execution_updated_at"
+const launchPlanNameColumn = "name"
+
+const digestSubject = "Flyte execution digest for %s: %d succeeded, %d failed"
+
+var terminalExecutionPhases = []core.WorkflowExecution_Phase{
+	core.WorkflowExecution_SUCCEEDED,
+	core.WorkflowExecution_FAILED,
+	core.WorkflowExecution_ABORTED,
+	core.WorkflowExecution_TIMED_OUT,
+}
+
+// Executions are listed joined with their launch plans and workflows so the sort key must be qualified.
+var ascExecutionUpdatedAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "executions.execution_updated_at",
+})
+
+// The digest Controller emails each configured digest once its period has ended.
+type Controller interface {
+	// Sends the digests whose latest period hasn't been summarized yet.
+	Send(ctx context.Context) error
+	// Sends the digests of every period which ends from now on.
+	Run()
+}
+
+type controllerMetrics struct {
+	Scope       promutils.Scope
+	DigestsSent prometheus.Counter
+	SendErrors  prometheus.Counter
+	Panics      prometheus.Counter
+}
+
+type controller struct {
+	db        repositories.RepositoryInterface
+	config    runtimeInterfaces.ApplicationConfiguration
+	publisher notificationInterfaces.Publisher
+	metrics   controllerMetrics
+	_clock    clock.Clock
+	// The end of the latest period summarized by each digest, by its index in the config.
+	summarizedUntil map[int]time.Time
+}
+
+// The executions of a launch plan which terminated within a period.
+type launchPlanSummary struct {
+	// The domain/name of the launch plan.
+	name   string
+	counts map[core.WorkflowExecution_Phase]int
+	// The executions which didn't succeed, in the order they terminated.
+	unsuccessful []*admin.Execution
+}
+
+func (c *controller) getFilters(
+	digestConfig runtimeInterfaces.NotificationDigestConfig, start, end time.Time) ([]common.InlineFilter, error) {
+	filters := make([]common.InlineFilter, 0, 6)
+	projectFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, shared.Project,
+		digestConfig.Project)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, projectFilter)
+	if len(digestConfig.Domain) > 0 {
+		domainFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, shared.Domain,
+			digestConfig.Domain)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, domainFilter)
+	}
+	phases := make([]string, len(terminalExecutionPhases))
+	for idx, phase := range terminalExecutionPhases {
+		phases[idx] = phase.String()
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, phaseColumn, phases)
+	if err != nil {
+		return nil, err
+	}
+	startFilter, err := common.NewSingleValueFilter(
+		common.Execution, common.GreaterThanOrEqual, executionUpdatedAtColumn, start)
+	if err != nil {
+		return nil, err
+	}
+	endFilter, err := common.NewSingleValueFilter(common.Execution, common.LessThan, executionUpdatedAtColumn, end)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, phaseFilter, startFilter, endFilter)
+	if len(digestConfig.LaunchPlans) > 0 {
+		launchPlanFilter, err := common.NewRepeatedValueFilter(
+			common.LaunchPlan, common.ValueIn, launchPlanNameColumn, digestConfig.LaunchPlans)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, launchPlanFilter)
+	}
+	return filters, nil
+}
+
+// Summarizes the executions the digest covers which terminated within [start, end), by launch plan.
+func (c *controller) summarize(ctx context.Context, digestConfig runtimeInterfaces.NotificationDigestConfig,
+	start, end time.Time) ([]*launchPlanSummary, error) {
+	filters, err := c.getFilters(digestConfig, start, end)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make(map[string]*launchPlanSummary)
+	for offset := 0; ; offset += executionsPageSize {
+		output, err := c.db.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         executionsPageSize,
+			Offset:        offset,
+			SortParameter: ascExecutionUpdatedAtSortParam,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, executionModel := range output.Executions {
+			execution, err := transformers.FromExecutionModel(executionModel)
+			if err != nil {
+				return nil, err
+			}
+			launchPlan := execution.GetSpec().GetLaunchPlan()
+			name := fmt.Sprintf("%s/%s", launchPlan.GetDomain(), launchPlan.GetName())
+			summary, ok := summaries[name]
+			if !ok {
+				summary = &launchPlanSummary{name: name, counts: make(map[core.WorkflowExecution_Phase]int)}
+				summaries[name] = summary
+			}
+			phase := execution.GetClosure().GetPhase()
+			summary.counts[phase]++
+			if phase != core.WorkflowExecution_SUCCEEDED {
+				summary.unsuccessful = append(summary.unsuccessful, execution)
+			}
+		}
+		if len(output.Executions) < executionsPageSize {
+			break
+		}
+	}
+	sorted := make([]*launchPlanSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		sorted = append(sorted, summary)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+	return sorted, nil
+}
+
+func formatExecution(consoleURL string, execution *admin.Execution) string {
+	name := html.EscapeString(execution.GetId().GetName())
+	if url := notifications.GetConsoleExecutionURL(consoleURL, execution.GetId()); len(url) > 0 {
+		name = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), name)
+	}
+	formatted := fmt.Sprintf("%s %s", name, strings.ToLower(execution.GetClosure().GetPhase().String()))
+	if message := execution.GetClosure().GetError().GetMessage(); len(message) > 0 {
+		formatted += ": " + html.EscapeString(message)
+	}
+	return formatted
+}
+
+func buildEmail(notificationsConfig runtimeInterfaces.NotificationsConfig,
+	digestConfig runtimeInterfaces.NotificationDigestConfig, start, end time.Time,
+	summaries []*launchPlanSummary) *admin.EmailMessage {
+	emailerConfig := notifications.GetEmailerConfig(
+		notificationsConfig.NotificationsEmailerConfig, digestConfig.Project, digestConfig.Domain)
+	var succeeded, failed int
+	sections := make([]string, len(summaries))
+	for idx, summary := range summaries {
+		succeeded += summary.counts[core.WorkflowExecution_SUCCEEDED]
+		failed += summary.counts[core.WorkflowExecution_FAILED]
+		counts := make([]string, 0, len(terminalExecutionPhases))
+		for _, phase := range terminalExecutionPhases {
+			if count := summary.counts[phase]; count > 0 {
+				counts = append(counts, fmt.Sprintf("%d %s", count, strings.ToLower(phase.String())))
+			}
+		}
+		lines := []string{fmt.Sprintf("<b>%s</b>: %s", html.EscapeString(summary.name), strings.Join(counts, ", "))}
+		for _, execution := range summary.unsuccessful {
+			lines = append(lines, formatExecution(emailerConfig.ConsoleURL, execution))
+		}
+		sections[idx] = strings.Join(lines, "<br/>")
+	}
+	period := fmt.Sprintf("Executions of project %s which terminated between %s and %s.",
+		html.EscapeString(digestConfig.Project), start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	return &admin.EmailMessage{
+		RecipientsEmail: digestConfig.Recipients,
+		SenderEmail:     emailerConfig.Sender,
+		SubjectLine:     fmt.Sprintf(digestSubject, digestConfig.Project, succeeded, failed),
+		Body:            period + "<br/><br/>" + strings.Join(sections, "<br/><br/>"),
+	}
+}
+
+// Summarizes the period ending at end for a digest, sending nothing when no execution terminated within it.
+func (c *controller) sendDigest(ctx context.Context, notificationsConfig runtimeInterfaces.NotificationsConfig,
+	digestConfig runtimeInterfaces.NotificationDigestConfig, end time.Time) error {
+	start := end.Add(-digestConfig.Interval.Duration)
+	summaries, err := c.summarize(ctx, digestConfig, start, end)
+	if err != nil {
+		return err
+	}
+	if len(summaries) == 0 {
+		logger.Debugf(ctx, "Skipping digest of project [%s] as no execution terminated between %v and %v",
+			digestConfig.Project, start, end)
+		return nil
+	}
+	email := buildEmail(notificationsConfig, digestConfig, start, end, summaries)
+	if err := c.publisher.Publish(ctx, proto.MessageName(&admin.EmailNotification{}), email); err != nil {
+		return err
+	}
+	c.metrics.DigestsSent.Inc()
+	return nil
+}
+
+// Returns the end of the latest period of a digest which has ended at time now.
+func getPeriodEnd(digestConfig runtimeInterfaces.NotificationDigestConfig, now time.Time) time.Time {
+	return now.UTC().Truncate(digestConfig.Interval.Duration)
+}
+
+func (c *controller) Send(ctx context.Context) error {
+	defer func() {
+		if err := recover(); err != nil {
+			c.metrics.Panics.Inc()
+			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+
+	notificationsConfig := *c.config.GetNotificationsConfig()
+	now := c._clock.Now()
+	var errs = make([]error, 0)
+	for idx, digestConfig := range notificationsConfig.Digests {
+		if digestConfig.Interval.Duration <= 0 || len(digestConfig.Recipients) == 0 {
+			logger.Warningf(ctx, "Skipping digest of project [%s] without an interval or recipients",
+				digestConfig.Project)
+			continue
+		}
+		end := getPeriodEnd(digestConfig, now)
+		if !c.summarizedUntil[idx].Before(end) {
+			continue
+		}
+		if err := c.sendDigest(ctx, notificationsConfig, digestConfig, end); err != nil {
+			c.metrics.SendErrors.Inc()
+			logger.Warningf(ctx, "Failed to send digest of project [%s] for the period ending at %v with err: %v",
+				digestConfig.Project, end, err)
+			errs = append(errs, err)
+			continue
+		}
+		c.summarizedUntil[idx] = end
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (c *controller) Run() {
+	ctx := context.Background()
+	logger.Infof(ctx, "Running DigestController")
+	// The period in progress is the first one summarized, as the one which ended before may have been summarized
+	// already.
+	now := c._clock.Now()
+	for idx, digestConfig := range c.config.GetNotificationsConfig().Digests {
+		if digestConfig.Interval.Duration > 0 {
+			c.summarizedUntil[idx] = getPeriodEnd(digestConfig, now)
+		}
+	}
+	wait.Forever(func() {
+		err := c.Send(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed sending digests with: %v", err)
+		}
+	}, refreshInterval)
+}
+
+func newMetrics(scope promutils.Scope) controllerMetrics {
+	return controllerMetrics{
+		Scope:       scope,
+		DigestsSent: scope.MustNewCounter("digests_sent", "overall count of execution digests sent"),
+		SendErrors: scope.MustNewCounter("send_errors",
+			"overall count of errors encountered summarizing or sending an execution digest"),
+		Panics: scope.MustNewCounter("panics",
+			"overall count of panics encountered in primary DigestController loop"),
+	}
+}
+
+func NewDigestController(
+	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration,
+	publisher notificationInterfaces.Publisher, scope promutils.Scope) Controller {
+	return &controller{
+		db:              db,
+		config:          config,
+		publisher:       publisher,
+		metrics:         newMetrics(scope),
+		_clock:          clock.New(),
+		summarizedUntil: make(map[int]time.Time),
+	}
+}
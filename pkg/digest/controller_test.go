@@ -0,0 +1,151 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/config"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/lyft/flyteadmin/pkg/common"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+var periodEnd = time.Date(2019, time.November, 28, 0, 0, 0, 0, time.UTC)
+
+func getTestController(repository *repositoryMocks.MockRepository, publisher *mocks.MockPublisher,
+	mockClock *clock.Mock) *controller {
+	applicationConfig := runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender:     "flyte@example.com",
+			ConsoleURL: "https://flyte.example.com/",
+		},
+		Digests: []runtimeInterfaces.NotificationDigestConfig{
+			{
+				Project:     "project",
+				LaunchPlans: []string{"nightly"},
+				Interval:    config.Duration{Duration: 24 * time.Hour},
+				Recipients:  []string{"team@example.com"},
+			},
+		},
+	})
+	mockClock.Set(periodEnd.Add(10 * time.Hour))
+	return &controller{
+		db:              repository,
+		config:          &applicationConfig,
+		publisher:       publisher,
+		metrics:         newMetrics(mockScope.NewTestScope()),
+		_clock:          mockClock,
+		summarizedUntil: make(map[int]time.Time),
+	}
+}
+
+func getExecutionModel(t *testing.T, name string, phase core.WorkflowExecution_Phase, message string) models.Execution {
+	spec, err := proto.Marshal(&admin.ExecutionSpec{
+		LaunchPlan: &core.Identifier{Project: "project", Domain: "development", Name: "nightly"},
+	})
+	assert.NoError(t, err)
+	closure := admin.ExecutionClosure{Phase: phase}
+	if len(message) > 0 {
+		closure.OutputResult = &admin.ExecutionClosure_Error{Error: &core.ExecutionError{Message: message}}
+	}
+	closureBytes, err := proto.Marshal(&closure)
+	assert.NoError(t, err)
+	return models.Execution{
+		ExecutionKey: models.ExecutionKey{Project: "project", Domain: "development", Name: name},
+		Phase:        phase.String(),
+		Spec:         spec,
+		Closure:      closureBytes,
+	}
+}
+
+func setListCallback(t *testing.T, repository *repositoryMocks.MockRepository, executions ...models.Execution) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, executionsPageSize, input.Limit)
+			assert.Len(t, input.InlineFilters, 5)
+			startQuery, err := input.InlineFilters[2].GetGormQueryExpr()
+			assert.NoError(t, err)
+			assert.Equal(t, periodEnd.Add(-24*time.Hour), startQuery.Args)
+			endQuery, err := input.InlineFilters[3].GetGormQueryExpr()
+			assert.NoError(t, err)
+			assert.Equal(t, periodEnd, endQuery.Args)
+			assert.Equal(t, common.LaunchPlan, input.InlineFilters[4].GetEntity())
+			return repoInterfaces.ExecutionCollectionOutput{Executions: executions}, nil
+		})
+}
+
+func TestSend(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository,
+		getExecutionModel(t, "a", core.WorkflowExecution_SUCCEEDED, ""),
+		getExecutionModel(t, "b", core.WorkflowExecution_FAILED, "<oom>"),
+		getExecutionModel(t, "c", core.WorkflowExecution_SUCCEEDED, ""))
+	var published []*admin.EmailMessage
+	var publisher mocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, "flyteidl.admin.EmailNotification", key)
+		published = append(published, msg.(*admin.EmailMessage))
+		return nil
+	})
+	mockClock := clock.NewMock()
+	digestController := getTestController(repository, &publisher, mockClock)
+
+	assert.NoError(t, digestController.Send(context.Background()))
+	assert.Len(t, published, 1)
+	assert.Equal(t, []string{"team@example.com"}, published[0].RecipientsEmail)
+	assert.Equal(t, "flyte@example.com", published[0].SenderEmail)
+	assert.Equal(t, "Flyte execution digest for project: 2 succeeded, 1 failed", published[0].SubjectLine)
+	assert.Contains(t, published[0].Body, "<b>development/nightly</b>: 2 succeeded, 1 failed")
+	assert.Contains(t, published[0].Body, `<a href="https://flyte.example.com/console/projects/project/domains/`+
+		`development/executions/b">b</a> failed: &lt;oom&gt;`)
+	assert.NotContains(t, published[0].Body, "executions/a")
+
+	// Each period is only summarized once.
+	mockClock.Add(time.Hour)
+	assert.NoError(t, digestController.Send(context.Background()))
+	assert.Len(t, published, 1)
+}
+
+func TestSend_NoExecutions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository)
+	var publisher mocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		t.Fatal("unexpected digest of an empty period")
+		return nil
+	})
+
+	assert.NoError(t, getTestController(repository, &publisher, clock.NewMock()).Send(context.Background()))
+}
+
+func TestSend_PublishError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository, getExecutionModel(t, "a", core.WorkflowExecution_SUCCEEDED, ""))
+	var attempts int
+	var publisher mocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		attempts++
+		return errors.New("connection reset")
+	})
+	digestController := getTestController(repository, &publisher, clock.NewMock())
+
+	// Periods whose digest couldn't be sent are retried.
+	assert.Error(t, digestController.Send(context.Background()))
+	assert.Error(t, digestController.Send(context.Background()))
+	assert.Equal(t, 2, attempts)
+}
@@ -0,0 +1,255 @@
+// Drives synthetic execution traffic against a running admin instance to help operators size their deployment
+// (notably the database) before onboarding new workloads.
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/lyft/flytestdlib/logger"
+)
+
+const (
+	createExecutionOperation = "CreateExecution"
+	createEventOperation     = "CreateWorkflowEvent"
+)
+
+// Phases reported for every synthetic execution, in order, mimicking what propeller sends for a successful run.
+var eventPhases = []core.WorkflowExecution_Phase{
+	core.WorkflowExecution_RUNNING,
+	core.WorkflowExecution_SUCCEEDING,
+	core.WorkflowExecution_SUCCEEDED,
+}
+
+// A launch plan to execute along with its relative share of the generated executions.
+type LaunchPlanMix struct {
+	Name    string
+	Version string
+	Weight  int
+}
+
+// Parses a launch plan mix of the form name:version[:weight]. The weight defaults to 1.
+func ParseLaunchPlanMix(value string) (LaunchPlanMix, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return LaunchPlanMix{}, fmt.Errorf("launch plan [%s] must be of the form name:version[:weight]", value)
+	}
+	mix := LaunchPlanMix{
+		Name:    parts[0],
+		Version: parts[1],
+		Weight:  1,
+	}
+	if len(parts) == 3 {
+		weight, err := strconv.Atoi(parts[2])
+		if err != nil || weight <= 0 {
+			return LaunchPlanMix{}, fmt.Errorf("launch plan [%s] must have a positive weight", value)
+		}
+		mix.Weight = weight
+	}
+	return mix, nil
+}
+
+const maxRate = float64(time.Second)
+
+type Config struct {
+	Project string
+	Domain  string
+	// Executions to create per second.
+	Rate float64
+	// How long to generate load for.
+	Duration time.Duration
+	// Maximum number of executions in flight at once.
+	Concurrency int
+	// When set, each created execution is followed by the workflow events propeller would have sent for it.
+	SendEvents  bool
+	LaunchPlans []LaunchPlanMix
+}
+
+func (c Config) validate() error {
+	if c.Project == "" || c.Domain == "" {
+		return fmt.Errorf("a project and domain are required")
+	}
+	if !(c.Rate > 0) || math.IsInf(c.Rate, 1) {
+		return fmt.Errorf("rate must be positive")
+	}
+	if c.Rate > maxRate {
+		// Ticks any closer than a nanosecond apart would truncate the interval to zero, which tickers reject.
+		return fmt.Errorf("rate must be at most %v per second", maxRate)
+	}
+	if c.Concurrency <= 0 {
+		return fmt.Errorf("concurrency must be positive")
+	}
+	if len(c.LaunchPlans) == 0 {
+		return fmt.Errorf("at least one launch plan is required")
+	}
+	for _, launchPlan := range c.LaunchPlans {
+		if launchPlan.Weight <= 0 {
+			return fmt.Errorf("launch plan [%s] must have a positive weight", launchPlan.Name)
+		}
+	}
+	return nil
+}
+
+type Generator struct {
+	client    service.AdminServiceClient
+	config    Config
+	recorder  *recorder
+	totalMix  int
+	random    *rand.Rand
+	randMutex sync.Mutex
+	runID     string
+}
+
+func (g *Generator) pickLaunchPlan() LaunchPlanMix {
+	g.randMutex.Lock()
+	choice := g.random.Intn(g.totalMix)
+	g.randMutex.Unlock()
+	for _, launchPlan := range g.config.LaunchPlans {
+		if choice < launchPlan.Weight {
+			return launchPlan
+		}
+		choice -= launchPlan.Weight
+	}
+	return g.config.LaunchPlans[len(g.config.LaunchPlans)-1]
+}
+
+func (g *Generator) createExecution(ctx context.Context, sequence int) (*core.WorkflowExecutionIdentifier, error) {
+	launchPlan := g.pickLaunchPlan()
+	name := fmt.Sprintf("lg%s%d", g.runID, sequence)
+	start := time.Now()
+	resp, err := g.client.CreateExecution(ctx, &admin.ExecutionCreateRequest{
+		Project: g.config.Project,
+		Domain:  g.config.Domain,
+		Name:    name,
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: &core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      g.config.Project,
+				Domain:       g.config.Domain,
+				Name:         launchPlan.Name,
+				Version:      launchPlan.Version,
+			},
+			Metadata: &admin.ExecutionMetadata{
+				Mode:      admin.ExecutionMetadata_MANUAL,
+				Principal: "loadgen",
+			},
+		},
+		Inputs: &core.LiteralMap{},
+	})
+	if err == nil && (resp.GetId() == nil || resp.GetId().Name != name) {
+		err = fmt.Errorf("unexpected execution identifier [%+v] in response for [%s]", resp.GetId(), name)
+	}
+	g.recorder.record(createExecutionOperation, time.Since(start), err)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Id, nil
+}
+
+func (g *Generator) sendEvents(ctx context.Context, id *core.WorkflowExecutionIdentifier) error {
+	for i, phase := range eventPhases {
+		executionEvent := &event.WorkflowExecutionEvent{
+			ExecutionId: id,
+			ProducerId:  "loadgen",
+			Phase:       phase,
+			OccurredAt:  ptypes.TimestampNow(),
+		}
+		if phase == core.WorkflowExecution_SUCCEEDED {
+			executionEvent.OutputResult = &event.WorkflowExecutionEvent_OutputUri{
+				OutputUri: fmt.Sprintf("s3://loadgen/%s/outputs.pb", id.Name),
+			}
+		}
+		start := time.Now()
+		_, err := g.client.CreateWorkflowEvent(ctx, &admin.WorkflowExecutionEventRequest{
+			RequestId: fmt.Sprintf("%s-%d", id.Name, i),
+			Event:     executionEvent,
+		})
+		g.recorder.record(createEventOperation, time.Since(start), err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) runOne(ctx context.Context, sequence int) {
+	id, err := g.createExecution(ctx, sequence)
+	if err != nil {
+		logger.Warningf(ctx, "failed to create execution %d: %v", sequence, err)
+		return
+	}
+	if !g.config.SendEvents {
+		return
+	}
+	if err := g.sendEvents(ctx, id); err != nil {
+		logger.Warningf(ctx, "failed to send events for execution [%+v]: %v", id, err)
+	}
+}
+
+// Creates executions at the configured rate until the duration elapses or the context is cancelled, then waits for
+// in-flight requests and returns the observed latencies.
+func (g *Generator) Run(ctx context.Context) Report {
+	start := time.Now()
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / g.config.Rate))
+	defer ticker.Stop()
+	deadline := time.After(g.config.Duration)
+	inFlight := make(chan struct{}, g.config.Concurrency)
+	var wg sync.WaitGroup
+	sequence := 0
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-deadline:
+			break loop
+		case <-ticker.C:
+			select {
+			case inFlight <- struct{}{}:
+			default:
+				logger.Warningf(ctx, "concurrency limit of %d reached, skipping a tick", g.config.Concurrency)
+				continue
+			}
+			wg.Add(1)
+			go func(sequence int) {
+				defer wg.Done()
+				defer func() { <-inFlight }()
+				g.runOne(ctx, sequence)
+			}(sequence)
+			sequence++
+		}
+	}
+	wg.Wait()
+	return g.recorder.report(time.Since(start))
+}
+
+func NewGenerator(client service.AdminServiceClient, config Config) (*Generator, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	totalMix := 0
+	for _, launchPlan := range config.LaunchPlans {
+		totalMix += launchPlan.Weight
+	}
+	seed := time.Now().UnixNano()
+	return &Generator{
+		client:   client,
+		config:   config,
+		recorder: newRecorder(),
+		totalMix: totalMix,
+		random:   rand.New(rand.NewSource(seed)),
+		runID:    strconv.FormatInt(seed%100000, 36),
+	}, nil
+}
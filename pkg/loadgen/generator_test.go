@@ -0,0 +1,93 @@
+package loadgen
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLaunchPlanMix(t *testing.T) {
+	mix, err := ParseLaunchPlanMix("name:version")
+	assert.NoError(t, err)
+	assert.Equal(t, LaunchPlanMix{Name: "name", Version: "version", Weight: 1}, mix)
+
+	mix, err = ParseLaunchPlanMix("name:version:3")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, mix.Weight)
+
+	for _, invalid := range []string{"name", ":version", "name:version:0", "name:version:x", "a:b:1:2"} {
+		_, err = ParseLaunchPlanMix(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestNewGenerator_InvalidConfig(t *testing.T) {
+	_, err := NewGenerator(nil, Config{
+		Project:     "project",
+		Domain:      "domain",
+		Rate:        1,
+		Concurrency: 1,
+	})
+	assert.EqualError(t, err, "at least one launch plan is required")
+
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1), 2e9} {
+		_, err = NewGenerator(nil, Config{
+			Project:     "project",
+			Domain:      "domain",
+			Rate:        rate,
+			Concurrency: 1,
+			LaunchPlans: []LaunchPlanMix{{Name: "name", Version: "version", Weight: 1}},
+		})
+		assert.Error(t, err, rate)
+	}
+}
+
+func TestPickLaunchPlan(t *testing.T) {
+	generator, err := NewGenerator(nil, Config{
+		Project:     "project",
+		Domain:      "domain",
+		Rate:        1,
+		Concurrency: 1,
+		LaunchPlans: []LaunchPlanMix{
+			{Name: "heavy", Version: "v", Weight: 9},
+			{Name: "light", Version: "v", Weight: 1},
+		},
+	})
+	assert.NoError(t, err)
+	counts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		counts[generator.pickLaunchPlan().Name]++
+	}
+	assert.True(t, counts["heavy"] > counts["light"])
+	assert.Equal(t, 1000, counts["heavy"]+counts["light"])
+}
+
+func TestRecorderReport(t *testing.T) {
+	recorder := newRecorder()
+	for i := 1; i <= 100; i++ {
+		recorder.record("op", time.Duration(i)*time.Millisecond, nil)
+	}
+	recorder.record("failing", time.Second, errors.New("foo"))
+
+	report := recorder.report(time.Minute)
+	assert.Equal(t, time.Minute, report.Elapsed)
+	assert.Len(t, report.Operations, 2)
+	assert.Equal(t, OperationReport{
+		Operation: "failing",
+		Requests:  1,
+		Errors:    1,
+		P50:       time.Second,
+		P90:       time.Second,
+		P99:       time.Second,
+		Max:       time.Second,
+	}, report.Operations[0])
+	assert.Equal(t, 100, report.Operations[1].Requests)
+	assert.Equal(t, 50*time.Millisecond, report.Operations[1].P50)
+	assert.Equal(t, 90*time.Millisecond, report.Operations[1].P90)
+	assert.Equal(t, 99*time.Millisecond, report.Operations[1].P99)
+	assert.Equal(t, 100*time.Millisecond, report.Operations[1].Max)
+	assert.Contains(t, report.String(), "failing")
+}
@@ -0,0 +1,110 @@
+package loadgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Summarizes the observed latencies and failures for a single admin operation.
+type OperationReport struct {
+	Operation string
+	Requests  int
+	Errors    int
+	P50       time.Duration
+	P90       time.Duration
+	P99       time.Duration
+	Max       time.Duration
+}
+
+type Report struct {
+	Elapsed    time.Duration
+	Operations []OperationReport
+}
+
+func (r Report) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "load generation completed in %v\n", r.Elapsed)
+	fmt.Fprintf(&builder, "%-20s %10s %8s %12s %12s %12s %12s\n",
+		"operation", "requests", "errors", "p50", "p90", "p99", "max")
+	for _, op := range r.Operations {
+		fmt.Fprintf(&builder, "%-20s %10d %8d %12v %12v %12v %12v\n",
+			op.Operation, op.Requests, op.Errors, op.P50, op.P90, op.P99, op.Max)
+	}
+	return builder.String()
+}
+
+type operationStats struct {
+	latencies []time.Duration
+	errors    int
+}
+
+// Collects latencies across concurrent workers.
+type recorder struct {
+	mutex      sync.Mutex
+	operations map[string]*operationStats
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		operations: make(map[string]*operationStats),
+	}
+}
+
+func (r *recorder) record(operation string, latency time.Duration, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	stats, ok := r.operations[operation]
+	if !ok {
+		stats = &operationStats{}
+		r.operations[operation] = stats
+	}
+	stats.latencies = append(stats.latencies, latency)
+	if err != nil {
+		stats.errors++
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(p*float64(len(sorted))+0.5) - 1
+	if index < 0 {
+		index = 0
+	} else if index >= len(sorted) {
+		index = len(sorted) - 1
+	}
+	return sorted[index]
+}
+
+func (r *recorder) report(elapsed time.Duration) Report {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	operations := make([]OperationReport, 0, len(r.operations))
+	for operation, stats := range r.operations {
+		sorted := make([]time.Duration, len(stats.latencies))
+		copy(sorted, stats.latencies)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i] < sorted[j]
+		})
+		operations = append(operations, OperationReport{
+			Operation: operation,
+			Requests:  len(sorted),
+			Errors:    stats.errors,
+			P50:       percentile(sorted, 0.5),
+			P90:       percentile(sorted, 0.9),
+			P99:       percentile(sorted, 0.99),
+			Max:       percentile(sorted, 1),
+		})
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].Operation < operations[j].Operation
+	})
+	return Report{
+		Elapsed:    elapsed,
+		Operations: operations,
+	}
+}
@@ -0,0 +1,171 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+)
+
+const defaultVerboseExecutionDuration = 30 * time.Minute
+
+var levelNames = map[string]logger.Level{
+	"panic":   logger.PanicLevel,
+	"fatal":   logger.FatalLevel,
+	"error":   logger.ErrorLevel,
+	"warn":    logger.WarnLevel,
+	"warning": logger.WarnLevel,
+	"info":    logger.InfoLevel,
+	"debug":   logger.DebugLevel,
+}
+
+func parseLevel(name string) (logger.Level, error) {
+	level, ok := levelNames[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("unrecognized log level [%s]", name)
+	}
+	return level, nil
+}
+
+func levelName(level logger.Level) string {
+	for name, candidate := range levelNames {
+		if candidate == level && name != "warning" {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", level)
+}
+
+type executionIdentifier struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+}
+
+// Describes a single change to the runtime log configuration. Exactly one of the following is applied:
+//   - Execution set: toggles verbose logging for that execution (for DurationSeconds, or until Clear is sent).
+//   - Module set: sets (or with Clear, removes) the module override to Level.
+//   - Otherwise: sets the global level to Level.
+type LogLevelUpdate struct {
+	Level           string               `json:"level"`
+	Module          string               `json:"module"`
+	Execution       *executionIdentifier `json:"execution"`
+	DurationSeconds int                  `json:"durationSeconds"`
+	Clear           bool                 `json:"clear"`
+}
+
+type LogLevelState struct {
+	// The replica the state applies to, i.e. its hostname.
+	Replica           string               `json:"replica"`
+	Level             string               `json:"level"`
+	Modules           map[Module]string    `json:"modules"`
+	VerboseExecutions map[string]time.Time `json:"verboseExecutions"`
+}
+
+func getState() LogLevelState {
+	modules := make(map[Module]string)
+	for module, level := range GetModuleLevels() {
+		modules[module] = levelName(level)
+	}
+	replica, _ := os.Hostname()
+	return LogLevelState{
+		Replica:           replica,
+		Level:             levelName(GetGlobalLevel()),
+		Modules:           modules,
+		VerboseExecutions: GetVerboseExecutions(),
+	}
+}
+
+func applyUpdate(ctx context.Context, update LogLevelUpdate) error {
+	if update.Execution != nil {
+		id := core.WorkflowExecutionIdentifier{
+			Project: update.Execution.Project,
+			Domain:  update.Execution.Domain,
+			Name:    update.Execution.Name,
+		}
+		if id.Project == "" || id.Domain == "" || id.Name == "" {
+			return fmt.Errorf("execution must specify a project, domain and name")
+		}
+		if update.Clear {
+			DisableVerboseExecution(id)
+			logger.Infof(ctx, "disabled verbose logging for execution [%+v]", id)
+			return nil
+		}
+		duration := defaultVerboseExecutionDuration
+		if update.DurationSeconds > 0 {
+			duration = time.Duration(update.DurationSeconds) * time.Second
+		}
+		EnableVerboseExecution(id, duration)
+		logger.Infof(ctx, "enabled verbose logging for execution [%+v] for %v", id, duration)
+		return nil
+	}
+	if update.Module != "" && update.Clear {
+		if !IsKnownModule(update.Module) {
+			return fmt.Errorf("unrecognized module [%s]", update.Module)
+		}
+		ClearModuleLevel(update.Module)
+		logger.Infof(ctx, "cleared log level override for module [%s]", update.Module)
+		return nil
+	}
+	level, err := parseLevel(update.Level)
+	if err != nil {
+		return err
+	}
+	if update.Module != "" {
+		if err := SetModuleLevel(update.Module, level); err != nil {
+			return err
+		}
+		logger.Infof(ctx, "set log level override for module [%s] to [%s]", update.Module, update.Level)
+		return nil
+	}
+	if err := SetGlobalLevel(level); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "set global log level to [%s]", update.Level)
+	return nil
+}
+
+func writeState(ctx context.Context, writer http.ResponseWriter) {
+	bytes, err := json.Marshal(getState())
+	if err != nil {
+		logger.Errorf(ctx, "Error marshaling log level state into JSON %s", err)
+		http.Error(writer, "Error marshaling response into JSON", http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	if _, err := writer.Write(bytes); err != nil {
+		logger.Errorf(ctx, "failed to write log level state, error: %s", err)
+	}
+}
+
+// Returns a handler that serves the current log configuration on GET and applies a LogLevelUpdate on PUT or POST.
+// The configuration only lives in the memory of the replica serving the request, which is reported along with it, and
+// is reset when the replica restarts. Since debug logs include request specs, updates are only served to operators when
+// auth is enabled, see auth.RequireOperator.
+func GetLogLevelHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var update LogLevelUpdate
+			if err := json.NewDecoder(request.Body).Decode(&update); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid log level update: %v", err), http.StatusBadRequest)
+				return
+			}
+			if err := applyUpdate(ctx, update); err != nil {
+				http.Error(writer, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeState(ctx, writer)
+	}
+}
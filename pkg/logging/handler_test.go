@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLogLevelHandler(t *testing.T) {
+	defer resetController()
+	assert.NoError(t, SetGlobalLevel(logger.InfoLevel))
+	handler := GetLogLevelHandler(context.Background())
+
+	req := httptest.NewRequest(http.MethodPut, "/logging", strings.NewReader(`{"level": "debug", "module": "executions"}`))
+	w := httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var state LogLevelState
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &state))
+	assert.NotEmpty(t, state.Replica)
+	assert.Equal(t, "info", state.Level)
+	assert.Equal(t, map[Module]string{Executions: "debug"}, state.Modules)
+
+	req = httptest.NewRequest(http.MethodPut, "/logging",
+		strings.NewReader(`{"execution": {"project": "p", "domain": "d", "name": "n"}, "durationSeconds": 60}`))
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &state))
+	assert.Contains(t, state.VerboseExecutions, "p/d/n")
+
+	req = httptest.NewRequest(http.MethodPut, "/logging", strings.NewReader(`{"level": "loud"}`))
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/logging", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
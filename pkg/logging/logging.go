@@ -0,0 +1,198 @@
+// Runtime controls for log verbosity. The underlying flytestdlib logger only supports a single, global level. This
+// package layers module-scoped overrides and request-scoped verbose logging for individual executions on top of it so
+// that operators can debug production issues without restarting admin.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/contextutils"
+	"github.com/lyft/flytestdlib/logger"
+)
+
+type Module = string
+
+const (
+	Executions    Module = "executions"
+	Repositories  Module = "repositories"
+	Notifications Module = "notifications"
+)
+
+var knownModules = map[Module]bool{
+	Executions:    true,
+	Repositories:  true,
+	Notifications: true,
+}
+
+const executionIDKey contextutils.Key = "logging_execution_id"
+
+// Invoked whenever the effective level of a module changes, e.g. to toggle verbose SQL logging for repositories.
+type ModuleLevelListener func(level logger.Level)
+
+type levelController struct {
+	mutex             sync.RWMutex
+	moduleLevels      map[Module]logger.Level
+	verboseExecutions map[string]time.Time
+	listeners         map[Module][]ModuleLevelListener
+	now               func() time.Time
+}
+
+var controller = newLevelController()
+
+func newLevelController() *levelController {
+	return &levelController{
+		moduleLevels:      make(map[Module]logger.Level),
+		verboseExecutions: make(map[string]time.Time),
+		listeners:         make(map[Module][]ModuleLevelListener),
+		now:               time.Now,
+	}
+}
+
+func executionKey(id core.WorkflowExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", id.Project, id.Domain, id.Name)
+}
+
+func IsKnownModule(module Module) bool {
+	return knownModules[module]
+}
+
+// Returns the log level currently applied globally.
+func GetGlobalLevel() logger.Level {
+	return logger.GetConfig().Level
+}
+
+// Updates the global log level for all loggers.
+func SetGlobalLevel(level logger.Level) error {
+	cfg := *logger.GetConfig()
+	cfg.Level = level
+	return logger.SetConfig(&cfg)
+}
+
+// Overrides the log level for a single module. The override only ever raises verbosity above the global level.
+func SetModuleLevel(module Module, level logger.Level) error {
+	if !IsKnownModule(module) {
+		return fmt.Errorf("unrecognized module [%s]", module)
+	}
+	controller.mutex.Lock()
+	controller.moduleLevels[module] = level
+	listeners := controller.listeners[module]
+	controller.mutex.Unlock()
+	for _, listener := range listeners {
+		listener(level)
+	}
+	return nil
+}
+
+// Removes any override for a module so that it once again follows the global level.
+func ClearModuleLevel(module Module) {
+	controller.mutex.Lock()
+	delete(controller.moduleLevels, module)
+	listeners := controller.listeners[module]
+	controller.mutex.Unlock()
+	for _, listener := range listeners {
+		listener(GetGlobalLevel())
+	}
+}
+
+// Returns all active module overrides.
+func GetModuleLevels() map[Module]logger.Level {
+	controller.mutex.RLock()
+	defer controller.mutex.RUnlock()
+	levels := make(map[Module]logger.Level, len(controller.moduleLevels))
+	for module, level := range controller.moduleLevels {
+		levels[module] = level
+	}
+	return levels
+}
+
+// Registers a listener to be notified when a module's level override changes.
+func RegisterModuleLevelListener(module Module, listener ModuleLevelListener) {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	controller.listeners[module] = append(controller.listeners[module], listener)
+}
+
+// Enables verbose logging for every request that operates on the given execution until the duration elapses.
+func EnableVerboseExecution(id core.WorkflowExecutionIdentifier, duration time.Duration) {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	controller.verboseExecutions[executionKey(id)] = controller.now().Add(duration)
+}
+
+func DisableVerboseExecution(id core.WorkflowExecutionIdentifier) {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	delete(controller.verboseExecutions, executionKey(id))
+}
+
+// Returns the executions with verbose logging enabled mapped to when verbose logging expires.
+func GetVerboseExecutions() map[string]time.Time {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	executions := make(map[string]time.Time, len(controller.verboseExecutions))
+	now := controller.now()
+	for key, expiry := range controller.verboseExecutions {
+		if now.After(expiry) {
+			delete(controller.verboseExecutions, key)
+			continue
+		}
+		executions[key] = expiry
+	}
+	return executions
+}
+
+// Tags the context with the execution a request operates on so that request-scoped verbose logging can apply.
+func WithExecutionID(ctx context.Context, id *core.WorkflowExecutionIdentifier) context.Context {
+	if id == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, executionIDKey, executionKey(*id))
+}
+
+// Returns the project/domain/name of the execution the context was tagged with, empty if it wasn't.
+func GetExecutionID(ctx context.Context) string {
+	key, _ := ctx.Value(executionIDKey).(string)
+	return key
+}
+
+func isVerboseExecution(ctx context.Context) bool {
+	key, ok := ctx.Value(executionIDKey).(string)
+	if !ok {
+		return false
+	}
+	controller.mutex.RLock()
+	expiry, ok := controller.verboseExecutions[key]
+	controller.mutex.RUnlock()
+	return ok && controller.now().Before(expiry)
+}
+
+// Returns whether a message at the given level should be emitted for the module and request context.
+func IsLoggable(ctx context.Context, module Module, level logger.Level) bool {
+	if logger.IsLoggable(ctx, level) {
+		return true
+	}
+	controller.mutex.RLock()
+	moduleLevel, ok := controller.moduleLevels[module]
+	controller.mutex.RUnlock()
+	if ok && level <= moduleLevel {
+		return true
+	}
+	return isVerboseExecution(ctx)
+}
+
+// Logs a debug message for the given module with sensitive values in args redacted. When debug logging is disabled
+// globally but enabled through a module override or a verbose execution, the message is emitted at info level (tagged
+// as debug) so that it isn't filtered by the global logger.
+func Debugf(ctx context.Context, module Module, format string, args ...interface{}) {
+	if logger.IsLoggable(ctx, logger.DebugLevel) {
+		logger.Debugf(ctx, format, redactArgs(args)...)
+		return
+	}
+	if IsLoggable(ctx, module, logger.DebugLevel) {
+		logger.Infof(ctx, "[debug:%s] "+format, append([]interface{}{module}, redactArgs(args)...)...)
+	}
+}
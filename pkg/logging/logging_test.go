@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+var testExecutionID = core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func resetController() {
+	controller = newLevelController()
+}
+
+func TestSetModuleLevel(t *testing.T) {
+	defer resetController()
+	assert.NoError(t, SetGlobalLevel(logger.InfoLevel))
+
+	var observedLevel logger.Level
+	RegisterModuleLevelListener(Repositories, func(level logger.Level) {
+		observedLevel = level
+	})
+	assert.NoError(t, SetModuleLevel(Repositories, logger.DebugLevel))
+	assert.Equal(t, logger.DebugLevel, observedLevel)
+	assert.True(t, IsLoggable(context.Background(), Repositories, logger.DebugLevel))
+	assert.False(t, IsLoggable(context.Background(), Executions, logger.DebugLevel))
+	assert.Equal(t, map[Module]logger.Level{Repositories: logger.DebugLevel}, GetModuleLevels())
+
+	ClearModuleLevel(Repositories)
+	assert.Equal(t, logger.InfoLevel, observedLevel)
+	assert.False(t, IsLoggable(context.Background(), Repositories, logger.DebugLevel))
+
+	assert.EqualError(t, SetModuleLevel("foo", logger.DebugLevel), "unrecognized module [foo]")
+}
+
+func TestVerboseExecution(t *testing.T) {
+	defer resetController()
+	assert.NoError(t, SetGlobalLevel(logger.InfoLevel))
+	now := time.Now()
+	controller.now = func() time.Time {
+		return now
+	}
+
+	ctx := WithExecutionID(context.Background(), &testExecutionID)
+	assert.Equal(t, "project/domain/name", GetExecutionID(ctx))
+	assert.Empty(t, GetExecutionID(context.Background()))
+	assert.False(t, IsLoggable(ctx, Executions, logger.DebugLevel))
+
+	EnableVerboseExecution(testExecutionID, time.Minute)
+	assert.True(t, IsLoggable(ctx, Executions, logger.DebugLevel))
+	assert.False(t, IsLoggable(context.Background(), Executions, logger.DebugLevel))
+	assert.Contains(t, GetVerboseExecutions(), "project/domain/name")
+
+	now = now.Add(2 * time.Minute)
+	assert.False(t, IsLoggable(ctx, Executions, logger.DebugLevel))
+	assert.Empty(t, GetVerboseExecutions())
+
+	EnableVerboseExecution(testExecutionID, time.Minute)
+	DisableVerboseExecution(testExecutionID)
+	assert.False(t, IsLoggable(ctx, Executions, logger.DebugLevel))
+}
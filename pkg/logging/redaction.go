@@ -0,0 +1,245 @@
+package logging
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+// Replaces sensitive values in logs and error messages.
+const RedactedValue = "[REDACTED]"
+
+// Matches every annotation key.
+const allAnnotations = "*"
+
+type redactionRules struct {
+	mutex          sync.RWMutex
+	inputs         map[string]bool
+	annotations    map[string]bool
+	allAnnotations bool
+}
+
+var rules = &redactionRules{}
+
+// Configures which input names and annotation keys hold sensitive values. An annotation key of "*" masks all annotation
+// values.
+func SetRedactionRules(sensitiveInputs, sensitiveAnnotations []string) {
+	inputs := make(map[string]bool, len(sensitiveInputs))
+	for _, name := range sensitiveInputs {
+		inputs[name] = true
+	}
+	annotations := make(map[string]bool, len(sensitiveAnnotations))
+	all := false
+	for _, key := range sensitiveAnnotations {
+		if key == allAnnotations {
+			all = true
+		}
+		annotations[key] = true
+	}
+	rules.mutex.Lock()
+	defer rules.mutex.Unlock()
+	rules.inputs = inputs
+	rules.annotations = annotations
+	rules.allAnnotations = all
+}
+
+func (r *redactionRules) isEmpty() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.inputs) == 0 && len(r.annotations) == 0
+}
+
+func (r *redactionRules) isSensitiveInput(name string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.inputs[name]
+}
+
+func (r *redactionRules) isSensitiveAnnotation(key string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.allAnnotations || r.annotations[key]
+}
+
+func redactedLiteral() *core.Literal {
+	return &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: &core.Primitive{
+						Value: &core.Primitive_StringValue{
+							StringValue: RedactedValue,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func redactLiteralMap(literals *core.LiteralMap) {
+	if literals == nil {
+		return
+	}
+	for name := range literals.Literals {
+		if rules.isSensitiveInput(name) {
+			literals.Literals[name] = redactedLiteral()
+		}
+	}
+}
+
+func redactParameterMap(parameters *core.ParameterMap) {
+	if parameters == nil {
+		return
+	}
+	for name, parameter := range parameters.Parameters {
+		if parameter == nil || parameter.GetDefault() == nil || !rules.isSensitiveInput(name) {
+			continue
+		}
+		parameter.Behavior = &core.Parameter_Default{
+			Default: redactedLiteral(),
+		}
+	}
+}
+
+func redactAnnotations(annotations *admin.Annotations) {
+	if annotations == nil {
+		return
+	}
+	for key := range annotations.Values {
+		if rules.isSensitiveAnnotation(key) {
+			annotations.Values[key] = RedactedValue
+		}
+	}
+}
+
+// Masks sensitive fields of messages which (transitively) hold inputs or annotations in place.
+func redactMessage(message proto.Message) {
+	switch m := message.(type) {
+	case *core.LiteralMap:
+		redactLiteralMap(m)
+	case *core.ParameterMap:
+		redactParameterMap(m)
+	case *admin.Annotations:
+		redactAnnotations(m)
+	case *admin.ExecutionSpec:
+		if m != nil {
+			redactLiteralMap(m.Inputs)
+			redactAnnotations(m.Annotations)
+		}
+	case *admin.ExecutionCreateRequest:
+		if m != nil {
+			redactLiteralMap(m.Inputs)
+			redactMessage(m.Spec)
+		}
+	case *admin.Execution:
+		if m != nil {
+			redactMessage(m.Spec)
+			redactLiteralMap(m.GetClosure().GetComputedInputs())
+		}
+	case *admin.LaunchPlanSpec:
+		if m != nil {
+			redactParameterMap(m.DefaultInputs)
+			redactLiteralMap(m.FixedInputs)
+			redactAnnotations(m.Annotations)
+		}
+	case *admin.LaunchPlanCreateRequest:
+		if m != nil {
+			redactMessage(m.Spec)
+		}
+	case *admin.LaunchPlan:
+		if m != nil {
+			redactMessage(m.Spec)
+		}
+	}
+}
+
+// Returns a copy of arg with sensitive inputs and annotation values masked. Protobuf messages are supported whether
+// passed by pointer or by value, anything else is returned as is.
+func Redact(arg interface{}) interface{} {
+	if arg == nil || rules.isEmpty() {
+		return arg
+	}
+	if message, ok := arg.(proto.Message); ok {
+		if reflect.ValueOf(message).IsNil() {
+			return arg
+		}
+		redacted := proto.Clone(message)
+		redactMessage(redacted)
+		return redacted
+	}
+	// Requests are frequently passed around (and logged) by value.
+	value := reflect.ValueOf(arg)
+	if value.Kind() != reflect.Struct {
+		return arg
+	}
+	pointer := reflect.New(value.Type())
+	pointer.Elem().Set(value)
+	message, ok := pointer.Interface().(proto.Message)
+	if !ok {
+		return arg
+	}
+	redacted := proto.Clone(message)
+	redactMessage(redacted)
+	return reflect.ValueOf(redacted).Elem().Interface()
+}
+
+func redactArgs(args []interface{}) []interface{} {
+	if rules.isEmpty() {
+		return args
+	}
+	redacted := make([]interface{}, len(args))
+	for i, arg := range args {
+		redacted[i] = Redact(arg)
+	}
+	return redacted
+}
+
+// Returns the string values of sensitive inputs and annotations, e.g. to scrub them from errors raised by other
+// systems which may echo them back.
+func GetSensitiveValues(inputs *core.LiteralMap, annotations map[string]string) []string {
+	if rules.isEmpty() {
+		return nil
+	}
+	var values []string
+	for name, literal := range inputs.GetLiterals() {
+		if !rules.isSensitiveInput(name) {
+			continue
+		}
+		if value := literal.GetScalar().GetPrimitive().GetStringValue(); value != "" {
+			values = append(values, value)
+		}
+	}
+	for key, value := range annotations {
+		if value != "" && rules.isSensitiveAnnotation(key) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Masks any occurrence of the given values in the error message, preserving the error code of admin errors.
+func RedactError(err error, sensitiveValues []string) error {
+	if err == nil || len(sensitiveValues) == 0 {
+		return err
+	}
+	message := err.Error()
+	redactedMessage := message
+	for _, value := range sensitiveValues {
+		redactedMessage = strings.Replace(redactedMessage, value, RedactedValue, -1)
+	}
+	if redactedMessage == message {
+		return err
+	}
+	if adminErr, ok := err.(errors.FlyteAdminError); ok {
+		return errors.NewFlyteAdminError(adminErr.Code(), redactedMessage)
+	}
+	return fmt.Errorf("%s", redactedMessage)
+}
@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+)
+
+func getStringLiteral(value string) *core.Literal {
+	return &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: &core.Primitive{
+						Value: &core.Primitive_StringValue{
+							StringValue: value,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getExecutionCreateRequest() admin.ExecutionCreateRequest {
+	return admin.ExecutionCreateRequest{
+		Name: "name",
+		Inputs: &core.LiteralMap{
+			Literals: map[string]*core.Literal{
+				"password": getStringLiteral("hunter2"),
+				"region":   getStringLiteral("us-east-1"),
+			},
+		},
+		Spec: &admin.ExecutionSpec{
+			Annotations: &admin.Annotations{
+				Values: map[string]string{
+					"token": "abc123",
+					"team":  "flyte",
+				},
+			},
+		},
+	}
+}
+
+func TestRedact(t *testing.T) {
+	defer SetRedactionRules(nil, nil)
+	request := getExecutionCreateRequest()
+
+	// Nothing is redacted unless configured.
+	assert.Equal(t, request, Redact(request))
+
+	SetRedactionRules([]string{"password"}, []string{"token"})
+	redacted, ok := Redact(request).(admin.ExecutionCreateRequest)
+	assert.True(t, ok)
+	assert.Equal(t, RedactedValue,
+		redacted.Inputs.Literals["password"].GetScalar().GetPrimitive().GetStringValue())
+	assert.Equal(t, "us-east-1", redacted.Inputs.Literals["region"].GetScalar().GetPrimitive().GetStringValue())
+	assert.Equal(t, RedactedValue, redacted.Spec.Annotations.Values["token"])
+	assert.Equal(t, "flyte", redacted.Spec.Annotations.Values["team"])
+	assert.NotContains(t, fmt.Sprintf("%+v", redacted), "hunter2")
+
+	// The original is left untouched.
+	assert.Equal(t, "hunter2", request.Inputs.Literals["password"].GetScalar().GetPrimitive().GetStringValue())
+
+	redactedPointer, ok := Redact(&request).(*admin.ExecutionCreateRequest)
+	assert.True(t, ok)
+	assert.Equal(t, RedactedValue, redactedPointer.Spec.Annotations.Values["token"])
+
+	var nilRequest *admin.ExecutionCreateRequest
+	assert.Nil(t, Redact(nilRequest))
+	assert.Equal(t, "foo", Redact("foo"))
+}
+
+func TestRedact_AllAnnotations(t *testing.T) {
+	defer SetRedactionRules(nil, nil)
+	SetRedactionRules(nil, []string{"*"})
+	redacted := Redact(getExecutionCreateRequest().Spec).(*admin.ExecutionSpec)
+	assert.Equal(t, RedactedValue, redacted.Annotations.Values["token"])
+	assert.Equal(t, RedactedValue, redacted.Annotations.Values["team"])
+}
+
+func TestRedactError(t *testing.T) {
+	defer SetRedactionRules(nil, nil)
+	SetRedactionRules([]string{"password"}, []string{"token"})
+	request := getExecutionCreateRequest()
+	values := GetSensitiveValues(request.Inputs, request.Spec.Annotations.Values)
+	assert.ElementsMatch(t, []string{"hunter2", "abc123"}, values)
+
+	err := RedactError(flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "bad value hunter2"), values)
+	assert.Equal(t, "bad value [REDACTED]", err.Error())
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	err = RedactError(errors.New("annotation abc123 is invalid"), values)
+	assert.Equal(t, "annotation [REDACTED] is invalid", err.Error())
+
+	original := errors.New("nothing sensitive")
+	assert.Equal(t, original, RedactError(original, values))
+	assert.Nil(t, RedactError(nil, values))
+}
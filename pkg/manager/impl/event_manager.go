@@ -0,0 +1,189 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+)
+
+const maxWorkflowEventsBatchSize = 1000
+
+type eventMetrics struct {
+	Scope          promutils.Scope
+	EventsApplied  prometheus.Counter
+	EventsRejected prometheus.Counter
+	BatchSize      prometheus.Summary
+}
+
+type EventManager struct {
+	db                   repositories.RepositoryInterface
+	executionManager     interfaces.ExecutionInterface
+	nodeExecutionManager interfaces.NodeExecutionInterface
+	taskExecutionManager interfaces.TaskExecutionInterface
+	metrics              eventMetrics
+}
+
+// Returns the workflow execution an event belongs to.
+func getWorkflowEventExecutionID(event interfaces.WorkflowEvent) (*core.WorkflowExecutionIdentifier, error) {
+	executionIDs := make([]*core.WorkflowExecutionIdentifier, 0, 1)
+	if event.WorkflowEvent != nil {
+		executionIDs = append(executionIDs, event.WorkflowEvent.GetEvent().GetExecutionId())
+	}
+	if event.NodeEvent != nil {
+		executionIDs = append(executionIDs, event.NodeEvent.GetEvent().GetId().GetExecutionId())
+	}
+	if event.TaskEvent != nil {
+		executionIDs = append(executionIDs, event.TaskEvent.GetEvent().GetParentNodeExecutionId().GetExecutionId())
+	}
+	if len(executionIDs) != 1 {
+		return nil, errors.NewFlyteAdminError(codes.InvalidArgument,
+			"exactly one of a workflow, node or task event must be set")
+	}
+	if err := validation.ValidateWorkflowExecutionIdentifier(executionIDs[0]); err != nil {
+		return nil, err
+	}
+	return executionIDs[0], nil
+}
+
+func (m *EventManager) applyWorkflowEvent(ctx context.Context, event interfaces.WorkflowEvent) error {
+	var err error
+	switch {
+	case event.WorkflowEvent != nil:
+		_, err = m.executionManager.CreateWorkflowEvent(ctx, *event.WorkflowEvent)
+	case event.NodeEvent != nil:
+		_, err = m.nodeExecutionManager.CreateNodeEvent(ctx, *event.NodeEvent)
+	case event.TaskEvent != nil:
+		_, err = m.taskExecutionManager.CreateTaskExecutionEvent(ctx, *event.TaskEvent)
+	}
+	return err
+}
+
+func getWorkflowEventStatus(err error) interfaces.WorkflowEventStatus {
+	if err == nil {
+		return interfaces.WorkflowEventStatus{
+			Code: codes.OK.String(),
+		}
+	}
+	errStatus := status.Convert(err)
+	return interfaces.WorkflowEventStatus{
+		Code:    errStatus.Code().String(),
+		Message: errStatus.Message(),
+	}
+}
+
+// Applies the events for a single workflow execution within one transaction. Each event is applied in a savepoint of
+// its own, so events which were already recorded only roll back their own writes without failing the transaction. Any
+// other failure rolls back the events applied before it and skips the ones after it. Side effects of the events, such
+// as notifications, are deferred by the managers until the transaction commits.
+func (m *EventManager) applyExecutionWorkflowEvents(
+	ctx context.Context, events []interfaces.WorkflowEvent, indices []int, statuses []interfaces.WorkflowEventStatus) {
+	var failedIndex = -1
+	err := m.db.Transaction(ctx, func(ctx context.Context) error {
+		for _, index := range indices {
+			err := m.db.Transaction(ctx, func(ctx context.Context) error {
+				return m.applyWorkflowEvent(ctx, events[index])
+			})
+			statuses[index] = getWorkflowEventStatus(err)
+			if err != nil && status.Code(err) != codes.AlreadyExists {
+				failedIndex = index
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		return
+	}
+	abortedStatus := interfaces.WorkflowEventStatus{
+		Code:    codes.Aborted.String(),
+		Message: "not applied as another event for the same execution failed",
+	}
+	for _, index := range indices {
+		if failedIndex < 0 {
+			// The transaction itself failed to commit.
+			statuses[index] = getWorkflowEventStatus(err)
+		} else if index != failedIndex {
+			statuses[index] = abortedStatus
+		}
+	}
+}
+
+func (m *EventManager) CreateWorkflowEvents(
+	ctx context.Context, request interfaces.WorkflowEventsRequest) (*interfaces.WorkflowEventsResponse, error) {
+	if len(request.Events) == 0 {
+		return nil, errors.NewFlyteAdminError(codes.InvalidArgument, "missing events")
+	}
+	if len(request.Events) > maxWorkflowEventsBatchSize {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"a batch cannot exceed %d events, found %d", maxWorkflowEventsBatchSize, len(request.Events))
+	}
+	m.metrics.BatchSize.Observe(float64(len(request.Events)))
+
+	statuses := make([]interfaces.WorkflowEventStatus, len(request.Events))
+	// Events are grouped by execution, preserving the order in which executions first appear.
+	executionIDs := make([]core.WorkflowExecutionIdentifier, 0)
+	executionEventIndices := make(map[core.WorkflowExecutionIdentifier][]int)
+	for index, event := range request.Events {
+		executionID, err := getWorkflowEventExecutionID(event)
+		if err != nil {
+			statuses[index] = getWorkflowEventStatus(err)
+			continue
+		}
+		key := core.WorkflowExecutionIdentifier{
+			Project: executionID.Project,
+			Domain:  executionID.Domain,
+			Name:    executionID.Name,
+		}
+		if _, ok := executionEventIndices[key]; !ok {
+			executionIDs = append(executionIDs, key)
+		}
+		executionEventIndices[key] = append(executionEventIndices[key], index)
+	}
+	for _, executionID := range executionIDs {
+		m.applyExecutionWorkflowEvents(ctx, request.Events, executionEventIndices[executionID], statuses)
+	}
+
+	for _, eventStatus := range statuses {
+		if eventStatus.Code == codes.OK.String() {
+			m.metrics.EventsApplied.Inc()
+		} else {
+			m.metrics.EventsRejected.Inc()
+		}
+	}
+	logger.Debugf(ctx, "Applied a batch of %d events for %d executions", len(request.Events), len(executionIDs))
+	return &interfaces.WorkflowEventsResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+func NewEventManager(
+	db repositories.RepositoryInterface, executionManager interfaces.ExecutionInterface,
+	nodeExecutionManager interfaces.NodeExecutionInterface, taskExecutionManager interfaces.TaskExecutionInterface,
+	scope promutils.Scope) interfaces.EventInterface {
+	metrics := eventMetrics{
+		Scope: scope,
+		EventsApplied: scope.MustNewCounter("events_applied",
+			"overall count of batched events which were applied"),
+		EventsRejected: scope.MustNewCounter("events_rejected",
+			"overall count of batched events which were not applied"),
+		BatchSize: scope.MustNewSummary("batch_size",
+			"number of events in each batch"),
+	}
+	return &EventManager{
+		db:                   db,
+		executionManager:     executionManager,
+		nodeExecutionManager: nodeExecutionManager,
+		taskExecutionManager: taskExecutionManager,
+		metrics:              metrics,
+	}
+}
@@ -0,0 +1,185 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+)
+
+// Records whether each transaction, and each savepoint nested within one, was rolled back.
+type transactionRecordingRepository struct {
+	repositories.RepositoryInterface
+	depth                int
+	rolledBack           []bool
+	savepointsRolledBack []bool
+}
+
+func (r *transactionRecordingRepository) Transaction(
+	ctx context.Context, fn func(ctx context.Context) error) error {
+	r.depth++
+	err := fn(ctx)
+	r.depth--
+	if r.depth > 0 {
+		r.savepointsRolledBack = append(r.savepointsRolledBack, err != nil)
+	} else {
+		r.rolledBack = append(r.rolledBack, err != nil)
+	}
+	return err
+}
+
+func getWorkflowEvent(name string) interfaces.WorkflowEvent {
+	return interfaces.WorkflowEvent{
+		WorkflowEvent: &admin.WorkflowExecutionEventRequest{
+			RequestId: name,
+			Event: &event.WorkflowExecutionEvent{
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    name,
+				},
+				Phase: core.WorkflowExecution_RUNNING,
+			},
+		},
+	}
+}
+
+func getNodeEvent(executionName, nodeID string) interfaces.WorkflowEvent {
+	return interfaces.WorkflowEvent{
+		NodeEvent: &admin.NodeExecutionEventRequest{
+			Event: &event.NodeExecutionEvent{
+				Id: &core.NodeExecutionIdentifier{
+					NodeId: nodeID,
+					ExecutionId: &core.WorkflowExecutionIdentifier{
+						Project: "project",
+						Domain:  "domain",
+						Name:    executionName,
+					},
+				},
+				Phase: core.NodeExecution_RUNNING,
+			},
+		},
+	}
+}
+
+func getTestEventManager(repository repositories.RepositoryInterface) (
+	interfaces.EventInterface, *mocks.MockExecutionManager, *mocks.MockNodeExecutionManager) {
+	executionManager := mocks.MockExecutionManager{}
+	nodeExecutionManager := mocks.MockNodeExecutionManager{}
+	return NewEventManager(repository, &executionManager, &nodeExecutionManager, &mocks.MockTaskExecutionManager{},
+		mockScope.NewTestScope()), &executionManager, &nodeExecutionManager
+}
+
+func TestCreateWorkflowEvents(t *testing.T) {
+	repository := &transactionRecordingRepository{
+		RepositoryInterface: repositoryMocks.NewMockRepository(),
+	}
+	eventManager, executionManager, nodeExecutionManager := getTestEventManager(repository)
+	var applied []string
+	executionManager.SetCreateEventCallback(
+		func(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
+			*admin.WorkflowExecutionEventResponse, error) {
+			applied = append(applied, request.Event.ExecutionId.Name)
+			return &admin.WorkflowExecutionEventResponse{}, nil
+		})
+	nodeExecutionManager.SetCreateNodeEventCallback(
+		func(ctx context.Context, request admin.NodeExecutionEventRequest) (
+			*admin.NodeExecutionEventResponse, error) {
+			applied = append(applied, request.Event.Id.ExecutionId.Name+"/"+request.Event.Id.NodeId)
+			if request.Event.Id.NodeId == "recorded" {
+				return nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already recorded")
+			}
+			return &admin.NodeExecutionEventResponse{}, nil
+		})
+
+	response, err := eventManager.CreateWorkflowEvents(context.Background(), interfaces.WorkflowEventsRequest{
+		Events: []interfaces.WorkflowEvent{
+			getWorkflowEvent("a"),
+			getWorkflowEvent("b"),
+			getNodeEvent("a", "recorded"),
+			getNodeEvent("a", "node"),
+			{},
+		},
+	})
+	assert.NoError(t, err)
+	// Events are applied per execution, in order.
+	assert.Equal(t, []string{"a", "a/recorded", "a/node", "b"}, applied)
+	assert.Equal(t, []bool{false, false}, repository.rolledBack)
+	// Only the savepoint of the event which was already recorded is rolled back.
+	assert.Equal(t, []bool{false, true, false, false}, repository.savepointsRolledBack)
+	assert.Equal(t, []interfaces.WorkflowEventStatus{
+		{Code: "OK"},
+		{Code: "OK"},
+		{Code: "AlreadyExists", Message: "already recorded"},
+		{Code: "OK"},
+		{Code: "InvalidArgument", Message: "exactly one of a workflow, node or task event must be set"},
+	}, response.Statuses)
+}
+
+func TestCreateWorkflowEvents_RollsBackExecution(t *testing.T) {
+	repository := &transactionRecordingRepository{
+		RepositoryInterface: repositoryMocks.NewMockRepository(),
+	}
+	eventManager, executionManager, nodeExecutionManager := getTestEventManager(repository)
+	executionManager.SetCreateEventCallback(
+		func(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
+			*admin.WorkflowExecutionEventResponse, error) {
+			return &admin.WorkflowExecutionEventResponse{}, nil
+		})
+	var appliedNodes []string
+	nodeExecutionManager.SetCreateNodeEventCallback(
+		func(ctx context.Context, request admin.NodeExecutionEventRequest) (
+			*admin.NodeExecutionEventResponse, error) {
+			appliedNodes = append(appliedNodes, request.Event.Id.NodeId)
+			if request.Event.Id.NodeId == "invalid" {
+				return nil, flyteAdminErrors.NewFlyteAdminError(codes.FailedPrecondition, "invalid phase transition")
+			}
+			return &admin.NodeExecutionEventResponse{}, nil
+		})
+
+	response, err := eventManager.CreateWorkflowEvents(context.Background(), interfaces.WorkflowEventsRequest{
+		Events: []interfaces.WorkflowEvent{
+			getWorkflowEvent("a"),
+			getNodeEvent("a", "invalid"),
+			getNodeEvent("a", "skipped"),
+			getWorkflowEvent("b"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"invalid"}, appliedNodes)
+	assert.Equal(t, []bool{true, false}, repository.rolledBack)
+	assert.Equal(t, []bool{false, true, false}, repository.savepointsRolledBack)
+	aborted := interfaces.WorkflowEventStatus{
+		Code:    "Aborted",
+		Message: "not applied as another event for the same execution failed",
+	}
+	assert.Equal(t, []interfaces.WorkflowEventStatus{
+		aborted,
+		{Code: "FailedPrecondition", Message: "invalid phase transition"},
+		aborted,
+		{Code: "OK"},
+	}, response.Statuses)
+}
+
+func TestCreateWorkflowEvents_InvalidBatch(t *testing.T) {
+	eventManager, _, _ := getTestEventManager(repositoryMocks.NewMockRepository())
+	_, err := eventManager.CreateWorkflowEvents(context.Background(), interfaces.WorkflowEventsRequest{})
+	assert.EqualError(t, err, "missing events")
+
+	_, err = eventManager.CreateWorkflowEvents(context.Background(), interfaces.WorkflowEventsRequest{
+		Events: make([]interfaces.WorkflowEvent, maxWorkflowEventsBatchSize+1),
+	})
+	assert.EqualError(t, err, "a batch cannot exceed 1000 events, found 1001")
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -3,6 +3,8 @@ package impl
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"sort"
 	"strconv"
 	"time"
 
@@ -11,15 +13,19 @@ import (
 	dataInterfaces "github.com/lyft/flyteadmin/pkg/data/interfaces"
 	"github.com/lyft/flytestdlib/promutils"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
 
 	"github.com/lyft/flyteadmin/pkg/common"
 
 	"github.com/lyft/flytestdlib/logger"
 	"github.com/lyft/flytestdlib/storage"
 
+	cloudEventInterfaces "github.com/lyft/flyteadmin/pkg/async/cloudevent/interfaces"
 	"github.com/lyft/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/logging"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
@@ -32,6 +38,7 @@ import (
 	workflowengineInterfaces "github.com/lyft/flyteadmin/pkg/workflowengine/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
 	"google.golang.org/grpc/codes"
 
 	"github.com/benbjohnson/clock"
@@ -43,22 +50,32 @@ const parentContainerQueueKey = "parent_queue"
 const childContainerQueueKey = "child_queue"
 const noSourceExecutionID = 0
 
+const backfillExecutionNameFormat = "backfill_%s_%s_%s"
+const defaultBackfillMaxExecutions = 500
+const defaultBackfillExecutionsPerSecond = 5
+
 // Map of [project] -> map of [domain] -> stop watch
 type projectDomainScopedStopWatchMap = map[string]map[string]*promutils.StopWatch
 
 type executionSystemMetrics struct {
-	Scope                    promutils.Scope
-	ActiveExecutions         prometheus.Gauge
-	ExecutionsCreated        prometheus.Counter
-	ExecutionsTerminated     prometheus.Counter
-	ExecutionEventsCreated   prometheus.Counter
-	PropellerFailures        prometheus.Counter
-	PublishNotificationError prometheus.Counter
-	TransformerError         prometheus.Counter
-	UnexpectedDataError      prometheus.Counter
-	SpecSizeBytes            prometheus.Summary
-	ClosureSizeBytes         prometheus.Summary
-	AcceptanceDelay          prometheus.Summary
+	Scope                     promutils.Scope
+	ActiveExecutions          prometheus.Gauge
+	ExecutionsCreated         prometheus.Counter
+	ExecutionsTerminated      prometheus.Counter
+	ExecutionEventsCreated    prometheus.Counter
+	PropellerFailures         prometheus.Counter
+	DuplicateExecutionEvents  prometheus.Counter
+	StaleExecutionEvents      prometheus.Counter
+	BackfillExecutionFailures prometheus.Counter
+	PublishNotificationError  prometheus.Counter
+	NotificationsSuppressed   prometheus.Counter
+	NotificationsDeferred     prometheus.Counter
+	TransformerError          prometheus.Counter
+	UnexpectedDataError       prometheus.Counter
+	SpecSizeBytes             prometheus.Summary
+	ClosureSizeBytes          prometheus.Summary
+	AcceptanceDelay           prometheus.Summary
+	EventMetrics              util.EventMetrics
 }
 
 type executionUserMetrics struct {
@@ -68,16 +85,19 @@ type executionUserMetrics struct {
 }
 
 type ExecutionManager struct {
-	db                 repositories.RepositoryInterface
-	config             runtimeInterfaces.Configuration
-	storageClient      *storage.DataStore
-	workflowExecutor   workflowengineInterfaces.Executor
-	queueAllocator     executions.QueueAllocator
-	_clock             clock.Clock
-	systemMetrics      executionSystemMetrics
-	userMetrics        executionUserMetrics
-	notificationClient notificationInterfaces.Publisher
-	urlData            dataInterfaces.RemoteURLInterface
+	db                  repositories.RepositoryInterface
+	config              runtimeInterfaces.Configuration
+	storageClient       *storage.DataStore
+	workflowExecutor    workflowengineInterfaces.Executor
+	queueAllocator      executions.QueueAllocator
+	_clock              clock.Clock
+	systemMetrics       executionSystemMetrics
+	userMetrics         executionUserMetrics
+	notificationClient  notificationInterfaces.Publisher
+	urlData             dataInterfaces.RemoteURLInterface
+	quietHours          *notifications.QuietHours
+	emailTemplates      *notifications.EmailTemplates
+	cloudEventPublisher cloudEventInterfaces.Publisher
 }
 
 func (m *ExecutionManager) populateExecutionQueue(
@@ -90,7 +110,7 @@ func (m *ExecutionManager) populateExecutionQueue(
 			continue
 		}
 		if queueConfig.PrimaryQueue != "" {
-			logger.Debugf(ctx, "Assigning %s as parent queue for task %+v", queueConfig.PrimaryQueue, task.Template.Id)
+			logging.Debugf(ctx, logging.Executions, "Assigning %s as parent queue for task %+v", queueConfig.PrimaryQueue, task.Template.Id)
 			container.Config = append(container.Config, &core.KeyValuePair{
 				Key:   parentContainerQueueKey,
 				Value: queueConfig.PrimaryQueue,
@@ -98,7 +118,7 @@ func (m *ExecutionManager) populateExecutionQueue(
 		}
 
 		if queueConfig.DynamicQueue != "" {
-			logger.Debugf(ctx, "Assigning %s as child queue for task %+v", queueConfig.DynamicQueue, task.Template.Id)
+			logging.Debugf(ctx, logging.Executions, "Assigning %s as child queue for task %+v", queueConfig.DynamicQueue, task.Template.Id)
 			container.Config = append(container.Config, &core.KeyValuePair{
 				Key:   childContainerQueueKey,
 				Value: queueConfig.DynamicQueue,
@@ -107,6 +127,90 @@ func (m *ExecutionManager) populateExecutionQueue(
 	}
 }
 
+// Rejects launching another execution in a project and domain when as many non-terminal executions as its quota
+// allows are already running there.
+func (m *ExecutionManager) checkConcurrentExecutionQuota(
+	ctx context.Context, project, domain string, quota validation.ResourceQuota) error {
+	if quota.MaxConcurrentExecutions <= 0 {
+		return nil
+	}
+	nonTerminalPhases := make([]string, 0, len(core.WorkflowExecution_Phase_name))
+	for phase, name := range core.WorkflowExecution_Phase_name {
+		if !common.IsExecutionTerminal(core.WorkflowExecution_Phase(phase)) {
+			nonTerminalPhases = append(nonTerminalPhases, name)
+		}
+	}
+	projectFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, shared.Project, project)
+	if err != nil {
+		return err
+	}
+	domainFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, shared.Domain, domain)
+	if err != nil {
+		return err
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, shared.Phase, nonTerminalPhases)
+	if err != nil {
+		return err
+	}
+	count, err := m.db.ExecutionRepo().Count(ctx, repositoryInterfaces.CountResourceInput{
+		InlineFilters: []common.InlineFilter{projectFilter, domainFilter, phaseFilter},
+	})
+	if err != nil {
+		return err
+	}
+	if count >= quota.MaxConcurrentExecutions {
+		return errors.NewFlyteAdminErrorf(codes.ResourceExhausted,
+			"project [%s] and domain [%s] already run the maximum of %d concurrent executions",
+			project, domain, quota.MaxConcurrentExecutions)
+	}
+	return nil
+}
+
+// Stops the tasks of an execution from reading and writing cached outputs, e.g. when caching is disabled in a domain.
+func disableCaching(ctx context.Context, compiledWorkflow *core.CompiledWorkflowClosure) {
+	for _, task := range compiledWorkflow.Tasks {
+		if task.Template.GetMetadata().GetDiscoverable() {
+			logging.Debugf(ctx, logging.Executions, "Disabling caching for task %+v", task.Template.Id)
+			task.Template.Metadata.Discoverable = false
+		}
+	}
+}
+
+// Sets the environment variables requested for an execution in every task container, overriding any variable of the
+// same name defined by the task itself.
+func (m *ExecutionManager) populateEnvironmentVariables(
+	ctx context.Context, environmentVariables map[string]string, compiledWorkflow *core.CompiledWorkflowClosure) {
+	if len(environmentVariables) == 0 {
+		return
+	}
+	names := make([]string, 0, len(environmentVariables))
+	for name := range environmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, task := range compiledWorkflow.Tasks {
+		container := task.Template.GetContainer()
+		if container == nil {
+			// Unrecognized target type, nothing to do
+			continue
+		}
+		env := make([]*core.KeyValuePair, 0, len(container.Env)+len(names))
+		for _, pair := range container.Env {
+			if _, overridden := environmentVariables[pair.Key]; !overridden {
+				env = append(env, pair)
+			}
+		}
+		for _, name := range names {
+			env = append(env, &core.KeyValuePair{
+				Key:   name,
+				Value: environmentVariables[name],
+			})
+		}
+		logging.Debugf(ctx, logging.Executions, "Setting environment variables %v for task %+v", names, task.Template.Id)
+		container.Env = env
+	}
+}
+
 func validateMapSize(maxEntries int, candidate map[string]string, candidateName string) error {
 	if maxEntries == 0 {
 		// Treat the max as unset
@@ -119,10 +223,26 @@ func validateMapSize(maxEntries int, candidate map[string]string, candidateName
 	return nil
 }
 
+// Adds the default values for the keys which aren't set.
+func addDefaultMapValues(values map[string]string, defaults map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return values
+	}
+	mergedValues := make(map[string]string, len(values)+len(defaults))
+	for key, value := range defaults {
+		mergedValues[key] = value
+	}
+	for key, value := range values {
+		mergedValues[key] = value
+	}
+	return mergedValues
+}
+
 // Labels and annotations defined in the execution spec are preferred over those defined in the
-// reference launch plan spec.
+// reference launch plan spec. The project-domain default labels and annotations fill in the keys neither sets.
 func (m *ExecutionManager) addLabelsAndAnnotations(requestSpec *admin.ExecutionSpec,
-	partiallyPopulatedInputs *workflowengineInterfaces.ExecuteWorkflowInput) error {
+	partiallyPopulatedInputs *workflowengineInterfaces.ExecuteWorkflowInput,
+	projectDomainAttributes map[string]string) error {
 
 	var labels map[string]string
 	if requestSpec.Labels != nil && requestSpec.Labels.Values != nil {
@@ -139,6 +259,9 @@ func (m *ExecutionManager) addLabelsAndAnnotations(requestSpec *admin.ExecutionS
 		partiallyPopulatedInputs.Reference.Spec.Annotations.Values != nil {
 		annotations = partiallyPopulatedInputs.Reference.Spec.Annotations.Values
 	}
+	labels = addDefaultMapValues(labels, validation.GetProjectDomainDefaultLabels(projectDomainAttributes))
+	annotations = addDefaultMapValues(
+		annotations, validation.GetProjectDomainDefaultAnnotations(projectDomainAttributes))
 
 	err := validateMapSize(m.config.RegistrationValidationConfiguration().GetMaxLabelEntries(), labels, "Labels")
 	if err != nil {
@@ -155,11 +278,39 @@ func (m *ExecutionManager) addLabelsAndAnnotations(requestSpec *admin.ExecutionS
 	return nil
 }
 
-func (m *ExecutionManager) offloadInputs(ctx context.Context, literalMap *core.LiteralMap, identifier *core.WorkflowExecutionIdentifier, key string) (storage.DataReference, error) {
+// Executions whose launch plan sets neither an IAM role nor a kubernetes service account are launched with the
+// project-domain default permissions, if any.
+func addDefaultAuth(partiallyPopulatedInputs *workflowengineInterfaces.ExecuteWorkflowInput,
+	projectDomainAttributes map[string]string) {
+	launchPlanSpec := partiallyPopulatedInputs.Reference.Spec
+	if len(launchPlanSpec.GetAuth().GetAssumableIamRole()) > 0 ||
+		len(launchPlanSpec.GetAuth().GetKubernetesServiceAccount()) > 0 || len(launchPlanSpec.GetRole()) > 0 {
+		return
+	}
+	defaultAuth := validation.GetProjectDomainDefaultAuth(projectDomainAttributes)
+	if defaultAuth == nil {
+		return
+	}
+	// The launch plan spec is copied so that the defaults don't leak into the launch plan.
+	var spec admin.LaunchPlanSpec
+	if launchPlanSpec != nil {
+		spec = *proto.Clone(launchPlanSpec).(*admin.LaunchPlanSpec)
+	}
+	spec.Auth = defaultAuth
+	partiallyPopulatedInputs.Reference.Spec = &spec
+}
+
+// Writes the literal map under the execution's metadata location. When an outputDataPrefix is specified it is used in
+// place of the default storage base container.
+func (m *ExecutionManager) offloadInputs(ctx context.Context, literalMap *core.LiteralMap, identifier *core.WorkflowExecutionIdentifier, key string, outputDataPrefix string) (storage.DataReference, error) {
 	if literalMap == nil {
 		literalMap = &core.LiteralMap{}
 	}
-	inputsURI, err := m.storageClient.ConstructReference(ctx, m.storageClient.GetBaseContainerFQN(ctx), shared.Metadata, identifier.Project, identifier.Domain, identifier.Name, key)
+	baseContainer := m.storageClient.GetBaseContainerFQN(ctx)
+	if len(outputDataPrefix) > 0 {
+		baseContainer = storage.DataReference(outputDataPrefix)
+	}
+	inputsURI, err := m.storageClient.ConstructReference(ctx, baseContainer, shared.Metadata, identifier.Project, identifier.Domain, identifier.Name, key)
 	if err != nil {
 		return "", err
 	}
@@ -169,41 +320,90 @@ func (m *ExecutionManager) offloadInputs(ctx context.Context, literalMap *core.L
 	return inputsURI, nil
 }
 
+// Launches the execution of the requested launch plan. When transformClosure is set, it's applied to the compiled
+// workflow closure before the closure is launched.
 func (m *ExecutionManager) launchExecutionAndPrepareModel(
-	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (*models.Execution, error) {
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time,
+	transformClosure func(closure *core.CompiledWorkflowClosure) error) (*models.Execution, error) {
 	err := validation.ValidateExecutionRequest(ctx, request, m.db, m.config.ApplicationConfiguration())
 	if err != nil {
-		logger.Debugf(ctx, "Failed to validate ExecutionCreateRequest %+v with err %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to validate ExecutionCreateRequest %+v with err %v", request, err)
+		return nil, err
+	}
+	cluster := common.GetClusterAssignment(request.Spec)
+	if len(cluster) > 0 {
+		err = validation.ValidateClusterAssignment(
+			cluster, request.Domain, m.config.ClusterConfiguration().GetClusterConfigs())
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = validation.ValidateInputReferences(ctx, request.Project, request.Inputs, m.storageClient,
+		m.config.ApplicationConfiguration().GetTopLevelConfig().InputReferences)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Invalid input references in ExecutionCreateRequest for [%s/%s] with err %v",
+			request.Project, request.Domain, err)
 		return nil, err
 	}
 	launchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, *request.Spec.LaunchPlan)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get launch plan model for ExecutionCreateRequest %+v with err %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to get launch plan model for ExecutionCreateRequest %+v with err %v", request, err)
 		return nil, err
 	}
 	launchPlan, err := transformers.FromLaunchPlanModel(launchPlanModel)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to transform launch plan model %+v with err %v", launchPlanModel, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to transform launch plan model %+v with err %v", launchPlanModel, err)
+		return nil, err
+	}
+	projectDomainAttributes, err := util.GetProjectDomainAttributes(ctx, m.db, request.Project, request.Domain)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get attributes of [%s/%s] with err %v",
+			request.Project, request.Domain, err)
+		return nil, err
+	}
+	resourceQuota, err := validation.GetResourceQuota(projectDomainAttributes)
+	if err != nil {
+		return nil, err
+	}
+	// Enforced again when the execution is created, checking here spares launching executions over the quota.
+	if err := m.checkConcurrentExecutionQuota(ctx, request.Project, request.Domain, resourceQuota); err != nil {
+		return nil, err
+	}
+	allowedRegistries, err := validation.GetAllowedImageRegistries(projectDomainAttributes)
+	if err != nil {
 		return nil, err
 	}
 	executionInputs, err := validation.CheckAndFetchInputsForExecution(
 		request.Inputs,
 		launchPlan.Spec.FixedInputs,
 		launchPlan.Closure.ExpectedInputs,
+		projectDomainAttributes,
 	)
 
 	if err != nil {
-		logger.Debugf(ctx, "Failed to CheckAndFetchInputsForExecution with request.Inputs: %+v"+
+		logging.Debugf(ctx, logging.Executions, "Failed to CheckAndFetchInputsForExecution with request.Inputs: %+v"+
 			"fixed inputs: %+v and expected inputs: %+v with err %v",
 			request.Inputs, launchPlan.Spec.FixedInputs, launchPlan.Closure.ExpectedInputs, err)
 		return nil, err
 	}
 	workflow, err := util.GetWorkflow(ctx, m.db, m.storageClient, *launchPlan.Spec.WorkflowId)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get workflow with id %+v with err %v", launchPlan.Spec.WorkflowId, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to get workflow with id %+v with err %v", launchPlan.Spec.WorkflowId, err)
+		return nil, err
+	}
+	if transformClosure != nil {
+		if err := transformClosure(workflow.Closure.CompiledWorkflow); err != nil {
+			return nil, err
+		}
+	}
+	namePolicy, err := util.GetExecutionNamePolicy(ctx, m.db, request.Project, request.Domain, workflow.Id.Name)
+	if err != nil {
+		return nil, err
+	}
+	name, err := util.GetExecutionName(request, namePolicy)
+	if err != nil {
 		return nil, err
 	}
-	name := util.GetExecutionName(request)
 	workflowExecutionID := core.WorkflowExecutionIdentifier{
 		Project: request.Project,
 		Domain:  request.Domain,
@@ -223,41 +423,94 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		parentNodeExecutionID = parentNodeExecutionModel.ID
 	}
 
+	// Executions not assigned a cluster are launched on the one labelled by the attributes matching their workflow.
+	if len(cluster) == 0 {
+		clusterAttributes, err := util.GetMatchableResource(ctx, m.db,
+			interfaces.MatchableResourceExecutionClusterLabel, request.Project, request.Domain, workflow.Id.Name)
+		if err != nil {
+			return nil, err
+		}
+		if clusterAttributes.ExecutionClusterLabel != nil {
+			cluster = clusterAttributes.ExecutionClusterLabel.Value
+			err = validation.ValidateClusterAssignment(
+				cluster, request.Domain, m.config.ClusterConfiguration().GetClusterConfigs())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Dynamically assign task resource defaults.
+	taskResourceConfig, err := util.GetTaskResourceConfiguration(ctx, m.db, m.config.TaskResourceConfiguration(),
+		request.Project, request.Domain, workflow.Id.Name)
+	if err != nil {
+		return nil, err
+	}
 	for _, task := range workflow.Closure.CompiledWorkflow.Tasks {
-		validation.SetDefaults(ctx, m.config.TaskResourceConfiguration(), task)
+		validation.SetDefaults(ctx, taskResourceConfig, task, resourceQuota)
+		if err := validation.ValidateTaskResourceQuota(task, resourceQuota); err != nil {
+			return nil, err
+		}
+		if err := validation.ValidateTaskImage(task.Template, allowedRegistries); err != nil {
+			return nil, err
+		}
+	}
+
+	featureFlags, err := util.GetFeatureFlags(ctx, m.db, request.Project, request.Domain, workflow.Id.Name)
+	if err != nil {
+		return nil, err
+	}
+	if featureFlags.DisableCaching {
+		disableCaching(ctx, workflow.Closure.CompiledWorkflow)
 	}
 
 	// Dynamically assign execution queues.
 	m.populateExecutionQueue(ctx, *workflow.Id, workflow.Closure.CompiledWorkflow)
+	m.populateEnvironmentVariables(
+		ctx, common.GetEnvironmentVariables(request.Spec), workflow.Closure.CompiledWorkflow)
 
-	inputsURI, err := m.offloadInputs(ctx, executionInputs, &workflowExecutionID, shared.Inputs)
+	outputDataPrefix := common.GetOutputDataPrefix(request.Spec)
+	inputsURI, err := m.offloadInputs(ctx, executionInputs, &workflowExecutionID, shared.Inputs, outputDataPrefix)
 	if err != nil {
 		return nil, err
 	}
-	userInputsURI, err := m.offloadInputs(ctx, request.Inputs, &workflowExecutionID, shared.UserInputs)
+	userInputsURI, err := m.offloadInputs(ctx, request.Inputs, &workflowExecutionID, shared.UserInputs, outputDataPrefix)
 	if err != nil {
 		return nil, err
 	}
 
+	onFailurePolicy, err := common.GetOnFailurePolicy(launchPlan.Spec)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Invalid on-failure policy of launch plan [%+v] with err %v",
+			launchPlan.Id, err)
+		return nil, err
+	}
+	traceParent := common.GetTraceParent(ctx)
 	// TODO: Reduce CRD size and use offloaded input URI to blob store instead.
 	executeWorkflowInputs := workflowengineInterfaces.ExecuteWorkflowInput{
-		ExecutionID: &workflowExecutionID,
-		WfClosure:   *workflow.Closure.CompiledWorkflow,
-		Inputs:      executionInputs,
-		Reference:   *launchPlan,
-		AcceptedAt:  requestedAt,
-	}
-	err = m.addLabelsAndAnnotations(request.Spec, &executeWorkflowInputs)
+		ExecutionID:      &workflowExecutionID,
+		WfClosure:        *workflow.Closure.CompiledWorkflow,
+		Inputs:           executionInputs,
+		Reference:        *launchPlan,
+		AcceptedAt:       requestedAt,
+		OutputDataPrefix: outputDataPrefix,
+		Cluster:          cluster,
+		TraceParent:      traceParent,
+		OnFailurePolicy:  string(onFailurePolicy),
+	}
+	err = m.addLabelsAndAnnotations(request.Spec, &executeWorkflowInputs, projectDomainAttributes)
 	if err != nil {
 		return nil, err
 	}
+	addDefaultAuth(&executeWorkflowInputs, projectDomainAttributes)
 
 	execInfo, err := m.workflowExecutor.ExecuteWorkflow(ctx, executeWorkflowInputs)
 	if err != nil {
 		m.systemMetrics.PropellerFailures.Inc()
+		// The workflow engine may echo back the inputs and annotations it was given.
+		err = logging.RedactError(err, logging.GetSensitiveValues(executionInputs, executeWorkflowInputs.Annotations))
 		logger.Infof(ctx, "Failed to execute workflow %+v with execution id %+v and inputs %+v with err %v",
-			request, workflowExecutionID, executionInputs, err)
+			logging.Redact(request), workflowExecutionID, logging.Redact(executionInputs), err)
 		return nil, err
 	}
 	executionCreatedAt := time.Now()
@@ -291,6 +544,7 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		Cluster:               execInfo.Cluster,
 		InputsURI:             inputsURI,
 		UserInputsURI:         userInputsURI,
+		TraceParent:           traceParent,
 	})
 	if err != nil {
 		logger.Infof(ctx, "Failed to create execution model in transformer for id: [%+v] with err: %v",
@@ -300,7 +554,10 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 	return executionModel, nil
 }
 
-// Inserts an execution model into the database store and emits platform metrics.
+// Inserts an execution model into the database store and emits platform metrics. The concurrent execution quota of
+// the execution's project and domain is enforced in the same transaction as the insert, since executions launched
+// concurrently may all have passed the check done before launching them. The launched workflow is terminated when
+// the quota turns out to be exhausted.
 func (m *ExecutionManager) createExecutionModel(
 	ctx context.Context, executionModel *models.Execution) (*core.WorkflowExecutionIdentifier, error) {
 	workflowExecutionIdentifier := core.WorkflowExecutionIdentifier{
@@ -308,9 +565,43 @@ func (m *ExecutionManager) createExecutionModel(
 		Domain:  executionModel.ExecutionKey.Domain,
 		Name:    executionModel.ExecutionKey.Name,
 	}
-	err := m.db.ExecutionRepo().Create(ctx, *executionModel)
+	var quotaErr error
+	err := m.db.Transaction(ctx, func(ctx context.Context) error {
+		projectDomainAttributes, err := util.GetProjectDomainAttributes(
+			ctx, m.db, workflowExecutionIdentifier.Project, workflowExecutionIdentifier.Domain)
+		if err != nil {
+			return err
+		}
+		resourceQuota, err := validation.GetResourceQuota(projectDomainAttributes)
+		if err != nil {
+			return err
+		}
+		if resourceQuota.MaxConcurrentExecutions > 0 {
+			err = m.db.ExecutionRepo().LockProjectDomain(
+				ctx, workflowExecutionIdentifier.Project, workflowExecutionIdentifier.Domain)
+			if err != nil {
+				return err
+			}
+			quotaErr = m.checkConcurrentExecutionQuota(
+				ctx, workflowExecutionIdentifier.Project, workflowExecutionIdentifier.Domain, resourceQuota)
+			if quotaErr != nil {
+				return quotaErr
+			}
+		}
+		return m.db.ExecutionRepo().Create(ctx, *executionModel)
+	})
+	if quotaErr != nil {
+		terminateErr := m.workflowExecutor.TerminateWorkflowExecution(ctx, workflowengineInterfaces.TerminateWorkflowInput{
+			ExecutionID: &workflowExecutionIdentifier,
+			Cluster:     executionModel.Cluster,
+		})
+		if terminateErr != nil {
+			logger.Warningf(ctx, "Failed to terminate execution [%+v] launched over the quota of its project and domain "+
+				"with err %v", workflowExecutionIdentifier, terminateErr)
+		}
+	}
 	if err != nil {
-		logger.Debugf(ctx, "failed to save newly created execution [%+v] with id %+v to db with err %v",
+		logging.Debugf(ctx, logging.Executions, "failed to save newly created execution [%+v] with id %+v to db with err %v",
 			workflowExecutionIdentifier, workflowExecutionIdentifier, err)
 		return nil, err
 	}
@@ -328,7 +619,7 @@ func (m *ExecutionManager) CreateExecution(
 	if request.Inputs == nil || len(request.Inputs.Literals) == 0 {
 		request.Inputs = request.GetSpec().GetInputs()
 	}
-	executionModel, err := m.launchExecutionAndPrepareModel(ctx, request, requestedAt)
+	executionModel, err := m.launchExecutionAndPrepareModel(ctx, request, requestedAt, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -341,12 +632,30 @@ func (m *ExecutionManager) CreateExecution(
 	}, nil
 }
 
+// Returns the inputs an existing execution was requested with.
+func (m *ExecutionManager) getUserInputs(
+	ctx context.Context, executionModel *models.Execution) (*core.LiteralMap, error) {
+	if len(executionModel.UserInputsURI) > 0 {
+		inputs := &core.LiteralMap{}
+		if err := m.storageClient.ReadProtobuf(ctx, executionModel.UserInputsURI, inputs); err != nil {
+			return nil, err
+		}
+		return inputs, nil
+	}
+	// For old data, inputs are held in the spec
+	var spec admin.ExecutionSpec
+	if err := proto.Unmarshal(executionModel.Spec, &spec); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal spec")
+	}
+	return spec.Inputs, nil
+}
+
 func (m *ExecutionManager) RelaunchExecution(
 	ctx context.Context, request admin.ExecutionRelaunchRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error) {
 	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution model for request [%+v] with err %v", request, err)
 		return nil, err
 	}
 	existingExecution, err := transformers.FromExecutionModel(*existingExecutionModel)
@@ -358,29 +667,132 @@ func (m *ExecutionManager) RelaunchExecution(
 	if executionSpec.Metadata == nil {
 		executionSpec.Metadata = &admin.ExecutionMetadata{}
 	}
-	var inputs *core.LiteralMap
-	if len(existingExecutionModel.UserInputsURI) > 0 {
-		inputs = &core.LiteralMap{}
-		if err := m.storageClient.ReadProtobuf(ctx, existingExecutionModel.UserInputsURI, inputs); err != nil {
+	inputs, err := m.getUserInputs(ctx, existingExecutionModel)
+	if err != nil {
+		return nil, err
+	}
+	executionSpec.Metadata.Mode = admin.ExecutionMetadata_RELAUNCH
+	executionModel, err := m.launchExecutionAndPrepareModel(ctx, admin.ExecutionCreateRequest{
+		Project: request.Id.Project,
+		Domain:  request.Id.Domain,
+		Name:    request.Name,
+		Spec:    executionSpec,
+		Inputs:  inputs,
+	}, requestedAt, nil)
+	if err != nil {
+		return nil, err
+	}
+	executionModel.SourceExecutionID = existingExecutionModel.ID
+	workflowExecutionIdentifier, err := m.createExecutionModel(ctx, executionModel)
+	if err != nil {
+		return nil, err
+	}
+	logging.Debugf(ctx, logging.Executions, "Successfully relaunched [%+v] as [%+v]", request.Id, workflowExecutionIdentifier)
+	return &admin.ExecutionCreateResponse{
+		Id: workflowExecutionIdentifier,
+	}, nil
+}
+
+// Reads the outputs recorded by the nodes of an execution.
+func (m *ExecutionManager) getNodeOutputs(
+	ctx context.Context, executionID *core.WorkflowExecutionIdentifier, nodeIDs []string) (
+	map[string]*core.LiteralMap, error) {
+	nodeOutputs := make(map[string]*core.LiteralMap, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, &core.NodeExecutionIdentifier{
+			NodeId:      nodeID,
+			ExecutionId: executionID,
+		})
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		// For old data, inputs are held in the spec
-		var spec admin.ExecutionSpec
-		err = proto.Unmarshal(existingExecutionModel.Spec, &spec)
+		nodeExecution, err := transformers.FromNodeExecutionModel(ctx, m.storageClient, *nodeExecutionModel)
 		if err != nil {
-			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal spec")
+			return nil, err
+		}
+		outputURI := nodeExecution.Closure.GetOutputUri()
+		if len(outputURI) == 0 {
+			return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+				"node [%s] of execution [%+v] recorded no outputs to re-run with", nodeID, executionID)
 		}
-		inputs = spec.Inputs
+		outputs := &core.LiteralMap{}
+		if err := m.storageClient.ReadProtobuf(ctx, storage.DataReference(outputURI), outputs); err != nil {
+			return nil, err
+		}
+		nodeOutputs[nodeID] = outputs
+	}
+	return nodeOutputs, nil
+}
+
+// Launches a new execution of a terminated execution's launch plan, in which only the requested failed node and the
+// nodes downstream of it run. The nodes upstream of it are pruned from the workflow closure and the outputs they
+// recorded in the terminated execution are bound in their place.
+func (m *ExecutionManager) RerunExecutionNode(
+	ctx context.Context, request interfaces.ExecutionNodeRerunRequest, requestedAt time.Time) (
+	*admin.ExecutionCreateResponse, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.ID); err != nil {
+		logging.Debugf(ctx, logging.Executions, "received node re-run request: %v with invalid identifier: %v",
+			request, err)
+		return nil, err
+	}
+	if len(request.NodeID) == 0 {
+		return nil, shared.GetMissingArgumentError(shared.NodeID)
+	}
+	ctx = logging.WithExecutionID(ctx, request.ID)
+	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, *request.ID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution model for request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	phase := core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[existingExecutionModel.Phase])
+	if !common.IsExecutionTerminal(phase) {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"execution [%+v] is still %s, only nodes of terminated executions can be re-run", request.ID, phase)
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, &core.NodeExecutionIdentifier{
+		NodeId:      request.NodeID,
+		ExecutionId: request.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodePhase := core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase])
+	if nodePhase != core.NodeExecution_FAILED && nodePhase != core.NodeExecution_TIMED_OUT {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"node [%s] of execution [%+v] is %s, only failed nodes can be re-run", request.NodeID, request.ID, nodePhase)
+	}
+
+	existingExecution, err := transformers.FromExecutionModel(*existingExecutionModel)
+	if err != nil {
+		return nil, err
+	}
+	executionSpec := existingExecution.Spec
+	if executionSpec.Metadata == nil {
+		executionSpec.Metadata = &admin.ExecutionMetadata{}
+	}
+	inputs, err := m.getUserInputs(ctx, existingExecutionModel)
+	if err != nil {
+		return nil, err
 	}
 	executionSpec.Metadata.Mode = admin.ExecutionMetadata_RELAUNCH
 	executionModel, err := m.launchExecutionAndPrepareModel(ctx, admin.ExecutionCreateRequest{
-		Project: request.Id.Project,
-		Domain:  request.Id.Domain,
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
 		Name:    request.Name,
 		Spec:    executionSpec,
 		Inputs:  inputs,
-	}, requestedAt)
+	}, requestedAt, func(closure *core.CompiledWorkflowClosure) error {
+		rerunNodeIDs, err := util.GetRerunNodeIDs(closure, request.NodeID)
+		if err != nil {
+			return err
+		}
+		upstreamOutputs, err := m.getNodeOutputs(
+			ctx, request.ID, util.GetRerunUpstreamNodeIDs(closure, rerunNodeIDs))
+		if err != nil {
+			return err
+		}
+		return util.PruneWorkflowClosureForRerun(closure, rerunNodeIDs, upstreamOutputs)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -389,12 +801,221 @@ func (m *ExecutionManager) RelaunchExecution(
 	if err != nil {
 		return nil, err
 	}
-	logger.Debugf(ctx, "Successfully relaunched [%+v] as [%+v]", request.Id, workflowExecutionIdentifier)
+	logging.Debugf(ctx, logging.Executions, "Successfully re-ran node [%s] of [%+v] as [%+v]",
+		request.NodeID, request.ID, workflowExecutionIdentifier)
 	return &admin.ExecutionCreateResponse{
 		Id: workflowExecutionIdentifier,
 	}, nil
 }
 
+// Backfill executions are named after their launch plan and kickoff time so that retrying a (partially failed)
+// backfill skips the executions it already created.
+func getBackfillExecutionName(launchPlanID *core.Identifier, kickoffTime time.Time) string {
+	h := fnv.New64()
+	_, _ = h.Write([]byte(fmt.Sprintf(backfillExecutionNameFormat,
+		launchPlanID.Project, launchPlanID.Domain, launchPlanID.Name)))
+	return common.GetExecutionName(kickoffTime.UnixNano() + int64(h.Sum64()))
+}
+
+func getKickoffTimeLiteral(kickoffTime *timestamp.Timestamp) *core.Literal {
+	return &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: &core.Primitive{
+						Value: &core.Primitive_Datetime{
+							Datetime: kickoffTime,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Launches the execution for a single backfilled schedule tick. Returns true alongside the execution identifier
+// when the execution already existed.
+func (m *ExecutionManager) backfillExecution(
+	ctx context.Context, request interfaces.BackfillExecutionsRequest, kickoffTimeInputArg string,
+	kickoffTime time.Time, requestedAt time.Time) (*core.WorkflowExecutionIdentifier, bool, error) {
+	workflowExecutionID := core.WorkflowExecutionIdentifier{
+		Project: request.LaunchPlan.Project,
+		Domain:  request.LaunchPlan.Domain,
+		Name:    getBackfillExecutionName(request.LaunchPlan, kickoffTime),
+	}
+	_, err := util.GetExecutionModel(ctx, m.db, workflowExecutionID)
+	if err == nil {
+		return &workflowExecutionID, true, nil
+	}
+	if adminErr, ok := err.(errors.FlyteAdminError); !ok || adminErr.Code() != codes.NotFound {
+		return nil, false, err
+	}
+
+	kickoffTimeProto, err := ptypes.TimestampProto(kickoffTime)
+	if err != nil {
+		return nil, false, errors.NewFlyteAdminErrorf(codes.Internal, "failed to serialize kickoff time [%v]", kickoffTime)
+	}
+	inputs := &core.LiteralMap{
+		Literals: make(map[string]*core.Literal),
+	}
+	for name, literal := range request.Inputs.GetLiterals() {
+		inputs.Literals[name] = literal
+	}
+	if kickoffTimeInputArg != "" {
+		inputs.Literals[kickoffTimeInputArg] = getKickoffTimeLiteral(kickoffTimeProto)
+	}
+	executionModel, err := m.launchExecutionAndPrepareModel(ctx, admin.ExecutionCreateRequest{
+		Project: workflowExecutionID.Project,
+		Domain:  workflowExecutionID.Domain,
+		Name:    workflowExecutionID.Name,
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: request.LaunchPlan,
+			Metadata: &admin.ExecutionMetadata{
+				// Backfilled executions aren't launched by the scheduler and mustn't count towards its delay metrics.
+				Mode:        admin.ExecutionMetadata_SYSTEM,
+				ScheduledAt: kickoffTimeProto,
+			},
+		},
+		Inputs: inputs,
+	}, requestedAt, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	createdID, err := m.createExecutionModel(ctx, executionModel)
+	if err != nil {
+		return nil, false, err
+	}
+	return createdID, false, nil
+}
+
+// Creates one execution for every time the launch plan schedule fires within the requested range. The request is
+// validated right away while the executions are launched in the background, see GetBackfill for their progress.
+func (m *ExecutionManager) BackfillExecutions(
+	ctx context.Context, request interfaces.BackfillExecutionsRequest, requestedAt time.Time) (
+	*interfaces.BackfillExecutionsResponse, error) {
+	if err := validation.ValidateIdentifier(request.LaunchPlan, common.LaunchPlan); err != nil {
+		return nil, err
+	}
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, *request.LaunchPlan)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get launch plan [%+v] to backfill with err %v",
+			request.LaunchPlan, err)
+		return nil, err
+	}
+	schedule := launchPlan.GetSpec().GetEntityMetadata().GetSchedule()
+	if schedule == nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%+v] has no schedule to backfill", request.LaunchPlan)
+	}
+	kickoffTimeInputArg := request.KickoffTimeInputArg
+	if kickoffTimeInputArg == "" {
+		kickoffTimeInputArg = schedule.KickoffTimeInputArg
+	}
+	if kickoffTimeInputArg != "" {
+		if _, ok := launchPlan.GetClosure().GetExpectedInputs().GetParameters()[kickoffTimeInputArg]; !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"launch plan [%+v] has no input [%s] for the kickoff time", request.LaunchPlan, kickoffTimeInputArg)
+		}
+	}
+
+	maxExecutions := m.config.ApplicationConfiguration().GetSchedulerConfig().BackfillConfig.MaxExecutions
+	if maxExecutions <= 0 {
+		maxExecutions = defaultBackfillMaxExecutions
+	}
+	kickoffTimes, err := executions.GetScheduleTicks(schedule, request.StartTime, request.EndTime, maxExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	backfill := interfaces.Backfill{
+		ID:         common.GetExecutionName(time.Now().UnixNano()),
+		LaunchPlan: request.LaunchPlan,
+		StartTime:  request.StartTime,
+		EndTime:    request.EndTime,
+		Total:      len(kickoffTimes),
+		Done:       len(kickoffTimes) == 0,
+	}
+	backfillModel, err := transformers.ToBackfillModel(backfill)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.db.BackfillRepo().Create(ctx, backfillModel); err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to create backfill of launch plan [%+v] with err %v",
+			request.LaunchPlan, err)
+		return nil, err
+	}
+	if !backfill.Done {
+		// The backfill outlives the request which started it.
+		go m.runBackfill(context.Background(), backfill, request, kickoffTimeInputArg, kickoffTimes, requestedAt)
+	}
+	return &interfaces.BackfillExecutionsResponse{
+		ID:         backfill.ID,
+		Executions: len(kickoffTimes),
+	}, nil
+}
+
+// Launches the executions of a backfill at the configured rate, recording its progress after each of them. A failure
+// to launch any one of them doesn't stop the others from launching.
+func (m *ExecutionManager) runBackfill(
+	ctx context.Context, backfill interfaces.Backfill, request interfaces.BackfillExecutionsRequest,
+	kickoffTimeInputArg string, kickoffTimes []time.Time, requestedAt time.Time) {
+	executionsPerSecond := m.config.ApplicationConfiguration().GetSchedulerConfig().BackfillConfig.ExecutionsPerSecond
+	if executionsPerSecond <= 0 {
+		executionsPerSecond = defaultBackfillExecutionsPerSecond
+	}
+	limiter := rate.NewLimiter(rate.Limit(executionsPerSecond), 1)
+	for _, kickoffTime := range kickoffTimes {
+		result := interfaces.BackfillExecutionResult{
+			KickoffTime: kickoffTime,
+		}
+		err := limiter.Wait(ctx)
+		if err == nil {
+			var existing bool
+			result.ID, existing, err = m.backfillExecution(ctx, request, kickoffTimeInputArg, kickoffTime, requestedAt)
+			if existing {
+				backfill.Skipped++
+			}
+		}
+		if err != nil {
+			m.systemMetrics.BackfillExecutionFailures.Inc()
+			logger.Infof(ctx, "Failed to backfill launch plan [%+v] for kickoff time [%v] with err: %v",
+				request.LaunchPlan, kickoffTime, err)
+			result.Error = err.Error()
+			backfill.Failed++
+		} else {
+			backfill.Succeeded++
+		}
+		backfill.Executions = append(backfill.Executions, result)
+		backfill.Done = len(backfill.Executions) == len(kickoffTimes)
+		m.recordBackfillProgress(ctx, backfill)
+	}
+	logger.Infof(ctx, "Backfilled launch plan [%+v] between [%v] and [%v]: %d succeeded (%d already existed), %d failed",
+		request.LaunchPlan, request.StartTime, request.EndTime, backfill.Succeeded, backfill.Skipped, backfill.Failed)
+}
+
+// Failing to record progress doesn't stop a backfill, the next execution it launches records it again.
+func (m *ExecutionManager) recordBackfillProgress(ctx context.Context, backfill interfaces.Backfill) {
+	backfillModel, err := transformers.ToBackfillModel(backfill)
+	if err == nil {
+		err = m.db.BackfillRepo().Update(ctx, backfillModel)
+	}
+	if err != nil {
+		logger.Warningf(ctx, "Failed to record the progress of backfill [%s] with err: %v", backfill.ID, err)
+	}
+}
+
+func (m *ExecutionManager) GetBackfill(ctx context.Context, id string) (*interfaces.Backfill, error) {
+	if err := validation.ValidateEmptyStringField(id, shared.ID); err != nil {
+		return nil, err
+	}
+	backfillModel, err := m.db.BackfillRepo().Get(ctx, id)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get backfill [%s] with err %v", id, err)
+		return nil, err
+	}
+	return transformers.FromBackfillModel(backfillModel)
+}
+
 func (m *ExecutionManager) emitScheduledWorkflowMetrics(
 	ctx context.Context, executionModel *models.Execution, runningEventTimeProto *timestamp.Timestamp) {
 	if executionModel == nil || runningEventTimeProto == nil {
@@ -525,53 +1146,86 @@ func (m *ExecutionManager) emitOverallWorkflowExecutionTime(
 
 func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
 	*admin.WorkflowExecutionEventResponse, error) {
+	receivedAt := m._clock.Now()
 	err := validation.ValidateCreateWorkflowEventRequest(request)
 	if err != nil {
-		logger.Debugf(ctx, "received invalid CreateWorkflowEventRequest [%s]: %v", request.RequestId, err)
+		logging.Debugf(ctx, logging.Executions, "received invalid CreateWorkflowEventRequest [%s]: %v", request.RequestId, err)
 		return nil, err
 	}
-	logger.Debugf(ctx, "Received workflow execution event for [%+v] transitioning to phase [%v]",
+	ctx = logging.WithExecutionID(ctx, request.Event.ExecutionId)
+	logging.Debugf(ctx, logging.Executions, "Received workflow execution event for [%+v] transitioning to phase [%v]",
 		request.Event.ExecutionId, request.Event.Phase)
+	m.systemMetrics.EventMetrics.RecordIngestionDelay(
+		util.WorkflowEventType, request.Event.Phase.String(), request.Event.OccurredAt, receivedAt)
 
+	lookupTimer := m.systemMetrics.EventMetrics.LookupDuration.Start()
 	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Event.ExecutionId)
+	lookupTimer.Stop()
 	if err != nil {
-		logger.Debugf(ctx, "failed to find execution [%+v] for recorded event [%s]: %v",
+		logging.Debugf(ctx, logging.Executions, "failed to find execution [%+v] for recorded event [%s]: %v",
 			request.Event.ExecutionId, request.RequestId, err)
 		return nil, err
 	}
 
 	wfExecPhase := core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])
-	if wfExecPhase == request.Event.Phase {
-		logger.Debugf(ctx, "This phase %s was already recorded for workflow execution %v",
-			wfExecPhase.String(), request.Event.ExecutionId)
-		return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
-			"This phase %s was already recorded for workflow execution %v",
+	occurredAt, err := ptypes.Timestamp(request.Event.OccurredAt)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "Failed to parse OccurredAt: %v", err)
+	}
+	switch executions.GetEventDisposition(wfExecPhase, executionModel.ExecutionUpdatedAt, request.Event.Phase, occurredAt) {
+	case executions.EventDispositionDuplicate:
+		// Propeller retries events it failed to confirm, there is nothing left to record.
+		logging.Debugf(ctx, logging.Executions, "This phase %s was already recorded for workflow execution %v",
 			wfExecPhase.String(), request.Event.ExecutionId)
-	} else if common.IsExecutionTerminal(wfExecPhase) {
-		// Cannot go backwards in time from a terminal state to anything else
-		curPhase := wfExecPhase.String()
-		errorMsg := fmt.Sprintf("Invalid phase change from %s to %s for workflow execution %v", curPhase, request.Event.Phase.String(), request.Event.ExecutionId)
-		return nil, errors.NewAlreadyInTerminalStateError(ctx, errorMsg, curPhase)
+		m.systemMetrics.DuplicateExecutionEvents.Inc()
+		return &admin.WorkflowExecutionEventResponse{}, nil
+	case executions.EventDispositionStale:
+		logging.Debugf(ctx, logging.Executions,
+			"Ignoring out-of-order phase %s which occurred at %v for workflow execution %v already in phase %s",
+			request.Event.Phase.String(), occurredAt, request.Event.ExecutionId, wfExecPhase.String())
+		m.systemMetrics.StaleExecutionEvents.Inc()
+		return &admin.WorkflowExecutionEventResponse{}, nil
+	case executions.EventDispositionConflict:
+		return nil, executions.WorkflowExecutionPhases.NewTransitionError(
+			ctx, int32(wfExecPhase), int32(request.Event.Phase), request.Event.ExecutionId)
 	}
 
 	err = transformers.UpdateExecutionModelState(executionModel, request, nil)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform updated workflow execution model [%+v] after receiving event with err: %v",
+		logging.Debugf(ctx, logging.Executions, "failed to transform updated workflow execution model [%+v] after receiving event with err: %v",
 			request.Event.ExecutionId, err)
 		return nil, err
 	}
 	executionEventModel, err := transformers.CreateExecutionEventModel(request)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform workflow execution event %s for [%+v] after receiving event with err: %v",
+		logging.Debugf(ctx, logging.Executions, "failed to transform workflow execution event %s for [%+v] after receiving event with err: %v",
 			request.RequestId, request.Event.ExecutionId, err)
 		return nil, err
 	}
+	// The update fails when another event was recorded for the execution since it was read above, the event is then
+	// retried and checked against the phase that event recorded.
+	executionModel.EventVersion++
+	writeTimer := m.systemMetrics.EventMetrics.WriteDuration.Start()
 	err = m.db.ExecutionRepo().Update(ctx, *executionEventModel, *executionModel)
+	writeTimer.Stop()
 	if err != nil {
-		logger.Debugf(ctx, "Failed to update execution with CreateWorkflowEvent [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to update execution with CreateWorkflowEvent [%+v] with err %v",
 			request, err)
 		return nil, err
 	}
+	// Events may be recorded within a transaction which is rolled back, so whatever else the event triggers waits for
+	// it to commit.
+	m.db.AfterCommit(ctx, func(ctx context.Context) {
+		m.handleRecordedWorkflowEvent(ctx, request, executionModel)
+	})
+	return &admin.WorkflowExecutionEventResponse{}, nil
+}
+
+// Publishes the cloud event and notifications of a recorded workflow execution event and updates the execution metrics.
+func (m *ExecutionManager) handleRecordedWorkflowEvent(
+	ctx context.Context, request admin.WorkflowExecutionEventRequest, executionModel *models.Execution) {
+	// Published as soon as the event is recorded since retries of this request are dropped as duplicates.
+	m.publishCloudEvent(ctx, request)
 
 	if request.Event.Phase == core.WorkflowExecution_RUNNING {
 		// Workflow executions are created in state "UNDEFINED". All the time up until a RUNNING event is received is
@@ -584,29 +1238,30 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 		m.systemMetrics.ExecutionsTerminated.Inc()
 		go m.emitOverallWorkflowExecutionTime(executionModel, request.Event.OccurredAt)
 
-		err = m.publishNotifications(ctx, request, *executionModel)
-		if err != nil {
-			// The only errors that publishNotifications will forward are those related
-			// to unexpected data and transformation errors.
-			logger.Debugf(ctx, "failed to publish notifications for CreateWorkflowEvent [%+v] due to err: %v",
-				request, err)
-			return nil, err
+		// Executions terminated through admin were notified of their abort as they were terminated.
+		if request.Event.Phase != core.WorkflowExecution_ABORTED || executionModel.AbortedAt == nil {
+			if err := m.publishNotifications(ctx, request, *executionModel); err != nil {
+				// The only errors that publishNotifications will forward are those related
+				// to unexpected data and transformation errors.
+				logger.Warningf(ctx, "failed to publish notifications for CreateWorkflowEvent [%+v] due to err: %v",
+					request, err)
+			}
 		}
 	}
 
 	m.systemMetrics.ExecutionEventsCreated.Inc()
-	return &admin.WorkflowExecutionEventResponse{}, nil
 }
 
 func (m *ExecutionManager) GetExecution(
 	ctx context.Context, request admin.WorkflowExecutionGetRequest) (*admin.Execution, error) {
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
-		logger.Debugf(ctx, "GetExecution request [%+v] failed validation with err: %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "GetExecution request [%+v] failed validation with err: %v", request, err)
 		return nil, err
 	}
+	ctx = logging.WithExecutionID(ctx, request.Id)
 	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err: %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution model for request [%+v] with err: %v", request, err)
 		return nil, err
 	}
 	var execution *admin.Execution
@@ -615,7 +1270,7 @@ func (m *ExecutionManager) GetExecution(
 		// Fetch parent execution to reconstruct its WorkflowExecutionIdentifier
 		referenceExecutionModel, err := m.db.ExecutionRepo().GetByID(ctx, executionModel.SourceExecutionID)
 		if err != nil {
-			logger.Debugf(ctx, "Failed to get reference execution source execution id [%s] for descendant execution [%v]",
+			logging.Debugf(ctx, logging.Executions, "Failed to get reference execution source execution id [%s] for descendant execution [%v]",
 				executionModel.SourceExecutionID)
 			return nil, err
 		}
@@ -625,7 +1280,7 @@ func (m *ExecutionManager) GetExecution(
 		execution, transformerErr = transformers.FromExecutionModel(*executionModel)
 	}
 	if transformerErr != nil {
-		logger.Debugf(ctx, "Failed to transform execution model [%+v] to proto object with err: %v", request.Id,
+		logging.Debugf(ctx, logging.Executions, "Failed to transform execution model [%+v] to proto object with err: %v", request.Id,
 			transformerErr)
 		return nil, transformerErr
 	}
@@ -652,23 +1307,34 @@ func (m *ExecutionManager) GetExecution(
 	return execution, nil
 }
 
-func (m *ExecutionManager) GetExecutionData(
-	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*admin.WorkflowExecutionGetDataResponse, error) {
+// Returns the signed URL blobs of an execution's data along with the unsigned inputs and outputs URIs they point to.
+func (m *ExecutionManager) getExecutionData(
+	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (
+	response *admin.WorkflowExecutionGetDataResponse, inputsURI, outputsURI string, err error) {
 	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err: %v", request, err)
-		return nil, err
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution model for request [%+v] with err: %v", request, err)
+		return nil, "", "", err
+	}
+	if err := util.ValidateDataAccess(ctx, *executionModel, m.config.ApplicationConfiguration()); err != nil {
+		return nil, "", "", err
+	}
+	if executionModel.DataRemovedAt != nil {
+		return nil, "", "", errors.NewFlyteAdminErrorf(codes.NotFound,
+			"data for execution [%+v] was removed by the domain retention policy at %v",
+			request.Id, *executionModel.DataRemovedAt)
 	}
 	execution, err := transformers.FromExecutionModel(*executionModel)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to transform execution model [%+v] to proto object with err: %v", request.Id, err)
-		return nil, err
+		logging.Debugf(ctx, logging.Executions, "Failed to transform execution model [%+v] to proto object with err: %v", request.Id, err)
+		return nil, "", "", err
 	}
 	signedOutputsURLBlob := admin.UrlBlob{}
 	if execution.Closure.GetOutputs() != nil && execution.Closure.GetOutputs().GetUri() != "" {
-		signedOutputsURLBlob, err = m.urlData.Get(ctx, execution.Closure.GetOutputs().GetUri())
+		outputsURI = execution.Closure.GetOutputs().GetUri()
+		signedOutputsURLBlob, err = m.urlData.Get(ctx, outputsURI)
 		if err != nil {
-			return nil, err
+			return nil, "", "", err
 		}
 	}
 	// Prior to flyteidl v0.15.0, Inputs were held in ExecutionClosure and were not offloaded. Ensure we can return the inputs as expected.
@@ -676,25 +1342,58 @@ func (m *ExecutionManager) GetExecutionData(
 		closure := &admin.ExecutionClosure{}
 		// We must not use the FromExecutionModel method because it empties deprecated fields.
 		if err := proto.Unmarshal(executionModel.Closure, closure); err != nil {
-			return nil, err
+			return nil, "", "", err
 		}
-		newInputsURI, err := m.offloadInputs(ctx, closure.ComputedInputs, request.Id, shared.Inputs)
+		newInputsURI, err := m.offloadInputs(
+			ctx, closure.ComputedInputs, request.Id, shared.Inputs, common.GetOutputDataPrefix(execution.Spec))
 		if err != nil {
-			return nil, err
+			return nil, "", "", err
 		}
 		// Update model so as not to offload again.
 		executionModel.InputsURI = newInputsURI
 		if err := m.db.ExecutionRepo().UpdateExecution(ctx, *executionModel); err != nil {
-			return nil, err
+			return nil, "", "", err
 		}
 	}
-	inputsURLBlob, err := m.urlData.Get(ctx, executionModel.InputsURI.String())
+	inputsURI = executionModel.InputsURI.String()
+	inputsURLBlob, err := m.urlData.Get(ctx, inputsURI)
 	if err != nil {
-		return nil, err
+		return nil, "", "", err
 	}
 	return &admin.WorkflowExecutionGetDataResponse{
 		Outputs: &signedOutputsURLBlob,
 		Inputs:  &inputsURLBlob,
+	}, inputsURI, outputsURI, nil
+}
+
+func (m *ExecutionManager) GetExecutionData(
+	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*admin.WorkflowExecutionGetDataResponse, error) {
+	response, _, _, err := m.getExecutionData(ctx, request)
+	return response, err
+}
+
+// Returns the signed URL blobs of an execution's data along with the inputs and outputs themselves when they're small
+// enough to be inlined.
+func (m *ExecutionManager) GetExecutionFullData(
+	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+	response, inputsURI, outputsURI, err := m.getExecutionData(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	maxInlineSizeBytes := m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxInlineSizeBytes
+	fullInputs, err := util.GetInlineLiteralMap(ctx, m.storageClient, inputsURI, response.Inputs, maxInlineSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	fullOutputs, err := util.GetInlineLiteralMap(ctx, m.storageClient, outputsURI, response.Outputs, maxInlineSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.FullDataResponse{
+		Inputs:      response.Inputs,
+		Outputs:     response.Outputs,
+		FullInputs:  fullInputs,
+		FullOutputs: fullOutputs,
 	}, nil
 }
 
@@ -702,9 +1401,15 @@ func (m *ExecutionManager) ListExecutions(
 	ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error) {
 	// Check required fields
 	if err := validation.ValidateResourceListRequest(request); err != nil {
-		logger.Debugf(ctx, "ListExecutions request [%+v] failed validation with err: %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "ListExecutions request [%+v] failed validation with err: %v", request, err)
+		return nil, err
+	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.ExecutionListLimits)
+	if err != nil {
 		return nil, err
 	}
+	request.Limit = limit
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project:        request.Id.Project,
 		Domain:         request.Id.Domain,
@@ -735,7 +1440,7 @@ func (m *ExecutionManager) ListExecutions(
 	}
 	output, err := m.db.ExecutionRepo().List(ctx, listExecutionsInput)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to list executions using input [%+v] with err %v", listExecutionsInput, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to list executions using input [%+v] with err %v", listExecutionsInput, err)
 		return nil, err
 	}
 	executionList, err := transformers.FromExecutionModels(output.Executions)
@@ -762,6 +1467,248 @@ func (m *ExecutionManager) ListExecutions(
 	}, nil
 }
 
+// Subscribed users are notified of these phases on their preferred channel unless they chose others.
+var notificationPreferencePhases = []core.WorkflowExecution_Phase{
+	core.WorkflowExecution_FAILED,
+	core.WorkflowExecution_TIMED_OUT,
+}
+
+func notifiesPhase(phases []core.WorkflowExecution_Phase, phase core.WorkflowExecution_Phase) bool {
+	for _, notifiedPhase := range phases {
+		if notifiedPhase == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the phases a user is notified of. Phases are validated when the preference is updated.
+func getNotificationPreferencePhases(preference models.NotificationPreference) []core.WorkflowExecution_Phase {
+	if preference.Phases == "" {
+		return notificationPreferencePhases
+	}
+	names := splitNotificationPreferencePhases(preference.Phases)
+	phases := make([]core.WorkflowExecution_Phase, len(names))
+	for idx, name := range names {
+		phases[idx] = core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[name])
+	}
+	return phases
+}
+
+func getNotificationRecipients(notification *admin.Notification) []string {
+	switch {
+	case notification.GetEmail() != nil:
+		return notification.GetEmail().GetRecipientsEmail()
+	case notification.GetPagerDuty() != nil:
+		return notification.GetPagerDuty().GetRecipientsEmail()
+	case notification.GetSlack() != nil:
+		return notification.GetSlack().GetRecipientsEmail()
+	}
+	return nil
+}
+
+func getNotificationChannel(notification *admin.Notification) string {
+	switch {
+	case notification.GetPagerDuty() != nil:
+		return interfaces.PagerDutyNotificationChannel
+	case notification.GetSlack() != nil:
+		return interfaces.SlackNotificationChannel
+	}
+	return interfaces.EmailNotificationChannel
+}
+
+// Returns a copy of the notification sent to the given recipients instead.
+func withNotificationRecipients(notification *admin.Notification, recipients []string) *admin.Notification {
+	switch {
+	case notification.GetEmail() != nil:
+		return &admin.Notification{
+			Phases: notification.Phases,
+			Type:   &admin.Notification_Email{Email: &admin.EmailNotification{RecipientsEmail: recipients}},
+		}
+	case notification.GetPagerDuty() != nil:
+		return &admin.Notification{
+			Phases: notification.Phases,
+			Type: &admin.Notification_PagerDuty{
+				PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: recipients}},
+		}
+	case notification.GetSlack() != nil:
+		return &admin.Notification{
+			Phases: notification.Phases,
+			Type:   &admin.Notification_Slack{Slack: &admin.SlackNotification{RecipientsEmail: recipients}},
+		}
+	}
+	return notification
+}
+
+func newChannelNotification(
+	channel string, phase core.WorkflowExecution_Phase, recipients []string) *admin.Notification {
+	var notification admin.Notification
+	switch channel {
+	case interfaces.SlackNotificationChannel:
+		notification.Type = &admin.Notification_Slack{Slack: &admin.SlackNotification{}}
+	case interfaces.PagerDutyNotificationChannel:
+		notification.Type = &admin.Notification_PagerDuty{PagerDuty: &admin.PagerDutyNotification{}}
+	default:
+		notification.Type = &admin.Notification_Email{Email: &admin.EmailNotification{}}
+	}
+	notification.Phases = []core.WorkflowExecution_Phase{phase}
+	return withNotificationRecipients(&notification, recipients)
+}
+
+// Users manage their own subscriptions to a launch plan's failure notifications: unsubscribed users are removed from
+// the configured recipients and subscribed users are added on their preferred channel unless they're notified of the
+// phase on that channel already. Users who chose the phases they're notified of, e.g. only FAILED and ABORTED, are
+// also removed from the configured recipients of any other phase. Preferences apply to every version of the launch
+// plan.
+func (m *ExecutionManager) applyNotificationPreferences(ctx context.Context, execution *admin.Execution,
+	phase core.WorkflowExecution_Phase, notificationsList []*admin.Notification) []*admin.Notification {
+	launchPlanID := execution.GetSpec().GetLaunchPlan()
+	if launchPlanID == nil {
+		return notificationsList
+	}
+	preferences, err := m.db.NotificationPreferenceRepo().ListForLaunchPlan(
+		ctx, launchPlanID.Project, launchPlanID.Domain, launchPlanID.Name)
+	if err != nil {
+		// Notifications are still sent to the configured recipients.
+		logger.Warningf(ctx, "Failed to list notification preferences of launch plan [%+v] with err: %v",
+			launchPlanID, err)
+		return notificationsList
+	}
+	if len(preferences) == 0 {
+		return notificationsList
+	}
+	excluded := make(map[string]bool)
+	for _, preference := range preferences {
+		if !preference.Subscribed ||
+			(preference.Phases != "" && !notifiesPhase(getNotificationPreferencePhases(preference), phase)) {
+			excluded[preference.UserEmail] = true
+		}
+	}
+
+	appliedNotifications := make([]*admin.Notification, 0, len(notificationsList))
+	// Which recipients are notified of the current phase by channel.
+	notifiedRecipients := make(map[string]map[string]bool)
+	for _, notification := range notificationsList {
+		recipients := make([]string, 0)
+		for _, recipient := range getNotificationRecipients(notification) {
+			if !excluded[recipient] {
+				recipients = append(recipients, recipient)
+			}
+		}
+		if len(recipients) == 0 {
+			continue
+		}
+		notification = withNotificationRecipients(notification, recipients)
+		appliedNotifications = append(appliedNotifications, notification)
+		if !notifiesPhase(notification.Phases, phase) {
+			continue
+		}
+		channel := getNotificationChannel(notification)
+		if notifiedRecipients[channel] == nil {
+			notifiedRecipients[channel] = make(map[string]bool)
+		}
+		for _, recipient := range recipients {
+			notifiedRecipients[channel][recipient] = true
+		}
+	}
+
+	subscribedRecipients := make(map[string][]string)
+	channels := make([]string, 0)
+	for _, preference := range preferences {
+		channel := preference.Channel
+		if channel == "" {
+			channel = interfaces.EmailNotificationChannel
+		}
+		if !preference.Subscribed || !notifiesPhase(getNotificationPreferencePhases(preference), phase) ||
+			notifiedRecipients[channel][preference.UserEmail] {
+			continue
+		}
+		if _, ok := subscribedRecipients[channel]; !ok {
+			channels = append(channels, channel)
+		}
+		subscribedRecipients[channel] = append(subscribedRecipients[channel], preference.UserEmail)
+	}
+	for _, channel := range channels {
+		appliedNotifications = append(appliedNotifications,
+			newChannelNotification(channel, phase, subscribedRecipients[channel]))
+	}
+	return appliedNotifications
+}
+
+// Adds the notifications of the current phase sent to the default recipients of the execution's project and domain,
+// unless they're notified of the phase on that channel already or the execution disabled notifications.
+func (m *ExecutionManager) addDefaultNotifications(ctx context.Context, execution *admin.Execution,
+	phase core.WorkflowExecution_Phase, notificationsList []*admin.Notification) []*admin.Notification {
+	if execution.GetSpec().GetDisableAll() {
+		return notificationsList
+	}
+	executionID := execution.GetId()
+	attributes, err := util.GetProjectDomainAttributes(ctx, m.db, executionID.GetProject(), executionID.GetDomain())
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get attributes of [%s/%s] with err: %v",
+			executionID.GetProject(), executionID.GetDomain(), err)
+		return notificationsList
+	}
+	defaultNotifications, err := validation.GetDefaultNotifications(attributes)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to read default notifications of [%s/%s] with err: %v",
+			executionID.GetProject(), executionID.GetDomain(), err)
+		return notificationsList
+	}
+	if len(defaultNotifications) == 0 {
+		return notificationsList
+	}
+	// Which recipients are notified of the current phase by channel.
+	notifiedRecipients := make(map[string]map[string]bool)
+	for _, notification := range notificationsList {
+		if !notifiesPhase(notification.Phases, phase) {
+			continue
+		}
+		channel := getNotificationChannel(notification)
+		if notifiedRecipients[channel] == nil {
+			notifiedRecipients[channel] = make(map[string]bool)
+		}
+		for _, recipient := range getNotificationRecipients(notification) {
+			notifiedRecipients[channel][recipient] = true
+		}
+	}
+	mergedNotifications := append(make([]*admin.Notification, 0, len(notificationsList)), notificationsList...)
+	for _, notification := range defaultNotifications {
+		if !notifiesPhase(notification.Phases, phase) {
+			continue
+		}
+		channel := getNotificationChannel(notification)
+		recipients := make([]string, 0)
+		for _, recipient := range getNotificationRecipients(notification) {
+			if !notifiedRecipients[channel][recipient] {
+				recipients = append(recipients, recipient)
+			}
+		}
+		if len(recipients) > 0 {
+			mergedNotifications = append(mergedNotifications, newChannelNotification(channel, phase, recipients))
+		}
+	}
+	return mergedNotifications
+}
+
+// Converts an email notification into the email message to be published, rendering the email templates of the
+// execution's project when they're loaded. Templates are validated as they're loaded, so failing to render one is
+// unexpected and the email falls back to the emailer config.
+func (m *ExecutionManager) toEmailMessage(ctx context.Context, emailNotification admin.EmailNotification,
+	request admin.WorkflowExecutionEventRequest, execution *admin.Execution) *admin.EmailMessage {
+	if m.emailTemplates != nil {
+		email, err := m.emailTemplates.ToEmailMessage(emailNotification, request, execution)
+		if err == nil {
+			return email
+		}
+		logger.Warningf(ctx, "failed to render email templates for execution [%+v] with err: %v",
+			request.Event.ExecutionId, err)
+		m.systemMetrics.UnexpectedDataError.Inc()
+	}
+	return notifications.ToEmailMessageFromWorkflowExecutionEvent(
+		*m.config.ApplicationConfiguration().GetNotificationsConfig(), emailNotification, request, execution)
+}
+
 // publishNotifications will only forward major errors because the assumption made is all of the objects
 // that are being manipulated have already been validated/manipulated by Flyte itself.
 // Note: This method should be refactored somewhere else once the interaction with pushing to SNS.
@@ -774,34 +1721,52 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 		m.systemMetrics.TransformerError.Inc()
 		return errors.NewFlyteAdminErrorf(codes.Internal, "Failed to transform execution [%+v] with err: %v", request.Event.ExecutionId, err)
 	}
-	var notificationsList = adminExecution.Closure.Notifications
-	logger.Debugf(ctx, "publishing notifications for execution [%+v] in state [%+v] for notifications [%+v]",
+	featureFlags, err := util.GetFeatureFlags(ctx, m.db, execution.Project, execution.Domain,
+		adminExecution.GetClosure().GetWorkflowId().GetName())
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get feature flags of execution [%+v] with err: %v",
+			request.Event.ExecutionId, err)
+	}
+	if featureFlags.DisableNotifications {
+		logging.Debugf(ctx, logging.Executions, "notifications are disabled for execution [%+v]",
+			request.Event.ExecutionId)
+		return nil
+	}
+	quietHours, err := util.GetQuietHours(ctx, m.db, execution.Project, execution.Domain)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get quiet hours of execution [%+v] with err: %v",
+			request.Event.ExecutionId, err)
+	}
+	now := m._clock.Now()
+	// Notifications are throttled per launch plan, so that a launch plan whose executions fail en masse results in a
+	// digest rather than an email per execution.
+	ctx = common.WithLaunchPlan(ctx, adminExecution.GetSpec().GetLaunchPlan())
+	var notificationsList = m.applyNotificationPreferences(ctx, adminExecution, request.Event.Phase,
+		m.addDefaultNotifications(ctx, adminExecution, request.Event.Phase, adminExecution.Closure.Notifications))
+	logging.Debugf(ctx, logging.Executions, "publishing notifications for execution [%+v] in state [%+v] for notifications [%+v]",
 		request.Event.ExecutionId, request.Event.Phase, notificationsList)
 	for _, notification := range notificationsList {
-		// Check if the notification phase matches the current one.
-		var matchPhase = false
-		for _, phase := range notification.Phases {
-			if phase == request.Event.Phase {
-				matchPhase = true
-			}
-		}
-
 		// The current phase doesn't match; no notifications will be sent for the current notification option.
-		if !matchPhase {
+		if !notifiesPhase(notification.Phases, request.Event.Phase) {
 			continue
 		}
 
 		// Currently all three supported notifications use email underneath to send the notification.
-		// Convert Slack and PagerDuty into an EmailNotification type.
+		// Convert Slack and PagerDuty into an EmailNotification type. The message is still published with the
+		// original notification type so that the publisher can tell the channels apart, e.g. to rate limit them.
 		var emailNotification admin.EmailNotification
+		var notificationType string
 		if notification.GetEmail() != nil {
 			emailNotification.RecipientsEmail = notification.GetEmail().GetRecipientsEmail()
+			notificationType = proto.MessageName(notification.GetEmail())
 		} else if notification.GetPagerDuty() != nil {
 			emailNotification.RecipientsEmail = notification.GetPagerDuty().GetRecipientsEmail()
+			notificationType = proto.MessageName(notification.GetPagerDuty())
 		} else if notification.GetSlack() != nil {
 			emailNotification.RecipientsEmail = notification.GetSlack().GetRecipientsEmail()
+			notificationType = proto.MessageName(notification.GetSlack())
 		} else {
-			logger.Debugf(ctx, "failed to publish notification, encountered unrecognized type: %v", notification.Type)
+			logging.Debugf(ctx, logging.Executions, "failed to publish notification, encountered unrecognized type: %v", notification.Type)
 			m.systemMetrics.UnexpectedDataError.Inc()
 			// Unsupported notification types should have been caught when the launch plan was being created.
 			return errors.NewFlyteAdminErrorf(codes.Internal, "Unsupported notification type [%v] for execution [%+v]",
@@ -809,26 +1774,87 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 		}
 
 		// Convert the email Notification into an email message to be published.
-		// Currently there are no possible errors while creating an email message.
-		// Once customizable content is specified, errors are possible.
-		email := notifications.ToEmailMessageFromWorkflowExecutionEvent(
-			*m.config.ApplicationConfiguration().GetNotificationsConfig(), emailNotification, request, adminExecution)
+		email := m.toEmailMessage(ctx, emailNotification, request, adminExecution)
+		switch m.quietHours.GetAction(quietHours, notification, request.Event.Phase, now) {
+		case notifications.QuietHoursActionSuppress:
+			logging.Debugf(ctx, logging.Executions, "suppressing notification [%+v] for execution [%+v] during quiet hours",
+				notification, request.Event.ExecutionId)
+			m.systemMetrics.NotificationsSuppressed.Inc()
+			continue
+		case notifications.QuietHoursActionDefer:
+			err = m.quietHours.Defer(ctx, execution.Project, execution.Domain, quietHours, email)
+			if err == nil {
+				logging.Debugf(ctx, logging.Executions,
+					"deferring notification [%+v] for execution [%+v] until quiet hours end",
+					notification, request.Event.ExecutionId)
+				m.systemMetrics.NotificationsDeferred.Inc()
+				continue
+			}
+			// Notifying during quiet hours is preferable to losing the notification.
+			logger.Warningf(ctx, "failed to defer notification [%+v] for execution [%+v], publishing it instead: %v",
+				notification, request.Event.ExecutionId, err)
+		}
 		// Errors seen while publishing a message are considered non-fatal to the method and will not result
 		// in the method returning an error.
-		if err = m.notificationClient.Publish(ctx, proto.MessageName(&emailNotification), email); err != nil {
+		if err = m.notificationClient.Publish(ctx, notificationType, email); err != nil {
 			m.systemMetrics.PublishNotificationError.Inc()
 			logger.Infof(ctx, "error publishing email notification [%+v] with err: [%v]", notification, err)
 		}
 	}
+	m.publishWebhookNotifications(ctx, request, adminExecution)
 	return nil
 }
 
+// Publish failures are only logged: the event was recorded and failing the request would have propeller retry it.
+func (m *ExecutionManager) publishCloudEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) {
+	if err := m.cloudEventPublisher.Publish(ctx, proto.MessageName(request.Event), request.Event); err != nil {
+		logger.Warningf(ctx, "Failed to publish cloud event for execution [%+v] with err: %v",
+			request.Event.ExecutionId, err)
+	}
+}
+
+// Publishes the Microsoft Teams and generic webhook notifications the project and domain attributes of the execution
+// configure. They're only queued here and POSTed to the webhooks in the background. Errors are logged rather than
+// returned, like those publishing any other notification.
+func (m *ExecutionManager) publishWebhookNotifications(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) {
+	if execution.GetSpec().GetDisableAll() {
+		return
+	}
+	executionID := execution.GetId()
+	attributes, err := util.GetProjectDomainAttributes(ctx, m.db, executionID.GetProject(), executionID.GetDomain())
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get attributes of [%s/%s] with err: %v",
+			executionID.GetProject(), executionID.GetDomain(), err)
+		return
+	}
+	webhookNotifications, err := validation.GetDefaultWebhookNotifications(attributes)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to read webhook notifications of [%s/%s] with err: %v",
+			executionID.GetProject(), executionID.GetDomain(), err)
+		return
+	}
+	for _, notification := range webhookNotifications {
+		if !notifiesPhase(notification.Phases, request.Event.Phase) {
+			continue
+		}
+		// Webhooks are notified with the rendered email, whose recipients are the names of the webhooks.
+		email := m.toEmailMessage(
+			ctx, admin.EmailNotification{RecipientsEmail: notification.Webhooks}, request, execution)
+		if err = m.notificationClient.Publish(ctx, notification.NotificationType, email); err != nil {
+			m.systemMetrics.PublishNotificationError.Inc()
+			logger.Infof(ctx, "error publishing webhook notification [%+v] with err: [%v]", notification, err)
+		}
+	}
+}
+
 func (m *ExecutionManager) TerminateExecution(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
-		logger.Debugf(ctx, "received terminate execution request: %v with invalid identifier: %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "received terminate execution request: %v with invalid identifier: %v", request, err)
 		return nil, err
 	}
+	ctx = logging.WithExecutionID(ctx, request.Id)
 	// Save the abort reason (best effort)
 	executionModel, err := m.db.ExecutionRepo().Get(ctx, repositoryInterfaces.GetResourceInput{
 		Project: request.Id.Project,
@@ -839,6 +1865,11 @@ func (m *ExecutionManager) TerminateExecution(
 		logger.Infof(ctx, "couldn't find execution [%+v] to save termination cause", request.Id)
 		return nil, err
 	}
+	phase := core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])
+	if common.IsExecutionTerminal(phase) {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"execution [%+v] already terminated as %s", request.Id, phase)
+	}
 
 	err = m.workflowExecutor.TerminateWorkflowExecution(ctx, workflowengineInterfaces.TerminateWorkflowInput{
 		ExecutionID: request.Id,
@@ -847,16 +1878,299 @@ func (m *ExecutionManager) TerminateExecution(
 	if err != nil {
 		return nil, err
 	}
+	if executionModel.AbortedAt != nil {
+		// Terminating again, e.g. when retrying, keeps the original abort cause and doesn't notify the owners twice.
+		return &admin.ExecutionTerminateResponse{}, nil
+	}
 
+	abortedAt := m._clock.Now()
 	executionModel.AbortCause = request.Cause
+	executionModel.AbortedBy = auth.GetUserEmail(ctx)
+	executionModel.AbortedAt = &abortedAt
 	err = m.db.ExecutionRepo().UpdateExecution(ctx, executionModel)
 	if err != nil {
-		logger.Debugf(ctx, "failed to save abort cause for terminated execution: %+v with err: %v", request.Id, err)
+		logging.Debugf(ctx, logging.Executions, "failed to save abort cause for terminated execution: %+v with err: %v", request.Id, err)
 		return nil, err
 	}
+	m.publishAbortNotifications(ctx, executionModel)
 	return &admin.ExecutionTerminateResponse{}, nil
 }
 
+// Notifies the owners of an execution terminated through admin of its abort, why and by whom, without waiting for
+// the ABORTED event. The notifications are published for an ABORTED event produced by the principal which terminated
+// the execution. Like those of events, errors are logged rather than failing the termination.
+func (m *ExecutionManager) publishAbortNotifications(ctx context.Context, executionModel models.Execution) {
+	occurredAt, err := ptypes.TimestampProto(*executionModel.AbortedAt)
+	if err != nil {
+		logger.Warningf(ctx, "failed to publish abort notifications for execution [%s] with err: %v",
+			executionModel.Name, err)
+		return
+	}
+	executionID := transformers.GetExecutionIdentifier(&executionModel)
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionID,
+			ProducerId:  executionModel.AbortedBy,
+			Phase:       core.WorkflowExecution_ABORTED,
+			OccurredAt:  occurredAt,
+		},
+	}
+	// The execution is rendered as it will be once aborted, i.e. with its abort cause.
+	executionModel.Phase = core.WorkflowExecution_ABORTED.String()
+	if err = m.publishNotifications(ctx, request, executionModel); err != nil {
+		logger.Warningf(ctx, "failed to publish abort notifications for execution [%+v] with err: %v",
+			executionID, err)
+	}
+}
+
+// Replaces the tags of an existing execution. Tags are recorded in the execution spec too so that they're returned with
+// the execution and carried over on relaunch.
+func (m *ExecutionManager) UpdateExecution(
+	ctx context.Context, request interfaces.ExecutionUpdateRequest) (*interfaces.ExecutionUpdateResponse, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.ID); err != nil {
+		logging.Debugf(ctx, logging.Executions, "received update execution request: %v with invalid identifier: %v",
+			request, err)
+		return nil, err
+	}
+	if err := validation.ValidateTags(request.Tags); err != nil {
+		return nil, err
+	}
+	ctx = logging.WithExecutionID(ctx, request.ID)
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.ID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution model for request [%+v] with err: %v",
+			request, err)
+		return nil, err
+	}
+	var spec admin.ExecutionSpec
+	if err := proto.Unmarshal(executionModel.Spec, &spec); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal spec")
+	}
+	common.SetTags(&spec, request.Tags)
+	tags := common.GetTags(&spec)
+	executionModel.Spec, err = proto.Marshal(&spec)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal spec")
+	}
+	executionModel.Tags = transformers.CreateExecutionTagModels(executionModel.ExecutionKey, tags)
+	if err := m.db.ExecutionRepo().UpdateTags(ctx, *executionModel); err != nil {
+		logging.Debugf(ctx, logging.Executions, "failed to update tags for execution: %+v with err: %v",
+			request.ID, err)
+		return nil, err
+	}
+	return &interfaces.ExecutionUpdateResponse{
+		Tags: tags,
+	}, nil
+}
+
+var ascExecutionEventOccurredAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "execution_events.occurred_at",
+})
+
+// Task executions are listed joined with their node executions and executions so the sort key must be qualified.
+var ascTaskExecutionCreatedAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "task_executions.created_at",
+})
+
+func (m *ExecutionManager) addWorkflowTimelineEvents(
+	ctx context.Context, key models.ExecutionKey, summary *util.ExecutionEventSummary,
+	timeline *interfaces.ExecutionTimeline) error {
+	if summary != nil {
+		for _, event := range summary.Events {
+			timeline.Events = append(timeline.Events, interfaces.TimelineEvent{
+				Kind:       interfaces.TimelineEventKindWorkflow,
+				Phase:      event.Phase,
+				OccurredAt: event.OccurredAt,
+			})
+		}
+	}
+	filters := make([]common.InlineFilter, 0, 3)
+	for _, identifier := range [][2]string{
+		{shared.Project, key.Project},
+		{shared.Domain, key.Domain},
+		{shared.Name, key.Name},
+	} {
+		filter, err := common.NewSingleValueFilter(common.ExecutionEvent, common.Equal, identifier[0], identifier[1])
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+	for offset := 0; ; offset += nodeExecutionEventsPageSize {
+		output, err := m.db.ExecutionRepo().ListEvents(ctx, repositoryInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         nodeExecutionEventsPageSize,
+			Offset:        offset,
+			SortParameter: ascExecutionEventOccurredAtSortParam,
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range output.ExecutionEvents {
+			timeline.Events = append(timeline.Events, interfaces.TimelineEvent{
+				Kind:       interfaces.TimelineEventKindWorkflow,
+				Phase:      event.Phase,
+				OccurredAt: event.OccurredAt,
+			})
+		}
+		if len(output.ExecutionEvents) < nodeExecutionEventsPageSize {
+			return nil
+		}
+	}
+}
+
+func (m *ExecutionManager) addNodeTimelineEvents(
+	ctx context.Context, key models.ExecutionKey, summary *util.ExecutionEventSummary,
+	filters []common.InlineFilter, timeline *interfaces.ExecutionTimeline) error {
+	addEvent := func(event models.NodeExecutionEvent) {
+		timeline.Events = append(timeline.Events, interfaces.TimelineEvent{
+			Kind:       interfaces.TimelineEventKindNode,
+			NodeID:     event.NodeID,
+			Phase:      event.Phase,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+	if summary != nil {
+		for _, event := range summary.GetNodeExecutionEvents(key) {
+			addEvent(event)
+		}
+	}
+	for offset := 0; ; offset += nodeExecutionEventsPageSize {
+		output, err := m.db.NodeExecutionRepo().ListEvents(ctx, repositoryInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         nodeExecutionEventsPageSize,
+			Offset:        offset,
+			SortParameter: ascOccurredAtSortParam,
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range output.NodeExecutionEvents {
+			addEvent(event)
+		}
+		if len(output.NodeExecutionEvents) < nodeExecutionEventsPageSize {
+			return nil
+		}
+	}
+}
+
+// Task execution events aren't stored individually, so the phases of each task execution are reconstructed from when
+// it was created, started running and last updated: an attempt created before it started running (or which hasn't
+// started yet) is reported as queued when it was created, and terminated attempts report their terminal phase when
+// they were last updated.
+func getTaskTimelineEvents(taskExecution models.TaskExecution) []interfaces.TimelineEvent {
+	task := &interfaces.TimelineTask{
+		Project:      taskExecution.TaskKey.Project,
+		Domain:       taskExecution.TaskKey.Domain,
+		Name:         taskExecution.TaskKey.Name,
+		Version:      taskExecution.TaskKey.Version,
+		RetryAttempt: *taskExecution.RetryAttempt,
+	}
+	events := make([]interfaces.TimelineEvent, 0, 3)
+	addEvent := func(phase core.TaskExecution_Phase, occurredAt time.Time) {
+		events = append(events, interfaces.TimelineEvent{
+			Kind:       interfaces.TimelineEventKindTask,
+			NodeID:     taskExecution.NodeID,
+			Task:       task,
+			Phase:      phase.String(),
+			OccurredAt: occurredAt,
+		})
+	}
+	phase := core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecution.Phase])
+	createdAt := taskExecution.TaskExecutionCreatedAt
+	updatedAt := taskExecution.TaskExecutionUpdatedAt
+	terminal := common.IsTaskExecutionTerminal(phase)
+	if createdAt != nil {
+		startedOnCreation := taskExecution.StartedAt != nil && !createdAt.Before(*taskExecution.StartedAt)
+		terminatedOnCreation := terminal && updatedAt != nil && !createdAt.Before(*updatedAt)
+		if !startedOnCreation && !terminatedOnCreation {
+			addEvent(core.TaskExecution_QUEUED, *createdAt)
+		}
+	}
+	if taskExecution.StartedAt != nil {
+		addEvent(core.TaskExecution_RUNNING, *taskExecution.StartedAt)
+	}
+	if terminal && updatedAt != nil {
+		addEvent(phase, *updatedAt)
+	}
+	return events
+}
+
+func (m *ExecutionManager) addTaskTimelineEvents(
+	ctx context.Context, filters []common.InlineFilter, timeline *interfaces.ExecutionTimeline) error {
+	for offset := 0; ; offset += nodeExecutionEventsPageSize {
+		output, err := m.db.TaskExecutionRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         nodeExecutionEventsPageSize,
+			Offset:        offset,
+			SortParameter: ascTaskExecutionCreatedAtSortParam,
+		})
+		if err != nil {
+			return err
+		}
+		for _, taskExecution := range output.TaskExecutions {
+			timeline.Events = append(timeline.Events, getTaskTimelineEvents(taskExecution)...)
+		}
+		if len(output.TaskExecutions) < nodeExecutionEventsPageSize {
+			return nil
+		}
+	}
+}
+
+// Assembles the events recorded for an execution, its node executions and their task executions (including those
+// preserved by its event summary, if its events were compacted) into a single chronological timeline.
+func (m *ExecutionManager) ExportExecutionTimeline(
+	ctx context.Context, request interfaces.ExecutionTimelineExportRequest) (*interfaces.ExecutionTimeline, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.ID); err != nil {
+		logging.Debugf(ctx, logging.Executions, "received export timeline request: %v with invalid identifier: %v",
+			request, err)
+		return nil, err
+	}
+	ctx = logging.WithExecutionID(ctx, request.ID)
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.ID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution model for request [%+v] with err: %v",
+			request, err)
+		return nil, err
+	}
+	summary, err := util.GetExecutionEventSummary(ctx, m.db, executionModel.ExecutionKey)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get event summary of [%+v] with err %v", request.ID, err)
+		return nil, err
+	}
+	filters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, *request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &interfaces.ExecutionTimeline{
+		ID:     request.ID,
+		Events: make([]interfaces.TimelineEvent, 0),
+	}
+	if err := m.addWorkflowTimelineEvents(ctx, executionModel.ExecutionKey, summary, timeline); err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to list execution events of [%+v] with err %v",
+			request.ID, err)
+		return nil, err
+	}
+	if err := m.addNodeTimelineEvents(ctx, executionModel.ExecutionKey, summary, filters, timeline); err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to list node execution events of [%+v] with err %v",
+			request.ID, err)
+		return nil, err
+	}
+	if err := m.addTaskTimelineEvents(ctx, filters, timeline); err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to list task executions of [%+v] with err %v",
+			request.ID, err)
+		return nil, err
+	}
+	// Workflow events precede node events which precede task events occurring at the same time.
+	sort.SliceStable(timeline.Events, func(i, j int) bool {
+		return timeline.Events[i].OccurredAt.Before(timeline.Events[j].OccurredAt)
+	})
+	return timeline, nil
+}
+
 func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 	return executionSystemMetrics{
 		Scope: scope,
@@ -868,6 +2182,12 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of terminated workflow executions"),
 		ExecutionEventsCreated: scope.MustNewCounter("execution_events_created",
 			"overall count of successfully completed WorkflowExecutionEventRequest"),
+		DuplicateExecutionEvents: scope.MustNewCounter("duplicate_execution_events",
+			"overall count of acknowledged WorkflowExecutionEventRequests repeating an already recorded phase"),
+		StaleExecutionEvents: scope.MustNewCounter("stale_execution_events",
+			"overall count of acknowledged out-of-order WorkflowExecutionEventRequests superseded by a later phase"),
+		BackfillExecutionFailures: scope.MustNewCounter("backfill_execution_failures",
+			"overall count of backfilled executions which failed to launch"),
 		PropellerFailures: scope.MustNewCounter("propeller_failures",
 			"propeller failures in creating workflow executions"),
 		TransformerError: scope.MustNewCounter("transformer_error",
@@ -876,10 +2196,15 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of unexpected data for previously validated objects"),
 		PublishNotificationError: scope.MustNewCounter("publish_error",
 			"overall count of publish notification errors when invoking publish()"),
+		NotificationsSuppressed: scope.MustNewCounter("notifications_suppressed",
+			"overall count of notifications dropped during quiet hours"),
+		NotificationsDeferred: scope.MustNewCounter("notifications_deferred",
+			"overall count of notifications deferred to a digest during quiet hours"),
 		SpecSizeBytes:    scope.MustNewSummary("spec_size_bytes", "size in bytes of serialized execution spec"),
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes", "size in bytes of serialized execution closure"),
 		AcceptanceDelay: scope.MustNewSummary("acceptance_delay",
 			"delay in seconds from when an execution was requested to be created and when it actually was"),
+		EventMetrics: util.NewEventMetrics(scope),
 	}
 }
 
@@ -891,8 +2216,9 @@ func NewExecutionManager(
 	systemScope promutils.Scope,
 	userScope promutils.Scope,
 	publisher notificationInterfaces.Publisher,
-	urlData dataInterfaces.RemoteURLInterface) interfaces.ExecutionInterface {
-	queueAllocator := executions.NewQueueAllocator(config)
+	urlData dataInterfaces.RemoteURLInterface,
+	cloudEventPublisher cloudEventInterfaces.Publisher) interfaces.ExecutionInterface {
+	queueAllocator := executions.NewQueueAllocator(config, db)
 	systemMetrics := newExecutionSystemMetrics(systemScope)
 
 	userMetrics := executionUserMetrics{
@@ -900,16 +2226,24 @@ func NewExecutionManager(
 		ScheduledExecutionDelays:   make(map[string]map[string]*promutils.StopWatch),
 		WorkflowExecutionDurations: make(map[string]map[string]*promutils.StopWatch),
 	}
+	// Invalid email templates fail admin initialization rather than the notifications they render.
+	emailTemplates, err := notifications.NewEmailTemplates(*config.ApplicationConfiguration().GetNotificationsConfig())
+	if err != nil {
+		panic(err)
+	}
 	return &ExecutionManager{
-		db:                 db,
-		config:             config,
-		storageClient:      storageClient,
-		workflowExecutor:   workflowExecutor,
-		queueAllocator:     queueAllocator,
-		_clock:             clock.New(),
-		systemMetrics:      systemMetrics,
-		userMetrics:        userMetrics,
-		notificationClient: publisher,
-		urlData:            urlData,
+		db:                  db,
+		config:              config,
+		storageClient:       storageClient,
+		workflowExecutor:    workflowExecutor,
+		queueAllocator:      queueAllocator,
+		_clock:              clock.New(),
+		systemMetrics:       systemMetrics,
+		userMetrics:         userMetrics,
+		notificationClient:  publisher,
+		urlData:             urlData,
+		quietHours:          notifications.NewQuietHours(publisher, db, systemScope),
+		emailTemplates:      emailTemplates,
+		cloudEventPublisher: cloudEventPublisher,
 	}
 }
@@ -2,9 +2,11 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
+	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/common"
 	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
 
@@ -20,16 +22,20 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	cloudEventMocks "github.com/lyft/flyteadmin/pkg/async/cloudevent/mocks"
 	notificationMocks "github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
 	dataMocks "github.com/lyft/flyteadmin/pkg/data/mocks"
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	workflowengineInterfaces "github.com/lyft/flyteadmin/pkg/workflowengine/interfaces"
@@ -41,6 +47,7 @@ import (
 	mockScope "github.com/lyft/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 )
 
 var spec = testutils.GetExecutionRequest().Spec
@@ -210,7 +217,7 @@ func TestCreateExecution(t *testing.T) {
 		})
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	request := testutils.GetExecutionRequest()
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -224,6 +231,85 @@ func TestCreateExecution(t *testing.T) {
 	// TODO: Check for offloaded inputs
 }
 
+func TestCreateExecutionTraceParent(t *testing.T) {
+	traceParent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var created bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			assert.Equal(t, traceParent, input.TraceParent)
+			created = true
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Equal(t, traceParent, inputs.TraceParent)
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(common.TraceParentHeader, traceParent))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, created)
+}
+
+func TestCreateExecution_OnFailurePolicy(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.OnFailureAnnotation: string(common.OnFailurePolicyFailAfterExecutableNodesComplete),
+		},
+	}
+	lpSpecBytes, _ := proto.Marshal(&lpSpec)
+	lpClosureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: lpSpec.DefaultInputs,
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec:    lpSpecBytes,
+				Closure: lpClosureBytes,
+			}, nil
+		})
+	var executed bool
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			// The policy is kept even though the execution's annotations replace those of the launch plan.
+			assert.Equal(t, "FAIL_AFTER_EXECUTABLE_NODES_COMPLETE", inputs.OnFailurePolicy)
+			assert.Equal(t, map[string]string{"key": "value"}, inputs.Annotations)
+			executed = true
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	request := testutils.GetExecutionRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"key": "value",
+		},
+	}
+	_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, executed)
+}
+
 func TestCreateExecutionFromWorkflowNode(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
@@ -258,7 +344,7 @@ func TestCreateExecutionFromWorkflowNode(t *testing.T) {
 
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Metadata = &admin.ExecutionMetadata{
 		Mode:                admin.ExecutionMetadata_SYSTEM,
@@ -274,6 +360,84 @@ func TestCreateExecutionFromWorkflowNode(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestCreateExecution_OutputDataPrefix(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			assert.Equal(t, "s3://team-bucket/flyte/metadata/project/domain/name/inputs", input.InputsURI.String())
+			assert.Equal(t, "s3://team-bucket/flyte/metadata/project/domain/name/user_inputs", input.UserInputsURI.String())
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Equal(t, "s3://team-bucket/flyte", inputs.OutputDataPrefix)
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	applicationConfig := testutils.GetApplicationConfigWithDefaultProjects()
+	applicationConfig.(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		AllowedOutputDataPrefixes: []string{"s3://team-bucket/"},
+	})
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		applicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	configProvider.(*runtimeMocks.MockConfigurationProvider).AddRegistrationValidationConfiguration(
+		runtimeMocks.NewMockRegistrationValidationProvider())
+	execManager := NewExecutionManager(
+		repository, configProvider, getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	request := testutils.GetExecutionRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.OutputDataPrefixAnnotation: "s3://team-bucket/flyte",
+		},
+	}
+	_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.Nil(t, err)
+}
+
+func TestCreateExecution_EnvironmentVariables(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.NotEmpty(t, inputs.WfClosure.Tasks)
+			for _, task := range inputs.WfClosure.Tasks {
+				assert.Equal(t, []*core.KeyValuePair{
+					{
+						Key:   "EXPERIMENT_ID",
+						Value: "42",
+					},
+					{
+						Key:   "MODE",
+						Value: "canary",
+					},
+				}, task.Template.GetContainer().Env)
+			}
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	request := testutils.GetExecutionRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.EnvironmentVariableAnnotationPrefix + "MODE":          "canary",
+			common.EnvironmentVariableAnnotationPrefix + "EXPERIMENT_ID": "42",
+		},
+	}
+	_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.Nil(t, err)
+}
+
 func TestCreateExecution_NoAssignedName(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
@@ -294,7 +458,7 @@ func TestCreateExecution_NoAssignedName(t *testing.T) {
 			}, nil
 		})
 	execManager := NewExecutionManager(
-		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	request := testutils.GetExecutionRequest()
 	request.Name = ""
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -348,7 +512,7 @@ func TestCreateExecution_TaggedQueue(t *testing.T) {
 			}, nil
 		})
 	execManager := NewExecutionManager(
-		repository, configProvider, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		repository, configProvider, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	request := testutils.GetExecutionRequest()
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -361,12 +525,185 @@ func TestCreateExecution_TaggedQueue(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestCreateExecution_ProjectDomainDefaultInputs(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpec.DefaultInputs.Parameters["bucket"] = &core.Parameter{
+		Var: &core.Variable{
+			Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+		},
+		Behavior: &core.Parameter_Required{
+			Required: true,
+		},
+	}
+	lpSpecBytes, _ := proto.Marshal(&lpSpec)
+	lpClosureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: lpSpec.DefaultInputs,
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec:    lpSpecBytes,
+				Closure: lpClosureBytes,
+			}, nil
+		})
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: map[string]string{
+			"default_input.bucket": "s3://bucket",
+		},
+	})
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		return projectDomainModel, nil
+	}
+	var executed bool
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Equal(t, "s3://bucket",
+				inputs.Inputs.Literals["bucket"].GetScalar().GetPrimitive().GetStringValue())
+			assert.Equal(t, "foo-value-1", inputs.Inputs.Literals["foo"].GetScalar().GetPrimitive().GetStringValue())
+			executed = true
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, executed)
+}
+
+func getMockRepositoryWithConcurrentExecutionQuota() repositories.RepositoryInterface {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: map[string]string{
+			"quota.max_concurrent_executions": "2",
+		},
+	})
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		return projectDomainModel, nil
+	}
+	return repository
+}
+
+func TestCreateExecution_ConcurrentExecutionQuota(t *testing.T) {
+	repository := getMockRepositoryWithConcurrentExecutionQuota()
+	var running int
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int, error) {
+			assert.Len(t, input.InlineFilters, 3)
+			return running, nil
+		})
+	var locked bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetLockProjectDomainCallback(
+		func(ctx context.Context, project, domain string) error {
+			assert.Equal(t, "project", project)
+			assert.Equal(t, "domain", domain)
+			locked = true
+			return nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	running = 1
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, locked)
+
+	running = 2
+	_, err = execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateExecution_ConcurrentExecutionQuotaExhaustedWhileLaunching(t *testing.T) {
+	repository := getMockRepositoryWithConcurrentExecutionQuota()
+	// Another execution is created while this one is launched.
+	running := 1
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int, error) {
+			count := running
+			running++
+			return count, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			assert.Fail(t, "execution over the quota must not be created")
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	var terminated bool
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			assert.Equal(t, "name", input.ExecutionID.Name)
+			assert.Equal(t, testCluster, input.Cluster)
+			terminated = true
+			return nil
+		})
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.True(t, terminated)
+}
+
+func TestCreateExecution_ImageNotAllowed(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: map[string]string{
+			"security.allowed_image_registries": "docker.io/lyft",
+		},
+	})
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		return projectDomainModel, nil
+	}
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	response, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, response)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "allowed registries: docker.io/lyft")
+}
+
 func TestCreateExecutionValidationError(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	request := testutils.GetExecutionRequest()
 	request.Domain = ""
@@ -380,7 +717,7 @@ func TestCreateExecution_InvalidLpIdentifier(t *testing.T) {
 	setDefaultLpCallbackForExecTest(repository)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	request := testutils.GetExecutionRequest()
 	request.Spec.LaunchPlan = nil
@@ -394,7 +731,7 @@ func TestCreateExecutionInCompatibleInputs(t *testing.T) {
 	setDefaultLpCallbackForExecTest(repository)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	request := testutils.GetExecutionRequest()
 	request.Inputs = &core.LiteralMap{
@@ -419,7 +756,7 @@ func TestCreateExecutionPropellerFailure(t *testing.T) {
 	}
 	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(createFunc)
 	execManager := NewExecutionManager(
-		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	request := testutils.GetExecutionRequest()
 
@@ -440,7 +777,7 @@ func TestCreateExecutionDatabaseFailure(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	request := testutils.GetExecutionRequest()
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -462,6 +799,7 @@ func TestCreateExecutionVerifyDbModel(t *testing.T) {
 		assert.Equal(t, "project", input.Project)
 		assert.Equal(t, uint(100), input.LaunchPlanID)
 		assert.Equal(t, core.WorkflowExecution_UNDEFINED.String(), input.Phase)
+		assert.Equal(t, common.DataClassificationInternal, input.DataClassification)
 
 		var specValue admin.ExecutionSpec
 		err := proto.Unmarshal(input.Spec, &specValue)
@@ -505,7 +843,7 @@ func TestCreateExecutionVerifyDbModel(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	execManager.(*ExecutionManager)._clock = mockClock
 
@@ -545,7 +883,7 @@ func TestCreateExecutionDefaultNotifications(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(),
-		mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -581,7 +919,7 @@ func TestCreateExecutionDisableNotifications(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(),
-		mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -648,7 +986,7 @@ func TestCreateExecutionNoNotifications(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -679,7 +1017,7 @@ func TestCreateExecutionDynamicLabelsAndAnnotations(t *testing.T) {
 		})
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Labels = &admin.Labels{
 		Values: map[string]string{
@@ -703,6 +1041,77 @@ func TestCreateExecutionDynamicLabelsAndAnnotations(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestCreateExecution_ProjectDomainDefaultLabelsAndAuth(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: map[string]string{
+			"default_label.team":                      "ml",
+			"default_label.dynamiclabel1":             "default",
+			"default_annotation.cost-center":          "42",
+			"default_auth.kubernetes_service_account": "default-sa",
+		},
+	})
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		return projectDomainModel, nil
+	}
+	var executed bool
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			// Labels set by the execution take precedence over the defaults.
+			assert.EqualValues(t, map[string]string{
+				"dynamiclabel1": "dynamic1",
+				"team":          "ml",
+			}, inputs.Labels)
+			assert.EqualValues(t, map[string]string{
+				"cost-center": "42",
+			}, inputs.Annotations)
+			assert.Equal(t, "default-sa", inputs.Reference.Spec.GetAuth().GetKubernetesServiceAccount())
+			executed = true
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	request := testutils.GetExecutionRequest()
+	request.Spec.Labels = &admin.Labels{
+		Values: map[string]string{
+			"dynamiclabel1": "dynamic1",
+		},
+	}
+	_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, executed)
+}
+
+func TestAddDefaultAuth(t *testing.T) {
+	attributes := map[string]string{
+		"default_auth.assumable_iam_role": "default-role",
+	}
+	launchPlanSpec := testutils.GetSampleLpSpecForTest()
+	input := workflowengineInterfaces.ExecuteWorkflowInput{
+		Reference: admin.LaunchPlan{
+			Spec: &launchPlanSpec,
+		},
+	}
+	addDefaultAuth(&input, attributes)
+	assert.Equal(t, "default-role", input.Reference.Spec.GetAuth().GetAssumableIamRole())
+	// The launch plan itself isn't modified.
+	assert.Nil(t, launchPlanSpec.Auth)
+
+	// Permissions set by the launch plan are kept.
+	launchPlanSpec.Auth = &admin.Auth{KubernetesServiceAccount: "sa"}
+	input.Reference.Spec = &launchPlanSpec
+	addDefaultAuth(&input, attributes)
+	assert.Equal(t, &admin.Auth{KubernetesServiceAccount: "sa"}, input.Reference.Spec.Auth)
+}
+
 func makeExecutionGetFunc(
 	t *testing.T, closureBytes []byte, startTime *time.Time) repositoryMocks.GetExecutionFunc {
 	return func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
@@ -761,7 +1170,7 @@ func TestRelaunchExecution(t *testing.T) {
 	setDefaultLpCallbackForExecTest(repository)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
@@ -820,7 +1229,7 @@ func TestRelaunchExecution_GetExistingFailure(t *testing.T) {
 	setDefaultLpCallbackForExecTest(repository)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	expectedErr := errors.New("expected error")
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
@@ -856,7 +1265,7 @@ func TestRelaunchExecution_CreateFailure(t *testing.T) {
 	setDefaultLpCallbackForExecTest(repository)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
@@ -938,12 +1347,22 @@ func TestCreateWorkflowEvent(t *testing.T) {
 		assert.Equal(t, specBytes, execution.Spec)
 		assert.Equal(t, startTime, *execution.StartedAt)
 		assert.Equal(t, duration, execution.Duration)
+		assert.Equal(t, uint32(1), execution.EventVersion)
+		assert.Equal(t, common.FailureClassificationSystem, execution.FailureClassification)
 		return nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
+	var publishedCloudEvent proto.Message
+	var cloudEventPublisher cloudEventMocks.MockPublisher
+	cloudEventPublisher.SetPublishCallback(func(ctx context.Context, eventType string, msg proto.Message) error {
+		assert.Equal(t, "flyteidl.event.WorkflowExecutionEvent", eventType)
+		publishedCloudEvent = msg
+		// Failing to mirror the event doesn't fail recording it.
+		return errors.New("topic unavailable")
+	})
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, &cloudEventPublisher)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
@@ -957,6 +1376,58 @@ func TestCreateWorkflowEvent(t *testing.T) {
 	})
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
+	assert.Equal(t, core.WorkflowExecution_FAILED, publishedCloudEvent.(*event.WorkflowExecutionEvent).Phase)
+}
+
+// Holds on to the functions deferred until commit rather than running them.
+type afterCommitRecordingRepository struct {
+	repositories.RepositoryInterface
+	afterCommit []func(ctx context.Context)
+}
+
+func (r *afterCommitRecordingRepository) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	r.afterCommit = append(r.afterCommit, fn)
+}
+
+func TestCreateWorkflowEvent_DeferredUntilCommit(t *testing.T) {
+	repository := &afterCommitRecordingRepository{
+		RepositoryInterface: repositoryMocks.NewMockRepository(),
+	}
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_RUNNING,
+		StartedAt: startTimeProto,
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	var publishedCloudEvent bool
+	var cloudEventPublisher cloudEventMocks.MockPublisher
+	cloudEventPublisher.SetPublishCallback(func(ctx context.Context, eventType string, msg proto.Message) error {
+		publishedCloudEvent = true
+		return nil
+	})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, &cloudEventPublisher)
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
+	_, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			OutputResult: &event.WorkflowExecutionEvent_OutputUri{
+				OutputUri: "s3://bucket/outputs.pb",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.False(t, publishedCloudEvent)
+	assert.Len(t, repository.afterCommit, 1)
+	repository.afterCommit[0](context.Background())
+	assert.True(t, publishedCloudEvent)
 }
 
 func TestCreateWorkflowEvent_TerminalState(t *testing.T) {
@@ -983,7 +1454,7 @@ func TestCreateWorkflowEvent_TerminalState(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
@@ -1040,7 +1511,7 @@ func TestCreateWorkflowEvent_StartedRunning(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
@@ -1078,10 +1549,16 @@ func TestCreateWorkflowEvent_DuplicateRunning(t *testing.T) {
 			}, nil
 		},
 	)
+	var updateExecutionCalled bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(context context.Context, event models.ExecutionEvent, execution models.Execution) error {
+			updateExecutionCalled = true
+			return nil
+		})
 
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
@@ -1091,10 +1568,59 @@ func TestCreateWorkflowEvent_DuplicateRunning(t *testing.T) {
 			Phase:       core.WorkflowExecution_RUNNING,
 		},
 	})
-	assert.NotNil(t, err)
-	adminError := err.(flyteAdminErrors.FlyteAdminError)
-	assert.Equal(t, adminError.Code(), codes.AlreadyExists)
-	assert.Nil(t, resp)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.False(t, updateExecutionCalled)
+}
+
+func TestCreateWorkflowEvent_StaleRunningAfterSucceeded(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startedAt := time.Now().UTC()
+	updatedAt := startedAt.Add(time.Minute)
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				BaseModel: models.BaseModel{
+					ID: uint(8),
+				},
+				Spec:               specBytes,
+				Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+				Closure:            closureBytes,
+				LaunchPlanID:       uint(1),
+				WorkflowID:         uint(2),
+				StartedAt:          &startedAt,
+				ExecutionUpdatedAt: &updatedAt,
+			}, nil
+		},
+	)
+	var updateExecutionCalled bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(context context.Context, event models.ExecutionEvent, execution models.Execution) error {
+			updateExecutionCalled = true
+			return nil
+		})
+
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	occurredAtTimestamp, _ := ptypes.TimestampProto(startedAt)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAtTimestamp,
+			Phase:       core.WorkflowExecution_RUNNING,
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.False(t, updateExecutionCalled)
 }
 
 func TestCreateWorkflowEvent_InvalidPhaseChange(t *testing.T) {
@@ -1124,7 +1650,7 @@ func TestCreateWorkflowEvent_InvalidPhaseChange(t *testing.T) {
 
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
@@ -1160,7 +1686,7 @@ func TestCreateWorkflowEvent_InvalidEvent(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
@@ -1190,7 +1716,7 @@ func TestCreateWorkflowEvent_UpdateModelError(t *testing.T) {
 
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
@@ -1224,7 +1750,7 @@ func TestCreateWorkflowEvent_DatabaseGetError(t *testing.T) {
 	}
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
@@ -1260,7 +1786,7 @@ func TestCreateWorkflowEvent_DatabaseUpdateError(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
@@ -1301,7 +1827,7 @@ func TestGetExecution(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
 		Id: &executionIdentifier,
 	})
@@ -1324,7 +1850,7 @@ func TestGetExecution_DatabaseError(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
 		Id: &executionIdentifier,
 	})
@@ -1356,7 +1882,7 @@ func TestGetExecution_TransformerError(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
 		Id: &executionIdentifier,
 	})
@@ -1415,7 +1941,7 @@ func TestListExecutions(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	executionList, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -1449,7 +1975,7 @@ func TestListExecutions_MissingParameters(t *testing.T) {
 	execManager := NewExecutionManager(
 		repositoryMocks.NewMockRepository(),
 		getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	_, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
 			Domain: domainValue,
@@ -1488,7 +2014,7 @@ func TestListExecutions_DatabaseError(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	_, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
 			Project: projectValue,
@@ -1521,7 +2047,7 @@ func TestListExecutions_TransformerError(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	executionList, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -1536,7 +2062,7 @@ func TestListExecutions_TransformerError(t *testing.T) {
 
 func TestExecutionManager_PublishNotifications(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider())
+	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider(), repository)
 
 	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
 	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
@@ -1630,93 +2156,142 @@ func TestExecutionManager_PublishNotifications(t *testing.T) {
 	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
 }
 
-func TestExecutionManager_PublishNotificationsTransformError(t *testing.T) {
+func TestExecutionManager_PublishNotificationsByChannel(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider())
-	var execManager = &ExecutionManager{
+	var publishedKeys []string
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		publishedKeys = append(publishedKeys, key)
+		// Notifications are throttled per launch plan.
+		assert.Equal(t, "project/domain/name", common.GetLaunchPlan(ctx))
+		return nil
+	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	execManager := &ExecutionManager{
 		db:                 repository,
-		config:             getMockExecutionsConfigProvider(),
-		storageClient:      getMockStorageForExecTest(context.Background()),
-		queueAllocator:     queue,
+		config:             mockRuntime,
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
-		notificationClient: &mockPublisher,
+		notificationClient: &publisher,
 	}
-
-	workflowRequest := admin.WorkflowExecutionEventRequest{
-		Event: &event.WorkflowExecutionEvent{
-			Phase: core.WorkflowExecution_FAILED,
-			//ExecutionId: "1234",
-			OutputResult: &event.WorkflowExecutionEvent_Error{
-				Error: &core.ExecutionError{
-					Code:    "CodeBad",
-					Message: "oopsie my bad",
+	phases := []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED}
+	execClosure := admin.ExecutionClosure{
+		Notifications: []*admin.Notification{
+			{
+				Phases: phases,
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"email@example.com"}},
+				},
+			},
+			{
+				Phases: phases,
+				Type: &admin.Notification_Slack{
+					Slack: &admin.SlackNotification{RecipientsEmail: []string{"slack@example.com"}},
+				},
+			},
+			{
+				Phases: phases,
+				Type: &admin.Notification_PagerDuty{
+					PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: []string{"pagerduty@example.com"}},
 				},
 			},
-			ExecutionId: &executionIdentifier,
+		},
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "wf_project",
+			Domain:       "wf_domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
 		},
 	}
-	// Ensure that an error is thrown when transforming an incorrect models.Execution
+	execClosureBytes, _ := proto.Marshal(&execClosure)
 	executionModel := models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: "project",
 			Domain:  "domain",
 			Name:    "name",
 		},
-		Phase:        core.WorkflowExecution_FAILED.String(),
-		LaunchPlanID: uint(1),
-		WorkflowID:   uint(2),
-		Spec:         []byte("I am invalid"),
+		Phase:   core.WorkflowExecution_SUCCEEDED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
 	}
-	assert.Error(t, execManager.publishNotifications(context.Background(), workflowRequest, executionModel))
+	assert.Nil(t, execManager.publishNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel))
+	assert.Equal(t, []string{
+		"flyteidl.admin.EmailNotification",
+		"flyteidl.admin.SlackNotification",
+		"flyteidl.admin.PagerDutyNotification",
+	}, publishedKeys)
 }
 
-func TestExecutionManager_TestExecutionManager_PublishNotificationsTransformError(t *testing.T) {
+func TestExecutionManager_PublishNotificationsWithPreferences(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider())
-	publishFunc := func(ctx context.Context, key string, msg proto.Message) error {
-		return errors.New("error publishing message")
+	repository.NotificationPreferenceRepo().(*repositoryMocks.MockNotificationPreferenceRepo).ListForLaunchPlanFunction =
+		func(ctx context.Context, project, domain, name string) ([]models.NotificationPreference, error) {
+			assert.Equal(t, "project", project)
+			assert.Equal(t, "domain", domain)
+			assert.Equal(t, "name", name)
+			return []models.NotificationPreference{
+				{UserEmail: "unsubscribed@example.com"},
+				{UserEmail: "configured@example.com", Subscribed: true, Channel: "email"},
+				{UserEmail: "subscribed@example.com", Subscribed: true, Channel: "slack"},
+			}, nil
+		}
+	type publishedNotification struct {
+		key        string
+		recipients []string
 	}
-
-	mockPublisher.SetPublishCallback(publishFunc)
-	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
-	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
-		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
-			Body: "http://example.com/console/projects/%s/domains/%s/executions/%s",
-		},
+	var published []publishedNotification
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = append(published, publishedNotification{
+			key:        key,
+			recipients: msg.(*admin.EmailMessage).RecipientsEmail,
+		})
+		return nil
 	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
 	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
 		&mockApplicationConfig,
 		runtimeMocks.NewMockQueueConfigurationProvider(
 			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
 		nil, nil, nil, nil)
-
-	var myExecManager = &ExecutionManager{
+	execManager := &ExecutionManager{
 		db:                 repository,
 		config:             mockRuntime,
-		storageClient:      getMockStorageForExecTest(context.Background()),
-		queueAllocator:     queue,
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
-		notificationClient: &mockPublisher,
+		notificationClient: &publisher,
 	}
-	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
-	// This sets up the skeleton for it and appeases the go lint overlords.
-	workflowRequest := admin.WorkflowExecutionEventRequest{
-		Event: &event.WorkflowExecutionEvent{
-			Phase: core.WorkflowExecution_FAILED,
-			//ExecutionId: "1234",
-			OutputResult: &event.WorkflowExecutionEvent_Error{
-				Error: &core.ExecutionError{
-					Code:    "CodeBad",
-					Message: "oopsie my bad",
+	phases := []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_SUCCEEDED}
+	execClosure := admin.ExecutionClosure{
+		Notifications: []*admin.Notification{
+			{
+				Phases: phases,
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{
+						RecipientsEmail: []string{"configured@example.com", "unsubscribed@example.com"},
+					},
+				},
+			},
+			{
+				Phases: phases,
+				Type: &admin.Notification_PagerDuty{
+					PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: []string{"unsubscribed@example.com"}},
 				},
 			},
-			ExecutionId: &executionIdentifier,
 		},
-	}
-	var execClosure = admin.ExecutionClosure{
-		Notifications: testutils.GetExecutionRequest().Spec.GetNotifications().Notifications,
 		WorkflowId: &core.Identifier{
 			ResourceType: core.ResourceType_WORKFLOW,
 			Project:      "wf_project",
@@ -1732,129 +2307,1024 @@ func TestExecutionManager_TestExecutionManager_PublishNotificationsTransformErro
 			Domain:  "domain",
 			Name:    "name",
 		},
-		Phase:        core.WorkflowExecution_FAILED.String(),
-		LaunchPlanID: uint(1),
-		WorkflowID:   uint(2),
-		Closure:      execClosureBytes,
-		Spec:         specBytes,
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
 	}
-	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
-
+	assert.Nil(t, execManager.publishNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel))
+	assert.Equal(t, []publishedNotification{
+		{key: "flyteidl.admin.EmailNotification", recipients: []string{"configured@example.com"}},
+		{key: "flyteidl.admin.SlackNotification", recipients: []string{"subscribed@example.com"}},
+	}, published)
+
+	// Subscribed users are only added to failure notifications.
+	published = nil
+	executionModel.Phase = core.WorkflowExecution_SUCCEEDED.String()
+	assert.Nil(t, execManager.publishNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel))
+	assert.Equal(t, []publishedNotification{
+		{key: "flyteidl.admin.EmailNotification", recipients: []string{"configured@example.com"}},
+	}, published)
 }
 
-func TestExecutionManager_PublishNotificationsNoPhaseMatch(t *testing.T) {
+func TestExecutionManager_PublishNotificationsWithPreferencePhases(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider())
-
-	var myExecManager = &ExecutionManager{
+	repository.NotificationPreferenceRepo().(*repositoryMocks.MockNotificationPreferenceRepo).ListForLaunchPlanFunction =
+		func(ctx context.Context, project, domain, name string) ([]models.NotificationPreference, error) {
+			return []models.NotificationPreference{
+				{UserEmail: "configured@example.com", Subscribed: true, Channel: "email", Phases: "FAILED,ABORTED"},
+				{UserEmail: "subscribed@example.com", Subscribed: true, Channel: "slack", Phases: "ABORTED"},
+			}, nil
+		}
+	published := make(map[string][]string)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published[key] = msg.(*admin.EmailMessage).RecipientsEmail
+		return nil
+	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	execManager := &ExecutionManager{
 		db:                 repository,
-		config:             getMockExecutionsConfigProvider(),
-		storageClient:      getMockStorageForExecTest(context.Background()),
-		queueAllocator:     queue,
+		config:             mockRuntime,
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
-		notificationClient: &mockPublisher,
+		notificationClient: &publisher,
 	}
-	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
-	// This sets up the skeleton for it and appeases the go lint overlords.
-	workflowRequest := admin.WorkflowExecutionEventRequest{
-		Event: &event.WorkflowExecutionEvent{
-			Phase: core.WorkflowExecution_SUCCEEDED,
-			OutputResult: &event.WorkflowExecutionEvent_OutputUri{
-				OutputUri: "somestring",
+	execClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{
+					core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_FAILED, core.WorkflowExecution_ABORTED,
+				},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{
+						RecipientsEmail: []string{"configured@example.com", "other@example.com"},
+					},
+				},
 			},
-			ExecutionId: &executionIdentifier,
 		},
-	}
-	var execClosure = admin.ExecutionClosure{
-		Notifications: testutils.GetExecutionRequest().Spec.GetNotifications().Notifications,
-	}
-	execClosureBytes, _ := proto.Marshal(&execClosure)
+	})
 	executionModel := models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: "project",
 			Domain:  "domain",
 			Name:    "name",
 		},
-		Phase:        core.WorkflowExecution_FAILED.String(),
-		LaunchPlanID: uint(1),
-		WorkflowID:   uint(2),
-		Closure:      execClosureBytes,
+		Closure: execClosureBytes,
+		Spec:    specBytes,
+	}
+	for phase, expected := range map[core.WorkflowExecution_Phase]map[string][]string{
+		// Users who chose their phases are removed from the configured recipients of the others.
+		core.WorkflowExecution_SUCCEEDED: {"flyteidl.admin.EmailNotification": {"other@example.com"}},
+		core.WorkflowExecution_FAILED: {
+			"flyteidl.admin.EmailNotification": {"configured@example.com", "other@example.com"},
+		},
+		// Subscribed users are added to the notifications of the phases they chose.
+		core.WorkflowExecution_ABORTED: {
+			"flyteidl.admin.EmailNotification": {"configured@example.com", "other@example.com"},
+			"flyteidl.admin.SlackNotification": {"subscribed@example.com"},
+		},
+	} {
+		published = make(map[string][]string)
+		executionModel.Phase = phase.String()
+		assert.Nil(t, execManager.publishNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+			Event: &event.WorkflowExecutionEvent{
+				Phase:       phase,
+				ExecutionId: &executionIdentifier,
+			},
+		}, executionModel))
+		assert.Equal(t, expected, published, phase.String())
 	}
-	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
 }
 
-func TestTerminateExecution(t *testing.T) {
+func TestExecutionManager_PublishNotificationsWithDefaults(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	startTime := time.Now()
-	executionGetFunc := makeExecutionGetFunc(t, []byte{}, &startTime)
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-
-	abortCause := "abort cause"
-	updateExecutionFunc := func(
-		context context.Context, execution models.Execution) error {
-		assert.Equal(t, "project", execution.Project)
-		assert.Equal(t, "domain", execution.Domain)
-		assert.Equal(t, "name", execution.Name)
-		assert.Equal(t, uint(1), execution.LaunchPlanID)
-		assert.Equal(t, uint(2), execution.WorkflowID)
-		assert.Equal(t, core.WorkflowExecution_QUEUED.String(), execution.Phase,
-			"an abort call should not update the execution status until a corresponding execution event "+
-				"is received")
-		assert.Equal(t, execution.ExecutionCreatedAt, execution.ExecutionUpdatedAt,
-			"an abort call should not change ExecutionUpdatedAt until a corresponding execution event is received")
-		assert.Equal(t, abortCause, execution.AbortCause)
-		assert.Equal(t, testCluster, execution.Cluster)
-		return nil
-	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(updateExecutionFunc)
-
-	mockExecutor := workflowengineMocks.NewMockExecutor()
-	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
-		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
-			assert.True(t, proto.Equal(&core.WorkflowExecutionIdentifier{
-				Project: "project",
-				Domain:  "domain",
-				Name:    "name",
-			}, input.ExecutionID))
-			return nil
-		})
-	execManager := NewExecutionManager(
-		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
-
-	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
-		Id: &core.WorkflowExecutionIdentifier{
-			Project: "project",
-			Domain:  "domain",
-			Name:    "name",
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: map[string]string{
+			"notifications.email":   "configured@example.com, team@example.com",
+			"notifications.slack":   "team@example.com",
+			"notifications.teams":   "data-eng",
+			"notifications.webhook": "audit",
 		},
-		Cause: abortCause,
 	})
-
-	assert.Nil(t, err)
-	assert.NotNil(t, resp)
-}
-
-func TestTerminateExecution_PropellerError(t *testing.T) {
-	var expectedError = errors.New("expected error")
-
-	mockExecutor := workflowengineMocks.NewMockExecutor()
-	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
-		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
-			return expectedError
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		assert.Equal(t, "project", project)
+		assert.Equal(t, "domain", domain)
+		return projectDomainModel, nil
+	}
+	type publishedNotification struct {
+		key        string
+		recipients []string
+	}
+	var published []publishedNotification
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = append(published, publishedNotification{
+			key:        key,
+			recipients: msg.(*admin.EmailMessage).RecipientsEmail,
 		})
-	repository := repositoryMocks.NewMockRepository()
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(func(
-		context context.Context, execution models.Execution) error {
-		t.Fatal("update should not be called when propeller fails to terminate an execution")
 		return nil
 	})
-	execManager := NewExecutionManager(
-		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
-
-	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	execClosure := admin.ExecutionClosure{
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"configured@example.com"}},
+				},
+			},
+		},
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "wf_project",
+			Domain:       "wf_domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
+	}
+	failedRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	assert.Nil(t, execManager.publishNotifications(context.Background(), failedRequest, executionModel))
+	assert.Equal(t, []publishedNotification{
+		{key: "flyteidl.admin.EmailNotification", recipients: []string{"configured@example.com"}},
+		{key: "flyteidl.admin.EmailNotification", recipients: []string{"team@example.com"}},
+		{key: "flyteidl.admin.SlackNotification", recipients: []string{"team@example.com"}},
+		{key: "flyteadmin.TeamsNotification", recipients: []string{"data-eng"}},
+		{key: "flyteadmin.WebhookNotification", recipients: []string{"audit"}},
+	}, published)
+
+	// Default recipients are only notified of failures and time outs unless configured otherwise.
+	published = nil
+	executionModel.Phase = core.WorkflowExecution_SUCCEEDED.String()
+	assert.Nil(t, execManager.publishNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel))
+	assert.Empty(t, published)
+
+	// Executions disabling notifications don't notify default recipients either.
+	published = nil
+	executionModel.Phase = core.WorkflowExecution_FAILED.String()
+	executionModel.Spec, _ = proto.Marshal(&admin.ExecutionSpec{
+		NotificationOverrides: &admin.ExecutionSpec_DisableAll{DisableAll: true},
+	})
+	assert.Nil(t, execManager.publishNotifications(context.Background(), failedRequest, executionModel))
+	assert.Equal(t, []publishedNotification{
+		{key: "flyteidl.admin.EmailNotification", recipients: []string{"configured@example.com"}},
+	}, published)
+}
+
+func TestExecutionManager_PublishNotificationsDisabledByFeatureFlags(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+		if id.ResourceType != int32(managerInterfaces.MatchableResourceFeatureFlags) {
+			return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		}
+		assert.Equal(t, "project", id.Project)
+		assert.Equal(t, "domain", id.Domain)
+		assert.Equal(t, "wf_name", id.Workflow)
+		return models.Resource{
+			Attributes: []byte(`{"feature_flags":{"disable_notifications":true}}`),
+		}, nil
+	}
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		t.Fatal("unexpected notification")
+		return nil
+	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	execManager := &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	execClosure := admin.ExecutionClosure{
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"configured@example.com"}},
+				},
+			},
+		},
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	specBytes, _ := proto.Marshal(&admin.ExecutionSpec{})
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    specBytes,
+	}
+	assert.Nil(t, execManager.publishNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel))
+}
+
+func TestDisableCaching(t *testing.T) {
+	compiledWorkflow := &core.CompiledWorkflowClosure{
+		Tasks: []*core.CompiledTask{
+			{
+				Template: &core.TaskTemplate{
+					Id:       &core.Identifier{Name: "cached"},
+					Metadata: &core.TaskMetadata{Discoverable: true, DiscoveryVersion: "1"},
+				},
+			},
+			{
+				Template: &core.TaskTemplate{
+					Id: &core.Identifier{Name: "uncached"},
+				},
+			},
+		},
+	}
+	disableCaching(context.Background(), compiledWorkflow)
+	assert.False(t, compiledWorkflow.Tasks[0].Template.Metadata.Discoverable)
+	assert.Equal(t, "1", compiledWorkflow.Tasks[0].Template.Metadata.DiscoveryVersion)
+	assert.Nil(t, compiledWorkflow.Tasks[1].Template.Metadata)
+}
+
+func TestExecutionManager_PublishNotificationsTransformError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider(), repository)
+	var execManager = &ExecutionManager{
+		db:                 repository,
+		config:             getMockExecutionsConfigProvider(),
+		storageClient:      getMockStorageForExecTest(context.Background()),
+		queueAllocator:     queue,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &mockPublisher,
+	}
+
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			//ExecutionId: "1234",
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Code:    "CodeBad",
+					Message: "oopsie my bad",
+				},
+			},
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	// Ensure that an error is thrown when transforming an incorrect models.Execution
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:        core.WorkflowExecution_FAILED.String(),
+		LaunchPlanID: uint(1),
+		WorkflowID:   uint(2),
+		Spec:         []byte("I am invalid"),
+	}
+	assert.Error(t, execManager.publishNotifications(context.Background(), workflowRequest, executionModel))
+}
+
+func TestExecutionManager_TestExecutionManager_PublishNotificationsTransformError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider(), repository)
+	publishFunc := func(ctx context.Context, key string, msg proto.Message) error {
+		return errors.New("error publishing message")
+	}
+
+	mockPublisher.SetPublishCallback(publishFunc)
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Body: "http://example.com/console/projects/%s/domains/%s/executions/%s",
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+
+	var myExecManager = &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		storageClient:      getMockStorageForExecTest(context.Background()),
+		queueAllocator:     queue,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &mockPublisher,
+	}
+	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
+	// This sets up the skeleton for it and appeases the go lint overlords.
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			//ExecutionId: "1234",
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Code:    "CodeBad",
+					Message: "oopsie my bad",
+				},
+			},
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	var execClosure = admin.ExecutionClosure{
+		Notifications: testutils.GetExecutionRequest().Spec.GetNotifications().Notifications,
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "wf_project",
+			Domain:       "wf_domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:        core.WorkflowExecution_FAILED.String(),
+		LaunchPlanID: uint(1),
+		WorkflowID:   uint(2),
+		Closure:      execClosureBytes,
+		Spec:         specBytes,
+	}
+	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
+
+}
+
+func TestExecutionManager_PublishNotificationsNoPhaseMatch(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider(), repository)
+
+	var myExecManager = &ExecutionManager{
+		db:                 repository,
+		config:             getMockExecutionsConfigProvider(),
+		storageClient:      getMockStorageForExecTest(context.Background()),
+		queueAllocator:     queue,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &mockPublisher,
+	}
+	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
+	// This sets up the skeleton for it and appeases the go lint overlords.
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_SUCCEEDED,
+			OutputResult: &event.WorkflowExecutionEvent_OutputUri{
+				OutputUri: "somestring",
+			},
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	var execClosure = admin.ExecutionClosure{
+		Notifications: testutils.GetExecutionRequest().Spec.GetNotifications().Notifications,
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:        core.WorkflowExecution_FAILED.String(),
+		LaunchPlanID: uint(1),
+		WorkflowID:   uint(2),
+		Closure:      execClosureBytes,
+	}
+	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
+}
+
+func TestTerminateExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionGetFunc := makeExecutionGetFunc(t, []byte{}, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+
+	abortCause := "abort cause"
+	updateExecutionFunc := func(
+		context context.Context, execution models.Execution) error {
+		assert.Equal(t, "project", execution.Project)
+		assert.Equal(t, "domain", execution.Domain)
+		assert.Equal(t, "name", execution.Name)
+		assert.Equal(t, uint(1), execution.LaunchPlanID)
+		assert.Equal(t, uint(2), execution.WorkflowID)
+		assert.Equal(t, core.WorkflowExecution_QUEUED.String(), execution.Phase,
+			"an abort call should not update the execution status until a corresponding execution event "+
+				"is received")
+		assert.Equal(t, execution.ExecutionCreatedAt, execution.ExecutionUpdatedAt,
+			"an abort call should not change ExecutionUpdatedAt until a corresponding execution event is received")
+		assert.Equal(t, abortCause, execution.AbortCause)
+		assert.Equal(t, testCluster, execution.Cluster)
+		return nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(updateExecutionFunc)
+
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			assert.True(t, proto.Equal(&core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			}, input.ExecutionID))
+			return nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Cause: abortCause,
+	})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+}
+
+func TestTerminateExecution_AlreadyTerminated(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionModel, _ := makeExecutionGetFunc(t, []byte{}, &startTime)(
+		context.Background(), interfaces.GetResourceInput{Project: "project", Domain: "domain", Name: "name"})
+	executionModel.Phase = core.WorkflowExecution_SUCCEEDED.String()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return executionModel, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			assert.Fail(t, "the abort cause of a terminated execution mustn't be updated")
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			assert.Fail(t, "a terminated execution mustn't be terminated again")
+			return nil
+		})
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Fail(t, "no abort notification must be published for a terminated execution")
+		return nil
+	})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	_, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+		Id:    &executionIdentifier,
+		Cause: "abort cause",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestTerminateExecution_AlreadyAborting(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionModel, _ := makeExecutionGetFunc(t, []byte{}, &startTime)(
+		context.Background(), interfaces.GetResourceInput{Project: "project", Domain: "domain", Name: "name"})
+	executionModel.Phase = core.WorkflowExecution_RUNNING.String()
+	executionModel.AbortCause = "first cause"
+	executionModel.AbortedAt = &startTime
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return executionModel, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			assert.Fail(t, "the original abort cause must be kept")
+			return nil
+		})
+	var terminated bool
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			terminated = true
+			return nil
+		})
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Fail(t, "the owners mustn't be notified of the abort twice")
+		return nil
+	})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+		Id:    &executionIdentifier,
+		Cause: "second cause",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, terminated)
+}
+
+func TestUpdateExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, closureBytes, &startTime))
+	var updated bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateTagsCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			var spec admin.ExecutionSpec
+			assert.NoError(t, proto.Unmarshal(execution.Spec, &spec))
+			assert.Equal(t, []string{"nightly", "team: data"}, common.GetTags(&spec))
+			assert.Equal(t, []models.ExecutionTag{
+				{
+					ExecutionKey: execution.ExecutionKey,
+					Name:         "nightly",
+				},
+				{
+					ExecutionKey: execution.ExecutionKey,
+					Name:         "team: data",
+				},
+			}, execution.Tags)
+			updated = true
+			return nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	resp, err := execManager.UpdateExecution(context.Background(), managerInterfaces.ExecutionUpdateRequest{
+		ID: &executionIdentifier,
+		Tags: []string{
+			"nightly", " team: data", "nightly",
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, []string{"nightly", "team: data"}, resp.Tags)
+}
+
+func TestUpdateExecution_InvalidTags(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	_, err := execManager.UpdateExecution(context.Background(), managerInterfaces.ExecutionUpdateRequest{
+		ID:   &executionIdentifier,
+		Tags: []string{"a,b"},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestExportExecutionTimeline(t *testing.T) {
+	startTime := time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time {
+		return startTime.Add(time.Duration(seconds) * time.Second)
+	}
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, closureBytes, &startTime))
+	// The execution's earliest events were compacted.
+	summary, _ := json.Marshal(util.ExecutionEventSummary{
+		Events: []util.SummarizedEvent{
+			{Phase: core.WorkflowExecution_QUEUED.String(), OccurredAt: at(0)},
+		},
+		NodeExecutions: []util.NodeExecutionEventSummary{
+			{
+				NodeID: "node",
+				Events: []util.SummarizedEvent{
+					{Phase: core.NodeExecution_QUEUED.String(), OccurredAt: at(1)},
+				},
+			},
+		},
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetEventSummaryCallback(
+		func(ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+			return models.ExecutionEventSummary{
+				ExecutionKey: key,
+				Summary:      summary,
+			}, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListEventsCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionEventCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 3)
+			return interfaces.ExecutionEventCollectionOutput{
+				ExecutionEvents: []models.ExecutionEvent{
+					{Phase: core.WorkflowExecution_RUNNING.String(), OccurredAt: at(2)},
+					{Phase: core.WorkflowExecution_SUCCEEDED.String(), OccurredAt: at(10)},
+				},
+			}, nil
+		})
+	nodeExecutionKey := models.NodeExecutionKey{
+		NodeID: "node",
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListEventCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionEventCollectionOutput, error) {
+			return interfaces.NodeExecutionEventCollectionOutput{
+				NodeExecutionEvents: []models.NodeExecutionEvent{
+					{
+						NodeExecutionKey: nodeExecutionKey,
+						Phase:            core.NodeExecution_RUNNING.String(),
+						OccurredAt:       at(3),
+					},
+					{
+						NodeExecutionKey: nodeExecutionKey,
+						Phase:            core.NodeExecution_SUCCEEDED.String(),
+						OccurredAt:       at(9),
+					},
+				},
+			}, nil
+		})
+	retryAttempt := uint32(0)
+	createdAt, taskStartedAt, updatedAt := at(4), at(5), at(8)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+			return interfaces.TaskExecutionCollectionOutput{
+				TaskExecutions: []models.TaskExecution{
+					{
+						TaskExecutionKey: models.TaskExecutionKey{
+							TaskKey: models.TaskKey{
+								Project: "project",
+								Domain:  "domain",
+								Name:    "task",
+								Version: "version",
+							},
+							NodeExecutionKey: nodeExecutionKey,
+							RetryAttempt:     &retryAttempt,
+						},
+						Phase:                  core.TaskExecution_SUCCEEDED.String(),
+						StartedAt:              &taskStartedAt,
+						TaskExecutionCreatedAt: &createdAt,
+						TaskExecutionUpdatedAt: &updatedAt,
+					},
+				},
+			}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	timeline, err := execManager.ExportExecutionTimeline(context.Background(),
+		managerInterfaces.ExecutionTimelineExportRequest{
+			ID: &executionIdentifier,
+		})
+	assert.NoError(t, err)
+	task := &managerInterfaces.TimelineTask{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "task",
+		Version: "version",
+	}
+	assert.Equal(t, []managerInterfaces.TimelineEvent{
+		{
+			Kind:       managerInterfaces.TimelineEventKindWorkflow,
+			Phase:      core.WorkflowExecution_QUEUED.String(),
+			OccurredAt: at(0),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindNode,
+			NodeID:     "node",
+			Phase:      core.NodeExecution_QUEUED.String(),
+			OccurredAt: at(1),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindWorkflow,
+			Phase:      core.WorkflowExecution_RUNNING.String(),
+			OccurredAt: at(2),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindNode,
+			NodeID:     "node",
+			Phase:      core.NodeExecution_RUNNING.String(),
+			OccurredAt: at(3),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindTask,
+			NodeID:     "node",
+			Task:       task,
+			Phase:      core.TaskExecution_QUEUED.String(),
+			OccurredAt: at(4),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindTask,
+			NodeID:     "node",
+			Task:       task,
+			Phase:      core.TaskExecution_RUNNING.String(),
+			OccurredAt: at(5),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindTask,
+			NodeID:     "node",
+			Task:       task,
+			Phase:      core.TaskExecution_SUCCEEDED.String(),
+			OccurredAt: at(8),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindNode,
+			NodeID:     "node",
+			Phase:      core.NodeExecution_SUCCEEDED.String(),
+			OccurredAt: at(9),
+		},
+		{
+			Kind:       managerInterfaces.TimelineEventKindWorkflow,
+			Phase:      core.WorkflowExecution_SUCCEEDED.String(),
+			OccurredAt: at(10),
+		},
+	}, timeline.Events)
+}
+
+func TestExportExecutionTimeline_InvalidID(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	_, err := execManager.ExportExecutionTimeline(context.Background(),
+		managerInterfaces.ExecutionTimelineExportRequest{
+			ID: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+			},
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func getRerunExecutionGetFunc(t *testing.T, phase core.WorkflowExecution_Phase) repositoryMocks.GetExecutionFunc {
+	startTime := time.Now()
+	return func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+		execution, err := makeExecutionGetFunc(t, closureBytes, &startTime)(ctx, input)
+		execution.Phase = phase.String()
+		return execution, err
+	}
+}
+
+func getRerunNodeExecutionGetFunc(
+	t *testing.T, phase core.NodeExecution_Phase) repositoryMocks.GetNodeExecutionFunc {
+	return func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+		assert.Equal(t, "node 1", input.NodeExecutionIdentifier.NodeId)
+		assert.Equal(t, "name", input.NodeExecutionIdentifier.ExecutionId.Name)
+		return models.NodeExecution{
+			Phase: phase.String(),
+		}, nil
+	}
+}
+
+func TestRerunExecutionNode(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		getRerunExecutionGetFunc(t, core.WorkflowExecution_FAILED))
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		getRerunNodeExecutionGetFunc(t, core.NodeExecution_FAILED))
+	var createCalled bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createCalled = true
+			assert.Equal(t, "rerun", input.Name)
+			assert.Equal(t, uint(8), input.SourceExecutionID)
+			assert.Equal(t, int32(admin.ExecutionMetadata_RELAUNCH), input.Mode)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			nodes := inputs.WfClosure.Primary.Template.Nodes
+			assert.Len(t, nodes, 1)
+			assert.Equal(t, "node 1", nodes[0].Id)
+			assert.Equal(t, []string{"node 1"},
+				inputs.WfClosure.Primary.Connections.Downstream["start-node"].Ids)
+			return &workflowengineInterfaces.ExecutionInfo{}, nil
+		})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	response, err := execManager.RerunExecutionNode(context.Background(), managerInterfaces.ExecutionNodeRerunRequest{
+		ID:     &executionIdentifier,
+		NodeID: "node 1",
+		Name:   "rerun",
+	}, requestedAt)
+	assert.NoError(t, err)
+	assert.True(t, createCalled)
+	assert.Equal(t, "rerun", response.Id.Name)
+}
+
+func TestRerunExecutionNode_NotRerunnable(t *testing.T) {
+	for _, testCase := range []struct {
+		executionPhase core.WorkflowExecution_Phase
+		nodePhase      core.NodeExecution_Phase
+	}{
+		{core.WorkflowExecution_RUNNING, core.NodeExecution_FAILED},
+		{core.WorkflowExecution_FAILED, core.NodeExecution_SUCCEEDED},
+	} {
+		repository := getMockRepositoryForExecTest()
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+			getRerunExecutionGetFunc(t, testCase.executionPhase))
+		repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+			getRerunNodeExecutionGetFunc(t, testCase.nodePhase))
+		execManager := NewExecutionManager(
+			repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+			workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+			mockExecutionRemoteURL, mockCloudEventPublisher)
+
+		_, err := execManager.RerunExecutionNode(context.Background(), managerInterfaces.ExecutionNodeRerunRequest{
+			ID:     &executionIdentifier,
+			NodeID: "node 1",
+		}, requestedAt)
+		assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestRerunExecutionNode_MissingNodeID(t *testing.T) {
+	execManager := NewExecutionManager(
+		repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(),
+		getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	_, err := execManager.RerunExecutionNode(context.Background(), managerInterfaces.ExecutionNodeRerunRequest{
+		ID: &executionIdentifier,
+	}, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestTerminateExecution_PublishesAbortNotifications(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	closureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_RUNNING,
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_ABORTED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"owner@example.com"}},
+				},
+			},
+		},
+	})
+	executionModel, _ := makeExecutionGetFunc(t, closureBytes, &startTime)(
+		context.Background(), interfaces.GetResourceInput{Project: "project", Domain: "domain", Name: "name"})
+	executionModel.Phase = core.WorkflowExecution_RUNNING.String()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return executionModel, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			assert.Equal(t, "abort cause", execution.AbortCause)
+			assert.Equal(t, "someone@example.com", execution.AbortedBy)
+			assert.NotNil(t, execution.AbortedAt)
+			executionModel = execution
+			return nil
+		})
+	var published []*admin.EmailMessage
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = append(published, msg.(*admin.EmailMessage))
+		return nil
+	})
+	configProvider := getMockExecutionsConfigProvider()
+	configProvider.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+				Subject: "Execution {{ name }} has {{ phase }}",
+				Body:    "{{ name }}.{{ error }}",
+			},
+		})
+	execManager := NewExecutionManager(
+		repository, configProvider, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	_, err := execManager.TerminateExecution(auth.WithUserEmail(context.Background(), "someone@example.com"),
+		admin.ExecutionTerminateRequest{
+			Id:    &executionIdentifier,
+			Cause: "abort cause",
+		})
+	assert.Nil(t, err)
+	assert.Len(t, published, 1)
+	assert.Equal(t, []string{"owner@example.com"}, published[0].RecipientsEmail)
+	assert.Equal(t, "Execution name has aborted", published[0].SubjectLine)
+	assert.Equal(t, "name. The execution was aborted by [someone@example.com]: [abort cause].", published[0].Body)
+
+	// The owners aren't notified again once the execution is reported aborted.
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Minute))
+	_, err = execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_ABORTED,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, published, 1)
+}
+
+func TestTerminateExecution_PropellerError(t *testing.T) {
+	var expectedError = errors.New("expected error")
+
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			return expectedError
+		})
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(func(
+		context context.Context, execution models.Execution) error {
+		t.Fatal("update should not be called when propeller fails to terminate an execution")
+		return nil
+	})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
 		Id: &core.WorkflowExecutionIdentifier{
 			Project: "project",
 			Domain:  "domain",
@@ -1881,7 +3351,7 @@ func TestTerminateExecution_DatabaseError(t *testing.T) {
 
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
 		Id: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -1947,7 +3417,7 @@ func TestGetExecutionData(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	dataResponse, err := execManager.GetExecutionData(context.Background(), admin.WorkflowExecutionGetDataRequest{
 		Id: &executionIdentifier,
 	})
@@ -1964,6 +3434,149 @@ func TestGetExecutionData(t *testing.T) {
 	}, dataResponse))
 }
 
+func TestGetExecutionFullData(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	closureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_SUCCEEDED,
+		OutputResult: &admin.ExecutionClosure_Outputs{
+			Outputs: &admin.LiteralMapBlob{
+				Data: &admin.LiteralMapBlob_Uri{
+					Uri: outputURI,
+				},
+			},
+		},
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				Spec:      specBytes,
+				Phase:     core.WorkflowExecution_SUCCEEDED.String(),
+				Closure:   closureBytes,
+				InputsURI: shared.Inputs,
+			}, nil
+		})
+	mockExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(
+		ctx context.Context, uri string) (admin.UrlBlob, error) {
+		if uri == outputURI {
+			return admin.UrlBlob{
+				Url:   "outputs",
+				Bytes: 100,
+			}, nil
+		} else if strings.HasSuffix(uri, shared.Inputs) {
+			return admin.UrlBlob{
+				Url:   "inputs",
+				Bytes: 200,
+			}, nil
+		}
+		return admin.UrlBlob{}, errors.New("unexpected input")
+	}
+	outputs := testutils.GetLaunchPlanRequest().Spec.FixedInputs
+	mockStorage := getMockStorageForExecTest(context.Background())
+	assert.Nil(t, mockStorage.WriteProtobuf(
+		context.Background(), storage.DataReference(outputURI), storage.Options{}, outputs))
+	configProvider := getMockExecutionsConfigProvider()
+	configProvider.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetRemoteDataConfig(
+		runtimeInterfaces.RemoteDataConfig{
+			MaxInlineSizeBytes: 150,
+		})
+	execManager := NewExecutionManager(
+		repository, configProvider, mockStorage, workflowengineMocks.NewMockExecutor(),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	dataResponse, err := execManager.GetExecutionFullData(context.Background(), admin.WorkflowExecutionGetDataRequest{
+		Id: &executionIdentifier,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "inputs", dataResponse.Inputs.Url)
+	assert.Equal(t, "outputs", dataResponse.Outputs.Url)
+	// The inputs are larger than the inline size threshold.
+	assert.Nil(t, dataResponse.FullInputs)
+	assert.True(t, proto.Equal(outputs, dataResponse.FullOutputs))
+}
+
+func TestGetExecutionData_DataRemoved(t *testing.T) {
+	dataRemovedAt := time.Now()
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				Spec:          specBytes,
+				Phase:         phase,
+				Closure:       closureBytes,
+				InputsURI:     shared.Inputs,
+				DataRemovedAt: &dataRemovedAt,
+			}, nil
+		})
+	mockExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(
+		ctx context.Context, uri string) (admin.UrlBlob, error) {
+		t.Fatalf("unexpected request to sign [%s]", uri)
+		return admin.UrlBlob{}, nil
+	}
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	_, err := execManager.GetExecutionData(context.Background(), admin.WorkflowExecutionGetDataRequest{
+		Id: &executionIdentifier,
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetExecutionData_Restricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				Spec:               specBytes,
+				Phase:              phase,
+				Closure:            closureBytes,
+				InputsURI:          shared.Inputs,
+				DataClassification: common.DataClassificationRestricted,
+			}, nil
+		})
+	mockExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(
+		ctx context.Context, uri string) (admin.UrlBlob, error) {
+		return admin.UrlBlob{
+			Url:   "inputs",
+			Bytes: 200,
+		}, nil
+	}
+	config := getMockExecutionsConfigProvider()
+	config.ApplicationConfiguration().GetTopLevelConfig().RestrictedDataReaders = []string{"reader@example.com"}
+	execManager := NewExecutionManager(
+		repository, config, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+	request := admin.WorkflowExecutionGetDataRequest{
+		Id: &executionIdentifier,
+	}
+
+	_, err := execManager.GetExecutionData(context.Background(), request)
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+	_, err = execManager.GetExecutionData(auth.WithUserEmail(context.Background(), "someone@example.com"), request)
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	dataResponse, err := execManager.GetExecutionData(
+		auth.WithUserEmail(context.Background(), "reader@example.com"), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "inputs", dataResponse.Inputs.Url)
+}
+
 func TestAddLabelsAndAnnotationsRuntimeLimitsObserved(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	setDefaultLpCallbackForExecTest(repository)
@@ -1976,7 +3589,7 @@ func TestAddLabelsAndAnnotationsRuntimeLimitsObserved(t *testing.T) {
 		mockRegistrationValidationConfig)
 	execManager := NewExecutionManager(
 		repository, configProvider, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Labels = &admin.Labels{
 		Values: map[string]string{
@@ -1995,7 +3608,7 @@ func TestAddLabelsAndAnnotationsRuntimeLimitsObserved(t *testing.T) {
 		Reference: admin.LaunchPlan{
 			Spec: &launchPlanSpec,
 		},
-	})
+	}, nil)
 	assert.EqualError(t, err, "Annotations has too many entries [2 > 1]")
 
 	mockRegistrationValidationConfig.(*runtimeMocks.MockRegistrationValidationProvider).MaxAnnotationEntries = 0
@@ -2004,7 +3617,7 @@ func TestAddLabelsAndAnnotationsRuntimeLimitsObserved(t *testing.T) {
 		Reference: admin.LaunchPlan{
 			Spec: &launchPlanSpec,
 		},
-	})
+	}, nil)
 	assert.EqualError(t, err, "Labels has too many entries [2 > 1]")
 }
 
@@ -2032,7 +3645,7 @@ func TestGetExecution_Legacy(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
 		Id: &executionIdentifier,
 	})
@@ -2069,7 +3682,7 @@ func TestGetExecution_LegacyClient_OffloadedData(t *testing.T) {
 	storageClient := getMockStorageForExecTest(context.Background())
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	_ = storageClient.WriteProtobuf(context.Background(), storage.DataReference(shared.UserInputs), storage.Options{}, getLegacySpec().Inputs)
 	_ = storageClient.WriteProtobuf(context.Background(), storage.DataReference(shared.Inputs), storage.Options{}, getLegacyClosure().ComputedInputs)
 	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
@@ -2131,7 +3744,7 @@ func TestGetExecutionData_LegacyModel(t *testing.T) {
 	storageClient := getMockStorageForExecTest(context.Background())
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	dataResponse, err := execManager.GetExecutionData(context.Background(), admin.WorkflowExecutionGetDataRequest{
 		Id: &executionIdentifier,
 	})
@@ -2172,7 +3785,7 @@ func TestCreateExecution_LegacyClient(t *testing.T) {
 		})
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor,
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	response, err := execManager.CreateExecution(context.Background(), *getLegacyExecutionRequest(), requestedAt)
 	assert.Nil(t, err)
 
@@ -2190,7 +3803,7 @@ func TestRelaunchExecution_LegacyModel(t *testing.T) {
 	storageClient := getMockStorageForExecTest(context.Background())
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := getLegacyClosure()
@@ -2303,7 +3916,7 @@ func TestListExecutions_LegacyModel(t *testing.T) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
 	execManager := NewExecutionManager(
 		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(),
-		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL)
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
 
 	executionList, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -2332,3 +3945,213 @@ func TestListExecutions_LegacyModel(t *testing.T) {
 	}
 	assert.Empty(t, executionList.Token)
 }
+
+func setScheduledLpCallbackForExecTest(repository repositories.RepositoryInterface, schedule *admin.Schedule) {
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpec.DefaultInputs.Parameters["kickoff"] = &core.Parameter{
+		Var: &core.Variable{
+			Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_DATETIME}},
+		},
+		Behavior: &core.Parameter_Required{
+			Required: true,
+		},
+	}
+	lpSpec.EntityMetadata.Schedule = schedule
+	lpSpecBytes, _ := proto.Marshal(&lpSpec)
+	lpClosureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: lpSpec.DefaultInputs,
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				BaseModel: models.BaseModel{
+					ID: uint(100),
+				},
+				Spec:    lpSpecBytes,
+				Closure: lpClosureBytes,
+			}, nil
+		})
+}
+
+func TestBackfillExecutions(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setScheduledLpCallbackForExecTest(repository, &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_Rate{
+			Rate: &admin.FixedRate{
+				Value: 1,
+				Unit:  admin.FixedRateUnit_HOUR,
+			},
+		},
+		KickoffTimeInputArg: "kickoff",
+	})
+	launchPlanID := testutils.GetExecutionRequest().Spec.LaunchPlan
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	existingName := getBackfillExecutionName(launchPlanID, start.Add(time.Hour))
+	failingName := getBackfillExecutionName(launchPlanID, start.Add(2*time.Hour))
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			if input.Name == existingName {
+				return models.Execution{}, nil
+			}
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+	var createdNames []string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createdNames = append(createdNames, input.Name)
+			var executionSpec admin.ExecutionSpec
+			assert.NoError(t, proto.Unmarshal(input.Spec, &executionSpec))
+			assert.Equal(t, admin.ExecutionMetadata_SYSTEM, executionSpec.Metadata.Mode)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			kickoffTime, err := ptypes.Timestamp(inputs.Inputs.Literals["kickoff"].GetScalar().GetPrimitive().GetDatetime())
+			assert.NoError(t, err)
+			assert.Equal(t, getBackfillExecutionName(launchPlanID, kickoffTime), inputs.ExecutionID.Name)
+			if inputs.ExecutionID.Name == failingName {
+				return nil, errors.New("expected test error")
+			}
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	config := getMockExecutionsConfigProvider()
+	config.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetSchedulerConfig(
+		runtimeInterfaces.SchedulerConfig{
+			BackfillConfig: runtimeInterfaces.BackfillConfig{
+				MaxExecutions:       10,
+				ExecutionsPerSecond: 1000,
+			},
+		})
+	var createdBackfill models.Backfill
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.MockBackfillRepo)
+	backfillRepo.CreateFunction = func(ctx context.Context, input models.Backfill) error {
+		createdBackfill = input
+		return nil
+	}
+	var updates int
+	done := make(chan models.Backfill, 1)
+	backfillRepo.UpdateFunction = func(ctx context.Context, input models.Backfill) error {
+		updates++
+		if input.Done {
+			done <- input
+		}
+		return nil
+	}
+	execManager := NewExecutionManager(
+		repository, config, getMockStorageForExecTest(context.Background()), mockExecutor,
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	response, err := execManager.BackfillExecutions(context.Background(), managerInterfaces.BackfillExecutionsRequest{
+		LaunchPlan: launchPlanID,
+		StartTime:  start,
+		EndTime:    start.Add(4 * time.Hour),
+	}, requestedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, response.Executions)
+	assert.Equal(t, createdBackfill.Name, response.ID)
+	assert.Equal(t, 4, createdBackfill.Total)
+	assert.False(t, createdBackfill.Done)
+
+	var backfillModel models.Backfill
+	select {
+	case backfillModel = <-done:
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "timed out waiting for the backfill to finish")
+	}
+	assert.Equal(t, 4, updates)
+	backfill, err := transformers.FromBackfillModel(backfillModel)
+	assert.NoError(t, err)
+	assert.Equal(t, response.ID, backfill.ID)
+	assert.Equal(t, 3, backfill.Succeeded)
+	assert.Equal(t, 1, backfill.Skipped)
+	assert.Equal(t, 1, backfill.Failed)
+	assert.Len(t, backfill.Executions, 4)
+	for i, result := range backfill.Executions {
+		assert.Equal(t, start.Add(time.Duration(i)*time.Hour), result.KickoffTime)
+	}
+	assert.Equal(t, existingName, backfill.Executions[1].ID.Name)
+	assert.Nil(t, backfill.Executions[2].ID)
+	assert.Equal(t, "expected test error", backfill.Executions[2].Error)
+	assert.Equal(t, []string{
+		backfill.Executions[0].ID.Name,
+		backfill.Executions[3].ID.Name,
+	}, createdNames)
+}
+
+func TestGetBackfill(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	repository.BackfillRepo().(*repositoryMocks.MockBackfillRepo).GetFunction = func(
+		ctx context.Context, name string) (models.Backfill, error) {
+		assert.Equal(t, "backfill", name)
+		return models.Backfill{
+			Name:              name,
+			LaunchPlanProject: "project",
+			LaunchPlanDomain:  "domain",
+			LaunchPlanName:    "name",
+			LaunchPlanVersion: "version",
+			Total:             2,
+			Succeeded:         1,
+		}, nil
+	}
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+
+	backfill, err := execManager.GetBackfill(context.Background(), "backfill")
+	assert.NoError(t, err)
+	assert.Equal(t, "name", backfill.LaunchPlan.Name)
+	assert.Equal(t, 2, backfill.Total)
+	assert.Equal(t, 1, backfill.Succeeded)
+	assert.False(t, backfill.Done)
+
+	_, err = execManager.GetBackfill(context.Background(), "")
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestBackfillExecutions_NoSchedule(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	_, err := execManager.BackfillExecutions(context.Background(), managerInterfaces.BackfillExecutionsRequest{
+		LaunchPlan: testutils.GetExecutionRequest().Spec.LaunchPlan,
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+	}, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestBackfillExecutions_MissingKickoffTimeInput(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setScheduledLpCallbackForExecTest(repository, &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronExpression{
+			CronExpression: "0 10 * * ? *",
+		},
+	})
+	execManager := NewExecutionManager(
+		repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()),
+		workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher,
+		mockExecutionRemoteURL, mockCloudEventPublisher)
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	_, err := execManager.BackfillExecutions(context.Background(), managerInterfaces.BackfillExecutionsRequest{
+		LaunchPlan:          testutils.GetExecutionRequest().Spec.LaunchPlan,
+		StartTime:           start,
+		EndTime:             start.Add(24 * time.Hour),
+		KickoffTimeInputArg: "missing",
+	}, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
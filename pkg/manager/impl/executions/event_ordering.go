@@ -0,0 +1,49 @@
+package executions
+
+import (
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+)
+
+// Describes how an incoming workflow execution event should be handled relative to the state already recorded.
+type EventDisposition int
+
+const (
+	// The event advances the execution and should be recorded.
+	EventDispositionApply EventDisposition = iota
+	// The event repeats the phase already recorded (e.g. a propeller retry) and can be safely acknowledged.
+	EventDispositionDuplicate
+	// The event describes a transition that was already superseded by a later one and can be safely acknowledged.
+	EventDispositionStale
+	// The event describes a transition the recorded phase doesn't allow, e.g. out of a terminal phase, and must be
+	// rejected.
+	EventDispositionConflict
+)
+
+// Decides how to reconcile an incoming event with the current execution phase. Events for transitions
+// WorkflowExecutionPhases doesn't allow, e.g. those that regress the phase, are considered stale, and therefore
+// acknowledged without being applied, when they occurred no later than the most recently recorded update. Those that
+// can't be shown to predate the recorded phase (for instance a RUNNING event emitted after an execution was aborted)
+// remain a conflict so that propeller stops processing them.
+func GetEventDisposition(currentPhase core.WorkflowExecution_Phase, lastUpdatedAt *time.Time,
+	eventPhase core.WorkflowExecution_Phase, occurredAt time.Time) EventDisposition {
+	occurredBeforeLastUpdate := lastUpdatedAt != nil && !occurredAt.After(*lastUpdatedAt)
+	switch WorkflowExecutionPhases.GetTransition(int32(currentPhase), int32(eventPhase)) {
+	case PhaseTransitionDuplicate:
+		return EventDispositionDuplicate
+	case PhaseTransitionFromTerminal:
+		if !common.IsExecutionTerminal(eventPhase) && occurredBeforeLastUpdate {
+			return EventDispositionStale
+		}
+		return EventDispositionConflict
+	case PhaseTransitionInvalid:
+		if occurredBeforeLastUpdate {
+			return EventDispositionStale
+		}
+		return EventDispositionConflict
+	}
+	return EventDispositionApply
+}
@@ -0,0 +1,50 @@
+package executions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventDisposition(t *testing.T) {
+	lastUpdatedAt := time.Date(2019, 11, 12, 10, 0, 0, 0, time.UTC)
+	earlier := lastUpdatedAt.Add(-time.Minute)
+	later := lastUpdatedAt.Add(time.Minute)
+
+	assert.Equal(t, EventDispositionApply, GetEventDisposition(
+		core.WorkflowExecution_UNDEFINED, nil, core.WorkflowExecution_RUNNING, later))
+	assert.Equal(t, EventDispositionApply, GetEventDisposition(
+		core.WorkflowExecution_RUNNING, &lastUpdatedAt, core.WorkflowExecution_SUCCEEDED, later))
+	assert.Equal(t, EventDispositionApply, GetEventDisposition(
+		core.WorkflowExecution_FAILING, &lastUpdatedAt, core.WorkflowExecution_SUCCEEDING, later))
+
+	// Retries of an already recorded phase.
+	assert.Equal(t, EventDispositionDuplicate, GetEventDisposition(
+		core.WorkflowExecution_RUNNING, &lastUpdatedAt, core.WorkflowExecution_RUNNING, later))
+	assert.Equal(t, EventDispositionDuplicate, GetEventDisposition(
+		core.WorkflowExecution_SUCCEEDED, &lastUpdatedAt, core.WorkflowExecution_SUCCEEDED, earlier))
+
+	// Out-of-order events which were superseded.
+	assert.Equal(t, EventDispositionStale, GetEventDisposition(
+		core.WorkflowExecution_SUCCEEDED, &lastUpdatedAt, core.WorkflowExecution_RUNNING, earlier))
+	assert.Equal(t, EventDispositionStale, GetEventDisposition(
+		core.WorkflowExecution_RUNNING, &lastUpdatedAt, core.WorkflowExecution_QUEUED, lastUpdatedAt))
+
+	// Regressions which can't be shown to predate the recorded state.
+	assert.Equal(t, EventDispositionConflict, GetEventDisposition(
+		core.WorkflowExecution_ABORTED, &lastUpdatedAt, core.WorkflowExecution_RUNNING, later))
+	assert.Equal(t, EventDispositionConflict, GetEventDisposition(
+		core.WorkflowExecution_SUCCEEDED, nil, core.WorkflowExecution_RUNNING, earlier))
+	assert.Equal(t, EventDispositionConflict, GetEventDisposition(
+		core.WorkflowExecution_SUCCEEDED, &lastUpdatedAt, core.WorkflowExecution_FAILED, earlier))
+	assert.Equal(t, EventDispositionConflict, GetEventDisposition(
+		core.WorkflowExecution_RUNNING, &lastUpdatedAt, core.WorkflowExecution_QUEUED, later))
+
+	// Transitions which aren't allowed out of non-terminal phases.
+	assert.Equal(t, EventDispositionConflict, GetEventDisposition(
+		core.WorkflowExecution_RUNNING, &lastUpdatedAt, core.WorkflowExecution_UNDEFINED, later))
+	assert.Equal(t, EventDispositionStale, GetEventDisposition(
+		core.WorkflowExecution_RUNNING, &lastUpdatedAt, core.WorkflowExecution_UNDEFINED, earlier))
+}
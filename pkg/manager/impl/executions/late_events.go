@@ -0,0 +1,24 @@
+package executions
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Returns whether an event which occurred at occurredAt may still enrich the closure of an entity which recorded its
+// terminal phase at terminalAt.
+func IsLateEventAccepted(
+	config runtimeInterfaces.LateEventsConfig, terminalAt *time.Time, occurredAt *timestamp.Timestamp) bool {
+	if config.MaxDelay.Duration <= 0 || terminalAt == nil {
+		return false
+	}
+	eventOccurredAt, err := ptypes.Timestamp(occurredAt)
+	if err != nil {
+		return false
+	}
+	return !eventOccurredAt.After(terminalAt.Add(config.MaxDelay.Duration))
+}
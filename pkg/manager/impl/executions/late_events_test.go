@@ -0,0 +1,26 @@
+package executions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lyft/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+func TestIsLateEventAccepted(t *testing.T) {
+	terminalAt := time.Date(2019, 11, 18, 10, 0, 0, 0, time.UTC)
+	withinDelay, _ := ptypes.TimestampProto(terminalAt.Add(30 * time.Second))
+	afterDelay, _ := ptypes.TimestampProto(terminalAt.Add(2 * time.Minute))
+	lateEventsConfig := runtimeInterfaces.LateEventsConfig{
+		MaxDelay: config.Duration{Duration: time.Minute},
+	}
+
+	assert.True(t, IsLateEventAccepted(lateEventsConfig, &terminalAt, withinDelay))
+	assert.False(t, IsLateEventAccepted(lateEventsConfig, &terminalAt, afterDelay))
+	assert.False(t, IsLateEventAccepted(lateEventsConfig, nil, withinDelay))
+	assert.False(t, IsLateEventAccepted(runtimeInterfaces.LateEventsConfig{}, &terminalAt, withinDelay))
+}
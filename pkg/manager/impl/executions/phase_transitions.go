@@ -0,0 +1,154 @@
+package executions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+// Describes whether an execution entity may move from its recorded phase to the phase of an incoming event.
+type PhaseTransition int
+
+const (
+	// The transition is allowed.
+	PhaseTransitionValid PhaseTransition = iota
+	// The event repeats the recorded phase.
+	PhaseTransitionDuplicate
+	// The recorded phase is terminal and can't be followed by any other phase.
+	PhaseTransitionFromTerminal
+	// The recorded phase isn't terminal but doesn't allow the transition.
+	PhaseTransitionInvalid
+)
+
+// A table of the phases each phase of an execution entity may transition to, keyed by the values of the entity's phase
+// enum. Phases without any allowed transitions are terminal, so phases added to the enums, e.g. PAUSED, are rejected
+// until they're added to the tables.
+type PhaseStateMachine struct {
+	// Used to describe rejected transitions, e.g. "node execution".
+	entity      string
+	phaseNames  map[int32]string
+	transitions map[int32]map[int32]bool
+}
+
+func (m PhaseStateMachine) IsTerminal(phase int32) bool {
+	return len(m.transitions[phase]) == 0
+}
+
+func (m PhaseStateMachine) GetTransition(from, to int32) PhaseTransition {
+	if from == to {
+		return PhaseTransitionDuplicate
+	}
+	if m.IsTerminal(from) {
+		return PhaseTransitionFromTerminal
+	}
+	if !m.transitions[from][to] {
+		return PhaseTransitionInvalid
+	}
+	return PhaseTransitionValid
+}
+
+// Returns the error used to reject a transition out of a terminal phase or one which isn't allowed.
+func (m PhaseStateMachine) NewTransitionError(ctx context.Context, from, to int32, id fmt.Stringer) error {
+	errorMsg := fmt.Sprintf("invalid phase change from %s to %s for %s %v",
+		m.phaseNames[from], m.phaseNames[to], m.entity, id)
+	if m.IsTerminal(from) {
+		return errors.NewAlreadyInTerminalStateError(ctx, errorMsg, m.phaseNames[from])
+	}
+	return errors.NewFlyteAdminError(codes.FailedPrecondition, errorMsg)
+}
+
+func NewPhaseStateMachine(
+	entity string, phaseNames map[int32]string, transitions map[int32][]int32) PhaseStateMachine {
+	machine := PhaseStateMachine{
+		entity:      entity,
+		phaseNames:  phaseNames,
+		transitions: make(map[int32]map[int32]bool, len(transitions)),
+	}
+	for from, toPhases := range transitions {
+		machine.transitions[from] = make(map[int32]bool, len(toPhases))
+		for _, to := range toPhases {
+			machine.transitions[from][to] = true
+		}
+	}
+	return machine
+}
+
+// Propeller may skip phases, for instance cached nodes are reported as SUCCEEDED straight away and executions may be
+// aborted or time out at any point, so each phase may transition to any phase after it. Phases never move backwards,
+// e.g. from RUNNING to QUEUED, or back to UNDEFINED.
+var WorkflowExecutionPhases = NewPhaseStateMachine("workflow execution", core.WorkflowExecution_Phase_name,
+	map[int32][]int32{
+		int32(core.WorkflowExecution_UNDEFINED): workflowExecutionPhases(core.WorkflowExecution_QUEUED,
+			core.WorkflowExecution_RUNNING, core.WorkflowExecution_SUCCEEDING, core.WorkflowExecution_SUCCEEDED,
+			core.WorkflowExecution_FAILING, core.WorkflowExecution_FAILED, core.WorkflowExecution_ABORTED,
+			core.WorkflowExecution_TIMED_OUT),
+		int32(core.WorkflowExecution_QUEUED): workflowExecutionPhases(core.WorkflowExecution_RUNNING,
+			core.WorkflowExecution_SUCCEEDING, core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_FAILING,
+			core.WorkflowExecution_FAILED, core.WorkflowExecution_ABORTED, core.WorkflowExecution_TIMED_OUT),
+		int32(core.WorkflowExecution_RUNNING): workflowExecutionPhases(core.WorkflowExecution_SUCCEEDING,
+			core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_FAILING, core.WorkflowExecution_FAILED,
+			core.WorkflowExecution_ABORTED, core.WorkflowExecution_TIMED_OUT),
+		// Failing to write the outputs of a succeeding workflow fails it, while a failing workflow may still succeed
+		// when its failure node recovers.
+		int32(core.WorkflowExecution_SUCCEEDING): workflowExecutionPhases(core.WorkflowExecution_SUCCEEDED,
+			core.WorkflowExecution_FAILING, core.WorkflowExecution_FAILED, core.WorkflowExecution_ABORTED,
+			core.WorkflowExecution_TIMED_OUT),
+		int32(core.WorkflowExecution_FAILING): workflowExecutionPhases(core.WorkflowExecution_SUCCEEDING,
+			core.WorkflowExecution_FAILED, core.WorkflowExecution_ABORTED, core.WorkflowExecution_TIMED_OUT),
+	})
+
+var NodeExecutionPhases = NewPhaseStateMachine("node execution", core.NodeExecution_Phase_name,
+	map[int32][]int32{
+		int32(core.NodeExecution_UNDEFINED): nodeExecutionPhases(core.NodeExecution_QUEUED,
+			core.NodeExecution_RUNNING, core.NodeExecution_SUCCEEDED, core.NodeExecution_FAILING,
+			core.NodeExecution_FAILED, core.NodeExecution_ABORTED, core.NodeExecution_SKIPPED,
+			core.NodeExecution_TIMED_OUT),
+		// Only nodes which haven't started running yet may be skipped.
+		int32(core.NodeExecution_QUEUED): nodeExecutionPhases(core.NodeExecution_RUNNING,
+			core.NodeExecution_SUCCEEDED, core.NodeExecution_FAILING, core.NodeExecution_FAILED,
+			core.NodeExecution_ABORTED, core.NodeExecution_SKIPPED, core.NodeExecution_TIMED_OUT),
+		int32(core.NodeExecution_RUNNING): nodeExecutionPhases(core.NodeExecution_SUCCEEDED,
+			core.NodeExecution_FAILING, core.NodeExecution_FAILED, core.NodeExecution_ABORTED,
+			core.NodeExecution_TIMED_OUT),
+		int32(core.NodeExecution_FAILING): nodeExecutionPhases(core.NodeExecution_FAILED,
+			core.NodeExecution_ABORTED, core.NodeExecution_TIMED_OUT),
+	})
+
+var TaskExecutionPhases = NewPhaseStateMachine("task execution", core.TaskExecution_Phase_name,
+	map[int32][]int32{
+		int32(core.TaskExecution_UNDEFINED): taskExecutionPhases(core.TaskExecution_QUEUED,
+			core.TaskExecution_RUNNING, core.TaskExecution_SUCCEEDED, core.TaskExecution_ABORTED,
+			core.TaskExecution_FAILED),
+		int32(core.TaskExecution_QUEUED): taskExecutionPhases(core.TaskExecution_RUNNING,
+			core.TaskExecution_SUCCEEDED, core.TaskExecution_ABORTED, core.TaskExecution_FAILED),
+		int32(core.TaskExecution_RUNNING): taskExecutionPhases(core.TaskExecution_SUCCEEDED,
+			core.TaskExecution_ABORTED, core.TaskExecution_FAILED),
+	})
+
+func workflowExecutionPhases(phases ...core.WorkflowExecution_Phase) []int32 {
+	values := make([]int32, len(phases))
+	for idx, phase := range phases {
+		values[idx] = int32(phase)
+	}
+	return values
+}
+
+func nodeExecutionPhases(phases ...core.NodeExecution_Phase) []int32 {
+	values := make([]int32, len(phases))
+	for idx, phase := range phases {
+		values[idx] = int32(phase)
+	}
+	return values
+}
+
+func taskExecutionPhases(phases ...core.TaskExecution_Phase) []int32 {
+	values := make([]int32, len(phases))
+	for idx, phase := range phases {
+		values[idx] = int32(phase)
+	}
+	return values
+}
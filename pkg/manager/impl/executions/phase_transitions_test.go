@@ -0,0 +1,130 @@
+package executions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+func TestPhaseStateMachines_TerminalPhases(t *testing.T) {
+	for phase := range core.WorkflowExecution_Phase_name {
+		assert.Equal(t, common.IsExecutionTerminal(core.WorkflowExecution_Phase(phase)),
+			WorkflowExecutionPhases.IsTerminal(phase))
+	}
+	for phase := range core.NodeExecution_Phase_name {
+		assert.Equal(t, common.IsNodeExecutionTerminal(core.NodeExecution_Phase(phase)),
+			NodeExecutionPhases.IsTerminal(phase))
+	}
+	for phase := range core.TaskExecution_Phase_name {
+		assert.Equal(t, common.IsTaskExecutionTerminal(core.TaskExecution_Phase(phase)),
+			TaskExecutionPhases.IsTerminal(phase))
+	}
+}
+
+func TestPhaseStateMachine_GetTransition(t *testing.T) {
+	assert.Equal(t, PhaseTransitionValid, NodeExecutionPhases.GetTransition(
+		int32(core.NodeExecution_UNDEFINED), int32(core.NodeExecution_RUNNING)))
+	assert.Equal(t, PhaseTransitionValid, NodeExecutionPhases.GetTransition(
+		int32(core.NodeExecution_UNDEFINED), int32(core.NodeExecution_SUCCEEDED)))
+	assert.Equal(t, PhaseTransitionDuplicate, NodeExecutionPhases.GetTransition(
+		int32(core.NodeExecution_RUNNING), int32(core.NodeExecution_RUNNING)))
+	assert.Equal(t, PhaseTransitionFromTerminal, NodeExecutionPhases.GetTransition(
+		int32(core.NodeExecution_SKIPPED), int32(core.NodeExecution_RUNNING)))
+	assert.Equal(t, PhaseTransitionInvalid, NodeExecutionPhases.GetTransition(
+		int32(core.NodeExecution_RUNNING), int32(core.NodeExecution_UNDEFINED)))
+
+	assert.Equal(t, PhaseTransitionValid, TaskExecutionPhases.GetTransition(
+		int32(core.TaskExecution_QUEUED), int32(core.TaskExecution_FAILED)))
+	assert.Equal(t, PhaseTransitionFromTerminal, TaskExecutionPhases.GetTransition(
+		int32(core.TaskExecution_SUCCEEDED), int32(core.TaskExecution_FAILED)))
+}
+
+func TestPhaseStateMachines_RejectedTransitions(t *testing.T) {
+	for _, transition := range [][2]core.WorkflowExecution_Phase{
+		{core.WorkflowExecution_RUNNING, core.WorkflowExecution_QUEUED},
+		{core.WorkflowExecution_SUCCEEDING, core.WorkflowExecution_RUNNING},
+		{core.WorkflowExecution_FAILING, core.WorkflowExecution_RUNNING},
+		{core.WorkflowExecution_FAILING, core.WorkflowExecution_SUCCEEDED},
+		{core.WorkflowExecution_QUEUED, core.WorkflowExecution_UNDEFINED},
+	} {
+		assert.Equal(t, PhaseTransitionInvalid, WorkflowExecutionPhases.GetTransition(
+			int32(transition[0]), int32(transition[1])), "%v", transition)
+	}
+	for _, transition := range [][2]core.NodeExecution_Phase{
+		{core.NodeExecution_RUNNING, core.NodeExecution_QUEUED},
+		{core.NodeExecution_RUNNING, core.NodeExecution_SKIPPED},
+		{core.NodeExecution_FAILING, core.NodeExecution_RUNNING},
+		{core.NodeExecution_FAILING, core.NodeExecution_SUCCEEDED},
+	} {
+		assert.Equal(t, PhaseTransitionInvalid, NodeExecutionPhases.GetTransition(
+			int32(transition[0]), int32(transition[1])), "%v", transition)
+	}
+	for _, transition := range [][2]core.TaskExecution_Phase{
+		{core.TaskExecution_RUNNING, core.TaskExecution_QUEUED},
+		{core.TaskExecution_QUEUED, core.TaskExecution_UNDEFINED},
+	} {
+		assert.Equal(t, PhaseTransitionInvalid, TaskExecutionPhases.GetTransition(
+			int32(transition[0]), int32(transition[1])), "%v", transition)
+	}
+}
+
+func TestPhaseStateMachine_CustomTable(t *testing.T) {
+	const (
+		queued   = int32(1)
+		running  = int32(2)
+		paused   = int32(3)
+		aborting = int32(4)
+		aborted  = int32(5)
+	)
+	machine := NewPhaseStateMachine("job", map[int32]string{
+		queued:   "QUEUED",
+		running:  "RUNNING",
+		paused:   "PAUSED",
+		aborting: "ABORTING",
+		aborted:  "ABORTED",
+	}, map[int32][]int32{
+		queued:   {running, aborting},
+		running:  {paused, aborting},
+		paused:   {running, aborting},
+		aborting: {aborted},
+	})
+	assert.Equal(t, PhaseTransitionValid, machine.GetTransition(running, paused))
+	assert.Equal(t, PhaseTransitionValid, machine.GetTransition(paused, running))
+	assert.Equal(t, PhaseTransitionInvalid, machine.GetTransition(queued, paused))
+	assert.Equal(t, PhaseTransitionInvalid, machine.GetTransition(aborting, running))
+	assert.Equal(t, PhaseTransitionFromTerminal, machine.GetTransition(aborted, running))
+	assert.True(t, machine.IsTerminal(aborted))
+	assert.False(t, machine.IsTerminal(aborting))
+}
+
+func TestPhaseStateMachine_NewTransitionError(t *testing.T) {
+	nodeExecutionID := &core.NodeExecutionIdentifier{
+		NodeId: "node",
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}
+	err := NodeExecutionPhases.NewTransitionError(context.Background(),
+		int32(core.NodeExecution_SUCCEEDED), int32(core.NodeExecution_RUNNING), nodeExecutionID)
+	adminError := err.(errors.FlyteAdminError)
+	assert.Equal(t, codes.FailedPrecondition, adminError.Code())
+	assert.Contains(t, adminError.Error(), "invalid phase change from SUCCEEDED to RUNNING for node execution")
+	details, ok := adminError.GRPCStatus().Details()[0].(*admin.EventFailureReason)
+	assert.True(t, ok)
+	assert.Equal(t, "SUCCEEDED", details.GetAlreadyInTerminalState().GetCurrentPhase())
+
+	err = NodeExecutionPhases.NewTransitionError(context.Background(),
+		int32(core.NodeExecution_RUNNING), int32(core.NodeExecution_UNDEFINED), nodeExecutionID)
+	adminError = err.(errors.FlyteAdminError)
+	assert.Equal(t, codes.FailedPrecondition, adminError.Code())
+	assert.Empty(t, adminError.GRPCStatus().Details())
+}
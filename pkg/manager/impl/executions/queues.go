@@ -2,14 +2,25 @@ package executions
 
 import (
 	"context"
+	"strings"
 
 	"github.com/lyft/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 )
 
+// The project-domain attribute listing the comma-separated tags of the execution queues which workflows in the
+// project and domain run on, when the queue configuration doesn't assign them a queue.
+const ExecutionQueueTagsAttribute = "execution_queue_tags"
+
 type project = string
 type domain = string
 type workflowName = string
@@ -60,6 +71,7 @@ type queueAllocatorImpl struct {
 	defaultProjectDomainQueueAssignmentMap defaultProjectDomainQueueAssignment
 	workflowQueueAssignmentMap             workflowQueueAssignment
 	config                                 runtimeInterfaces.Configuration
+	db                                     repositories.RepositoryInterface
 }
 
 // Returns an arbitrary map entry's key from the input map. Used when a workflow can be run on multiple queues.
@@ -170,8 +182,10 @@ func (q *queueAllocatorImpl) refreshWorkflowQueueMap(workflowConfigs []runtimeIn
 	q.workflowQueueAssignmentMap = workflowQueueMap
 }
 
-// Returns a queue specifically matching identifier project, domain, and name
-// Barring a match for that, a queue matching a combination of project + domain will be returned.
+// Returns a queue specifically matching identifier project, domain, and name in the (deprecated) workflow configs.
+// Execution queue attributes matching the identifier take precedence over all workflow configs. Barring a match for
+// that, a queue matching a combination of project + domain will be returned, followed by one
+// matching the execution queue tags in the project + domain attributes.
 // And if there is no existing match for that, a queue matching the project will be returned if it exists.
 func (q *queueAllocatorImpl) getQueueForIdentifier(identifier core.Identifier) *singleQueueConfiguration {
 	projectSubMap, ok := q.workflowQueueAssignmentMap[identifier.Project]
@@ -201,6 +215,76 @@ func (q *queueAllocatorImpl) getQueueForProjectAndDomain(identifier core.Identif
 	return &defaultDomainQueue
 }
 
+// Returns a queue matching the tags of the execution queue attributes which most specifically match the identifier's
+// project, domain and name.
+func (q *queueAllocatorImpl) getQueueForMatchableAttributes(
+	ctx context.Context, identifier core.Identifier) *singleQueueConfiguration {
+	resourceModel, err := q.db.ResourceRepo().Get(ctx, repoInterfaces.ResourceID{
+		Project:      identifier.Project,
+		Domain:       identifier.Domain,
+		Workflow:     identifier.Name,
+		ResourceType: int32(interfaces.MatchableResourceExecutionQueue),
+	})
+	if err != nil {
+		if adminErr, ok := err.(errors.FlyteAdminError); !ok || adminErr.Code() != codes.NotFound {
+			logger.Warningf(ctx, "Failed to get execution queue attributes of [%+v] with err: %v", identifier, err)
+		}
+		return nil
+	}
+	attributes, err := transformers.FromResourceModel(resourceModel)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to read execution queue attributes of [%+v] with err: %v", identifier, err)
+		return nil
+	}
+	if attributes.Attributes.ExecutionQueueAttributes == nil ||
+		len(attributes.Attributes.ExecutionQueueAttributes.Tags) == 0 {
+		return nil
+	}
+	queueCandidates := q.findQueueCandidates(runtimeInterfaces.WorkflowConfig{
+		Tags: attributes.Attributes.ExecutionQueueAttributes.Tags,
+	})
+	if len(queueCandidates) == 0 {
+		return nil
+	}
+	queue := getAnyMapKey(queueCandidates)
+	return &queue
+}
+
+func (q *queueAllocatorImpl) getQueueForProjectDomainAttributes(
+	ctx context.Context, identifier core.Identifier) *singleQueueConfiguration {
+	projectDomainModel, err := q.db.ProjectDomainRepo().Get(ctx, identifier.Project, identifier.Domain)
+	if err != nil {
+		if adminErr, ok := err.(errors.FlyteAdminError); !ok || adminErr.Code() != codes.NotFound {
+			logger.Warningf(ctx, "Failed to get attributes of [%s/%s] with err: %v",
+				identifier.Project, identifier.Domain, err)
+		}
+		return nil
+	}
+	attributes, err := transformers.FromProjectDomainModel(projectDomainModel)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to read attributes of [%s/%s] with err: %v",
+			identifier.Project, identifier.Domain, err)
+		return nil
+	}
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(attributes.Attributes[ExecutionQueueTagsAttribute], ",") {
+		if tag = strings.TrimSpace(tag); len(tag) > 0 {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	queueCandidates := q.findQueueCandidates(runtimeInterfaces.WorkflowConfig{
+		Tags: tags,
+	})
+	if len(queueCandidates) == 0 {
+		return nil
+	}
+	queue := getAnyMapKey(queueCandidates)
+	return &queue
+}
+
 func (q *queueAllocatorImpl) getQueueForProject(identifier core.Identifier) *singleQueueConfiguration {
 	queue, ok := q.defaultProjectQueueAssignmentMap[identifier.Project]
 	if !ok {
@@ -222,7 +306,12 @@ func (q *queueAllocatorImpl) GetQueue(ctx context.Context, identifier core.Ident
 		"Evaluating execution queue for [%+v] with available queues [%+v] and available workflow configs [%+v]",
 		identifier, executionQueues, workflowConfigs)
 
-	queue := q.getQueueForIdentifier(identifier)
+	queue := q.getQueueForMatchableAttributes(ctx, identifier)
+	if queue != nil {
+		logger.Debugf(ctx, "Found queue for execution queue attributes matching [%+v]: %v", identifier, queue)
+		return *queue
+	}
+	queue = q.getQueueForIdentifier(identifier)
 	if queue != nil {
 		logger.Debugf(ctx, "Found queue for identifier [%+v]: %v", identifier, queue)
 		return *queue
@@ -232,6 +321,12 @@ func (q *queueAllocatorImpl) GetQueue(ctx context.Context, identifier core.Ident
 		logger.Debugf(ctx, "Found queue for project+domain [%s/%s]: %v", identifier.Project, identifier.Domain, queue)
 		return *queue
 	}
+	queue = q.getQueueForProjectDomainAttributes(ctx, identifier)
+	if queue != nil {
+		logger.Debugf(ctx, "Found queue for project+domain attributes [%s/%s]: %v",
+			identifier.Project, identifier.Domain, queue)
+		return *queue
+	}
 	queue = q.getQueueForProject(identifier)
 	if queue != nil {
 		logger.Debugf(ctx, "Found queue for project [%s]: %v", identifier.Project, queue)
@@ -240,9 +335,10 @@ func (q *queueAllocatorImpl) GetQueue(ctx context.Context, identifier core.Ident
 	return q.defaultQueue
 }
 
-func NewQueueAllocator(config runtimeInterfaces.Configuration) QueueAllocator {
+func NewQueueAllocator(config runtimeInterfaces.Configuration, db repositories.RepositoryInterface) QueueAllocator {
 	queueAllocator := queueAllocatorImpl{
 		config: config,
+		db:     db,
 	}
 	return &queueAllocator
 }
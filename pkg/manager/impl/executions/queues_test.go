@@ -4,8 +4,16 @@ import (
 	"context"
 	"testing"
 
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
 
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	"github.com/stretchr/testify/assert"
@@ -67,7 +75,7 @@ func TestGetQueue(t *testing.T) {
 	}
 	queueAllocator := NewQueueAllocator(runtimeMocks.NewMockConfigurationProvider(
 		nil, runtimeMocks.NewMockQueueConfigurationProvider(executionQueues, workflowConfigs),
-		nil, nil, nil, nil))
+		nil, nil, nil, nil), repositoryMocks.NewMockRepository())
 	queueConfig := singleQueueConfiguration{
 		PrimaryQueue: "queue primary",
 		DynamicQueue: "queue dynamic",
@@ -139,7 +147,7 @@ func TestGetQueueDefaults(t *testing.T) {
 	}
 	queueAllocator := NewQueueAllocator(runtimeMocks.NewMockConfigurationProvider(
 		nil, runtimeMocks.NewMockQueueConfigurationProvider(executionQueues, workflowConfigs), nil,
-		nil, nil, nil))
+		nil, nil, nil), repositoryMocks.NewMockRepository())
 	assert.Equal(t, singleQueueConfiguration{
 		PrimaryQueue: "default primary",
 		DynamicQueue: "default dynamic",
@@ -177,3 +185,129 @@ func TestGetQueueDefaults(t *testing.T) {
 			Name:    "workflow",
 		}))
 }
+
+func TestGetQueue_ProjectDomainAttributes(t *testing.T) {
+	executionQueues := []runtimeInterfaces.ExecutionQueue{
+		{
+			Primary:    "default primary",
+			Dynamic:    "default dynamic",
+			Attributes: []string{"default"},
+		},
+		{
+			Primary:    "gpu primary",
+			Dynamic:    "gpu dynamic",
+			Attributes: []string{"gpu", "large"},
+		},
+	}
+	workflowConfigs := []runtimeInterfaces.WorkflowConfig{
+		{
+			Tags: []string{"default"},
+		},
+		{
+			Project: "configured",
+			Domain:  "domain",
+			Tags:    []string{"default"},
+		},
+	}
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		if domain != "domain" {
+			return models.ProjectDomain{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		}
+		return transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+			Project: project,
+			Domain:  domain,
+			Attributes: map[string]string{
+				ExecutionQueueTagsAttribute: "gpu, large",
+			},
+		})
+	}
+	queueAllocator := NewQueueAllocator(runtimeMocks.NewMockConfigurationProvider(
+		nil, runtimeMocks.NewMockQueueConfigurationProvider(executionQueues, workflowConfigs), nil,
+		nil, nil, nil), repository)
+	gpuQueue := singleQueueConfiguration{
+		PrimaryQueue: "gpu primary",
+		DynamicQueue: "gpu dynamic",
+	}
+	defaultQueue := singleQueueConfiguration{
+		PrimaryQueue: "default primary",
+		DynamicQueue: "default dynamic",
+	}
+	assert.Equal(t, gpuQueue, queueAllocator.GetQueue(context.Background(), core.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "workflow",
+	}))
+	// Queues assigned in the queue configuration take precedence.
+	assert.Equal(t, defaultQueue, queueAllocator.GetQueue(context.Background(), core.Identifier{
+		Project: "configured",
+		Domain:  "domain",
+		Name:    "workflow",
+	}))
+	assert.Equal(t, defaultQueue, queueAllocator.GetQueue(context.Background(), core.Identifier{
+		Project: "project",
+		Domain:  "other",
+		Name:    "workflow",
+	}))
+}
+
+func TestGetQueue_MatchableAttributes(t *testing.T) {
+	executionQueues := []runtimeInterfaces.ExecutionQueue{
+		{
+			Primary:    "default primary",
+			Dynamic:    "default dynamic",
+			Attributes: []string{"default"},
+		},
+		{
+			Primary:    "gpu primary",
+			Dynamic:    "gpu dynamic",
+			Attributes: []string{"gpu"},
+		},
+	}
+	workflowConfigs := []runtimeInterfaces.WorkflowConfig{
+		{
+			Project: "project",
+			Domain:  "domain",
+			Tags:    []string{"default"},
+		},
+	}
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, id repoInterfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, int32(interfaces.MatchableResourceExecutionQueue), id.ResourceType)
+		if id.Workflow != "workflow" {
+			return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		}
+		return transformers.ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+			Project:  id.Project,
+			Domain:   id.Domain,
+			Workflow: id.Workflow,
+			Attributes: interfaces.MatchingAttributes{
+				ExecutionQueueAttributes: &interfaces.ExecutionQueueAttributes{
+					Tags: []string{"gpu"},
+				},
+			},
+		})
+	}
+	queueAllocator := NewQueueAllocator(runtimeMocks.NewMockConfigurationProvider(
+		nil, runtimeMocks.NewMockQueueConfigurationProvider(executionQueues, workflowConfigs), nil,
+		nil, nil, nil), repository)
+	// Execution queue attributes take precedence over the queue configuration.
+	assert.Equal(t, singleQueueConfiguration{
+		PrimaryQueue: "gpu primary",
+		DynamicQueue: "gpu dynamic",
+	}, queueAllocator.GetQueue(context.Background(), core.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "workflow",
+	}))
+	assert.Equal(t, singleQueueConfiguration{
+		PrimaryQueue: "default primary",
+		DynamicQueue: "default dynamic",
+	}, queueAllocator.GetQueue(context.Background(), core.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "other",
+	}))
+}
@@ -0,0 +1,310 @@
+package executions
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+// Schedules are evaluated the way CloudWatch evaluates them: cron expressions use six fields
+// (minutes hours day-of-month month day-of-week year) and are interpreted in UTC.
+const cronFieldCount = 6
+
+// Unlike CloudWatch, cron expressions may name the timezone they're interpreted in with a prefix, e.g.
+// "CRON_TZ=Europe/Berlin 0 9 ? * MON-FRI *".
+const cronTimezonePrefix = "CRON_TZ="
+
+// Matches any value. Only valid for the day-of-month and day-of-week fields, exactly one of which must use it.
+const cronNoSpecificValue = "?"
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+// CloudWatch numbers the days of the week starting from Sunday = 1.
+var cronDayOfWeekNames = map[string]int{
+	"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+}
+
+type cronField struct {
+	min    int
+	values []bool
+}
+
+func (f cronField) matches(value int) bool {
+	index := value - f.min
+	return index >= 0 && index < len(f.values) && f.values[index]
+}
+
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if named, ok := names[strings.ToUpper(value)]; ok {
+		return named, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// Parses a single cron field made up of comma separated values, ranges (a-b), wildcards and increments (a/n).
+// The L, W and # wildcards aren't supported.
+func parseCronField(expression string, min, max int, names map[string]int) (cronField, error) {
+	field := cronField{
+		min:    min,
+		values: make([]bool, max-min+1),
+	}
+	for _, item := range strings.Split(expression, ",") {
+		rangeExpression := item
+		step := 1
+		if parts := strings.SplitN(item, "/", 2); len(parts) == 2 {
+			rangeExpression = parts[0]
+			parsedStep, err := strconv.Atoi(parts[1])
+			if err != nil || parsedStep <= 0 {
+				return cronField{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"invalid increment in cron field [%s]", expression)
+			}
+			step = parsedStep
+		}
+		start, end := min, max
+		if rangeExpression != "*" && rangeExpression != cronNoSpecificValue {
+			bounds := strings.SplitN(rangeExpression, "-", 2)
+			var err error
+			if start, err = parseCronValue(bounds[0], names); err != nil {
+				return cronField{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"unsupported value [%s] in cron field [%s]", bounds[0], expression)
+			}
+			switch {
+			case len(bounds) == 2:
+				if end, err = parseCronValue(bounds[1], names); err != nil {
+					return cronField{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+						"unsupported value [%s] in cron field [%s]", bounds[1], expression)
+				}
+			case step == 1:
+				end = start
+			}
+		}
+		if start < min || end > max || start > end {
+			return cronField{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"cron field [%s] is outside of the allowed range [%d-%d]", expression, min, max)
+		}
+		for value := start; value <= end; value += step {
+			field.values[value-min] = true
+		}
+	}
+	return field, nil
+}
+
+type cronSchedule struct {
+	minutes     cronField
+	hours       cronField
+	daysOfMonth cronField
+	months      cronField
+	daysOfWeek  cronField
+	years       cronField
+	// Whether days are matched on the day-of-week field rather than the day-of-month field.
+	matchDayOfWeek bool
+	location       *time.Location
+}
+
+// Returns whether the cron expression is prefixed with the timezone it's interpreted in.
+func HasCronTimezone(expression string) bool {
+	return strings.HasPrefix(strings.TrimSpace(expression), cronTimezonePrefix)
+}
+
+// Splits the timezone prefix, if any, off a cron expression. Returns the remaining expression along with the location
+// it's interpreted in.
+func ParseCronTimezone(expression string) (string, *time.Location, error) {
+	if !HasCronTimezone(expression) {
+		return expression, time.UTC, nil
+	}
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(expression), cronTimezonePrefix), " ", 2)
+	if len(parts) != 2 {
+		return "", nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"cron expression [%s] has a timezone but no schedule", expression)
+	}
+	// Neither an empty name nor Local, which both load successfully, name a timezone independent of the server.
+	location, err := time.LoadLocation(parts[0])
+	if err != nil || parts[0] == "" || parts[0] == "Local" {
+		return "", nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"unknown timezone [%s] in cron expression [%s]", parts[0], expression)
+	}
+	return strings.TrimSpace(parts[1]), location, nil
+}
+
+func parseCronSchedule(expression string) (cronSchedule, error) {
+	expression, location, err := ParseCronTimezone(expression)
+	if err != nil {
+		return cronSchedule{}, err
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != cronFieldCount {
+		return cronSchedule{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"cron expression [%s] must have %d fields", expression, cronFieldCount)
+	}
+	if (fields[2] == cronNoSpecificValue) == (fields[4] == cronNoSpecificValue) {
+		return cronSchedule{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"cron expression [%s] must use %s for exactly one of day-of-month and day-of-week",
+			expression, cronNoSpecificValue)
+	}
+	schedule := cronSchedule{
+		location: location,
+	}
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return cronSchedule{}, err
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return cronSchedule{}, err
+	}
+	if schedule.daysOfMonth, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return cronSchedule{}, err
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return cronSchedule{}, err
+	}
+	if schedule.daysOfWeek, err = parseCronField(fields[4], 1, 7, cronDayOfWeekNames); err != nil {
+		return cronSchedule{}, err
+	}
+	if schedule.years, err = parseCronField(fields[5], 1970, 2199, nil); err != nil {
+		return cronSchedule{}, err
+	}
+	schedule.matchDayOfWeek = fields[2] == cronNoSpecificValue
+	return schedule, nil
+}
+
+func (s cronSchedule) matchesDay(t time.Time) bool {
+	if s.matchDayOfWeek {
+		return s.daysOfWeek.matches(int(t.Weekday()) + 1)
+	}
+	return s.daysOfMonth.matches(t.Day())
+}
+
+func (s cronSchedule) matches(t time.Time) bool {
+	t = t.In(s.location)
+	return s.years.matches(t.Year()) && s.months.matches(int(t.Month())) && s.matchesDay(t) &&
+		s.hours.matches(t.Hour()) && s.minutes.matches(t.Minute())
+}
+
+func tooManyTicksError(maxTicks int) error {
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		"schedule fires more than the maximum of %d times in the requested range", maxTicks)
+}
+
+func (s cronSchedule) ticks(start, end time.Time, maxTicks int) ([]time.Time, error) {
+	var ticks []time.Time
+	t := start.In(s.location).Truncate(time.Minute)
+	if t.Before(start) {
+		t = t.Add(time.Minute)
+	}
+	// Skip ahead by the largest unit that doesn't match rather than testing every minute in the range. Skipping to a
+	// local time which daylight saving time skips over lands on the first time after it.
+	for t.Before(end) {
+		switch {
+		case !s.years.matches(t.Year()):
+			t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, s.location)
+		case !s.months.matches(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+		case !s.hours.matches(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+		case !s.minutes.matches(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			if len(ticks) == maxTicks {
+				return nil, tooManyTicksError(maxTicks)
+			}
+			ticks = append(ticks, t.UTC())
+			t = t.Add(time.Minute)
+		}
+	}
+	return ticks, nil
+}
+
+// Schedules with a timezone are evaluated by flyteadmin rather than CloudWatch, so unlike other cron expressions they
+// must be parseable when their launch plan is created.
+func ValidateCronTimezone(expression string) error {
+	if !HasCronTimezone(expression) {
+		return nil
+	}
+	_, err := parseCronSchedule(expression)
+	return err
+}
+
+// Returns whether a cron expression fires at the minute of the given time.
+func IsCronScheduleTick(expression string, t time.Time) (bool, error) {
+	schedule, err := parseCronSchedule(expression)
+	if err != nil {
+		return false, err
+	}
+	return schedule.matches(t), nil
+}
+
+func getFixedRateInterval(rate *admin.FixedRate) (time.Duration, error) {
+	if rate.GetValue() == 0 {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "fixed rate schedules must have a positive value")
+	}
+	switch rate.Unit {
+	case admin.FixedRateUnit_MINUTE:
+		return time.Duration(rate.Value) * time.Minute, nil
+	case admin.FixedRateUnit_HOUR:
+		return time.Duration(rate.Value) * time.Hour, nil
+	case admin.FixedRateUnit_DAY:
+		return time.Duration(rate.Value) * 24 * time.Hour, nil
+	}
+	return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized fixed rate unit [%v]", rate.Unit)
+}
+
+// Returns the times within [start, end) at which the schedule fires, or an error when there are more than maxTicks
+// of them. Fixed rate schedules are anchored to the start of the range since the time at which the live schedule was
+// first created isn't recorded.
+func GetScheduleTicks(schedule *admin.Schedule, start, end time.Time, maxTicks int) ([]time.Time, error) {
+	if !start.Before(end) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"start time [%v] must be before end time [%v]", start, end)
+	}
+	if schedule.GetCronExpression() != "" {
+		cron, err := parseCronSchedule(schedule.GetCronExpression())
+		if err != nil {
+			return nil, err
+		}
+		return cron.ticks(start, end, maxTicks)
+	}
+	if schedule.GetRate() == nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "schedule has neither a cron expression nor a rate")
+	}
+	interval, err := getFixedRateInterval(schedule.GetRate())
+	if err != nil {
+		return nil, err
+	}
+	var ticks []time.Time
+	for t := start.UTC(); t.Before(end); t = t.Add(interval) {
+		if len(ticks) == maxTicks {
+			return nil, tooManyTicksError(maxTicks)
+		}
+		ticks = append(ticks, t)
+	}
+	return ticks, nil
+}
+
+// Shifts the minute a cron expression fires at by the given number of minutes, wrapping around within the hour. Only
+// expressions firing at a single minute of the hour can be shifted.
+func OffsetCronMinute(expression string, minutes int) (string, error) {
+	fields := strings.Fields(expression)
+	minuteIdx := 0
+	if HasCronTimezone(expression) {
+		minuteIdx = 1
+	}
+	if len(fields) <= minuteIdx {
+		return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cron expression [%s] is empty", expression)
+	}
+	minute, err := strconv.Atoi(fields[minuteIdx])
+	if err != nil || minute < 0 || minute > 59 {
+		return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"can't offset cron expression [%s] which doesn't fire at a single minute of the hour", expression)
+	}
+	fields[minuteIdx] = strconv.Itoa((minute + minutes) % 60)
+	return strings.Join(fields, " "), nil
+}
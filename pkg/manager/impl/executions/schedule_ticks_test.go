@@ -0,0 +1,175 @@
+package executions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func getCronSchedule(expression string) *admin.Schedule {
+	return &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronExpression{
+			CronExpression: expression,
+		},
+	}
+}
+
+func TestGetScheduleTicks_Cron(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 9, 30, 0, 0, time.UTC)
+	end := time.Date(2019, time.November, 4, 9, 30, 0, 0, time.UTC)
+
+	ticks, err := GetScheduleTicks(getCronSchedule("0 10 * * ? *"), start, end, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2019, time.November, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2019, time.November, 2, 10, 0, 0, 0, time.UTC),
+		time.Date(2019, time.November, 3, 10, 0, 0, 0, time.UTC),
+	}, ticks)
+
+	// November 1st 2019 was a Friday.
+	ticks, err = GetScheduleTicks(getCronSchedule("0/30 9 ? * MON-FRI *"), start, end, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2019, time.November, 1, 9, 30, 0, 0, time.UTC),
+		time.Date(2019, time.November, 4, 9, 0, 0, 0, time.UTC),
+	}, ticks)
+
+	ticks, err = GetScheduleTicks(getCronSchedule("15 0 1,15 NOV,DEC ? 2019"), start,
+		time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2019, time.November, 15, 0, 15, 0, 0, time.UTC),
+		time.Date(2019, time.December, 1, 0, 15, 0, 0, time.UTC),
+		time.Date(2019, time.December, 15, 0, 15, 0, 0, time.UTC),
+	}, ticks)
+}
+
+func TestGetScheduleTicks_CronTimezone(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.November, 5, 0, 0, 0, 0, time.UTC)
+
+	// Daylight saving time ended in New York on November 3rd 2019.
+	ticks, err := GetScheduleTicks(getCronSchedule("CRON_TZ=America/New_York 0 9 * * ? *"), start, end, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2019, time.November, 1, 13, 0, 0, 0, time.UTC),
+		time.Date(2019, time.November, 2, 13, 0, 0, 0, time.UTC),
+		time.Date(2019, time.November, 3, 14, 0, 0, 0, time.UTC),
+		time.Date(2019, time.November, 4, 14, 0, 0, 0, time.UTC),
+	}, ticks)
+
+	ticks, err = GetScheduleTicks(getCronSchedule("CRON_TZ=Asia/Kolkata 0 9 ? * MON *"), start, end, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2019, time.November, 4, 3, 30, 0, 0, time.UTC),
+	}, ticks)
+}
+
+func TestParseCronTimezone(t *testing.T) {
+	expression, location, err := ParseCronTimezone("0 9 * * ? *")
+	assert.NoError(t, err)
+	assert.Equal(t, "0 9 * * ? *", expression)
+	assert.Equal(t, time.UTC, location)
+
+	expression, location, err = ParseCronTimezone("CRON_TZ=Europe/Berlin 0 9 * * ? *")
+	assert.NoError(t, err)
+	assert.Equal(t, "0 9 * * ? *", expression)
+	assert.Equal(t, "Europe/Berlin", location.String())
+
+	for _, expression := range []string{
+		"CRON_TZ=Europe/Berlin",
+		"CRON_TZ=Local 0 9 * * ? *",
+		"CRON_TZ= 0 9 * * ? *",
+		"CRON_TZ=Mars/Olympus_Mons 0 9 * * ? *",
+	} {
+		_, _, err = ParseCronTimezone(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestIsCronScheduleTick(t *testing.T) {
+	isTick, err := IsCronScheduleTick("CRON_TZ=America/New_York 0 9 * * ? *",
+		time.Date(2019, time.November, 4, 14, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.True(t, isTick)
+
+	isTick, err = IsCronScheduleTick("CRON_TZ=America/New_York 0 9 * * ? *",
+		time.Date(2019, time.November, 4, 13, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.False(t, isTick)
+
+	_, err = IsCronScheduleTick("CRON_TZ=America/New_York 0 9 L * ? *", time.Now())
+	assert.Error(t, err)
+}
+
+func TestValidateCronTimezone(t *testing.T) {
+	// Cron expressions without a timezone are left for CloudWatch to validate.
+	assert.NoError(t, ValidateCronTimezone("0 9 L * ? *"))
+	assert.NoError(t, ValidateCronTimezone("CRON_TZ=America/New_York 0 9 * * ? *"))
+	assert.Error(t, ValidateCronTimezone("CRON_TZ=America/New_York 0 9 L * ? *"))
+}
+
+func TestGetScheduleTicks_InvalidCron(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	for _, expression := range []string{
+		"0 10 * * *",
+		"0 10 * * * *",
+		"0 10 ? * ? *",
+		"61 10 * * ? *",
+		"0 10 L * ? *",
+		"0/0 10 * * ? *",
+		"0 10 ? * 2#1 *",
+	} {
+		_, err := GetScheduleTicks(getCronSchedule(expression), start, end, 10)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestGetScheduleTicks_FixedRate(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	schedule := &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_Rate{
+			Rate: &admin.FixedRate{
+				Value: 6,
+				Unit:  admin.FixedRateUnit_HOUR,
+			},
+		},
+	}
+	ticks, err := GetScheduleTicks(schedule, start, start.Add(24*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		start,
+		start.Add(6 * time.Hour),
+		start.Add(12 * time.Hour),
+		start.Add(18 * time.Hour),
+	}, ticks)
+
+	_, err = GetScheduleTicks(schedule, start, start.Add(24*time.Hour), 3)
+	assert.EqualError(t, err, "schedule fires more than the maximum of 3 times in the requested range")
+}
+
+func TestGetScheduleTicks_InvalidRange(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	_, err := GetScheduleTicks(getCronSchedule("0 10 * * ? *"), start, start, 10)
+	assert.Error(t, err)
+	_, err = GetScheduleTicks(&admin.Schedule{}, start, start.Add(time.Hour), 10)
+	assert.Error(t, err)
+}
+
+func TestOffsetCronMinute(t *testing.T) {
+	expression, err := OffsetCronMinute("10 9 ? * MON-FRI *", 15)
+	assert.NoError(t, err)
+	assert.Equal(t, "25 9 ? * MON-FRI *", expression)
+
+	expression, err = OffsetCronMinute("CRON_TZ=Europe/Berlin 50 9 * * ? *", 15)
+	assert.NoError(t, err)
+	assert.Equal(t, "CRON_TZ=Europe/Berlin 5 9 * * ? *", expression)
+
+	_, err = OffsetCronMinute("0/15 * * * ? *", 15)
+	assert.Error(t, err)
+	_, err = OffsetCronMinute("", 15)
+	assert.Error(t, err)
+}
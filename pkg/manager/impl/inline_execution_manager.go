@@ -0,0 +1,312 @@
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	engine "github.com/lyft/flytepropeller/pkg/compiler/common"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/pbhash"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+// Inline tasks, workflows and launch plans are registered under versions with this prefix.
+const inlineVersionPrefix = "inline-"
+
+// The number of hex characters of the spec digest which inline versions end with.
+const inlineVersionDigestLength = 32
+
+// The id of the node of workflows generated to run a single inline task.
+const inlineTaskNodeID = "task"
+
+type inlineExecutionMetrics struct {
+	Scope             promutils.Scope
+	ExecutionsCreated prometheus.Counter
+}
+
+type InlineExecutionManager struct {
+	taskManager       interfaces.TaskInterface
+	workflowManager   interfaces.WorkflowInterface
+	launchPlanManager interfaces.LaunchPlanInterface
+	executionManager  interfaces.ExecutionInterface
+	metrics           inlineExecutionMetrics
+}
+
+func validateInlineExecutionRequest(request interfaces.InlineExecutionCreateRequest) error {
+	if len(request.Project) == 0 {
+		return shared.GetMissingArgumentError(shared.Project)
+	}
+	if len(request.Domain) == 0 {
+		return shared.GetMissingArgumentError(shared.Domain)
+	}
+	if request.Workflow == nil && len(request.Tasks) != 1 {
+		return errors.NewFlyteAdminError(codes.InvalidArgument,
+			"a workflow must be set unless exactly one task is launched")
+	}
+	for idx, task := range request.Tasks {
+		if len(task.GetTemplate().GetId().GetName()) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "task at index %d has no name", idx)
+		}
+	}
+	if request.Workflow != nil && len(request.Workflow.GetTemplate().GetId().GetName()) == 0 {
+		return errors.NewFlyteAdminError(codes.InvalidArgument, "workflow has no name")
+	}
+	return nil
+}
+
+func getInlineIdentifier(resourceType core.ResourceType, project, domain, name string) *core.Identifier {
+	return &core.Identifier{
+		ResourceType: resourceType,
+		Project:      project,
+		Domain:       domain,
+		Name:         name,
+	}
+}
+
+// Points task nodes referencing an inline task by name at the inline task and returns the identifiers it set.
+func setInlineTaskReferences(
+	node *core.Node, project, domain string, inlineTaskNames map[string]bool) []*core.Identifier {
+	if node == nil {
+		return nil
+	}
+	identifiers := make([]*core.Identifier, 0)
+	if taskNode := node.GetTaskNode(); taskNode != nil {
+		reference := taskNode.GetReferenceId()
+		if inlineTaskNames[reference.GetName()] && (len(reference.GetProject()) == 0 || reference.Project == project) &&
+			(len(reference.GetDomain()) == 0 || reference.Domain == domain) {
+			identifier := getInlineIdentifier(core.ResourceType_TASK, project, domain, reference.Name)
+			taskNode.Reference = &core.TaskNode_ReferenceId{
+				ReferenceId: identifier,
+			}
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	ifElse := node.GetBranchNode().GetIfElse()
+	branchNodes := []*core.Node{ifElse.GetCase().GetThenNode(), ifElse.GetElseNode()}
+	for _, other := range ifElse.GetOther() {
+		branchNodes = append(branchNodes, other.GetThenNode())
+	}
+	for _, branchNode := range branchNodes {
+		identifiers = append(identifiers, setInlineTaskReferences(branchNode, project, domain, inlineTaskNames)...)
+	}
+	return identifiers
+}
+
+func getSortedVariableNames(variables *core.VariableMap) []string {
+	names := make([]string, 0, len(variables.GetVariables()))
+	for name := range variables.GetVariables() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getPromiseBinding(variable, nodeID, nodeVariable string) *core.Binding {
+	return &core.Binding{
+		Var: variable,
+		Binding: &core.BindingData{
+			Value: &core.BindingData_Promise{
+				Promise: &core.OutputReference{
+					NodeId: nodeID,
+					Var:    nodeVariable,
+				},
+			},
+		},
+	}
+}
+
+// Returns a workflow with the interface of the task which passes its inputs to a single node running the task and
+// outputs the node's outputs.
+func getSingleTaskWorkflow(task *core.TaskTemplate, workflowID, taskID *core.Identifier) *admin.WorkflowSpec {
+	taskInterface := task.GetInterface()
+	inputs := make([]*core.Binding, 0, len(taskInterface.GetInputs().GetVariables()))
+	for _, name := range getSortedVariableNames(taskInterface.GetInputs()) {
+		inputs = append(inputs, getPromiseBinding(name, engine.StartNodeID, name))
+	}
+	outputs := make([]*core.Binding, 0, len(taskInterface.GetOutputs().GetVariables()))
+	for _, name := range getSortedVariableNames(taskInterface.GetOutputs()) {
+		outputs = append(outputs, getPromiseBinding(name, inlineTaskNodeID, name))
+	}
+	return &admin.WorkflowSpec{
+		Template: &core.WorkflowTemplate{
+			Id:        workflowID,
+			Interface: taskInterface,
+			Nodes: []*core.Node{
+				{
+					Id:     inlineTaskNodeID,
+					Inputs: inputs,
+					Target: &core.Node_TaskNode{
+						TaskNode: &core.TaskNode{
+							Reference: &core.TaskNode_ReferenceId{
+								ReferenceId: taskID,
+							},
+						},
+					},
+				},
+			},
+			Outputs: outputs,
+		},
+	}
+}
+
+// Returns a version which is the same for the same inline specs.
+func getInlineVersion(ctx context.Context, tasks []*admin.TaskSpec, workflow *admin.WorkflowSpec) (string, error) {
+	hash := sha256.New()
+	specs := make([]proto.Message, 0, len(tasks)+1)
+	for _, task := range tasks {
+		specs = append(specs, task)
+	}
+	specs = append(specs, workflow)
+	for _, spec := range specs {
+		digest, err := pbhash.ComputeHash(ctx, spec)
+		if err != nil {
+			return "", errors.NewFlyteAdminErrorf(codes.Internal, "failed to hash inline spec with err %v", err)
+		}
+		if _, err := hash.Write(digest); err != nil {
+			return "", errors.NewFlyteAdminErrorf(codes.Internal, "failed to hash inline spec with err %v", err)
+		}
+	}
+	return inlineVersionPrefix + hex.EncodeToString(hash.Sum(nil))[:inlineVersionDigestLength], nil
+}
+
+// Registering entities which are already registered with the same spec isn't an error.
+func ignoreAlreadyExists(err error) error {
+	if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+func (m *InlineExecutionManager) register(ctx context.Context, tasks []*admin.TaskSpec, workflow *admin.WorkflowSpec,
+	launchPlanID *core.Identifier) error {
+	for _, task := range tasks {
+		_, err := m.taskManager.CreateTask(ctx, admin.TaskCreateRequest{
+			Id:   task.Template.Id,
+			Spec: task,
+		})
+		if err = ignoreAlreadyExists(err); err != nil {
+			return err
+		}
+	}
+	_, err := m.workflowManager.CreateWorkflow(ctx, admin.WorkflowCreateRequest{
+		Id:   workflow.Template.Id,
+		Spec: workflow,
+	})
+	if err = ignoreAlreadyExists(err); err != nil {
+		return err
+	}
+	_, err = m.launchPlanManager.CreateLaunchPlan(ctx, admin.LaunchPlanCreateRequest{
+		Id: launchPlanID,
+		Spec: &admin.LaunchPlanSpec{
+			WorkflowId: workflow.Template.Id,
+		},
+	})
+	if err = ignoreAlreadyExists(err); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *InlineExecutionManager) CreateInlineExecution(
+	ctx context.Context, request interfaces.InlineExecutionCreateRequest, requestedAt time.Time) (
+	*interfaces.InlineExecutionCreateResponse, error) {
+	if err := validateInlineExecutionRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid inline execution request [%s/%s]: %v", request.Project, request.Domain, err)
+		return nil, err
+	}
+	// The identifiers of everything registered, whose versions are set once the specs referencing them are final.
+	identifiers := make([]*core.Identifier, 0, len(request.Tasks)+2)
+	tasks := make([]*admin.TaskSpec, len(request.Tasks))
+	inlineTaskNames := make(map[string]bool)
+	for idx, task := range request.Tasks {
+		tasks[idx] = proto.Clone(task).(*admin.TaskSpec)
+		name := task.Template.Id.Name
+		tasks[idx].Template.Id = getInlineIdentifier(core.ResourceType_TASK, request.Project, request.Domain, name)
+		identifiers = append(identifiers, tasks[idx].Template.Id)
+		inlineTaskNames[name] = true
+	}
+	var workflow *admin.WorkflowSpec
+	if request.Workflow != nil {
+		workflow = proto.Clone(request.Workflow).(*admin.WorkflowSpec)
+		workflow.Template.Id = getInlineIdentifier(
+			core.ResourceType_WORKFLOW, request.Project, request.Domain, request.Workflow.Template.Id.Name)
+		for _, node := range workflow.Template.Nodes {
+			identifiers = append(identifiers,
+				setInlineTaskReferences(node, request.Project, request.Domain, inlineTaskNames)...)
+		}
+	} else {
+		task := tasks[0].Template
+		workflowID := getInlineIdentifier(core.ResourceType_WORKFLOW, request.Project, request.Domain, task.Id.Name)
+		taskID := getInlineIdentifier(core.ResourceType_TASK, request.Project, request.Domain, task.Id.Name)
+		identifiers = append(identifiers, taskID)
+		workflow = getSingleTaskWorkflow(task, workflowID, taskID)
+	}
+	identifiers = append(identifiers, workflow.Template.Id)
+
+	version, err := getInlineVersion(ctx, tasks, workflow)
+	if err != nil {
+		return nil, err
+	}
+	for _, identifier := range identifiers {
+		identifier.Version = version
+	}
+	launchPlanID := getInlineIdentifier(
+		core.ResourceType_LAUNCH_PLAN, request.Project, request.Domain, workflow.Template.Id.Name)
+	launchPlanID.Version = version
+	if err := m.register(ctx, tasks, workflow, launchPlanID); err != nil {
+		logger.Infof(ctx, "Failed to register inline workflow [%+v] with err %v", workflow.Template.Id, err)
+		return nil, err
+	}
+
+	spec := &admin.ExecutionSpec{}
+	if request.Spec != nil {
+		spec = proto.Clone(request.Spec).(*admin.ExecutionSpec)
+	}
+	spec.LaunchPlan = launchPlanID
+	response, err := m.executionManager.CreateExecution(ctx, admin.ExecutionCreateRequest{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Name:    request.Name,
+		Spec:    spec,
+		Inputs:  request.Inputs,
+	}, requestedAt)
+	if err != nil {
+		return nil, err
+	}
+	m.metrics.ExecutionsCreated.Inc()
+	logger.Debugf(ctx, "Launched inline workflow [%+v] as [%+v]", workflow.Template.Id, response.Id)
+	return &interfaces.InlineExecutionCreateResponse{
+		ID:      response.Id,
+		Version: version,
+	}, nil
+}
+
+func NewInlineExecutionManager(
+	taskManager interfaces.TaskInterface, workflowManager interfaces.WorkflowInterface,
+	launchPlanManager interfaces.LaunchPlanInterface, executionManager interfaces.ExecutionInterface,
+	scope promutils.Scope) interfaces.InlineExecutionInterface {
+	metrics := inlineExecutionMetrics{
+		Scope: scope,
+		ExecutionsCreated: scope.MustNewCounter("executions_created",
+			"overall count of inline executions which were launched"),
+	}
+	return &InlineExecutionManager{
+		taskManager:       taskManager,
+		workflowManager:   workflowManager,
+		launchPlanManager: launchPlanManager,
+		executionManager:  executionManager,
+		metrics:           metrics,
+	}
+}
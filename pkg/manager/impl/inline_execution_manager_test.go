@@ -0,0 +1,237 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+func getInlineTaskSpec(name string) *admin.TaskSpec {
+	return &admin.TaskSpec{
+		Template: &core.TaskTemplate{
+			Id: &core.Identifier{
+				Name: name,
+			},
+			Type: "python",
+			Interface: &core.TypedInterface{
+				Inputs: &core.VariableMap{
+					Variables: map[string]*core.Variable{
+						"x": {
+							Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}},
+						},
+					},
+				},
+				Outputs: &core.VariableMap{
+					Variables: map[string]*core.Variable{
+						"y": {
+							Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}},
+						},
+					},
+				},
+			},
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{
+					Image: "image",
+				},
+			},
+		},
+	}
+}
+
+func getInlineTaskNode(nodeID string, taskID *core.Identifier) *core.Node {
+	return &core.Node{
+		Id: nodeID,
+		Target: &core.Node_TaskNode{
+			TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{
+					ReferenceId: taskID,
+				},
+			},
+		},
+	}
+}
+
+type inlineExecutionRecorder struct {
+	tasks       []admin.TaskCreateRequest
+	workflows   []admin.WorkflowCreateRequest
+	launchPlans []admin.LaunchPlanCreateRequest
+	executions  []admin.ExecutionCreateRequest
+}
+
+func getMockInlineExecutionManager(
+	recorder *inlineExecutionRecorder, taskErr error) interfaces.InlineExecutionInterface {
+	taskManager := mocks.MockTaskManager{}
+	taskManager.SetCreateCallback(func(ctx context.Context, request admin.TaskCreateRequest) (
+		*admin.TaskCreateResponse, error) {
+		recorder.tasks = append(recorder.tasks, request)
+		return nil, taskErr
+	})
+	workflowManager := mocks.MockWorkflowManager{}
+	workflowManager.SetCreateCallback(func(ctx context.Context, request admin.WorkflowCreateRequest) (
+		*admin.WorkflowCreateResponse, error) {
+		recorder.workflows = append(recorder.workflows, request)
+		return &admin.WorkflowCreateResponse{}, nil
+	})
+	launchPlanManager := mocks.MockLaunchPlanManager{}
+	launchPlanManager.SetCreateCallback(func(ctx context.Context, request admin.LaunchPlanCreateRequest) (
+		*admin.LaunchPlanCreateResponse, error) {
+		recorder.launchPlans = append(recorder.launchPlans, request)
+		return &admin.LaunchPlanCreateResponse{}, nil
+	})
+	executionManager := mocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		recorder.executions = append(recorder.executions, request)
+		return &admin.ExecutionCreateResponse{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: request.Project,
+				Domain:  request.Domain,
+				Name:    "name",
+			},
+		}, nil
+	})
+	return NewInlineExecutionManager(&taskManager, &workflowManager, &launchPlanManager, &executionManager,
+		mockScope.NewTestScope())
+}
+
+func TestCreateInlineExecution_SingleTask(t *testing.T) {
+	recorder := &inlineExecutionRecorder{}
+	// Tasks which are already registered are reused.
+	manager := getMockInlineExecutionManager(
+		recorder, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "task already exists"))
+	request := interfaces.InlineExecutionCreateRequest{
+		Project: "project",
+		Domain:  "domain",
+		Tasks:   []*admin.TaskSpec{getInlineTaskSpec("t")},
+		Spec: &admin.ExecutionSpec{
+			Labels: &admin.Labels{
+				Values: map[string]string{"foo": "bar"},
+			},
+		},
+	}
+	response, err := manager.CreateInlineExecution(context.Background(), request, time.Now())
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(response.Version, inlineVersionPrefix))
+	assert.Equal(t, "name", response.ID.Name)
+
+	taskID := &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "t",
+		Version:      response.Version,
+	}
+	assert.Len(t, recorder.tasks, 1)
+	assert.Equal(t, taskID, recorder.tasks[0].Id)
+	assert.Len(t, recorder.workflows, 1)
+	workflow := recorder.workflows[0].Spec.Template
+	assert.Equal(t, core.ResourceType_WORKFLOW, workflow.Id.ResourceType)
+	assert.Equal(t, "t", workflow.Id.Name)
+	assert.Equal(t, response.Version, workflow.Id.Version)
+	assert.Len(t, workflow.Nodes, 1)
+	assert.Equal(t, taskID, workflow.Nodes[0].GetTaskNode().GetReferenceId())
+	assert.Equal(t, "start-node", workflow.Nodes[0].Inputs[0].Binding.GetPromise().NodeId)
+	assert.Equal(t, inlineTaskNodeID, workflow.Outputs[0].Binding.GetPromise().NodeId)
+	assert.Len(t, recorder.launchPlans, 1)
+	assert.Equal(t, workflow.Id, recorder.launchPlans[0].Spec.WorkflowId)
+	assert.Len(t, recorder.executions, 1)
+	assert.Equal(t, recorder.launchPlans[0].Id, recorder.executions[0].Spec.LaunchPlan)
+	assert.Equal(t, "bar", recorder.executions[0].Spec.Labels.Values["foo"])
+	assert.Nil(t, request.Spec.LaunchPlan)
+	assert.Empty(t, request.Tasks[0].Template.Id.Version)
+
+	// Launching the same specs again registers them under the same version.
+	secondResponse, err := manager.CreateInlineExecution(context.Background(), request, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, response.Version, secondResponse.Version)
+}
+
+func TestCreateInlineExecution_Workflow(t *testing.T) {
+	recorder := &inlineExecutionRecorder{}
+	manager := getMockInlineExecutionManager(recorder, nil)
+	registeredTaskID := &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "registered",
+		Version:      "v1",
+	}
+	response, err := manager.CreateInlineExecution(context.Background(), interfaces.InlineExecutionCreateRequest{
+		Project: "project",
+		Domain:  "domain",
+		Tasks:   []*admin.TaskSpec{getInlineTaskSpec("t")},
+		Workflow: &admin.WorkflowSpec{
+			Template: &core.WorkflowTemplate{
+				Id: &core.Identifier{
+					Name: "wf",
+				},
+				Nodes: []*core.Node{
+					getInlineTaskNode("a", &core.Identifier{Name: "t"}),
+					getInlineTaskNode("b", registeredTaskID),
+				},
+			},
+		},
+	}, time.Now())
+	assert.NoError(t, err)
+
+	workflow := recorder.workflows[0].Spec.Template
+	assert.Equal(t, "wf", workflow.Id.Name)
+	assert.Equal(t, response.Version, workflow.Id.Version)
+	assert.Equal(t, &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "t",
+		Version:      response.Version,
+	}, workflow.Nodes[0].GetTaskNode().GetReferenceId())
+	assert.Equal(t, registeredTaskID, workflow.Nodes[1].GetTaskNode().GetReferenceId())
+	assert.Equal(t, "wf", recorder.launchPlans[0].Id.Name)
+}
+
+func TestCreateInlineExecution_InvalidRequest(t *testing.T) {
+	manager := getMockInlineExecutionManager(&inlineExecutionRecorder{}, nil)
+	for _, request := range []interfaces.InlineExecutionCreateRequest{
+		{
+			Domain: "domain",
+			Tasks:  []*admin.TaskSpec{getInlineTaskSpec("t")},
+		},
+		{
+			Project: "project",
+			Domain:  "domain",
+			Tasks:   []*admin.TaskSpec{getInlineTaskSpec("t"), getInlineTaskSpec("u")},
+		},
+		{
+			Project: "project",
+			Domain:  "domain",
+			Tasks:   []*admin.TaskSpec{getInlineTaskSpec("")},
+		},
+	} {
+		_, err := manager.CreateInlineExecution(context.Background(), request, time.Now())
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestCreateInlineExecution_RegistrationError(t *testing.T) {
+	recorder := &inlineExecutionRecorder{}
+	manager := getMockInlineExecutionManager(
+		recorder, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "task is invalid"))
+	_, err := manager.CreateInlineExecution(context.Background(), interfaces.InlineExecutionCreateRequest{
+		Project: "project",
+		Domain:  "domain",
+		Tasks:   []*admin.TaskSpec{getInlineTaskSpec("t")},
+	}, time.Now())
+	assert.EqualError(t, err, "task is invalid")
+	assert.Empty(t, recorder.workflows)
+	assert.Empty(t, recorder.executions)
+}
@@ -3,7 +3,10 @@ package impl
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/lyft/flyteadmin/pkg/async/schedule/aws"
 
@@ -15,8 +18,10 @@ import (
 	"github.com/lyft/flytestdlib/logger"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
@@ -27,6 +32,7 @@ import (
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytepropeller/pkg/compiler/validators"
 	"google.golang.org/grpc/codes"
 )
 
@@ -81,6 +87,10 @@ func (m *LaunchPlanManager) CreateLaunchPlan(
 	existingLaunchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, *request.Id)
 	if err == nil {
 		if bytes.Equal(existingLaunchPlanModel.Digest, launchPlanDigest) {
+			if m.config.ApplicationConfiguration().GetTopLevelConfig().IdempotentRegistration {
+				logger.Debugf(ctx, "identical launch plan already exists with id [%+v]", request.Id)
+				return &admin.LaunchPlanCreateResponse{}, nil
+			}
 			return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
 				"identical launch plan already exists with id %s", request.Id)
 		}
@@ -97,7 +107,22 @@ func (m *LaunchPlanManager) CreateLaunchPlan(
 			request, workflowInterface.Outputs, err)
 		return nil, err
 	}
-	err = m.db.LaunchPlanRepo().Create(ctx, launchPlanModel)
+	autoActivate, err := m.isAutoActivated(request)
+	if err != nil {
+		return nil, err
+	}
+	if autoActivate {
+		// Registering and activating the new version happen in a single transaction so that clients never observe the
+		// new version inactive, nor race with each other activating versions in two steps.
+		err = m.db.Transaction(ctx, func(ctx context.Context) error {
+			if err := m.db.LaunchPlanRepo().Create(ctx, launchPlanModel); err != nil {
+				return err
+			}
+			return m.activateLaunchPlanModel(ctx, launchPlanModel)
+		})
+	} else {
+		err = m.db.LaunchPlanRepo().Create(ctx, launchPlanModel)
+	}
 	if err != nil {
 		logger.Errorf(ctx, "Failed to save launch plan model %+v with err: %v", request.Id, err)
 		return nil, err
@@ -107,6 +132,19 @@ func (m *LaunchPlanManager) CreateLaunchPlan(
 	return &admin.LaunchPlanCreateResponse{}, nil
 }
 
+// Launch plans are activated when they're registered if they opt in, or if their project does by default and they
+// don't opt out.
+func (m *LaunchPlanManager) isAutoActivated(request admin.LaunchPlanCreateRequest) (bool, error) {
+	var projectDefault bool
+	for _, project := range m.config.ApplicationConfiguration().GetTopLevelConfig().AutoActivateLaunchPlanProjects {
+		if project == request.Id.Project {
+			projectDefault = true
+			break
+		}
+	}
+	return common.IsAutoActivated(request.Spec, projectDefault)
+}
+
 func (m *LaunchPlanManager) updateLaunchPlanModelState(launchPlan *models.LaunchPlan, state admin.LaunchPlanState) error {
 	var launchPlanClosure admin.LaunchPlanClosure
 	err := proto.Unmarshal(launchPlan.Closure, &launchPlanClosure)
@@ -144,6 +182,31 @@ func isScheduleEmpty(launchPlanSpec admin.LaunchPlanSpec) bool {
 	return true
 }
 
+func isSchedulePaused(launchPlan models.LaunchPlan) bool {
+	return launchPlan.ScheduleState != nil &&
+		interfaces.LaunchPlanScheduleState(*launchPlan.ScheduleState) == interfaces.LaunchPlanScheduleStatePaused
+}
+
+// Returns the audit records of launch plan versions having transitioned to their current state, attributed to the
+// authenticated user making the request.
+func getLaunchPlanStateChanges(ctx context.Context, launchPlans ...models.LaunchPlan) []models.LaunchPlanStateChange {
+	principal := auth.GetUserEmail(ctx)
+	occurredAt := time.Now().UTC()
+	changes := make([]models.LaunchPlanStateChange, len(launchPlans))
+	for idx, launchPlan := range launchPlans {
+		changes[idx] = models.LaunchPlanStateChange{
+			Project:    launchPlan.Project,
+			Domain:     launchPlan.Domain,
+			Name:       launchPlan.Name,
+			Version:    launchPlan.Version,
+			State:      *launchPlan.State,
+			Principal:  principal,
+			OccurredAt: occurredAt,
+		}
+	}
+	return changes
+}
+
 func (m *LaunchPlanManager) enableSchedule(ctx context.Context, launchPlanIdentifier admin.NamedEntityIdentifier,
 	launchPlanSpec admin.LaunchPlanSpec) error {
 
@@ -251,7 +314,12 @@ func (m *LaunchPlanManager) disableLaunchPlan(ctx context.Context, request admin
 			return nil, err
 		}
 	}
-	err = m.db.LaunchPlanRepo().Update(ctx, launchPlanModel)
+	err = m.db.Transaction(ctx, func(ctx context.Context) error {
+		if err := m.db.LaunchPlanRepo().Update(ctx, launchPlanModel); err != nil {
+			return err
+		}
+		return m.db.LaunchPlanRepo().CreateStateChanges(ctx, getLaunchPlanStateChanges(ctx, launchPlanModel))
+	})
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update launchPlanModel with ID [%+v] with err %v", request.Id, err)
 		return nil, err
@@ -272,16 +340,25 @@ func (m *LaunchPlanManager) enableLaunchPlan(ctx context.Context, request admin.
 		logger.Debugf(ctx, "Failed to find launch plan to enable with id [%+v] and err %v", request.Id, err)
 		return nil, err
 	}
+	if err = m.activateLaunchPlanModel(ctx, newlyActiveLaunchPlanModel); err != nil {
+		return nil, err
+	}
+	return &admin.LaunchPlanUpdateResponse{}, nil
+}
+
+// Activates a launch plan version, deactivating the formerly active version of the launch plan, if any.
+func (m *LaunchPlanManager) activateLaunchPlanModel(
+	ctx context.Context, newlyActiveLaunchPlanModel models.LaunchPlan) error {
 	// Set desired launch plan version to active:
-	err = m.updateLaunchPlanModelState(&newlyActiveLaunchPlanModel, admin.LaunchPlanState_ACTIVE)
+	err := m.updateLaunchPlanModelState(&newlyActiveLaunchPlanModel, admin.LaunchPlanState_ACTIVE)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Find currently active version, if it exists.
 	filters, err := util.GetActiveLaunchPlanVersionFilters(newlyActiveLaunchPlanModel.Project, newlyActiveLaunchPlanModel.Domain, newlyActiveLaunchPlanModel.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	formerlyActiveLaunchPlanModelOutput, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
 		InlineFilters: filters,
@@ -291,36 +368,66 @@ func (m *LaunchPlanManager) enableLaunchPlan(ctx context.Context, request admin.
 	if err != nil {
 		// Not found is fine, there isn't always a guaranteed active launch plan model.
 		if err.(errors.FlyteAdminError).Code() != codes.NotFound {
-			logger.Infof(ctx, "Failed to search for an active launch plan model with project: %s, domain: %s, name: %s and err %v",
-				request.Id.Project, request.Id.Domain, request.Id.Name, err)
-			return nil, err
+			logger.Infof(ctx,
+				"Failed to search for an active launch plan model with project: %s, domain: %s, name: %s and err %v",
+				newlyActiveLaunchPlanModel.Project, newlyActiveLaunchPlanModel.Domain, newlyActiveLaunchPlanModel.Name, err)
+			return err
 		}
 		logger.Debugf(ctx, "No active launch plan model found to disable with project: %s, domain: %s, name: %s",
-			request.Id.Project, request.Id.Domain, request.Id.Name)
+			newlyActiveLaunchPlanModel.Project, newlyActiveLaunchPlanModel.Domain, newlyActiveLaunchPlanModel.Name)
 	} else if formerlyActiveLaunchPlanModelOutput.LaunchPlans != nil &&
 		len(formerlyActiveLaunchPlanModelOutput.LaunchPlans) > 0 {
 		formerlyActiveLaunchPlanModel = &formerlyActiveLaunchPlanModelOutput.LaunchPlans[0]
 		err = m.updateLaunchPlanModelState(formerlyActiveLaunchPlanModel, admin.LaunchPlanState_INACTIVE)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
+	// Paused schedules stay paused when another version of the launch plan with a schedule is activated.
+	var newlyActiveLaunchPlanSpec admin.LaunchPlanSpec
+	if err = proto.Unmarshal(newlyActiveLaunchPlanModel.Spec, &newlyActiveLaunchPlanSpec); err != nil {
+		logger.Errorf(ctx, "failed to unmarshal newly enabled launch plan spec")
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal newly enabled launch plan spec")
+	}
+	scheduleState := int32(interfaces.LaunchPlanScheduleStateActive)
+	if formerlyActiveLaunchPlanModel != nil && isSchedulePaused(*formerlyActiveLaunchPlanModel) &&
+		!isScheduleEmpty(newlyActiveLaunchPlanSpec) {
+		scheduleState = int32(interfaces.LaunchPlanScheduleStatePaused)
+	}
+	newlyActiveLaunchPlanModel.ScheduleState = &scheduleState
 	err = m.updateSchedules(ctx, newlyActiveLaunchPlanModel, formerlyActiveLaunchPlanModel)
+	if err == nil && isSchedulePaused(newlyActiveLaunchPlanModel) {
+		// Pausing is a no-op for schedules left untouched by the update, which are paused already.
+		err = m.scheduler.PauseSchedule(ctx, admin.NamedEntityIdentifier{
+			Project: newlyActiveLaunchPlanModel.Project,
+			Domain:  newlyActiveLaunchPlanModel.Domain,
+			Name:    newlyActiveLaunchPlanModel.Name,
+		})
+	}
 	if err != nil {
 		m.metrics.FailedScheduleUpdates.Inc()
-		return nil, err
+		return err
 	}
 
 	// This operation is takes in the (formerly) active launch plan version as only one version can be active at a time.
 	// Setting the desired launch plan to active also requires disabling the existing active launch plan version.
-	err = m.db.LaunchPlanRepo().SetActive(ctx, newlyActiveLaunchPlanModel, formerlyActiveLaunchPlanModel)
+	err = m.db.Transaction(ctx, func(ctx context.Context) error {
+		err := m.db.LaunchPlanRepo().SetActive(ctx, newlyActiveLaunchPlanModel, formerlyActiveLaunchPlanModel)
+		if err != nil {
+			return err
+		}
+		changedLaunchPlanModels := []models.LaunchPlan{newlyActiveLaunchPlanModel}
+		if formerlyActiveLaunchPlanModel != nil {
+			changedLaunchPlanModels = []models.LaunchPlan{*formerlyActiveLaunchPlanModel, newlyActiveLaunchPlanModel}
+		}
+		return m.db.LaunchPlanRepo().CreateStateChanges(ctx, getLaunchPlanStateChanges(ctx, changedLaunchPlanModels...))
+	})
 	if err != nil {
-		logger.Debugf(ctx,
-			"Failed to set launchPlanModel with ID [%+v] to active with err %v", request.Id, err)
-		return nil, err
+		logger.Debugf(ctx, "Failed to set launchPlanModel with ID [%+v] to active with err %v",
+			newlyActiveLaunchPlanModel.LaunchPlanKey, err)
+		return err
 	}
-	return &admin.LaunchPlanUpdateResponse{}, nil
-
+	return nil
 }
 
 func (m *LaunchPlanManager) UpdateLaunchPlan(ctx context.Context, request admin.LaunchPlanUpdateRequest) (
@@ -388,6 +495,12 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request admin.R
 		logger.Debugf(ctx, "")
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.LaunchPlanListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project:        request.Id.Project,
@@ -447,6 +560,12 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request a
 		logger.Debugf(ctx, "")
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.LaunchPlanListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	filters, err := util.ListActiveLaunchPlanVersionsFilters(request.Project, request.Domain)
 	if err != nil {
@@ -496,6 +615,12 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request a
 // At least project name and domain must be specified along with limit.
 func (m *LaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 	*admin.NamedEntityIdentifierList, error) {
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.LaunchPlanListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project: request.Project,
@@ -538,6 +663,66 @@ func (m *LaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request admin
 	}, nil
 }
 
+// Pauses or resumes the schedule of the active version of a launch plan, which remains active either way.
+func (m *LaunchPlanManager) UpdateLaunchPlanScheduleState(
+	ctx context.Context, request interfaces.LaunchPlanScheduleStateUpdateRequest) (
+	*interfaces.LaunchPlanScheduleStateUpdateResponse, error) {
+	if err := validation.ValidateLaunchPlanScheduleStateUpdateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	filters, err := util.GetActiveLaunchPlanVersionFilters(request.ID.Project, request.ID.Domain, request.ID.Name)
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         1,
+		InlineFilters: filters,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list active launch plan for request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	if len(output.LaunchPlans) != 1 {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "No active launch plan could be found: %s:%s:%s",
+			request.ID.Project, request.ID.Domain, request.ID.Name)
+	}
+	launchPlanModel := output.LaunchPlans[0]
+	var launchPlanSpec admin.LaunchPlanSpec
+	if err = proto.Unmarshal(launchPlanModel.Spec, &launchPlanSpec); err != nil {
+		logger.Errorf(ctx, "failed to unmarshal spec of launch plan [%+v] with err: %v", launchPlanModel.LaunchPlanKey, err)
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal launch plan spec")
+	}
+	if isScheduleEmpty(launchPlanSpec) {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"active launch plan [%s:%s:%s] has no schedule to pause or resume",
+			request.ID.Project, request.ID.Domain, request.ID.Name)
+	}
+	if isSchedulePaused(launchPlanModel) == (request.State == interfaces.LaunchPlanScheduleStatePaused) {
+		return &interfaces.LaunchPlanScheduleStateUpdateResponse{}, nil
+	}
+	if request.State == interfaces.LaunchPlanScheduleStatePaused {
+		err = m.scheduler.PauseSchedule(ctx, *request.ID)
+	} else {
+		err = m.scheduler.ResumeSchedule(ctx, *request.ID)
+	}
+	if err != nil {
+		m.metrics.FailedScheduleUpdates.Inc()
+		return nil, err
+	}
+	scheduleState := int32(request.State)
+	err = m.db.LaunchPlanRepo().Update(ctx, models.LaunchPlan{
+		LaunchPlanKey: launchPlanModel.LaunchPlanKey,
+		ScheduleState: &scheduleState,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to update the schedule state of launch plan [%+v] with err %v",
+			launchPlanModel.LaunchPlanKey, err)
+		return nil, err
+	}
+	return &interfaces.LaunchPlanScheduleStateUpdateResponse{}, nil
+}
+
 func NewLaunchPlanManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration,
@@ -558,3 +743,191 @@ func NewLaunchPlanManager(
 		metrics:   metrics,
 	}
 }
+
+// Lists the ACTIVE/INACTIVE transitions of all versions of a launch plan, most recent first, e.g. to find out who
+// disabled a launch plan and when.
+func (m *LaunchPlanManager) ListLaunchPlanStateHistory(
+	ctx context.Context, request interfaces.LaunchPlanStateHistoryListRequest) (
+	*interfaces.LaunchPlanStateHistory, error) {
+	if err := validation.ValidateNamedEntityIdentifier(request.ID); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.LaunchPlanListLimits)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListLaunchPlanStateHistory", request.Token)
+	}
+	changes, err := m.db.LaunchPlanRepo().ListStateChanges(ctx, repoInterfaces.ListStateChangesInput{
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
+		Name:    request.ID.Name,
+		Limit:   int(limit),
+		Offset:  offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list launch plan state changes for request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	history := &interfaces.LaunchPlanStateHistory{
+		Changes: make([]interfaces.LaunchPlanStateChange, len(changes)),
+	}
+	for idx, change := range changes {
+		history.Changes[idx] = interfaces.LaunchPlanStateChange{
+			Version:    change.Version,
+			State:      admin.LaunchPlanState(change.State).String(),
+			Principal:  change.Principal,
+			OccurredAt: change.OccurredAt,
+		}
+	}
+	if len(changes) == int(limit) {
+		history.Token = strconv.Itoa(offset + len(changes))
+	}
+	return history, nil
+}
+
+// Resolves the inputs of a launch plan version the way they're resolved when an execution is created, so that clients
+// can render launch forms without re-deriving the precedence of launch plan defaults, project-domain defaults and
+// fixed inputs.
+func (m *LaunchPlanManager) GetLaunchPlanInputs(
+	ctx context.Context, request admin.ObjectGetRequest) (*interfaces.LaunchPlanInputs, error) {
+	if err := validation.ValidateIdentifier(request.Id, common.LaunchPlan); err != nil {
+		logger.Debugf(ctx, "can't get inputs of launch plan [%+v] with invalid identifier: %v", request.Id, err)
+		return nil, err
+	}
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, *request.Id)
+	if err != nil {
+		return nil, err
+	}
+	projectDomainAttributes, err := util.GetProjectDomainAttributes(ctx, m.db, request.Id.Project, request.Id.Domain)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get attributes of [%s/%s] with err %v", request.Id.Project, request.Id.Domain, err)
+		return nil, err
+	}
+	inputs := make([]interfaces.LaunchPlanInput, 0)
+	for name, expectedInput := range launchPlan.GetClosure().GetExpectedInputs().GetParameters() {
+		input := interfaces.LaunchPlanInput{
+			Name:        name,
+			Description: expectedInput.GetVar().GetDescription(),
+			Type:        expectedInput.GetVar().GetType(),
+			Overridable: true,
+		}
+		projectDomainDefault, err := validation.GetProjectDomainDefaultInput(
+			name, expectedInput, projectDomainAttributes)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case projectDomainDefault != nil:
+			input.Default = projectDomainDefault
+			input.DefaultSource = interfaces.LaunchPlanInputDefaultSourceProjectDomain
+		case expectedInput.GetRequired():
+			input.Required = true
+		case expectedInput.GetDefault() != nil:
+			input.Default = expectedInput.GetDefault()
+			input.DefaultSource = interfaces.LaunchPlanInputDefaultSourceLaunchPlan
+		}
+		inputs = append(inputs, input)
+	}
+	for name, fixedInput := range launchPlan.GetSpec().GetFixedInputs().GetLiterals() {
+		inputs = append(inputs, interfaces.LaunchPlanInput{
+			Name:          name,
+			Type:          validators.LiteralTypeForLiteral(fixedInput),
+			Default:       fixedInput,
+			DefaultSource: interfaces.LaunchPlanInputDefaultSourceFixed,
+		})
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].Name < inputs[j].Name
+	})
+	return &interfaces.LaunchPlanInputs{
+		ID:     request.Id,
+		Inputs: inputs,
+	}, nil
+}
+
+// Returns the spec of a variant of a base launch plan, whose cron schedule, unless the variant replaces it, is shifted
+// by the given number of minutes.
+func getLaunchPlanVariantSpec(
+	base *admin.LaunchPlanSpec, variant interfaces.LaunchPlanVariant, staggerMinutes int) (*admin.LaunchPlanSpec, error) {
+	spec := proto.Clone(base).(*admin.LaunchPlanSpec)
+	if len(variant.FixedInputs.GetLiterals()) > 0 {
+		if spec.FixedInputs == nil || spec.FixedInputs.Literals == nil {
+			spec.FixedInputs = &core.LiteralMap{
+				Literals: make(map[string]*core.Literal, len(variant.FixedInputs.Literals)),
+			}
+		}
+		for name, literal := range variant.FixedInputs.Literals {
+			spec.FixedInputs.Literals[name] = literal
+			// Fixed inputs take the place of the defaults the base launch plan has for them.
+			if spec.DefaultInputs != nil {
+				delete(spec.DefaultInputs.Parameters, name)
+			}
+		}
+	}
+	if variant.Schedule != nil {
+		if spec.EntityMetadata == nil {
+			spec.EntityMetadata = &admin.LaunchPlanMetadata{}
+		}
+		spec.EntityMetadata.Schedule = variant.Schedule
+	} else if staggerMinutes > 0 && spec.GetEntityMetadata().GetSchedule().GetCronExpression() != "" {
+		cronExpression, err := executions.OffsetCronMinute(
+			spec.EntityMetadata.Schedule.GetCronExpression(), staggerMinutes)
+		if err != nil {
+			return nil, err
+		}
+		spec.EntityMetadata.Schedule.ScheduleExpression = &admin.Schedule_CronExpression{
+			CronExpression: cronExpression,
+		}
+	}
+	return spec, nil
+}
+
+// Creates a launch plan for every variant of a base launch plan version. Variants are created independently of each
+// other and the outcome of each is reported, so that retried requests only need to list the variants which failed.
+func (m *LaunchPlanManager) CreateLaunchPlanVariants(
+	ctx context.Context, request interfaces.LaunchPlanVariantsCreateRequest) (
+	*interfaces.LaunchPlanVariantsCreateResponse, error) {
+	if err := validation.ValidateLaunchPlanVariantsCreateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request to create variants of launch plan [%+v]: %v", request.Base, err)
+		return nil, err
+	}
+	base, err := util.GetLaunchPlan(ctx, m.db, *request.Base)
+	if err != nil {
+		return nil, err
+	}
+	response := &interfaces.LaunchPlanVariantsCreateResponse{
+		LaunchPlans: make([]interfaces.LaunchPlanVariantResult, len(request.Variants)),
+	}
+	for idx, variant := range request.Variants {
+		variantID := &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      request.Base.Project,
+			Domain:       request.Base.Domain,
+			Name:         fmt.Sprintf("%s-%s", request.Base.Name, variant.Key),
+			Version:      request.Base.Version,
+		}
+		response.LaunchPlans[idx].ID = variantID
+		spec, err := getLaunchPlanVariantSpec(base.Spec, variant, idx*request.StaggerMinutes)
+		if err == nil {
+			_, err = m.CreateLaunchPlan(ctx, admin.LaunchPlanCreateRequest{
+				Id:   variantID,
+				Spec: spec,
+			})
+		}
+		if err != nil {
+			logger.Debugf(ctx, "failed to create variant [%+v] of launch plan [%+v] with err %v",
+				variantID, request.Base, err)
+			response.LaunchPlans[idx].Error = err.Error()
+			response.Failed++
+			continue
+		}
+		response.Succeeded++
+	}
+	return response, nil
+}
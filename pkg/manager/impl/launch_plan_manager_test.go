@@ -13,9 +13,11 @@ import (
 	scheduleInterfaces "github.com/lyft/flyteadmin/pkg/async/schedule/interfaces"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/common"
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
@@ -102,6 +104,128 @@ func TestCreateLaunchPlan(t *testing.T) {
 	assert.True(t, createCalled)
 }
 
+func getMockConfigWithAutoActivateForLpTest() runtimeInterfaces.Configuration {
+	applicationConfig := testutils.GetApplicationConfigWithDefaultProjects()
+	applicationConfig.(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		AutoActivateLaunchPlanProjects: []string{project},
+	})
+	return runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+}
+
+func TestCreateLaunchPlan_Identical(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	var registeredLaunchPlan *models.LaunchPlan
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			if registeredLaunchPlan == nil {
+				return models.LaunchPlan{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "foo")
+			}
+			return *registeredLaunchPlan, nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetCreateCallback(
+		func(input models.LaunchPlan) error {
+			assert.Nil(t, registeredLaunchPlan)
+			registeredLaunchPlan = &input
+			return nil
+		})
+	setDefaultWorkflowCallbackForLpTest(repository)
+	mockConfig := getMockConfigForLpTest()
+	lpManager := NewLaunchPlanManager(repository, mockConfig, mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.CreateLaunchPlan(context.Background(), testutils.GetLaunchPlanRequest())
+	assert.Nil(t, err)
+
+	_, err = lpManager.CreateLaunchPlan(context.Background(), testutils.GetLaunchPlanRequest())
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			IdempotentRegistration: true,
+		})
+	response, err := lpManager.CreateLaunchPlan(context.Background(), testutils.GetLaunchPlanRequest())
+	assert.Nil(t, err)
+	assert.Equal(t, &admin.LaunchPlanCreateResponse{}, response)
+}
+
+func TestCreateLaunchPlan_AutoActivate(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "foo")
+		})
+	var createCalled, setActiveCalled bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetCreateCallback(
+		func(input models.LaunchPlan) error {
+			assert.Equal(t, inactive, *input.State)
+			createCalled = true
+			return nil
+		})
+	closureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: project,
+							Domain:  domain,
+							Name:    name,
+							Version: "old version",
+						},
+						State:   &active,
+						Closure: closureBytes,
+					},
+				},
+			}, nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(
+		func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+			assert.True(t, createCalled)
+			assert.Equal(t, version, toEnable.Version)
+			assert.Equal(t, active, *toEnable.State)
+			assert.Equal(t, "old version", toDisable.Version)
+			assert.Equal(t, inactive, *toDisable.State)
+			setActiveCalled = true
+			return nil
+		})
+	setDefaultWorkflowCallbackForLpTest(repository)
+	lpManager := NewLaunchPlanManager(
+		repository, getMockConfigWithAutoActivateForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.CreateLaunchPlan(context.Background(), testutils.GetLaunchPlanRequest())
+	assert.Nil(t, err)
+	assert.True(t, setActiveCalled)
+}
+
+func TestCreateLaunchPlan_AutoActivateOptOut(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "foo")
+		})
+	var createCalled bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetCreateCallback(
+		func(input models.LaunchPlan) error {
+			createCalled = true
+			return nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(
+		func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+			assert.Fail(t, "launch plans which opt out shouldn't be activated")
+			return nil
+		})
+	setDefaultWorkflowCallbackForLpTest(repository)
+	lpManager := NewLaunchPlanManager(
+		repository, getMockConfigWithAutoActivateForLpTest(), mockScheduler, mockScope.NewTestScope())
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.AutoActivateAnnotation: "false",
+		},
+	}
+	_, err := lpManager.CreateLaunchPlan(context.Background(), request)
+	assert.Nil(t, err)
+	assert.True(t, createCalled)
+}
+
 func TestLaunchPlanManager_GetLaunchPlan(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
@@ -138,6 +262,209 @@ func TestLaunchPlanManager_GetLaunchPlan(t *testing.T) {
 	assert.NotNil(t, response)
 }
 
+func TestLaunchPlanManager_GetLaunchPlanInputs(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpRequest := testutils.GetLaunchPlanRequest()
+	integerType := &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}
+	lpRequest.Spec.DefaultInputs.Parameters["baz"] = &core.Parameter{
+		Var: &core.Variable{
+			Type:        integerType,
+			Description: "required",
+		},
+		Behavior: &core.Parameter_Required{Required: true},
+	}
+	lpRequest.Spec.DefaultInputs.Parameters["qux"] = &core.Parameter{
+		Var: &core.Variable{
+			Type: integerType,
+		},
+		Behavior: &core.Parameter_Required{Required: true},
+	}
+	specBytes, _ := proto.Marshal(lpRequest.Spec)
+	closureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: lpRequest.Spec.DefaultInputs,
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec:    specBytes,
+				Closure: closureBytes,
+			}, nil
+		})
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: project,
+		Domain:  domain,
+		Attributes: map[string]string{
+			"default_input.qux": "42",
+			// Launch plan defaults take precedence over project-domain defaults.
+			"default_input.foo": "other-value",
+		},
+	})
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		return projectDomainModel, nil
+	}
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	response, err := lpManager.GetLaunchPlanInputs(context.Background(), admin.ObjectGetRequest{
+		Id: &launchPlanIdentifier,
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&launchPlanIdentifier, response.ID))
+	assert.Len(t, response.Inputs, 4)
+
+	assert.Equal(t, "bar", response.Inputs[0].Name)
+	assert.False(t, response.Inputs[0].Overridable)
+	assert.Equal(t, core.SimpleType_STRING, response.Inputs[0].Type.GetSimple())
+	assert.Equal(t, "bar-value", response.Inputs[0].Default.GetScalar().GetPrimitive().GetStringValue())
+	assert.Equal(t, managerInterfaces.LaunchPlanInputDefaultSourceFixed, response.Inputs[0].DefaultSource)
+
+	assert.Equal(t, "baz", response.Inputs[1].Name)
+	assert.Equal(t, "required", response.Inputs[1].Description)
+	assert.True(t, proto.Equal(integerType, response.Inputs[1].Type))
+	assert.True(t, response.Inputs[1].Required)
+	assert.True(t, response.Inputs[1].Overridable)
+	assert.Nil(t, response.Inputs[1].Default)
+
+	assert.Equal(t, "foo", response.Inputs[2].Name)
+	assert.False(t, response.Inputs[2].Required)
+	assert.True(t, response.Inputs[2].Overridable)
+	assert.Equal(t, "foo-value", response.Inputs[2].Default.GetScalar().GetPrimitive().GetStringValue())
+	assert.Equal(t, managerInterfaces.LaunchPlanInputDefaultSourceLaunchPlan, response.Inputs[2].DefaultSource)
+
+	assert.Equal(t, "qux", response.Inputs[3].Name)
+	assert.False(t, response.Inputs[3].Required)
+	assert.Equal(t, int64(42), response.Inputs[3].Default.GetScalar().GetPrimitive().GetInteger())
+	assert.Equal(t, managerInterfaces.LaunchPlanInputDefaultSourceProjectDomain, response.Inputs[3].DefaultSource)
+}
+
+func TestLaunchPlanManager_GetLaunchPlanInputs_InvalidProjectDomainDefault(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpRequest := testutils.GetLaunchPlanRequest()
+	lpRequest.Spec.DefaultInputs.Parameters["baz"] = &core.Parameter{
+		Var: &core.Variable{
+			Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}},
+		},
+		Behavior: &core.Parameter_Required{Required: true},
+	}
+	closureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: lpRequest.Spec.DefaultInputs,
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				Closure: closureBytes,
+			}, nil
+		})
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: project,
+		Domain:  domain,
+		Attributes: map[string]string{
+			"default_input.baz": "forty-two",
+		},
+	})
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		return projectDomainModel, nil
+	}
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.GetLaunchPlanInputs(context.Background(), admin.ObjectGetRequest{
+		Id: &launchPlanIdentifier,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestLaunchPlanManager_CreateLaunchPlanVariants(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	baseRequest := testutils.GetLaunchPlanRequestWithCronSchedule("0 9 * * ? *")
+	specBytes, _ := proto.Marshal(baseRequest.Spec)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			if input.Name != name {
+				return models.LaunchPlan{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "foo")
+			}
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec:    specBytes,
+				Closure: []byte{},
+			}, nil
+		})
+	createdSpecs := make(map[string]*admin.LaunchPlanSpec)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetCreateCallback(
+		func(input models.LaunchPlan) error {
+			assert.Equal(t, version, input.Version)
+			var spec admin.LaunchPlanSpec
+			assert.NoError(t, proto.Unmarshal(input.Spec, &spec))
+			createdSpecs[input.Name] = &spec
+			return nil
+		})
+	setDefaultWorkflowCallbackForLpTest(repository)
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	response, err := lpManager.CreateLaunchPlanVariants(context.Background(),
+		managerInterfaces.LaunchPlanVariantsCreateRequest{
+			Base: &launchPlanIdentifier,
+			Variants: []managerInterfaces.LaunchPlanVariant{
+				{
+					Key: "us",
+					FixedInputs: &core.LiteralMap{
+						Literals: map[string]*core.Literal{"foo": utils.MustMakeLiteral("us-value")},
+					},
+				},
+				{
+					Key: "eu",
+				},
+				{
+					Key: "unknown",
+					FixedInputs: &core.LiteralMap{
+						Literals: map[string]*core.Literal{"unknown": utils.MustMakeLiteral("value")},
+					},
+				},
+			},
+			StaggerMinutes: 15,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Succeeded)
+	assert.Equal(t, 1, response.Failed)
+	assert.Len(t, response.LaunchPlans, 3)
+	assert.Equal(t, "name-us", response.LaunchPlans[0].ID.Name)
+	assert.Empty(t, response.LaunchPlans[0].Error)
+	assert.Equal(t, "name-unknown", response.LaunchPlans[2].ID.Name)
+	assert.NotEmpty(t, response.LaunchPlans[2].Error)
+
+	assert.Len(t, createdSpecs, 2)
+	usSpec := createdSpecs["name-us"]
+	assert.Equal(t, "us-value", usSpec.FixedInputs.Literals["foo"].GetScalar().GetPrimitive().GetStringValue())
+	assert.Equal(t, "bar-value", usSpec.FixedInputs.Literals["bar"].GetScalar().GetPrimitive().GetStringValue())
+	assert.NotContains(t, usSpec.DefaultInputs.GetParameters(), "foo")
+	assert.Equal(t, "0 9 * * ? *", usSpec.EntityMetadata.Schedule.GetCronExpression())
+	euSpec := createdSpecs["name-eu"]
+	assert.Len(t, euSpec.FixedInputs.Literals, 1)
+	assert.Equal(t, "15 9 * * ? *", euSpec.EntityMetadata.Schedule.GetCronExpression())
+}
+
+func TestLaunchPlanManager_CreateLaunchPlanVariants_InvalidRequest(t *testing.T) {
+	lpManager := NewLaunchPlanManager(
+		getMockRepositoryForLpTest(), getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.CreateLaunchPlanVariants(context.Background(),
+		managerInterfaces.LaunchPlanVariantsCreateRequest{
+			Base: &launchPlanIdentifier,
+			Variants: []managerInterfaces.LaunchPlanVariant{
+				{Key: "us"},
+				{Key: "us"},
+			},
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestLaunchPlanManager_GetActiveLaunchPlan(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
@@ -802,14 +1129,25 @@ func TestDisableLaunchPlan(t *testing.T) {
 		})
 
 	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetUpdateCallback(disableFunc)
+	var stateChanges []models.LaunchPlanStateChange
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetCreateStateChangesCallback(
+		func(changes []models.LaunchPlanStateChange) error {
+			stateChanges = changes
+			return nil
+		})
 
 	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
-	_, err := lpManager.UpdateLaunchPlan(context.Background(), admin.LaunchPlanUpdateRequest{
-		Id:    &launchPlanIdentifier,
-		State: admin.LaunchPlanState_INACTIVE,
-	})
+	_, err := lpManager.UpdateLaunchPlan(auth.WithUserEmail(context.Background(), "user@example.com"),
+		admin.LaunchPlanUpdateRequest{
+			Id:    &launchPlanIdentifier,
+			State: admin.LaunchPlanState_INACTIVE,
+		})
 	assert.NoError(t, err)
 	assert.True(t, removeScheduleFuncCalled)
+	assert.Len(t, stateChanges, 1)
+	assert.Equal(t, version, stateChanges[0].Version)
+	assert.Equal(t, inactive, stateChanges[0].State)
+	assert.Equal(t, "user@example.com", stateChanges[0].Principal)
 }
 
 func TestDisableLaunchPlan_DatabaseError(t *testing.T) {
@@ -913,6 +1251,12 @@ func TestEnableLaunchPlan(t *testing.T) {
 		return nil
 	}
 	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(enableFunc)
+	var stateChanges []models.LaunchPlanStateChange
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetCreateStateChangesCallback(
+		func(changes []models.LaunchPlanStateChange) error {
+			stateChanges = changes
+			return nil
+		})
 
 	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
 	_, err := lpManager.UpdateLaunchPlan(context.Background(), admin.LaunchPlanUpdateRequest{
@@ -920,6 +1264,13 @@ func TestEnableLaunchPlan(t *testing.T) {
 		State: admin.LaunchPlanState_ACTIVE,
 	})
 	assert.NoError(t, err)
+	assert.Len(t, stateChanges, 2)
+	assert.Equal(t, "old version", stateChanges[0].Version)
+	assert.Equal(t, inactive, stateChanges[0].State)
+	assert.Equal(t, version, stateChanges[1].Version)
+	assert.Equal(t, active, stateChanges[1].State)
+	assert.Equal(t, stateChanges[0].OccurredAt, stateChanges[1].OccurredAt)
+	assert.Empty(t, stateChanges[1].Principal)
 }
 
 func TestEnableLaunchPlan_NoCurrentlyActiveVersion(t *testing.T) {
@@ -1318,3 +1669,298 @@ func TestLaunchPlanManager_ListActiveLaunchPlans_BadRequest(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, lpList)
 }
+
+func TestEnableLaunchPlan_PausedSchedule(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	specBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Schedule: &admin.Schedule{
+				ScheduleExpression: &admin.Schedule_CronExpression{
+					CronExpression: "cron",
+				},
+			},
+		},
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec: specBytes,
+			}, nil
+		})
+	paused := int32(managerInterfaces.LaunchPlanScheduleStatePaused)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: project,
+							Domain:  domain,
+							Name:    name,
+							Version: "old version",
+						},
+						Spec:          specBytes,
+						State:         &active,
+						ScheduleState: &paused,
+					},
+				},
+			}, nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(
+		func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+			assert.Equal(t, version, toEnable.Version)
+			assert.Equal(t, paused, *toEnable.ScheduleState)
+			return nil
+		})
+	mockScheduler := mocks.NewMockEventScheduler()
+	var pauseCalled bool
+	mockScheduler.(*mocks.MockEventScheduler).SetPauseScheduleFunc(
+		func(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+			assert.True(t, proto.Equal(&launchPlanNamedIdentifier, &identifier))
+			pauseCalled = true
+			return nil
+		})
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlan(context.Background(), admin.LaunchPlanUpdateRequest{
+		Id:    &launchPlanIdentifier,
+		State: admin.LaunchPlanState_ACTIVE,
+	})
+	assert.NoError(t, err)
+	assert.True(t, pauseCalled)
+}
+
+func makeActiveLaunchPlanListCallback(
+	spec *admin.LaunchPlanSpec, scheduleState *int32) repositoryMocks.ListLaunchPlanFunc {
+	specBytes, _ := proto.Marshal(spec)
+	return func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+		return interfaces.LaunchPlanCollectionOutput{
+			LaunchPlans: []models.LaunchPlan{
+				{
+					LaunchPlanKey: models.LaunchPlanKey{
+						Project: project,
+						Domain:  domain,
+						Name:    name,
+						Version: version,
+					},
+					Spec:          specBytes,
+					State:         &active,
+					ScheduleState: scheduleState,
+				},
+			},
+		}, nil
+	}
+}
+
+var scheduledLaunchPlanSpec = admin.LaunchPlanSpec{
+	EntityMetadata: &admin.LaunchPlanMetadata{
+		Schedule: &admin.Schedule{
+			ScheduleExpression: &admin.Schedule_Rate{
+				Rate: &admin.FixedRate{
+					Value: 2,
+					Unit:  admin.FixedRateUnit_HOUR,
+				},
+			},
+		},
+	},
+}
+
+func TestUpdateLaunchPlanScheduleState_Pause(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		makeActiveLaunchPlanListCallback(&scheduledLaunchPlanSpec, nil))
+	var updateCalled bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetUpdateCallback(
+		func(input models.LaunchPlan) error {
+			assert.Equal(t, version, input.Version)
+			assert.Equal(t, int32(managerInterfaces.LaunchPlanScheduleStatePaused), *input.ScheduleState)
+			updateCalled = true
+			return nil
+		})
+	mockScheduler := mocks.NewMockEventScheduler()
+	var pauseCalled bool
+	mockScheduler.(*mocks.MockEventScheduler).SetPauseScheduleFunc(
+		func(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+			assert.True(t, proto.Equal(&launchPlanNamedIdentifier, &identifier))
+			pauseCalled = true
+			return nil
+		})
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlanScheduleState(context.Background(),
+		managerInterfaces.LaunchPlanScheduleStateUpdateRequest{
+			ID:    &launchPlanNamedIdentifier,
+			State: managerInterfaces.LaunchPlanScheduleStatePaused,
+		})
+	assert.NoError(t, err)
+	assert.True(t, pauseCalled)
+	assert.True(t, updateCalled)
+}
+
+func TestUpdateLaunchPlanScheduleState_Resume(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	paused := int32(managerInterfaces.LaunchPlanScheduleStatePaused)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		makeActiveLaunchPlanListCallback(&scheduledLaunchPlanSpec, &paused))
+	var updateCalled bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetUpdateCallback(
+		func(input models.LaunchPlan) error {
+			assert.Equal(t, int32(managerInterfaces.LaunchPlanScheduleStateActive), *input.ScheduleState)
+			updateCalled = true
+			return nil
+		})
+	mockScheduler := mocks.NewMockEventScheduler()
+	var resumeCalled bool
+	mockScheduler.(*mocks.MockEventScheduler).SetResumeScheduleFunc(
+		func(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+			assert.True(t, proto.Equal(&launchPlanNamedIdentifier, &identifier))
+			resumeCalled = true
+			return nil
+		})
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlanScheduleState(context.Background(),
+		managerInterfaces.LaunchPlanScheduleStateUpdateRequest{
+			ID:    &launchPlanNamedIdentifier,
+			State: managerInterfaces.LaunchPlanScheduleStateActive,
+		})
+	assert.NoError(t, err)
+	assert.True(t, resumeCalled)
+	assert.True(t, updateCalled)
+}
+
+func TestUpdateLaunchPlanScheduleState_Unchanged(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		makeActiveLaunchPlanListCallback(&scheduledLaunchPlanSpec, nil))
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetUpdateCallback(
+		func(input models.LaunchPlan) error {
+			assert.Fail(t, "unexpected update of an unchanged schedule state")
+			return nil
+		})
+	mockScheduler := mocks.NewMockEventScheduler()
+	mockScheduler.(*mocks.MockEventScheduler).SetResumeScheduleFunc(
+		func(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+			assert.Fail(t, "unexpected resume of an active schedule")
+			return nil
+		})
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlanScheduleState(context.Background(),
+		managerInterfaces.LaunchPlanScheduleStateUpdateRequest{
+			ID:    &launchPlanNamedIdentifier,
+			State: managerInterfaces.LaunchPlanScheduleStateActive,
+		})
+	assert.NoError(t, err)
+}
+
+func TestUpdateLaunchPlanScheduleState_NoSchedule(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		makeActiveLaunchPlanListCallback(&admin.LaunchPlanSpec{}, nil))
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlanScheduleState(context.Background(),
+		managerInterfaces.LaunchPlanScheduleStateUpdateRequest{
+			ID:    &launchPlanNamedIdentifier,
+			State: managerInterfaces.LaunchPlanScheduleStatePaused,
+		})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestUpdateLaunchPlanScheduleState_NoneActive(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlanScheduleState(context.Background(),
+		managerInterfaces.LaunchPlanScheduleStateUpdateRequest{
+			ID:    &launchPlanNamedIdentifier,
+			State: managerInterfaces.LaunchPlanScheduleStatePaused,
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListLaunchPlanStateHistory(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	occurredAt := time.Date(2019, 12, 9, 10, 0, 0, 0, time.UTC)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListStateChangesCallback(
+		func(input interfaces.ListStateChangesInput) ([]models.LaunchPlanStateChange, error) {
+			assert.Equal(t, interfaces.ListStateChangesInput{
+				Project: project,
+				Domain:  domain,
+				Name:    name,
+				Limit:   2,
+				Offset:  4,
+			}, input)
+			return []models.LaunchPlanStateChange{
+				{
+					Project:    project,
+					Domain:     domain,
+					Name:       name,
+					Version:    version,
+					State:      inactive,
+					Principal:  "user@example.com",
+					OccurredAt: occurredAt,
+				},
+				{
+					Project:    project,
+					Domain:     domain,
+					Name:       name,
+					Version:    version,
+					State:      active,
+					OccurredAt: occurredAt.Add(-time.Hour),
+				},
+			}, nil
+		})
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	history, err := lpManager.ListLaunchPlanStateHistory(context.Background(),
+		managerInterfaces.LaunchPlanStateHistoryListRequest{
+			ID:    &launchPlanNamedIdentifier,
+			Limit: 2,
+			Token: "4",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, &managerInterfaces.LaunchPlanStateHistory{
+		Changes: []managerInterfaces.LaunchPlanStateChange{
+			{
+				Version:    version,
+				State:      "INACTIVE",
+				Principal:  "user@example.com",
+				OccurredAt: occurredAt,
+			},
+			{
+				Version:    version,
+				State:      "ACTIVE",
+				OccurredAt: occurredAt.Add(-time.Hour),
+			},
+		},
+		Token: "6",
+	}, history)
+}
+
+func TestListLaunchPlanStateHistory_InvalidRequest(t *testing.T) {
+	lpManager := NewLaunchPlanManager(
+		getMockRepositoryForLpTest(), getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.ListLaunchPlanStateHistory(context.Background(),
+		managerInterfaces.LaunchPlanStateHistoryListRequest{
+			ID: &admin.NamedEntityIdentifier{
+				Project: project,
+				Domain:  domain,
+			},
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = lpManager.ListLaunchPlanStateHistory(context.Background(),
+		managerInterfaces.LaunchPlanStateHistoryListRequest{
+			ID:    &launchPlanNamedIdentifier,
+			Token: "foo",
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -3,31 +3,57 @@ package impl
 import (
 	"context"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"google.golang.org/grpc/codes"
 
+	scheduleInterfaces "github.com/lyft/flyteadmin/pkg/async/schedule/interfaces"
+
 	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flytestdlib/logger"
 	"github.com/lyft/flytestdlib/promutils"
 )
 
+// Executions are counted over this window when reporting entity usage.
+const entityUsageWindow = 30 * 24 * time.Hour
+
+// The launch plans referencing an archived workflow are listed in batches of this size.
+const archivedLaunchPlanBatchSize = 100
+
+var ascLaunchPlanIDSortParam, _ = common.NewSortParameter(admin.Sort{
+	Key:       "launch_plans.id",
+	Direction: admin.Sort_ASCENDING,
+})
+
+// The resource types searched when a search doesn't name any.
+var searchedResourceTypes = []core.ResourceType{
+	core.ResourceType_TASK,
+	core.ResourceType_WORKFLOW,
+	core.ResourceType_LAUNCH_PLAN,
+}
+
 type NamedEntityMetrics struct {
 	Scope promutils.Scope
 }
 
 type NamedEntityManager struct {
-	db      repositories.RepositoryInterface
-	config  runtimeInterfaces.Configuration
-	metrics NamedEntityMetrics
+	db        repositories.RepositoryInterface
+	config    runtimeInterfaces.Configuration
+	scheduler scheduleInterfaces.EventScheduler
+	metrics   NamedEntityMetrics
 }
 
 func (m *NamedEntityManager) UpdateNamedEntity(ctx context.Context, request admin.NamedEntityUpdateRequest) (
@@ -67,6 +93,12 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request admi
 		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.NamedEntityListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project: request.Project,
@@ -113,17 +145,278 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request admi
 
 }
 
+func (m *NamedEntityManager) listNamedEntityUsage(ctx context.Context, resourceType core.ResourceType,
+	input repoInterfaces.ListNamedEntityUsageInput) ([]interfaces.NamedEntityUsage, error) {
+	output, err := m.db.NamedEntityRepo().ListUsage(ctx, resourceType, input)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list usage of named entities of type: %s with project: %s, domain: %s. "+
+			"Returned error was: %v", resourceType, input.Project, input.Domain, err)
+		return nil, err
+	}
+	usage := make([]interfaces.NamedEntityUsage, len(output))
+	for idx, entityUsage := range output {
+		usage[idx] = interfaces.NamedEntityUsage{
+			ID: &admin.NamedEntityIdentifier{
+				Project: entityUsage.Project,
+				Domain:  entityUsage.Domain,
+				Name:    entityUsage.Name,
+			},
+			LastExecutedAt: entityUsage.LastExecutedAt,
+			ExecutionCount: entityUsage.ExecutionCount,
+		}
+	}
+	return usage, nil
+}
+
+// Reports when each workflow and launch plan in a project was last executed and how many times it was executed over
+// the usage window, so that those which are no longer used can be found and cleaned up.
+func (m *NamedEntityManager) GetEntityUsage(ctx context.Context, request interfaces.EntityUsageGetRequest) (
+	*interfaces.EntityUsage, error) {
+	if err := validation.ValidateEntityUsageGetRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	input := repoInterfaces.ListNamedEntityUsageInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Since:   time.Now().UTC().Add(-entityUsageWindow),
+	}
+	workflows, err := m.listNamedEntityUsage(ctx, core.ResourceType_WORKFLOW, input)
+	if err != nil {
+		return nil, err
+	}
+	launchPlans, err := m.listNamedEntityUsage(ctx, core.ResourceType_LAUNCH_PLAN, input)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.EntityUsage{
+		Since:       input.Since,
+		Workflows:   workflows,
+		LaunchPlans: launchPlans,
+	}, nil
+}
+
+// Searches the names and descriptions of tasks, workflows and launch plans at once, sparing clients from listing each
+// resource type separately with filters.
+func (m *NamedEntityManager) SearchEntities(ctx context.Context, request interfaces.EntitySearchRequest) (
+	*interfaces.EntitySearchResults, error) {
+	if err := validation.ValidateEntitySearchRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.NamedEntityListLimits)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for SearchEntities", request.Token)
+	}
+	resourceTypes := request.ResourceTypes
+	if len(resourceTypes) == 0 {
+		resourceTypes = searchedResourceTypes
+	}
+	output, err := m.db.NamedEntityRepo().Search(ctx, repoInterfaces.SearchNamedEntitiesInput{
+		Project:       request.Project,
+		Domain:        request.Domain,
+		ResourceTypes: resourceTypes,
+		Keywords:      strings.Fields(request.Query),
+		Limit:         int(limit),
+		Offset:        offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to search named entities with project: %s, domain: %s, query: %s. "+
+			"Returned error was: %v", request.Project, request.Domain, request.Query, err)
+		return nil, err
+	}
+	results := &interfaces.EntitySearchResults{
+		Entities: make([]interfaces.EntitySearchResult, len(output.Entities)),
+	}
+	for idx, entity := range output.Entities {
+		results.Entities[idx] = interfaces.EntitySearchResult{
+			ResourceType: entity.ResourceType.String(),
+			ID: &admin.NamedEntityIdentifier{
+				Project: entity.Project,
+				Domain:  entity.Domain,
+				Name:    entity.Name,
+			},
+			Description: entity.Description,
+		}
+	}
+	if len(output.Entities) == int(limit) {
+		results.Token = strconv.Itoa(offset + len(output.Entities))
+	}
+	return results, nil
+}
+
+// Lists the launch plans with a version referencing any version of a workflow, along with their active versions.
+func (m *NamedEntityManager) listWorkflowLaunchPlans(ctx context.Context, workflowID admin.NamedEntityIdentifier) (
+	[]*admin.NamedEntityIdentifier, []models.LaunchPlan, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: workflowID.Project,
+		Domain:  workflowID.Domain,
+		Name:    workflowID.Name,
+	}, common.Workflow)
+	if err != nil {
+		return nil, nil, err
+	}
+	launchPlanIDs := make([]*admin.NamedEntityIdentifier, 0)
+	activeLaunchPlans := make([]models.LaunchPlan, 0)
+	listed := make(map[admin.NamedEntityIdentifier]bool)
+	for offset := 0; ; offset += archivedLaunchPlanBatchSize {
+		output, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         archivedLaunchPlanBatchSize,
+			Offset:        offset,
+			InlineFilters: filters,
+			SortParameter: ascLaunchPlanIDSortParam,
+		})
+		if err != nil {
+			logger.Debugf(ctx, "Failed to list the launch plans of workflow [%+v] with err %v", workflowID, err)
+			return nil, nil, err
+		}
+		for _, launchPlan := range output.LaunchPlans {
+			launchPlanID := admin.NamedEntityIdentifier{
+				Project: launchPlan.Project,
+				Domain:  launchPlan.Domain,
+				Name:    launchPlan.Name,
+			}
+			if !listed[launchPlanID] {
+				listed[launchPlanID] = true
+				launchPlanIDs = append(launchPlanIDs, &launchPlanID)
+			}
+			if launchPlan.State != nil && *launchPlan.State == int32(admin.LaunchPlanState_ACTIVE) {
+				activeLaunchPlans = append(activeLaunchPlans, launchPlan)
+			}
+		}
+		if len(output.LaunchPlans) < archivedLaunchPlanBatchSize {
+			return launchPlanIDs, activeLaunchPlans, nil
+		}
+	}
+}
+
+func (m *NamedEntityManager) updateNamedEntityState(ctx context.Context, resourceType core.ResourceType,
+	id admin.NamedEntityIdentifier, state interfaces.NamedEntityState) error {
+	stateInt := int32(state)
+	err := m.db.NamedEntityRepo().Update(ctx, models.NamedEntity{
+		NamedEntityKey: models.NamedEntityKey{
+			ResourceType: resourceType,
+			Project:      id.Project,
+			Domain:       id.Domain,
+			Name:         id.Name,
+		},
+		NamedEntityMetadataFields: models.NamedEntityMetadataFields{
+			State: &stateInt,
+		},
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to update the state of named entity [%+v] with err %v", id, err)
+		return err
+	}
+	return nil
+}
+
+// Archives or restores a task, workflow or launch plan. Archiving a workflow can cascade to the launch plans
+// referencing it, which are archived and deactivated in the same transaction as the workflow once their schedules are
+// removed.
+func (m *NamedEntityManager) UpdateNamedEntityState(
+	ctx context.Context, request interfaces.NamedEntityStateUpdateRequest) (
+	*interfaces.NamedEntityStateUpdateResponse, error) {
+	if err := validation.ValidateNamedEntityStateUpdateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if _, err := util.GetNamedEntity(ctx, m.db, request.ResourceType, *request.ID); err != nil {
+		return nil, err
+	}
+	response := &interfaces.NamedEntityStateUpdateResponse{
+		ArchivedLaunchPlans:    make([]*admin.NamedEntityIdentifier, 0),
+		DeactivatedLaunchPlans: make([]*core.Identifier, 0),
+		RemovedSchedules:       make([]*admin.NamedEntityIdentifier, 0),
+	}
+	activeLaunchPlans := make([]models.LaunchPlan, 0)
+	if request.Cascade {
+		var err error
+		response.ArchivedLaunchPlans, activeLaunchPlans, err = m.listWorkflowLaunchPlans(ctx, *request.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for idx := range activeLaunchPlans {
+		launchPlan := &activeLaunchPlans[idx]
+		response.DeactivatedLaunchPlans = append(response.DeactivatedLaunchPlans, &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      launchPlan.Project,
+			Domain:       launchPlan.Domain,
+			Name:         launchPlan.Name,
+			Version:      launchPlan.Version,
+		})
+		var launchPlanSpec admin.LaunchPlanSpec
+		if err := proto.Unmarshal(launchPlan.Spec, &launchPlanSpec); err != nil {
+			logger.Errorf(ctx, "failed to unmarshal launch plan spec of %+v", launchPlan.LaunchPlanKey)
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to unmarshal launch plan spec of %+v", launchPlan.LaunchPlanKey)
+		}
+		if !isScheduleEmpty(launchPlanSpec) {
+			response.RemovedSchedules = append(response.RemovedSchedules, &admin.NamedEntityIdentifier{
+				Project: launchPlan.Project,
+				Domain:  launchPlan.Domain,
+				Name:    launchPlan.Name,
+			})
+		}
+		inactive := int32(admin.LaunchPlanState_INACTIVE)
+		launchPlan.State = &inactive
+	}
+	if request.DryRun {
+		return response, nil
+	}
+
+	for _, launchPlanID := range response.RemovedSchedules {
+		if err := m.scheduler.RemoveSchedule(ctx, *launchPlanID); err != nil {
+			logger.Debugf(ctx, "Failed to remove the schedule of launch plan [%+v] with err %v", launchPlanID, err)
+			return nil, err
+		}
+	}
+	err := m.db.Transaction(ctx, func(ctx context.Context) error {
+		if err := m.updateNamedEntityState(ctx, request.ResourceType, *request.ID, request.State); err != nil {
+			return err
+		}
+		for _, launchPlanID := range response.ArchivedLaunchPlans {
+			if err := m.updateNamedEntityState(
+				ctx, core.ResourceType_LAUNCH_PLAN, *launchPlanID, interfaces.NamedEntityStateArchived); err != nil {
+				return err
+			}
+		}
+		for _, launchPlan := range activeLaunchPlans {
+			if err := m.db.LaunchPlanRepo().Update(ctx, launchPlan); err != nil {
+				return err
+			}
+		}
+		if len(activeLaunchPlans) == 0 {
+			return nil
+		}
+		return m.db.LaunchPlanRepo().CreateStateChanges(ctx, getLaunchPlanStateChanges(ctx, activeLaunchPlans...))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func NewNamedEntityManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration,
+	scheduler scheduleInterfaces.EventScheduler,
 	scope promutils.Scope) interfaces.NamedEntityInterface {
 
 	metrics := NamedEntityMetrics{
 		Scope: scope,
 	}
 	return &NamedEntityManager{
-		db:      db,
-		config:  config,
-		metrics: metrics,
+		db:        db,
+		config:    config,
+		scheduler: scheduler,
+		metrics:   metrics,
 	}
 }
@@ -3,8 +3,13 @@ package impl
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/async/schedule/mocks"
+	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
@@ -41,7 +46,8 @@ func getMockConfigForNETest() runtimeInterfaces.Configuration {
 
 func TestNamedEntityManager_Get(t *testing.T) {
 	repository := getMockRepositoryForNETest()
-	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
 
 	getFunction := func(input interfaces.GetNamedEntityInput) (models.NamedEntity, error) {
 		return models.NamedEntity{
@@ -67,7 +73,8 @@ func TestNamedEntityManager_Get(t *testing.T) {
 
 func TestNamedEntityManager_Get_BadRequest(t *testing.T) {
 	repository := getMockRepositoryForNETest()
-	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
 
 	response, err := manager.GetNamedEntity(context.Background(), admin.NamedEntityGetRequest{
 		ResourceType: core.ResourceType_UNSPECIFIED,
@@ -86,7 +93,8 @@ func TestNamedEntityManager_Get_BadRequest(t *testing.T) {
 
 func TestNamedEntityManager_Update(t *testing.T) {
 	repository := getMockRepositoryForNETest()
-	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
 	updatedDescription := "updated description"
 	var updateCalled bool
 
@@ -115,7 +123,8 @@ func TestNamedEntityManager_Update(t *testing.T) {
 
 func TestNamedEntityManager_Update_BadRequest(t *testing.T) {
 	repository := getMockRepositoryForNETest()
-	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
 	updatedDescription := "updated description"
 
 	updatedMetadata := admin.NamedEntityMetadata{
@@ -137,3 +146,353 @@ func TestNamedEntityManager_Update_BadRequest(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, response)
 }
+
+func TestNamedEntityManager_GetEntityUsage(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+
+	lastExecutedAt := time.Date(2019, 12, 10, 10, 0, 0, 0, time.UTC)
+	var since time.Time
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetListUsageCallback(
+		func(resourceType core.ResourceType, input interfaces.ListNamedEntityUsageInput) (
+			[]models.NamedEntityUsage, error) {
+			assert.Equal(t, project, input.Project)
+			assert.Equal(t, domain, input.Domain)
+			assert.True(t, time.Since(input.Since) >= entityUsageWindow)
+			since = input.Since
+			if resourceType == core.ResourceType_WORKFLOW {
+				return []models.NamedEntityUsage{
+					{
+						NamedEntityKey: models.NamedEntityKey{
+							ResourceType: resourceType,
+							Project:      project,
+							Domain:       domain,
+							Name:         name,
+						},
+						LastExecutedAt: &lastExecutedAt,
+						ExecutionCount: 3,
+					},
+				}, nil
+			}
+			assert.Equal(t, core.ResourceType_LAUNCH_PLAN, resourceType)
+			return []models.NamedEntityUsage{
+				{
+					NamedEntityKey: models.NamedEntityKey{
+						ResourceType: resourceType,
+						Project:      project,
+						Domain:       domain,
+						Name:         "unused",
+					},
+				},
+			}, nil
+		})
+	usage, err := manager.GetEntityUsage(context.Background(), managerInterfaces.EntityUsageGetRequest{
+		Project: project,
+		Domain:  domain,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, since, usage.Since)
+	assert.Equal(t, []managerInterfaces.NamedEntityUsage{
+		{
+			ID:             &namedEntityIdentifier,
+			LastExecutedAt: &lastExecutedAt,
+			ExecutionCount: 3,
+		},
+	}, usage.Workflows)
+	assert.Equal(t, []managerInterfaces.NamedEntityUsage{
+		{
+			ID: &admin.NamedEntityIdentifier{
+				Project: project,
+				Domain:  domain,
+				Name:    "unused",
+			},
+		},
+	}, usage.LaunchPlans)
+}
+
+func TestNamedEntityManager_GetEntityUsage_BadRequest(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+
+	usage, err := manager.GetEntityUsage(context.Background(), managerInterfaces.EntityUsageGetRequest{
+		Domain: domain,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, usage)
+}
+
+func TestNamedEntityManager_SearchEntities(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetSearchCallback(
+		func(input interfaces.SearchNamedEntitiesInput) (interfaces.NamedEntityCollectionOutput, error) {
+			assert.Equal(t, project, input.Project)
+			assert.Empty(t, input.Domain)
+			assert.Equal(t, []core.ResourceType{
+				core.ResourceType_TASK, core.ResourceType_WORKFLOW, core.ResourceType_LAUNCH_PLAN,
+			}, input.ResourceTypes)
+			assert.Equal(t, []string{"daily", "report"}, input.Keywords)
+			assert.Equal(t, 2, input.Limit)
+			assert.Equal(t, 2, input.Offset)
+			return interfaces.NamedEntityCollectionOutput{
+				Entities: []models.NamedEntity{
+					{
+						NamedEntityKey: models.NamedEntityKey{
+							ResourceType: core.ResourceType_TASK,
+							Project:      project,
+							Domain:       domain,
+							Name:         "daily_report",
+						},
+						NamedEntityMetadataFields: models.NamedEntityMetadataFields{
+							Description: "Sends the daily report",
+						},
+					},
+					{
+						NamedEntityKey: models.NamedEntityKey{
+							ResourceType: core.ResourceType_LAUNCH_PLAN,
+							Project:      project,
+							Domain:       domain,
+							Name:         "daily_report",
+						},
+					},
+				},
+			}, nil
+		})
+	results, err := manager.SearchEntities(context.Background(), managerInterfaces.EntitySearchRequest{
+		Project: project,
+		Query:   " daily  report ",
+		Limit:   2,
+		Token:   "2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &managerInterfaces.EntitySearchResults{
+		Entities: []managerInterfaces.EntitySearchResult{
+			{
+				ResourceType: "TASK",
+				ID: &admin.NamedEntityIdentifier{
+					Project: project,
+					Domain:  domain,
+					Name:    "daily_report",
+				},
+				Description: "Sends the daily report",
+			},
+			{
+				ResourceType: "LAUNCH_PLAN",
+				ID: &admin.NamedEntityIdentifier{
+					Project: project,
+					Domain:  domain,
+					Name:    "daily_report",
+				},
+			},
+		},
+		Token: "4",
+	}, results)
+}
+
+func TestNamedEntityManager_SearchEntities_BadRequest(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+
+	for _, request := range []managerInterfaces.EntitySearchRequest{
+		{Query: "report"},
+		{Project: project, Query: " "},
+		{Project: project, Query: "report", ResourceTypes: []core.ResourceType{core.ResourceType_UNSPECIFIED}},
+		{Project: project, Query: "report", Token: "foo"},
+	} {
+		results, err := manager.SearchEntities(context.Background(), request)
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	}
+}
+
+func getMockRepositoryForArchivalTest(t *testing.T) repositories.RepositoryInterface {
+	repository := getMockRepositoryForNETest()
+	activeState := int32(admin.LaunchPlanState_ACTIVE)
+	inactiveState := int32(admin.LaunchPlanState_INACTIVE)
+	scheduledSpecBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Schedule: &admin.Schedule{
+				ScheduleExpression: &admin.Schedule_CronExpression{CronExpression: "0 * * * *"},
+			},
+		},
+	})
+	unscheduledSpecBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			assert.Equal(t, 0, input.Offset)
+			assert.Len(t, input.InlineFilters, 3)
+			for _, filter := range input.InlineFilters {
+				assert.Equal(t, common.Workflow, filter.GetEntity())
+			}
+			return interfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: project, Domain: domain, Name: "lp", Version: "v1",
+						},
+						Spec:  scheduledSpecBytes,
+						State: &inactiveState,
+					},
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: project, Domain: domain, Name: "lp", Version: "v2",
+						},
+						Spec:  scheduledSpecBytes,
+						State: &activeState,
+					},
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: project, Domain: domain, Name: "other", Version: "v1",
+						},
+						Spec:  unscheduledSpecBytes,
+						State: &activeState,
+					},
+				},
+			}, nil
+		})
+	return repository
+}
+
+func TestNamedEntityManager_UpdateNamedEntityState(t *testing.T) {
+	repository := getMockRepositoryForArchivalTest(t)
+	archived := make(map[core.ResourceType][]string)
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetUpdateCallback(
+		func(input models.NamedEntity) error {
+			assert.Equal(t, int32(managerInterfaces.NamedEntityStateArchived), *input.State)
+			assert.Empty(t, input.Description)
+			archived[input.ResourceType] = append(archived[input.ResourceType], input.Name)
+			return nil
+		})
+	var deactivated []string
+	launchPlanRepo := repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo)
+	launchPlanRepo.SetUpdateCallback(func(input models.LaunchPlan) error {
+		assert.Equal(t, int32(admin.LaunchPlanState_INACTIVE), *input.State)
+		deactivated = append(deactivated, input.Name+"/"+input.Version)
+		return nil
+	})
+	var stateChanges []models.LaunchPlanStateChange
+	launchPlanRepo.SetCreateStateChangesCallback(func(changes []models.LaunchPlanStateChange) error {
+		stateChanges = changes
+		return nil
+	})
+	mockScheduler := mocks.NewMockEventScheduler()
+	var removedSchedules []string
+	mockScheduler.(*mocks.MockEventScheduler).SetRemoveScheduleFunc(
+		func(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+			removedSchedules = append(removedSchedules, identifier.Name)
+			return nil
+		})
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScheduler, mockScope.NewTestScope())
+
+	response, err := manager.UpdateNamedEntityState(context.Background(), managerInterfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           &namedEntityIdentifier,
+		State:        managerInterfaces.NamedEntityStateArchived,
+		Cascade:      true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &managerInterfaces.NamedEntityStateUpdateResponse{
+		ArchivedLaunchPlans: []*admin.NamedEntityIdentifier{
+			{Project: project, Domain: domain, Name: "lp"},
+			{Project: project, Domain: domain, Name: "other"},
+		},
+		DeactivatedLaunchPlans: []*core.Identifier{
+			{ResourceType: core.ResourceType_LAUNCH_PLAN, Project: project, Domain: domain, Name: "lp", Version: "v2"},
+			{ResourceType: core.ResourceType_LAUNCH_PLAN, Project: project, Domain: domain, Name: "other", Version: "v1"},
+		},
+		RemovedSchedules: []*admin.NamedEntityIdentifier{
+			{Project: project, Domain: domain, Name: "lp"},
+		},
+	}, response)
+	assert.Equal(t, map[core.ResourceType][]string{
+		core.ResourceType_WORKFLOW:    {name},
+		core.ResourceType_LAUNCH_PLAN: {"lp", "other"},
+	}, archived)
+	assert.Equal(t, []string{"lp/v2", "other/v1"}, deactivated)
+	assert.Len(t, stateChanges, 2)
+	assert.Equal(t, []string{"lp"}, removedSchedules)
+}
+
+func TestNamedEntityManager_UpdateNamedEntityState_DryRun(t *testing.T) {
+	repository := getMockRepositoryForArchivalTest(t)
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetUpdateCallback(
+		func(input models.NamedEntity) error {
+			t.Fatal("unexpected named entity update")
+			return nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetUpdateCallback(
+		func(input models.LaunchPlan) error {
+			t.Fatal("unexpected launch plan update")
+			return nil
+		})
+	mockScheduler := mocks.NewMockEventScheduler()
+	mockScheduler.(*mocks.MockEventScheduler).SetRemoveScheduleFunc(
+		func(ctx context.Context, identifier admin.NamedEntityIdentifier) error {
+			t.Fatal("unexpected schedule removal")
+			return nil
+		})
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScheduler, mockScope.NewTestScope())
+
+	response, err := manager.UpdateNamedEntityState(context.Background(), managerInterfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           &namedEntityIdentifier,
+		State:        managerInterfaces.NamedEntityStateArchived,
+		Cascade:      true,
+		DryRun:       true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.ArchivedLaunchPlans, 2)
+	assert.Len(t, response.DeactivatedLaunchPlans, 2)
+	assert.Len(t, response.RemovedSchedules, 1)
+}
+
+func TestNamedEntityManager_UpdateNamedEntityState_WithoutCascade(t *testing.T) {
+	repository := getMockRepositoryForArchivalTest(t)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			t.Fatal("unexpected launch plan listing")
+			return interfaces.LaunchPlanCollectionOutput{}, nil
+		})
+	var updated []models.NamedEntity
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetUpdateCallback(
+		func(input models.NamedEntity) error {
+			updated = append(updated, input)
+			return nil
+		})
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+
+	response, err := manager.UpdateNamedEntityState(context.Background(), managerInterfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		ID:           &namedEntityIdentifier,
+		State:        managerInterfaces.NamedEntityStateActive,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, response.ArchivedLaunchPlans)
+	assert.Len(t, updated, 1)
+	assert.Equal(t, core.ResourceType_LAUNCH_PLAN, updated[0].ResourceType)
+	assert.Equal(t, int32(managerInterfaces.NamedEntityStateActive), *updated[0].State)
+}
+
+func TestNamedEntityManager_UpdateNamedEntityState_BadRequest(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(
+		repository, getMockConfigForNETest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+
+	for _, request := range []managerInterfaces.NamedEntityStateUpdateRequest{
+		{ResourceType: core.ResourceType_WORKFLOW, ID: &badIdentifier},
+		{ResourceType: core.ResourceType_WORKFLOW, ID: &namedEntityIdentifier, State: 5},
+		{ResourceType: core.ResourceType_TASK, ID: &namedEntityIdentifier, State: managerInterfaces.NamedEntityStateArchived,
+			Cascade: true},
+		{ResourceType: core.ResourceType_WORKFLOW, ID: &namedEntityIdentifier, Cascade: true},
+	} {
+		response, err := manager.UpdateNamedEntityState(context.Background(), request)
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	}
+}
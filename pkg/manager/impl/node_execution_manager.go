@@ -2,14 +2,22 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lyft/flyteadmin/pkg/logging"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
 	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/lyft/flytestdlib/logger"
 
+	cloudEventInterfaces "github.com/lyft/flyteadmin/pkg/async/cloudevent/interfaces"
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 
@@ -23,6 +31,7 @@ import (
 	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"google.golang.org/grpc/codes"
@@ -36,12 +45,21 @@ type nodeExecutionMetrics struct {
 	NodeExecutionEventsCreated prometheus.Counter
 	MissingWorkflowExecution   prometheus.Counter
 	ClosureSizeBytes           prometheus.Summary
+	LateEventsAccepted         prometheus.Counter
+	DynamicWorkflowsCreated    prometheus.Counter
+	ClosuresOffloaded          prometheus.Counter
+	EventMetrics               util.EventMetrics
 }
 
 type NodeExecutionManager struct {
-	db      repositories.RepositoryInterface
-	metrics nodeExecutionMetrics
-	urlData dataInterfaces.RemoteURLInterface
+	db            repositories.RepositoryInterface
+	config        runtimeInterfaces.Configuration
+	storagePrefix []string
+	storageClient *storage.DataStore
+	metrics       nodeExecutionMetrics
+	urlData       dataInterfaces.RemoteURLInterface
+	// Mirrors recorded node execution events to downstream consumers.
+	cloudEventPublisher cloudEventInterfaces.Publisher
 }
 
 type updateNodeExecutionStatus int
@@ -49,7 +67,8 @@ type updateNodeExecutionStatus int
 const (
 	updateSucceeded updateNodeExecutionStatus = iota
 	updateFailed
-	alreadyInTerminalStatus
+	// A late event only enriched the closure of a terminated node execution.
+	lateEventEnriched
 )
 
 const addIsParentFilter = true
@@ -58,57 +77,178 @@ var isParent = common.NewMapFilter(map[string]interface{}{
 	shared.ParentTaskExecutionID: nil,
 })
 
+// Offloads the closure of a node execution to blob storage when it exceeds the configured size or was offloaded before.
+func (m *NodeExecutionManager) offloadClosure(ctx context.Context, nodeExecutionModel *models.NodeExecution) error {
+	thresholdBytes := m.config.ApplicationConfiguration().GetTopLevelConfig().ClosureOffloading.NodeExecutionThresholdBytes
+	if thresholdBytes <= 0 && len(nodeExecutionModel.RemoteClosureReference) == 0 {
+		return nil
+	}
+	reference, err := transformers.GetNodeExecutionClosureReference(
+		ctx, m.storageClient, m.storagePrefix, nodeExecutionModel.NodeExecutionKey)
+	if err != nil {
+		return err
+	}
+	offloaded, err := transformers.OffloadNodeExecutionClosure(
+		ctx, m.storageClient, reference, thresholdBytes, nodeExecutionModel)
+	if err != nil {
+		return err
+	}
+	if offloaded {
+		m.metrics.ClosuresOffloaded.Inc()
+	}
+	return nil
+}
+
+// Keeps the parent's rollup of child phases, if the node execution has a parent, in step with the node execution's
+// phase.
+func (m *NodeExecutionManager) updateChildPhaseCounts(
+	ctx context.Context, parentID uint, previousPhase, phase string) error {
+	if parentID == 0 || previousPhase == phase {
+		return nil
+	}
+	return m.db.NodeExecutionRepo().UpdateChildPhaseCounts(ctx, repoInterfaces.UpdateChildPhaseCountsInput{
+		ParentID:      parentID,
+		PreviousPhase: previousPhase,
+		Phase:         phase,
+	})
+}
+
+// Returns the identifier of the sub-workflow (if any) in a compiled closure which a node belongs to. Nodes of the primary
+// workflow only belong to a sub-workflow when the execution was launched by a launch plan node of another execution.
+func getSubWorkflowID(
+	closure *core.CompiledWorkflowClosure, nodeID string, launchedByNode bool) *core.Identifier {
+	for _, node := range closure.GetPrimary().GetTemplate().GetNodes() {
+		if node.Id != nodeID {
+			continue
+		}
+		if launchedByNode {
+			return closure.Primary.Template.Id
+		}
+		return nil
+	}
+	for _, subWorkflow := range closure.GetSubWorkflows() {
+		for _, node := range subWorkflow.GetTemplate().GetNodes() {
+			if node.Id == nodeID {
+				return subWorkflow.Template.Id
+			}
+		}
+	}
+	return nil
+}
+
+// Looks up which sub-workflow (if any) the node of a node execution belongs to in its execution's workflow. Nodes of
+// dynamic workflows, which are compiled at runtime, aren't attributed.
+func (m *NodeExecutionManager) lookupSubWorkflowID(ctx context.Context, executionModel *models.Execution,
+	request *admin.NodeExecutionEventRequest) (*core.Identifier, error) {
+	if request.Event.ParentTaskMetadata != nil {
+		return nil, nil
+	}
+	execution, err := transformers.FromExecutionModel(*executionModel)
+	if err != nil {
+		return nil, err
+	}
+	if execution.Closure.GetWorkflowId() == nil {
+		return nil, nil
+	}
+	workflow, err := util.GetWorkflow(ctx, m.db, m.storageClient, *execution.Closure.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	return getSubWorkflowID(workflow.Closure.GetCompiledWorkflow(), request.Event.Id.NodeId,
+		executionModel.ParentNodeExecutionID != 0), nil
+}
+
 func (m *NodeExecutionManager) createNodeExecutionWithEvent(
-	ctx context.Context, request *admin.NodeExecutionEventRequest) error {
+	ctx context.Context, executionModel *models.Execution, request *admin.NodeExecutionEventRequest) error {
 
-	var parentTaskExecutionID uint
+	var parentTaskExecutionID, parentID uint
 	if request.Event.ParentTaskMetadata != nil {
 		taskExecutionModel, err := util.GetTaskExecutionModel(ctx, m.db, request.Event.ParentTaskMetadata.Id)
 		if err != nil {
 			return err
 		}
 		parentTaskExecutionID = taskExecutionModel.ID
+		parentNodeExecutionModel, err := util.GetNodeExecutionModel(
+			ctx, m.db, request.Event.ParentTaskMetadata.Id.NodeExecutionId)
+		if err != nil {
+			return err
+		}
+		parentID = parentNodeExecutionModel.ID
+	}
+	// Attribution only aids debugging, so node executions are still recorded when it fails.
+	subWorkflowID, err := m.lookupSubWorkflowID(ctx, executionModel, request)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to look up the sub-workflow of node execution [%+v] with err: %v",
+			request.Event.Id, err)
 	}
 	nodeExecutionModel, err := transformers.CreateNodeExecutionModel(transformers.ToNodeExecutionModelInput{
 		Request:               request,
 		ParentTaskExecutionID: parentTaskExecutionID,
+		ParentID:              parentID,
+		SubWorkflowID:         subWorkflowID,
 	})
 	if err != nil {
-		logger.Debugf(ctx, "failed to create node execution model for event request: %s with err: %v",
+		logging.Debugf(ctx, logging.Executions, "failed to create node execution model for event request: %s with err: %v",
 			request.RequestId, err)
 		return err
 	}
+	closureSizeBytes := len(nodeExecutionModel.Closure)
+	if err := m.offloadClosure(ctx, nodeExecutionModel); err != nil {
+		return err
+	}
 	nodeExecutionEventModel, err := transformers.CreateNodeExecutionEventModel(*request)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform node execution event request: %s into model with err: %v",
+		logging.Debugf(ctx, logging.Executions, "failed to transform node execution event request: %s into model with err: %v",
 			request.RequestId, err)
 		return err
 	}
 
-	if err := m.db.NodeExecutionRepo().Create(ctx, nodeExecutionEventModel, nodeExecutionModel); err != nil {
-		logger.Debugf(ctx, "Failed to create node execution with id [%+v] and model [%+v] "+
+	writeTimer := m.metrics.EventMetrics.WriteDuration.Start()
+	err = m.db.Transaction(ctx, func(ctx context.Context) error {
+		if err := m.db.NodeExecutionRepo().Create(ctx, nodeExecutionEventModel, nodeExecutionModel); err != nil {
+			return err
+		}
+		return m.updateChildPhaseCounts(ctx, nodeExecutionModel.ParentID, "", nodeExecutionModel.Phase)
+	})
+	writeTimer.Stop()
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to create node execution with id [%+v] and model [%+v] "+
 			"and event [%+v] with err %v", request.Event.Id, nodeExecutionModel, nodeExecutionEventModel, err)
 		return err
 	}
-	m.metrics.ClosureSizeBytes.Observe(float64(len(nodeExecutionModel.Closure)))
+	m.metrics.ClosureSizeBytes.Observe(float64(closureSizeBytes))
 	return nil
 }
 
 func (m *NodeExecutionManager) updateNodeExecutionWithEvent(
-	ctx context.Context, request *admin.NodeExecutionEventRequest, nodeExecutionModel *models.NodeExecution) (updateNodeExecutionStatus, error) {
+	ctx context.Context, request *admin.NodeExecutionEventRequest, nodeExecutionModel *models.NodeExecution) (
+	updateNodeExecutionStatus, error) {
 	// If we have an existing execution, check if the phase change is valid
 	nodeExecPhase := core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase])
-	if nodeExecPhase == request.Event.Phase {
-		logger.Debugf(ctx, "This phase was already recorded %v for %+v", nodeExecPhase.String(), request.Event.Id)
+	switch executions.NodeExecutionPhases.GetTransition(int32(nodeExecPhase), int32(request.Event.Phase)) {
+	case executions.PhaseTransitionDuplicate:
+		logging.Debugf(ctx, logging.Executions, "This phase was already recorded %v for %+v", nodeExecPhase.String(), request.Event.Id)
 		return updateFailed, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
 			"This phase was already recorded %v for %+v", nodeExecPhase.String(), request.Event.Id)
-	} else if common.IsNodeExecutionTerminal(nodeExecPhase) {
-		// Cannot go from a terminal state to anything else
-		logger.Warnf(ctx, "Invalid phase change from %v to %v for node execution %v",
-			nodeExecPhase.String(), request.Event.Phase.String(), request.Event.Id)
-		return alreadyInTerminalStatus, nil
+	case executions.PhaseTransitionFromTerminal:
+		if executions.IsLateEventAccepted(m.config.ApplicationConfiguration().GetTopLevelConfig().LateEvents,
+			nodeExecutionModel.NodeExecutionUpdatedAt, request.Event.OccurredAt) {
+			enriched, err := m.enrichNodeExecutionWithLateEvent(ctx, request, nodeExecutionModel)
+			if err != nil {
+				return updateFailed, err
+			}
+			if enriched {
+				return lateEventEnriched, nil
+			}
+		}
+		return updateFailed, executions.NodeExecutionPhases.NewTransitionError(
+			ctx, int32(nodeExecPhase), int32(request.Event.Phase), request.Event.Id)
+	case executions.PhaseTransitionInvalid:
+		return updateFailed, executions.NodeExecutionPhases.NewTransitionError(
+			ctx, int32(nodeExecPhase), int32(request.Event.Phase), request.Event.Id)
 	}
 
+	previousPhase := nodeExecutionModel.Phase
 	// if this node execution kicked off a workflow, validate that the execution exists
 	var childExecutionID *core.WorkflowExecutionIdentifier
 	if request.Event.GetWorkflowNodeMetadata() != nil {
@@ -125,21 +265,31 @@ func (m *NodeExecutionManager) updateNodeExecutionWithEvent(
 			return updateFailed, err
 		}
 	}
-	err := transformers.UpdateNodeExecutionModel(request, nodeExecutionModel, childExecutionID)
+	err := transformers.UpdateNodeExecutionModel(ctx, m.storageClient, request, nodeExecutionModel, childExecutionID)
 	if err != nil {
-		logger.Debugf(ctx, "failed to update node execution model: %+v with err: %v", request.Event.Id, err)
+		logging.Debugf(ctx, logging.Executions, "failed to update node execution model: %+v with err: %v", request.Event.Id, err)
+		return updateFailed, err
+	}
+	if err := m.offloadClosure(ctx, nodeExecutionModel); err != nil {
 		return updateFailed, err
 	}
 
 	nodeExecutionEventModel, err := transformers.CreateNodeExecutionEventModel(*request)
 	if err != nil {
-		logger.Debugf(ctx, "failed to create node execution event model for request: %s with err: %v",
+		logging.Debugf(ctx, logging.Executions, "failed to create node execution event model for request: %s with err: %v",
 			request.RequestId, err)
 		return updateFailed, err
 	}
-	err = m.db.NodeExecutionRepo().Update(ctx, nodeExecutionEventModel, nodeExecutionModel)
+	writeTimer := m.metrics.EventMetrics.WriteDuration.Start()
+	err = m.db.Transaction(ctx, func(ctx context.Context) error {
+		if err := m.db.NodeExecutionRepo().Update(ctx, nodeExecutionEventModel, nodeExecutionModel); err != nil {
+			return err
+		}
+		return m.updateChildPhaseCounts(ctx, nodeExecutionModel.ParentID, previousPhase, nodeExecutionModel.Phase)
+	})
+	writeTimer.Stop()
 	if err != nil {
-		logger.Debugf(ctx, "Failed to update node execution with id [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to update node execution with id [%+v] with err %v",
 			request.Event.Id, err)
 		return updateFailed, err
 	}
@@ -147,16 +297,50 @@ func (m *NodeExecutionManager) updateNodeExecutionWithEvent(
 	return updateSucceeded, nil
 }
 
+// Adds the metadata of an event received after the node execution terminated to its closure. Returns whether the event
+// had anything to add.
+func (m *NodeExecutionManager) enrichNodeExecutionWithLateEvent(
+	ctx context.Context, request *admin.NodeExecutionEventRequest, nodeExecutionModel *models.NodeExecution) (
+	bool, error) {
+	enriched, err := transformers.EnrichNodeExecutionModel(ctx, m.storageClient, request, nodeExecutionModel)
+	if err != nil || !enriched {
+		return false, err
+	}
+	if err := m.offloadClosure(ctx, nodeExecutionModel); err != nil {
+		return false, err
+	}
+	nodeExecutionEventModel, err := transformers.CreateNodeExecutionEventModel(*request)
+	if err != nil {
+		return false, err
+	}
+	err = m.db.NodeExecutionRepo().Update(ctx, nodeExecutionEventModel, nodeExecutionModel)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to enrich node execution with id [%+v] with err %v",
+			request.Event.Id, err)
+		return false, err
+	}
+	logging.Debugf(ctx, logging.Executions, "Enriched terminated node execution [%+v] with late %s event",
+		request.Event.Id, request.Event.Phase.String())
+	m.metrics.LateEventsAccepted.Inc()
+	return true, nil
+}
+
 func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admin.NodeExecutionEventRequest) (
 	*admin.NodeExecutionEventResponse, error) {
+	receivedAt := time.Now()
 	executionID := request.Event.Id.ExecutionId
-	logger.Debugf(ctx, "Received node execution event for [%+v] transitioning to phase [%v]",
+	ctx = logging.WithExecutionID(ctx, executionID)
+	logging.Debugf(ctx, logging.Executions, "Received node execution event for [%+v] transitioning to phase [%v]",
 		executionID, request.Event.Phase)
+	m.metrics.EventMetrics.RecordIngestionDelay(
+		util.NodeEventType, request.Event.Phase.String(), request.Event.OccurredAt, receivedAt)
 
-	_, err := util.GetExecutionModel(ctx, m.db, *executionID)
+	lookupTimer := m.metrics.EventMetrics.LookupDuration.Start()
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *executionID)
 	if err != nil {
+		lookupTimer.Stop()
 		m.metrics.MissingWorkflowExecution.Inc()
-		logger.Debugf(ctx, "Failed to find existing execution with id [%+v] with err: %v", executionID, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to find existing execution with id [%+v] with err: %v", executionID, err)
 		if ferr, ok := err.(errors.FlyteAdminError); ok {
 			return nil, errors.NewFlyteAdminErrorf(ferr.Code(),
 				"Failed to get existing execution id:[%+v] with err: %v", executionID, err)
@@ -167,14 +351,14 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 	nodeExecutionModel, err := m.db.NodeExecutionRepo().Get(ctx, repoInterfaces.GetNodeExecutionInput{
 		NodeExecutionIdentifier: *request.Event.Id,
 	})
-	phase := core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase])
+	lookupTimer.Stop()
 	if err != nil {
 		if err.(errors.FlyteAdminError).Code() != codes.NotFound {
-			logger.Debugf(ctx, "Failed to retrieve existing node execution with id [%+v] with err: %v",
+			logging.Debugf(ctx, logging.Executions, "Failed to retrieve existing node execution with id [%+v] with err: %v",
 				request.Event.Id, err)
 			return nil, err
 		}
-		err = m.createNodeExecutionWithEvent(ctx, &request)
+		err = m.createNodeExecutionWithEvent(ctx, executionModel, &request)
 		if err != nil {
 			return nil, err
 		}
@@ -184,11 +368,9 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		if err != nil {
 			return nil, err
 		}
-
-		if updateStatus == alreadyInTerminalStatus {
-			curPhase := request.Event.Phase.String()
-			errorMsg := fmt.Sprintf("Invalid phase change from %s to %s for node execution %v", phase.String(), curPhase, nodeExecutionModel.ID)
-			return nil, errors.NewAlreadyInTerminalStateError(ctx, errorMsg, curPhase)
+		if updateStatus == lateEventEnriched {
+			m.publishCloudEvent(ctx, request)
+			return &admin.NodeExecutionEventResponse{}, nil
 		}
 	}
 
@@ -199,33 +381,57 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		m.metrics.NodeExecutionsTerminated.Inc()
 	}
 	m.metrics.NodeExecutionEventsCreated.Inc()
+	m.publishCloudEvent(ctx, request)
 
 	return &admin.NodeExecutionEventResponse{}, nil
 }
 
+// Publish failures are only logged: the event was recorded and failing the request would have propeller retry it.
+// Events may be recorded within a transaction, so they're only published once it commits.
+func (m *NodeExecutionManager) publishCloudEvent(ctx context.Context, request admin.NodeExecutionEventRequest) {
+	m.db.AfterCommit(ctx, func(ctx context.Context) {
+		if err := m.cloudEventPublisher.Publish(ctx, proto.MessageName(request.Event), request.Event); err != nil {
+			logger.Warningf(ctx, "Failed to publish cloud event for node execution [%+v] with err: %v",
+				request.Event.Id, err)
+		}
+	})
+}
+
 func (m *NodeExecutionManager) GetNodeExecution(
 	ctx context.Context, request admin.NodeExecutionGetRequest) (*admin.NodeExecution, error) {
 	if err := validation.ValidateNodeExecutionIdentifier(request.Id); err != nil {
-		logger.Debugf(ctx, "get node execution called with invalid identifier [%+v]: %v", request.Id, err)
+		logging.Debugf(ctx, logging.Executions, "get node execution called with invalid identifier [%+v]: %v", request.Id, err)
 	}
 	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.Id)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get node execution with id [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
 			request.Id, err)
 		return nil, err
 	}
-	nodeExecution, err := transformers.FromNodeExecutionModel(*nodeExecutionModel)
+	nodeExecution, err := transformers.FromNodeExecutionModel(ctx, m.storageClient, *nodeExecutionModel)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform node execution model [%+v] to proto with err: %v", request.Id, err)
+		logging.Debugf(ctx, logging.Executions, "failed to transform node execution model [%+v] to proto with err: %v", request.Id, err)
 		return nil, err
 	}
 	return nodeExecution, nil
 }
 
+// Lists node executions with either of the node execution repo's list functions.
+type listNodeExecutionsFunc func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+	repoInterfaces.NodeExecutionCollectionOutput, error)
+
 func (m *NodeExecutionManager) listNodeExecutions(
 	ctx context.Context, identifierFilters []common.InlineFilter,
 	requestFilters string, limit uint32, requestToken string, sortBy *admin.Sort, addIsParentFilter bool) (
 	*admin.NodeExecutionList, error) {
+	return m.listNodeExecutionsWith(ctx, m.db.NodeExecutionRepo().List, identifierFilters, requestFilters, limit,
+		requestToken, sortBy, addIsParentFilter)
+}
+
+func (m *NodeExecutionManager) listNodeExecutionsWith(
+	ctx context.Context, list listNodeExecutionsFunc, identifierFilters []common.InlineFilter,
+	requestFilters string, limit uint32, requestToken string, sortBy *admin.Sort, addIsParentFilter bool) (
+	*admin.NodeExecutionList, error) {
 
 	filters, err := util.AddRequestFilters(requestFilters, common.NodeExecution, identifierFilters)
 	if err != nil {
@@ -254,9 +460,9 @@ func (m *NodeExecutionManager) listNodeExecutions(
 			isParent,
 		}
 	}
-	output, err := m.db.NodeExecutionRepo().List(ctx, listInput)
+	output, err := list(ctx, listInput)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to list node executions for request with err %v", err)
+		logging.Debugf(ctx, logging.Executions, "Failed to list node executions for request with err %v", err)
 		return nil, err
 	}
 
@@ -264,9 +470,9 @@ func (m *NodeExecutionManager) listNodeExecutions(
 	if len(output.NodeExecutions) == int(limit) {
 		token = strconv.Itoa(offset + len(output.NodeExecutions))
 	}
-	nodeExecutionList, err := transformers.FromNodeExecutionModels(output.NodeExecutions)
+	nodeExecutionList, err := transformers.FromNodeExecutionModels(ctx, m.storageClient, output.NodeExecutions)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform node execution models for request with err: %v", err)
+		logging.Debugf(ctx, logging.Executions, "failed to transform node execution models for request with err: %v", err)
 		return nil, err
 	}
 
@@ -282,6 +488,12 @@ func (m *NodeExecutionManager) ListNodeExecutions(
 	if err := validation.ValidateNodeExecutionListRequest(request); err != nil {
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.NodeExecutionListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	identifierFilters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, *request.WorkflowExecutionId)
 	if err != nil {
@@ -299,6 +511,12 @@ func (m *NodeExecutionManager) ListNodeExecutionsForTask(
 	if err := validation.ValidateNodeExecutionForTaskListRequest(request); err != nil {
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.NodeExecutionListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 	identifierFilters, err := util.GetWorkflowExecutionIdentifierFilters(
 		ctx, *request.TaskExecutionId.NodeExecutionId.ExecutionId)
 	if err != nil {
@@ -318,42 +536,673 @@ func (m *NodeExecutionManager) ListNodeExecutionsForTask(
 		ctx, identifierFilters, request.Filters, request.Limit, request.Token, request.SortBy, !addIsParentFilter)
 }
 
+// Filters on node executions which were launched by the node execution identified in the request params, e.g. the
+// nodes yielded by a dynamic task, so that execution trees can be rendered one level at a time.
+func (m *NodeExecutionManager) ListNodeExecutionChildren(
+	ctx context.Context, request interfaces.NodeExecutionChildrenListRequest) (*admin.NodeExecutionList, error) {
+	// Check required fields
+	if err := validation.ValidateNodeExecutionIdentifier(request.ParentNodeExecutionID); err != nil {
+		return nil, err
+	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.NodeExecutionListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
+	identifierFilters, err := util.GetWorkflowExecutionIdentifierFilters(
+		ctx, *request.ParentNodeExecutionID.ExecutionId)
+	if err != nil {
+		return nil, err
+	}
+	parentNodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.ParentNodeExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	parentIDFilter, err := common.NewSingleValueFilter(
+		common.NodeExecution, common.Equal, shared.ParentID, parentNodeExecutionModel.ID)
+	if err != nil {
+		return nil, err
+	}
+	identifierFilters = append(identifierFilters, parentIDFilter)
+	return m.listNodeExecutions(
+		ctx, identifierFilters, request.Filters, request.Limit, request.Token, request.SortBy, !addIsParentFilter)
+}
+
+// Filters on node executions, across all executions of the project and domain identified in the request params, whose
+// task executions ran the task identified in the request params. The task version is optional so that all runs of a
+// task can be found regardless of the version they ran.
+func (m *NodeExecutionManager) ListNodeExecutionsByTask(
+	ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (*admin.NodeExecutionList, error) {
+	// Check required fields
+	if request.TaskID == nil {
+		return nil, shared.GetMissingArgumentError(shared.ID)
+	}
+	if err := validation.ValidateEmptyStringField(request.TaskID.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.TaskID.Domain, shared.Domain); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.TaskID.Name, shared.Name); err != nil {
+		return nil, err
+	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.NodeExecutionListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
+
+	identifierFilters := make([]common.InlineFilter, 0, 6)
+	for _, filter := range []struct {
+		entity common.Entity
+		field  string
+		value  string
+	}{
+		{common.Execution, shared.Project, request.TaskID.Project},
+		{common.Execution, shared.Domain, request.TaskID.Domain},
+		{common.TaskExecution, shared.Project, request.TaskID.Project},
+		{common.TaskExecution, shared.Domain, request.TaskID.Domain},
+		{common.TaskExecution, shared.Name, request.TaskID.Name},
+		{common.TaskExecution, shared.Version, request.TaskID.Version},
+	} {
+		if filter.value == "" {
+			continue
+		}
+		identifierFilter, err := util.GetSingleValueEqualityFilter(filter.entity, filter.field, filter.value)
+		if err != nil {
+			return nil, err
+		}
+		identifierFilters = append(identifierFilters, identifierFilter)
+	}
+	return m.listNodeExecutionsWith(ctx, m.db.NodeExecutionRepo().ListByTask, identifierFilters, request.Filters,
+		request.Limit, request.Token, request.SortBy, !addIsParentFilter)
+}
+
 func (m *NodeExecutionManager) GetNodeExecutionData(
 	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error) {
-	if err := validation.ValidateNodeExecutionIdentifier(request.Id); err != nil {
-		logger.Debugf(ctx, "can't get node execution data with invalid identifier [%+v]: %v", request.Id, err)
+	response, _, _, _, _, err := m.getNodeExecutionData(ctx, request)
+	return response, err
+}
+
+// Returns the signed URL blobs of a node execution's data and registered artifacts along with the inputs and outputs
+// themselves when they're small enough to be inlined.
+func (m *NodeExecutionManager) GetNodeExecutionFullData(
+	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+	response, nodeExecutionModel, inputsURI, outputsURI, errorURI, err := m.getNodeExecutionData(ctx, request)
+	if err != nil {
+		return nil, err
 	}
-	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.Id)
+	maxInlineSizeBytes := m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxInlineSizeBytes
+	fullInputs, err := util.GetInlineLiteralMap(ctx, m.storageClient, inputsURI, response.Inputs, maxInlineSizeBytes)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get node execution with id [%+v] with err %v",
-			request.Id, err)
 		return nil, err
 	}
-	nodeExecution, err := transformers.FromNodeExecutionModel(*nodeExecutionModel)
+	fullOutputs, err := util.GetInlineLiteralMap(ctx, m.storageClient, outputsURI, response.Outputs, maxInlineSizeBytes)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform node execution model [%+v] when fetching data: %v", request.Id, err)
 		return nil, err
 	}
-	signedInputsURLBlob, err := m.urlData.Get(ctx, nodeExecution.InputUri)
+	fullDataResponse := &interfaces.FullDataResponse{
+		Inputs:      response.Inputs,
+		Outputs:     response.Outputs,
+		FullInputs:  fullInputs,
+		FullOutputs: fullOutputs,
+	}
+	if errorURI != "" {
+		// Error documents are inlined regardless of the size threshold so that stack traces can be shown without
+		// direct access to the bucket.
+		signedErrorURLBlob, err := m.urlData.Get(ctx, errorURI)
+		if err != nil {
+			return nil, err
+		}
+		fullDataResponse.ErrorDocument = &signedErrorURLBlob
+		if fullDataResponse.Error, err = util.GetInlineExecutionError(ctx, m.storageClient, errorURI); err != nil {
+			return nil, err
+		}
+	}
+	if fullDataResponse.Artifacts, err = m.getSignedNodeExecutionArtifacts(ctx, nodeExecutionModel); err != nil {
+		return nil, err
+	}
+	return fullDataResponse, nil
+}
+
+// Fetches a node execution's model once the caller is verified to have access to its execution's data.
+func (m *NodeExecutionManager) getNodeExecutionModelForData(
+	ctx context.Context, nodeExecutionID *core.NodeExecutionIdentifier) (*models.NodeExecution, error) {
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, nodeExecutionID)
 	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			nodeExecutionID, err)
 		return nil, err
 	}
+	executionModel, err := util.GetExecutionModel(ctx, m.db, core.WorkflowExecutionIdentifier{
+		Project: nodeExecutionModel.Project,
+		Domain:  nodeExecutionModel.Domain,
+		Name:    nodeExecutionModel.Name,
+	})
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution for node execution [%+v] with err %v",
+			nodeExecutionID, err)
+		return nil, err
+	}
+	if err := util.ValidateDataAccess(ctx, *executionModel, m.config.ApplicationConfiguration()); err != nil {
+		return nil, err
+	}
+	return nodeExecutionModel, nil
+}
+
+// Returns the signed URL blobs of a node execution's data along with its model, the unsigned inputs and outputs URIs
+// they point to and the URI of the error document a failed node execution wrote.
+func (m *NodeExecutionManager) getNodeExecutionData(
+	ctx context.Context, request admin.NodeExecutionGetDataRequest) (
+	response *admin.NodeExecutionGetDataResponse, nodeExecutionModel *models.NodeExecution,
+	inputsURI, outputsURI, errorURI string, err error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.Id); err != nil {
+		logging.Debugf(ctx, logging.Executions, "can't get node execution data with invalid identifier [%+v]: %v", request.Id, err)
+	}
+	nodeExecutionModel, err = m.getNodeExecutionModelForData(ctx, request.Id)
+	if err != nil {
+		return nil, nil, "", "", "", err
+	}
+	nodeExecution, err := transformers.FromNodeExecutionModel(ctx, m.storageClient, *nodeExecutionModel)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "failed to transform node execution model [%+v] when fetching data: %v", request.Id, err)
+		return nil, nil, "", "", "", err
+	}
+	signedInputsURLBlob, err := m.urlData.Get(ctx, nodeExecution.InputUri)
+	if err != nil {
+		return nil, nil, "", "", "", err
+	}
 	signedOutputsURLBlob := admin.UrlBlob{}
 	if nodeExecution.Closure.GetOutputUri() != "" {
-		signedOutputsURLBlob, err = m.urlData.Get(ctx, nodeExecution.Closure.GetOutputUri())
+		outputsURI = nodeExecution.Closure.GetOutputUri()
+		signedOutputsURLBlob, err = m.urlData.Get(ctx, outputsURI)
 		if err != nil {
-			return nil, err
+			return nil, nil, "", "", "", err
 		}
 	}
 	return &admin.NodeExecutionGetDataResponse{
 		Inputs:  &signedInputsURLBlob,
 		Outputs: &signedOutputsURLBlob,
+	}, nodeExecutionModel, nodeExecution.InputUri, outputsURI, nodeExecution.Closure.GetError().GetErrorUri(), nil
+}
+
+// Returns the data of one retry attempt of a node execution's task, which GetNodeExecutionData can't since node
+// executions only reference the data of their latest attempt.
+func (m *NodeExecutionManager) GetNodeExecutionAttemptData(
+	ctx context.Context, request interfaces.NodeExecutionAttemptDataGetRequest) (
+	*interfaces.NodeExecutionAttemptDataResponse, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
+		return nil, err
+	}
+	if _, err := m.getNodeExecutionModelForData(ctx, request.NodeExecutionID); err != nil {
+		return nil, err
+	}
+	filters, err := util.GetNodeExecutionIdentifierFilters(ctx, *request.NodeExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	retryAttemptFilter, err := common.NewSingleValueFilter(
+		common.TaskExecution, common.Equal, shared.RetryAttempt, request.RetryAttempt)
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.db.TaskExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		InlineFilters: append(filters, retryAttemptFilter),
+		Limit:         1,
+	})
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to list task executions of node execution [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	if len(output.TaskExecutions) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"node execution [%+v] has no retry attempt %d", request.NodeExecutionID, request.RetryAttempt)
+	}
+	taskExecutionModel := output.TaskExecutions[0]
+	var closure admin.TaskExecutionClosure
+	if err := proto.Unmarshal(taskExecutionModel.Closure, &closure); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal closure of task execution [%+v] with err %v", request.NodeExecutionID, err)
+	}
+	outputsURI := taskExecutionModel.OutputURI
+	if outputsURI == "" {
+		// Attempts which terminated before their output locations were recorded on the model.
+		outputsURI = closure.GetOutputUri()
+	}
+	errorURI := taskExecutionModel.ErrorURI
+	if errorURI == "" {
+		errorURI = closure.GetError().GetErrorUri()
+	}
+
+	response := &interfaces.NodeExecutionAttemptDataResponse{
+		Error: closure.GetError(),
+	}
+	if taskExecutionModel.InputURI != "" {
+		signedInputsURLBlob, err := m.urlData.Get(ctx, taskExecutionModel.InputURI)
+		if err != nil {
+			return nil, err
+		}
+		response.Inputs = &signedInputsURLBlob
+	}
+	if outputsURI != "" {
+		signedOutputsURLBlob, err := m.urlData.Get(ctx, outputsURI)
+		if err != nil {
+			return nil, err
+		}
+		response.Outputs = &signedOutputsURLBlob
+	}
+	if errorURI != "" {
+		signedErrorURLBlob, err := m.urlData.Get(ctx, errorURI)
+		if err != nil {
+			return nil, err
+		}
+		response.ErrorDocument = &signedErrorURLBlob
+	}
+	return response, nil
+}
+
+func (m *NodeExecutionManager) createDynamicWorkflowDataReference(
+	ctx context.Context, identifier *core.NodeExecutionIdentifier) (storage.DataReference, error) {
+	nestedKeys := make([]string, 0, len(m.storagePrefix)+5)
+	nestedKeys = append(nestedKeys, m.storagePrefix...)
+	nestedKeys = append(nestedKeys, identifier.ExecutionId.Project, identifier.ExecutionId.Domain,
+		identifier.ExecutionId.Name, "dynamic", identifier.NodeId)
+	return m.storageClient.ConstructReference(ctx, m.storageClient.GetBaseContainerFQN(ctx), nestedKeys...)
+}
+
+// Offloads the workflow closure compiled at runtime by the task of a dynamic node and references it from the node
+// execution. Retried dynamic tasks overwrite the closure recorded by earlier attempts.
+func (m *NodeExecutionManager) CreateDynamicNodeWorkflow(
+	ctx context.Context, request interfaces.DynamicNodeWorkflowCreateRequest) (
+	*interfaces.DynamicNodeWorkflowCreateResponse, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
+		return nil, err
+	}
+	if request.CompiledWorkflow == nil || request.CompiledWorkflow.Primary == nil {
+		return nil, shared.GetMissingArgumentError(shared.CompiledWorkflow)
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.NodeExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	createdAt, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Failed to serialize CreatedAt: %v when saving dynamic workflow for %+v", err, request.NodeExecutionID)
+	}
+	remoteClosureDataRef, err := m.createDynamicWorkflowDataReference(ctx, request.NodeExecutionID)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to construct data reference for dynamic workflow closure of [%+v] with err %v",
+			request.NodeExecutionID, err)
+	}
+	err = m.storageClient.WriteProtobuf(ctx, remoteClosureDataRef, storage.Options{}, &admin.WorkflowClosure{
+		CompiledWorkflow: request.CompiledWorkflow,
+		CreatedAt:        createdAt,
+	})
+	if err != nil {
+		logger.Infof(ctx, "failed to write dynamic workflow closure of [%+v] to storage %s with err %v",
+			request.NodeExecutionID, remoteClosureDataRef.String(), err)
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to write dynamic workflow closure of [%+v] to storage %s with err %v",
+			request.NodeExecutionID, remoteClosureDataRef.String(), err)
+	}
+	err = m.db.NodeExecutionRepo().UpdateNodeExecution(ctx, models.NodeExecution{
+		BaseModel:                             nodeExecutionModel.BaseModel,
+		NodeExecutionKey:                      nodeExecutionModel.NodeExecutionKey,
+		DynamicWorkflowRemoteClosureReference: remoteClosureDataRef.String(),
+	})
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to record dynamic workflow for node execution [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	m.metrics.DynamicWorkflowsCreated.Inc()
+	return &interfaces.DynamicNodeWorkflowCreateResponse{}, nil
+}
+
+func (m *NodeExecutionManager) GetDynamicNodeWorkflow(
+	ctx context.Context, request interfaces.DynamicNodeWorkflowGetRequest) (*admin.WorkflowClosure, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
+		return nil, err
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.NodeExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	if nodeExecutionModel.DynamicWorkflowRemoteClosureReference == "" {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"node execution [%+v] has no dynamic workflow", request.NodeExecutionID)
+	}
+	return util.FetchAndGetWorkflowClosure(
+		ctx, m.storageClient, nodeExecutionModel.DynamicWorkflowRemoteClosureReference)
+}
+
+// Records the catalog cache information reported for the task of a node execution. Retried tasks overwrite the
+// information recorded by earlier attempts.
+func (m *NodeExecutionManager) CreateNodeExecutionCacheMetadata(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataCreateRequest) (
+	*interfaces.NodeExecutionCacheMetadataCreateResponse, error) {
+	if err := validation.ValidateNodeExecutionCacheMetadataCreateRequest(request); err != nil {
+		return nil, err
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.NodeExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	cacheMetadata, err := json.Marshal(request.Metadata)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to marshal cache metadata of [%+v] with err %v", request.NodeExecutionID, err)
+	}
+	err = m.db.NodeExecutionRepo().UpdateNodeExecution(ctx, models.NodeExecution{
+		BaseModel:        nodeExecutionModel.BaseModel,
+		NodeExecutionKey: nodeExecutionModel.NodeExecutionKey,
+		CacheStatus:      request.Metadata.CacheStatus,
+		CacheMetadata:    cacheMetadata,
+	})
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to record cache metadata for node execution [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	return &interfaces.NodeExecutionCacheMetadataCreateResponse{}, nil
+}
+
+func (m *NodeExecutionManager) GetNodeExecutionCacheMetadata(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataGetRequest) (
+	*interfaces.NodeExecutionCacheMetadata, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
+		return nil, err
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.NodeExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	if len(nodeExecutionModel.CacheMetadata) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"node execution [%+v] has no cache metadata", request.NodeExecutionID)
+	}
+	var cacheMetadata interfaces.NodeExecutionCacheMetadata
+	if err := json.Unmarshal(nodeExecutionModel.CacheMetadata, &cacheMetadata); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal cache metadata of [%+v] with err %v", request.NodeExecutionID, err)
+	}
+	return &cacheMetadata, nil
+}
+
+func getNodeExecutionArtifacts(nodeExecutionModel *models.NodeExecution) ([]interfaces.NodeExecutionArtifact, error) {
+	if len(nodeExecutionModel.Artifacts) == 0 {
+		return nil, nil
+	}
+	var artifacts []interfaces.NodeExecutionArtifact
+	if err := json.Unmarshal(nodeExecutionModel.Artifacts, &artifacts); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal artifacts of node execution [%+v] with err %v",
+			nodeExecutionModel.NodeExecutionKey, err)
+	}
+	return artifacts, nil
+}
+
+func (m *NodeExecutionManager) getSignedNodeExecutionArtifacts(
+	ctx context.Context, nodeExecutionModel *models.NodeExecution) ([]interfaces.SignedNodeExecutionArtifact, error) {
+	artifacts, err := getNodeExecutionArtifacts(nodeExecutionModel)
+	if err != nil {
+		return nil, err
+	}
+	signedArtifacts := make([]interfaces.SignedNodeExecutionArtifact, len(artifacts))
+	for idx, artifact := range artifacts {
+		signedURLBlob, err := m.urlData.Get(ctx, artifact.URI)
+		if err != nil {
+			return nil, err
+		}
+		signedArtifacts[idx] = interfaces.SignedNodeExecutionArtifact{
+			NodeExecutionArtifact: artifact,
+			SignedURL:             &signedURLBlob,
+		}
+	}
+	return signedArtifacts, nil
+}
+
+// Registers the auxiliary artifacts, such as HTML reports and profiling dumps, written by the task of a node execution.
+// Artifacts replace those registered earlier under the same name, e.g. by previous attempts, and are otherwise kept in
+// the order they were first registered.
+func (m *NodeExecutionManager) CreateNodeExecutionArtifacts(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsCreateRequest) (
+	*interfaces.NodeExecutionArtifactsCreateResponse, error) {
+	if err := validation.ValidateNodeExecutionArtifactsCreateRequest(request); err != nil {
+		return nil, err
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.NodeExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	artifacts, err := getNodeExecutionArtifacts(nodeExecutionModel)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int, len(artifacts))
+	for idx, artifact := range artifacts {
+		indexes[artifact.Name] = idx
+	}
+	for _, artifact := range request.Artifacts {
+		if idx, ok := indexes[artifact.Name]; ok {
+			artifacts[idx] = artifact
+			continue
+		}
+		indexes[artifact.Name] = len(artifacts)
+		artifacts = append(artifacts, artifact)
+	}
+	serializedArtifacts, err := json.Marshal(artifacts)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to marshal artifacts of [%+v] with err %v", request.NodeExecutionID, err)
+	}
+	err = m.db.NodeExecutionRepo().UpdateNodeExecution(ctx, models.NodeExecution{
+		BaseModel:        nodeExecutionModel.BaseModel,
+		NodeExecutionKey: nodeExecutionModel.NodeExecutionKey,
+		Artifacts:        serializedArtifacts,
+	})
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to record artifacts for node execution [%+v] with err %v",
+			request.NodeExecutionID, err)
+		return nil, err
+	}
+	return &interfaces.NodeExecutionArtifactsCreateResponse{}, nil
+}
+
+// Returns the artifacts registered by the task of a node execution along with signed URLs to fetch them from.
+func (m *NodeExecutionManager) GetNodeExecutionArtifacts(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsGetRequest) (
+	*interfaces.NodeExecutionArtifactsGetResponse, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
+		return nil, err
+	}
+	nodeExecutionModel, err := m.getNodeExecutionModelForData(ctx, request.NodeExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	signedArtifacts, err := m.getSignedNodeExecutionArtifacts(ctx, nodeExecutionModel)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.NodeExecutionArtifactsGetResponse{
+		Artifacts: signedArtifacts,
+	}, nil
+}
+
+// Returns the counts of a parent node execution's children by phase, which are kept up to date as children report
+// events.
+func (m *NodeExecutionManager) GetNodeExecutionChildRollup(
+	ctx context.Context, request interfaces.NodeExecutionChildRollupGetRequest) (
+	*interfaces.NodeExecutionChildRollup, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.ParentNodeExecutionID); err != nil {
+		return nil, err
+	}
+	parentNodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.ParentNodeExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get node execution with id [%+v] with err %v",
+			request.ParentNodeExecutionID, err)
+		return nil, err
+	}
+	childPhaseCounts, err := m.db.NodeExecutionRepo().ListChildPhaseCounts(ctx, parentNodeExecutionModel.ID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to list child phase counts of node execution [%+v] with err %v",
+			request.ParentNodeExecutionID, err)
+		return nil, err
+	}
+	rollup := &interfaces.NodeExecutionChildRollup{
+		PhaseCounts: make(map[string]int64, len(childPhaseCounts)),
+	}
+	for _, childPhaseCount := range childPhaseCounts {
+		rollup.PhaseCounts[childPhaseCount.Phase] = childPhaseCount.Count
+		rollup.Total += childPhaseCount.Count
+	}
+	return rollup, nil
+}
+
+// Node execution events are read in pages of this size when computing node execution metrics.
+const nodeExecutionEventsPageSize = 1000
+
+var ascOccurredAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "node_execution_events.occurred_at",
+})
+
+// When a node execution first reported each of the phases which bound its lifecycle stages.
+type nodeExecutionPhaseTimes struct {
+	phase      string
+	createdAt  time.Time
+	queuedAt   *time.Time
+	runningAt  *time.Time
+	terminalAt *time.Time
+}
+
+func durationBetween(start, end *time.Time) *time.Duration {
+	if start == nil || end == nil {
+		return nil
+	}
+	duration := end.Sub(*start)
+	return &duration
+}
+
+// Events must be recorded in the order they occurred.
+func (t *nodeExecutionPhaseTimes) recordEvent(event models.NodeExecutionEvent) {
+	t.phase = event.Phase
+	occurredAt := event.OccurredAt
+	phase := core.NodeExecution_Phase(core.NodeExecution_Phase_value[event.Phase])
+	switch {
+	case phase == core.NodeExecution_QUEUED && t.queuedAt == nil:
+		t.queuedAt = &occurredAt
+	case phase == core.NodeExecution_RUNNING && t.runningAt == nil:
+		t.runningAt = &occurredAt
+	case common.IsNodeExecutionTerminal(phase) && t.terminalAt == nil:
+		t.terminalAt = &occurredAt
+	}
+}
+
+func (t *nodeExecutionPhaseTimes) toMetrics(nodeID string) interfaces.NodeExecutionMetrics {
+	return interfaces.NodeExecutionMetrics{
+		NodeID:            nodeID,
+		Phase:             t.phase,
+		CreatedAt:         t.createdAt,
+		CreatedToQueued:   durationBetween(&t.createdAt, t.queuedAt),
+		QueuedToRunning:   durationBetween(t.queuedAt, t.runningAt),
+		RunningToTerminal: durationBetween(t.runningAt, t.terminalAt),
+		Total:             durationBetween(&t.createdAt, t.terminalAt),
+	}
+}
+
+// Computes the time each node execution of a workflow execution spent created, queued and running from its stored
+// events, so the slowest stages of an execution can be found without querying prometheus.
+func (m *NodeExecutionManager) GetNodeExecutionMetrics(
+	ctx context.Context, request interfaces.NodeExecutionMetricsGetRequest) (
+	*interfaces.NodeExecutionMetricsGetResponse, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.WorkflowExecutionID); err != nil {
+		return nil, err
+	}
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.WorkflowExecutionID)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution with id [%+v] with err %v",
+			request.WorkflowExecutionID, err)
+		return nil, err
+	}
+	filters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, *request.WorkflowExecutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeIDs := make([]string, 0)
+	phaseTimesByNodeID := make(map[string]*nodeExecutionPhaseTimes)
+	recordEvent := func(event models.NodeExecutionEvent) {
+		phaseTimes, ok := phaseTimesByNodeID[event.NodeID]
+		if !ok {
+			phaseTimes = &nodeExecutionPhaseTimes{
+				createdAt: event.OccurredAt,
+			}
+			phaseTimesByNodeID[event.NodeID] = phaseTimes
+			nodeIDs = append(nodeIDs, event.NodeID)
+		}
+		phaseTimes.recordEvent(event)
+	}
+	// Compacted events precede those stored since the execution's events were compacted.
+	summary, err := util.GetExecutionEventSummary(ctx, m.db, executionModel.ExecutionKey)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get event summary of [%+v] with err %v",
+			request.WorkflowExecutionID, err)
+		return nil, err
+	}
+	if summary != nil {
+		for _, event := range summary.GetNodeExecutionEvents(executionModel.ExecutionKey) {
+			recordEvent(event)
+		}
+	}
+	for offset := 0; ; offset += nodeExecutionEventsPageSize {
+		output, err := m.db.NodeExecutionRepo().ListEvents(ctx, repoInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         nodeExecutionEventsPageSize,
+			Offset:        offset,
+			SortParameter: ascOccurredAtSortParam,
+		})
+		if err != nil {
+			logging.Debugf(ctx, logging.Executions, "Failed to list node execution events of [%+v] with err %v",
+				request.WorkflowExecutionID, err)
+			return nil, err
+		}
+		for _, event := range output.NodeExecutionEvents {
+			recordEvent(event)
+		}
+		if len(output.NodeExecutionEvents) < nodeExecutionEventsPageSize {
+			break
+		}
+	}
+
+	nodeExecutions := make([]interfaces.NodeExecutionMetrics, len(nodeIDs))
+	for idx, nodeID := range nodeIDs {
+		nodeExecutions[idx] = phaseTimesByNodeID[nodeID].toMetrics(nodeID)
+	}
+	return &interfaces.NodeExecutionMetricsGetResponse{
+		NodeExecutions: nodeExecutions,
 	}, nil
 }
 
 func NewNodeExecutionManager(
-	db repositories.RepositoryInterface, scope promutils.Scope,
-	urlData dataInterfaces.RemoteURLInterface) interfaces.NodeExecutionInterface {
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storagePrefix []string,
+	storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface,
+	cloudEventPublisher cloudEventInterfaces.Publisher) interfaces.NodeExecutionInterface {
 	metrics := nodeExecutionMetrics{
 		Scope: scope,
 		ActiveNodeExecutions: scope.MustNewGauge("active_node_executions",
@@ -368,10 +1217,22 @@ func NewNodeExecutionManager(
 			"overall count of node execution events received that are missing a parent workflow execution"),
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes",
 			"size in bytes of serialized node execution closure"),
+		LateEventsAccepted: scope.MustNewCounter("late_events_accepted",
+			"overall count of events received after a node execution terminated which enriched its closure"),
+		DynamicWorkflowsCreated: scope.MustNewCounter("dynamic_workflows_created",
+			"overall count of workflows compiled at runtime by dynamic nodes which were recorded"),
+		ClosuresOffloaded: scope.MustNewCounter("closures_offloaded",
+			"overall count of node execution closure writes offloaded to blob storage for exceeding the configured size"),
+		EventMetrics: util.NewEventMetrics(scope),
 	}
 	return &NodeExecutionManager{
-		db:      db,
-		metrics: metrics,
-		urlData: urlData,
+		db:            db,
+		config:        config,
+		storagePrefix: storagePrefix,
+		storageClient: storageClient,
+		metrics:       metrics,
+		urlData:       urlData,
+
+		cloudEventPublisher: cloudEventPublisher,
 	}
 }
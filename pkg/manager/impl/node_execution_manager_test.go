@@ -2,23 +2,32 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	cloudEventMocks "github.com/lyft/flyteadmin/pkg/async/cloudevent/mocks"
 	"github.com/lyft/flyteadmin/pkg/common"
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
 	dataMocks "github.com/lyft/flyteadmin/pkg/data/mocks"
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
 	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 )
@@ -57,6 +66,7 @@ var workflowExecutionIdentifier = core.WorkflowExecutionIdentifier{
 }
 
 var mockNodeExecutionRemoteURL = dataMocks.NewMockRemoteURL()
+var mockCloudEventPublisher = &cloudEventMocks.MockPublisher{}
 
 func addGetExecutionCallback(t *testing.T, repository repositories.RepositoryInterface) {
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
@@ -128,12 +138,288 @@ func TestCreateNodeEvent(t *testing.T) {
 			}, *input)
 			return nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
 }
 
+func TestCreateNodeEvent_OffloadClosure(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var createdModel models.NodeExecution
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, event *models.NodeExecutionEvent, input *models.NodeExecution) error {
+			createdModel = *input
+			return nil
+		})
+	mockStorage := commonMocks.GetMockStorageClient()
+	offloadedClosures := make(map[storage.DataReference]proto.Message)
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).WriteProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, opts storage.Options, msg proto.Message) error {
+		offloadedClosures[reference] = proto.Clone(msg)
+		return nil
+	}
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		proto.Merge(msg, offloadedClosures[reference])
+		return nil
+	}
+	configProvider := getMockExecutionsConfigProvider()
+	configProvider.ApplicationConfiguration().GetTopLevelConfig().ClosureOffloading.NodeExecutionThresholdBytes = 1
+	nodeExecManager := NewNodeExecutionManager(
+		repository, configProvider, storagePrefix, mockStorage, mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://bucket/metadata/admin/project/domain/name/closure/node id",
+		createdModel.RemoteClosureReference)
+	assert.Len(t, offloadedClosures, 1)
+
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return createdModel, nil
+		})
+	nodeExecution, err := nodeExecManager.GetNodeExecution(context.Background(), admin.NodeExecutionGetRequest{
+		Id: &nodeExecutionIdentifier,
+	})
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&admin.NodeExecutionClosure{
+		Phase:     request.Event.Phase,
+		StartedAt: occurredAtProto,
+		CreatedAt: occurredAtProto,
+		UpdatedAt: occurredAtProto,
+	}, nodeExecution.Closure))
+}
+
+func getSubWorkflowTestClosure() *core.CompiledWorkflowClosure {
+	return &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Id:    &core.Identifier{Name: "parent"},
+				Nodes: []*core.Node{{Id: "parent node"}},
+			},
+		},
+		SubWorkflows: []*core.CompiledWorkflow{
+			{
+				Template: &core.WorkflowTemplate{
+					Id:    &core.Identifier{Name: "child"},
+					Nodes: []*core.Node{{Id: "node id"}},
+				},
+			},
+		},
+	}
+}
+
+func TestGetSubWorkflowID(t *testing.T) {
+	closure := getSubWorkflowTestClosure()
+	assert.Nil(t, getSubWorkflowID(closure, "parent node", false))
+	assert.Equal(t, "parent", getSubWorkflowID(closure, "parent node", true).Name)
+	assert.Equal(t, "child", getSubWorkflowID(closure, "node id", false).Name)
+	assert.Nil(t, getSubWorkflowID(closure, "unknown", true))
+}
+
+func TestCreateNodeEvent_SubWorkflow(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	workflowID := &core.Identifier{
+		ResourceType: core.ResourceType_WORKFLOW,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "parent",
+		Version:      "version",
+	}
+	executionClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		WorkflowId: workflowID,
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				Closure: executionClosureBytes,
+			}, nil
+		})
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Workflow, error) {
+			assert.Equal(t, "parent", input.Name)
+			return models.Workflow{
+				WorkflowKey: models.WorkflowKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				TypedInterface:          testutils.GetWorkflowRequestInterfaceBytes(),
+				RemoteClosureIdentifier: "s3://bucket/closure",
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var createdModel models.NodeExecution
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, event *models.NodeExecutionEvent, input *models.NodeExecution) error {
+			createdModel = *input
+			return nil
+		})
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		assert.Equal(t, storage.DataReference("s3://bucket/closure"), reference)
+		proto.Merge(msg, &admin.WorkflowClosure{
+			CompiledWorkflow: getSubWorkflowTestClosure(),
+		})
+		return nil
+	}
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix, mockStorage, mockScope.NewTestScope(),
+		mockNodeExecutionRemoteURL, mockCloudEventPublisher)
+	_, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, "child", createdModel.SubWorkflowName)
+	assert.Empty(t, createdModel.SubWorkflowVersion)
+}
+
+func TestCreateNodeEvent_PublishCloudEvent(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var published bool
+	publisher := &cloudEventMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, eventType string, msg proto.Message) error {
+		published = true
+		assert.Equal(t, "flyteidl.event.NodeExecutionEvent", eventType)
+		assert.True(t, proto.Equal(request.Event, msg))
+		return errors.New("topic unavailable")
+	})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL, publisher)
+	// Failing to mirror the event doesn't fail recording it.
+	_, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.True(t, published)
+}
+
+func TestCreateNodeEvent_ParentNodeExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{
+				BaseModel: models.BaseModel{
+					ID: uint(8),
+				},
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			if input.NodeExecutionIdentifier.NodeId == "parent node id" {
+				return models.NodeExecution{
+					BaseModel: models.BaseModel{
+						ID: uint(4),
+					},
+				}, nil
+			}
+			return models.NodeExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var createCalled bool
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, event *models.NodeExecutionEvent, input *models.NodeExecution) error {
+			createCalled = true
+			assert.Equal(t, uint(8), input.ParentTaskExecutionID)
+			assert.Equal(t, uint(4), input.ParentID)
+			return nil
+		})
+	var childPhaseCountsUpdated bool
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetUpdateChildPhaseCountsCallback(
+		func(ctx context.Context, input interfaces.UpdateChildPhaseCountsInput) error {
+			childPhaseCountsUpdated = true
+			assert.Equal(t, interfaces.UpdateChildPhaseCountsInput{
+				ParentID: 4,
+				Phase:    core.NodeExecution_RUNNING.String(),
+			}, input)
+			return nil
+		})
+	childRequest := proto.Clone(&request).(*admin.NodeExecutionEventRequest)
+	childRequest.Event.ParentTaskMetadata = &event.ParentTaskExecutionMetadata{
+		Id: &core.TaskExecutionIdentifier{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "task",
+				Version:      "version",
+			},
+			NodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId:      "parent node id",
+				ExecutionId: &workflowExecutionIdentifier,
+			},
+		},
+	}
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.CreateNodeEvent(context.Background(), *childRequest)
+	assert.Nil(t, err)
+	assert.True(t, createCalled)
+	assert.True(t, childPhaseCountsUpdated)
+}
+
+func TestCreateNodeEvent_UpdateChildPhaseCounts(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase:    core.NodeExecution_QUEUED.String(),
+				InputURI: "input uri",
+				ParentID: 4,
+			}, nil
+		})
+	var childPhaseCountsUpdated bool
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetUpdateChildPhaseCountsCallback(
+		func(ctx context.Context, input interfaces.UpdateChildPhaseCountsInput) error {
+			childPhaseCountsUpdated = true
+			assert.Equal(t, interfaces.UpdateChildPhaseCountsInput{
+				ParentID:      4,
+				PreviousPhase: core.NodeExecution_QUEUED.String(),
+				Phase:         core.NodeExecution_RUNNING.String(),
+			}, input)
+			return nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.True(t, childPhaseCountsUpdated)
+}
+
 func TestCreateNodeEvent_Update(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	addGetExecutionCallback(t, repository)
@@ -183,7 +469,10 @@ func TestCreateNodeEvent_Update(t *testing.T) {
 
 			return nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
@@ -196,7 +485,10 @@ func TestCreateNodeEvent_MissingExecution(t *testing.T) {
 		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
 			return models.Execution{}, expectedErr
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.EqualError(t, err, "failed to get existing execution id: [project:\"project\""+
 		" domain:\"domain\" name:\"name\" ] with err: expected error")
@@ -216,7 +508,10 @@ func TestCreateNodeEvent_CreateDatabaseError(t *testing.T) {
 		func(ctx context.Context, event *models.NodeExecutionEvent, input *models.NodeExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -251,7 +546,10 @@ func TestCreateNodeEvent_UpdateDatabaseError(t *testing.T) {
 		func(ctx context.Context, event *models.NodeExecutionEvent, nodeExecution *models.NodeExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -280,7 +578,10 @@ func TestCreateNodeEvent_UpdateTerminalEventError(t *testing.T) {
 				StartedAt: &occurredAt,
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.Nil(t, resp)
 	assert.NotNil(t, err)
@@ -315,7 +616,10 @@ func TestCreateNodeEvent_UpdateDuplicateEventError(t *testing.T) {
 				StartedAt: &occurredAt,
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateNodeEvent(context.Background(), request)
 	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
 	assert.Nil(t, resp)
@@ -328,7 +632,10 @@ func TestCreateNodeEvent_FirstEventIsTerminal(t *testing.T) {
 		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
 			return models.NodeExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	succeededRequest := admin.NodeExecutionEventRequest{
 		RequestId: "request id",
 		Event: &event.NodeExecutionEvent{
@@ -383,7 +690,10 @@ func TestGetNodeExecution(t *testing.T) {
 				Closure:   closureBytes,
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecution, err := nodeExecManager.GetNodeExecution(context.Background(), admin.NodeExecutionGetRequest{
 		Id: &nodeExecutionIdentifier,
 	})
@@ -402,7 +712,10 @@ func TestGetNodeExecution_DatabaseError(t *testing.T) {
 		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
 			return models.NodeExecution{}, expectedErr
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecution, err := nodeExecManager.GetNodeExecution(context.Background(), admin.NodeExecutionGetRequest{
 		Id: &nodeExecutionIdentifier,
 	})
@@ -429,7 +742,10 @@ func TestGetNodeExecution_TransformerError(t *testing.T) {
 				Closure:   []byte("i'm invalid"),
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecution, err := nodeExecManager.GetNodeExecution(context.Background(), admin.NodeExecutionGetRequest{
 		Id: &nodeExecutionIdentifier,
 	})
@@ -490,7 +806,10 @@ func TestListNodeExecutions(t *testing.T) {
 				},
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecutions, err := nodeExecManager.ListNodeExecutions(context.Background(), admin.NodeExecutionListRequest{
 		WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -522,7 +841,9 @@ func TestListNodeExecutions(t *testing.T) {
 }
 
 func TestListNodeExecutions_InvalidParams(t *testing.T) {
-	nodeExecManager := NewNodeExecutionManager(nil, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(nil, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	_, err := nodeExecManager.ListNodeExecutions(context.Background(), admin.NodeExecutionListRequest{
 		Filters: "eq(execution.project, project)",
 	})
@@ -548,7 +869,10 @@ func TestListNodeExecutions_DatabaseError(t *testing.T) {
 			interfaces.NodeExecutionCollectionOutput, error) {
 			return interfaces.NodeExecutionCollectionOutput{}, expectedErr
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecutions, err := nodeExecManager.ListNodeExecutions(context.Background(), admin.NodeExecutionListRequest{
 		WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -586,7 +910,10 @@ func TestListNodeExecutions_TransformerError(t *testing.T) {
 				},
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecutions, err := nodeExecManager.ListNodeExecutions(context.Background(), admin.NodeExecutionListRequest{
 		WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -614,7 +941,10 @@ func TestListNodeExecutions_NothingToReturn(t *testing.T) {
 			listExecutionsCalled = true
 			return interfaces.ExecutionCollectionOutput{}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	_, err := nodeExecManager.ListNodeExecutions(context.Background(), admin.NodeExecutionListRequest{
 		WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -692,7 +1022,10 @@ func TestListNodeExecutionsForTask(t *testing.T) {
 				},
 			}, nil
 		})
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	nodeExecutions, err := nodeExecManager.ListNodeExecutionsForTask(context.Background(), admin.NodeExecutionForTaskListRequest{
 		TaskExecutionId: &core.TaskExecutionIdentifier{
 			NodeExecutionId: &core.NodeExecutionIdentifier{
@@ -735,6 +1068,211 @@ func TestListNodeExecutionsForTask(t *testing.T) {
 	assert.Equal(t, "3", nodeExecutions.Token)
 }
 
+func TestListNodeExecutionChildren(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	expectedClosure := admin.NodeExecutionClosure{
+		Phase: core.NodeExecution_SUCCEEDED,
+	}
+	closureBytes, _ := proto.Marshal(&expectedClosure)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			assert.Equal(t, "parent node id", input.NodeExecutionIdentifier.NodeId)
+			return models.NodeExecution{
+				BaseModel: models.BaseModel{
+					ID: uint(4),
+				},
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionCollectionOutput, error) {
+			assert.Equal(t, 1, input.Limit)
+			assert.Equal(t, 0, input.Offset)
+			assert.Empty(t, input.MapFilters)
+			assert.Len(t, input.InlineFilters, 4)
+			assert.Equal(t, common.NodeExecution, input.InlineFilters[3].GetEntity())
+			queryExpr, _ := input.InlineFilters[3].GetGormQueryExpr()
+			assert.Equal(t, uint(4), queryExpr.Args)
+			assert.Equal(t, "parent_id = ?", queryExpr.Query)
+			return interfaces.NodeExecutionCollectionOutput{
+				NodeExecutions: []models.NodeExecution{
+					{
+						NodeExecutionKey: models.NodeExecutionKey{
+							NodeID: "child node id",
+							ExecutionKey: models.ExecutionKey{
+								Project: "project",
+								Domain:  "domain",
+								Name:    "name",
+							},
+						},
+						Phase:    core.NodeExecution_SUCCEEDED.String(),
+						InputURI: "input uri",
+						Closure:  closureBytes,
+						ParentID: 4,
+					},
+				},
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	nodeExecutions, err := nodeExecManager.ListNodeExecutionChildren(
+		context.Background(), managerInterfaces.NodeExecutionChildrenListRequest{
+			ParentNodeExecutionID: &core.NodeExecutionIdentifier{
+				NodeId:      "parent node id",
+				ExecutionId: &workflowExecutionIdentifier,
+			},
+			Limit: 1,
+		})
+	assert.Nil(t, err)
+	assert.Len(t, nodeExecutions.NodeExecutions, 1)
+	assert.Equal(t, "child node id", nodeExecutions.NodeExecutions[0].Id.NodeId)
+	assert.Equal(t, "1", nodeExecutions.Token)
+}
+
+func TestListNodeExecutionChildren_InvalidParent(t *testing.T) {
+	nodeExecManager := NewNodeExecutionManager(repositoryMocks.NewMockRepository(),
+		getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.ListNodeExecutionChildren(
+		context.Background(), managerInterfaces.NodeExecutionChildrenListRequest{
+			ParentNodeExecutionID: &core.NodeExecutionIdentifier{
+				ExecutionId: &workflowExecutionIdentifier,
+			},
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListNodeExecutionsByTask(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	expectedClosure := admin.NodeExecutionClosure{
+		Phase: core.NodeExecution_SUCCEEDED,
+	}
+	closureBytes, _ := proto.Marshal(&expectedClosure)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionCollectionOutput, error) {
+			t.Fatal("node executions by task must be listed joined with their task executions")
+			return interfaces.NodeExecutionCollectionOutput{}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListByTaskCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionCollectionOutput, error) {
+			assert.Equal(t, 1, input.Limit)
+			assert.Equal(t, 2, input.Offset)
+			assert.Empty(t, input.MapFilters)
+			assert.Len(t, input.InlineFilters, 6)
+			expectedFilters := []struct {
+				entity common.Entity
+				value  string
+			}{
+				{common.Execution, "project"},
+				{common.Execution, "domain"},
+				{common.TaskExecution, "project"},
+				{common.TaskExecution, "domain"},
+				{common.TaskExecution, "task"},
+				{common.NodeExecution, "SUCCEEDED"},
+			}
+			for idx, expected := range expectedFilters {
+				assert.Equal(t, expected.entity, input.InlineFilters[idx].GetEntity())
+				queryExpr, _ := input.InlineFilters[idx].GetGormQueryExpr()
+				assert.Equal(t, expected.value, queryExpr.Args)
+			}
+			return interfaces.NodeExecutionCollectionOutput{
+				NodeExecutions: []models.NodeExecution{
+					{
+						NodeExecutionKey: models.NodeExecutionKey{
+							NodeID: "node id",
+							ExecutionKey: models.ExecutionKey{
+								Project: "project",
+								Domain:  "domain",
+								Name:    "name",
+							},
+						},
+						Phase:    core.NodeExecution_SUCCEEDED.String(),
+						InputURI: "input uri",
+						Closure:  closureBytes,
+					},
+				},
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	nodeExecutions, err := nodeExecManager.ListNodeExecutionsByTask(
+		context.Background(), managerInterfaces.NodeExecutionsByTaskListRequest{
+			TaskID: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "task",
+			},
+			Limit:   1,
+			Token:   "2",
+			Filters: "eq(phase,SUCCEEDED)",
+		})
+	assert.Nil(t, err)
+	assert.Len(t, nodeExecutions.NodeExecutions, 1)
+	assert.Equal(t, "node id", nodeExecutions.NodeExecutions[0].Id.NodeId)
+	assert.Equal(t, "name", nodeExecutions.NodeExecutions[0].Id.ExecutionId.Name)
+	assert.Equal(t, "3", nodeExecutions.Token)
+}
+
+func TestListNodeExecutionsByTask_TaskVersion(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListByTaskCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 6)
+			assert.Equal(t, common.TaskExecution, input.InlineFilters[5].GetEntity())
+			queryExpr, _ := input.InlineFilters[5].GetGormQueryExpr()
+			assert.Equal(t, "version", queryExpr.Args)
+			return interfaces.NodeExecutionCollectionOutput{}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	nodeExecutions, err := nodeExecManager.ListNodeExecutionsByTask(
+		context.Background(), managerInterfaces.NodeExecutionsByTaskListRequest{
+			TaskID: &core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task",
+				Version: "version",
+			},
+			Limit: 1,
+		})
+	assert.Nil(t, err)
+	assert.Empty(t, nodeExecutions.NodeExecutions)
+	assert.Empty(t, nodeExecutions.Token)
+}
+
+func TestListNodeExecutionsByTask_InvalidTask(t *testing.T) {
+	nodeExecManager := NewNodeExecutionManager(repositoryMocks.NewMockRepository(),
+		getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.ListNodeExecutionsByTask(
+		context.Background(), managerInterfaces.NodeExecutionsByTaskListRequest{
+			Limit: 1,
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = nodeExecManager.ListNodeExecutionsByTask(
+		context.Background(), managerInterfaces.NodeExecutionsByTaskListRequest{
+			TaskID: &core.Identifier{
+				Project: "project",
+				Name:    "task",
+			},
+			Limit: 1,
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestGetNodeExecutionData(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	expectedClosure := admin.NodeExecutionClosure{
@@ -787,7 +1325,10 @@ func TestGetNodeExecutionData(t *testing.T) {
 
 		return admin.UrlBlob{}, errors.New("unexpected input")
 	}
-	nodeExecManager := NewNodeExecutionManager(repository, mockScope.NewTestScope(), mockNodeExecutionRemoteURL)
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
 	dataResponse, err := nodeExecManager.GetNodeExecutionData(context.Background(), admin.NodeExecutionGetDataRequest{
 		Id: &nodeExecutionIdentifier,
 	})
@@ -803,3 +1344,779 @@ func TestGetNodeExecutionData(t *testing.T) {
 		},
 	}, dataResponse))
 }
+
+func TestGetNodeExecutionFullData(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	closureBytes, _ := proto.Marshal(&admin.NodeExecutionClosure{
+		Phase: core.NodeExecution_SUCCEEDED,
+		OutputResult: &admin.NodeExecutionClosure_OutputUri{
+			OutputUri: "output uri",
+		},
+	})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase:    core.NodeExecution_SUCCEEDED.String(),
+				InputURI: "input uri",
+				Closure:  closureBytes,
+			}, nil
+		})
+	mockNodeExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockNodeExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		if uri == "input uri" {
+			return admin.UrlBlob{
+				Url:   "inputs",
+				Bytes: 100,
+			}, nil
+		} else if uri == "output uri" {
+			return admin.UrlBlob{
+				Url:   "outputs",
+				Bytes: 200,
+			}, nil
+		}
+		return admin.UrlBlob{}, errors.New("unexpected input")
+	}
+	inputs := testutils.GetLaunchPlanRequest().Spec.FixedInputs
+	mockStorage := getMockStorageForExecTest(context.Background())
+	assert.Nil(t, mockStorage.WriteProtobuf(context.Background(), "input uri", storage.Options{}, inputs))
+	configProvider := getMockExecutionsConfigProvider()
+	configProvider.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetRemoteDataConfig(
+		runtimeInterfaces.RemoteDataConfig{
+			MaxInlineSizeBytes: 150,
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, configProvider, storagePrefix, mockStorage, mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	dataResponse, err := nodeExecManager.GetNodeExecutionFullData(context.Background(), admin.NodeExecutionGetDataRequest{
+		Id: &nodeExecutionIdentifier,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "inputs", dataResponse.Inputs.Url)
+	assert.Equal(t, "outputs", dataResponse.Outputs.Url)
+	assert.True(t, proto.Equal(inputs, dataResponse.FullInputs))
+	// The outputs are larger than the inline size threshold.
+	assert.Nil(t, dataResponse.FullOutputs)
+}
+
+func TestGetNodeExecutionFullData_ErrorDocument(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	closureBytes, _ := proto.Marshal(&admin.NodeExecutionClosure{
+		Phase: core.NodeExecution_FAILED,
+		OutputResult: &admin.NodeExecutionClosure_Error{
+			Error: &core.ExecutionError{
+				Code:     "USER:ValueError",
+				Message:  "truncated message",
+				ErrorUri: "error uri",
+			},
+		},
+	})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase:    core.NodeExecution_FAILED.String(),
+				InputURI: "input uri",
+				Closure:  closureBytes,
+			}, nil
+		})
+	mockNodeExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockNodeExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		if uri == "input uri" {
+			return admin.UrlBlob{
+				Url:   "inputs",
+				Bytes: 100,
+			}, nil
+		} else if uri == "error uri" {
+			return admin.UrlBlob{
+				Url:   "error",
+				Bytes: 300,
+			}, nil
+		}
+		return admin.UrlBlob{}, errors.New("unexpected input")
+	}
+	mockStorage := getMockStorageForExecTest(context.Background())
+	assert.Nil(t, mockStorage.WriteProtobuf(context.Background(), "error uri", storage.Options{}, &core.ErrorDocument{
+		Error: &core.ContainerError{
+			Code:    "USER:ValueError",
+			Message: "full stack trace",
+		},
+	}))
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix, mockStorage, mockScope.NewTestScope(),
+		mockNodeExecutionRemoteURL, mockCloudEventPublisher)
+	dataResponse, err := nodeExecManager.GetNodeExecutionFullData(context.Background(), admin.NodeExecutionGetDataRequest{
+		Id: &nodeExecutionIdentifier,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "inputs", dataResponse.Inputs.Url)
+	assert.Equal(t, "error", dataResponse.ErrorDocument.Url)
+	// The error document is inlined even though inlining is disabled for literal maps.
+	assert.True(t, proto.Equal(&core.ExecutionError{
+		Code:     "USER:ValueError",
+		Message:  "full stack trace",
+		ErrorUri: "error uri",
+	}, dataResponse.Error))
+}
+
+func TestGetNodeExecutionData_Restricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			assert.Equal(t, "name", input.Name)
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+				DataClassification: common.DataClassificationRestricted,
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase:    core.NodeExecution_SUCCEEDED.String(),
+				InputURI: "input uri",
+			}, nil
+		})
+	mockNodeExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockNodeExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		t.Fatalf("unexpected request to sign [%s]", uri)
+		return admin.UrlBlob{}, nil
+	}
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.GetNodeExecutionData(context.Background(), admin.NodeExecutionGetDataRequest{
+		Id: &nodeExecutionIdentifier,
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func getMockNodeExecutionRepoForAttemptData(taskExecutions []models.TaskExecution) repositories.RepositoryInterface {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase:    core.NodeExecution_RUNNING.String(),
+				InputURI: "input uri",
+			}, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 5)
+			assert.Equal(t, common.NodeExecution, input.InlineFilters[3].GetEntity())
+			queryExpr, _ := input.InlineFilters[3].GetGormQueryExpr()
+			assert.Equal(t, "node id", queryExpr.Args)
+			assert.Equal(t, common.TaskExecution, input.InlineFilters[4].GetEntity())
+			queryExpr, _ = input.InlineFilters[4].GetGormQueryExpr()
+			assert.Equal(t, uint32(1), queryExpr.Args)
+			assert.Equal(t, "retry_attempt = ?", queryExpr.Query)
+			assert.Equal(t, 1, input.Limit)
+			return interfaces.TaskExecutionCollectionOutput{
+				TaskExecutions: taskExecutions,
+			}, nil
+		})
+	return repository
+}
+
+func TestGetNodeExecutionAttemptData(t *testing.T) {
+	closureBytes, _ := proto.Marshal(&admin.TaskExecutionClosure{
+		Phase: core.TaskExecution_FAILED,
+		OutputResult: &admin.TaskExecutionClosure_Error{
+			Error: &core.ExecutionError{
+				Code:     "OOMKilled",
+				ErrorUri: "error uri",
+			},
+		},
+	})
+	repository := getMockNodeExecutionRepoForAttemptData([]models.TaskExecution{
+		{
+			Phase:    core.TaskExecution_FAILED.String(),
+			InputURI: "attempt input uri",
+			ErrorURI: "error uri",
+			Closure:  closureBytes,
+		},
+	})
+	mockNodeExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockNodeExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		if uri == "attempt input uri" {
+			return admin.UrlBlob{
+				Url:   "inputs",
+				Bytes: 100,
+			}, nil
+		} else if uri == "error uri" {
+			return admin.UrlBlob{
+				Url:   "error",
+				Bytes: 10,
+			}, nil
+		}
+		return admin.UrlBlob{}, errors.New("unexpected input")
+	}
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	dataResponse, err := nodeExecManager.GetNodeExecutionAttemptData(context.Background(),
+		managerInterfaces.NodeExecutionAttemptDataGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			RetryAttempt:    1,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "inputs", dataResponse.Inputs.Url)
+	assert.Nil(t, dataResponse.Outputs)
+	assert.Equal(t, "OOMKilled", dataResponse.Error.Code)
+	assert.Equal(t, "error", dataResponse.ErrorDocument.Url)
+}
+
+func TestGetNodeExecutionAttemptData_ClosureOutputs(t *testing.T) {
+	// Attempts recorded before output locations were stored on the model fall back to their closures.
+	closureBytes, _ := proto.Marshal(&admin.TaskExecutionClosure{
+		Phase: core.TaskExecution_SUCCEEDED,
+		OutputResult: &admin.TaskExecutionClosure_OutputUri{
+			OutputUri: "output uri",
+		},
+	})
+	repository := getMockNodeExecutionRepoForAttemptData([]models.TaskExecution{
+		{
+			Phase:    core.TaskExecution_SUCCEEDED.String(),
+			InputURI: "input uri",
+			Closure:  closureBytes,
+		},
+	})
+	mockNodeExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockNodeExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		return admin.UrlBlob{
+			Url: uri,
+		}, nil
+	}
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	dataResponse, err := nodeExecManager.GetNodeExecutionAttemptData(context.Background(),
+		managerInterfaces.NodeExecutionAttemptDataGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			RetryAttempt:    1,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "input uri", dataResponse.Inputs.Url)
+	assert.Equal(t, "output uri", dataResponse.Outputs.Url)
+	assert.Nil(t, dataResponse.Error)
+	assert.Nil(t, dataResponse.ErrorDocument)
+}
+
+func TestGetNodeExecutionAttemptData_MissingAttempt(t *testing.T) {
+	nodeExecManager := NewNodeExecutionManager(
+		getMockNodeExecutionRepoForAttemptData(nil), getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), dataMocks.NewMockRemoteURL(),
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.GetNodeExecutionAttemptData(context.Background(),
+		managerInterfaces.NodeExecutionAttemptDataGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			RetryAttempt:    1,
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateDynamicNodeWorkflow(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				BaseModel: models.BaseModel{
+					ID: uint(7),
+				},
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase: core.NodeExecution_RUNNING.String(),
+			}, nil
+		})
+	expectedReference := "s3://bucket/metadata/admin/project/domain/name/dynamic/node id"
+	var updateCalled bool
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetUpdateNodeExecutionCallback(
+		func(ctx context.Context, nodeExecution models.NodeExecution) error {
+			updateCalled = true
+			assert.Equal(t, uint(7), nodeExecution.ID)
+			assert.Equal(t, "node id", nodeExecution.NodeID)
+			assert.Equal(t, expectedReference, nodeExecution.DynamicWorkflowRemoteClosureReference)
+			assert.Empty(t, nodeExecution.Phase)
+			return nil
+		})
+	mockStorage := getMockStorageForExecTest(context.Background())
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		mockStorage, mockScope.NewTestScope(), mockNodeExecutionRemoteURL, mockCloudEventPublisher)
+	compiledWorkflow := testutils.GetWorkflowClosure().CompiledWorkflow
+	_, err := nodeExecManager.CreateDynamicNodeWorkflow(context.Background(),
+		managerInterfaces.DynamicNodeWorkflowCreateRequest{
+			NodeExecutionID:  &nodeExecutionIdentifier,
+			CompiledWorkflow: compiledWorkflow,
+		})
+	assert.Nil(t, err)
+	assert.True(t, updateCalled)
+
+	var storedClosure admin.WorkflowClosure
+	assert.Nil(t, mockStorage.ReadProtobuf(context.Background(), storage.DataReference(expectedReference), &storedClosure))
+	assert.True(t, proto.Equal(compiledWorkflow, storedClosure.CompiledWorkflow))
+	assert.NotNil(t, storedClosure.CreatedAt)
+}
+
+func TestCreateDynamicNodeWorkflow_MissingWorkflow(t *testing.T) {
+	nodeExecManager := NewNodeExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(),
+		storagePrefix, commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.CreateDynamicNodeWorkflow(context.Background(),
+		managerInterfaces.DynamicNodeWorkflowCreateRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetDynamicNodeWorkflow(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				NodeExecutionKey: models.NodeExecutionKey{
+					NodeID: "node id",
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "domain",
+						Name:    "name",
+					},
+				},
+				Phase:                                 core.NodeExecution_SUCCEEDED.String(),
+				DynamicWorkflowRemoteClosureReference: remoteClosureIdentifier,
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	closure, err := nodeExecManager.GetDynamicNodeWorkflow(context.Background(),
+		managerInterfaces.DynamicNodeWorkflowGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(testutils.GetWorkflowClosure(), closure))
+}
+
+func TestGetDynamicNodeWorkflow_NotRecorded(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				Phase: core.NodeExecution_SUCCEEDED.String(),
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.GetDynamicNodeWorkflow(context.Background(),
+		managerInterfaces.DynamicNodeWorkflowGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestNodeExecutionCacheMetadata(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var recorded models.NodeExecution
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return recorded, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetUpdateNodeExecutionCallback(
+		func(ctx context.Context, nodeExecution models.NodeExecution) error {
+			assert.Equal(t, managerInterfaces.CacheHit, nodeExecution.CacheStatus)
+			assert.Empty(t, nodeExecution.Phase)
+			recorded = nodeExecution
+			return nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.GetNodeExecutionCacheMetadata(context.Background(),
+		managerInterfaces.NodeExecutionCacheMetadataGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	cacheMetadata := managerInterfaces.NodeExecutionCacheMetadata{
+		CacheStatus: managerInterfaces.CacheHit,
+		ArtifactKey: "artifact key",
+		SourceExecution: &core.NodeExecutionIdentifier{
+			NodeId: "source node",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "source",
+			},
+		},
+	}
+	_, err = nodeExecManager.CreateNodeExecutionCacheMetadata(context.Background(),
+		managerInterfaces.NodeExecutionCacheMetadataCreateRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			Metadata:        cacheMetadata,
+		})
+	assert.Nil(t, err)
+
+	fetched, err := nodeExecManager.GetNodeExecutionCacheMetadata(context.Background(),
+		managerInterfaces.NodeExecutionCacheMetadataGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, cacheMetadata.CacheStatus, fetched.CacheStatus)
+	assert.Equal(t, cacheMetadata.ArtifactKey, fetched.ArtifactKey)
+	assert.True(t, proto.Equal(cacheMetadata.SourceExecution, fetched.SourceExecution))
+}
+
+func TestCreateNodeExecutionCacheMetadata_InvalidStatus(t *testing.T) {
+	nodeExecManager := NewNodeExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(),
+		storagePrefix, commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.CreateNodeExecutionCacheMetadata(context.Background(),
+		managerInterfaces.NodeExecutionCacheMetadataCreateRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			Metadata: managerInterfaces.NodeExecutionCacheMetadata{
+				CacheStatus: "foo",
+			},
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestNodeExecutionArtifacts(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	recorded := models.NodeExecution{
+		NodeExecutionKey: models.NodeExecutionKey{
+			NodeID: "node id",
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+		},
+	}
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return recorded, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetUpdateNodeExecutionCallback(
+		func(ctx context.Context, nodeExecution models.NodeExecution) error {
+			assert.Empty(t, nodeExecution.Phase)
+			assert.Empty(t, nodeExecution.Closure)
+			recorded = nodeExecution
+			return nil
+		})
+	mockRemoteURL := dataMocks.NewMockRemoteURL()
+	mockRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		return admin.UrlBlob{
+			Url:   "signed " + uri,
+			Bytes: 100,
+		}, nil
+	}
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockRemoteURL, mockCloudEventPublisher)
+	fetched, err := nodeExecManager.GetNodeExecutionArtifacts(context.Background(),
+		managerInterfaces.NodeExecutionArtifactsGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.Empty(t, fetched.Artifacts)
+
+	_, err = nodeExecManager.CreateNodeExecutionArtifacts(context.Background(),
+		managerInterfaces.NodeExecutionArtifactsCreateRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			Artifacts: []managerInterfaces.NodeExecutionArtifact{
+				{
+					Name: "report",
+					Kind: managerInterfaces.ArtifactKindHTML,
+					URI:  "s3://bucket/attempt-0/report.html",
+				},
+				{
+					Name: "deck",
+					Kind: managerInterfaces.ArtifactKindDeck,
+					URI:  "s3://bucket/attempt-0/deck.html",
+				},
+			},
+		})
+	assert.Nil(t, err)
+	// A later attempt replaces the report and adds a profile.
+	_, err = nodeExecManager.CreateNodeExecutionArtifacts(context.Background(),
+		managerInterfaces.NodeExecutionArtifactsCreateRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+			Artifacts: []managerInterfaces.NodeExecutionArtifact{
+				{
+					Name: "profile",
+					Kind: managerInterfaces.ArtifactKindProfile,
+					URI:  "s3://bucket/attempt-1/profile.pprof",
+				},
+				{
+					Name: "report",
+					Kind: managerInterfaces.ArtifactKindHTML,
+					URI:  "s3://bucket/attempt-1/report.html",
+				},
+			},
+		})
+	assert.Nil(t, err)
+
+	fetched, err = nodeExecManager.GetNodeExecutionArtifacts(context.Background(),
+		managerInterfaces.NodeExecutionArtifactsGetRequest{
+			NodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.Len(t, fetched.Artifacts, 3)
+	for idx, expected := range []struct {
+		name string
+		uri  string
+	}{
+		{"report", "s3://bucket/attempt-1/report.html"},
+		{"deck", "s3://bucket/attempt-0/deck.html"},
+		{"profile", "s3://bucket/attempt-1/profile.pprof"},
+	} {
+		assert.Equal(t, expected.name, fetched.Artifacts[idx].Name)
+		assert.Equal(t, expected.uri, fetched.Artifacts[idx].URI)
+		assert.Equal(t, "signed "+expected.uri, fetched.Artifacts[idx].SignedURL.Url)
+	}
+}
+
+func TestCreateNodeExecutionArtifacts_Invalid(t *testing.T) {
+	nodeExecManager := NewNodeExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(),
+		storagePrefix, commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	for _, artifacts := range [][]managerInterfaces.NodeExecutionArtifact{
+		nil,
+		{{Name: "report", Kind: "PDF", URI: "s3://bucket/report.pdf"}},
+		{{Name: "report", Kind: managerInterfaces.ArtifactKindHTML, URI: "report.html"}},
+		{
+			{Name: "report", Kind: managerInterfaces.ArtifactKindHTML, URI: "s3://bucket/report.html"},
+			{Name: "report", Kind: managerInterfaces.ArtifactKindDeck, URI: "s3://bucket/deck.html"},
+		},
+	} {
+		_, err := nodeExecManager.CreateNodeExecutionArtifacts(context.Background(),
+			managerInterfaces.NodeExecutionArtifactsCreateRequest{
+				NodeExecutionID: &nodeExecutionIdentifier,
+				Artifacts:       artifacts,
+			})
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestGetNodeExecutionChildRollup(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+			return models.NodeExecution{
+				BaseModel: models.BaseModel{
+					ID: uint(4),
+				},
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListChildPhaseCountsCallback(
+		func(ctx context.Context, parentID uint) ([]models.NodeExecutionChildPhaseCount, error) {
+			assert.Equal(t, uint(4), parentID)
+			return []models.NodeExecutionChildPhaseCount{
+				{ParentID: 4, Phase: core.NodeExecution_SUCCEEDED.String(), Count: 10},
+				{ParentID: 4, Phase: core.NodeExecution_FAILED.String(), Count: 2},
+				{ParentID: 4, Phase: core.NodeExecution_RUNNING.String(), Count: 3},
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(
+		repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	rollup, err := nodeExecManager.GetNodeExecutionChildRollup(context.Background(),
+		managerInterfaces.NodeExecutionChildRollupGetRequest{
+			ParentNodeExecutionID: &nodeExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, &managerInterfaces.NodeExecutionChildRollup{
+		PhaseCounts: map[string]int64{
+			core.NodeExecution_SUCCEEDED.String(): 10,
+			core.NodeExecution_FAILED.String():    2,
+			core.NodeExecution_RUNNING.String():   3,
+		},
+		Total: 15,
+	}, rollup)
+}
+
+func TestGetNodeExecutionMetrics(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	createdAt := time.Date(2019, 11, 25, 10, 0, 0, 0, time.UTC)
+	newEvent := func(nodeID string, phase core.NodeExecution_Phase, offset time.Duration) models.NodeExecutionEvent {
+		return models.NodeExecutionEvent{
+			NodeExecutionKey: models.NodeExecutionKey{
+				NodeID: nodeID,
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			},
+			Phase:      phase.String(),
+			OccurredAt: createdAt.Add(offset),
+		}
+	}
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListEventCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionEventCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 3)
+			assert.Equal(t, common.Execution, input.InlineFilters[0].GetEntity())
+			assert.Equal(t, "node_execution_events.occurred_at asc", input.SortParameter.GetGormOrderExpr())
+			assert.Equal(t, 0, input.Offset)
+			return interfaces.NodeExecutionEventCollectionOutput{
+				NodeExecutionEvents: []models.NodeExecutionEvent{
+					newEvent("start", core.NodeExecution_UNDEFINED, 0),
+					newEvent("start", core.NodeExecution_QUEUED, time.Second),
+					newEvent("start", core.NodeExecution_RUNNING, 3*time.Second),
+					newEvent("end", core.NodeExecution_QUEUED, 4*time.Second),
+					newEvent("start", core.NodeExecution_SUCCEEDED, 10*time.Second),
+					newEvent("end", core.NodeExecution_RUNNING, 11*time.Second),
+				},
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	response, err := nodeExecManager.GetNodeExecutionMetrics(context.Background(),
+		managerInterfaces.NodeExecutionMetricsGetRequest{
+			WorkflowExecutionID: &workflowExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.Len(t, response.NodeExecutions, 2)
+
+	start := response.NodeExecutions[0]
+	assert.Equal(t, "start", start.NodeID)
+	assert.Equal(t, core.NodeExecution_SUCCEEDED.String(), start.Phase)
+	assert.Equal(t, createdAt, start.CreatedAt)
+	assert.Equal(t, time.Second, *start.CreatedToQueued)
+	assert.Equal(t, 2*time.Second, *start.QueuedToRunning)
+	assert.Equal(t, 7*time.Second, *start.RunningToTerminal)
+	assert.Equal(t, 10*time.Second, *start.Total)
+
+	end := response.NodeExecutions[1]
+	assert.Equal(t, "end", end.NodeID)
+	assert.Equal(t, core.NodeExecution_RUNNING.String(), end.Phase)
+	assert.Equal(t, time.Duration(0), *end.CreatedToQueued)
+	assert.Equal(t, 7*time.Second, *end.QueuedToRunning)
+	assert.Nil(t, end.RunningToTerminal)
+	assert.Nil(t, end.Total)
+}
+
+func TestGetNodeExecutionMetrics_CompactedEvents(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetExecutionCallback(t, repository)
+	createdAt := time.Date(2019, 11, 25, 10, 0, 0, 0, time.UTC)
+	summary, _ := json.Marshal(util.ExecutionEventSummary{
+		NodeExecutions: []util.NodeExecutionEventSummary{
+			{
+				NodeID: "start",
+				Events: []util.SummarizedEvent{
+					{Phase: core.NodeExecution_QUEUED.String(), OccurredAt: createdAt},
+					{Phase: core.NodeExecution_RUNNING.String(), OccurredAt: createdAt.Add(2 * time.Second)},
+				},
+			},
+		},
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetEventSummaryCallback(
+		func(ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+			return models.ExecutionEventSummary{
+				ExecutionKey: key,
+				Summary:      summary,
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListEventCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionEventCollectionOutput, error) {
+			return interfaces.NodeExecutionEventCollectionOutput{
+				NodeExecutionEvents: []models.NodeExecutionEvent{
+					{
+						NodeExecutionKey: models.NodeExecutionKey{NodeID: "start"},
+						Phase:            core.NodeExecution_SUCCEEDED.String(),
+						OccurredAt:       createdAt.Add(5 * time.Second),
+					},
+				},
+			}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	response, err := nodeExecManager.GetNodeExecutionMetrics(context.Background(),
+		managerInterfaces.NodeExecutionMetricsGetRequest{
+			WorkflowExecutionID: &workflowExecutionIdentifier,
+		})
+	assert.Nil(t, err)
+	assert.Len(t, response.NodeExecutions, 1)
+	start := response.NodeExecutions[0]
+	assert.Equal(t, core.NodeExecution_SUCCEEDED.String(), start.Phase)
+	assert.Equal(t, createdAt, start.CreatedAt)
+	assert.Equal(t, 2*time.Second, *start.QueuedToRunning)
+	assert.Equal(t, 3*time.Second, *start.RunningToTerminal)
+	assert.Equal(t, 5*time.Second, *start.Total)
+}
+
+func TestGetNodeExecutionMetrics_MissingExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "execution not found")
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListEventCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (
+			interfaces.NodeExecutionEventCollectionOutput, error) {
+			assert.Fail(t, "node execution events shouldn't be listed for a missing execution")
+			return interfaces.NodeExecutionEventCollectionOutput{}, nil
+		})
+	nodeExecManager := NewNodeExecutionManager(repository, getMockExecutionsConfigProvider(), storagePrefix,
+		commonMocks.GetMockStorageClient(), mockScope.NewTestScope(), mockNodeExecutionRemoteURL,
+		mockCloudEventPublisher)
+	_, err := nodeExecManager.GetNodeExecutionMetrics(context.Background(),
+		managerInterfaces.NodeExecutionMetricsGetRequest{
+			WorkflowExecutionID: &workflowExecutionIdentifier,
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
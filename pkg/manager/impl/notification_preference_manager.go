@@ -0,0 +1,107 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type NotificationPreferenceManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+// Preferences belong to the authenticated user making the request.
+func getNotificationPreferenceUser(ctx context.Context) (string, error) {
+	userEmail := auth.GetUserEmail(ctx)
+	if userEmail == "" {
+		return "", errors.NewFlyteAdminError(codes.Unauthenticated,
+			"notification preferences can only be managed by authenticated users")
+	}
+	return userEmail, nil
+}
+
+// Phases are stored comma-separated since a preference has few of them.
+func splitNotificationPreferencePhases(phases string) []string {
+	if phases == "" {
+		return nil
+	}
+	return strings.Split(phases, ",")
+}
+
+func (m *NotificationPreferenceManager) UpdateNotificationPreference(
+	ctx context.Context, request interfaces.NotificationPreferenceUpdateRequest) (
+	*interfaces.NotificationPreferenceUpdateResponse, error) {
+	userEmail, err := getNotificationPreferenceUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateNotificationPreferenceUpdateRequest(request); err != nil {
+		return nil, err
+	}
+	channel := request.Preference.Channel
+	if channel == "" {
+		channel = interfaces.EmailNotificationChannel
+	}
+	err = m.db.NotificationPreferenceRepo().CreateOrUpdate(ctx, models.NotificationPreference{
+		UserEmail:  userEmail,
+		Project:    request.Preference.LaunchPlan.Project,
+		Domain:     request.Preference.LaunchPlan.Domain,
+		Name:       request.Preference.LaunchPlan.Name,
+		Subscribed: request.Preference.Subscribed,
+		Channel:    channel,
+		Phases:     strings.Join(request.Preference.Phases, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.NotificationPreferenceUpdateResponse{}, nil
+}
+
+func (m *NotificationPreferenceManager) ListNotificationPreferences(
+	ctx context.Context, request interfaces.NotificationPreferenceListRequest) (
+	*interfaces.NotificationPreferenceListResponse, error) {
+	userEmail, err := getNotificationPreferenceUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	preferenceModels, err := m.db.NotificationPreferenceRepo().ListForUser(ctx, userEmail)
+	if err != nil {
+		return nil, err
+	}
+	preferences := make([]interfaces.NotificationPreference, len(preferenceModels))
+	for idx, model := range preferenceModels {
+		preferences[idx] = interfaces.NotificationPreference{
+			LaunchPlan: &admin.NamedEntityIdentifier{
+				Project: model.Project,
+				Domain:  model.Domain,
+				Name:    model.Name,
+			},
+			Subscribed: model.Subscribed,
+			Channel:    model.Channel,
+			Phases:     splitNotificationPreferencePhases(model.Phases),
+		}
+	}
+	return &interfaces.NotificationPreferenceListResponse{
+		Preferences: preferences,
+	}, nil
+}
+
+func NewNotificationPreferenceManager(
+	db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration) interfaces.NotificationPreferenceInterface {
+	return &NotificationPreferenceManager{
+		db:     db,
+		config: config,
+	}
+}
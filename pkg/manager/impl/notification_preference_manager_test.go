@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+var notificationPreferenceLaunchPlan = admin.NamedEntityIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestUpdateNotificationPreference(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var updated bool
+	repository.NotificationPreferenceRepo().(*repositoryMocks.MockNotificationPreferenceRepo).CreateOrUpdateFunction =
+		func(ctx context.Context, input models.NotificationPreference) error {
+			assert.Equal(t, models.NotificationPreference{
+				UserEmail:  "user@example.com",
+				Project:    "project",
+				Domain:     "domain",
+				Name:       "name",
+				Subscribed: true,
+				Channel:    interfaces.EmailNotificationChannel,
+				Phases:     "FAILED,ABORTED",
+			}, input)
+			updated = true
+			return nil
+		}
+	manager := NewNotificationPreferenceManager(repository, mockProjectConfigProvider)
+	_, err := manager.UpdateNotificationPreference(auth.WithUserEmail(context.Background(), "user@example.com"),
+		interfaces.NotificationPreferenceUpdateRequest{
+			Preference: interfaces.NotificationPreference{
+				LaunchPlan: &notificationPreferenceLaunchPlan,
+				Subscribed: true,
+				Phases:     []string{"FAILED", "ABORTED"},
+			},
+		})
+	assert.Nil(t, err)
+	assert.True(t, updated)
+}
+
+func TestUpdateNotificationPreference_Unauthenticated(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationPreferenceRepo().(*repositoryMocks.MockNotificationPreferenceRepo).CreateOrUpdateFunction =
+		func(ctx context.Context, input models.NotificationPreference) error {
+			t.Fatal("preferences of unauthenticated requests must not be stored")
+			return nil
+		}
+	manager := NewNotificationPreferenceManager(repository, mockProjectConfigProvider)
+	_, err := manager.UpdateNotificationPreference(context.Background(), interfaces.NotificationPreferenceUpdateRequest{
+		Preference: interfaces.NotificationPreference{
+			LaunchPlan: &notificationPreferenceLaunchPlan,
+		},
+	})
+	assert.Equal(t, codes.Unauthenticated, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListNotificationPreferences(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationPreferenceRepo().(*repositoryMocks.MockNotificationPreferenceRepo).ListForUserFunction =
+		func(ctx context.Context, userEmail string) ([]models.NotificationPreference, error) {
+			assert.Equal(t, "user@example.com", userEmail)
+			return []models.NotificationPreference{
+				{
+					UserEmail: userEmail,
+					Project:   "project",
+					Domain:    "domain",
+					Name:      "name",
+					Channel:   interfaces.SlackNotificationChannel,
+					Phases:    "SUCCEEDED",
+				},
+			}, nil
+		}
+	manager := NewNotificationPreferenceManager(repository, mockProjectConfigProvider)
+	response, err := manager.ListNotificationPreferences(auth.WithUserEmail(context.Background(), "user@example.com"),
+		interfaces.NotificationPreferenceListRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, []interfaces.NotificationPreference{
+		{
+			LaunchPlan: &notificationPreferenceLaunchPlan,
+			Channel:    interfaces.SlackNotificationChannel,
+			Phases:     []string{"SUCCEEDED"},
+		},
+	}, response.Preferences)
+}
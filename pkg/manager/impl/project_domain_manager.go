@@ -2,19 +2,32 @@ package impl
 
 import (
 	"context"
+	"time"
+
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
+type projectDomainMetrics struct {
+	Scope promutils.Scope
+	// Per cluster, how many seconds ago the oldest update the cluster hasn't acknowledged yet was made.
+	ClusterAttributesLag *prometheus.GaugeVec
+}
+
 type ProjectDomainManager struct {
-	db     repositories.RepositoryInterface
-	config runtimeInterfaces.Configuration
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.Configuration
+	metrics projectDomainMetrics
 }
 
 func (m *ProjectDomainManager) UpdateProjectDomain(
@@ -28,18 +41,103 @@ func (m *ProjectDomainManager) UpdateProjectDomain(
 	if err != nil {
 		return nil, err
 	}
+	// The repository assigns the version.
 	err = m.db.ProjectDomainRepo().CreateOrUpdate(ctx, model)
 	if err != nil {
 		return nil, err
 	}
-
 	return &admin.ProjectDomainAttributesUpdateResponse{}, nil
 }
 
+func (m *ProjectDomainManager) SyncProjectDomainAttributes(
+	ctx context.Context, request interfaces.ProjectDomainAttributesSyncRequest) (
+	*interfaces.ProjectDomainAttributesSyncResponse, error) {
+	projectDomainModels, err := m.db.ProjectDomainRepo().ListUpdatedSince(ctx, request.SinceVersion)
+	if err != nil {
+		return nil, err
+	}
+	response := &interfaces.ProjectDomainAttributesSyncResponse{
+		Version:    request.SinceVersion,
+		Attributes: make([]interfaces.VersionedProjectDomainAttributes, len(projectDomainModels)),
+	}
+	for idx, model := range projectDomainModels {
+		attributes, err := transformers.FromProjectDomainModel(model)
+		if err != nil {
+			return nil, err
+		}
+		response.Attributes[idx] = interfaces.VersionedProjectDomainAttributes{
+			Version:    model.Version,
+			Attributes: &attributes,
+		}
+		if model.Version > response.Version {
+			response.Version = model.Version
+		}
+	}
+	return response, nil
+}
+
+func (m *ProjectDomainManager) AckProjectDomainAttributes(
+	ctx context.Context, request interfaces.ProjectDomainAttributesAckRequest) (
+	*interfaces.ProjectDomainAttributesAckResponse, error) {
+	if err := validation.ValidateProjectDomainAttributesAckRequest(request); err != nil {
+		return nil, err
+	}
+	err := m.db.ProjectDomainRepo().CreateOrUpdateAck(ctx, models.ProjectDomainAttributesAck{
+		Cluster: request.Cluster,
+		Version: request.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := m.db.ProjectDomainRepo().ListUpdatedSince(ctx, request.Version)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to list attributes updated since version [%d] acked by cluster [%s] with err: %v",
+			request.Version, request.Cluster, err)
+		return &interfaces.ProjectDomainAttributesAckResponse{}, nil
+	}
+	m.setClusterAttributesLag(request.Cluster, pending)
+	return &interfaces.ProjectDomainAttributesAckResponse{}, nil
+}
+
+// Recomputes the lag of every cluster which has acknowledged attributes from the database so that every replica reports
+// the same lag, whichever replica served the updates and acks.
+func (m *ProjectDomainManager) RefreshClusterAttributesLag(ctx context.Context) error {
+	acks, err := m.db.ProjectDomainRepo().ListAcks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ack := range acks {
+		pending, err := m.db.ProjectDomainRepo().ListUpdatedSince(ctx, ack.Version)
+		if err != nil {
+			return err
+		}
+		m.setClusterAttributesLag(ack.Cluster, pending)
+	}
+	return nil
+}
+
+// Pending attributes are ordered by version, so the first one is the oldest update the cluster is missing.
+func (m *ProjectDomainManager) setClusterAttributesLag(cluster string, pending []models.ProjectDomain) {
+	var lag time.Duration
+	if len(pending) > 0 {
+		lag = time.Since(pending[0].UpdatedAt)
+	}
+	m.metrics.ClusterAttributesLag.WithLabelValues(cluster).Set(lag.Seconds())
+}
+
 func NewProjectDomainManager(
-	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ProjectDomainInterface {
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	scope promutils.Scope) interfaces.ProjectDomainInterface {
+	metrics := projectDomainMetrics{
+		Scope: scope,
+		ClusterAttributesLag: scope.MustNewGaugeVec("cluster_attributes_lag_seconds",
+			"seconds since the oldest project domain attributes update a cluster hasn't acknowledged was made",
+			"cluster"),
+	}
 	return &ProjectDomainManager{
-		db:     db,
-		config: config,
+		db:      db,
+		config:  config,
+		metrics: metrics,
 	}
 }
@@ -0,0 +1,165 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+var projectDomainAttributes = admin.ProjectDomainAttributes{
+	Project: "project",
+	Domain:  "domain",
+	Attributes: map[string]string{
+		"cpu": "100",
+	},
+}
+
+func getClusterAttributesLag(t *testing.T, manager interfaces.ProjectDomainInterface, cluster string) float64 {
+	var metric dto.Metric
+	assert.NoError(t, manager.(*ProjectDomainManager).metrics.ClusterAttributesLag.WithLabelValues(cluster).Write(&metric))
+	return metric.Gauge.GetValue()
+}
+
+func TestUpdateProjectDomain(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var updated bool
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.ProjectDomain) error {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		// Versions come from the database rather than the replica's clock.
+		assert.Zero(t, input.Version)
+		updated = true
+		return nil
+	}
+	projectDomainManager := NewProjectDomainManager(repository, mockProjectConfigProvider, mockScope.NewTestScope())
+	_, err := projectDomainManager.UpdateProjectDomain(context.Background(), admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &projectDomainAttributes,
+	})
+	assert.Nil(t, err)
+	assert.True(t, updated)
+}
+
+func TestSyncProjectDomainAttributes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	model, err := transformers.ToProjectDomainModel(projectDomainAttributes)
+	assert.Nil(t, err)
+	model.Version = 20
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).ListUpdatedSinceFunction = func(
+		ctx context.Context, version int64) ([]models.ProjectDomain, error) {
+		if version >= 20 {
+			return nil, nil
+		}
+		return []models.ProjectDomain{model}, nil
+	}
+	projectDomainManager := NewProjectDomainManager(repository, mockProjectConfigProvider, mockScope.NewTestScope())
+
+	response, err := projectDomainManager.SyncProjectDomainAttributes(context.Background(),
+		interfaces.ProjectDomainAttributesSyncRequest{
+			SinceVersion: 10,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), response.Version)
+	assert.Len(t, response.Attributes, 1)
+	assert.Equal(t, int64(20), response.Attributes[0].Version)
+	assert.True(t, proto.Equal(&projectDomainAttributes, response.Attributes[0].Attributes))
+
+	response, err = projectDomainManager.SyncProjectDomainAttributes(context.Background(),
+		interfaces.ProjectDomainAttributesSyncRequest{
+			SinceVersion: 20,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), response.Version)
+	assert.Empty(t, response.Attributes)
+}
+
+func TestAckProjectDomainAttributes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var acked models.ProjectDomainAttributesAck
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).CreateOrUpdateAckFunction = func(
+		ctx context.Context, input models.ProjectDomainAttributesAck) error {
+		acked = input
+		return nil
+	}
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).ListUpdatedSinceFunction = func(
+		ctx context.Context, version int64) ([]models.ProjectDomain, error) {
+		if version >= 10 {
+			return nil, nil
+		}
+		updated := []models.ProjectDomain{
+			{BaseModel: models.BaseModel{UpdatedAt: time.Now().Add(-time.Minute)}, Version: 5},
+			{BaseModel: models.BaseModel{UpdatedAt: time.Now()}, Version: 10},
+		}
+		if version >= 5 {
+			return updated[1:], nil
+		}
+		return updated, nil
+	}
+	projectDomainManager := NewProjectDomainManager(repository, mockProjectConfigProvider, mockScope.NewTestScope())
+
+	_, err := projectDomainManager.AckProjectDomainAttributes(context.Background(),
+		interfaces.ProjectDomainAttributesAckRequest{
+			Cluster: "cluster",
+			Version: 4,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "cluster", acked.Cluster)
+	assert.Equal(t, int64(4), acked.Version)
+	assert.True(t, getClusterAttributesLag(t, projectDomainManager, "cluster") >= time.Minute.Seconds())
+
+	_, err = projectDomainManager.AckProjectDomainAttributes(context.Background(),
+		interfaces.ProjectDomainAttributesAckRequest{
+			Cluster: "cluster",
+			Version: 10,
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, float64(0), getClusterAttributesLag(t, projectDomainManager, "cluster"))
+}
+
+func TestRefreshClusterAttributesLag(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).ListAcksFunction = func(
+		ctx context.Context) ([]models.ProjectDomainAttributesAck, error) {
+		return []models.ProjectDomainAttributesAck{
+			{Cluster: "behind", Version: 4},
+			{Cluster: "current", Version: 10},
+		}, nil
+	}
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).ListUpdatedSinceFunction = func(
+		ctx context.Context, version int64) ([]models.ProjectDomain, error) {
+		if version >= 10 {
+			return nil, nil
+		}
+		return []models.ProjectDomain{
+			{BaseModel: models.BaseModel{UpdatedAt: time.Now().Add(-time.Minute)}, Version: 10},
+		}, nil
+	}
+	projectDomainManager := NewProjectDomainManager(repository, mockProjectConfigProvider, mockScope.NewTestScope())
+
+	assert.NoError(t, projectDomainManager.RefreshClusterAttributesLag(context.Background()))
+	assert.True(t, getClusterAttributesLag(t, projectDomainManager, "behind") >= time.Minute.Seconds())
+	assert.Equal(t, float64(0), getClusterAttributesLag(t, projectDomainManager, "current"))
+}
+
+func TestAckProjectDomainAttributes_Invalid(t *testing.T) {
+	projectDomainManager := NewProjectDomainManager(
+		repositoryMocks.NewMockRepository(), mockProjectConfigProvider, mockScope.NewTestScope())
+	_, err := projectDomainManager.AckProjectDomainAttributes(context.Background(),
+		interfaces.ProjectDomainAttributesAckRequest{
+			Version: 1,
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -2,19 +2,33 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
 
+	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/onboarding"
 	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
 )
 
 type ProjectManager struct {
-	db     repositories.RepositoryInterface
-	config runtimeInterfaces.Configuration
+	db        repositories.RepositoryInterface
+	config    runtimeInterfaces.Configuration
+	onboarder onboarding.Onboarder
 }
 
 var alphabeticalSortParam, _ = common.NewSortParameter(admin.Sort{
@@ -33,36 +47,316 @@ func (m *ProjectManager) CreateProject(ctx context.Context, request admin.Projec
 		return nil, err
 	}
 
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().Onboarding.Enabled {
+		// Onboarding outlives the registration request and its progress is tracked through the project's onboarding
+		// status rather than failing registration.
+		go m.onboarder.Onboard(context.Background(), projectModel.Identifier)
+	}
 	return &admin.ProjectRegisterResponse{}, nil
 }
 
-func (m *ProjectManager) getDomains() []*admin.Domain {
-	configDomains := m.config.ApplicationConfiguration().GetDomainsConfig()
-	var domains = make([]*admin.Domain, len(*configDomains))
-	for index, configDomain := range *configDomains {
+func (m *ProjectManager) getDomains(ctx context.Context) ([]*admin.Domain, error) {
+	domainsConfig, err := repositories.GetDomains(ctx, m.db, m.config.ApplicationConfiguration())
+	if err != nil {
+		return nil, err
+	}
+	var domains = make([]*admin.Domain, len(domainsConfig))
+	for index, domain := range domainsConfig {
 		domains[index] = &admin.Domain{
-			Id:   configDomain.ID,
-			Name: configDomain.Name,
+			Id:   domain.ID,
+			Name: domain.Name,
 		}
 	}
-	return domains
+	return domains, nil
 }
 
-func (m *ProjectManager) ListProjects(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error) {
+func getProjectState(projectModel models.Project) interfaces.ProjectState {
+	if projectModel.State == nil {
+		return interfaces.ProjectStateActive
+	}
+	return interfaces.ProjectState(*projectModel.State)
+}
+
+func (m *ProjectManager) listProjectModels(ctx context.Context, includeArchived bool) ([]models.Project, error) {
 	projectModels, err := m.db.ProjectRepo().ListAll(ctx, alphabeticalSortParam)
 	if err != nil {
 		return nil, err
 	}
+	listedProjectModels := make([]models.Project, 0, len(projectModels))
+	for _, projectModel := range projectModels {
+		if !auth.IsProjectAuthorized(ctx, projectModel.Identifier) {
+			continue
+		}
+		if includeArchived || getProjectState(projectModel) != interfaces.ProjectStateArchived {
+			listedProjectModels = append(listedProjectModels, projectModel)
+		}
+	}
+	return listedProjectModels, nil
+}
 
-	projects := transformers.FromProjectModels(projectModels, m.getDomains())
+// Archived projects are left out, as the pinned flyteidl admin.ProjectListRequest can't ask for them, as are those the
+// caller isn't entitled to.
+func (m *ProjectManager) ListProjects(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error) {
+	projectModels, err := m.listProjectModels(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := m.getDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := transformers.FromProjectModels(projectModels, domains)
 	return &admin.Projects{
 		Projects: projects,
 	}, nil
 }
 
-func NewProjectManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ProjectInterface {
+func (m *ProjectManager) GetProjectOnboardingStatus(
+	ctx context.Context, request interfaces.ProjectOnboardingStatusGetRequest) (
+	*interfaces.ProjectOnboardingStatus, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	projectModel, err := m.db.ProjectRepo().Get(ctx, request.Project)
+	if err != nil {
+		return nil, err
+	}
+	if len(projectModel.OnboardingStatus) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "project [%s] wasn't onboarded", request.Project)
+	}
+	var status interfaces.ProjectOnboardingStatus
+	if err := json.Unmarshal(projectModel.OnboardingStatus, &status); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal onboarding status of project [%s] with err %v", request.Project, err)
+	}
+	return &status, nil
+}
+
+func (m *ProjectManager) UpdateProject(ctx context.Context, request interfaces.ProjectUpdateRequest) (
+	*interfaces.ProjectUpdateResponse, error) {
+	if err := validation.ValidateProjectUpdateRequest(request); err != nil {
+		return nil, err
+	}
+	projectModel, err := m.db.ProjectRepo().Get(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Description != nil {
+		projectModel.Description = *request.Description
+	}
+	if request.Labels != nil {
+		projectModel.Labels, err = transformers.CreateProjectLabels(request.ID, request.Labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if request.State != nil {
+		state := int32(*request.State)
+		projectModel.State = &state
+	}
+	if err := m.db.ProjectRepo().Update(ctx, projectModel); err != nil {
+		logger.Debugf(ctx, "Failed to update project [%s] with err %v", request.ID, err)
+		return nil, err
+	}
+	return &interfaces.ProjectUpdateResponse{}, nil
+}
+
+func hasLabels(labels, required map[string]string) bool {
+	for key, value := range required {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Lists a page of the projects matching the request. Labels are serialized and projects without a state are active, so
+// both the label and archival predicates are evaluated here rather than in the database. The token is therefore the
+// offset of the next unexamined project in the database rather than the number of projects listed so far.
+func (m *ProjectManager) ListProjectDetails(ctx context.Context, request interfaces.ProjectDetailsListRequest) (
+	*interfaces.ProjectDetailsList, error) {
+	var filters []common.InlineFilter
+	if len(request.Filters) > 0 {
+		var err error
+		filters, err = util.ParseFilters(request.Filters, common.Project)
+		if err != nil {
+			return nil, err
+		}
+	}
+	limit, err := validation.ValidateLimit(request.Limit, m.config.ApplicationConfiguration(),
+		validation.ProjectListLimits)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListProjectDetails", request.Token)
+	}
+	projects := make([]interfaces.ProjectDetails, 0, limit)
+	for {
+		projectModels, err := m.db.ProjectRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         int(limit),
+			Offset:        offset,
+			InlineFilters: filters,
+			SortParameter: alphabeticalSortParam,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for idx, projectModel := range projectModels {
+			if !auth.IsProjectAuthorized(ctx, projectModel.Identifier) {
+				continue
+			}
+			state := getProjectState(projectModel)
+			if state == interfaces.ProjectStateArchived && !request.IncludeArchived {
+				continue
+			}
+			labels, err := transformers.FromProjectModelLabels(projectModel)
+			if err != nil {
+				return nil, err
+			}
+			if !hasLabels(labels, request.Labels) {
+				continue
+			}
+			projects = append(projects, interfaces.ProjectDetails{
+				ID:          projectModel.Identifier,
+				Name:        projectModel.Name,
+				Description: projectModel.Description,
+				Labels:      labels,
+				State:       state,
+			})
+			if len(projects) == int(limit) {
+				return &interfaces.ProjectDetailsList{
+					Projects: projects,
+					Token:    strconv.Itoa(offset + idx + 1),
+				}, nil
+			}
+		}
+		if len(projectModels) < int(limit) {
+			return &interfaces.ProjectDetailsList{
+				Projects: projects,
+			}, nil
+		}
+		offset += len(projectModels)
+	}
+}
+
+// Reports how much each project and domain the caller is entitled to executed within a window, for chargeback.
+// Executions and node executions are aggregated by the database so that reports over long windows don't have to list
+// them.
+func (m *ProjectManager) GetProjectUsageReport(ctx context.Context, request interfaces.ProjectUsageReportRequest) (
+	*interfaces.ProjectUsageReport, error) {
+	if err := validation.ValidateProjectUsageReportRequest(request); err != nil {
+		return nil, err
+	}
+	input := repoInterfaces.ListProjectUsageInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Since:   request.StartTime,
+		Until:   request.EndTime,
+	}
+	executionUsage, err := m.db.ExecutionRepo().ListProjectUsage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	nodeExecutionUsage, err := m.db.NodeExecutionRepo().ListProjectUsage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	usageByProjectDomain := make(map[string]*interfaces.ProjectDomainUsage)
+	usage := make([]*interfaces.ProjectDomainUsage, 0, len(executionUsage))
+	getUsage := func(projectUsage models.ProjectUsage) *interfaces.ProjectDomainUsage {
+		key := fmt.Sprintf("%s/%s", projectUsage.Project, projectUsage.Domain)
+		projectDomainUsage, ok := usageByProjectDomain[key]
+		if !ok {
+			projectDomainUsage = &interfaces.ProjectDomainUsage{
+				Project: projectUsage.Project,
+				Domain:  projectUsage.Domain,
+			}
+			usageByProjectDomain[key] = projectDomainUsage
+			usage = append(usage, projectDomainUsage)
+		}
+		return projectDomainUsage
+	}
+	for _, projectUsage := range executionUsage {
+		if !auth.IsProjectAuthorized(ctx, projectUsage.Project) {
+			continue
+		}
+		getUsage(projectUsage).ExecutionCount = projectUsage.ExecutionCount
+	}
+	for _, projectUsage := range nodeExecutionUsage {
+		if !auth.IsProjectAuthorized(ctx, projectUsage.Project) {
+			continue
+		}
+		getUsage(projectUsage).NodeExecutionSeconds = projectUsage.Duration.Seconds()
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Project != usage[j].Project {
+			return usage[i].Project < usage[j].Project
+		}
+		return usage[i].Domain < usage[j].Domain
+	})
+
+	report := &interfaces.ProjectUsageReport{
+		StartTime: request.StartTime,
+		EndTime:   request.EndTime,
+		Usage:     make([]interfaces.ProjectDomainUsage, len(usage)),
+	}
+	for idx, projectDomainUsage := range usage {
+		report.Usage[idx] = *projectDomainUsage
+	}
+	return report, nil
+}
+
+func (m *ProjectManager) RegisterDomain(ctx context.Context, request interfaces.DomainRegisterRequest) (
+	*interfaces.DomainRegisterResponse, error) {
+	if err := validation.ValidateDomainRegisterRequest(request, m.config.ApplicationConfiguration()); err != nil {
+		return nil, err
+	}
+	err := m.db.DomainRepo().Create(ctx, models.Domain{
+		Identifier: request.ID,
+		Name:       request.Name,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to register domain [%s] with err %v", request.ID, err)
+		return nil, err
+	}
+	return &interfaces.DomainRegisterResponse{}, nil
+}
+
+func (m *ProjectManager) ListDomains(ctx context.Context) (*interfaces.DomainList, error) {
+	registeredDomains, err := m.db.DomainRepo().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	configDomains := m.config.ApplicationConfiguration().GetDomainsConfig()
+	domains := make([]interfaces.Domain, 0, len(*configDomains)+len(registeredDomains))
+	for _, configDomain := range *configDomains {
+		domains = append(domains, interfaces.Domain{
+			ID:         configDomain.ID,
+			Name:       configDomain.Name,
+			Configured: true,
+		})
+	}
+	for _, registeredDomain := range registeredDomains {
+		domains = append(domains, interfaces.Domain{
+			ID:   registeredDomain.Identifier,
+			Name: registeredDomain.Name,
+		})
+	}
+	return &interfaces.DomainList{
+		Domains: domains,
+	}, nil
+}
+
+func NewProjectManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	onboarder onboarding.Onboarder) interfaces.ProjectInterface {
 	return &ProjectManager{
-		db:     db,
-		config: config,
+		db:        db,
+		config:    config,
+		onboarder: onboarder,
 	}
 }
@@ -2,17 +2,26 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/lyft/flyteadmin/pkg/auth"
 	"github.com/lyft/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	onboardingMocks "github.com/lyft/flyteadmin/pkg/onboarding/mocks"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var mockProjectConfigProvider = runtimeMocks.NewMockConfigurationProvider(
@@ -56,7 +65,7 @@ func TestListProjects(t *testing.T) {
 		}, nil
 	}
 
-	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
 	resp, err := projectManager.ListProjects(context.Background(), admin.ProjectListRequest{})
 	assert.NoError(t, err)
 
@@ -80,7 +89,7 @@ func TestProjectManager_CreateProject(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil), &onboardingMocks.MockOnboarder{})
 	_, err := projectManager.CreateProject(context.Background(), admin.ProjectRegisterRequest{
 		Project: &admin.Project{
 			Id:          "flyte-project-id",
@@ -100,7 +109,7 @@ func TestProjectManager_CreateProjectError(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil), &onboardingMocks.MockOnboarder{})
 	_, err := projectManager.CreateProject(context.Background(), admin.ProjectRegisterRequest{
 		Project: &admin.Project{
 			Id:          "flyte-project-id",
@@ -124,3 +133,344 @@ func TestProjectManager_CreateProjectError(t *testing.T) {
 	})
 	assert.EqualError(t, err, "Domains are currently only set system wide. Please retry without domains included in your request.")
 }
+
+func TestProjectManager_CreateProjectOnboarding(t *testing.T) {
+	mockApplicationConfig := getMockApplicationConfigForProjectManagerTest()
+	mockApplicationConfig.(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		Onboarding: runtimeInterfaces.OnboardingConfig{
+			Enabled: true,
+		},
+	})
+	onboarded := make(chan string, 1)
+	projectManager := NewProjectManager(repositoryMocks.NewMockRepository(),
+		runtimeMocks.NewMockConfigurationProvider(mockApplicationConfig, nil, nil, nil, nil, nil),
+		&onboardingMocks.MockOnboarder{
+			OnboardFunc: func(ctx context.Context, project string) interfaces.ProjectOnboardingStatus {
+				onboarded <- project
+				return interfaces.ProjectOnboardingStatus{}
+			},
+		})
+	_, err := projectManager.CreateProject(context.Background(), admin.ProjectRegisterRequest{
+		Project: &admin.Project{
+			Id:   "flyte-project-id",
+			Name: "flyte-project-name",
+		},
+	})
+	assert.Nil(t, err)
+	select {
+	case project := <-onboarded:
+		assert.Equal(t, "flyte-project-id", project)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "project wasn't onboarded")
+	}
+}
+
+func TestProjectManager_GetProjectOnboardingStatus(t *testing.T) {
+	status := interfaces.ProjectOnboardingStatus{
+		Project: "project",
+		Steps: []interfaces.ProjectOnboardingStep{
+			{
+				Name:  interfaces.OnboardingStepNamespaceResources,
+				State: interfaces.OnboardingStepSucceeded,
+			},
+			{
+				Name:    interfaces.OnboardingStepSampleLaunchPlan,
+				State:   interfaces.OnboardingStepFailed,
+				Message: "launch plan not found",
+			},
+		},
+	}
+	serializedStatus, _ := json.Marshal(status)
+	mockRepository := repositoryMocks.NewMockRepository()
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		if projectID == "project" {
+			return models.Project{
+				Identifier:       projectID,
+				OnboardingStatus: serializedStatus,
+			}, nil
+		}
+		return models.Project{
+			Identifier: projectID,
+		}, nil
+	}
+	projectManager := NewProjectManager(mockRepository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+
+	fetched, err := projectManager.GetProjectOnboardingStatus(context.Background(),
+		interfaces.ProjectOnboardingStatusGetRequest{
+			Project: "project",
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, status.Steps, fetched.Steps)
+
+	_, err = projectManager.GetProjectOnboardingStatus(context.Background(),
+		interfaces.ProjectOnboardingStatusGetRequest{
+			Project: "not-onboarded",
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = projectManager.GetProjectOnboardingStatus(context.Background(),
+		interfaces.ProjectOnboardingStatusGetRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func getProjectModelsWithArchived() []models.Project {
+	archived := int32(interfaces.ProjectStateArchived)
+	return []models.Project{
+		{
+			Identifier: "active",
+			Name:       "active",
+		},
+		{
+			Identifier: "archived",
+			Name:       "archived",
+			State:      &archived,
+		},
+	}
+}
+
+func TestListProjects_SkipsArchivedProjects(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, parameter common.SortParameter) ([]models.Project, error) {
+		return getProjectModelsWithArchived(), nil
+	}
+
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+	resp, err := projectManager.ListProjects(context.Background(), admin.ProjectListRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Projects, 1)
+	assert.Equal(t, "active", resp.Projects[0].Id)
+}
+
+func TestListProjects_SkipsUnauthorizedProjects(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, parameter common.SortParameter) ([]models.Project, error) {
+		return []models.Project{
+			{Identifier: "authorized", Name: "authorized"},
+			{Identifier: "unauthorized", Name: "unauthorized"},
+		}, nil
+	}
+
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+	ctx := auth.WithAuthorizedProjects(context.Background(), []string{"authorized"})
+	resp, err := projectManager.ListProjects(ctx, admin.ProjectListRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Projects, 1)
+	assert.Equal(t, "authorized", resp.Projects[0].Id)
+}
+
+func TestProjectManager_UpdateProject(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{
+			Identifier:  projectID,
+			Name:        "name",
+			Description: "description",
+		}, nil
+	}
+	var updated models.Project
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateFunction = func(
+		ctx context.Context, project models.Project) error {
+		updated = project
+		return nil
+	}
+	projectManager := NewProjectManager(mockRepository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+
+	archived := interfaces.ProjectStateArchived
+	_, err := projectManager.UpdateProject(context.Background(), interfaces.ProjectUpdateRequest{
+		ID:     "project",
+		Labels: map[string]string{"team": "flyte"},
+		State:  &archived,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "project", updated.Identifier)
+	assert.Equal(t, "description", updated.Description)
+	assert.Equal(t, int32(interfaces.ProjectStateArchived), *updated.State)
+	labels, err := transformers.FromProjectModelLabels(updated)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "flyte"}, labels)
+
+	_, err = projectManager.UpdateProject(context.Background(), interfaces.ProjectUpdateRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestProjectManager_ListProjectDetails(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+		return getProjectModelsWithArchived(), nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+
+	resp, err := projectManager.ListProjectDetails(context.Background(), interfaces.ProjectDetailsListRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []interfaces.ProjectDetails{
+		{
+			ID:    "active",
+			Name:  "active",
+			State: interfaces.ProjectStateActive,
+		},
+	}, resp.Projects)
+
+	resp, err = projectManager.ListProjectDetails(context.Background(), interfaces.ProjectDetailsListRequest{
+		IncludeArchived: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Projects, 2)
+	assert.Equal(t, interfaces.ProjectStateArchived, resp.Projects[1].State)
+}
+
+func TestProjectManager_ListProjectDetailsPaginated(t *testing.T) {
+	teamLabels, err := transformers.CreateProjectLabels("project", map[string]string{"team": "flyte"})
+	assert.NoError(t, err)
+	archived := int32(interfaces.ProjectStateArchived)
+	projectModels := []models.Project{
+		{Identifier: "a", Labels: teamLabels},
+		{Identifier: "b"},
+		{Identifier: "c", Labels: teamLabels, State: &archived},
+		{Identifier: "d", Labels: teamLabels},
+		{Identifier: "e", Labels: teamLabels},
+	}
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+		assert.Equal(t, 2, input.Limit)
+		assert.Len(t, input.InlineFilters, 1)
+		assert.Equal(t, alphabeticalSortParam, input.SortParameter)
+		end := input.Offset + input.Limit
+		if end > len(projectModels) {
+			end = len(projectModels)
+		}
+		return projectModels[input.Offset:end], nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+
+	request := interfaces.ProjectDetailsListRequest{
+		Filters: "contains(name,project)",
+		Labels:  map[string]string{"team": "flyte"},
+		Limit:   2,
+	}
+	resp, err := projectManager.ListProjectDetails(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Projects, 2)
+	assert.Equal(t, "a", resp.Projects[0].ID)
+	assert.Equal(t, "d", resp.Projects[1].ID)
+	assert.Equal(t, "4", resp.Token)
+
+	request.Token = resp.Token
+	resp, err = projectManager.ListProjectDetails(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Projects, 1)
+	assert.Equal(t, "e", resp.Projects[0].ID)
+	assert.Empty(t, resp.Token)
+
+	_, err = projectManager.ListProjectDetails(context.Background(), interfaces.ProjectDetailsListRequest{
+		Filters: "invalid",
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestProjectManager_GetProjectUsageReport(t *testing.T) {
+	startTime := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(24 * time.Hour)
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListProjectUsageCallback(
+		func(ctx context.Context, input repoInterfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+			assert.Equal(t, repoInterfaces.ListProjectUsageInput{
+				Project: "project",
+				Since:   startTime,
+				Until:   endTime,
+			}, input)
+			return []models.ProjectUsage{
+				{Project: "project", Domain: "production", ExecutionCount: 2, Duration: time.Hour},
+				{Project: "project", Domain: "development", ExecutionCount: 5, Duration: time.Hour},
+			}, nil
+		})
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListProjectUsageCallback(
+		func(ctx context.Context, input repoInterfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+			assert.Equal(t, startTime, input.Since)
+			return []models.ProjectUsage{
+				{Project: "project", Domain: "development", ExecutionCount: 20, Duration: 90 * time.Second},
+				{Project: "project", Domain: "staging", ExecutionCount: 1, Duration: time.Minute},
+			}, nil
+		})
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, &onboardingMocks.MockOnboarder{})
+
+	report, err := projectManager.GetProjectUsageReport(context.Background(), interfaces.ProjectUsageReportRequest{
+		Project:   "project",
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ProjectUsageReport{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Usage: []interfaces.ProjectDomainUsage{
+			{Project: "project", Domain: "development", ExecutionCount: 5, NodeExecutionSeconds: 90},
+			{Project: "project", Domain: "production", ExecutionCount: 2},
+			{Project: "project", Domain: "staging", NodeExecutionSeconds: 60},
+		},
+	}, report)
+
+	_, err = projectManager.GetProjectUsageReport(context.Background(), interfaces.ProjectUsageReportRequest{
+		StartTime: endTime,
+		EndTime:   startTime,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestProjectManager_RegisterDomain(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	var registered models.Domain
+	mockRepository.DomainRepo().(*repositoryMocks.MockDomainRepo).CreateFunction = func(
+		ctx context.Context, domain models.Domain) error {
+		registered = domain
+		return nil
+	}
+	projectManager := NewProjectManager(mockRepository,
+		runtimeMocks.NewMockConfigurationProvider(
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil), &onboardingMocks.MockOnboarder{})
+
+	_, err := projectManager.RegisterDomain(context.Background(), interfaces.DomainRegisterRequest{
+		ID:   "qa",
+		Name: "QA",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.Domain{Identifier: "qa", Name: "QA"}, registered)
+
+	_, err = projectManager.RegisterDomain(context.Background(), interfaces.DomainRegisterRequest{
+		ID:   "staging",
+		Name: "staging",
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestProjectManager_ListDomains(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	mockRepository.DomainRepo().(*repositoryMocks.MockDomainRepo).ListAllFunction = func(
+		ctx context.Context) ([]models.Domain, error) {
+		return []models.Domain{{Identifier: "qa", Name: "QA"}}, nil
+	}
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, parameter common.SortParameter) ([]models.Project, error) {
+		return []models.Project{{Identifier: "project", Name: "project"}}, nil
+	}
+	projectManager := NewProjectManager(mockRepository,
+		runtimeMocks.NewMockConfigurationProvider(
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil), &onboardingMocks.MockOnboarder{})
+
+	domains, err := projectManager.ListDomains(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, domains.Domains, 5)
+	assert.True(t, domains.Domains[0].Configured)
+	assert.Equal(t, interfaces.Domain{ID: "qa", Name: "QA"}, domains.Domains[4])
+
+	// Projects are listed in the registered domains too.
+	projects, err := projectManager.ListProjects(context.Background(), admin.ProjectListRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, projects.Projects[0].Domains, 5)
+	assert.Equal(t, "qa", projects.Projects[0].Domains[4].Id)
+}
@@ -0,0 +1,100 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+type ResourceManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func (m *ResourceManager) UpdateResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesUpdateRequest) (
+	*interfaces.ResourceAttributesUpdateResponse, error) {
+	if err := validation.ValidateResourceAttributesUpdateRequest(request); err != nil {
+		return nil, err
+	}
+	model, err := transformers.ToResourceModel(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.db.ResourceRepo().CreateOrUpdate(ctx, model); err != nil {
+		return nil, err
+	}
+	return &interfaces.ResourceAttributesUpdateResponse{}, nil
+}
+
+func (m *ResourceManager) GetResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesGetRequest) (*interfaces.ResourceAttributes, error) {
+	if err := validation.ValidateResourceAttributesGetRequest(request); err != nil {
+		return nil, err
+	}
+	model, err := m.db.ResourceRepo().GetRaw(ctx, repoInterfaces.ResourceID{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		ResourceType: int32(request.ResourceType),
+	})
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := transformers.FromResourceModel(model)
+	if err != nil {
+		return nil, err
+	}
+	return &attributes, nil
+}
+
+func (m *ResourceManager) DeleteResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesDeleteRequest) (
+	*interfaces.ResourceAttributesDeleteResponse, error) {
+	if err := validation.ValidateResourceAttributesDeleteRequest(request); err != nil {
+		return nil, err
+	}
+	err := m.db.ResourceRepo().Delete(ctx, repoInterfaces.ResourceID{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		ResourceType: int32(request.ResourceType),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.ResourceAttributesDeleteResponse{}, nil
+}
+
+func (m *ResourceManager) ListResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesListRequest) (*interfaces.ResourceAttributesList, error) {
+	if err := validation.ValidateResourceAttributesListRequest(request); err != nil {
+		return nil, err
+	}
+	resourceModels, err := m.db.ResourceRepo().ListAll(ctx, int32(request.ResourceType))
+	if err != nil {
+		return nil, err
+	}
+	list := &interfaces.ResourceAttributesList{
+		Attributes: make([]interfaces.ResourceAttributes, len(resourceModels)),
+	}
+	for idx, model := range resourceModels {
+		if list.Attributes[idx], err = transformers.FromResourceModel(model); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func NewResourceManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ResourceInterface {
+	return &ResourceManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,128 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+)
+
+var executionQueueAttributes = interfaces.MatchingAttributes{
+	ExecutionQueueAttributes: &interfaces.ExecutionQueueAttributes{
+		Tags: []string{"gpu"},
+	},
+}
+
+func TestUpdateResourceAttributes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var updated bool
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.Resource) error {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "workflow", input.Workflow)
+		assert.Equal(t, int32(interfaces.MatchableResourceExecutionQueue), input.ResourceType)
+		assert.Equal(t, int32(models.ResourcePriorityWorkflowLevel), input.Priority)
+		updated = true
+		return nil
+	}
+	resourceManager := NewResourceManager(repository, mockProjectConfigProvider)
+	_, err := resourceManager.UpdateResourceAttributes(context.Background(), interfaces.ResourceAttributesUpdateRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Workflow:   "workflow",
+		Attributes: executionQueueAttributes,
+	})
+	assert.NoError(t, err)
+	assert.True(t, updated)
+}
+
+func TestUpdateResourceAttributes_InvalidRequest(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.Resource) error {
+		t.Fatal("invalid attributes shouldn't be stored")
+		return nil
+	}
+	resourceManager := NewResourceManager(repository, mockProjectConfigProvider)
+	_, err := resourceManager.UpdateResourceAttributes(context.Background(), interfaces.ResourceAttributesUpdateRequest{
+		Domain:     "domain",
+		Attributes: executionQueueAttributes,
+	})
+	assert.EqualError(t, err, "missing project")
+}
+
+func TestGetResourceAttributes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	model, _ := transformers.ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Attributes: executionQueueAttributes,
+	})
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetRawFunction = func(
+		ctx context.Context, id repoInterfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, repoInterfaces.ResourceID{
+			Project:      "project",
+			Domain:       "domain",
+			ResourceType: int32(interfaces.MatchableResourceExecutionQueue),
+		}, id)
+		return model, nil
+	}
+	resourceManager := NewResourceManager(repository, mockProjectConfigProvider)
+	attributes, err := resourceManager.GetResourceAttributes(context.Background(), interfaces.ResourceAttributesGetRequest{
+		Project:      "project",
+		Domain:       "domain",
+		ResourceType: interfaces.MatchableResourceExecutionQueue,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ResourceAttributes{
+		Project:    "project",
+		Domain:     "domain",
+		Attributes: executionQueueAttributes,
+	}, attributes)
+}
+
+func TestDeleteResourceAttributes_NotFound(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).DeleteFunction = func(
+		ctx context.Context, id repoInterfaces.ResourceID) error {
+		return flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+	}
+	resourceManager := NewResourceManager(repository, mockProjectConfigProvider)
+	_, err := resourceManager.DeleteResourceAttributes(context.Background(),
+		interfaces.ResourceAttributesDeleteRequest{
+			Project:      "project",
+			ResourceType: interfaces.MatchableResourceTaskResource,
+		})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListResourceAttributes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	model, _ := transformers.ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Project:    "project",
+		Attributes: executionQueueAttributes,
+	})
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).ListAllFunction = func(
+		ctx context.Context, resourceType int32) ([]models.Resource, error) {
+		assert.Equal(t, int32(interfaces.MatchableResourceExecutionQueue), resourceType)
+		return []models.Resource{model}, nil
+	}
+	resourceManager := NewResourceManager(repository, mockProjectConfigProvider)
+	list, err := resourceManager.ListResourceAttributes(context.Background(), interfaces.ResourceAttributesListRequest{
+		ResourceType: interfaces.MatchableResourceExecutionQueue,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, list.Attributes, 1)
+	assert.Equal(t, "project", list.Attributes[0].Project)
+	assert.Equal(t, []string{"gpu"}, list.Attributes[0].Attributes.ExecutionQueueAttributes.Tags)
+}
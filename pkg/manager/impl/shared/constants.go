@@ -31,6 +31,27 @@ const (
 	OccurredAt            = "occurred_at"
 	Event                 = "event"
 	ParentTaskExecutionID = "parent_task_execution_id"
+	ParentID              = "parent_id"
 	UserInputs            = "user_inputs"
 	ProjectDomain         = "project_domain"
+	CompiledWorkflow      = "compiled_workflow"
+	CacheStatus           = "cache_status"
+	Artifacts             = "artifacts"
+	Kind                  = "kind"
+	URI                   = "uri"
+	Cluster               = "cluster"
+	RetryAttempt          = "retry_attempt"
+	LaunchPlan            = "launch_plan"
+	Channel               = "channel"
+	Variants              = "variants"
+	Query                 = "query"
+	Phase                 = "phase"
+	Workflow              = "workflow"
+	Attributes            = "attributes"
+	ExecutionQueueTags    = "execution_queue_attributes.tags"
+	ExecutionClusterLabel = "execution_cluster_label.value"
+	ExecutionNameTemplate = "execution_name_policy.template"
+	QuietHours            = "quiet_hours"
+	StartTime             = "start_time"
+	EndTime               = "end_time"
 )
@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flytestdlib/logger"
 	"github.com/lyft/flytestdlib/promutils"
 	"github.com/prometheus/client_golang/prometheus"
 
+	cloudEventInterfaces "github.com/lyft/flyteadmin/pkg/async/cloudevent/interfaces"
 	"github.com/lyft/flyteadmin/pkg/common"
 	dataInterfaces "github.com/lyft/flyteadmin/pkg/data/interfaces"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/logging"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
@@ -18,9 +24,10 @@ import (
 	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	logInterfaces "github.com/lyft/flyteadmin/pkg/tasklog/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
-	"github.com/lyft/flytestdlib/logger"
 	"google.golang.org/grpc/codes"
 )
 
@@ -33,35 +40,144 @@ type taskExecutionMetrics struct {
 	MissingTaskExecution       prometheus.Counter
 	MissingTaskDefinition      prometheus.Counter
 	ClosureSizeBytes           prometheus.Summary
+	LateEventsAccepted         prometheus.Counter
+	EventMetrics               util.EventMetrics
 }
 
 type TaskExecutionManager struct {
-	db      repositories.RepositoryInterface
-	metrics taskExecutionMetrics
-	urlData dataInterfaces.RemoteURLInterface
+	db             repositories.RepositoryInterface
+	config         runtimeInterfaces.Configuration
+	queueAllocator executions.QueueAllocator
+	metrics        taskExecutionMetrics
+	urlData        dataInterfaces.RemoteURLInterface
+	logProvider    logInterfaces.LogProvider
+	// Mirrors recorded task execution events to downstream consumers.
+	cloudEventPublisher cloudEventInterfaces.Publisher
+}
+
+// Returns the latest checkpoint of the retry attempts before the one an event was reported for, which the attempt can
+// resume from. Attempts which didn't checkpoint pass on the checkpoint they were handed.
+func (m *TaskExecutionManager) getPreviousCheckpointURI(
+	ctx context.Context, request *admin.TaskExecutionEventRequest) (string, error) {
+	if request.Event.RetryAttempt == 0 {
+		return "", nil
+	}
+	previousTaskExecutionID := core.TaskExecutionIdentifier{
+		TaskId:          request.Event.TaskId,
+		NodeExecutionId: request.Event.ParentNodeExecutionId,
+		RetryAttempt:    request.Event.RetryAttempt - 1,
+	}
+	previousTaskExecutionModel, err := m.db.TaskExecutionRepo().Get(ctx, repoInterfaces.GetTaskExecutionInput{
+		TaskExecutionID: previousTaskExecutionID,
+	})
+	if err != nil {
+		if ferr, ok := err.(errors.FlyteAdminError); ok && ferr.Code() == codes.NotFound {
+			return "", nil
+		}
+		logging.Debugf(ctx, logging.Executions, "Failed to get previous task execution [%+v] with err %v",
+			previousTaskExecutionID, err)
+		return "", err
+	}
+	if len(previousTaskExecutionModel.CheckpointURI) > 0 {
+		return previousTaskExecutionModel.CheckpointURI, nil
+	}
+	return previousTaskExecutionModel.PreviousCheckpointURI, nil
+}
+
+// Derives the environment a task execution runs in from its compiled task the way it was launched: with the default
+// resources and the queue assigned to the execution's workflow. Child task executions of dynamic nodes run in the
+// dynamic queue, when one is assigned.
+func (m *TaskExecutionManager) getTaskExecutionEnvironment(
+	ctx context.Context, nodeExecutionModel *models.NodeExecution, request *admin.TaskExecutionEventRequest) (
+	transformers.TaskExecutionEnvironment, error) {
+	task, err := util.GetTask(ctx, m.db, *request.Event.TaskId)
+	if err != nil {
+		if ferr, ok := err.(errors.FlyteAdminError); ok && ferr.Code() == codes.NotFound {
+			m.metrics.MissingTaskDefinition.Inc()
+		}
+		return transformers.TaskExecutionEnvironment{}, err
+	}
+	compiledTask := task.GetClosure().GetCompiledTask()
+	container := compiledTask.GetTemplate().GetContainer()
+	if container == nil {
+		// Unrecognized target type, nothing to record
+		return transformers.TaskExecutionEnvironment{}, nil
+	}
+	executionID := request.Event.ParentNodeExecutionId.ExecutionId
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *executionID)
+	if err != nil {
+		return transformers.TaskExecutionEnvironment{}, err
+	}
+	execution, err := transformers.FromExecutionModel(*executionModel)
+	if err != nil {
+		return transformers.TaskExecutionEnvironment{}, err
+	}
+	projectDomainAttributes, err := util.GetProjectDomainAttributes(
+		ctx, m.db, executionID.Project, executionID.Domain)
+	if err != nil {
+		return transformers.TaskExecutionEnvironment{}, err
+	}
+	resourceQuota, err := validation.GetResourceQuota(projectDomainAttributes)
+	if err != nil {
+		return transformers.TaskExecutionEnvironment{}, err
+	}
+	taskResourceConfig, err := util.GetTaskResourceConfiguration(ctx, m.db, m.config.TaskResourceConfiguration(),
+		executionID.Project, executionID.Domain, execution.Closure.GetWorkflowId().GetName())
+	if err != nil {
+		return transformers.TaskExecutionEnvironment{}, err
+	}
+	validation.SetDefaults(ctx, taskResourceConfig, compiledTask, resourceQuota)
+	environment := transformers.TaskExecutionEnvironment{
+		Image:     container.Image,
+		Resources: container.Resources,
+	}
+
+	if execution.Closure.GetWorkflowId() != nil {
+		queueConfig := m.queueAllocator.GetQueue(ctx, *execution.Closure.WorkflowId)
+		environment.Queue = queueConfig.PrimaryQueue
+		if nodeExecutionModel.ParentTaskExecutionID != 0 && len(queueConfig.DynamicQueue) > 0 {
+			environment.Queue = queueConfig.DynamicQueue
+		}
+	}
+	return environment, nil
 }
 
 func (m *TaskExecutionManager) createTaskExecution(
 	ctx context.Context, nodeExecutionModel *models.NodeExecution, request *admin.TaskExecutionEventRequest) (
 	models.TaskExecution, error) {
 
+	previousCheckpointURI, err := m.getPreviousCheckpointURI(ctx, request)
+	if err != nil {
+		return models.TaskExecution{}, err
+	}
+	// The environment reported by events takes precedence, so task executions are still recorded when deriving it fails.
+	environment, err := m.getTaskExecutionEnvironment(ctx, nodeExecutionModel, request)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to derive the environment of task execution [%+v] with err: %v",
+			request.Event.TaskId, err)
+	}
 	taskExecutionModel, err := transformers.CreateTaskExecutionModel(
 		transformers.CreateTaskExecutionModelInput{
-			Request: request,
+			Request:               request,
+			PreviousCheckpointURI: previousCheckpointURI,
+			Environment:           environment,
 		})
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform task execution %+v into database model: %v", request.Event.TaskId, err)
+		logging.Debugf(ctx, logging.Executions, "failed to transform task execution %+v into database model: %v", request.Event.TaskId, err)
 		return models.TaskExecution{}, err
 	}
-	if err := m.db.TaskExecutionRepo().Create(ctx, *taskExecutionModel); err != nil {
-		logger.Debugf(ctx, "Failed to create task execution with task id [%+v] and node execution model [%+v] with err %v",
+	writeTimer := m.metrics.EventMetrics.WriteDuration.Start()
+	err = m.db.TaskExecutionRepo().Create(ctx, *taskExecutionModel)
+	writeTimer.Stop()
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to create task execution with task id [%+v] and node execution model [%+v] with err %v",
 			request.Event.TaskId, nodeExecutionModel, err)
 		return models.TaskExecution{}, err
 	}
 
 	m.metrics.TaskExecutionsCreated.Inc()
 	m.metrics.ClosureSizeBytes.Observe(float64(len(nodeExecutionModel.Closure)))
-	logger.Debugf(ctx, "created task execution: %+v", request.Event.TaskId)
+	logging.Debugf(ctx, logging.Executions, "created task execution: %+v", request.Event.TaskId)
 	return *taskExecutionModel, nil
 }
 
@@ -71,13 +187,15 @@ func (m *TaskExecutionManager) updateTaskExecutionModelState(
 
 	err := transformers.UpdateTaskExecutionModel(request, existingTaskExecution)
 	if err != nil {
-		logger.Debugf(ctx, "failed to update task execution model [%+v] with err: %v", request.Event.TaskId, err)
+		logging.Debugf(ctx, logging.Executions, "failed to update task execution model [%+v] with err: %v", request.Event.TaskId, err)
 		return models.TaskExecution{}, err
 	}
 
+	writeTimer := m.metrics.EventMetrics.WriteDuration.Start()
 	err = m.db.TaskExecutionRepo().Update(ctx, *existingTaskExecution)
+	writeTimer.Stop()
 	if err != nil {
-		logger.Debugf(ctx, "Failed to update task execution with task id [%+v] and task execution model [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to update task execution with task id [%+v] and task execution model [%+v] with err %v",
 			request.Event.TaskId, existingTaskExecution, err)
 		return models.TaskExecution{}, err
 	}
@@ -85,21 +203,49 @@ func (m *TaskExecutionManager) updateTaskExecutionModelState(
 	return *existingTaskExecution, nil
 }
 
+// Adds the metadata of an event received after the task execution terminated to its closure. Returns whether the event
+// had anything to add.
+func (m *TaskExecutionManager) enrichTaskExecutionWithLateEvent(
+	ctx context.Context, request *admin.TaskExecutionEventRequest, taskExecutionModel *models.TaskExecution) (
+	bool, error) {
+	enriched, err := transformers.EnrichTaskExecutionModel(request, taskExecutionModel)
+	if err != nil || !enriched {
+		return false, err
+	}
+	err = m.db.TaskExecutionRepo().Update(ctx, *taskExecutionModel)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to enrich task execution with task id [%+v] with err %v",
+			request.Event.TaskId, err)
+		return false, err
+	}
+	logging.Debugf(ctx, logging.Executions, "Enriched terminated task execution [%+v] with late %s event",
+		request.Event.TaskId, request.Event.Phase.String())
+	m.metrics.LateEventsAccepted.Inc()
+	return true, nil
+}
+
 func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, request admin.TaskExecutionEventRequest) (
 	*admin.TaskExecutionEventResponse, error) {
+	receivedAt := time.Now()
 	// Get the parent node execution, if none found a MissingEntityError will be returned
 	nodeExecutionID := request.Event.ParentNodeExecutionId
+	ctx = logging.WithExecutionID(ctx, nodeExecutionID.GetExecutionId())
 	taskExecutionID := core.TaskExecutionIdentifier{
 		TaskId:          request.Event.TaskId,
 		NodeExecutionId: nodeExecutionID,
 		RetryAttempt:    request.Event.RetryAttempt,
 	}
-	logger.Debugf(ctx, "Received task execution event for [%+v] transitioning to phase [%v]",
+	logging.Debugf(ctx, logging.Executions, "Received task execution event for [%+v] transitioning to phase [%v]",
 		taskExecutionID, request.Event.Phase)
+	m.metrics.EventMetrics.RecordIngestionDelay(
+		util.TaskEventType, request.Event.Phase.String(), request.Event.OccurredAt, receivedAt)
+
+	lookupTimer := m.metrics.EventMetrics.LookupDuration.Start()
 	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, nodeExecutionID)
 	if err != nil {
+		lookupTimer.Stop()
 		m.metrics.MissingTaskExecution.Inc()
-		logger.Debugf(ctx, "Failed to get existing node execution [%+v] with err %v", nodeExecutionID, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to get existing node execution [%+v] with err %v", nodeExecutionID, err)
 		if ferr, ok := err.(errors.FlyteAdminError); ok {
 			return nil, errors.NewFlyteAdminErrorf(ferr.Code(),
 				"Failed to get existing execution node id:[%+v] with err: %v", nodeExecutionID, err)
@@ -113,22 +259,24 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	taskExecutionModel, err := m.db.TaskExecutionRepo().Get(ctx, repoInterfaces.GetTaskExecutionInput{
 		TaskExecutionID: taskExecutionID,
 	})
+	lookupTimer.Stop()
 
 	if err != nil {
 		if err.(errors.FlyteAdminError).Code() != codes.NotFound {
-			logger.Debugf(ctx, "Failed to find existing task execution [%+v] with err %v", taskExecutionID, err)
+			logging.Debugf(ctx, logging.Executions, "Failed to find existing task execution [%+v] with err %v", taskExecutionID, err)
 			return nil, err
 		}
 		_, err := m.createTaskExecution(ctx, nodeExecutionModel, &request)
 		if err != nil {
 			return nil, err
 		}
+		m.publishCloudEvent(ctx, request)
 
 		return &admin.TaskExecutionEventResponse{}, nil
 	}
 	if taskExecutionModel.Phase == request.Event.Phase.String() &&
 		taskExecutionModel.PhaseVersion >= request.Event.PhaseVersion {
-		logger.Debugf(ctx, "have already recorded task execution phase %s (version: %d) for %v",
+		logging.Debugf(ctx, logging.Executions, "have already recorded task execution phase %s (version: %d) for %v",
 			request.Event.Phase.String(), request.Event.PhaseVersion, taskExecutionID)
 		return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
 			"have already recorded task execution phase %s (version: %d) for %v",
@@ -136,17 +284,29 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	}
 
 	currentPhase := core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecutionModel.Phase])
-	if common.IsTaskExecutionTerminal(currentPhase) {
-		// Cannot update a terminal execution.
-		curPhase := request.Event.Phase.String()
-		errorMsg := fmt.Sprintf("invalid phase change from %v to %v for task execution %v", taskExecutionModel.Phase, request.Event.Phase, taskExecutionID)
-		logger.Warnf(ctx, errorMsg)
-		return nil, errors.NewAlreadyInTerminalStateError(ctx, errorMsg, curPhase)
+	switch executions.TaskExecutionPhases.GetTransition(int32(currentPhase), int32(request.Event.Phase)) {
+	case executions.PhaseTransitionFromTerminal:
+		if executions.IsLateEventAccepted(m.config.ApplicationConfiguration().GetTopLevelConfig().LateEvents,
+			taskExecutionModel.TaskExecutionUpdatedAt, request.Event.OccurredAt) {
+			enriched, err := m.enrichTaskExecutionWithLateEvent(ctx, &request, &taskExecutionModel)
+			if err != nil {
+				return nil, err
+			}
+			if enriched {
+				m.publishCloudEvent(ctx, request)
+				return &admin.TaskExecutionEventResponse{}, nil
+			}
+		}
+		return nil, executions.TaskExecutionPhases.NewTransitionError(
+			ctx, int32(currentPhase), int32(request.Event.Phase), &taskExecutionID)
+	case executions.PhaseTransitionInvalid:
+		return nil, executions.TaskExecutionPhases.NewTransitionError(
+			ctx, int32(currentPhase), int32(request.Event.Phase), &taskExecutionID)
 	}
 
 	taskExecutionModel, err = m.updateTaskExecutionModelState(ctx, &request, &taskExecutionModel)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to update task execution with id [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to update task execution with id [%+v] with err %v",
 			taskExecutionID, err)
 		return nil, err
 	}
@@ -159,11 +319,23 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	}
 
 	m.metrics.TaskExecutionEventsCreated.Inc()
-	logger.Debugf(ctx, "Successfully recorded task execution event [%v]", request.Event)
+	logging.Debugf(ctx, logging.Executions, "Successfully recorded task execution event [%v]", request.Event)
+	m.publishCloudEvent(ctx, request)
 	// TODO: we will want to return some scope information here soon!
 	return &admin.TaskExecutionEventResponse{}, nil
 }
 
+// Publish failures are only logged: the event was recorded and failing the request would have propeller retry it.
+// Events may be recorded within a transaction, so they're only published once it commits.
+func (m *TaskExecutionManager) publishCloudEvent(ctx context.Context, request admin.TaskExecutionEventRequest) {
+	m.db.AfterCommit(ctx, func(ctx context.Context) {
+		if err := m.cloudEventPublisher.Publish(ctx, proto.MessageName(request.Event), request.Event); err != nil {
+			logger.Warningf(ctx, "Failed to publish cloud event for task execution of [%+v] with err: %v",
+				request.Event.ParentNodeExecutionId, err)
+		}
+	})
+}
+
 func (m *TaskExecutionManager) GetTaskExecution(
 	ctx context.Context, request admin.TaskExecutionGetRequest) (*admin.TaskExecution, error) {
 	taskExecutionModel, err := util.GetTaskExecutionModel(ctx, m.db, request.Id)
@@ -172,7 +344,7 @@ func (m *TaskExecutionManager) GetTaskExecution(
 	}
 	taskExecution, err := transformers.FromTaskExecutionModel(*taskExecutionModel)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to transform task execution model [%+v] to proto: %v", request.Id, err)
+		logging.Debugf(ctx, logging.Executions, "Failed to transform task execution model [%+v] to proto: %v", request.Id, err)
 		return nil, err
 	}
 	return taskExecution, nil
@@ -181,9 +353,15 @@ func (m *TaskExecutionManager) GetTaskExecution(
 func (m *TaskExecutionManager) ListTaskExecutions(
 	ctx context.Context, request admin.TaskExecutionListRequest) (*admin.TaskExecutionList, error) {
 	if err := validation.ValidateTaskExecutionListRequest(request); err != nil {
-		logger.Debugf(ctx, "ListTaskExecutions request [%+v] is invalid: %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "ListTaskExecutions request [%+v] is invalid: %v", request, err)
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, m.config.ApplicationConfiguration(), validation.TaskExecutionListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	identifierFilters, err := util.GetNodeExecutionIdentifierFilters(ctx, *request.NodeExecutionId)
 	if err != nil {
@@ -215,14 +393,14 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 		SortParameter: sortParameter,
 	})
 	if err != nil {
-		logger.Debugf(ctx, "Failed to list task executions with request [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to list task executions with request [%+v] with err %v",
 			request, err)
 		return nil, err
 	}
 
 	taskExecutionList, err := transformers.FromTaskExecutionModels(output.TaskExecutions)
 	if err != nil {
-		logger.Debugf(ctx, "failed to transform task execution models for request [%+v] with err: %v", request, err)
+		logging.Debugf(ctx, logging.Executions, "failed to transform task execution models for request [%+v] with err: %v", request, err)
 		return nil, err
 	}
 	var token string
@@ -238,16 +416,26 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 func (m *TaskExecutionManager) GetTaskExecutionData(
 	ctx context.Context, request admin.TaskExecutionGetDataRequest) (*admin.TaskExecutionGetDataResponse, error) {
 	if err := validation.ValidateTaskExecutionIdentifier(request.Id); err != nil {
-		logger.Debugf(ctx, "Invalid identifier [%+v]: %v", request.Id, err)
+		logging.Debugf(ctx, logging.Executions, "Invalid identifier [%+v]: %v", request.Id, err)
+		return nil, err
 	}
 	taskExecution, err := m.GetTaskExecution(ctx, admin.TaskExecutionGetRequest{
 		Id: request.Id,
 	})
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get task execution with id [%+v] with err %v",
+		logging.Debugf(ctx, logging.Executions, "Failed to get task execution with id [%+v] with err %v",
+			request.Id, err)
+		return nil, err
+	}
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id.NodeExecutionId.ExecutionId)
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get execution for task execution [%+v] with err %v",
 			request.Id, err)
 		return nil, err
 	}
+	if err := util.ValidateDataAccess(ctx, *executionModel, m.config.ApplicationConfiguration()); err != nil {
+		return nil, err
+	}
 	signedInputsURLBlob, err := m.urlData.Get(ctx, taskExecution.InputUri)
 	if err != nil {
 		return nil, err
@@ -265,9 +453,35 @@ func (m *TaskExecutionManager) GetTaskExecutionData(
 	}, nil
 }
 
+// Computes the task execution's log links with the configured log provider rather than returning the links captured
+// from its events, which may have expired.
+func (m *TaskExecutionManager) GetTaskExecutionLogs(
+	ctx context.Context, request interfaces.TaskExecutionLogsGetRequest) (*interfaces.TaskExecutionLogsGetResponse, error) {
+	if err := validation.ValidateTaskExecutionIdentifier(request.ID); err != nil {
+		return nil, err
+	}
+	taskExecution, err := m.GetTaskExecution(ctx, admin.TaskExecutionGetRequest{
+		Id: request.ID,
+	})
+	if err != nil {
+		logging.Debugf(ctx, logging.Executions, "Failed to get task execution with id [%+v] with err %v",
+			request.ID, err)
+		return nil, err
+	}
+	logs, err := m.logProvider.GetTaskLogs(ctx, taskExecution)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to compute log links for task execution [%+v] with err: %v", request.ID, err)
+	}
+	return &interfaces.TaskExecutionLogsGetResponse{
+		Logs: logs,
+	}, nil
+}
+
 func NewTaskExecutionManager(
-	db repositories.RepositoryInterface,
-	scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface) interfaces.TaskExecutionInterface {
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, logProvider logInterfaces.LogProvider,
+	cloudEventPublisher cloudEventInterfaces.Publisher) interfaces.TaskExecutionInterface {
 	metrics := taskExecutionMetrics{
 		Scope: scope,
 		ActiveTaskExecutions: scope.MustNewGauge("active_executions",
@@ -284,10 +498,18 @@ func NewTaskExecutionManager(
 			"overall count of task execution events received that are missing a task definition"),
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes",
 			"size in bytes of serialized task execution closure"),
+		LateEventsAccepted: scope.MustNewCounter("late_events_accepted",
+			"overall count of events received after a task execution terminated which enriched its closure"),
+		EventMetrics: util.NewEventMetrics(scope),
 	}
 	return &TaskExecutionManager{
-		db:      db,
-		metrics: metrics,
-		urlData: urlData,
+		db:             db,
+		config:         config,
+		queueAllocator: executions.NewQueueAllocator(config, db),
+		metrics:        metrics,
+		urlData:        urlData,
+		logProvider:    logProvider,
+
+		cloudEventPublisher: cloudEventPublisher,
 	}
 }
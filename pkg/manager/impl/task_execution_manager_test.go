@@ -10,12 +10,18 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	cloudEventMocks "github.com/lyft/flyteadmin/pkg/async/cloudevent/mocks"
 	dataMocks "github.com/lyft/flyteadmin/pkg/data/mocks"
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+	logImplementations "github.com/lyft/flyteadmin/pkg/tasklog/implementations"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
@@ -59,6 +65,8 @@ var taskEventRequest = admin.TaskExecutionEventRequest{
 
 var mockTaskExecutionRemoteURL = dataMocks.NewMockRemoteURL()
 
+var mockTaskLogProvider = logImplementations.NewEventLogProvider()
+
 var retryAttemptValue = uint32(1)
 
 func addGetWorkflowExecutionCallback(repository repositories.RepositoryInterface) {
@@ -170,7 +178,9 @@ func TestCreateTaskEvent(t *testing.T) {
 			}, input)
 			return nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, createTaskCalled)
@@ -178,6 +188,160 @@ func TestCreateTaskEvent(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestCreateTaskEvent_PublishCloudEvent(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	addGetNodeExecutionCallback(repository)
+	addGetTaskCallback(repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var published bool
+	publisher := &cloudEventMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, eventType string, msg proto.Message) error {
+		published = true
+		assert.Equal(t, "flyteidl.event.TaskExecutionEvent", eventType)
+		assert.True(t, proto.Equal(taskEventRequest.Event, msg))
+		return errors.New("topic unavailable")
+	})
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, publisher)
+	// Failing to mirror the event doesn't fail recording it.
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
+	assert.Nil(t, err)
+	assert.True(t, published)
+}
+
+func TestCreateTaskEvent_PreviousCheckpoint(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	addGetNodeExecutionCallback(repository)
+	addGetTaskCallback(repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			if input.TaskExecutionID.RetryAttempt == 0 {
+				return models.TaskExecution{
+					Phase:         core.TaskExecution_FAILED.String(),
+					CheckpointURI: "s3://bucket/checkpoints/0",
+				}, nil
+			}
+			return models.TaskExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var createTaskCalled bool
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.TaskExecution) error {
+			createTaskCalled = true
+			assert.Equal(t, retryAttemptValue, *input.RetryAttempt)
+			assert.Equal(t, "s3://bucket/checkpoints/0", input.PreviousCheckpointURI)
+			return nil
+		})
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
+	assert.Nil(t, err)
+	assert.True(t, createTaskCalled)
+}
+
+func TestCreateTaskEvent_Environment(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetNodeExecutionCallback(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			closureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+				WorkflowId: &core.Identifier{
+					ResourceType: core.ResourceType_WORKFLOW,
+					Project:      "project",
+					Domain:       "domain",
+					Name:         "workflow",
+					Version:      "version",
+				},
+			})
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: sampleNodeExecID.ExecutionId.Project,
+					Domain:  sampleNodeExecID.ExecutionId.Domain,
+					Name:    sampleNodeExecID.ExecutionId.Name,
+				},
+				Closure: closureBytes,
+			}, nil
+		})
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			closureBytes, _ := proto.Marshal(&admin.TaskClosure{
+				CompiledTask: &core.CompiledTask{
+					Template: &core.TaskTemplate{
+						Id: sampleTaskID,
+						Target: &core.TaskTemplate_Container{
+							Container: &core.Container{
+								Image: "image:tag",
+								Resources: &core.Resources{
+									Requests: []*core.Resources_ResourceEntry{
+										{
+											Name:  core.Resources_CPU,
+											Value: "1",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			return models.Task{
+				TaskKey: models.TaskKey{
+					Project: sampleTaskID.Project,
+					Domain:  sampleTaskID.Domain,
+					Name:    sampleTaskID.Name,
+					Version: sampleTaskID.Version,
+				},
+				Closure: closureBytes,
+			}, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	var createTaskCalled bool
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.TaskExecution) error {
+			createTaskCalled = true
+			assert.Equal(t, "image:tag", input.ContainerImage)
+			assert.Equal(t, "primary Q", input.Queue)
+			var resources core.Resources
+			assert.Nil(t, proto.Unmarshal(input.Resources, &resources))
+			assert.Equal(t, "1", resources.Requests[0].Value)
+			assert.NotEmpty(t, resources.Limits)
+			return nil
+		})
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		testutils.GetApplicationConfigWithDefaultProjects(),
+		runtimeMocks.NewMockQueueConfigurationProvider([]runtimeInterfaces.ExecutionQueue{
+			{
+				Primary:    "primary Q",
+				Dynamic:    "dynamic Q",
+				Attributes: []string{"tag"},
+			},
+		}, []runtimeInterfaces.WorkflowConfig{
+			{
+				Project:      "project",
+				Domain:       "domain",
+				WorkflowName: "workflow",
+				Tags:         []string{"tag"},
+			},
+		}),
+		nil, runtimeMocks.NewMockTaskResourceConfiguration(
+			runtimeInterfaces.TaskResourceSet{Memory: "200Mi"}, runtimeInterfaces.TaskResourceSet{}), nil, nil)
+	taskExecManager := NewTaskExecutionManager(
+		repository, configProvider, mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
+	assert.Nil(t, err)
+	assert.True(t, createTaskCalled)
+}
+
 func TestCreateTaskEvent_Update(t *testing.T) {
 	taskCompletedAt := taskStartedAt.Add(time.Minute)
 	taskEventCompletedAtProto, _ := ptypes.TimestampProto(taskCompletedAt)
@@ -283,7 +447,9 @@ func TestCreateTaskEvent_Update(t *testing.T) {
 		OutputUri: expectedOutputResult.OutputUri,
 	}
 
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -298,7 +464,9 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
 			return models.NodeExecution{}, expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ] "+
@@ -318,7 +486,9 @@ func TestCreateTaskEvent_CreateDatabaseError(t *testing.T) {
 		func(ctx context.Context, input models.TaskExecution) error {
 			return expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -358,7 +528,9 @@ func TestCreateTaskEvent_UpdateDatabaseError(t *testing.T) {
 		func(ctx context.Context, execution models.TaskExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	nodeExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := nodeExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -391,7 +563,9 @@ func TestCreateTaskEvent_UpdateTerminalEventError(t *testing.T) {
 			}, nil
 		})
 	taskEventRequest.Event.Phase = core.TaskExecution_RUNNING
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 
 	assert.Nil(t, resp)
@@ -458,7 +632,9 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	taskEventRequest.Event.PhaseVersion = uint32(1)
 	taskEventRequest.Event.OccurredAt = taskEventUpdatedAtProto
 
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -524,7 +700,9 @@ func TestGetTaskExecution(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -574,7 +752,9 @@ func TestGetTaskExecution_TransformerError(t *testing.T) {
 				Closure:   []byte("i'm an invalid task closure"),
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -684,7 +864,9 @@ func TestListTaskExecutions(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	taskExecutions, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			NodeId: "nodey b",
@@ -746,6 +928,62 @@ func TestListTaskExecutions(t *testing.T) {
 	}, taskExecutions.TaskExecutions[1]))
 }
 
+func TestListTaskExecutions_PhaseRetryAttemptAndDurationFilters(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 8)
+			queryExpr, _ := input.InlineFilters[4].GetGormJoinTableQueryExpr("task_executions")
+			assert.Equal(t, "task_executions.phase in (?)", queryExpr.Query)
+			assert.Equal(t, []interface{}{"RUNNING", "FAILED"}, queryExpr.Args)
+
+			queryExpr, _ = input.InlineFilters[5].GetGormJoinTableQueryExpr("task_executions")
+			assert.Equal(t, "task_executions.retry_attempt >= ?", queryExpr.Query)
+			assert.Equal(t, int64(1), queryExpr.Args)
+
+			queryExpr, _ = input.InlineFilters[6].GetGormJoinTableQueryExpr("task_executions")
+			assert.Equal(t, "(CASE WHEN task_executions.duration > 0 THEN task_executions.duration "+
+				"ELSE CAST(EXTRACT(EPOCH FROM now() - task_executions.started_at) * 1000000000 AS BIGINT) END) >= ?",
+				queryExpr.Query)
+			assert.Equal(t, time.Minute, queryExpr.Args)
+
+			queryExpr, _ = input.InlineFilters[7].GetGormJoinTableQueryExpr("task_executions")
+			assert.Contains(t, queryExpr.Query, "END) < ?")
+			assert.Equal(t, time.Hour, queryExpr.Args)
+			return interfaces.TaskExecutionCollectionOutput{}, nil
+		})
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
+	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId: "nodey",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "exec project",
+				Domain:  "exec domain",
+				Name:    "exec name",
+			},
+		},
+		Limit:   10,
+		Filters: "value_in(phase,RUNNING;FAILED)+gte(retry_attempt,1)+gte(duration,60)+lt(duration,1h)",
+	})
+	assert.NoError(t, err)
+
+	_, err = taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId: "nodey",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "exec project",
+				Domain:  "exec domain",
+				Name:    "exec name",
+			},
+		},
+		Limit:   10,
+		Filters: "eq(retry_attempt,last)",
+	})
+	assert.EqualError(t, err, "Failed to parse integer [last]")
+}
+
 func TestListTaskExecutions_NoFilters(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 
@@ -755,7 +993,9 @@ func TestListTaskExecutions_NoFilters(t *testing.T) {
 			listTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Token: "1",
 		Limit: 99,
@@ -771,13 +1011,51 @@ func TestListTaskExecutions_NoLimit(t *testing.T) {
 	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
 		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
 			getTaskCalled = true
+			assert.Equal(t, 100, input.Limit)
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
-		Limit: 0,
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId: "nodey",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "exec project",
+				Domain:  "exec domain",
+				Name:    "exec name",
+			},
+		},
 	})
-	assert.NotNil(t, err)
+	assert.Nil(t, err)
+	assert.True(t, getTaskCalled)
+}
+
+func TestListTaskExecutions_LimitExceedsMax(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+
+	getTaskCalled := false
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+			getTaskCalled = true
+			return interfaces.TaskExecutionCollectionOutput{}, nil
+		})
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
+	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId: "nodey",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "exec project",
+				Domain:  "exec domain",
+				Name:    "exec name",
+			},
+		},
+		Limit: 100000,
+	})
+	assert.EqualError(t, err, "invalid value for limit [100000], the maximum allowed is 10000")
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
 	assert.False(t, getTaskCalled)
 }
 
@@ -804,7 +1082,9 @@ func TestListTaskExecutions_NothingToReturn(t *testing.T) {
 			listTasksCalled = true
 			return interfaces.TaskCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			ExecutionId: &core.WorkflowExecutionIdentifier{
@@ -882,7 +1162,9 @@ func TestGetTaskExecutionData(t *testing.T) {
 
 		return admin.UrlBlob{}, errors.New("unexpected input")
 	}
-	taskExecManager := NewTaskExecutionManager(repository, mockScope.NewTestScope(), mockTaskExecutionRemoteURL)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
 	dataResponse, err := taskExecManager.GetTaskExecutionData(context.Background(), admin.TaskExecutionGetDataRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -903,3 +1185,161 @@ func TestGetTaskExecutionData(t *testing.T) {
 		},
 	}, dataResponse))
 }
+
+func TestGetTaskExecutionData_Restricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+				DataClassification: common.DataClassificationRestricted,
+			}, nil
+		})
+	addGetNodeExecutionCallback(repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{
+				TaskExecutionKey: models.TaskExecutionKey{
+					TaskKey: models.TaskKey{
+						Project: sampleTaskID.Project,
+						Domain:  sampleTaskID.Domain,
+						Name:    sampleTaskID.Name,
+						Version: sampleTaskID.Version,
+					},
+					NodeExecutionKey: models.NodeExecutionKey{
+						NodeID: sampleNodeExecID.NodeId,
+						ExecutionKey: models.ExecutionKey{
+							Project: sampleNodeExecID.ExecutionId.Project,
+							Domain:  sampleNodeExecID.ExecutionId.Domain,
+							Name:    sampleNodeExecID.ExecutionId.Name,
+						},
+					},
+					RetryAttempt: &retryAttemptValue,
+				},
+				Phase:    core.TaskExecution_SUCCEEDED.String(),
+				InputURI: "input-uri.pb",
+			}, nil
+		})
+	mockRemoteURL := dataMocks.NewMockRemoteURL()
+	mockRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		t.Fatalf("unexpected request to sign [%s]", uri)
+		return admin.UrlBlob{}, nil
+	}
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockRemoteURL,
+		mockTaskLogProvider, mockCloudEventPublisher)
+	_, err := taskExecManager.GetTaskExecutionData(context.Background(), admin.TaskExecutionGetDataRequest{
+		Id: &core.TaskExecutionIdentifier{
+			TaskId:          sampleTaskID,
+			NodeExecutionId: sampleNodeExecID,
+			RetryAttempt:    1,
+		},
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func getMockTaskExecutionRepoWithLogs(t *testing.T, logs []*core.TaskLog) repositories.RepositoryInterface {
+	repository := repositoryMocks.NewMockRepository()
+	closureBytes, err := proto.Marshal(&admin.TaskExecutionClosure{
+		Phase:     core.TaskExecution_RUNNING,
+		StartedAt: sampleTaskEventOccurredAt,
+		UpdatedAt: sampleTaskEventOccurredAt,
+		Logs:      logs,
+	})
+	assert.Nil(t, err)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{
+				TaskExecutionKey: models.TaskExecutionKey{
+					TaskKey: models.TaskKey{
+						Project: sampleTaskID.Project,
+						Domain:  sampleTaskID.Domain,
+						Name:    sampleTaskID.Name,
+						Version: sampleTaskID.Version,
+					},
+					NodeExecutionKey: models.NodeExecutionKey{
+						NodeID: sampleNodeExecID.NodeId,
+						ExecutionKey: models.ExecutionKey{
+							Project: sampleNodeExecID.ExecutionId.Project,
+							Domain:  sampleNodeExecID.ExecutionId.Domain,
+							Name:    sampleNodeExecID.ExecutionId.Name,
+						},
+					},
+					RetryAttempt: &retryAttemptValue,
+				},
+				Phase:     core.TaskExecution_RUNNING.String(),
+				StartedAt: &taskStartedAt,
+				Closure:   closureBytes,
+			}, nil
+		})
+	return repository
+}
+
+func TestGetTaskExecutionLogs(t *testing.T) {
+	repository := getMockTaskExecutionRepoWithLogs(t, []*core.TaskLog{
+		{
+			Name: "stale",
+			Uri:  "https://logs/stale",
+		},
+	})
+	logProvider, err := logImplementations.NewTemplateLogProvider([]runtimeInterfaces.TaskLogTemplate{
+		{
+			Name:          "logs",
+			URITemplate:   "https://logs/{{ .Namespace }}/{{ .ExecutionName }}/{{ .NodeID }}/{{ .RetryAttempt }}",
+			MessageFormat: "JSON",
+		},
+	})
+	assert.Nil(t, err)
+	taskExecManager := NewTaskExecutionManager(
+		repository, getMockExecutionsConfigProvider(), mockScope.NewTestScope(), mockTaskExecutionRemoteURL,
+		logProvider, mockCloudEventPublisher)
+	response, err := taskExecManager.GetTaskExecutionLogs(context.Background(),
+		managerInterfaces.TaskExecutionLogsGetRequest{
+			ID: &core.TaskExecutionIdentifier{
+				TaskId:          sampleTaskID,
+				NodeExecutionId: sampleNodeExecID,
+				RetryAttempt:    1,
+			},
+		})
+	assert.Nil(t, err)
+	assert.Len(t, response.Logs, 1)
+	assert.True(t, proto.Equal(&core.TaskLog{
+		Name:          "logs",
+		Uri:           "https://logs/project-domain/name/node-id/1",
+		MessageFormat: core.TaskLog_JSON,
+	}, response.Logs[0]))
+}
+
+func TestGetTaskExecutionLogs_EventLogs(t *testing.T) {
+	eventLog := &core.TaskLog{
+		Name: "event",
+		Uri:  "https://logs/event",
+	}
+	taskExecManager := NewTaskExecutionManager(
+		getMockTaskExecutionRepoWithLogs(t, []*core.TaskLog{eventLog}), getMockExecutionsConfigProvider(),
+		mockScope.NewTestScope(), mockTaskExecutionRemoteURL, mockTaskLogProvider, mockCloudEventPublisher)
+	response, err := taskExecManager.GetTaskExecutionLogs(context.Background(),
+		managerInterfaces.TaskExecutionLogsGetRequest{
+			ID: &core.TaskExecutionIdentifier{
+				TaskId:          sampleTaskID,
+				NodeExecutionId: sampleNodeExecID,
+				RetryAttempt:    1,
+			},
+		})
+	assert.Nil(t, err)
+	assert.Len(t, response.Logs, 1)
+	assert.True(t, proto.Equal(eventLog, response.Logs[0]))
+}
+
+func TestGetTaskExecutionLogs_InvalidID(t *testing.T) {
+	taskExecManager := NewTaskExecutionManager(
+		repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), mockScope.NewTestScope(),
+		mockTaskExecutionRemoteURL, mockTaskLogProvider, mockCloudEventPublisher)
+	_, err := taskExecManager.GetTaskExecutionLogs(context.Background(),
+		managerInterfaces.TaskExecutionLogsGetRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -17,15 +18,19 @@ import (
 
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	taskTypeInterfaces "github.com/lyft/flyteadmin/pkg/tasktype/interfaces"
 	workflowengine "github.com/lyft/flyteadmin/pkg/workflowengine/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"google.golang.org/grpc/codes"
 )
 
@@ -36,10 +41,11 @@ type taskMetrics struct {
 }
 
 type TaskManager struct {
-	db       repositories.RepositoryInterface
-	config   runtimeInterfaces.Configuration
-	compiler workflowengine.Compiler
-	metrics  taskMetrics
+	db               repositories.RepositoryInterface
+	config           runtimeInterfaces.Configuration
+	compiler         workflowengine.Compiler
+	taskTypeRegistry taskTypeInterfaces.Registry
+	metrics          taskMetrics
 }
 
 func setDefaults(request admin.TaskCreateRequest) (admin.TaskCreateRequest, error) {
@@ -52,22 +58,90 @@ func setDefaults(request admin.TaskCreateRequest) (admin.TaskCreateRequest, erro
 	return request, nil
 }
 
-func (t *TaskManager) CreateTask(
-	ctx context.Context,
-	request admin.TaskCreateRequest) (*admin.TaskCreateResponse, error) {
-	if err := validation.ValidateTask(ctx, request, t.db, t.config.TaskResourceConfiguration(),
+// Archived task versions are left out of listings unless the filters select versions by state.
+func addActiveTaskFilter(filters []common.InlineFilter) ([]common.InlineFilter, error) {
+	for _, filter := range filters {
+		expr, err := filter.GetGormQueryExpr()
+		if err != nil {
+			return nil, err
+		}
+		if filter.GetEntity() == common.Task && strings.HasPrefix(expr.Query, shared.State+" ") {
+			return filters, nil
+		}
+	}
+	activeFilter, err := common.NewSingleValueFilter(
+		common.Task, common.Equal, shared.State, int32(interfaces.TaskStateActive))
+	if err != nil {
+		return nil, err
+	}
+	return append(filters, activeFilter), nil
+}
+
+// Validates a task create request and compiles the task it registers.
+func (t *TaskManager) compileTask(ctx context.Context, request admin.TaskCreateRequest) (
+	admin.TaskCreateRequest, *core.CompiledTask, error) {
+	taskResourceConfig, err := util.GetTaskResourceConfiguration(ctx, t.db, t.config.TaskResourceConfiguration(),
+		request.GetId().GetProject(), request.GetId().GetDomain(), "")
+	if err != nil {
+		return admin.TaskCreateRequest{}, nil, err
+	}
+	if err := validation.ValidateTask(ctx, request, t.db, taskResourceConfig,
 		t.config.WhitelistConfiguration(), t.config.ApplicationConfiguration()); err != nil {
 		logger.Debugf(ctx, "Task [%+v] failed validation with err: %v", request.Id, err)
-		return nil, err
+		return admin.TaskCreateRequest{}, nil, err
 	}
 	finalizedRequest, err := setDefaults(request)
 	if err != nil {
-		return nil, err
+		return admin.TaskCreateRequest{}, nil, err
+	}
+	template := finalizedRequest.Spec.Template
+	for _, plugin := range t.taskTypeRegistry.GetPlugins(template.Type) {
+		if err := plugin.Validate(ctx, template); err != nil {
+			logger.Debugf(ctx, "Task [%+v] failed validation for type [%s] with err: %v", request.Id, template.Type, err)
+			return admin.TaskCreateRequest{}, nil, err
+		}
+		if err := plugin.SetDefaults(ctx, template); err != nil {
+			logger.Debugf(ctx, "Failed to set defaults for task [%+v] of type [%s] with err: %v",
+				request.Id, template.Type, err)
+			return admin.TaskCreateRequest{}, nil, err
+		}
+	}
+	projectDomainAttributes, err := util.GetProjectDomainAttributes(
+		ctx, t.db, request.GetId().GetProject(), request.GetId().GetDomain())
+	if err != nil {
+		return admin.TaskCreateRequest{}, nil, err
+	}
+	allowedRegistries, err := validation.GetAllowedImageRegistries(projectDomainAttributes)
+	if err != nil {
+		return admin.TaskCreateRequest{}, nil, err
+	}
+	if err := validation.ValidateTaskImage(template, allowedRegistries); err != nil {
+		logger.Debugf(ctx, "Task [%+v] failed image validation with err: %v", request.Id, err)
+		return admin.TaskCreateRequest{}, nil, err
 	}
-	// Compile task and store the compiled version in the database.
 	compiledTask, err := t.compiler.CompileTask(finalizedRequest.Spec.Template)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to compile task with id [%+v] with err %v", request.Id, err)
+		return admin.TaskCreateRequest{}, nil, err
+	}
+	return finalizedRequest, compiledTask, nil
+}
+
+// Returns the fields of a compiled task which differ from those of the registered task model.
+func diffTaskModel(existingTask models.Task, compiledTask *core.CompiledTask) ([]interfaces.FieldDiff, error) {
+	existing, err := transformers.FromTaskModel(existingTask)
+	if err != nil {
+		return nil, err
+	}
+	return util.DiffCompiledTasks(existing.Closure.GetCompiledTask(), compiledTask)
+}
+
+func (t *TaskManager) CreateTask(
+	ctx context.Context,
+	request admin.TaskCreateRequest) (*admin.TaskCreateResponse, error) {
+	// Compile task and store the compiled version in the database.
+	finalizedRequest, compiledTask, err := t.compileTask(ctx, request)
+	if err != nil {
 		return nil, err
 	}
 	createdAt, err := ptypes.TimestampProto(time.Now())
@@ -84,11 +158,22 @@ func (t *TaskManager) CreateTask(
 	existingTask, err := util.GetTaskModel(ctx, t.db, request.Spec.Template.Id)
 	if err == nil {
 		if bytes.Equal(taskDigest, existingTask.Digest) {
+			if t.config.ApplicationConfiguration().GetTopLevelConfig().IdempotentRegistration {
+				logger.Debugf(ctx, "identical task already exists with id [%+v]", request.Id)
+				return &admin.TaskCreateResponse{}, nil
+			}
 			return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
 				"identical task already exists with id %s", request.Id)
 		}
+		diffs, err := diffTaskModel(*existingTask, compiledTask)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to diff task [%+v] against the registered task with err %v", request.Id, err)
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"task with different structure already exists with id %v", request.Id)
+		}
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
-			"task with different structure already exists with id %v", request.Id)
+			"task with different structure already exists with id %v, differing fields: %s", request.Id,
+			strings.Join(util.GetFieldDiffPaths(diffs), ", "))
 	}
 	taskModel, err := transformers.CreateTaskModel(finalizedRequest, admin.TaskClosure{
 		CompiledTask: compiledTask,
@@ -133,6 +218,12 @@ func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListR
 		logger.Debugf(ctx, "Invalid request [%+v]: %v", request, err)
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, t.config.ApplicationConfiguration(), validation.TaskListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 	spec := util.FilterSpec{
 		Project:        request.Id.Project,
 		Domain:         request.Id.Domain,
@@ -144,6 +235,10 @@ func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListR
 	if err != nil {
 		return nil, err
 	}
+	filters, err = addActiveTaskFilter(filters)
+	if err != nil {
+		return nil, err
+	}
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
 		sortParameter, err = common.NewSortParameter(*request.SortBy)
@@ -193,6 +288,12 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request adm
 		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, t.config.ApplicationConfiguration(), validation.TaskListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project: request.Project,
 		Domain:  request.Domain,
@@ -200,6 +301,10 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request adm
 	if err != nil {
 		return nil, err
 	}
+	filters, err = addActiveTaskFilter(filters)
+	if err != nil {
+		return nil, err
+	}
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
 		sortParameter, err = common.NewSortParameter(*request.SortBy)
@@ -237,19 +342,66 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request adm
 	}, nil
 }
 
+// Archives or restores a task version, or all versions of a task when no version is specified. Versions registered
+// after a task is archived are active.
+func (t *TaskManager) UpdateTaskState(ctx context.Context, request interfaces.TaskStateUpdateRequest) (
+	*interfaces.TaskStateUpdateResponse, error) {
+	if err := validation.ValidateTaskStateUpdateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	err := t.db.TaskRepo().UpdateState(ctx, repoInterfaces.GetResourceInput{
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
+		Name:    request.ID.Name,
+		Version: request.ID.Version,
+	}, int32(request.State))
+	if err != nil {
+		logger.Debugf(ctx, "Failed to update the state of task [%+v] with err %v", request.ID, err)
+		return nil, err
+	}
+	return &interfaces.TaskStateUpdateResponse{}, nil
+}
+
+// Compares the task a create request compiles to against the task registered with the same identifier, so users can
+// see why registering it is rejected.
+func (t *TaskManager) DiffTask(ctx context.Context, request admin.TaskCreateRequest) (
+	*interfaces.TaskDiffResponse, error) {
+	_, compiledTask, err := t.compileTask(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	existingTask, err := util.GetTaskModel(ctx, t.db, request.Id)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get task with id [%+v] with err %v", request.Id, err)
+		return nil, err
+	}
+	diffs, err := diffTaskModel(*existingTask, compiledTask)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to diff task [%+v] against the registered task with err %v", request.Id, err)
+		return nil, err
+	}
+	return &interfaces.TaskDiffResponse{
+		ID:        request.Id,
+		Identical: len(diffs) == 0,
+		Diffs:     diffs,
+	}, nil
+}
+
 func NewTaskManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration, compiler workflowengine.Compiler,
-	scope promutils.Scope) interfaces.TaskInterface {
+	taskTypeRegistry taskTypeInterfaces.Registry, scope promutils.Scope) interfaces.TaskInterface {
 	metrics := taskMetrics{
 		Scope:            scope,
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes", "size in bytes of serialized task closure"),
 		Registered:       labeled.NewCounter("num_registered", "count of registered tasks", scope),
 	}
 	return &TaskManager{
-		db:       db,
-		config:   config,
-		compiler: compiler,
-		metrics:  metrics,
+		db:               db,
+		config:           config,
+		compiler:         compiler,
+		taskTypeRegistry: taskTypeRegistry,
+		metrics:          metrics,
 	}
 }
@@ -12,11 +12,14 @@ import (
 	"github.com/lyft/flyteadmin/pkg/common"
 	adminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+	"github.com/lyft/flyteadmin/pkg/tasktype"
 	"github.com/lyft/flytestdlib/promutils/labeled"
 
 	workflowengine "github.com/lyft/flyteadmin/pkg/workflowengine/interfaces"
@@ -83,7 +86,7 @@ func TestCreateTask(t *testing.T) {
 		return nil
 	})
 	taskManager := NewTaskManager(mockRepository, getMockConfigForTaskTest(), getMockTaskCompiler(),
-		mockScope.NewTestScope())
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	request := testutils.GetValidTaskRequest()
 	response, err := taskManager.CreateTask(context.Background(), request)
 	assert.NoError(t, err)
@@ -93,10 +96,43 @@ func TestCreateTask(t *testing.T) {
 	assert.True(t, createCalled)
 }
 
+func TestCreateTask_Identical(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	var registeredTask *models.Task
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			if registeredTask == nil {
+				return models.Task{}, adminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+			}
+			return *registeredTask, nil
+		})
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetCreateCallback(func(input models.Task) error {
+		assert.Nil(t, registeredTask)
+		registeredTask = &input
+		return nil
+	})
+	mockConfig := getMockConfigForTaskTest()
+	taskManager := NewTaskManager(mockRepository, mockConfig, getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	_, err := taskManager.CreateTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.NoError(t, err)
+
+	_, err = taskManager.CreateTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.Equal(t, codes.AlreadyExists, err.(adminErrors.FlyteAdminError).Code())
+
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			IdempotentRegistration: true,
+		})
+	response, err := taskManager.CreateTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, &admin.TaskCreateResponse{}, response)
+}
+
 func TestCreateTask_ValidationError(t *testing.T) {
 	mockRepository := getMockTaskRepository()
 	taskManager := NewTaskManager(mockRepository, getMockConfigForTaskTest(), getMockTaskCompiler(),
-		mockScope.NewTestScope())
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	request := testutils.GetValidTaskRequest()
 	request.Id = nil
 	response, err := taskManager.CreateTask(context.Background(), request)
@@ -104,6 +140,48 @@ func TestCreateTask_ValidationError(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestCreateTask_ImageNotAllowed(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	projectDomainModel, _ := transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: map[string]string{
+			"security.allowed_image_registries": "docker.io/lyft",
+		},
+	})
+	mockRepository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		assert.Equal(t, "project", project)
+		assert.Equal(t, "domain", domain)
+		return projectDomainModel, nil
+	}
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetCreateCallback(func(input models.Task) error {
+		assert.Fail(t, "task with a disallowed image shouldn't be created")
+		return nil
+	})
+	taskManager := NewTaskManager(mockRepository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	response, err := taskManager.CreateTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.Nil(t, response)
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "image image")
+}
+
+func TestCreateTask_TaskTypePluginError(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	taskTypeRegistry := tasktype.NewRegistry(map[string]runtimeInterfaces.TaskTypeConfig{
+		"type": {
+			RequiredCustomFields: []string{"mainApplicationFile"},
+		},
+	})
+	taskManager := NewTaskManager(mockRepository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		taskTypeRegistry, mockScope.NewTestScope())
+	response, err := taskManager.CreateTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.EqualError(t, err, "tasks of type [type] must set the custom field [mainApplicationFile]")
+	assert.Nil(t, response)
+}
+
 func TestCreateTask_CompilerError(t *testing.T) {
 	mockCompiler := workflowMocks.NewMockCompiler()
 	expectedErr := errors.New("expected error")
@@ -113,7 +191,7 @@ func TestCreateTask_CompilerError(t *testing.T) {
 		})
 	mockRepository := getMockTaskRepository()
 	taskManager := NewTaskManager(mockRepository, getMockConfigForTaskTest(), mockCompiler,
-		mockScope.NewTestScope())
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	request := testutils.GetValidTaskRequest()
 	response, err := taskManager.CreateTask(context.Background(), request)
 	assert.EqualError(t, err, expectedErr.Error())
@@ -132,13 +210,89 @@ func TestCreateTask_DatabaseError(t *testing.T) {
 	}
 
 	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetCreateCallback(taskCreateFunc)
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	request := testutils.GetValidTaskRequest()
 	response, err := taskManager.CreateTask(context.Background(), request)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, response)
 }
 
+func getRegisteredTaskModel(image string) models.Task {
+	request := testutils.GetValidTaskRequest()
+	request.Spec.Template.Id = request.Id
+	request.Spec.Template.GetContainer().Image = image
+	closure, _ := proto.Marshal(&admin.TaskClosure{
+		CompiledTask: &core.CompiledTask{
+			Template: request.Spec.Template,
+		},
+	})
+	return models.Task{
+		TaskKey: models.TaskKey{
+			Project: request.Id.Project,
+			Domain:  request.Id.Domain,
+			Name:    request.Id.Name,
+			Version: request.Id.Version,
+		},
+		Closure: closure,
+		Digest:  []byte("digest"),
+	}
+}
+
+func TestCreateTask_DifferentStructure(t *testing.T) {
+	repository := getMockTaskRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			return getRegisteredTaskModel("other image"), nil
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	_, err := taskManager.CreateTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "differing fields: template.container.image")
+}
+
+func TestDiffTask(t *testing.T) {
+	repository := getMockTaskRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			return getRegisteredTaskModel("other image"), nil
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	response, err := taskManager.DiffTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.NoError(t, err)
+	assert.False(t, response.Identical)
+	assert.Equal(t, []managerInterfaces.FieldDiff{
+		{
+			Path:     "template.container.image",
+			Existing: "other image",
+			Incoming: "image",
+		},
+	}, response.Diffs)
+
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			return getRegisteredTaskModel("image"), nil
+		})
+	response, err = taskManager.DiffTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.NoError(t, err)
+	assert.True(t, response.Identical)
+	assert.Empty(t, response.Diffs)
+}
+
+func TestDiffTask_NotRegistered(t *testing.T) {
+	repository := getMockTaskRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			return models.Task{}, adminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	_, err := taskManager.DiffTask(context.Background(), testutils.GetValidTaskRequest())
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
 func TestGetTask(t *testing.T) {
 	repository := getMockTaskRepository()
 	taskGetFunc := func(input interfaces.GetResourceInput) (models.Task, error) {
@@ -160,7 +314,8 @@ func TestGetTask(t *testing.T) {
 		}, nil
 	}
 	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(taskGetFunc)
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 
 	task, err := taskManager.GetTask(context.Background(), admin.ObjectGetRequest{
 		Id: &taskIdentifier,
@@ -180,7 +335,8 @@ func TestGetTask_DatabaseError(t *testing.T) {
 		return models.Task{}, expectedErr
 	}
 	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(taskGetFunc)
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	task, err := taskManager.GetTask(context.Background(), admin.ObjectGetRequest{
 		Id: &taskIdentifier,
 	})
@@ -206,7 +362,8 @@ func TestGetTask_TransformerError(t *testing.T) {
 		}, nil
 	}
 	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(taskGetFunc)
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 
 	task, err := taskManager.GetTask(context.Background(), admin.ObjectGetRequest{
 		Id: &taskIdentifier,
@@ -218,7 +375,7 @@ func TestGetTask_TransformerError(t *testing.T) {
 func TestListTasks(t *testing.T) {
 	repository := getMockTaskRepository()
 	taskListFunc := func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
-		var projectFilter, domainFilter, nameFilter bool
+		var projectFilter, domainFilter, nameFilter, stateFilter bool
 		for _, filter := range input.InlineFilters {
 			assert.Equal(t, common.Task, filter.GetEntity())
 			queryExpr, _ := filter.GetGormQueryExpr()
@@ -231,10 +388,14 @@ func TestListTasks(t *testing.T) {
 			if queryExpr.Args == nameValue && queryExpr.Query == testutils.NameQueryPattern {
 				nameFilter = true
 			}
+			if queryExpr.Args == int32(managerInterfaces.TaskStateActive) && queryExpr.Query == "state = ?" {
+				stateFilter = true
+			}
 		}
 		assert.True(t, projectFilter, "Missing project equality filter")
 		assert.True(t, domainFilter, "Missing domain equality filter")
 		assert.True(t, nameFilter, "Missing name equality filter")
+		assert.True(t, stateFilter, "Missing state equality filter")
 		assert.Equal(t, 2, input.Limit)
 		assert.Equal(t, "domain asc", input.SortParameter.GetGormOrderExpr())
 		return interfaces.TaskCollectionOutput{
@@ -265,7 +426,8 @@ func TestListTasks(t *testing.T) {
 		}, nil
 	}
 	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetListCallback(taskListFunc)
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 
 	taskList, err := taskManager.ListTasks(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -295,9 +457,42 @@ func TestListTasks(t *testing.T) {
 	assert.Equal(t, "2", taskList.Token)
 }
 
+func TestListTasks_StateFilter(t *testing.T) {
+	repository := getMockTaskRepository()
+	var listCalled bool
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
+			listCalled = true
+			var stateFilters int
+			for _, filter := range input.InlineFilters {
+				queryExpr, _ := filter.GetGormQueryExpr()
+				if queryExpr.Query == "state = ?" {
+					stateFilters++
+					assert.Equal(t, int64(managerInterfaces.TaskStateArchived), queryExpr.Args)
+				}
+			}
+			assert.Equal(t, 1, stateFilters)
+			return interfaces.TaskCollectionOutput{}, nil
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	_, err := taskManager.ListTasks(context.Background(), admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: projectValue,
+			Domain:  domainValue,
+			Name:    nameValue,
+		},
+		Limit:   limit,
+		Filters: "eq(state,1)",
+	})
+	assert.NoError(t, err)
+	assert.True(t, listCalled)
+}
+
 func TestListTasks_MissingParameters(t *testing.T) {
 	repository := getMockTaskRepository()
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	_, err := taskManager.ListTasks(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
 			Domain: domainValue,
@@ -327,7 +522,8 @@ func TestListTasks_DatabaseError(t *testing.T) {
 	}
 
 	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetListCallback(taskListFunc)
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 	_, err := taskManager.ListTasks(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
 			Project: projectValue,
@@ -341,21 +537,26 @@ func TestListTasks_DatabaseError(t *testing.T) {
 
 func TestListUniqueTaskIdentifiers(t *testing.T) {
 	repository := getMockTaskRepository()
-	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
 
 	listFunc := func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
 		// Test that parameters are being passed in
 		assert.Equal(t, 100, input.Limit)
-		assert.Len(t, input.InlineFilters, 2)
+		assert.Len(t, input.InlineFilters, 3)
 		for idx, filter := range input.InlineFilters {
 			assert.Equal(t, common.Task, filter.GetEntity())
 			query, _ := filter.GetGormQueryExpr()
-			if idx == 0 {
+			switch idx {
+			case 0:
 				assert.Equal(t, testutils.ProjectQueryPattern, query.Query)
 				assert.Equal(t, "foo", query.Args)
-			} else {
+			case 1:
 				assert.Equal(t, testutils.DomainQueryPattern, query.Query)
 				assert.Equal(t, "bar", query.Args)
+			default:
+				assert.Equal(t, "state = ?", query.Query)
+				assert.Equal(t, int32(managerInterfaces.TaskStateActive), query.Args)
 			}
 		}
 		assert.Equal(t, 10, input.Offset)
@@ -398,3 +599,47 @@ func TestListUniqueTaskIdentifiers(t *testing.T) {
 	assert.Equal(t, 2, len(resp.Entities))
 	assert.Empty(t, resp.Token)
 }
+
+func TestUpdateTaskState(t *testing.T) {
+	repository := getMockTaskRepository()
+	var updateCalled bool
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetUpdateStateCallback(
+		func(input interfaces.GetResourceInput, state int32) error {
+			updateCalled = true
+			assert.Equal(t, interfaces.GetResourceInput{
+				Project: projectValue,
+				Domain:  domainValue,
+				Name:    nameValue,
+			}, input)
+			assert.Equal(t, int32(managerInterfaces.TaskStateArchived), state)
+			return nil
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	_, err := taskManager.UpdateTaskState(context.Background(), managerInterfaces.TaskStateUpdateRequest{
+		ID: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      projectValue,
+			Domain:       domainValue,
+			Name:         nameValue,
+		},
+		State: managerInterfaces.TaskStateArchived,
+	})
+	assert.NoError(t, err)
+	assert.True(t, updateCalled)
+}
+
+func TestUpdateTaskState_NotFound(t *testing.T) {
+	repository := getMockTaskRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetUpdateStateCallback(
+		func(input interfaces.GetResourceInput, state int32) error {
+			return adminErrors.NewFlyteAdminError(codes.NotFound, "foo")
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(),
+		tasktype.NewRegistry(nil), mockScope.NewTestScope())
+	_, err := taskManager.UpdateTaskState(context.Background(), managerInterfaces.TaskStateUpdateRequest{
+		ID:    &taskIdentifier,
+		State: managerInterfaces.TaskStateArchived,
+	})
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Verifies the caller may be handed (signed URLs for) the inputs and outputs of an execution and its node executions.
+// The data of restricted executions is only available to the configured readers, and so never to unauthenticated
+// callers.
+func ValidateDataAccess(
+	ctx context.Context, executionModel models.Execution, config runtimeInterfaces.ApplicationConfiguration) error {
+	if executionModel.DataClassification != common.DataClassificationRestricted {
+		return nil
+	}
+	if identity := auth.GetUserEmail(ctx); identity != "" {
+		for _, reader := range config.GetTopLevelConfig().RestrictedDataReaders {
+			if reader == identity {
+				return nil
+			}
+		}
+	}
+	return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+		"data for restricted execution [%s/%s/%s] is only available to authorized readers",
+		executionModel.Project, executionModel.Domain, executionModel.Name)
+}
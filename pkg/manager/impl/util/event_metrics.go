@@ -0,0 +1,54 @@
+package util
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Event types used to label event ingestion metrics.
+const (
+	WorkflowEventType = "workflow"
+	NodeEventType     = "node"
+	TaskEventType     = "task"
+)
+
+// Breaks down the end-to-end latency of recording an execution event so that delays in the event producer (e.g.
+// propeller) can be told apart from time spent processing the event within admin.
+type EventMetrics struct {
+	// Seconds elapsed between an event occurring and admin receiving it, labeled by event type and phase.
+	IngestionDelay *prometheus.HistogramVec
+	// Time spent reading the existing records an event applies to.
+	LookupDuration promutils.StopWatch
+	// Time spent persisting the event and the records it updates.
+	WriteDuration promutils.StopWatch
+}
+
+// Records the delay between when an event occurred and when admin received it. Producer clocks may run ahead of
+// admin's, in which case the event is recorded as having been received without delay.
+func (m EventMetrics) RecordIngestionDelay(
+	eventType, phase string, occurredAt *timestamp.Timestamp, receivedAt time.Time) {
+	occurredAtTime, err := ptypes.Timestamp(occurredAt)
+	if err != nil {
+		return
+	}
+	delay := receivedAt.Sub(occurredAtTime)
+	if delay < 0 {
+		delay = 0
+	}
+	m.IngestionDelay.WithLabelValues(eventType, phase).Observe(delay.Seconds())
+}
+
+func NewEventMetrics(scope promutils.Scope) EventMetrics {
+	return EventMetrics{
+		IngestionDelay: scope.MustNewHistogramVec("event_ingestion_delay",
+			"delay in seconds between an event occurring and admin receiving it", "event_type", "phase"),
+		LookupDuration: scope.MustNewStopWatch("event_lookup_duration",
+			"time taken to read the existing records an event applies to", time.Millisecond),
+		WriteDuration: scope.MustNewStopWatch("event_write_duration",
+			"time taken to persist an event and the records it updates", time.Millisecond),
+	}
+}
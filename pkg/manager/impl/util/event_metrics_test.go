@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func getIngestionDelaySample(t *testing.T, metrics EventMetrics, eventType, phase string) *dto.Histogram {
+	var metric dto.Metric
+	assert.NoError(t, metrics.IngestionDelay.WithLabelValues(eventType, phase).(prometheus.Histogram).Write(&metric))
+	return metric.Histogram
+}
+
+func TestRecordIngestionDelay(t *testing.T) {
+	metrics := NewEventMetrics(mockScope.NewTestScope())
+	receivedAt := time.Date(2019, time.November, 16, 12, 0, 0, 0, time.UTC)
+	occurredAt, _ := ptypes.TimestampProto(receivedAt.Add(-2 * time.Second))
+
+	metrics.RecordIngestionDelay(NodeEventType, "RUNNING", occurredAt, receivedAt)
+	histogram := getIngestionDelaySample(t, metrics, NodeEventType, "RUNNING")
+	assert.EqualValues(t, 1, histogram.GetSampleCount())
+	assert.Equal(t, float64(2), histogram.GetSampleSum())
+	assert.EqualValues(t, 0, getIngestionDelaySample(t, metrics, TaskEventType, "RUNNING").GetSampleCount())
+}
+
+func TestRecordIngestionDelay_ClockSkew(t *testing.T) {
+	metrics := NewEventMetrics(mockScope.NewTestScope())
+	receivedAt := time.Date(2019, time.November, 16, 12, 0, 0, 0, time.UTC)
+	occurredAt, _ := ptypes.TimestampProto(receivedAt.Add(time.Second))
+
+	metrics.RecordIngestionDelay(WorkflowEventType, "SUCCEEDED", occurredAt, receivedAt)
+	histogram := getIngestionDelaySample(t, metrics, WorkflowEventType, "SUCCEEDED")
+	assert.EqualValues(t, 1, histogram.GetSampleCount())
+	assert.Equal(t, float64(0), histogram.GetSampleSum())
+}
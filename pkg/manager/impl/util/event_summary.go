@@ -0,0 +1,154 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+// The phase and occurrence time of an event preserved by an execution event summary.
+type SummarizedEvent struct {
+	Phase      string    `json:"phase"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+type NodeExecutionEventSummary struct {
+	NodeID string            `json:"nodeId"`
+	Events []SummarizedEvent `json:"events"`
+}
+
+// Compacted events are summarized by those which execution metrics are computed from: the first event to report each
+// phase and the latest event, for the execution and each of its node executions, in the order they occurred.
+type ExecutionEventSummary struct {
+	Events         []SummarizedEvent           `json:"events"`
+	NodeExecutions []NodeExecutionEventSummary `json:"nodeExecutions"`
+}
+
+type summarizedEvents struct {
+	events []SummarizedEvent
+	phases map[string]bool
+	// Whether the last event is only preserved for being the latest.
+	latestOnly bool
+}
+
+func (s *summarizedEvents) add(phase string, occurredAt time.Time) {
+	event := SummarizedEvent{
+		Phase:      phase,
+		OccurredAt: occurredAt,
+	}
+	switch {
+	case !s.phases[phase]:
+		s.phases[phase] = true
+		s.events = append(s.events, event)
+		s.latestOnly = false
+	case s.latestOnly:
+		s.events[len(s.events)-1] = event
+	default:
+		s.events = append(s.events, event)
+		s.latestOnly = true
+	}
+}
+
+func newSummarizedEvents() *summarizedEvents {
+	return &summarizedEvents{
+		events: make([]SummarizedEvent, 0),
+		phases: make(map[string]bool),
+	}
+}
+
+// Builds the summary of an execution's events. Events must be added in the order they occurred.
+type ExecutionEventSummaryBuilder struct {
+	events         *summarizedEvents
+	nodeIDs        []string
+	nodeExecutions map[string]*summarizedEvents
+	eventCount     int
+}
+
+func (b *ExecutionEventSummaryBuilder) AddExecutionEvent(event models.ExecutionEvent) {
+	b.events.add(event.Phase, event.OccurredAt)
+	b.eventCount++
+}
+
+func (b *ExecutionEventSummaryBuilder) AddNodeExecutionEvent(event models.NodeExecutionEvent) {
+	events, ok := b.nodeExecutions[event.NodeID]
+	if !ok {
+		events = newSummarizedEvents()
+		b.nodeExecutions[event.NodeID] = events
+		b.nodeIDs = append(b.nodeIDs, event.NodeID)
+	}
+	events.add(event.Phase, event.OccurredAt)
+	b.eventCount++
+}
+
+// Returns how many events were added.
+func (b *ExecutionEventSummaryBuilder) EventCount() int {
+	return b.eventCount
+}
+
+func (b *ExecutionEventSummaryBuilder) Build() ExecutionEventSummary {
+	nodeExecutions := make([]NodeExecutionEventSummary, len(b.nodeIDs))
+	for idx, nodeID := range b.nodeIDs {
+		nodeExecutions[idx] = NodeExecutionEventSummary{
+			NodeID: nodeID,
+			Events: b.nodeExecutions[nodeID].events,
+		}
+	}
+	return ExecutionEventSummary{
+		Events:         b.events.events,
+		NodeExecutions: nodeExecutions,
+	}
+}
+
+func NewExecutionEventSummaryBuilder() *ExecutionEventSummaryBuilder {
+	return &ExecutionEventSummaryBuilder{
+		events:         newSummarizedEvents(),
+		nodeIDs:        make([]string, 0),
+		nodeExecutions: make(map[string]*summarizedEvents),
+	}
+}
+
+// Returns the node execution events preserved by the summary, in the order they occurred for each node execution.
+func (s ExecutionEventSummary) GetNodeExecutionEvents(key models.ExecutionKey) []models.NodeExecutionEvent {
+	nodeExecutionEvents := make([]models.NodeExecutionEvent, 0)
+	for _, nodeExecution := range s.NodeExecutions {
+		for _, event := range nodeExecution.Events {
+			nodeExecutionEvents = append(nodeExecutionEvents, models.NodeExecutionEvent{
+				NodeExecutionKey: models.NodeExecutionKey{
+					ExecutionKey: key,
+					NodeID:       nodeExecution.NodeID,
+				},
+				Phase:      event.Phase,
+				OccurredAt: event.OccurredAt,
+			})
+		}
+	}
+	return nodeExecutionEvents
+}
+
+// Returns the summary of the execution's compacted events, nil if its events weren't compacted.
+func GetExecutionEventSummary(
+	ctx context.Context, repo repositories.RepositoryInterface, key models.ExecutionKey) (
+	*ExecutionEventSummary, error) {
+	summaryModel, err := repo.ExecutionRepo().GetEventSummary(ctx, key)
+	if err != nil {
+		if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(summaryModel.Summary) == 0 {
+		return nil, nil
+	}
+	var summary ExecutionEventSummary
+	if err := json.Unmarshal(summaryModel.Summary, &summary); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal event summary of execution [%+v] with err: %v", key, err)
+	}
+	return &summary, nil
+}
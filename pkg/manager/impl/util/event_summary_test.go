@@ -0,0 +1,108 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+func TestExecutionEventSummaryBuilder(t *testing.T) {
+	start := time.Date(2019, 11, 28, 10, 0, 0, 0, time.UTC)
+	builder := NewExecutionEventSummaryBuilder()
+	for idx, phase := range []string{"QUEUED", "RUNNING", "RUNNING", "RUNNING", "SUCCEEDED"} {
+		builder.AddExecutionEvent(models.ExecutionEvent{
+			Phase:      phase,
+			OccurredAt: start.Add(time.Duration(idx) * time.Second),
+		})
+	}
+	for idx, phase := range []string{"QUEUED", "QUEUED", "RUNNING"} {
+		builder.AddNodeExecutionEvent(models.NodeExecutionEvent{
+			NodeExecutionKey: models.NodeExecutionKey{NodeID: "node"},
+			Phase:            phase,
+			OccurredAt:       start.Add(time.Duration(idx) * time.Second),
+		})
+	}
+	assert.Equal(t, 8, builder.EventCount())
+
+	summary := builder.Build()
+	// Repeated events are only kept if they're the latest.
+	assert.Equal(t, []SummarizedEvent{
+		{Phase: "QUEUED", OccurredAt: start},
+		{Phase: "RUNNING", OccurredAt: start.Add(time.Second)},
+		{Phase: "SUCCEEDED", OccurredAt: start.Add(4 * time.Second)},
+	}, summary.Events)
+	assert.Equal(t, []NodeExecutionEventSummary{
+		{
+			NodeID: "node",
+			Events: []SummarizedEvent{
+				{Phase: "QUEUED", OccurredAt: start},
+				{Phase: "RUNNING", OccurredAt: start.Add(2 * time.Second)},
+			},
+		},
+	}, summary.NodeExecutions)
+
+	key := models.ExecutionKey{Project: project, Domain: domain, Name: name}
+	events := summary.GetNodeExecutionEvents(key)
+	assert.Len(t, events, 2)
+	assert.Equal(t, key, events[0].ExecutionKey)
+	assert.Equal(t, "node", events[0].NodeID)
+	assert.Equal(t, "QUEUED", events[0].Phase)
+}
+
+func TestExecutionEventSummaryBuilder_LatestEvent(t *testing.T) {
+	start := time.Date(2019, 11, 28, 10, 0, 0, 0, time.UTC)
+	builder := NewExecutionEventSummaryBuilder()
+	for idx, phase := range []string{"RUNNING", "RUNNING", "RUNNING"} {
+		builder.AddExecutionEvent(models.ExecutionEvent{
+			Phase:      phase,
+			OccurredAt: start.Add(time.Duration(idx) * time.Second),
+		})
+	}
+	assert.Equal(t, []SummarizedEvent{
+		{Phase: "RUNNING", OccurredAt: start},
+		{Phase: "RUNNING", OccurredAt: start.Add(2 * time.Second)},
+	}, builder.Build().Events)
+}
+
+func TestGetExecutionEventSummary(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetEventSummaryCallback(
+		func(ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+			return models.ExecutionEventSummary{
+				ExecutionKey: key,
+				Summary:      []byte(`{"events":[{"phase":"SUCCEEDED"}]}`),
+			}, nil
+		})
+	summary, err := GetExecutionEventSummary(context.Background(), repository, models.ExecutionKey{Name: name})
+	assert.NoError(t, err)
+	assert.Len(t, summary.Events, 1)
+	assert.Equal(t, "SUCCEEDED", summary.Events[0].Phase)
+}
+
+func TestGetExecutionEventSummary_NotCompacted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetEventSummaryCallback(
+		func(ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+			return models.ExecutionEventSummary{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "missing")
+		})
+	summary, err := GetExecutionEventSummary(context.Background(), repository, models.ExecutionKey{Name: name})
+	assert.NoError(t, err)
+	assert.Nil(t, summary)
+}
+
+func TestGetExecutionEventSummary_Error(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetEventSummaryCallback(
+		func(ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+			return models.ExecutionEventSummary{}, errExpected
+		})
+	_, err := GetExecutionEventSummary(context.Background(), repository, models.ExecutionKey{Name: name})
+	assert.Equal(t, errExpected, err)
+}
@@ -48,6 +48,11 @@ var durationFields = map[string]bool{
 	"duration": true,
 }
 
+var integerFields = map[string]bool{
+	"retry_attempt": true,
+	"state":         true,
+}
+
 const filterFieldEntityPrefixFmt = "%s."
 const secondsFormat = "%vs"
 
@@ -56,6 +61,8 @@ var filterFieldEntityPrefix = map[string]common.Entity{
 	"workflow":       common.Workflow,
 	"launch_plan":    common.LaunchPlan,
 	"execution":      common.Execution,
+	"extension":      common.ExecutionExtension,
+	"tag":            common.ExecutionTag,
 	"node_execution": common.NodeExecution,
 	"task_execution": common.TaskExecution,
 }
@@ -103,6 +110,15 @@ func prepareValues(field string, values []string) (interface{}, error) {
 			}
 			preparedValues[idx] = duration
 		}
+	} else if isIntegerField := integerFields[field]; isIntegerField {
+		for idx, value := range values {
+			integerValue, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"Failed to parse integer [%s]", value)
+			}
+			preparedValues[idx] = integerValue
+		}
 	} else {
 		for idx, value := range values {
 			preparedValues[idx] = value
@@ -54,6 +54,19 @@ func TestPrepareValues_WithDuration(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPrepareValues_WithInteger(t *testing.T) {
+	values, err := prepareValues("retry_attempt", []string{"2"})
+	assert.Nil(t, err)
+	assert.EqualValues(t, int64(2), values)
+
+	values, err = prepareValues("retry_attempt", []string{"0", "1"})
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{int64(0), int64(1)}, values)
+
+	_, err = prepareValues("retry_attempt", []string{"first"})
+	assert.EqualError(t, err, "Failed to parse integer [first]")
+}
+
 func TestPrepareValues_RepeatedValues(t *testing.T) {
 	values, err := prepareValues("field", []string{"value"})
 	assert.NoError(t, err)
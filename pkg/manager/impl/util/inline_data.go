@@ -0,0 +1,48 @@
+package util
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/storage"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+// Reads the literal map offloaded to uri when the size reported by its URL blob doesn't exceed maxInlineSizeBytes.
+// Returns nil when there's no offloaded object, inlining is disabled or the object is too large to inline.
+func GetInlineLiteralMap(ctx context.Context, store *storage.DataStore, uri string, urlBlob *admin.UrlBlob,
+	maxInlineSizeBytes int64) (*core.LiteralMap, error) {
+	if uri == "" || urlBlob == nil || maxInlineSizeBytes <= 0 || urlBlob.Bytes > maxInlineSizeBytes {
+		return nil, nil
+	}
+	var literalMap core.LiteralMap
+	if err := store.ReadProtobuf(ctx, storage.DataReference(uri), &literalMap); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to read literal map from %s with err: %v", uri, err)
+	}
+	return &literalMap, nil
+}
+
+// Reads the error document a failed node or task execution wrote to uri, returning its error along with the uri it was
+// read from. Returns nil when there's no error document or it doesn't hold an error.
+func GetInlineExecutionError(ctx context.Context, store *storage.DataStore, uri string) (*core.ExecutionError, error) {
+	if uri == "" {
+		return nil, nil
+	}
+	var errorDocument core.ErrorDocument
+	if err := store.ReadProtobuf(ctx, storage.DataReference(uri), &errorDocument); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to read error document from %s with err: %v", uri, err)
+	}
+	if errorDocument.Error == nil {
+		return nil, nil
+	}
+	return &core.ExecutionError{
+		Code:     errorDocument.Error.Code,
+		Message:  errorDocument.Error.Message,
+		ErrorUri: uri,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytepropeller/pkg/utils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+)
+
+const inputsURI = "s3://bucket/metadata/inputs.pb"
+
+var inlineLiteralMap = core.LiteralMap{
+	Literals: map[string]*core.Literal{
+		"foo": utils.MustMakeLiteral("foo-value"),
+	},
+}
+
+func TestGetInlineLiteralMap(t *testing.T) {
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			assert.Equal(t, inputsURI, reference.String())
+			literalMapBytes, _ := proto.Marshal(&inlineLiteralMap)
+			_ = proto.Unmarshal(literalMapBytes, msg)
+			return nil
+		}
+	literalMap, err := GetInlineLiteralMap(
+		context.Background(), mockStorageClient, inputsURI, &admin.UrlBlob{Bytes: 100}, 100)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&inlineLiteralMap, literalMap))
+}
+
+func TestGetInlineLiteralMap_NotInlined(t *testing.T) {
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			t.Fatalf("unexpected read of [%s]", reference)
+			return nil
+		}
+	for _, test := range []struct {
+		uri                string
+		urlBlob            *admin.UrlBlob
+		maxInlineSizeBytes int64
+	}{
+		{inputsURI, &admin.UrlBlob{Bytes: 101}, 100},
+		{inputsURI, &admin.UrlBlob{Bytes: 1}, 0},
+		{inputsURI, nil, 100},
+		{"", &admin.UrlBlob{}, 100},
+	} {
+		literalMap, err := GetInlineLiteralMap(
+			context.Background(), mockStorageClient, test.uri, test.urlBlob, test.maxInlineSizeBytes)
+		assert.Nil(t, err)
+		assert.Nil(t, literalMap)
+	}
+}
+
+func TestGetInlineLiteralMap_ReadError(t *testing.T) {
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			return errExpected
+		}
+	_, err := GetInlineLiteralMap(context.Background(), mockStorageClient, inputsURI, &admin.UrlBlob{}, 100)
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetInlineExecutionError(t *testing.T) {
+	errorURI := "s3://bucket/metadata/error.pb"
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			assert.Equal(t, errorURI, reference.String())
+			errorDocumentBytes, _ := proto.Marshal(&core.ErrorDocument{
+				Error: &core.ContainerError{
+					Code:    "USER:ValueError",
+					Message: "Traceback (most recent call last): ...",
+				},
+			})
+			_ = proto.Unmarshal(errorDocumentBytes, msg)
+			return nil
+		}
+	executionError, err := GetInlineExecutionError(context.Background(), mockStorageClient, errorURI)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&core.ExecutionError{
+		Code:     "USER:ValueError",
+		Message:  "Traceback (most recent call last): ...",
+		ErrorUri: errorURI,
+	}, executionError))
+
+	executionError, err = GetInlineExecutionError(context.Background(), mockStorageClient, "")
+	assert.Nil(t, err)
+	assert.Nil(t, executionError)
+}
+
+func TestGetInlineExecutionError_ReadError(t *testing.T) {
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			return errExpected
+		}
+	_, err := GetInlineExecutionError(context.Background(), mockStorageClient, "s3://bucket/metadata/error.pb")
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
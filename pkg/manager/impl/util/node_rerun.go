@@ -0,0 +1,247 @@
+package util
+
+import (
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	engine "github.com/lyft/flytepropeller/pkg/compiler/common"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+// Returns the node's bindings along with those of the nodes nested in it by branches.
+func getNodeBindings(node *core.Node) []*core.Binding {
+	bindings := append([]*core.Binding{}, node.Inputs...)
+	ifElse := node.GetBranchNode().GetIfElse()
+	if ifElse == nil {
+		return bindings
+	}
+	branchNodes := []*core.Node{ifElse.GetCase().GetThenNode(), ifElse.GetElseNode()}
+	for _, other := range ifElse.Other {
+		branchNodes = append(branchNodes, other.GetThenNode())
+	}
+	for _, branchNode := range branchNodes {
+		if branchNode != nil {
+			bindings = append(bindings, getNodeBindings(branchNode)...)
+		}
+	}
+	return bindings
+}
+
+// Returns the bindings of the nodes which re-run and of the workflow outputs.
+func getRerunBindings(closure *core.CompiledWorkflowClosure, rerunNodeIDs map[string]bool) []*core.Binding {
+	bindings := append([]*core.Binding{}, closure.Primary.Template.Outputs...)
+	for _, node := range closure.Primary.Template.Nodes {
+		if rerunNodeIDs[node.Id] || node.Id == engine.EndNodeID {
+			bindings = append(bindings, getNodeBindings(node)...)
+		}
+	}
+	return bindings
+}
+
+func forEachPromise(bindingData *core.BindingData, fn func(promise *core.OutputReference)) {
+	switch value := bindingData.GetValue().(type) {
+	case *core.BindingData_Promise:
+		fn(value.Promise)
+	case *core.BindingData_Collection:
+		for _, binding := range value.Collection.GetBindings() {
+			forEachPromise(binding, fn)
+		}
+	case *core.BindingData_Map:
+		for _, binding := range value.Map.GetBindings() {
+			forEachPromise(binding, fn)
+		}
+	}
+}
+
+func literalToBindingData(literal *core.Literal) *core.BindingData {
+	switch value := literal.GetValue().(type) {
+	case *core.Literal_Collection:
+		bindings := make([]*core.BindingData, len(value.Collection.GetLiterals()))
+		for idx, item := range value.Collection.GetLiterals() {
+			bindings[idx] = literalToBindingData(item)
+		}
+		return &core.BindingData{
+			Value: &core.BindingData_Collection{
+				Collection: &core.BindingDataCollection{
+					Bindings: bindings,
+				},
+			},
+		}
+	case *core.Literal_Map:
+		bindings := make(map[string]*core.BindingData, len(value.Map.GetLiterals()))
+		for key, item := range value.Map.GetLiterals() {
+			bindings[key] = literalToBindingData(item)
+		}
+		return &core.BindingData{
+			Value: &core.BindingData_Map{
+				Map: &core.BindingDataMap{
+					Bindings: bindings,
+				},
+			},
+		}
+	default:
+		return &core.BindingData{
+			Value: &core.BindingData_Scalar{
+				Scalar: literal.GetScalar(),
+			},
+		}
+	}
+}
+
+// Replaces promises of nodes which don't re-run with the outputs they recorded.
+func fixBindingData(bindingData *core.BindingData, rerunNodeIDs map[string]bool,
+	upstreamOutputs map[string]*core.LiteralMap) (*core.BindingData, error) {
+	switch value := bindingData.GetValue().(type) {
+	case *core.BindingData_Promise:
+		nodeID := value.Promise.NodeId
+		if nodeID == engine.StartNodeID || rerunNodeIDs[nodeID] {
+			return bindingData, nil
+		}
+		literal, ok := upstreamOutputs[nodeID].GetLiterals()[value.Promise.Var]
+		if !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+				"node [%s] recorded no output [%s] to re-run with", nodeID, value.Promise.Var)
+		}
+		return literalToBindingData(literal), nil
+	case *core.BindingData_Collection:
+		for idx, binding := range value.Collection.GetBindings() {
+			fixed, err := fixBindingData(binding, rerunNodeIDs, upstreamOutputs)
+			if err != nil {
+				return nil, err
+			}
+			value.Collection.Bindings[idx] = fixed
+		}
+	case *core.BindingData_Map:
+		for key, binding := range value.Map.GetBindings() {
+			fixed, err := fixBindingData(binding, rerunNodeIDs, upstreamOutputs)
+			if err != nil {
+				return nil, err
+			}
+			value.Map.Bindings[key] = fixed
+		}
+	}
+	return bindingData, nil
+}
+
+func filterConnections(connections map[string]*core.ConnectionSet_IdList, keep map[string]bool) {
+	for nodeID, idList := range connections {
+		if !keep[nodeID] {
+			delete(connections, nodeID)
+			continue
+		}
+		ids := make([]string, 0, len(idList.GetIds()))
+		for _, id := range idList.GetIds() {
+			if keep[id] {
+				ids = append(ids, id)
+			}
+		}
+		idList.Ids = ids
+	}
+}
+
+// Returns the ids of a node of the primary workflow of a compiled closure and of all nodes downstream of it, which are
+// the nodes that re-run when the node is re-run.
+func GetRerunNodeIDs(closure *core.CompiledWorkflowClosure, nodeID string) (map[string]bool, error) {
+	var found bool
+	for _, node := range closure.GetPrimary().GetTemplate().GetNodes() {
+		if node.Id == nodeID {
+			found = true
+			break
+		}
+	}
+	if !found || nodeID == engine.StartNodeID || nodeID == engine.EndNodeID {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"node [%s] is not a node of the workflow", nodeID)
+	}
+	downstream := closure.Primary.GetConnections().GetDownstream()
+	rerunNodeIDs := map[string]bool{nodeID: true}
+	pending := []string{nodeID}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		for _, downstreamNodeID := range downstream[current].GetIds() {
+			if downstreamNodeID == engine.EndNodeID || rerunNodeIDs[downstreamNodeID] {
+				continue
+			}
+			rerunNodeIDs[downstreamNodeID] = true
+			pending = append(pending, downstreamNodeID)
+		}
+	}
+	return rerunNodeIDs, nil
+}
+
+// Returns the ids of the nodes which don't re-run but whose outputs are bound by nodes which do, or by the workflow
+// outputs.
+func GetRerunUpstreamNodeIDs(closure *core.CompiledWorkflowClosure, rerunNodeIDs map[string]bool) []string {
+	upstreamNodeIDs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, binding := range getRerunBindings(closure, rerunNodeIDs) {
+		forEachPromise(binding.GetBinding(), func(promise *core.OutputReference) {
+			nodeID := promise.NodeId
+			if nodeID == engine.StartNodeID || rerunNodeIDs[nodeID] || seen[nodeID] {
+				return
+			}
+			seen[nodeID] = true
+			upstreamNodeIDs = append(upstreamNodeIDs, nodeID)
+		})
+	}
+	return upstreamNodeIDs
+}
+
+// Prunes the primary workflow of a compiled closure down to the nodes which re-run. Their bindings (and those of the
+// workflow outputs) to nodes which don't re-run are fixed to the outputs those nodes recorded, and nodes which re-run
+// but no longer have upstream nodes start right away.
+func PruneWorkflowClosureForRerun(closure *core.CompiledWorkflowClosure, rerunNodeIDs map[string]bool,
+	upstreamOutputs map[string]*core.LiteralMap) error {
+	for _, binding := range getRerunBindings(closure, rerunNodeIDs) {
+		fixed, err := fixBindingData(binding.GetBinding(), rerunNodeIDs, upstreamOutputs)
+		if err != nil {
+			return err
+		}
+		binding.Binding = fixed
+	}
+
+	keep := map[string]bool{
+		engine.StartNodeID: true,
+		engine.EndNodeID:   true,
+	}
+	for nodeID := range rerunNodeIDs {
+		keep[nodeID] = true
+	}
+	template := closure.Primary.Template
+	nodes := make([]*core.Node, 0, len(rerunNodeIDs)+2)
+	for _, node := range template.Nodes {
+		if keep[node.Id] {
+			nodes = append(nodes, node)
+		}
+	}
+	template.Nodes = nodes
+
+	if closure.Primary.Connections == nil {
+		closure.Primary.Connections = &core.ConnectionSet{}
+	}
+	connections := closure.Primary.Connections
+	if connections.Downstream == nil {
+		connections.Downstream = make(map[string]*core.ConnectionSet_IdList)
+	}
+	if connections.Upstream == nil {
+		connections.Upstream = make(map[string]*core.ConnectionSet_IdList)
+	}
+	filterConnections(connections.Downstream, keep)
+	filterConnections(connections.Upstream, keep)
+	for _, node := range nodes {
+		if node.Id == engine.StartNodeID || node.Id == engine.EndNodeID ||
+			len(connections.Upstream[node.Id].GetIds()) > 0 {
+			continue
+		}
+		connections.Upstream[node.Id] = &core.ConnectionSet_IdList{
+			Ids: []string{engine.StartNodeID},
+		}
+		if connections.Downstream[engine.StartNodeID] == nil {
+			connections.Downstream[engine.StartNodeID] = &core.ConnectionSet_IdList{}
+		}
+		connections.Downstream[engine.StartNodeID].Ids = append(
+			connections.Downstream[engine.StartNodeID].Ids, node.Id)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+)
+
+func getPromiseBinding(variable, nodeID, nodeVariable string) *core.Binding {
+	return &core.Binding{
+		Var: variable,
+		Binding: &core.BindingData{
+			Value: &core.BindingData_Promise{
+				Promise: &core.OutputReference{
+					NodeId: nodeID,
+					Var:    nodeVariable,
+				},
+			},
+		},
+	}
+}
+
+func getIDList(ids ...string) *core.ConnectionSet_IdList {
+	return &core.ConnectionSet_IdList{
+		Ids: ids,
+	}
+}
+
+// Returns a closure where start-node -> a -> b -> end-node, start-node -> c -> end-node and the workflow outputs are
+// bound to the outputs of b and c.
+func getRerunTestClosure() *core.CompiledWorkflowClosure {
+	return &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Nodes: []*core.Node{
+					{Id: "start-node"},
+					{
+						Id:     "a",
+						Inputs: []*core.Binding{getPromiseBinding("x", "start-node", "x")},
+					},
+					{
+						Id:     "b",
+						Inputs: []*core.Binding{getPromiseBinding("y", "a", "y")},
+					},
+					{Id: "c"},
+					{
+						Id: "end-node",
+						Inputs: []*core.Binding{
+							getPromiseBinding("b_out", "b", "out"),
+							getPromiseBinding("c_out", "c", "out"),
+						},
+					},
+				},
+				Outputs: []*core.Binding{
+					getPromiseBinding("b_out", "b", "out"),
+					getPromiseBinding("c_out", "c", "out"),
+				},
+			},
+			Connections: &core.ConnectionSet{
+				Downstream: map[string]*core.ConnectionSet_IdList{
+					"start-node": getIDList("a", "c"),
+					"a":          getIDList("b"),
+					"b":          getIDList("end-node"),
+					"c":          getIDList("end-node"),
+				},
+				Upstream: map[string]*core.ConnectionSet_IdList{
+					"a":        getIDList("start-node"),
+					"b":        getIDList("a"),
+					"c":        getIDList("start-node"),
+					"end-node": getIDList("b", "c"),
+				},
+			},
+		},
+	}
+}
+
+func TestGetRerunNodeIDs(t *testing.T) {
+	rerunNodeIDs, err := GetRerunNodeIDs(getRerunTestClosure(), "a")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, rerunNodeIDs)
+
+	rerunNodeIDs, err = GetRerunNodeIDs(getRerunTestClosure(), "c")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"c": true}, rerunNodeIDs)
+
+	for _, nodeID := range []string{"start-node", "end-node", "unknown"} {
+		_, err = GetRerunNodeIDs(getRerunTestClosure(), nodeID)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestGetRerunUpstreamNodeIDs(t *testing.T) {
+	assert.Equal(t, []string{"c", "a"},
+		GetRerunUpstreamNodeIDs(getRerunTestClosure(), map[string]bool{"b": true}))
+	assert.Equal(t, []string{"c"},
+		GetRerunUpstreamNodeIDs(getRerunTestClosure(), map[string]bool{"a": true, "b": true}))
+}
+
+func TestPruneWorkflowClosureForRerun(t *testing.T) {
+	closure := getRerunTestClosure()
+	cOut := &core.Literal{
+		Value: &core.Literal_Collection{
+			Collection: &core.LiteralCollection{
+				Literals: []*core.Literal{
+					{Value: &core.Literal_Scalar{Scalar: &core.Scalar{}}},
+				},
+			},
+		},
+	}
+	err := PruneWorkflowClosureForRerun(closure, map[string]bool{"b": true}, map[string]*core.LiteralMap{
+		"a": {Literals: map[string]*core.Literal{"y": {Value: &core.Literal_Scalar{Scalar: &core.Scalar{}}}}},
+		"c": {Literals: map[string]*core.Literal{"out": cOut}},
+	})
+	assert.NoError(t, err)
+
+	template := closure.Primary.Template
+	nodeIDs := make([]string, len(template.Nodes))
+	for idx, node := range template.Nodes {
+		nodeIDs[idx] = node.Id
+	}
+	assert.Equal(t, []string{"start-node", "b", "end-node"}, nodeIDs)
+	assert.NotNil(t, template.Nodes[1].Inputs[0].Binding.GetScalar())
+	assert.Equal(t, "b", template.Nodes[2].Inputs[0].Binding.GetPromise().NodeId)
+	assert.Len(t, template.Nodes[2].Inputs[1].Binding.GetCollection().Bindings, 1)
+	assert.Equal(t, "b", template.Outputs[0].Binding.GetPromise().NodeId)
+	assert.Len(t, template.Outputs[1].Binding.GetCollection().Bindings, 1)
+
+	assert.Equal(t, map[string]*core.ConnectionSet_IdList{
+		"start-node": getIDList("b"),
+		"b":          getIDList("end-node"),
+	}, closure.Primary.Connections.Downstream)
+	assert.Equal(t, map[string]*core.ConnectionSet_IdList{
+		"b":        getIDList("start-node"),
+		"end-node": getIDList("b"),
+	}, closure.Primary.Connections.Upstream)
+}
+
+func TestPruneWorkflowClosureForRerun_MissingOutput(t *testing.T) {
+	err := PruneWorkflowClosureForRerun(getRerunTestClosure(), map[string]bool{"b": true},
+		map[string]*core.LiteralMap{})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,120 @@
+package util
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Returns the attributes of a resource type which most specifically match a project, domain and workflow, which are
+// empty when none were set.
+func GetMatchableResource(
+	ctx context.Context, repo repositories.RepositoryInterface, resourceType interfaces.MatchableResource,
+	project, domain, workflow string) (interfaces.MatchingAttributes, error) {
+	model, err := repo.ResourceRepo().Get(ctx, repoInterfaces.ResourceID{
+		Project:      project,
+		Domain:       domain,
+		Workflow:     workflow,
+		ResourceType: int32(resourceType),
+	})
+	if err != nil {
+		if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.NotFound {
+			return interfaces.MatchingAttributes{}, nil
+		}
+		return interfaces.MatchingAttributes{}, err
+	}
+	attributes, err := transformers.FromResourceModel(model)
+	if err != nil {
+		return interfaces.MatchingAttributes{}, err
+	}
+	return attributes.Attributes, nil
+}
+
+// Returns the feature flags which most specifically match a project, domain and workflow, which leave every behavior on
+// when none were set.
+func GetFeatureFlags(ctx context.Context, repo repositories.RepositoryInterface, project, domain, workflow string) (
+	interfaces.FeatureFlags, error) {
+	attributes, err := GetMatchableResource(
+		ctx, repo, interfaces.MatchableResourceFeatureFlags, project, domain, workflow)
+	if err != nil {
+		return interfaces.FeatureFlags{}, err
+	}
+	if attributes.FeatureFlags == nil {
+		return interfaces.FeatureFlags{}, nil
+	}
+	return *attributes.FeatureFlags, nil
+}
+
+// Returns the naming policy of the executions of a workflow, which is nil when none is set.
+func GetExecutionNamePolicy(ctx context.Context, repo repositories.RepositoryInterface, project, domain,
+	workflow string) (*interfaces.ExecutionNamePolicy, error) {
+	attributes, err := GetMatchableResource(
+		ctx, repo, interfaces.MatchableResourceExecutionNamePolicy, project, domain, workflow)
+	if err != nil {
+		return nil, err
+	}
+	return attributes.ExecutionNamePolicy, nil
+}
+
+// Returns the quiet hours of the executions of a project and domain, which are nil when none are set.
+func GetQuietHours(ctx context.Context, repo repositories.RepositoryInterface, project, domain string) (
+	*interfaces.QuietHours, error) {
+	attributes, err := GetMatchableResource(ctx, repo, interfaces.MatchableResourceQuietHours, project, domain, "")
+	if err != nil {
+		return nil, err
+	}
+	return attributes.QuietHours, nil
+}
+
+type taskResourceConfiguration struct {
+	defaults runtimeInterfaces.TaskResourceSet
+	limits   runtimeInterfaces.TaskResourceSet
+}
+
+func (c *taskResourceConfiguration) GetDefaults() runtimeInterfaces.TaskResourceSet {
+	return c.defaults
+}
+
+func (c *taskResourceConfiguration) GetLimits() runtimeInterfaces.TaskResourceSet {
+	return c.limits
+}
+
+func overrideTaskResourceSet(
+	set runtimeInterfaces.TaskResourceSet, spec interfaces.TaskResourceSpec) runtimeInterfaces.TaskResourceSet {
+	if len(spec.CPU) > 0 {
+		set.CPU = spec.CPU
+	}
+	if len(spec.GPU) > 0 {
+		set.GPU = spec.GPU
+	}
+	if len(spec.Memory) > 0 {
+		set.Memory = spec.Memory
+	}
+	return set
+}
+
+// Returns the task resource defaults and limits applying to the tasks of a workflow (or of a project and domain when
+// the workflow is empty): the configured ones, overridden by the task resource attributes matching the workflow.
+func GetTaskResourceConfiguration(
+	ctx context.Context, repo repositories.RepositoryInterface, config runtimeInterfaces.TaskResourceConfiguration,
+	project, domain, workflow string) (runtimeInterfaces.TaskResourceConfiguration, error) {
+	attributes, err := GetMatchableResource(
+		ctx, repo, interfaces.MatchableResourceTaskResource, project, domain, workflow)
+	if err != nil {
+		return nil, err
+	}
+	if attributes.TaskResourceAttributes == nil {
+		return config, nil
+	}
+	return &taskResourceConfiguration{
+		defaults: overrideTaskResourceSet(config.GetDefaults(), attributes.TaskResourceAttributes.Defaults),
+		limits:   overrideTaskResourceSet(config.GetLimits(), attributes.TaskResourceAttributes.Limits),
+	}, nil
+}
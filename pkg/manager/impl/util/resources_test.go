@@ -0,0 +1,69 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+func getTaskResourceConfigForTest() runtimeInterfaces.TaskResourceConfiguration {
+	taskConfig := runtimeMocks.MockTaskResourceConfiguration{}
+	taskConfig.Defaults = runtimeInterfaces.TaskResourceSet{CPU: "200m", Memory: "200Mi"}
+	taskConfig.Limits = runtimeInterfaces.TaskResourceSet{CPU: "2", Memory: "2Gi"}
+	return &taskConfig
+}
+
+func TestGetTaskResourceConfiguration(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	model, _ := transformers.ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: interfaces.MatchingAttributes{
+			TaskResourceAttributes: &interfaces.TaskResourceAttributes{
+				Defaults: interfaces.TaskResourceSpec{Memory: "1Gi"},
+				Limits:   interfaces.TaskResourceSpec{CPU: "4", Memory: "8Gi"},
+			},
+		},
+	})
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, id repoInterfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, repoInterfaces.ResourceID{
+			Project:      "project",
+			Domain:       "domain",
+			Workflow:     "workflow",
+			ResourceType: int32(interfaces.MatchableResourceTaskResource),
+		}, id)
+		return model, nil
+	}
+
+	taskConfig, err := GetTaskResourceConfiguration(
+		context.Background(), repository, getTaskResourceConfigForTest(), "project", "domain", "workflow")
+	assert.NoError(t, err)
+	assert.Equal(t, runtimeInterfaces.TaskResourceSet{CPU: "200m", Memory: "1Gi"}, taskConfig.GetDefaults())
+	assert.Equal(t, runtimeInterfaces.TaskResourceSet{CPU: "4", Memory: "8Gi"}, taskConfig.GetLimits())
+}
+
+func TestGetTaskResourceConfiguration_NotSet(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, id repoInterfaces.ResourceID) (models.Resource, error) {
+		return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+	}
+
+	config := getTaskResourceConfigForTest()
+	taskConfig, err := GetTaskResourceConfiguration(
+		context.Background(), repository, config, "project", "domain", "workflow")
+	assert.NoError(t, err)
+	assert.Equal(t, config, taskConfig)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
@@ -20,11 +21,27 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-func GetExecutionName(request admin.ExecutionCreateRequest) string {
+// Returns the name requested for an execution, or otherwise generates one following the naming policy matching the
+// execution, if any.
+func GetExecutionName(request admin.ExecutionCreateRequest, policy *interfaces.ExecutionNamePolicy) (string, error) {
 	if request.Name != "" {
-		return request.Name
+		return request.Name, nil
 	}
-	return common.GetExecutionName(time.Now().UnixNano())
+	now := time.Now()
+	if policy == nil || len(policy.Template) == 0 {
+		return common.GetExecutionName(now.UnixNano()), nil
+	}
+	prefix, err := common.RenderExecutionNameTemplate(policy.Template, common.ExecutionNameTemplateInput{
+		LaunchPlan:  request.Spec.GetLaunchPlan(),
+		KickoffTime: now,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err = validation.ValidateExecutionNamePrefix(prefix, policy.Template); err != nil {
+		return "", err
+	}
+	return common.GetPrefixedExecutionName(prefix, now.UnixNano()), nil
 }
 
 func GetTask(ctx context.Context, repo repositories.RepositoryInterface, identifier core.Identifier) (
@@ -114,6 +131,23 @@ func GetLaunchPlan(
 	return transformers.FromLaunchPlanModel(launchPlanModel)
 }
 
+// Returns the attributes set for a project and domain, which are empty when none were set.
+func GetProjectDomainAttributes(
+	ctx context.Context, repo repositories.RepositoryInterface, project, domain string) (map[string]string, error) {
+	projectDomainModel, err := repo.ProjectDomainRepo().Get(ctx, project, domain)
+	if err != nil {
+		if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	attributes, err := transformers.FromProjectDomainModel(projectDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	return attributes.Attributes, nil
+}
+
 func GetNamedEntityModel(
 	ctx context.Context, repo repositories.RepositoryInterface, resourceType core.ResourceType, identifier admin.NamedEntityIdentifier) (models.NamedEntity, error) {
 	metadataModel, err := (repo).NamedEntityRepo().Get(ctx, repoInterfaces.GetNamedEntityInput{
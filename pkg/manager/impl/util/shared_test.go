@@ -13,6 +13,7 @@ import (
 	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
@@ -33,20 +34,45 @@ const remoteClosureIdentifier = "remote closure id"
 var errExpected = errors.New("expected error")
 
 func TestPopulateExecutionID(t *testing.T) {
-	name := GetExecutionName(admin.ExecutionCreateRequest{
+	name, err := GetExecutionName(admin.ExecutionCreateRequest{
 		Project: "project",
 		Domain:  "domain",
-	})
+	}, nil)
+	assert.NoError(t, err)
 	assert.NotEmpty(t, name)
 	assert.Len(t, name, common.ExecutionIDLength)
 }
 
+func TestPopulateExecutionID_NamePolicy(t *testing.T) {
+	request := admin.ExecutionCreateRequest{
+		Project: "project",
+		Domain:  "domain",
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: &core.Identifier{Project: "project", Domain: "domain", Name: "etl", Version: "v1"},
+		},
+	}
+	name, err := GetExecutionName(request, &managerInterfaces.ExecutionNamePolicy{Template: "ml-{kickoff_date}"})
+	assert.NoError(t, err)
+	assert.Regexp(t, `^ml-[0-9]{8}-[a-z][a-z0-9]{7}$`, name)
+
+	name, err = GetExecutionName(request, &managerInterfaces.ExecutionNamePolicy{Template: "{lp_name}"})
+	assert.NoError(t, err)
+	assert.Regexp(t, `^etl-[a-z][a-z0-9]{9}$`, name)
+
+	// Launch plan names are only known as executions are named.
+	request.Spec.LaunchPlan.Name = "nightly_aggregation"
+	_, err = GetExecutionName(request, &managerInterfaces.ExecutionNamePolicy{Template: "{lp_name}"})
+	assert.EqualError(t, err,
+		"execution name template [{lp_name}] leaves less than 6 characters for the random suffix of names")
+}
+
 func TestPopulateExecutionID_ExistingName(t *testing.T) {
-	name := GetExecutionName(admin.ExecutionCreateRequest{
+	name, err := GetExecutionName(admin.ExecutionCreateRequest{
 		Project: "project",
 		Domain:  "domain",
 		Name:    "name",
-	})
+	}, &managerInterfaces.ExecutionNamePolicy{Template: "ml-{kickoff_date}"})
+	assert.NoError(t, err)
 	assert.Equal(t, "name", name)
 }
 
@@ -0,0 +1,114 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+// Returns the protobuf JSON mapping of a message decoded into maps, slices and scalars.
+func toJSONValue(message proto.Message) (interface{}, error) {
+	var buffer bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buffer, message); err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(buffer.Bytes(), &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func joinFieldPath(path, field string) string {
+	if len(path) == 0 {
+		return field
+	}
+	return fmt.Sprintf("%s.%s", path, field)
+}
+
+func diffJSONValues(path string, existing, incoming interface{}, diffs []interfaces.FieldDiff) []interfaces.FieldDiff {
+	existingMap, existingIsMap := existing.(map[string]interface{})
+	incomingMap, incomingIsMap := incoming.(map[string]interface{})
+	if existingIsMap && incomingIsMap {
+		fields := make([]string, 0, len(existingMap)+len(incomingMap))
+		for field := range existingMap {
+			fields = append(fields, field)
+		}
+		for field := range incomingMap {
+			if _, ok := existingMap[field]; !ok {
+				fields = append(fields, field)
+			}
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			diffs = diffJSONValues(joinFieldPath(path, field), existingMap[field], incomingMap[field], diffs)
+		}
+		return diffs
+	}
+	existingList, existingIsList := existing.([]interface{})
+	incomingList, incomingIsList := incoming.([]interface{})
+	if existingIsList && incomingIsList {
+		length := len(existingList)
+		if len(incomingList) > length {
+			length = len(incomingList)
+		}
+		for idx := 0; idx < length; idx++ {
+			var existingItem, incomingItem interface{}
+			if idx < len(existingList) {
+				existingItem = existingList[idx]
+			}
+			if idx < len(incomingList) {
+				incomingItem = incomingList[idx]
+			}
+			diffs = diffJSONValues(fmt.Sprintf("%s[%d]", path, idx), existingItem, incomingItem, diffs)
+		}
+		return diffs
+	}
+	if !reflect.DeepEqual(existing, incoming) {
+		diffs = append(diffs, interfaces.FieldDiff{
+			Path:     path,
+			Existing: existing,
+			Incoming: incoming,
+		})
+	}
+	return diffs
+}
+
+// Returns the fields which differ between a registered compiled task and one compiled from a create request, ordered
+// by path.
+func DiffCompiledTasks(existing, incoming *core.CompiledTask) ([]interfaces.FieldDiff, error) {
+	if existing == nil {
+		existing = &core.CompiledTask{}
+	}
+	if incoming == nil {
+		incoming = &core.CompiledTask{}
+	}
+	existingValue, err := toJSONValue(existing)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal registered task: %v", err)
+	}
+	incomingValue, err := toJSONValue(incoming)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal incoming task: %v", err)
+	}
+	return diffJSONValues("", existingValue, incomingValue, make([]interfaces.FieldDiff, 0)), nil
+}
+
+// Returns the paths of the fields which differ, for error messages.
+func GetFieldDiffPaths(diffs []interfaces.FieldDiff) []string {
+	paths := make([]string, len(diffs))
+	for idx, diff := range diffs {
+		paths[idx] = diff.Path
+	}
+	return paths
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+func getDiffTestTask(image string, args ...string) *core.CompiledTask {
+	return &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Type: "python",
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{
+					Image: image,
+					Args:  args,
+				},
+			},
+		},
+	}
+}
+
+func TestDiffCompiledTasks(t *testing.T) {
+	diffs, err := DiffCompiledTasks(getDiffTestTask("image:1", "a", "b"), getDiffTestTask("image:2", "a"))
+	assert.NoError(t, err)
+	assert.Equal(t, []interfaces.FieldDiff{
+		{
+			Path:     "template.container.args[1]",
+			Existing: "b",
+		},
+		{
+			Path:     "template.container.image",
+			Existing: "image:1",
+			Incoming: "image:2",
+		},
+	}, diffs)
+	assert.Equal(t, []string{"template.container.args[1]", "template.container.image"}, GetFieldDiffPaths(diffs))
+}
+
+func TestDiffCompiledTasks_Identical(t *testing.T) {
+	diffs, err := DiffCompiledTasks(getDiffTestTask("image", "a"), getDiffTestTask("image", "a"))
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestDiffCompiledTasks_MissingField(t *testing.T) {
+	diffs, err := DiffCompiledTasks(nil, getDiffTestTask("image"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"template"}, GetFieldDiffPaths(diffs))
+	assert.Nil(t, diffs[0].Existing)
+}
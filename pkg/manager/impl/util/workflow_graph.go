@@ -0,0 +1,166 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	engine "github.com/lyft/flytepropeller/pkg/compiler/common"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type workflowGraphBuilder struct {
+	subWorkflows []*core.CompiledWorkflow
+	nodes        []interfaces.WorkflowGraphNode
+	edges        []interfaces.WorkflowGraphEdge
+}
+
+func (b *workflowGraphBuilder) getSubWorkflow(id *core.Identifier) *core.CompiledWorkflow {
+	for _, subWorkflow := range b.subWorkflows {
+		if proto.Equal(subWorkflow.GetTemplate().GetId(), id) {
+			return subWorkflow
+		}
+	}
+	return nil
+}
+
+// Adds the nodes of a workflow and the edges between them. Expanding lists the sub-workflows the workflow is nested
+// in, which aren't expanded again should a malformed closure reference them recursively.
+func (b *workflowGraphBuilder) addWorkflow(workflow *core.CompiledWorkflow, parent string, expanding []*core.Identifier) {
+	prefix := ""
+	if len(parent) > 0 {
+		prefix = parent + "/"
+	}
+	for _, node := range workflow.GetTemplate().GetNodes() {
+		b.addNode(node, prefix, parent, expanding)
+	}
+	downstream := workflow.GetConnections().GetDownstream()
+	fromNodeIDs := make([]string, 0, len(downstream))
+	for nodeID := range downstream {
+		fromNodeIDs = append(fromNodeIDs, nodeID)
+	}
+	sort.Strings(fromNodeIDs)
+	for _, from := range fromNodeIDs {
+		for _, to := range downstream[from].GetIds() {
+			b.edges = append(b.edges, interfaces.WorkflowGraphEdge{
+				From: prefix + from,
+				To:   prefix + to,
+			})
+		}
+	}
+}
+
+func (b *workflowGraphBuilder) addNode(node *core.Node, prefix, parent string, expanding []*core.Identifier) {
+	graphNode := interfaces.WorkflowGraphNode{
+		ID:     prefix + node.Id,
+		Name:   node.Id,
+		Parent: parent,
+	}
+	switch {
+	case node.Id == engine.StartNodeID:
+		graphNode.Kind = interfaces.WorkflowGraphNodeKindStart
+	case node.Id == engine.EndNodeID:
+		graphNode.Kind = interfaces.WorkflowGraphNodeKindEnd
+	case node.GetBranchNode() != nil:
+		graphNode.Kind = interfaces.WorkflowGraphNodeKindBranch
+	case node.GetWorkflowNode().GetLaunchplanRef() != nil:
+		graphNode.Kind = interfaces.WorkflowGraphNodeKindLaunchPlan
+		graphNode.Target = node.GetWorkflowNode().GetLaunchplanRef()
+	case node.GetWorkflowNode() != nil:
+		graphNode.Kind = interfaces.WorkflowGraphNodeKindWorkflow
+		graphNode.Target = node.GetWorkflowNode().GetSubWorkflowRef()
+	default:
+		graphNode.Kind = interfaces.WorkflowGraphNodeKindTask
+		graphNode.Target = node.GetTaskNode().GetReferenceId()
+	}
+	b.nodes = append(b.nodes, graphNode)
+
+	switch graphNode.Kind {
+	case interfaces.WorkflowGraphNodeKindBranch:
+		ifElse := node.GetBranchNode().GetIfElse()
+		branchNodes := []*core.Node{ifElse.GetCase().GetThenNode()}
+		for _, other := range ifElse.GetOther() {
+			branchNodes = append(branchNodes, other.GetThenNode())
+		}
+		branchNodes = append(branchNodes, ifElse.GetElseNode())
+		for _, branchNode := range branchNodes {
+			if branchNode != nil {
+				b.addNode(branchNode, graphNode.ID+"/", graphNode.ID, expanding)
+			}
+		}
+	case interfaces.WorkflowGraphNodeKindWorkflow:
+		for _, id := range expanding {
+			if proto.Equal(id, graphNode.Target) {
+				return
+			}
+		}
+		if subWorkflow := b.getSubWorkflow(graphNode.Target); subWorkflow != nil {
+			b.addWorkflow(subWorkflow, graphNode.ID, append(expanding, graphNode.Target))
+		}
+	}
+}
+
+// Returns the nodes of the primary workflow of a compiled closure and the edges between them, with branch and
+// sub-workflow nodes followed by the nodes nested in them.
+func GetWorkflowGraph(closure *core.CompiledWorkflowClosure) (
+	[]interfaces.WorkflowGraphNode, []interfaces.WorkflowGraphEdge) {
+	builder := workflowGraphBuilder{
+		subWorkflows: closure.GetSubWorkflows(),
+		nodes:        make([]interfaces.WorkflowGraphNode, 0),
+		edges:        make([]interfaces.WorkflowGraphEdge, 0),
+	}
+	builder.addWorkflow(closure.GetPrimary(), "", nil)
+	return builder.nodes, builder.edges
+}
+
+func getDotNodeShape(kind string) string {
+	switch kind {
+	case interfaces.WorkflowGraphNodeKindStart, interfaces.WorkflowGraphNodeKindEnd:
+		return "circle"
+	case interfaces.WorkflowGraphNodeKindBranch:
+		return "diamond"
+	case interfaces.WorkflowGraphNodeKindWorkflow, interfaces.WorkflowGraphNodeKindLaunchPlan:
+		return "component"
+	default:
+		return "box"
+	}
+}
+
+func writeDotNodes(buffer *bytes.Buffer, children map[string][]interfaces.WorkflowGraphNode, parent, indent string) {
+	for _, node := range children[parent] {
+		nodeIndent := indent
+		_, isParent := children[node.ID]
+		if isParent {
+			fmt.Fprintf(buffer, "%ssubgraph %q {\n", indent, "cluster_"+node.ID)
+			fmt.Fprintf(buffer, "%s\tlabel=%q;\n", indent, node.Name)
+			nodeIndent = indent + "\t"
+		}
+		fmt.Fprintf(buffer, "%s%q [label=%q, shape=%s];\n", nodeIndent, node.ID, node.Name,
+			getDotNodeShape(node.Kind))
+		if isParent {
+			writeDotNodes(buffer, children, node.ID, nodeIndent)
+			fmt.Fprintf(buffer, "%s}\n", indent)
+		}
+	}
+}
+
+// Renders a workflow graph in the graphviz DOT language, with the nodes nested in branch and sub-workflow nodes drawn
+// in a cluster along with their parent.
+func ToWorkflowGraphDot(
+	name string, nodes []interfaces.WorkflowGraphNode, edges []interfaces.WorkflowGraphEdge) string {
+	children := make(map[string][]interfaces.WorkflowGraphNode)
+	for _, node := range nodes {
+		children[node.Parent] = append(children[node.Parent], node)
+	}
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "digraph %q {\n", name)
+	writeDotNodes(&buffer, children, "", "\t")
+	for _, edge := range edges {
+		fmt.Fprintf(&buffer, "\t%q -> %q;\n", edge.From, edge.To)
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
@@ -0,0 +1,197 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+var graphTaskID = &core.Identifier{
+	ResourceType: core.ResourceType_TASK,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "task",
+	Version:      "version",
+}
+
+var graphSubWorkflowID = &core.Identifier{
+	ResourceType: core.ResourceType_WORKFLOW,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "sub",
+	Version:      "version",
+}
+
+var graphLaunchPlanID = &core.Identifier{
+	ResourceType: core.ResourceType_LAUNCH_PLAN,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "lp",
+	Version:      "version",
+}
+
+func getGraphTaskNode(id string) *core.Node {
+	return &core.Node{
+		Id: id,
+		Target: &core.Node_TaskNode{
+			TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{
+					ReferenceId: graphTaskID,
+				},
+			},
+		},
+	}
+}
+
+// Returns a closure where start-node -> branch -> sub -> end-node. The branch runs either then or else, and sub runs
+// the sub-workflow start-node -> t -> lp -> end-node.
+func getGraphTestClosure() *core.CompiledWorkflowClosure {
+	return &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Nodes: []*core.Node{
+					{Id: "start-node"},
+					{
+						Id: "branch",
+						Target: &core.Node_BranchNode{
+							BranchNode: &core.BranchNode{
+								IfElse: &core.IfElseBlock{
+									Case: &core.IfBlock{
+										ThenNode: getGraphTaskNode("then"),
+									},
+									Default: &core.IfElseBlock_ElseNode{
+										ElseNode: getGraphTaskNode("else"),
+									},
+								},
+							},
+						},
+					},
+					{
+						Id: "sub",
+						Target: &core.Node_WorkflowNode{
+							WorkflowNode: &core.WorkflowNode{
+								Reference: &core.WorkflowNode_SubWorkflowRef{
+									SubWorkflowRef: graphSubWorkflowID,
+								},
+							},
+						},
+					},
+					{Id: "end-node"},
+				},
+			},
+			Connections: &core.ConnectionSet{
+				Downstream: map[string]*core.ConnectionSet_IdList{
+					"start-node": getIDList("branch"),
+					"branch":     getIDList("sub"),
+					"sub":        getIDList("end-node"),
+				},
+			},
+		},
+		SubWorkflows: []*core.CompiledWorkflow{
+			{
+				Template: &core.WorkflowTemplate{
+					Id: graphSubWorkflowID,
+					Nodes: []*core.Node{
+						{Id: "start-node"},
+						getGraphTaskNode("t"),
+						{
+							Id: "lp",
+							Target: &core.Node_WorkflowNode{
+								WorkflowNode: &core.WorkflowNode{
+									Reference: &core.WorkflowNode_LaunchplanRef{
+										LaunchplanRef: graphLaunchPlanID,
+									},
+								},
+							},
+						},
+						{Id: "end-node"},
+					},
+				},
+				Connections: &core.ConnectionSet{
+					Downstream: map[string]*core.ConnectionSet_IdList{
+						"start-node": getIDList("t"),
+						"t":          getIDList("lp"),
+						"lp":         getIDList("end-node"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetWorkflowGraph(t *testing.T) {
+	nodes, edges := GetWorkflowGraph(getGraphTestClosure())
+	assert.Equal(t, []interfaces.WorkflowGraphNode{
+		{ID: "start-node", Kind: interfaces.WorkflowGraphNodeKindStart, Name: "start-node"},
+		{ID: "branch", Kind: interfaces.WorkflowGraphNodeKindBranch, Name: "branch"},
+		{ID: "branch/then", Kind: interfaces.WorkflowGraphNodeKindTask, Name: "then", Parent: "branch",
+			Target: graphTaskID},
+		{ID: "branch/else", Kind: interfaces.WorkflowGraphNodeKindTask, Name: "else", Parent: "branch",
+			Target: graphTaskID},
+		{ID: "sub", Kind: interfaces.WorkflowGraphNodeKindWorkflow, Name: "sub", Target: graphSubWorkflowID},
+		{ID: "sub/start-node", Kind: interfaces.WorkflowGraphNodeKindStart, Name: "start-node", Parent: "sub"},
+		{ID: "sub/t", Kind: interfaces.WorkflowGraphNodeKindTask, Name: "t", Parent: "sub", Target: graphTaskID},
+		{ID: "sub/lp", Kind: interfaces.WorkflowGraphNodeKindLaunchPlan, Name: "lp", Parent: "sub",
+			Target: graphLaunchPlanID},
+		{ID: "sub/end-node", Kind: interfaces.WorkflowGraphNodeKindEnd, Name: "end-node", Parent: "sub"},
+		{ID: "end-node", Kind: interfaces.WorkflowGraphNodeKindEnd, Name: "end-node"},
+	}, nodes)
+	assert.Equal(t, []interfaces.WorkflowGraphEdge{
+		{From: "sub/lp", To: "sub/end-node"},
+		{From: "sub/start-node", To: "sub/t"},
+		{From: "sub/t", To: "sub/lp"},
+		{From: "branch", To: "sub"},
+		{From: "start-node", To: "branch"},
+		{From: "sub", To: "end-node"},
+	}, edges)
+}
+
+func TestGetWorkflowGraph_RecursiveSubWorkflow(t *testing.T) {
+	closure := getGraphTestClosure()
+	closure.SubWorkflows[0].Template.Nodes[1] = &core.Node{
+		Id: "t",
+		Target: &core.Node_WorkflowNode{
+			WorkflowNode: &core.WorkflowNode{
+				Reference: &core.WorkflowNode_SubWorkflowRef{
+					SubWorkflowRef: graphSubWorkflowID,
+				},
+			},
+		},
+	}
+	nodes, _ := GetWorkflowGraph(closure)
+	assert.Len(t, nodes, 10)
+	assert.Equal(t, interfaces.WorkflowGraphNodeKindWorkflow, nodes[6].Kind)
+	assert.Equal(t, "sub/lp", nodes[7].ID)
+}
+
+func TestToWorkflowGraphDot(t *testing.T) {
+	nodes, edges := GetWorkflowGraph(getGraphTestClosure())
+	assert.Equal(t, `digraph "name" {
+	"start-node" [label="start-node", shape=circle];
+	subgraph "cluster_branch" {
+		label="branch";
+		"branch" [label="branch", shape=diamond];
+		"branch/then" [label="then", shape=box];
+		"branch/else" [label="else", shape=box];
+	}
+	subgraph "cluster_sub" {
+		label="sub";
+		"sub" [label="sub", shape=component];
+		"sub/start-node" [label="start-node", shape=circle];
+		"sub/t" [label="t", shape=box];
+		"sub/lp" [label="lp", shape=component];
+		"sub/end-node" [label="end-node", shape=circle];
+	}
+	"end-node" [label="end-node", shape=circle];
+	"sub/lp" -> "sub/end-node";
+	"sub/start-node" -> "sub/t";
+	"sub/t" -> "sub/lp";
+	"branch" -> "sub";
+	"start-node" -> "branch";
+	"sub" -> "end-node";
+}
+`, ToWorkflowGraphDot("name", nodes, edges))
+}
@@ -0,0 +1,127 @@
+package validation
+
+import (
+	"strings"
+
+	notificationInterfaces "github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+)
+
+// Project-domain attributes listing the comma-separated recipients notified of every execution in the project and
+// domain, on top of those configured for the launch plan or the execution.
+const (
+	DefaultEmailRecipientsAttribute     = "notifications." + interfaces.EmailNotificationChannel
+	DefaultSlackRecipientsAttribute     = "notifications." + interfaces.SlackNotificationChannel
+	DefaultPagerDutyRecipientsAttribute = "notifications." + interfaces.PagerDutyNotificationChannel
+	// The comma-separated phases default recipients are notified of, failures and time outs when unset.
+	DefaultNotificationPhasesAttribute = "notifications.phases"
+)
+
+// Project-domain attributes listing the comma-separated names of the configured webhooks notified of every execution in
+// the project and domain, through channels flyteidl has no notification type for at this version. Webhooks are
+// notified of the phases default recipients are notified of, regardless of quiet hours.
+const (
+	DefaultTeamsWebhooksAttribute = "notifications.teams"
+	DefaultWebhooksAttribute      = "notifications.webhook"
+)
+
+// Webhooks notified of executions, published with the notification type of their channel.
+type WebhookNotification struct {
+	NotificationType string
+	Phases           []core.WorkflowExecution_Phase
+	Webhooks         []string
+}
+
+var defaultNotificationPhases = []core.WorkflowExecution_Phase{
+	core.WorkflowExecution_FAILED,
+	core.WorkflowExecution_TIMED_OUT,
+}
+
+func splitAttribute(value string) []string {
+	values := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+func getDefaultNotificationPhases(attributes map[string]string) ([]core.WorkflowExecution_Phase, error) {
+	value, ok := attributes[DefaultNotificationPhasesAttribute]
+	if !ok {
+		return defaultNotificationPhases, nil
+	}
+	phases := make([]core.WorkflowExecution_Phase, 0)
+	for _, phaseName := range splitAttribute(value) {
+		phase, ok := core.WorkflowExecution_Phase_value[strings.ToUpper(phaseName)]
+		if !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid phase %s for attribute [%s]", phaseName, DefaultNotificationPhasesAttribute)
+		}
+		phases = append(phases, core.WorkflowExecution_Phase(phase))
+	}
+	if len(phases) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"missing phases for attribute [%s]", DefaultNotificationPhasesAttribute)
+	}
+	return phases, nil
+}
+
+// Reads the notifications the attributes of a project and domain send to default recipients, one per channel.
+func GetDefaultNotifications(attributes map[string]string) ([]*admin.Notification, error) {
+	phases, err := getDefaultNotificationPhases(attributes)
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]*admin.Notification, 0)
+	if recipients := splitAttribute(attributes[DefaultEmailRecipientsAttribute]); len(recipients) > 0 {
+		notifications = append(notifications, &admin.Notification{
+			Phases: phases,
+			Type:   &admin.Notification_Email{Email: &admin.EmailNotification{RecipientsEmail: recipients}},
+		})
+	}
+	if recipients := splitAttribute(attributes[DefaultSlackRecipientsAttribute]); len(recipients) > 0 {
+		notifications = append(notifications, &admin.Notification{
+			Phases: phases,
+			Type:   &admin.Notification_Slack{Slack: &admin.SlackNotification{RecipientsEmail: recipients}},
+		})
+	}
+	if recipients := splitAttribute(attributes[DefaultPagerDutyRecipientsAttribute]); len(recipients) > 0 {
+		notifications = append(notifications, &admin.Notification{
+			Phases: phases,
+			Type: &admin.Notification_PagerDuty{
+				PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: recipients}},
+		})
+	}
+	return notifications, nil
+}
+
+// Reads the webhooks the attributes of a project and domain notify, one notification per channel.
+func GetDefaultWebhookNotifications(attributes map[string]string) ([]WebhookNotification, error) {
+	phases, err := getDefaultNotificationPhases(attributes)
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]WebhookNotification, 0)
+	for _, channel := range []struct {
+		attribute        string
+		notificationType string
+	}{
+		{DefaultTeamsWebhooksAttribute, notificationInterfaces.TeamsNotificationType},
+		{DefaultWebhooksAttribute, notificationInterfaces.WebhookNotificationType},
+	} {
+		if webhooks := splitAttribute(attributes[channel.attribute]); len(webhooks) > 0 {
+			notifications = append(notifications, WebhookNotification{
+				NotificationType: channel.notificationType,
+				Phases:           phases,
+				Webhooks:         webhooks,
+			})
+		}
+	}
+	return notifications, nil
+}
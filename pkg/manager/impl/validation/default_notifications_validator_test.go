@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDefaultNotifications(t *testing.T) {
+	notifications, err := GetDefaultNotifications(map[string]string{
+		DefaultEmailRecipientsAttribute:     "a@example.com, b@example.com",
+		DefaultPagerDutyRecipientsAttribute: "oncall@example.com",
+		DefaultNotificationPhasesAttribute:  "failed,succeeded",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 2)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, notifications[0].GetEmail().RecipientsEmail)
+	assert.Equal(t, []string{"oncall@example.com"}, notifications[1].GetPagerDuty().RecipientsEmail)
+	assert.Equal(t, []core.WorkflowExecution_Phase{
+		core.WorkflowExecution_FAILED, core.WorkflowExecution_SUCCEEDED}, notifications[1].Phases)
+}
+
+func TestGetDefaultNotifications_DefaultPhases(t *testing.T) {
+	notifications, err := GetDefaultNotifications(map[string]string{
+		DefaultSlackRecipientsAttribute: "channel@example.com",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, []string{"channel@example.com"}, notifications[0].GetSlack().RecipientsEmail)
+	assert.Equal(t, defaultNotificationPhases, notifications[0].Phases)
+}
+
+func TestGetDefaultNotifications_Unset(t *testing.T) {
+	notifications, err := GetDefaultNotifications(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, notifications)
+}
+
+func TestGetDefaultNotifications_InvalidPhase(t *testing.T) {
+	_, err := GetDefaultNotifications(map[string]string{
+		DefaultNotificationPhasesAttribute: "failed,broken",
+	})
+	assert.EqualError(t, err, "invalid phase broken for attribute [notifications.phases]")
+
+	_, err = GetDefaultNotifications(map[string]string{
+		DefaultNotificationPhasesAttribute: " , ",
+	})
+	assert.EqualError(t, err, "missing phases for attribute [notifications.phases]")
+}
+
+func TestGetDefaultWebhookNotifications(t *testing.T) {
+	notifications, err := GetDefaultWebhookNotifications(map[string]string{
+		DefaultTeamsWebhooksAttribute:      "data-eng",
+		DefaultWebhooksAttribute:           "audit, incidents",
+		DefaultNotificationPhasesAttribute: "failed",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []WebhookNotification{
+		{
+			NotificationType: "flyteadmin.TeamsNotification",
+			Phases:           []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+			Webhooks:         []string{"data-eng"},
+		},
+		{
+			NotificationType: "flyteadmin.WebhookNotification",
+			Phases:           []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+			Webhooks:         []string{"audit", "incidents"},
+		},
+	}, notifications)
+
+	notifications, err = GetDefaultWebhookNotifications(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, notifications)
+}
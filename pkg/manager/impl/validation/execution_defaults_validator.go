@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Project-domain attributes with these prefixes followed by a key set the label or annotation with that key on
+// executions in the project and domain, e.g. default_label.team=ml, unless the execution or its launch plan sets it.
+const (
+	DefaultLabelAttributePrefix      = "default_label."
+	DefaultAnnotationAttributePrefix = "default_annotation."
+)
+
+// Project-domain attributes setting the permissions of executions in the project and domain whose launch plan sets
+// neither an IAM role nor a kubernetes service account.
+const (
+	DefaultAssumableIamRoleAttribute         = "default_auth.assumable_iam_role"
+	DefaultKubernetesServiceAccountAttribute = "default_auth.kubernetes_service_account"
+)
+
+func getPrefixedAttributes(attributes map[string]string, prefix string) map[string]string {
+	values := make(map[string]string)
+	for key, value := range attributes {
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			values[strings.TrimPrefix(key, prefix)] = value
+		}
+	}
+	return values
+}
+
+// Returns the labels set by the default_label. attributes of a project and domain.
+func GetProjectDomainDefaultLabels(attributes map[string]string) map[string]string {
+	return getPrefixedAttributes(attributes, DefaultLabelAttributePrefix)
+}
+
+// Returns the annotations set by the default_annotation. attributes of a project and domain.
+func GetProjectDomainDefaultAnnotations(attributes map[string]string) map[string]string {
+	return getPrefixedAttributes(attributes, DefaultAnnotationAttributePrefix)
+}
+
+// Returns the permissions set by the default_auth. attributes of a project and domain, which is nil when neither is
+// set. An IAM role takes precedence over a service account, as it does when set by launch plans.
+func GetProjectDomainDefaultAuth(attributes map[string]string) *admin.Auth {
+	if role := attributes[DefaultAssumableIamRoleAttribute]; len(role) > 0 {
+		return &admin.Auth{AssumableIamRole: role}
+	}
+	if serviceAccount := attributes[DefaultKubernetesServiceAccountAttribute]; len(serviceAccount) > 0 {
+		return &admin.Auth{KubernetesServiceAccount: serviceAccount}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProjectDomainDefaultLabelsAndAnnotations(t *testing.T) {
+	attributes := map[string]string{
+		"default_label.team":                     "ml",
+		"default_label.":                         "ignored",
+		"default_annotation.cost-center":         "42",
+		"default_input.bucket":                   "s3://bucket",
+		MaxConcurrentExecutionsAttribute:         "10",
+		DefaultKubernetesServiceAccountAttribute: "sa",
+	}
+	assert.Equal(t, map[string]string{"team": "ml"}, GetProjectDomainDefaultLabels(attributes))
+	assert.Equal(t, map[string]string{"cost-center": "42"}, GetProjectDomainDefaultAnnotations(attributes))
+	assert.Empty(t, GetProjectDomainDefaultLabels(nil))
+}
+
+func TestGetProjectDomainDefaultAuth(t *testing.T) {
+	assert.Nil(t, GetProjectDomainDefaultAuth(nil))
+	assert.Equal(t, &admin.Auth{KubernetesServiceAccount: "sa"}, GetProjectDomainDefaultAuth(map[string]string{
+		DefaultKubernetesServiceAccountAttribute: "sa",
+	}))
+	assert.Equal(t, &admin.Auth{AssumableIamRole: "role"}, GetProjectDomainDefaultAuth(map[string]string{
+		DefaultAssumableIamRoleAttribute:         "role",
+		DefaultKubernetesServiceAccountAttribute: "sa",
+	}))
+}
@@ -3,7 +3,11 @@ package validation
 import (
 	"context"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/lyft/flyteadmin/pkg/repositories"
 
@@ -18,10 +22,30 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-const allowedExecutionNameLength = 20
+const allowedExecutionNameLength = common.MaxExecutionNameLength
+
+// Names generated from execution name templates end with a dash and a random suffix of at least this length, so that
+// they're unlikely to collide.
+const minExecutionNameSuffixLength = 6
+
+const maxTags = 32
+const maxTagLength = 128
+
+const maxEnvironmentVariableEntries = 32
+const maxEnvironmentVariablesSize = 4096
+
+// Variables with this prefix are set by the platform itself and can't be overridden by an execution.
+const reservedEnvironmentVariablePrefix = "FLYTE_"
+
+// Project-domain attributes with this prefix followed by an input name supply the value of the input to executions in
+// the project and domain which neither set the input nor have a launch plan default for it, e.g.
+// default_input.output_bucket=s3://my-bucket. Values are parsed according to the input's simple type.
+const DefaultInputAttributePrefix = "default_input."
 
 var executionIDRegex = regexp.MustCompile(`^[a-z][a-z\-0-9]*$`)
 
+var environmentVariableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 func ValidateExecutionRequest(ctx context.Context, request admin.ExecutionCreateRequest,
 	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration) error {
 	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
@@ -39,7 +63,7 @@ func ValidateExecutionRequest(ctx context.Context, request admin.ExecutionCreate
 		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"name for ExecutionCreateRequest [%+v] exceeded allowed length %d", request, allowedExecutionNameLength)
 	}
-	if err := ValidateProjectAndDomain(ctx, db, config, request.Project, request.Domain); err != nil {
+	if err := ValidateActiveProjectAndDomain(ctx, db, config, request.Project, request.Domain); err != nil {
 		return err
 	}
 
@@ -52,6 +76,21 @@ func ValidateExecutionRequest(ctx context.Context, request admin.ExecutionCreate
 	if err := validateLiteralMap(request.Inputs, shared.Inputs); err != nil {
 		return err
 	}
+	if err := ValidateOutputDataPrefix(
+		common.GetOutputDataPrefix(request.Spec), config.GetTopLevelConfig().AllowedOutputDataPrefixes); err != nil {
+		return err
+	}
+	if err := ValidateEnvironmentVariables(common.GetEnvironmentVariables(request.Spec)); err != nil {
+		return err
+	}
+	if err := ValidateTags(common.GetTags(request.Spec)); err != nil {
+		return err
+	}
+	if classification := common.GetDataClassification(request.Spec); !common.IsValidDataClassification(classification) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid data classification [%s], expected one of %s, %s or %s", classification,
+			common.DataClassificationPublic, common.DataClassificationInternal, common.DataClassificationRestricted)
+	}
 	// TODO: Remove redundant validation with the rest of the method.
 	// This final call to validating the request ensures the notification types are expected.
 	if err := request.Validate(); err != nil {
@@ -60,8 +99,79 @@ func ValidateExecutionRequest(ctx context.Context, request admin.ExecutionCreate
 	return nil
 }
 
+func makePrimitiveLiteral(primitive *core.Primitive) *core.Literal {
+	return &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: primitive,
+				},
+			},
+		},
+	}
+}
+
+// Parses the value of a default_input. project-domain attribute as a literal of the input's type.
+func getDefaultInputLiteral(name, value string, literalType *core.LiteralType) (*core.Literal, error) {
+	var primitive core.Primitive
+	var err error
+	switch literalType.GetSimple() {
+	case core.SimpleType_STRING:
+		primitive.Value = &core.Primitive_StringValue{StringValue: value}
+	case core.SimpleType_INTEGER:
+		var integer int64
+		if integer, err = strconv.ParseInt(value, 10, 64); err == nil {
+			primitive.Value = &core.Primitive_Integer{Integer: integer}
+		}
+	case core.SimpleType_FLOAT:
+		var float float64
+		if float, err = strconv.ParseFloat(value, 64); err == nil {
+			primitive.Value = &core.Primitive_FloatValue{FloatValue: float}
+		}
+	case core.SimpleType_BOOLEAN:
+		var boolean bool
+		if boolean, err = strconv.ParseBool(value); err == nil {
+			primitive.Value = &core.Primitive_Boolean{Boolean: boolean}
+		}
+	case core.SimpleType_DATETIME:
+		var datetime time.Time
+		if datetime, err = time.Parse(time.RFC3339, value); err == nil {
+			datetimeValue := &core.Primitive_Datetime{}
+			datetimeValue.Datetime, err = ptypes.TimestampProto(datetime)
+			primitive.Value = datetimeValue
+		}
+	case core.SimpleType_DURATION:
+		var duration time.Duration
+		if duration, err = time.ParseDuration(value); err == nil {
+			primitive.Value = &core.Primitive_Duration{Duration: ptypes.DurationProto(duration)}
+		}
+	default:
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"project-domain default for input %s can't be used, only inputs of primitive types can be defaulted", name)
+	}
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid project-domain default [%s] for input %s of type %s", value, name, literalType.GetSimple())
+	}
+	return makePrimitiveLiteral(&primitive), nil
+}
+
+// Returns the value a project-domain default_input. attribute supplies for an expected input which an execution doesn't
+// set, which is nil when the launch plan has a default for the input or no attribute is set for it.
+func GetProjectDomainDefaultInput(
+	name string, expectedInput *core.Parameter, projectDomainAttributes map[string]string) (*core.Literal, error) {
+	value, ok := projectDomainAttributes[DefaultInputAttributePrefix+name]
+	if !ok || expectedInput.GetDefault() != nil {
+		return nil, nil
+	}
+	return getDefaultInputLiteral(name, value, expectedInput.GetVar().GetType())
+}
+
+// Returns the execution inputs, which are the user inputs, falling back to the project-domain defaults set by the
+// default_input. attributes and then to the launch plan defaults, along with the launch plan's fixed inputs.
 func CheckAndFetchInputsForExecution(
-	userInputs *core.LiteralMap, fixedInputs *core.LiteralMap, expectedInputs *core.ParameterMap) (*core.LiteralMap, error) {
+	userInputs *core.LiteralMap, fixedInputs *core.LiteralMap, expectedInputs *core.ParameterMap,
+	projectDomainAttributes map[string]string) (*core.LiteralMap, error) {
 
 	executionInputMap := map[string]*core.Literal{}
 	expectedInputMap := map[string]*core.Parameter{}
@@ -81,6 +191,14 @@ func CheckAndFetchInputsForExecution(
 
 	for name, expectedInput := range expectedInputMap {
 		if _, ok := executionInputMap[name]; !ok {
+			defaultInput, err := GetProjectDomainDefaultInput(name, expectedInput, projectDomainAttributes)
+			if err != nil {
+				return nil, err
+			}
+			if defaultInput != nil {
+				executionInputMap[name] = defaultInput
+				continue
+			}
 			if expectedInput.GetRequired() {
 				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s %s missing", shared.ExpectedInputs, name)
 			}
@@ -107,6 +225,135 @@ func CheckAndFetchInputsForExecution(
 	}, nil
 }
 
+// An output data prefix override is only valid when it falls under one of the allowed prefixes. Prefixes match on
+// path segment boundaries, so s3://team-bucket doesn't allow s3://team-bucket-other, and overrides can't climb out of
+// an allowed prefix with ".." segments.
+func ValidateOutputDataPrefix(outputDataPrefix string, allowedPrefixes []string) error {
+	if len(outputDataPrefix) == 0 {
+		return nil
+	}
+	for _, segment := range strings.Split(outputDataPrefix, "/") {
+		if segment == ".." {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"output data prefix [%s] can't contain [..] path segments", outputDataPrefix)
+		}
+	}
+	for _, allowedPrefix := range allowedPrefixes {
+		if len(allowedPrefix) == 0 {
+			continue
+		}
+		allowedPrefix = strings.TrimSuffix(allowedPrefix, "/")
+		if outputDataPrefix == allowedPrefix || strings.HasPrefix(outputDataPrefix, allowedPrefix+"/") {
+			return nil
+		}
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		"output data prefix [%s] is not under any of the allowed prefixes", outputDataPrefix)
+}
+
+// A cluster assignment must name an enabled execution cluster which accepts executions for the domain.
+func ValidateClusterAssignment(cluster, domain string, clusters []runtimeInterfaces.ClusterConfig) error {
+	if len(cluster) == 0 {
+		return nil
+	}
+	for _, clusterConfig := range clusters {
+		if clusterConfig.Name != cluster {
+			continue
+		}
+		if !clusterConfig.Enabled {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "execution cluster [%s] is disabled", cluster)
+		}
+		if len(clusterConfig.AllowedDomains) == 0 {
+			return nil
+		}
+		for _, allowedDomain := range clusterConfig.AllowedDomains {
+			if allowedDomain == domain {
+				return nil
+			}
+		}
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"execution cluster [%s] does not accept executions for domain [%s]", cluster, domain)
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unrecognized execution cluster [%s]", cluster)
+}
+
+// Execution environment variable overrides must be valid, non-reserved names and fit within the size limits.
+func ValidateEnvironmentVariables(environmentVariables map[string]string) error {
+	if len(environmentVariables) > maxEnvironmentVariableEntries {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "too many environment variables [%d > %d]",
+			len(environmentVariables), maxEnvironmentVariableEntries)
+	}
+	size := 0
+	for name, value := range environmentVariables {
+		if !environmentVariableNameRegex.MatchString(name) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid environment variable name [%s]", name)
+		}
+		if strings.HasPrefix(strings.ToUpper(name), reservedEnvironmentVariablePrefix) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"environment variable [%s] uses the reserved prefix %s", name, reservedEnvironmentVariablePrefix)
+		}
+		size += len(name) + len(value)
+	}
+	if size > maxEnvironmentVariablesSize {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"environment variables exceed the maximum size [%d > %d bytes]", size, maxEnvironmentVariablesSize)
+	}
+	return nil
+}
+
+// Tags are free-form, however they're bounded in number and length and, since they're recorded as a comma-separated
+// annotation, can't contain commas.
+func ValidateTags(tags []string) error {
+	if len(tags) > maxTags {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "too many tags [%d > %d]", len(tags), maxTags)
+	}
+	for _, tag := range tags {
+		if len(strings.TrimSpace(tag)) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "tags can't be empty")
+		}
+		if len(tag) > maxTagLength {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"tag [%s] exceeds the maximum length of %d", tag, maxTagLength)
+		}
+		if strings.Contains(tag, ",") {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "tag [%s] can't contain commas", tag)
+		}
+	}
+	return nil
+}
+
+// Asserts that the prefix an execution name template rendered to leaves room for the random suffix of the names
+// generated from it, and that those are valid kubernetes resource names.
+func ValidateExecutionNamePrefix(prefix, template string) error {
+	if len(prefix)+1+minExecutionNameSuffixLength > allowedExecutionNameLength {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"execution name template [%s] leaves less than %d characters for the random suffix of names", template,
+			minExecutionNameSuffixLength)
+	}
+	if !executionIDRegex.MatchString(prefix) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"execution name template [%s] must render to a name starting with a lowercase letter: %s", template, prefix)
+	}
+	return nil
+}
+
+// Validates the execution name template of a project or domain. The launch plans executions are created from aren't
+// known yet, so the template is rendered for a launch plan with a single character name and version here and
+// GetExecutionName validates the actual prefix as executions are named.
+func ValidateExecutionNameTemplate(template, project, domain string) error {
+	if err := ValidateEmptyStringField(template, shared.ExecutionNameTemplate); err != nil {
+		return err
+	}
+	prefix, err := common.RenderExecutionNameTemplate(template, common.ExecutionNameTemplateInput{
+		LaunchPlan:  &core.Identifier{Project: project, Domain: domain, Name: "a", Version: "a"},
+		KickoffTime: executionNameTemplateKickoffTime,
+	})
+	if err != nil {
+		return err
+	}
+	return ValidateExecutionNamePrefix(prefix, template)
+}
+
 func CheckValidExecutionID(executionID, fieldName string) error {
 	if len(executionID) > allowedExecutionNameLength {
 		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
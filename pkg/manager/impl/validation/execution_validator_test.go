@@ -3,11 +3,14 @@ package validation
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
 
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flytepropeller/pkg/utils"
@@ -73,6 +76,7 @@ func TestGetExecutionInputs(t *testing.T) {
 		executionRequest.Inputs,
 		lpRequest.Spec.FixedInputs,
 		lpRequest.Spec.DefaultInputs,
+		nil,
 	)
 	expectedMap := core.LiteralMap{
 		Literals: map[string]*core.Literal{
@@ -97,6 +101,7 @@ func TestValidateExecInputsWrongType(t *testing.T) {
 		executionRequest.Inputs,
 		lpRequest.Spec.FixedInputs,
 		lpRequest.Spec.DefaultInputs,
+		nil,
 	)
 	assert.EqualError(t, err, "invalid foo input wrong type")
 }
@@ -114,6 +119,7 @@ func TestValidateExecInputsExtraInputs(t *testing.T) {
 		executionRequest.Inputs,
 		lpRequest.Spec.FixedInputs,
 		lpRequest.Spec.DefaultInputs,
+		nil,
 	)
 	assert.EqualError(t, err, "invalid input foo-extra")
 }
@@ -131,6 +137,7 @@ func TestValidateExecInputsOverrideFixed(t *testing.T) {
 		executionRequest.Inputs,
 		lpRequest.Spec.FixedInputs,
 		lpRequest.Spec.DefaultInputs,
+		nil,
 	)
 	assert.EqualError(t, err, "invalid input bar")
 }
@@ -143,6 +150,7 @@ func TestValidateExecEmptyInputs(t *testing.T) {
 		executionRequest.Inputs,
 		lpRequest.Spec.FixedInputs,
 		lpRequest.Spec.DefaultInputs,
+		nil,
 	)
 	expectedMap := core.LiteralMap{
 		Literals: map[string]*core.Literal{
@@ -160,6 +168,97 @@ func TestValidExecutionId(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func getSimpleParameter(simpleType core.SimpleType, required bool) *core.Parameter {
+	parameter := &core.Parameter{
+		Var: &core.Variable{
+			Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: simpleType}},
+		},
+	}
+	if required {
+		parameter.Behavior = &core.Parameter_Required{Required: true}
+	}
+	return parameter
+}
+
+func TestGetExecutionInputs_ProjectDomainDefaults(t *testing.T) {
+	executionRequest := testutils.GetExecutionRequest()
+	lpRequest := testutils.GetLaunchPlanRequest()
+	lpRequest.Spec.DefaultInputs.Parameters["bucket"] = getSimpleParameter(core.SimpleType_STRING, true)
+	lpRequest.Spec.DefaultInputs.Parameters["retries"] = getSimpleParameter(core.SimpleType_INTEGER, false)
+	lpRequest.Spec.DefaultInputs.Parameters["profile"] = getSimpleParameter(core.SimpleType_STRING, false)
+	executionRequest.Inputs.Literals["profile"] = utils.MustMakeLiteral("user-profile")
+
+	actualInputs, err := CheckAndFetchInputsForExecution(
+		executionRequest.Inputs,
+		lpRequest.Spec.FixedInputs,
+		lpRequest.Spec.DefaultInputs,
+		map[string]string{
+			"default_input.bucket":  "s3://bucket",
+			"default_input.retries": "3",
+			"default_input.profile": "project-profile",
+			"default_input.foo":     "project-foo",
+		},
+	)
+	assert.NoError(t, err)
+	expectedMap := core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"foo":     utils.MustMakeLiteral("foo-value-1"),
+			"bar":     utils.MustMakeLiteral("bar-value"),
+			"bucket":  utils.MustMakeLiteral("s3://bucket"),
+			"retries": utils.MustMakeLiteral(3),
+			"profile": utils.MustMakeLiteral("user-profile"),
+		},
+	}
+	assert.EqualValues(t, expectedMap, *actualInputs)
+
+	// Launch plan defaults take precedence over project-domain defaults.
+	executionRequest.Inputs = nil
+	actualInputs, err = CheckAndFetchInputsForExecution(
+		executionRequest.Inputs,
+		lpRequest.Spec.FixedInputs,
+		lpRequest.Spec.DefaultInputs,
+		map[string]string{
+			"default_input.bucket": "s3://bucket",
+			"default_input.foo":    "project-foo",
+		},
+	)
+	assert.NoError(t, err)
+	assert.EqualValues(t, utils.MustMakeLiteral("foo-value"), actualInputs.Literals["foo"])
+}
+
+func TestGetExecutionInputs_InvalidProjectDomainDefaults(t *testing.T) {
+	executionRequest := testutils.GetExecutionRequest()
+	lpRequest := testutils.GetLaunchPlanRequest()
+	lpRequest.Spec.DefaultInputs.Parameters["retries"] = getSimpleParameter(core.SimpleType_INTEGER, true)
+	_, err := CheckAndFetchInputsForExecution(
+		executionRequest.Inputs,
+		lpRequest.Spec.FixedInputs,
+		lpRequest.Spec.DefaultInputs,
+		map[string]string{
+			"default_input.retries": "three",
+		},
+	)
+	assert.EqualError(t, err, "invalid project-domain default [three] for input retries of type INTEGER")
+
+	lpRequest.Spec.DefaultInputs.Parameters["retries"] = &core.Parameter{
+		Var: &core.Variable{
+			Type: &core.LiteralType{Type: &core.LiteralType_CollectionType{
+				CollectionType: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}},
+			}},
+		},
+	}
+	_, err = CheckAndFetchInputsForExecution(
+		executionRequest.Inputs,
+		lpRequest.Spec.FixedInputs,
+		lpRequest.Spec.DefaultInputs,
+		map[string]string{
+			"default_input.retries": "3",
+		},
+	)
+	assert.EqualError(t, err,
+		"project-domain default for input retries can't be used, only inputs of primitive types can be defaulted")
+}
+
 func TestValidExecutionIdInvalidLength(t *testing.T) {
 	err := CheckValidExecutionID("abcdeasdasdasdasdasdasdasd123", "a")
 	assert.NotNil(t, err)
@@ -222,3 +321,119 @@ func TestValidateWorkflowExecutionIdentifier_Error(t *testing.T) {
 		Name:   "name",
 	}))
 }
+
+func TestValidateOutputDataPrefix(t *testing.T) {
+	allowedPrefixes := []string{"s3://team-bucket/", "gs://shared/flyte/"}
+	assert.Nil(t, ValidateOutputDataPrefix("", nil))
+	assert.Nil(t, ValidateOutputDataPrefix("s3://team-bucket/data", allowedPrefixes))
+	assert.Nil(t, ValidateOutputDataPrefix("gs://shared/flyte/team", allowedPrefixes))
+	assert.EqualError(t, ValidateOutputDataPrefix("s3://other-bucket/data", allowedPrefixes),
+		"output data prefix [s3://other-bucket/data] is not under any of the allowed prefixes")
+	assert.EqualError(t, ValidateOutputDataPrefix("s3://team-bucket/data", nil),
+		"output data prefix [s3://team-bucket/data] is not under any of the allowed prefixes")
+}
+
+func TestValidateOutputDataPrefix_SegmentBoundary(t *testing.T) {
+	allowedPrefixes := []string{"s3://team-bucket", "gs://shared/flyte/"}
+	assert.Nil(t, ValidateOutputDataPrefix("s3://team-bucket", allowedPrefixes))
+	assert.Nil(t, ValidateOutputDataPrefix("s3://team-bucket/data", allowedPrefixes))
+	assert.Nil(t, ValidateOutputDataPrefix("gs://shared/flyte", allowedPrefixes))
+	assert.EqualError(t, ValidateOutputDataPrefix("s3://team-bucket-other/data", allowedPrefixes),
+		"output data prefix [s3://team-bucket-other/data] is not under any of the allowed prefixes")
+	assert.EqualError(t, ValidateOutputDataPrefix("gs://shared/flyteother", allowedPrefixes),
+		"output data prefix [gs://shared/flyteother] is not under any of the allowed prefixes")
+}
+
+func TestValidateOutputDataPrefix_ParentSegments(t *testing.T) {
+	allowedPrefixes := []string{"s3://team-bucket/"}
+	assert.EqualError(t, ValidateOutputDataPrefix("s3://team-bucket/../other-bucket", allowedPrefixes),
+		"output data prefix [s3://team-bucket/../other-bucket] can't contain [..] path segments")
+	assert.EqualError(t, ValidateOutputDataPrefix("s3://team-bucket/data/..", allowedPrefixes),
+		"output data prefix [s3://team-bucket/data/..] can't contain [..] path segments")
+	assert.Nil(t, ValidateOutputDataPrefix("s3://team-bucket/data..v2", allowedPrefixes))
+}
+
+func TestValidateExecOutputDataPrefixNotAllowed(t *testing.T) {
+	request := testutils.GetExecutionRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"flyte.lyft.com/output-data-prefix": "s3://team-bucket/data",
+		},
+	}
+	err := ValidateExecutionRequest(context.Background(), request, testutils.GetRepoWithDefaultProject(), execConfig)
+	assert.EqualError(t, err, "output data prefix [s3://team-bucket/data] is not under any of the allowed prefixes")
+}
+
+func TestValidateClusterAssignment(t *testing.T) {
+	clusters := []runtimeInterfaces.ClusterConfig{
+		{
+			Name:    "shared",
+			Enabled: true,
+		},
+		{
+			Name:           "production",
+			Enabled:        true,
+			AllowedDomains: []string{"production"},
+		},
+		{
+			Name: "decommissioned",
+		},
+	}
+	assert.Nil(t, ValidateClusterAssignment("", "development", nil))
+	assert.Nil(t, ValidateClusterAssignment("shared", "development", clusters))
+	assert.Nil(t, ValidateClusterAssignment("production", "production", clusters))
+	assert.EqualError(t, ValidateClusterAssignment("production", "development", clusters),
+		"execution cluster [production] does not accept executions for domain [development]")
+	assert.EqualError(t, ValidateClusterAssignment("decommissioned", "development", clusters),
+		"execution cluster [decommissioned] is disabled")
+	assert.EqualError(t, ValidateClusterAssignment("unknown", "development", clusters),
+		"unrecognized execution cluster [unknown]")
+}
+
+func TestValidateTags(t *testing.T) {
+	assert.Nil(t, ValidateTags(nil))
+	assert.Nil(t, ValidateTags([]string{"nightly", "team: data"}))
+	assert.EqualError(t, ValidateTags([]string{" "}), "tags can't be empty")
+	assert.EqualError(t, ValidateTags([]string{"a,b"}), "tag [a,b] can't contain commas")
+	longTag := strings.Repeat("t", maxTagLength+1)
+	assert.EqualError(t, ValidateTags([]string{longTag}),
+		fmt.Sprintf("tag [%s] exceeds the maximum length of %d", longTag, maxTagLength))
+	tooManyTags := make([]string, maxTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = fmt.Sprintf("tag-%d", i)
+	}
+	assert.EqualError(t, ValidateTags(tooManyTags), "too many tags [33 > 32]")
+}
+
+func TestValidateEnvironmentVariables(t *testing.T) {
+	assert.Nil(t, ValidateEnvironmentVariables(nil))
+	assert.Nil(t, ValidateEnvironmentVariables(map[string]string{
+		"EXPERIMENT_ID": "42",
+		"_private":      "",
+	}))
+	assert.EqualError(t, ValidateEnvironmentVariables(map[string]string{"1BAD": "foo"}),
+		"invalid environment variable name [1BAD]")
+	assert.EqualError(t, ValidateEnvironmentVariables(map[string]string{"BAD-NAME": "foo"}),
+		"invalid environment variable name [BAD-NAME]")
+	assert.EqualError(t, ValidateEnvironmentVariables(map[string]string{"FLYTE_INTERNAL": "foo"}),
+		"environment variable [FLYTE_INTERNAL] uses the reserved prefix FLYTE_")
+	assert.EqualError(t, ValidateEnvironmentVariables(map[string]string{"BIG": strings.Repeat("a", 4096)}),
+		"environment variables exceed the maximum size [4099 > 4096 bytes]")
+
+	tooMany := make(map[string]string)
+	for i := 0; i <= maxEnvironmentVariableEntries; i++ {
+		tooMany[fmt.Sprintf("VAR_%d", i)] = "foo"
+	}
+	assert.EqualError(t, ValidateEnvironmentVariables(tooMany), "too many environment variables [33 > 32]")
+}
+
+func TestValidateExecInvalidEnvironmentVariable(t *testing.T) {
+	request := testutils.GetExecutionRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"env.flyte.lyft.com/NOT-VALID": "foo",
+		},
+	}
+	err := ValidateExecutionRequest(context.Background(), request, testutils.GetRepoWithDefaultProject(), execConfig)
+	assert.EqualError(t, err, "invalid environment variable name [NOT-VALID]")
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+)
+
+// Project-domain attribute listing the comma-separated registries, optionally followed by a repository path, which
+// the container images of the tasks registered and executed in the project and domain must come from.
+const AllowedImageRegistriesAttribute = "security.allowed_image_registries"
+
+// Reads the registries allowed in the attributes of a project and domain. Any registry is allowed when unset.
+func GetAllowedImageRegistries(attributes map[string]string) ([]string, error) {
+	value, ok := attributes[AllowedImageRegistriesAttribute]
+	if !ok {
+		return nil, nil
+	}
+	registries := splitAttribute(value)
+	if len(registries) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"no registries listed in attribute [%s]", AllowedImageRegistriesAttribute)
+	}
+	for i, registry := range registries {
+		if strings.Contains(registry, "://") {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid registry %s in attribute [%s], registries must not include a scheme", registry,
+				AllowedImageRegistriesAttribute)
+		}
+		registries[i] = strings.TrimSuffix(registry, "/")
+	}
+	return registries, nil
+}
+
+// An image comes from a registry when it's prefixed by the registry followed by a path separator, so that allowing
+// docker.io doesn't allow docker.io.example.com as well.
+func isImageFromRegistry(image, registry string) bool {
+	return strings.HasPrefix(image, registry+"/")
+}
+
+// Asserts that the container image of a task comes from one of the allowed registries, if any.
+func ValidateTaskImage(template *core.TaskTemplate, allowedRegistries []string) error {
+	image := template.GetContainer().GetImage()
+	if len(allowedRegistries) == 0 || len(image) == 0 {
+		return nil
+	}
+	for _, registry := range allowedRegistries {
+		if isImageFromRegistry(image, registry) {
+			return nil
+		}
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		"image %s of task [%+v] doesn't come from any of the allowed registries: %s", image, template.Id,
+		strings.Join(allowedRegistries, ", "))
+}
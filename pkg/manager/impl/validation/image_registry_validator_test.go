@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTaskTemplateWithImage(image string) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id: &core.Identifier{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Image: image,
+			},
+		},
+	}
+}
+
+func TestGetAllowedImageRegistries(t *testing.T) {
+	registries, err := GetAllowedImageRegistries(map[string]string{
+		AllowedImageRegistriesAttribute: "docker.io/lyft, gcr.io/project/ ,",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docker.io/lyft", "gcr.io/project"}, registries)
+
+	registries, err = GetAllowedImageRegistries(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, registries)
+}
+
+func TestGetAllowedImageRegistries_Invalid(t *testing.T) {
+	for _, value := range []string{"", " , ", "https://docker.io"} {
+		_, err := GetAllowedImageRegistries(map[string]string{AllowedImageRegistriesAttribute: value})
+		assert.Error(t, err, "%s should be rejected", value)
+	}
+}
+
+func TestValidateTaskImage(t *testing.T) {
+	registries := []string{"docker.io/lyft", "gcr.io"}
+	assert.NoError(t, ValidateTaskImage(getTaskTemplateWithImage("docker.io/lyft/flytekit:v1"), registries))
+	assert.NoError(t, ValidateTaskImage(getTaskTemplateWithImage("gcr.io/project/image:v1"), registries))
+	assert.NoError(t, ValidateTaskImage(getTaskTemplateWithImage("image"), nil))
+	assert.NoError(t, ValidateTaskImage(&core.TaskTemplate{}, registries))
+
+	for _, image := range []string{"image", "docker.io/lyftx/image:v1", "gcr.io.example.com/image:v1"} {
+		err := ValidateTaskImage(getTaskTemplateWithImage(image), registries)
+		assert.Error(t, err, "%s should be rejected", image)
+		assert.Contains(t, err.Error(), "docker.io/lyft, gcr.io")
+	}
+}
@@ -0,0 +1,94 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/storage"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Records the URIs referenced by blob and schema literals, including those nested in collections and maps, keyed by
+// the path of the literal within the inputs, e.g. "files[0]" or "tables.users".
+func addLiteralReferences(path string, literal *core.Literal, references map[string]string) {
+	switch {
+	case literal.GetScalar().GetBlob() != nil:
+		references[path] = literal.GetScalar().GetBlob().Uri
+	case literal.GetScalar().GetSchema() != nil:
+		references[path] = literal.GetScalar().GetSchema().Uri
+	case literal.GetCollection() != nil:
+		for index, item := range literal.GetCollection().Literals {
+			addLiteralReferences(fmt.Sprintf("%s[%d]", path, index), item, references)
+		}
+	case literal.GetMap() != nil:
+		for key, value := range literal.GetMap().Literals {
+			addLiteralReferences(fmt.Sprintf("%s.%s", path, key), value, references)
+		}
+	}
+}
+
+func isAllowedReference(reference string, allowedPrefixes ...[]string) bool {
+	for _, prefixes := range allowedPrefixes {
+		for _, prefix := range prefixes {
+			if len(prefix) > 0 && strings.HasPrefix(reference, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Blob and schema inputs may only reference objects under the storage prefixes allowed for the project and, when
+// configured, which exist. Existence is checked through the configured data store, so references to containers it
+// can't reach are reported as missing.
+func ValidateInputReferences(ctx context.Context, project string, inputs *core.LiteralMap, store *storage.DataStore,
+	config runtimeInterfaces.InputReferencesConfig) error {
+	if len(config.AllowedPrefixes) == 0 && len(config.ProjectAllowedPrefixes) == 0 {
+		return nil
+	}
+	references := make(map[string]string)
+	for name, literal := range inputs.GetLiterals() {
+		addLiteralReferences(name, literal, references)
+	}
+	paths := make([]string, 0, len(references))
+	for path := range references {
+		paths = append(paths, path)
+	}
+	// Report problems in a stable order.
+	sort.Strings(paths)
+	for _, path := range paths {
+		reference := references[path]
+		parsedReference, err := url.Parse(reference)
+		if err != nil || parsedReference.Scheme == "" {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"input [%s] references [%s] which is not a blob storage URI", path, reference)
+		}
+		if !isAllowedReference(reference, config.AllowedPrefixes, config.ProjectAllowedPrefixes[project]) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"input [%s] references [%s] which is not under any of the prefixes allowed for project [%s]",
+				path, reference, project)
+		}
+		if !config.CheckExistence {
+			continue
+		}
+		metadata, err := store.Head(ctx, storage.DataReference(reference))
+		if err != nil {
+			return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+				"failed to check input [%s] reference [%s] in bucket [%s] with err: %v",
+				path, reference, parsedReference.Host, err)
+		}
+		if !metadata.Exists() {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"input [%s] references [%s] which does not exist or is not reachable in bucket [%s]",
+				path, reference, parsedReference.Host)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+type referenceMetadata struct {
+	exists bool
+}
+
+func (m referenceMetadata) Exists() bool {
+	return m.exists
+}
+
+func (m referenceMetadata) Size() int64 {
+	return 0
+}
+
+var inputReferencesConfig = runtimeInterfaces.InputReferencesConfig{
+	AllowedPrefixes: []string{"s3://shared-bucket/"},
+	ProjectAllowedPrefixes: map[string][]string{
+		"project": {"gs://team-bucket/data/"},
+	},
+	CheckExistence: true,
+}
+
+func getBlobLiteral(uri string) *core.Literal {
+	return &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Blob{
+					Blob: &core.Blob{
+						Uri: uri,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getReferenceInputs() *core.LiteralMap {
+	return &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"file": getBlobLiteral("s3://shared-bucket/file.csv"),
+			"tables": {
+				Value: &core.Literal_Map{
+					Map: &core.LiteralMap{
+						Literals: map[string]*core.Literal{
+							"users": {
+								Value: &core.Literal_Scalar{
+									Scalar: &core.Scalar{
+										Value: &core.Scalar_Schema{
+											Schema: &core.Schema{
+												Uri: "gs://team-bucket/data/users",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getMockReferenceStore(missing string) *storage.DataStore {
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).HeadCb =
+		func(ctx context.Context, reference storage.DataReference) (storage.Metadata, error) {
+			return referenceMetadata{exists: reference.String() != missing}, nil
+		}
+	return mockStorage
+}
+
+func TestValidateInputReferences(t *testing.T) {
+	assert.Nil(t, ValidateInputReferences(context.Background(), "project", getReferenceInputs(),
+		getMockReferenceStore(""), inputReferencesConfig))
+}
+
+func TestValidateInputReferences_NotConfigured(t *testing.T) {
+	inputs := getReferenceInputs()
+	inputs.Literals["other"] = getBlobLiteral("s3://other-bucket/file.csv")
+	assert.Nil(t, ValidateInputReferences(context.Background(), "project", inputs,
+		commonMocks.GetMockStorageClient(), runtimeInterfaces.InputReferencesConfig{}))
+}
+
+func TestValidateInputReferences_NotAllowed(t *testing.T) {
+	// Prefixes allowed for another project don't apply.
+	err := ValidateInputReferences(context.Background(), "other-project", getReferenceInputs(),
+		getMockReferenceStore(""), inputReferencesConfig)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "input [tables.users] references [gs://team-bucket/data/users]")
+
+	inputs := getReferenceInputs()
+	inputs.Literals["files"] = &core.Literal{
+		Value: &core.Literal_Collection{
+			Collection: &core.LiteralCollection{
+				Literals: []*core.Literal{
+					getBlobLiteral("s3://shared-bucket/a.csv"),
+					getBlobLiteral("s3://other-bucket/b.csv"),
+				},
+			},
+		},
+	}
+	err = ValidateInputReferences(context.Background(), "project", inputs,
+		getMockReferenceStore(""), inputReferencesConfig)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "input [files[1]] references [s3://other-bucket/b.csv]")
+}
+
+func TestValidateInputReferences_NotURI(t *testing.T) {
+	inputs := getReferenceInputs()
+	inputs.Literals["file"] = getBlobLiteral("/local/file.csv")
+	err := ValidateInputReferences(context.Background(), "project", inputs,
+		getMockReferenceStore(""), inputReferencesConfig)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "not a blob storage URI")
+}
+
+func TestValidateInputReferences_Missing(t *testing.T) {
+	err := ValidateInputReferences(context.Background(), "project", getReferenceInputs(),
+		getMockReferenceStore("s3://shared-bucket/file.csv"), inputReferencesConfig)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "does not exist or is not reachable in bucket [shared-bucket]")
+
+	config := inputReferencesConfig
+	config.CheckExistence = false
+	assert.Nil(t, ValidateInputReferences(context.Background(), "project", getReferenceInputs(),
+		getMockReferenceStore("s3://shared-bucket/file.csv"), config))
+}
+
+func TestValidateInputReferences_StorageError(t *testing.T) {
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).HeadCb =
+		func(ctx context.Context, reference storage.DataReference) (storage.Metadata, error) {
+			return nil, errors.New("access denied")
+		}
+	err := ValidateInputReferences(context.Background(), "project", getReferenceInputs(), mockStorage,
+		inputReferencesConfig)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "access denied")
+}
@@ -2,10 +2,13 @@ package validation
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
@@ -14,6 +17,8 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+const executionNameTemplateField = "execution name template"
+
 func ValidateLaunchPlan(ctx context.Context,
 	request admin.LaunchPlanCreateRequest, db repositories.RepositoryInterface,
 	config runtimeInterfaces.ApplicationConfiguration, workflowInterface *core.TypedInterface) error {
@@ -44,6 +49,15 @@ func ValidateLaunchPlan(ctx context.Context,
 	if err := validateSchedule(request, expectedInputs); err != nil {
 		return err
 	}
+	if err := validateExecutionNameTemplate(request); err != nil {
+		return err
+	}
+	if _, err := common.IsAutoActivated(request.Spec, false); err != nil {
+		return err
+	}
+	if _, err := common.GetOnFailurePolicy(request.Spec); err != nil {
+		return err
+	}
 	// Augment default inputs with the unbound workflow inputs.
 	request.Spec.DefaultInputs = expectedInputs
 	// TODO: Remove redundant validation that occurs with launch plan and the validate method for the message.
@@ -57,6 +71,9 @@ func ValidateLaunchPlan(ctx context.Context,
 func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap) error {
 	schedule := request.GetSpec().GetEntityMetadata().GetSchedule()
 	if schedule.GetCronExpression() != "" || schedule.GetRate() != nil {
+		if err := executions.ValidateCronTimezone(schedule.GetCronExpression()); err != nil {
+			return err
+		}
 		for key, value := range expectedInputs.Parameters {
 			if value.GetRequired() && key != schedule.GetKickoffTimeInputArg() {
 				return errors.NewFlyteAdminErrorf(
@@ -79,6 +96,27 @@ func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *cor
 	return nil
 }
 
+// Kickoff dates and times render to fixed-width values so any kickoff time serves to validate a template.
+var executionNameTemplateKickoffTime = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Templates which can never produce a valid execution name for this launch plan, e.g. because they're too long, are
+// rejected up front.
+func validateExecutionNameTemplate(request admin.LaunchPlanCreateRequest) error {
+	template := common.GetExecutionNameTemplate(request.Spec)
+	if template == "" {
+		return nil
+	}
+	name, err := common.RenderExecutionNameTemplate(template, common.ExecutionNameTemplateInput{
+		LaunchPlan:  request.Id,
+		KickoffTime: executionNameTemplateKickoffTime,
+		Sequence:    1,
+	})
+	if err != nil {
+		return err
+	}
+	return CheckValidExecutionID(name, executionNameTemplateField)
+}
+
 func checkAndFetchExpectedInputForLaunchPlan(
 	workflowVariableMap *core.VariableMap, fixedInputs *core.LiteralMap, defaultInputs *core.ParameterMap) (*core.ParameterMap, error) {
 	expectedInputMap := map[string]*core.Parameter{}
@@ -154,3 +192,38 @@ func checkAndFetchExpectedInputForLaunchPlan(
 		Parameters: expectedInputMap,
 	}, nil
 }
+
+func ValidateLaunchPlanScheduleStateUpdateRequest(request interfaces.LaunchPlanScheduleStateUpdateRequest) error {
+	if err := ValidateNamedEntityIdentifier(request.ID); err != nil {
+		return err
+	}
+	if request.State != interfaces.LaunchPlanScheduleStateActive &&
+		request.State != interfaces.LaunchPlanScheduleStatePaused {
+		return shared.GetInvalidArgumentError(shared.State)
+	}
+	return nil
+}
+
+func ValidateLaunchPlanVariantsCreateRequest(request interfaces.LaunchPlanVariantsCreateRequest) error {
+	if err := ValidateIdentifier(request.Base, common.LaunchPlan); err != nil {
+		return err
+	}
+	if len(request.Variants) == 0 {
+		return shared.GetMissingArgumentError(shared.Variants)
+	}
+	if request.StaggerMinutes < 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"stagger minutes must not be negative, not [%d]", request.StaggerMinutes)
+	}
+	keys := make(map[string]bool, len(request.Variants))
+	for _, variant := range request.Variants {
+		if variant.Key == "" {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "variants of launch plans must have a key")
+		}
+		if keys[variant.Key] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "variant key [%s] isn't unique", variant.Key)
+		}
+		keys[variant.Key] = true
+	}
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flytepropeller/pkg/utils"
 	"github.com/stretchr/testify/assert"
@@ -51,6 +54,29 @@ func TestValidateLpEmptySpec(t *testing.T) {
 	assert.EqualError(t, err, "missing spec")
 }
 
+func TestValidateLpInvalidAutoActivate(t *testing.T) {
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.AutoActivateAnnotation: "sometimes",
+		},
+	}
+	err := ValidateLaunchPlan(context.Background(), request, testutils.GetRepoWithDefaultProject(), lpApplicationConfig, getWorkflowInterface())
+	assert.EqualError(t, err, "annotation [flyte.lyft.com/auto-activate] must be true or false, not [sometimes]")
+}
+
+func TestValidateLpInvalidOnFailurePolicy(t *testing.T) {
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.OnFailureAnnotation: "FAIL_EVENTUALLY",
+		},
+	}
+	err := ValidateLaunchPlan(context.Background(), request, testutils.GetRepoWithDefaultProject(), lpApplicationConfig, getWorkflowInterface())
+	assert.EqualError(t, err, "annotation [flyte.lyft.com/on-failure] must be FAIL_IMMEDIATELY or "+
+		"FAIL_AFTER_EXECUTABLE_NODES_COMPLETE, not [FAIL_EVENTUALLY]")
+}
+
 func TestGetLpExpectedInputs(t *testing.T) {
 	request := testutils.GetLaunchPlanRequest()
 	actualExpectedMap, err := checkAndFetchExpectedInputForLaunchPlan(
@@ -368,3 +394,87 @@ func TestValidateSchedule_KickoffTimeBound(t *testing.T) {
 	err := validateSchedule(request, inputMap)
 	assert.Nil(t, err)
 }
+
+func TestValidateSchedule_CronTimezone(t *testing.T) {
+	inputMap := &core.ParameterMap{
+		Parameters: map[string]*core.Parameter{},
+	}
+	request := testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=America/New_York 0 9 ? * MON-FRI *")
+	assert.Nil(t, validateSchedule(request, inputMap))
+
+	request = testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=Mars/Olympus_Mons 0 9 ? * MON-FRI *")
+	assert.EqualError(t, validateSchedule(request, inputMap),
+		"unknown timezone [Mars/Olympus_Mons] in cron expression [CRON_TZ=Mars/Olympus_Mons 0 9 ? * MON-FRI *]")
+
+	// Schedules with a timezone must be parseable since flyteadmin evaluates them itself.
+	request = testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=America/New_York 0 9 L * ? *")
+	assert.NotNil(t, validateSchedule(request, inputMap))
+}
+
+func TestValidateExecutionNameTemplate(t *testing.T) {
+	request := testutils.GetLaunchPlanRequestWithCronSchedule("* * * * * *")
+	assert.Nil(t, validateExecutionNameTemplate(request))
+
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.ExecutionNameTemplateAnnotation: "{lp_name}-{kickoff_date}",
+		},
+	}
+	assert.Nil(t, validateExecutionNameTemplate(request))
+
+	// Project and domain execution name templates are followed by a random suffix.
+	assert.Nil(t, ValidateExecutionNameTemplate("ml-{kickoff_date}", "project", "domain"))
+	assert.Nil(t, ValidateExecutionNameTemplate("{project}{kickoff_time}", "project", "domain"))
+	assert.Nil(t, ValidateExecutionNameTemplate("{lp_name}", "project", "domain"))
+}
+
+func TestValidateExecutionNameTemplate_Invalid(t *testing.T) {
+	request := testutils.GetLaunchPlanRequestWithCronSchedule("* * * * * *")
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.ExecutionNameTemplateAnnotation: "{lp_name}-{kickoff}",
+		},
+	}
+	assert.EqualError(t, validateExecutionNameTemplate(request),
+		"unknown variable [kickoff] in execution name template [{lp_name}-{kickoff}]")
+
+	request.Spec.Annotations.Values[common.ExecutionNameTemplateAnnotation] = "{project}-{domain}-{kickoff_date}"
+	assert.EqualError(t, validateExecutionNameTemplate(request),
+		"size of execution name template exceeded length 20 : project-domain-20190101")
+
+	request.Spec.Annotations.Values[common.ExecutionNameTemplateAnnotation] = "{kickoff_date}-{lp_name}"
+	assert.EqualError(t, validateExecutionNameTemplate(request),
+		"invalid execution name template format: 20190101-name")
+
+	assert.EqualError(t, ValidateExecutionNameTemplate("", "project", "domain"),
+		"missing execution_name_policy.template")
+	assert.EqualError(t, ValidateExecutionNameTemplate("ml-{kickoff_date}-{kickoff_time}", "project", "domain"),
+		"execution name template [ml-{kickoff_date}-{kickoff_time}] leaves less than 6 characters for the random "+
+			"suffix of names")
+	assert.EqualError(t, ValidateExecutionNameTemplate("{kickoff_date}-ml", "project", "domain"),
+		"execution name template [{kickoff_date}-ml] must render to a name starting with a lowercase letter: "+
+			"20190101-ml")
+	assert.EqualError(t, ValidateExecutionNameTemplate("ml-{team}", "project", "domain"),
+		"unknown variable [team] in execution name template [ml-{team}]")
+}
+
+func TestValidateLaunchPlanScheduleStateUpdateRequest(t *testing.T) {
+	request := interfaces.LaunchPlanScheduleStateUpdateRequest{
+		ID: &admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		State: interfaces.LaunchPlanScheduleStatePaused,
+	}
+	assert.Nil(t, ValidateLaunchPlanScheduleStateUpdateRequest(request))
+
+	request.State = interfaces.LaunchPlanScheduleState(2)
+	assert.EqualError(t, ValidateLaunchPlanScheduleStateUpdateRequest(request), "invalid value for state")
+
+	request.ID.Name = ""
+	assert.EqualError(t, ValidateLaunchPlanScheduleStateUpdateRequest(request), "missing name")
+
+	request.ID = nil
+	assert.EqualError(t, ValidateLaunchPlanScheduleStateUpdateRequest(request), "missing id")
+}
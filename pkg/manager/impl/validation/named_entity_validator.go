@@ -1,8 +1,15 @@
 package validation
 
 import (
+	"strings"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
 )
 
 func ValidateNamedEntityGetRequest(request admin.NamedEntityGetRequest) error {
@@ -38,8 +45,44 @@ func ValidateNamedEntityListRequest(request admin.NamedEntityListRequest) error
 	if err := ValidateResourceType(request.ResourceType); err != nil {
 		return err
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
+	return nil
+}
+
+func ValidateEntityUsageGetRequest(request interfaces.EntityUsageGetRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	return nil
+}
+
+func ValidateEntitySearchRequest(request interfaces.EntitySearchRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
 		return err
 	}
+	if len(strings.Fields(request.Query)) == 0 {
+		return shared.GetMissingArgumentError(shared.Query)
+	}
+	for _, resourceType := range request.ResourceTypes {
+		if _, ok := common.ResourceTypeToEntity[resourceType]; !ok {
+			return shared.GetInvalidArgumentError(shared.ResourceType)
+		}
+	}
+	return nil
+}
+
+func ValidateNamedEntityStateUpdateRequest(request interfaces.NamedEntityStateUpdateRequest) error {
+	if err := ValidateResourceType(request.ResourceType); err != nil {
+		return err
+	}
+	if err := ValidateNamedEntityIdentifier(request.ID); err != nil {
+		return err
+	}
+	if request.State != interfaces.NamedEntityStateActive && request.State != interfaces.NamedEntityStateArchived {
+		return shared.GetInvalidArgumentError(shared.State)
+	}
+	if request.Cascade && (request.ResourceType != core.ResourceType_WORKFLOW ||
+		request.State != interfaces.NamedEntityStateArchived) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "only archiving workflows can cascade")
+	}
 	return nil
 }
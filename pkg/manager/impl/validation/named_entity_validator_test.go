@@ -3,6 +3,7 @@ package validation
 import (
 	"testing"
 
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
@@ -133,9 +134,56 @@ func TestValidateNamedEntityListRequest(t *testing.T) {
 		Limit:        2,
 	}))
 
-	assert.NotNil(t, ValidateNamedEntityListRequest(admin.NamedEntityListRequest{
+	assert.Nil(t, ValidateNamedEntityListRequest(admin.NamedEntityListRequest{
 		ResourceType: core.ResourceType_WORKFLOW,
 		Project:      "project",
 		Domain:       "domain",
 	}))
 }
+
+func TestValidateEntityUsageGetRequest(t *testing.T) {
+	assert.Nil(t, ValidateEntityUsageGetRequest(interfaces.EntityUsageGetRequest{
+		Project: "project",
+		Domain:  "domain",
+	}))
+
+	assert.Nil(t, ValidateEntityUsageGetRequest(interfaces.EntityUsageGetRequest{
+		Project: "project",
+	}))
+
+	assert.NotNil(t, ValidateEntityUsageGetRequest(interfaces.EntityUsageGetRequest{
+		Domain: "domain",
+	}))
+}
+
+func TestValidateNamedEntityStateUpdateRequest(t *testing.T) {
+	id := &admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.Nil(t, ValidateNamedEntityStateUpdateRequest(interfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           id,
+		State:        interfaces.NamedEntityStateArchived,
+		Cascade:      true,
+	}))
+
+	assert.Nil(t, ValidateNamedEntityStateUpdateRequest(interfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_TASK,
+		ID:           id,
+		State:        interfaces.NamedEntityStateActive,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntityStateUpdateRequest(interfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		State:        interfaces.NamedEntityStateArchived,
+	}))
+
+	assert.EqualError(t, ValidateNamedEntityStateUpdateRequest(interfaces.NamedEntityStateUpdateRequest{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		ID:           id,
+		State:        interfaces.NamedEntityStateArchived,
+		Cascade:      true,
+	}), "only archiving workflows can cascade")
+}
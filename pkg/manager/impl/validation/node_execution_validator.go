@@ -1,7 +1,10 @@
 package validation
 
 import (
+	"net/url"
+
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
@@ -24,9 +27,6 @@ func ValidateNodeExecutionListRequest(request admin.NodeExecutionListRequest) er
 	if err := ValidateWorkflowExecutionIdentifier(request.WorkflowExecutionId); err != nil {
 		return shared.GetMissingArgumentError(shared.ExecutionID)
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -34,8 +34,76 @@ func ValidateNodeExecutionForTaskListRequest(request admin.NodeExecutionForTaskL
 	if err := ValidateTaskExecutionIdentifier(request.TaskExecutionId); err != nil {
 		return err
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
+	return nil
+}
+
+var cacheStatuses = map[string]bool{
+	interfaces.CacheDisabled:      true,
+	interfaces.CacheHit:           true,
+	interfaces.CacheMiss:          true,
+	interfaces.CachePopulated:     true,
+	interfaces.CacheLookupFailure: true,
+	interfaces.CachePutFailure:    true,
+}
+
+func ValidateNodeExecutionCacheMetadataCreateRequest(request interfaces.NodeExecutionCacheMetadataCreateRequest) error {
+	if err := ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
+		return err
+	}
+	if request.Metadata.CacheStatus == "" {
+		return shared.GetMissingArgumentError(shared.CacheStatus)
+	}
+	if !cacheStatuses[request.Metadata.CacheStatus] {
+		return shared.GetInvalidArgumentError(shared.CacheStatus)
+	}
+	if request.Metadata.SourceExecution != nil {
+		return ValidateNodeExecutionIdentifier(request.Metadata.SourceExecution)
+	}
+	return nil
+}
+
+var artifactKinds = map[string]bool{
+	interfaces.ArtifactKindHTML:    true,
+	interfaces.ArtifactKindDeck:    true,
+	interfaces.ArtifactKindProfile: true,
+}
+
+func validateNodeExecutionArtifact(artifact interfaces.NodeExecutionArtifact) error {
+	if artifact.Name == "" {
+		return shared.GetMissingArgumentError(shared.Name)
+	}
+	if artifact.Kind == "" {
+		return shared.GetMissingArgumentError(shared.Kind)
+	}
+	if !artifactKinds[artifact.Kind] {
+		return shared.GetInvalidArgumentError(shared.Kind)
+	}
+	if artifact.URI == "" {
+		return shared.GetMissingArgumentError(shared.URI)
+	}
+	// Artifacts are signed like node execution data, so they must live in blob storage.
+	if parsedURI, err := url.Parse(artifact.URI); err != nil || parsedURI.Scheme == "" {
+		return shared.GetInvalidArgumentError(shared.URI)
+	}
+	return nil
+}
+
+func ValidateNodeExecutionArtifactsCreateRequest(request interfaces.NodeExecutionArtifactsCreateRequest) error {
+	if err := ValidateNodeExecutionIdentifier(request.NodeExecutionID); err != nil {
 		return err
 	}
+	if len(request.Artifacts) == 0 {
+		return shared.GetMissingArgumentError(shared.Artifacts)
+	}
+	names := make(map[string]bool, len(request.Artifacts))
+	for _, artifact := range request.Artifacts {
+		if err := validateNodeExecutionArtifact(artifact); err != nil {
+			return err
+		}
+		if names[artifact.Name] {
+			return shared.GetInvalidArgumentError(shared.Artifacts)
+		}
+		names[artifact.Name] = true
+	}
 	return nil
 }
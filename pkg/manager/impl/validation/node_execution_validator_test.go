@@ -3,6 +3,7 @@ package validation
 import (
 	"testing"
 
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
@@ -53,7 +54,7 @@ func TestValidateNodeExecutionListRequest_MissingFields(t *testing.T) {
 	err = ValidateNodeExecutionListRequest(admin.NodeExecutionListRequest{
 		WorkflowExecutionId: &testExecutionID,
 	})
-	assert.EqualError(t, err, "invalid value for limit")
+	assert.Nil(t, err)
 }
 
 func TestValidateNodeExecutionForTaskListRequest(t *testing.T) {
@@ -93,7 +94,7 @@ func TestValidateNodeExecutionForTaskListRequest_MissingFields(t *testing.T) {
 			},
 		},
 	})
-	assert.EqualError(t, err, "invalid value for limit")
+	assert.Nil(t, err)
 
 	err = ValidateNodeExecutionForTaskListRequest(admin.NodeExecutionForTaskListRequest{
 		TaskExecutionId: &core.TaskExecutionIdentifier{
@@ -129,3 +130,32 @@ func TestValidateNodeExecutionForTaskListRequest_MissingFields(t *testing.T) {
 	})
 	assert.EqualError(t, err, "missing project")
 }
+
+func TestValidateNodeExecutionCacheMetadataCreateRequest(t *testing.T) {
+	nodeExecutionID := &core.NodeExecutionIdentifier{
+		ExecutionId: &testExecutionID,
+		NodeId:      "node id",
+	}
+	err := ValidateNodeExecutionCacheMetadataCreateRequest(interfaces.NodeExecutionCacheMetadataCreateRequest{
+		NodeExecutionID: nodeExecutionID,
+		Metadata: interfaces.NodeExecutionCacheMetadata{
+			CacheStatus:     interfaces.CacheHit,
+			ArtifactKey:     "key",
+			SourceExecution: nodeExecutionID,
+		},
+	})
+	assert.Nil(t, err)
+
+	err = ValidateNodeExecutionCacheMetadataCreateRequest(interfaces.NodeExecutionCacheMetadataCreateRequest{
+		NodeExecutionID: nodeExecutionID,
+	})
+	assert.EqualError(t, err, "missing cache_status")
+
+	err = ValidateNodeExecutionCacheMetadataCreateRequest(interfaces.NodeExecutionCacheMetadataCreateRequest{
+		NodeExecutionID: nodeExecutionID,
+		Metadata: interfaces.NodeExecutionCacheMetadata{
+			CacheStatus: "foo",
+		},
+	})
+	assert.EqualError(t, err, "invalid value for cache_status")
+}
@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var notificationChannels = map[string]bool{
+	interfaces.EmailNotificationChannel:     true,
+	interfaces.SlackNotificationChannel:     true,
+	interfaces.PagerDutyNotificationChannel: true,
+}
+
+func ValidateNotificationPreferenceUpdateRequest(request interfaces.NotificationPreferenceUpdateRequest) error {
+	if request.Preference.LaunchPlan == nil {
+		return shared.GetMissingArgumentError(shared.LaunchPlan)
+	}
+	if err := ValidateNamedEntityIdentifier(request.Preference.LaunchPlan); err != nil {
+		return err
+	}
+	if request.Preference.Channel != "" && !notificationChannels[request.Preference.Channel] {
+		return shared.GetInvalidArgumentError(shared.Channel)
+	}
+	// Notifications are only sent when executions terminate.
+	for _, phase := range request.Preference.Phases {
+		value, ok := core.WorkflowExecution_Phase_value[phase]
+		if !ok || !common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) {
+			return shared.GetInvalidArgumentError(shared.Phase)
+		}
+	}
+	return nil
+}
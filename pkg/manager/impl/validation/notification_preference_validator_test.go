@@ -0,0 +1,62 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNotificationPreferenceUpdateRequest(t *testing.T) {
+	err := ValidateNotificationPreferenceUpdateRequest(interfaces.NotificationPreferenceUpdateRequest{})
+	assert.EqualError(t, err, "missing launch_plan")
+
+	err = ValidateNotificationPreferenceUpdateRequest(interfaces.NotificationPreferenceUpdateRequest{
+		Preference: interfaces.NotificationPreference{
+			LaunchPlan: &admin.NamedEntityIdentifier{
+				Project: "project",
+				Domain:  "domain",
+			},
+		},
+	})
+	assert.EqualError(t, err, "missing name")
+
+	err = ValidateNotificationPreferenceUpdateRequest(interfaces.NotificationPreferenceUpdateRequest{
+		Preference: interfaces.NotificationPreference{
+			LaunchPlan: &admin.NamedEntityIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Channel: "carrier pigeon",
+		},
+	})
+	assert.EqualError(t, err, "invalid value for channel")
+
+	err = ValidateNotificationPreferenceUpdateRequest(interfaces.NotificationPreferenceUpdateRequest{
+		Preference: interfaces.NotificationPreference{
+			LaunchPlan: &admin.NamedEntityIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Channel: interfaces.SlackNotificationChannel,
+			Phases:  []string{"RUNNING"},
+		},
+	})
+	assert.EqualError(t, err, "invalid value for phase")
+
+	err = ValidateNotificationPreferenceUpdateRequest(interfaces.NotificationPreferenceUpdateRequest{
+		Preference: interfaces.NotificationPreference{
+			LaunchPlan: &admin.NamedEntityIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Channel: interfaces.SlackNotificationChannel,
+			Phases:  []string{"FAILED", "ABORTED"},
+		},
+	})
+	assert.Nil(t, err)
+}
@@ -1,8 +1,11 @@
 package validation
 
 import (
+	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"google.golang.org/grpc/codes"
 )
 
 func ValidateProjectDomainAttributesUpdateRequest(request admin.ProjectDomainAttributesUpdateRequest) error {
@@ -15,6 +18,35 @@ func ValidateProjectDomainAttributesUpdateRequest(request admin.ProjectDomainAtt
 	if err := ValidateEmptyStringField(request.Attributes.Domain, shared.Domain); err != nil {
 		return err
 	}
-	// Resource attributes are not a required field and therefore are not checked in validation.
+	// Resource attributes are not a required field and therefore are not checked in validation, other than default
+	// inputs, labels and annotations naming the input or key they default.
+	if _, ok := request.Attributes.Attributes[DefaultInputAttributePrefix]; ok {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"missing input name in attribute [%s]", DefaultInputAttributePrefix)
+	}
+	for _, prefix := range []string{DefaultLabelAttributePrefix, DefaultAnnotationAttributePrefix} {
+		if _, ok := request.Attributes.Attributes[prefix]; ok {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "missing key in attribute [%s]", prefix)
+		}
+	}
+	if _, err := GetResourceQuota(request.Attributes.Attributes); err != nil {
+		return err
+	}
+	if _, err := GetAllowedImageRegistries(request.Attributes.Attributes); err != nil {
+		return err
+	}
+	if _, err := GetDefaultNotifications(request.Attributes.Attributes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func ValidateProjectDomainAttributesAckRequest(request interfaces.ProjectDomainAttributesAckRequest) error {
+	if err := ValidateEmptyStringField(request.Cluster, shared.Cluster); err != nil {
+		return err
+	}
+	if request.Version <= 0 {
+		return shared.GetInvalidArgumentError(shared.Version)
+	}
 	return nil
 }
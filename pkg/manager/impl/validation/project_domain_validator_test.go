@@ -3,6 +3,7 @@ package validation
 import (
 	"testing"
 
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/stretchr/testify/assert"
 )
@@ -30,4 +31,67 @@ func TestValidateProjectDomainAttributesUpdateRequest(t *testing.T) {
 		},
 	})
 	assert.Nil(t, err)
+
+	err = ValidateProjectDomainAttributesUpdateRequest(admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: "project",
+			Domain:  "domain",
+			Attributes: map[string]string{
+				"default_input.": "s3://bucket",
+			},
+		},
+	})
+	assert.EqualError(t, err, "missing input name in attribute [default_input.]")
+
+	err = ValidateProjectDomainAttributesUpdateRequest(admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: "project",
+			Domain:  "domain",
+			Attributes: map[string]string{
+				"default_label.": "ml",
+			},
+		},
+	})
+	assert.EqualError(t, err, "missing key in attribute [default_label.]")
+
+	err = ValidateProjectDomainAttributesUpdateRequest(admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: "project",
+			Domain:  "domain",
+			Attributes: map[string]string{
+				MaxConcurrentExecutionsAttribute: "many",
+			},
+		},
+	})
+	assert.EqualError(t, err, "invalid number many for attribute [quota.max_concurrent_executions]")
+
+	err = ValidateProjectDomainAttributesUpdateRequest(admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: "project",
+			Domain:  "domain",
+			Attributes: map[string]string{
+				DefaultEmailRecipientsAttribute:    "team@example.com",
+				DefaultNotificationPhasesAttribute: "broken",
+			},
+		},
+	})
+	assert.EqualError(t, err, "invalid phase broken for attribute [notifications.phases]")
+}
+
+func TestValidateProjectDomainAttributesAckRequest(t *testing.T) {
+	err := ValidateProjectDomainAttributesAckRequest(interfaces.ProjectDomainAttributesAckRequest{
+		Cluster: "cluster",
+		Version: 1,
+	})
+	assert.Nil(t, err)
+
+	err = ValidateProjectDomainAttributesAckRequest(interfaces.ProjectDomainAttributesAckRequest{
+		Version: 1,
+	})
+	assert.EqualError(t, err, "missing cluster")
+
+	err = ValidateProjectDomainAttributesAckRequest(interfaces.ProjectDomainAttributesAckRequest{
+		Cluster: "cluster",
+	})
+	assert.EqualError(t, err, "invalid value for version")
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"google.golang.org/grpc/codes"
@@ -15,6 +17,8 @@ import (
 const projectID = "project_id"
 const projectName = "project_name"
 const projectDescription = "project_description"
+const domainID = "domain_id"
+const domainName = "domain_name"
 const maxDescriptionLength = 300
 
 func ValidateProjectRegisterRequest(request admin.ProjectRegisterRequest) error {
@@ -40,25 +44,107 @@ func ValidateProjectRegisterRequest(request admin.ProjectRegisterRequest) error
 	return nil
 }
 
+func ValidateProjectUpdateRequest(request interfaces.ProjectUpdateRequest) error {
+	if err := ValidateEmptyStringField(request.ID, projectID); err != nil {
+		return err
+	}
+	if request.Description != nil {
+		if err := ValidateMaxLengthStringField(*request.Description, projectDescription, maxDescriptionLength); err != nil {
+			return err
+		}
+	}
+	for key, value := range request.Labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid project label key [%s]: %v", key, errs)
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid value [%s] of project label [%s]: %v", value, key, errs)
+		}
+	}
+	if request.State != nil && *request.State != interfaces.ProjectStateActive &&
+		*request.State != interfaces.ProjectStateArchived {
+		return shared.GetInvalidArgumentError(shared.State)
+	}
+	return nil
+}
+
+func ValidateProjectUsageReportRequest(request interfaces.ProjectUsageReportRequest) error {
+	if request.StartTime.IsZero() {
+		return shared.GetMissingArgumentError(shared.StartTime)
+	}
+	if request.EndTime.IsZero() {
+		return shared.GetMissingArgumentError(shared.EndTime)
+	}
+	if !request.StartTime.Before(request.EndTime) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"start time [%v] must be before end time [%v]", request.StartTime, request.EndTime)
+	}
+	return nil
+}
+
+func ValidateDomainRegisterRequest(request interfaces.DomainRegisterRequest,
+	config runtimeInterfaces.ApplicationConfiguration) error {
+	if err := ValidateEmptyStringField(request.ID, domainID); err != nil {
+		return err
+	}
+	if errs := validation.IsDNS1123Label(request.ID); len(errs) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid domain id [%s]: %v", request.ID, errs)
+	}
+	if err := ValidateEmptyStringField(request.Name, domainName); err != nil {
+		return err
+	}
+	for _, domain := range *config.GetDomainsConfig() {
+		if domain.ID == request.ID {
+			return errors.NewFlyteAdminErrorf(codes.AlreadyExists,
+				"domain [%s] is already configured for the server", request.ID)
+		}
+	}
+	return nil
+}
+
 // Validates that a specified project and domain combination has been registered and exists in the db.
 func ValidateProjectAndDomain(
 	ctx context.Context, db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration, projectID, domainID string) error {
-	_, err := db.ProjectRepo().Get(ctx, projectID)
+	_, err := getProjectInDomain(ctx, db, config, projectID, domainID)
+	return err
+}
+
+// Like ValidateProjectAndDomain, but also rejects archived projects.
+func ValidateActiveProjectAndDomain(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, projectID, domainID string) error {
+	project, err := getProjectInDomain(ctx, db, config, projectID, domainID)
 	if err != nil {
-		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		return err
+	}
+	if project.State != nil && interfaces.ProjectState(*project.State) == interfaces.ProjectStateArchived {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "project [%s] is archived", projectID)
+	}
+	return nil
+}
+
+func getProjectInDomain(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, projectID, domainID string) (models.Project, error) {
+	project, err := db.ProjectRepo().Get(ctx, projectID)
+	if err != nil {
+		return models.Project{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"failed to validate that project [%s] and domain [%s] are registered, err: [%+v]",
 			projectID, domainID, err)
 	}
 	var validDomain bool
-	domains := config.GetDomainsConfig()
-	for _, domain := range *domains {
+	domains, err := repositories.GetDomains(ctx, db, config)
+	if err != nil {
+		return models.Project{}, err
+	}
+	for _, domain := range domains {
 		if domain.ID == domainID {
 			validDomain = true
 			break
 		}
 	}
 	if !validDomain {
-		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "domain [%s] is unrecognized by system", domainID)
+		return models.Project{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"domain [%s] is unrecognized by system", domainID)
 	}
-	return nil
+	return project, nil
 }
@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
@@ -123,3 +127,136 @@ func TestValidateProjectAndDomain(t *testing.T) {
 	assert.EqualError(t, err,
 		"failed to validate that project [flyte-project-id] and domain [domain] are registered, err: [foo]")
 }
+
+func TestValidateActiveProjectAndDomain(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockRepository()
+	err := ValidateActiveProjectAndDomain(context.Background(), mockRepo,
+		testutils.GetApplicationConfigWithDefaultProjects(), "flyte-project-id", "domain")
+	assert.Nil(t, err)
+
+	mockRepo.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		state := int32(interfaces.ProjectStateArchived)
+		return models.Project{State: &state}, nil
+	}
+	err = ValidateActiveProjectAndDomain(context.Background(), mockRepo,
+		testutils.GetApplicationConfigWithDefaultProjects(), "flyte-project-id", "domain")
+	assert.EqualError(t, err, "project [flyte-project-id] is archived")
+}
+
+func TestValidateProjectUpdateRequest(t *testing.T) {
+	description := "description"
+	archived := interfaces.ProjectStateArchived
+	assert.Nil(t, ValidateProjectUpdateRequest(interfaces.ProjectUpdateRequest{
+		ID:          "proj",
+		Description: &description,
+		Labels:      map[string]string{"team": "flyte"},
+		State:       &archived,
+	}))
+
+	unknownState := interfaces.ProjectState(2)
+	testValues := map[string]interfaces.ProjectUpdateRequest{
+		"missing project_id": {},
+		"invalid project label key [not a key]": {
+			ID:     "proj",
+			Labels: map[string]string{"not a key": "value"},
+		},
+		"invalid value [not a value] of project label [team]": {
+			ID:     "proj",
+			Labels: map[string]string{"team": "not a value"},
+		},
+		"invalid value for state": {
+			ID:    "proj",
+			State: &unknownState,
+		},
+	}
+	for expectedError, request := range testValues {
+		err := ValidateProjectUpdateRequest(request)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expectedError)
+	}
+}
+
+func TestValidateProjectUsageReportRequest(t *testing.T) {
+	startTime := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, ValidateProjectUsageReportRequest(interfaces.ProjectUsageReportRequest{
+		StartTime: startTime,
+		EndTime:   startTime.Add(24 * time.Hour),
+	}))
+
+	testValues := map[string]interfaces.ProjectUsageReportRequest{
+		"missing start_time": {EndTime: startTime},
+		"missing end_time":   {StartTime: startTime},
+		"must be before end time": {
+			StartTime: startTime,
+			EndTime:   startTime,
+		},
+	}
+	for expectedError, request := range testValues {
+		err := ValidateProjectUsageReportRequest(request)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expectedError)
+	}
+}
+
+func TestValidateProjectAndDomain_RegisteredDomain(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockRepository()
+	mockRepo.DomainRepo().(*repositoryMocks.MockDomainRepo).ListAllFunction = func(
+		ctx context.Context) ([]models.Domain, error) {
+		return []models.Domain{{Identifier: "qa", Name: "qa"}}, nil
+	}
+	err := ValidateProjectAndDomain(context.Background(), mockRepo, testutils.GetApplicationConfigWithDefaultProjects(),
+		"flyte-project-id", "qa")
+	assert.Nil(t, err)
+
+	err = ValidateProjectAndDomain(context.Background(), mockRepo, testutils.GetApplicationConfigWithDefaultProjects(),
+		"flyte-project-id", "uat")
+	assert.EqualError(t, err, "domain [uat] is unrecognized by system")
+}
+
+// Serves domain listings through the cache the server uses.
+type cachedDomainsRepository struct {
+	repositories.RepositoryInterface
+	domainRepo repositoryInterfaces.DomainRepoInterface
+}
+
+func (r cachedDomainsRepository) DomainRepo() repositoryInterfaces.DomainRepoInterface {
+	return r.domainRepo
+}
+
+func TestValidateProjectAndDomain_CachedDomains(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockRepository()
+	var listings int
+	mockRepo.DomainRepo().(*repositoryMocks.MockDomainRepo).ListAllFunction = func(
+		ctx context.Context) ([]models.Domain, error) {
+		listings++
+		return []models.Domain{{Identifier: "qa", Name: "qa"}}, nil
+	}
+	repo := cachedDomainsRepository{
+		RepositoryInterface: mockRepo,
+		domainRepo:          repositories.NewCachedDomainRepo(mockRepo.DomainRepo()),
+	}
+	for i := 0; i < 3; i++ {
+		err := ValidateProjectAndDomain(context.Background(), repo, testutils.GetApplicationConfigWithDefaultProjects(),
+			"flyte-project-id", "qa")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 1, listings)
+}
+
+func TestValidateDomainRegisterRequest(t *testing.T) {
+	config := testutils.GetApplicationConfigWithDefaultProjects()
+	assert.Nil(t, ValidateDomainRegisterRequest(interfaces.DomainRegisterRequest{ID: "qa", Name: "QA"}, config))
+
+	testValues := map[string]interfaces.DomainRegisterRequest{
+		"missing domain_id":       {Name: "QA"},
+		"invalid domain id [Q A]": {ID: "Q A", Name: "QA"},
+		"missing domain_name":     {ID: "qa"},
+		"domain [development] is already configured for the server": {ID: "development", Name: "development"},
+	}
+	for expectedError, request := range testValues {
+		err := ValidateDomainRegisterRequest(request, config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expectedError)
+	}
+}
@@ -0,0 +1,113 @@
+package validation
+
+import (
+	"strconv"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Project-domain attributes capping the resources the tenant's executions may use.
+const (
+	MaxTaskCPUAttribute              = "quota.max_task_cpu"
+	MaxTaskMemoryAttribute           = "quota.max_task_memory"
+	MaxConcurrentExecutionsAttribute = "quota.max_concurrent_executions"
+)
+
+// Caps on the resources used by the executions of a project and domain. Unset (nil or zero) caps aren't enforced.
+type ResourceQuota struct {
+	MaxTaskCPU              *resource.Quantity
+	MaxTaskMemory           *resource.Quantity
+	MaxConcurrentExecutions int
+}
+
+func getQuotaQuantity(attributes map[string]string, name string) (*resource.Quantity, error) {
+	value, ok := attributes[name]
+	if !ok {
+		return nil, nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil || quantity.Sign() <= 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid quantity %s for attribute [%s]", value, name)
+	}
+	return &quantity, nil
+}
+
+// Reads the resource quota set in the attributes of a project and domain.
+func GetResourceQuota(attributes map[string]string) (ResourceQuota, error) {
+	var quota ResourceQuota
+	var err error
+	if quota.MaxTaskCPU, err = getQuotaQuantity(attributes, MaxTaskCPUAttribute); err != nil {
+		return ResourceQuota{}, err
+	}
+	if quota.MaxTaskMemory, err = getQuotaQuantity(attributes, MaxTaskMemoryAttribute); err != nil {
+		return ResourceQuota{}, err
+	}
+	if value, ok := attributes[MaxConcurrentExecutionsAttribute]; ok {
+		quota.MaxConcurrentExecutions, err = strconv.Atoi(value)
+		if err != nil || quota.MaxConcurrentExecutions <= 0 {
+			return ResourceQuota{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid number %s for attribute [%s]", value, MaxConcurrentExecutionsAttribute)
+		}
+	}
+	return quota, nil
+}
+
+// Returns the cap on a resource, if any.
+func (q ResourceQuota) getMax(name core.Resources_ResourceName) *resource.Quantity {
+	switch name {
+	case core.Resources_CPU:
+		return q.MaxTaskCPU
+	case core.Resources_MEMORY:
+		return q.MaxTaskMemory
+	}
+	return nil
+}
+
+func capQuantity(value string, max *resource.Quantity) string {
+	if max == nil {
+		return value
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil || quantity.Cmp(*max) <= 0 {
+		return value
+	}
+	return max.String()
+}
+
+// Lowers the platform default resources to the quota, so that tasks relying on them aren't rejected.
+func (q ResourceQuota) capTaskResourceSet(set runtimeInterfaces.TaskResourceSet) runtimeInterfaces.TaskResourceSet {
+	set.CPU = capQuantity(set.CPU, q.MaxTaskCPU)
+	set.Memory = capQuantity(set.Memory, q.MaxTaskMemory)
+	return set
+}
+
+// Asserts that neither the resources a task requests nor its limits exceed the quota.
+func ValidateTaskResourceQuota(task *core.CompiledTask, quota ResourceQuota) error {
+	resources := task.GetTemplate().GetContainer().GetResources()
+	if resources == nil {
+		return nil
+	}
+	entries := append(append([]*core.Resources_ResourceEntry{}, resources.Requests...), resources.Limits...)
+	for _, entry := range entries {
+		max := quota.getMax(entry.Name)
+		if max == nil {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(entry.Value)
+		if err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Invalid quantity %s for resource: %v for task [%+v]", entry.Value, entry.Name, task.Template.Id)
+		}
+		if quantity.Cmp(*max) > 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"%v of %s for task [%+v] exceeds the quota of %s", entry.Name, entry.Value, task.Template.Id,
+				max.String())
+		}
+	}
+	return nil
+}
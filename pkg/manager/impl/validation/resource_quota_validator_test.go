@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func getTaskWithResources(requests, limits []*core.Resources_ResourceEntry) *core.CompiledTask {
+	return &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Id: &core.Identifier{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{
+					Resources: &core.Resources{
+						Requests: requests,
+						Limits:   limits,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetResourceQuota(t *testing.T) {
+	quota, err := GetResourceQuota(map[string]string{
+		MaxTaskCPUAttribute:              "2",
+		MaxTaskMemoryAttribute:           "4Gi",
+		MaxConcurrentExecutionsAttribute: "10",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", quota.MaxTaskCPU.String())
+	assert.Equal(t, "4Gi", quota.MaxTaskMemory.String())
+	assert.Equal(t, 10, quota.MaxConcurrentExecutions)
+}
+
+func TestGetResourceQuota_Unset(t *testing.T) {
+	quota, err := GetResourceQuota(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ResourceQuota{}, quota)
+}
+
+func TestGetResourceQuota_Invalid(t *testing.T) {
+	for _, attributes := range []map[string]string{
+		{MaxTaskCPUAttribute: "two"},
+		{MaxTaskMemoryAttribute: "-1Gi"},
+		{MaxConcurrentExecutionsAttribute: "0"},
+		{MaxConcurrentExecutionsAttribute: "ten"},
+	} {
+		_, err := GetResourceQuota(attributes)
+		assert.Error(t, err, "%+v should be rejected", attributes)
+	}
+}
+
+func TestValidateTaskResourceQuota(t *testing.T) {
+	maxTaskCPU := resource.MustParse("1")
+	quota := ResourceQuota{MaxTaskCPU: &maxTaskCPU}
+	task := getTaskWithResources(
+		[]*core.Resources_ResourceEntry{
+			{Name: core.Resources_CPU, Value: "500m"},
+			{Name: core.Resources_MEMORY, Value: "100Gi"},
+		},
+		[]*core.Resources_ResourceEntry{
+			{Name: core.Resources_CPU, Value: "1"},
+		})
+	assert.NoError(t, ValidateTaskResourceQuota(task, quota))
+	assert.NoError(t, ValidateTaskResourceQuota(&core.CompiledTask{}, quota))
+}
+
+func TestValidateTaskResourceQuota_Exceeded(t *testing.T) {
+	maxTaskMemory := resource.MustParse("1Gi")
+	quota := ResourceQuota{MaxTaskMemory: &maxTaskMemory}
+	task := getTaskWithResources(
+		[]*core.Resources_ResourceEntry{
+			{Name: core.Resources_MEMORY, Value: "500Mi"},
+		},
+		[]*core.Resources_ResourceEntry{
+			{Name: core.Resources_MEMORY, Value: "2Gi"},
+		})
+	err := ValidateTaskResourceQuota(task, quota)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "MEMORY of 2Gi")
+	assert.Contains(t, err.Error(), "exceeds the quota of 1Gi")
+}
@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"github.com/lyft/flyteadmin/pkg/async/notifications"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Attributes apply to a project, to a domain of a project or to a workflow in them, so a workflow can't be given
+// without its domain. Feature flags may apply to a domain across all projects too, while quiet hours only apply to a
+// project or to a domain of it as notifications deferred during them are combined per project and domain.
+func validateResourceLevel(project, domain, workflow string, resourceType interfaces.MatchableResource) error {
+	if resourceType == interfaces.MatchableResourceFeatureFlags && len(project) == 0 && len(domain) > 0 &&
+		len(workflow) == 0 {
+		return nil
+	}
+	if err := ValidateEmptyStringField(project, shared.Project); err != nil {
+		return err
+	}
+	if len(workflow) > 0 && len(domain) == 0 {
+		return shared.GetMissingArgumentError(shared.Domain)
+	}
+	if resourceType == interfaces.MatchableResourceQuietHours && len(workflow) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"quiet hours can only be set for a project or a domain of a project, not for workflow [%s]", workflow)
+	}
+	return nil
+}
+
+func validateMatchableResource(resourceType interfaces.MatchableResource) error {
+	switch resourceType {
+	case interfaces.MatchableResourceTaskResource, interfaces.MatchableResourceExecutionQueue,
+		interfaces.MatchableResourceExecutionClusterLabel, interfaces.MatchableResourceFeatureFlags,
+		interfaces.MatchableResourceExecutionNamePolicy, interfaces.MatchableResourceQuietHours:
+		return nil
+	}
+	return shared.GetInvalidArgumentError(shared.ResourceType)
+}
+
+func validateTaskResourceSpec(spec interfaces.TaskResourceSpec) error {
+	for _, value := range []string{spec.CPU, spec.GPU, spec.Memory} {
+		if len(value) == 0 {
+			continue
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid task resource quantity %s", value)
+		}
+	}
+	return nil
+}
+
+func ValidateResourceAttributesUpdateRequest(request interfaces.ResourceAttributesUpdateRequest) error {
+	attributes := request.Attributes
+	var resourceType interfaces.MatchableResource
+	if attributes.FeatureFlags != nil {
+		resourceType = interfaces.MatchableResourceFeatureFlags
+	}
+	if attributes.QuietHours != nil {
+		resourceType = interfaces.MatchableResourceQuietHours
+	}
+	if err := validateResourceLevel(request.Project, request.Domain, request.Workflow, resourceType); err != nil {
+		return err
+	}
+	var setAttributes int
+	if attributes.TaskResourceAttributes != nil {
+		setAttributes++
+		if err := validateTaskResourceSpec(attributes.TaskResourceAttributes.Defaults); err != nil {
+			return err
+		}
+		if err := validateTaskResourceSpec(attributes.TaskResourceAttributes.Limits); err != nil {
+			return err
+		}
+	}
+	if attributes.ExecutionQueueAttributes != nil {
+		setAttributes++
+		if len(attributes.ExecutionQueueAttributes.Tags) == 0 {
+			return shared.GetMissingArgumentError(shared.ExecutionQueueTags)
+		}
+		for _, tag := range attributes.ExecutionQueueAttributes.Tags {
+			if len(tag) == 0 {
+				return shared.GetInvalidArgumentError(shared.ExecutionQueueTags)
+			}
+		}
+	}
+	if attributes.ExecutionClusterLabel != nil {
+		setAttributes++
+		if err := ValidateEmptyStringField(
+			attributes.ExecutionClusterLabel.Value, shared.ExecutionClusterLabel); err != nil {
+			return err
+		}
+	}
+	if attributes.FeatureFlags != nil {
+		setAttributes++
+	}
+	if attributes.ExecutionNamePolicy != nil {
+		setAttributes++
+		if err := ValidateExecutionNameTemplate(
+			attributes.ExecutionNamePolicy.Template, request.Project, request.Domain); err != nil {
+			return err
+		}
+	}
+	if attributes.QuietHours != nil {
+		setAttributes++
+		if err := notifications.ValidateQuietHours(*attributes.QuietHours); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s: %v", shared.QuietHours, err)
+		}
+	}
+	if setAttributes != 1 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"exactly one resource type must be set in %s", shared.Attributes)
+	}
+	return nil
+}
+
+func ValidateResourceAttributesGetRequest(request interfaces.ResourceAttributesGetRequest) error {
+	if err := validateResourceLevel(
+		request.Project, request.Domain, request.Workflow, request.ResourceType); err != nil {
+		return err
+	}
+	return validateMatchableResource(request.ResourceType)
+}
+
+func ValidateResourceAttributesDeleteRequest(request interfaces.ResourceAttributesDeleteRequest) error {
+	if err := validateResourceLevel(
+		request.Project, request.Domain, request.Workflow, request.ResourceType); err != nil {
+		return err
+	}
+	return validateMatchableResource(request.ResourceType)
+}
+
+func ValidateResourceAttributesListRequest(request interfaces.ResourceAttributesListRequest) error {
+	return validateMatchableResource(request.ResourceType)
+}
@@ -0,0 +1,153 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateResourceAttributesUpdateRequest(t *testing.T) {
+	err := ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Domain:  "domain",
+		Attributes: interfaces.MatchingAttributes{
+			TaskResourceAttributes: &interfaces.TaskResourceAttributes{
+				Defaults: interfaces.TaskResourceSpec{CPU: "500m", Memory: "1Gi"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionClusterLabel: &interfaces.ExecutionClusterLabel{Value: "cluster"},
+		},
+	})
+	assert.EqualError(t, err, "missing project")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project:  "project",
+		Workflow: "workflow",
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionClusterLabel: &interfaces.ExecutionClusterLabel{Value: "cluster"},
+		},
+	})
+	assert.EqualError(t, err, "missing domain")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Domain: "development",
+		Attributes: interfaces.MatchingAttributes{
+			FeatureFlags: &interfaces.FeatureFlags{DisableNotifications: true},
+		},
+	})
+	assert.NoError(t, err)
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Domain: "development",
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionClusterLabel: &interfaces.ExecutionClusterLabel{Value: "cluster"},
+		},
+	})
+	assert.EqualError(t, err, "missing project")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+	})
+	assert.EqualError(t, err, "exactly one resource type must be set in attributes")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionQueueAttributes: &interfaces.ExecutionQueueAttributes{Tags: []string{"gpu"}},
+			ExecutionClusterLabel:    &interfaces.ExecutionClusterLabel{Value: "cluster"},
+		},
+	})
+	assert.EqualError(t, err, "exactly one resource type must be set in attributes")
+}
+
+func TestValidateResourceAttributesUpdateRequest_InvalidAttributes(t *testing.T) {
+	err := ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Attributes: interfaces.MatchingAttributes{
+			TaskResourceAttributes: &interfaces.TaskResourceAttributes{
+				Limits: interfaces.TaskResourceSpec{Memory: "lots"},
+			},
+		},
+	})
+	assert.EqualError(t, err, "invalid task resource quantity lots")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionQueueAttributes: &interfaces.ExecutionQueueAttributes{},
+		},
+	})
+	assert.EqualError(t, err, "missing execution_queue_attributes.tags")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionClusterLabel: &interfaces.ExecutionClusterLabel{},
+		},
+	})
+	assert.EqualError(t, err, "missing execution_cluster_label.value")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Attributes: interfaces.MatchingAttributes{
+			ExecutionNamePolicy: &interfaces.ExecutionNamePolicy{Template: "{project}-{kickoff_date}"},
+		},
+	})
+	assert.EqualError(t, err, "execution name template [{project}-{kickoff_date}] leaves less than 6 characters "+
+		"for the random suffix of names")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project: "project",
+		Attributes: interfaces.MatchingAttributes{
+			QuietHours: &interfaces.QuietHours{Start: "22:00", End: "07:00", Timezone: "Not/AZone"},
+		},
+	})
+	assert.EqualError(t, err, "invalid quiet_hours: invalid timezone [Not/AZone]: unknown time zone Not/AZone")
+
+	err = ValidateResourceAttributesUpdateRequest(interfaces.ResourceAttributesUpdateRequest{
+		Project:  "project",
+		Domain:   "domain",
+		Workflow: "workflow",
+		Attributes: interfaces.MatchingAttributes{
+			QuietHours: &interfaces.QuietHours{Start: "22:00", End: "07:00"},
+		},
+	})
+	assert.EqualError(t, err, "quiet hours can only be set for a project or a domain of a project, not for "+
+		"workflow [workflow]")
+}
+
+func TestValidateResourceAttributesGetRequest(t *testing.T) {
+	err := ValidateResourceAttributesGetRequest(interfaces.ResourceAttributesGetRequest{
+		Project:      "project",
+		Domain:       "domain",
+		Workflow:     "workflow",
+		ResourceType: interfaces.MatchableResourceExecutionQueue,
+	})
+	assert.NoError(t, err)
+
+	err = ValidateResourceAttributesGetRequest(interfaces.ResourceAttributesGetRequest{
+		Project:      "project",
+		ResourceType: interfaces.MatchableResource(10),
+	})
+	assert.EqualError(t, err, "invalid value for resource_type")
+}
+
+func TestValidateResourceAttributesDeleteRequest(t *testing.T) {
+	err := ValidateResourceAttributesDeleteRequest(interfaces.ResourceAttributesDeleteRequest{
+		Domain:       "domain",
+		ResourceType: interfaces.MatchableResourceTaskResource,
+	})
+	assert.EqualError(t, err, "missing project")
+
+	err = ValidateResourceAttributesDeleteRequest(interfaces.ResourceAttributesDeleteRequest{
+		Domain:       "domain",
+		ResourceType: interfaces.MatchableResourceFeatureFlags,
+	})
+	assert.NoError(t, err)
+}
@@ -49,8 +49,5 @@ func ValidateTaskExecutionListRequest(request admin.TaskExecutionListRequest) er
 	if err := ValidateNodeExecutionIdentifier(request.NodeExecutionId); err != nil {
 		return err
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
-		return err
-	}
 	return nil
 }
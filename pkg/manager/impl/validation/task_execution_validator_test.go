@@ -167,5 +167,5 @@ func TestValidateTaskExecutionListRequest_MissingFields(t *testing.T) {
 			},
 		},
 	})
-	assert.EqualError(t, err, "invalid value for limit")
+	assert.Nil(t, err)
 }
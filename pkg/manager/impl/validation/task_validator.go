@@ -9,6 +9,7 @@ import (
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	runtime "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
@@ -259,8 +260,10 @@ func assignResourcesIfUnset(ctx context.Context, identifier *core.Identifier,
 //
 // Note: The system will assign a system-default value for request but for limit it will deduce it from the request
 // itself => Limit := Min([Some-Multiplier X Request], System-Max). For now we are using a multiplier of 1. In
-// general we recommend the users to set limits close to requests for more predictability in the system.
-func SetDefaults(ctx context.Context, taskConfig runtime.TaskResourceConfiguration, task *core.CompiledTask) {
+// general we recommend the users to set limits close to requests for more predictability in the system. The
+// system-default values are lowered to the resource quota of the task's project and domain, if any.
+func SetDefaults(ctx context.Context, taskConfig runtime.TaskResourceConfiguration, task *core.CompiledTask,
+	quota ResourceQuota) {
 	if task == nil {
 		logger.Warningf(ctx, "Can't set default resources for nil task.")
 		return
@@ -272,7 +275,8 @@ func SetDefaults(ctx context.Context, taskConfig runtime.TaskResourceConfigurati
 	}
 	logger.Debugf(ctx, "Assigning task requested resources for [%+v]", task.Template.Id)
 	task.Template.GetContainer().Resources.Requests = assignResourcesIfUnset(
-		ctx, task.Template.Id, taskConfig.GetDefaults(), task.Template.GetContainer().Resources.Requests)
+		ctx, task.Template.Id, quota.capTaskResourceSet(taskConfig.GetDefaults()),
+		task.Template.GetContainer().Resources.Requests)
 	logger.Debugf(ctx, "Assigning task resource limits for [%+v]", task.Template.Id)
 	task.Template.GetContainer().Resources.Limits = assignResourcesIfUnset(
 		ctx, task.Template.Id, createTaskDefaultLimits(ctx, task), task.Template.GetContainer().Resources.Limits)
@@ -337,3 +341,23 @@ func validateTaskType(taskID core.Identifier, taskType string, whitelistConfig r
 	}
 	return whitelistedTaskErr
 }
+
+func ValidateTaskStateUpdateRequest(request interfaces.TaskStateUpdateRequest) error {
+	if request.ID == nil {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	if request.ID.ResourceType != core.ResourceType_UNSPECIFIED && request.ID.ResourceType != core.ResourceType_TASK {
+		return shared.GetInvalidArgumentError(shared.ResourceType)
+	}
+	if err := ValidateNamedEntityIdentifier(&admin.NamedEntityIdentifier{
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
+		Name:    request.ID.Name,
+	}); err != nil {
+		return err
+	}
+	if request.State != interfaces.TaskStateActive && request.State != interfaces.TaskStateArchived {
+		return shared.GetInvalidArgumentError(shared.State)
+	}
+	return nil
+}
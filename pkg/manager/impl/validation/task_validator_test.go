@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	"github.com/stretchr/testify/assert"
@@ -443,7 +444,7 @@ func TestSetDefaults(t *testing.T) {
 		GPU:    "8",
 		Memory: "500Gi",
 	}
-	SetDefaults(context.Background(), &taskConfig, task)
+	SetDefaults(context.Background(), &taskConfig, task, ResourceQuota{})
 	assert.True(t, proto.Equal(
 		&core.Container{
 			Resources: &core.Resources{
@@ -472,6 +473,52 @@ func TestSetDefaults(t *testing.T) {
 		task.Template.GetContainer()), fmt.Sprintf("%+v", task.Template.GetContainer()))
 }
 
+func TestSetDefaults_ResourceQuota(t *testing.T) {
+	task := &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{
+					Resources: &core.Resources{},
+				},
+			},
+		},
+	}
+
+	taskConfig := runtimeMocks.MockTaskResourceConfiguration{}
+	taskConfig.Defaults = runtimeInterfaces.TaskResourceSet{
+		CPU:    "200m",
+		Memory: "200Gi",
+	}
+	maxTaskMemory := resource.MustParse("1Gi")
+	SetDefaults(context.Background(), &taskConfig, task, ResourceQuota{MaxTaskMemory: &maxTaskMemory})
+	assert.True(t, proto.Equal(
+		&core.Container{
+			Resources: &core.Resources{
+				Requests: []*core.Resources_ResourceEntry{
+					{
+						Name:  core.Resources_CPU,
+						Value: "200m",
+					},
+					{
+						Name:  core.Resources_MEMORY,
+						Value: "1Gi",
+					},
+				},
+				Limits: []*core.Resources_ResourceEntry{
+					{
+						Name:  core.Resources_CPU,
+						Value: "200m",
+					},
+					{
+						Name:  core.Resources_MEMORY,
+						Value: "1Gi",
+					},
+				},
+			},
+		},
+		task.Template.GetContainer()), fmt.Sprintf("%+v", task.Template.GetContainer()))
+}
+
 func TestSetDefaults_MissingDefaults(t *testing.T) {
 	task := &core.CompiledTask{
 		Template: &core.TaskTemplate{
@@ -500,7 +547,7 @@ func TestSetDefaults_MissingDefaults(t *testing.T) {
 		CPU: "300m",
 		GPU: "8",
 	}
-	SetDefaults(context.Background(), &taskConfig, task)
+	SetDefaults(context.Background(), &taskConfig, task, ResourceQuota{})
 	assert.True(t, proto.Equal(
 		&core.Container{
 			Resources: &core.Resources{
@@ -555,3 +602,29 @@ func TestCreateTaskDefaultLimits(t *testing.T) {
 	assert.Equal(t, "200Mi", defaultLimits.Memory)
 	assert.Equal(t, "200m", defaultLimits.CPU)
 }
+
+func TestValidateTaskStateUpdateRequest(t *testing.T) {
+	id := &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "name",
+	}
+	assert.NoError(t, ValidateTaskStateUpdateRequest(interfaces.TaskStateUpdateRequest{
+		ID:    id,
+		State: interfaces.TaskStateArchived,
+	}))
+	assert.EqualError(t, ValidateTaskStateUpdateRequest(interfaces.TaskStateUpdateRequest{
+		State: interfaces.TaskStateArchived,
+	}), "missing id")
+	assert.EqualError(t, ValidateTaskStateUpdateRequest(interfaces.TaskStateUpdateRequest{
+		ID: &core.Identifier{
+			Project: "project",
+			Domain:  "domain",
+		},
+	}), "missing name")
+	assert.EqualError(t, ValidateTaskStateUpdateRequest(interfaces.TaskStateUpdateRequest{
+		ID:    id,
+		State: interfaces.TaskState(2),
+	}), "invalid value for state")
+}
@@ -7,12 +7,29 @@ import (
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flytepropeller/pkg/compiler/validators"
 	"google.golang.org/grpc/codes"
 )
 
+// Page sizes enforced for list requests when none are configured.
+const defaultPageSize = 100
+const defaultMaxPageSize = 10000
+
+// Entities whose page size limits can be configured individually.
+const (
+	ExecutionListLimits     = "executions"
+	LaunchPlanListLimits    = "launchPlans"
+	NamedEntityListLimits   = "namedEntities"
+	NodeExecutionListLimits = "nodeExecutions"
+	ProjectListLimits       = "projects"
+	TaskListLimits          = "tasks"
+	TaskExecutionListLimits = "taskExecutions"
+	WorkflowListLimits      = "workflows"
+)
+
 var entityToResourceType = map[common.Entity]core.ResourceType{
 	common.Task:       core.ResourceType_TASK,
 	common.Workflow:   core.ResourceType_WORKFLOW,
@@ -100,9 +117,6 @@ func ValidateResourceListRequest(request admin.ResourceListRequest) error {
 	if err := ValidateEmptyStringField(request.Id.Domain, shared.Domain); err != nil {
 		return err
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -126,9 +140,6 @@ func ValidateActiveLaunchPlanListRequest(request admin.ActiveLaunchPlanListReque
 	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
 		return err
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -139,9 +150,6 @@ func ValidateNamedEntityIdentifierListRequest(request admin.NamedEntityIdentifie
 	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
 		return err
 	}
-	if err := ValidateLimit(request.Limit); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -205,9 +213,38 @@ func ValidateToken(token string) (int, error) {
 	return offset, nil
 }
 
-func ValidateLimit(limit uint32) error {
+// Applies the page size limits configured for the listed entity. List requests which don't specify a limit are served
+// pages of the default size and those requesting pages larger than the maximum are rejected.
+func ValidateLimit(limit uint32, config runtimeInterfaces.ApplicationConfiguration, entity string) (uint32, error) {
+	limits := getPageSizeLimits(config.GetListLimitsConfig(), entity)
 	if limit == 0 {
-		return shared.GetInvalidArgumentError(shared.Limit)
+		return limits.Default, nil
 	}
-	return nil
+	if limit > limits.Max {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid value for %s [%d], the maximum allowed is %d", shared.Limit, limit, limits.Max)
+	}
+	return limit, nil
+}
+
+func getPageSizeLimits(config *runtimeInterfaces.ListLimitsConfig, entity string) runtimeInterfaces.PageSizeLimits {
+	limits := config.Default
+	if entityLimits, ok := config.Entities[entity]; ok {
+		if entityLimits.Default > 0 {
+			limits.Default = entityLimits.Default
+		}
+		if entityLimits.Max > 0 {
+			limits.Max = entityLimits.Max
+		}
+	}
+	if limits.Max == 0 {
+		limits.Max = defaultMaxPageSize
+	}
+	if limits.Default == 0 {
+		limits.Default = defaultPageSize
+	}
+	if limits.Default > limits.Max {
+		limits.Default = limits.Max
+	}
+	return limits
 }
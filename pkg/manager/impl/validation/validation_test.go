@@ -6,6 +6,8 @@ import (
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flytepropeller/pkg/utils"
@@ -73,7 +75,7 @@ func TestValidateNamedEntityIdentifierListRequest(t *testing.T) {
 		Limit:   2,
 	}))
 
-	assert.NotNil(t, ValidateNamedEntityIdentifierListRequest(admin.NamedEntityIdentifierListRequest{
+	assert.Nil(t, ValidateNamedEntityIdentifierListRequest(admin.NamedEntityIdentifierListRequest{
 		Project: "project",
 		Domain:  "domain",
 	}))
@@ -137,7 +139,7 @@ func TestValidateListTaskRequest_MissingLimit(t *testing.T) {
 			Name:    "name",
 		},
 	}
-	assert.EqualError(t, ValidateResourceListRequest(request), "invalid value for limit")
+	assert.NoError(t, ValidateResourceListRequest(request))
 }
 
 func TestValidateParameterMap(t *testing.T) {
@@ -290,5 +292,48 @@ func TestValidateActiveLaunchPlanListRequest(t *testing.T) {
 			Limit:   0,
 		},
 	)
-	assert.Error(t, err)
+	assert.Nil(t, err)
+}
+
+func TestValidateLimit(t *testing.T) {
+	config := runtimeMocks.MockApplicationProvider{}
+	limit, err := ValidateLimit(0, &config, ExecutionListLimits)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(100), limit)
+
+	limit, err = ValidateLimit(10000, &config, ExecutionListLimits)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10000), limit)
+
+	_, err = ValidateLimit(100000, &config, ExecutionListLimits)
+	assert.EqualError(t, err, "invalid value for limit [100000], the maximum allowed is 10000")
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestValidateLimit_Configured(t *testing.T) {
+	config := runtimeMocks.MockApplicationProvider{}
+	config.SetListLimitsConfig(runtimeInterfaces.ListLimitsConfig{
+		Default: runtimeInterfaces.PageSizeLimits{
+			Default: 50,
+			Max:     500,
+		},
+		Entities: map[string]runtimeInterfaces.PageSizeLimits{
+			NodeExecutionListLimits: {
+				Max: 20,
+			},
+		},
+	})
+	limit, err := ValidateLimit(0, &config, ExecutionListLimits)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(50), limit)
+
+	_, err = ValidateLimit(501, &config, ExecutionListLimits)
+	assert.EqualError(t, err, "invalid value for limit [501], the maximum allowed is 500")
+
+	limit, err = ValidateLimit(0, &config, NodeExecutionListLimits)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(20), limit)
+
+	_, err = ValidateLimit(21, &config, NodeExecutionListLimits)
+	assert.EqualError(t, err, "invalid value for limit [21], the maximum allowed is 20")
 }
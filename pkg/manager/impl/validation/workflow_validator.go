@@ -61,3 +61,21 @@ func ValidateCompiledWorkflow(identifier core.Identifier, workflow admin.Workflo
 	}
 	return nil
 }
+
+// Workflows may reference the registered tasks of any project unless shared task projects are configured, in which
+// case they may only reference those of their own project and of projects sharing their tasks with it.
+func ValidateTaskReference(
+	workflowID, taskID core.Identifier, config runtime.ApplicationConfiguration) error {
+	sharedTaskProjects := config.GetTopLevelConfig().SharedTaskProjects
+	if len(sharedTaskProjects) == 0 || workflowID.Project == taskID.Project {
+		return nil
+	}
+	for _, project := range sharedTaskProjects[taskID.Project] {
+		if project == "*" || project == workflowID.Project {
+			return nil
+		}
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		"workflow [%+v] in project [%s] may not reference task [%+v] of project [%s] which isn't shared with it",
+		workflowID, workflowID.Project, taskID, taskID.Project)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
@@ -79,3 +80,35 @@ func TestValidateCompiledWorkflow(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.EqualError(t, err, "Workflow closure size exceeds max limit [1]")
 }
+
+func TestValidateTaskReference(t *testing.T) {
+	config := runtimeMocks.MockApplicationProvider{}
+	config.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		SharedTaskProjects: map[string][]string{
+			"shared":  {"*"},
+			"library": {"consumer"},
+		},
+	})
+	workflowID := core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Project: "consumer", Name: "workflow"}
+	for _, project := range []string{"consumer", "shared", "library"} {
+		taskID := core.Identifier{ResourceType: core.ResourceType_TASK, Project: project, Name: "task"}
+		assert.NoError(t, ValidateTaskReference(workflowID, taskID, &config), project)
+	}
+
+	otherWorkflowID := core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Project: "other", Name: "workflow"}
+	err := ValidateTaskReference(otherWorkflowID,
+		core.Identifier{ResourceType: core.ResourceType_TASK, Project: "library", Name: "task"}, &config)
+	assert.Error(t, err)
+
+	err = ValidateTaskReference(workflowID,
+		core.Identifier{ResourceType: core.ResourceType_TASK, Project: "private", Name: "task"}, &config)
+	assert.Error(t, err)
+}
+
+func TestValidateTaskReference_Unrestricted(t *testing.T) {
+	config := runtimeMocks.MockApplicationProvider{}
+	config.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{})
+	workflowID := core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Project: "consumer", Name: "workflow"}
+	taskID := core.Identifier{ResourceType: core.ResourceType_TASK, Project: "private", Name: "task"}
+	assert.NoError(t, ValidateTaskReference(workflowID, taskID, &config))
+}
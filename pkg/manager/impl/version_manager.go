@@ -0,0 +1,80 @@
+package impl
+
+import (
+	"context"
+	goRuntime "runtime"
+
+	"github.com/lyft/flytestdlib/version"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+type VersionManager struct {
+	config         runtimeInterfaces.Configuration
+	useAuth        bool
+	schemaReadOnly bool
+}
+
+func (m *VersionManager) getFeatureFlags() map[string]bool {
+	applicationConfig := m.config.ApplicationConfiguration()
+	topLevelConfig := applicationConfig.GetTopLevelConfig()
+	return map[string]bool{
+		"auth":              m.useAuth,
+		"closureOffloading": topLevelConfig.ClosureOffloading.NodeExecutionThresholdBytes > 0,
+		"cloudEvents":       applicationConfig.GetCloudEventsConfig().Enable,
+		"eventCompaction":   applicationConfig.GetEventCompactionConfig().MaxAge.Duration > 0,
+		"inputReferenceChecks": len(topLevelConfig.InputReferences.AllowedPrefixes) > 0 ||
+			len(topLevelConfig.InputReferences.ProjectAllowedPrefixes) > 0,
+		"lateEvents":       topLevelConfig.LateEvents.MaxDelay.Duration > 0,
+		"readOnlyMode":     topLevelConfig.ReadOnly.Enabled,
+		"retention":        len(applicationConfig.GetRetentionConfig().Domains) > 0,
+		"shadowReads":      topLevelConfig.ShadowReads.Enabled,
+		"versionRetention": len(applicationConfig.GetVersionRetentionConfig().Projects) > 0,
+	}
+}
+
+// Services which aren't configured are left out.
+func (m *VersionManager) getCloudProviders() map[string]string {
+	applicationConfig := m.config.ApplicationConfiguration()
+	schedulerConfig := applicationConfig.GetSchedulerConfig()
+	cloudProviders := map[string]string{
+		"remoteData":       applicationConfig.GetRemoteDataConfig().Scheme,
+		"notifications":    applicationConfig.GetNotificationsConfig().Type,
+		"eventScheduler":   schedulerConfig.EventSchedulerConfig.Scheme,
+		"workflowExecutor": schedulerConfig.WorkflowExecutorConfig.Scheme,
+	}
+	if cloudEventsConfig := applicationConfig.GetCloudEventsConfig(); cloudEventsConfig.Enable {
+		cloudProviders["cloudEvents"] = cloudEventsConfig.Type
+	}
+	for service, provider := range cloudProviders {
+		if provider == "" {
+			delete(cloudProviders, service)
+		}
+	}
+	return cloudProviders
+}
+
+func (m *VersionManager) GetVersion(ctx context.Context, request interfaces.VersionGetRequest) (
+	*interfaces.VersionGetResponse, error) {
+	return &interfaces.VersionGetResponse{
+		Version:        version.Version,
+		GitSHA:         version.Build,
+		BuildTime:      version.BuildTime,
+		GoVersion:      goRuntime.Version(),
+		FeatureFlags:   m.getFeatureFlags(),
+		CloudProviders: m.getCloudProviders(),
+		SchemaVersion:  repositoryConfig.GetExpectedSchemaVersion(),
+		SchemaReadOnly: m.schemaReadOnly,
+	}, nil
+}
+
+func NewVersionManager(
+	config runtimeInterfaces.Configuration, useAuth, schemaReadOnly bool) interfaces.VersionInterface {
+	return &VersionManager{
+		config:         config,
+		useAuth:        useAuth,
+		schemaReadOnly: schemaReadOnly,
+	}
+}
@@ -0,0 +1,53 @@
+package impl
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/lyft/flytestdlib/version"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+func TestGetVersion(t *testing.T) {
+	config := getMockExecutionsConfigProvider()
+	applicationProvider := config.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider)
+	applicationProvider.SetRemoteDataConfig(runtimeInterfaces.RemoteDataConfig{
+		Scheme: "aws",
+	})
+	applicationProvider.SetCloudEventsConfig(runtimeInterfaces.CloudEventsConfig{
+		Enable: true,
+		Type:   "aws",
+	})
+	applicationProvider.GetTopLevelConfig().ShadowReads.Enabled = true
+
+	response, err := NewVersionManager(config, true, false).GetVersion(
+		context.Background(), interfaces.VersionGetRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, version.Version, response.Version)
+	assert.Equal(t, version.Build, response.GitSHA)
+	assert.Equal(t, runtime.Version(), response.GoVersion)
+	assert.Equal(t, map[string]bool{
+		"auth":                 true,
+		"closureOffloading":    false,
+		"cloudEvents":          true,
+		"eventCompaction":      false,
+		"inputReferenceChecks": false,
+		"lateEvents":           false,
+		"readOnlyMode":         false,
+		"retention":            false,
+		"shadowReads":          true,
+		"versionRetention":     false,
+	}, response.FeatureFlags)
+	assert.Equal(t, map[string]string{
+		"remoteData":  "aws",
+		"cloudEvents": "aws",
+	}, response.CloudProviders)
+	assert.Equal(t, repositoryConfig.GetExpectedSchemaVersion(), response.SchemaVersion)
+	assert.False(t, response.SchemaReadOnly)
+}
@@ -3,12 +3,16 @@ package impl
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/shared"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/validation"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
@@ -55,24 +59,41 @@ func (w *WorkflowManager) setDefaults(request admin.WorkflowCreateRequest) (admi
 	return request, nil
 }
 
+// Compiles a workflow template against the given compiled tasks, and the registered tasks for those it references
+// which aren't given.
 // TODO: Once the SDK sends subworkflows, pipe them through to calls to GetRequirements & CompileWorkflow.
-func (w *WorkflowManager) getCompiledWorkflow(
-	ctx context.Context, request admin.WorkflowCreateRequest) (admin.WorkflowClosure, error) {
-	reqs, err := w.compiler.GetRequirements(request.Spec.Template, nil)
+func (w *WorkflowManager) compileWorkflowTemplate(ctx context.Context, template *core.WorkflowTemplate,
+	inlineTasks []*core.CompiledTask) (*core.CompiledWorkflowClosure, error) {
+	reqs, err := w.compiler.GetRequirements(template, nil)
 	if err != nil {
 		w.metrics.CompilationFailures.Inc()
 		logger.Errorf(ctx, "Failed to get workflow requirements for template [%+v] with err %v",
-			request.Spec.Template, err)
-		return admin.WorkflowClosure{}, err
+			template, err)
+		return nil, err
 	}
 
 	var tasks = make([]*core.CompiledTask, len(reqs.GetRequiredTaskIds()))
 	for idx, taskID := range reqs.GetRequiredTaskIds() {
+		for _, inlineTask := range inlineTasks {
+			if proto.Equal(inlineTask.Template.Id, &taskID) {
+				tasks[idx] = inlineTask
+				break
+			}
+		}
+		if tasks[idx] != nil {
+			continue
+		}
+		if template.Id != nil {
+			err = validation.ValidateTaskReference(*template.Id, taskID, w.config.ApplicationConfiguration())
+			if err != nil {
+				return nil, err
+			}
+		}
 		task, err := util.GetTask(ctx, w.db, taskID)
 		if err != nil {
 			logger.Debugf(ctx, "Failed to get task with id [%+v] when compiling workflow with id [%+v] with err %v",
-				taskID, request.Id, err)
-			return admin.WorkflowClosure{}, err
+				taskID, template.Id, err)
+			return nil, err
 		}
 		tasks[idx] = task.Closure.CompiledTask
 	}
@@ -83,23 +104,62 @@ func (w *WorkflowManager) getCompiledWorkflow(
 		launchPlanModel, err = util.GetLaunchPlanModel(ctx, w.db, launchPlanID)
 		if err != nil {
 			logger.Debugf(ctx, "Failed to get launch plan with id [%+v] when compiling workflow with id [%+v] with err %v",
-				launchPlanID, request.Id, err)
-			return admin.WorkflowClosure{}, err
+				launchPlanID, template.Id, err)
+			return nil, err
 		}
 		var launchPlanInterfaceProvider workflowengine.InterfaceProvider
 		launchPlanInterfaceProvider, err = workflowengine.NewLaunchPlanInterfaceProvider(launchPlanModel, launchPlanID)
 		if err != nil {
 			logger.Debugf(ctx, "Failed to create LaunchPlanInterfaceProvider for launch plan [%+v] with err %v",
 				launchPlanModel, err)
-			return admin.WorkflowClosure{}, err
+			return nil, err
 		}
 		launchPlans[idx] = launchPlanInterfaceProvider
 	}
 
-	closure, err := w.compiler.CompileWorkflow(request.Spec.Template, nil, tasks, launchPlans)
+	closure, err := w.compiler.CompileWorkflow(template, nil, tasks, launchPlans)
 	if err != nil {
 		w.metrics.CompilationFailures.Inc()
-		logger.Debugf(ctx, "Failed to compile workflow with id [%+v] with err %v", request.Id, err)
+		logger.Debugf(ctx, "Failed to compile workflow with id [%+v] with err %v", template.Id, err)
+		return nil, err
+	}
+	return closure, nil
+}
+
+// Looks up every task a workflow references before it's compiled, so that registering a workflow which references
+// unregistered tasks fails listing all of them rather than only the first one.
+func (w *WorkflowManager) verifyTaskReferences(ctx context.Context, template *core.WorkflowTemplate) error {
+	reqs, err := w.compiler.GetRequirements(template, nil)
+	if err != nil {
+		return err
+	}
+	missingTaskIDs := make([]string, 0)
+	for _, taskID := range reqs.GetRequiredTaskIds() {
+		taskID := taskID
+		_, err := util.GetTaskModel(ctx, w.db, &taskID)
+		if err == nil {
+			continue
+		}
+		if adminErr, ok := err.(errors.FlyteAdminError); !ok || adminErr.Code() != codes.NotFound {
+			logger.Debugf(ctx, "Failed to get task with id [%+v] referenced by workflow with id [%+v] with err %v",
+				taskID, template.Id, err)
+			return err
+		}
+		missingTaskIDs = append(missingTaskIDs, fmt.Sprintf("%s/%s/%s/%s",
+			taskID.Project, taskID.Domain, taskID.Name, taskID.Version))
+	}
+	if len(missingTaskIDs) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"workflow [%+v] references tasks which aren't registered: %s", template.Id,
+			strings.Join(missingTaskIDs, ", "))
+	}
+	return nil
+}
+
+func (w *WorkflowManager) getCompiledWorkflow(
+	ctx context.Context, request admin.WorkflowCreateRequest) (admin.WorkflowClosure, error) {
+	closure, err := w.compileWorkflowTemplate(ctx, request.Spec.Template, nil)
+	if err != nil {
 		return admin.WorkflowClosure{}, err
 	}
 	createdAt, err := ptypes.TimestampProto(time.Now())
@@ -136,10 +196,21 @@ func (w *WorkflowManager) CreateWorkflow(
 		logger.Debugf(ctx, "Failed to set defaults for workflow with id [%+v] with err %v", request.Id, err)
 		return nil, err
 	}
+	verifyTaskReferences := w.config.RegistrationValidationConfiguration().GetVerifyTaskReferences()
+	if verifyTaskReferences {
+		if err := w.verifyTaskReferences(ctx, finalizedRequest.Spec.Template); err != nil {
+			return nil, err
+		}
+	}
 	// Validate that the workflow compiles.
 	workflowClosure, err := w.getCompiledWorkflow(ctx, finalizedRequest)
 	if err != nil {
 		logger.Errorf(ctx, "Failed to compile workflow with err: %v", err)
+		if adminErr, ok := err.(errors.FlyteAdminError); verifyTaskReferences && ok &&
+			adminErr.Code() == codes.InvalidArgument {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"workflow [%+v] doesn't compile against the tasks and launch plans it references: %v", request.Id, err)
+		}
 		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
 			"failed to compile workflow for [%+v] with err %v", request.Id, err)
 	}
@@ -160,6 +231,10 @@ func (w *WorkflowManager) CreateWorkflow(
 	if err == nil {
 		// A workflow's structure is uniquely defined by its collection of nodes.
 		if bytes.Equal(workflowDigest, existingMatchingWorkflow.Digest) {
+			if w.config.ApplicationConfiguration().GetTopLevelConfig().IdempotentRegistration {
+				logger.Debugf(ctx, "identical workflow already exists with id [%+v]", request.Id)
+				return &admin.WorkflowCreateResponse{}, nil
+			}
 			return nil, errors.NewFlyteAdminErrorf(
 				codes.AlreadyExists, "identical workflow already exists with id %v", request.Id)
 		}
@@ -218,6 +293,92 @@ func (w *WorkflowManager) GetWorkflow(ctx context.Context, request admin.ObjectG
 	return workflow, nil
 }
 
+func (w *WorkflowManager) GetWorkflowGraph(
+	ctx context.Context, request admin.ObjectGetRequest) (*interfaces.WorkflowGraph, error) {
+	workflow, err := w.GetWorkflow(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	nodes, edges := util.GetWorkflowGraph(workflow.GetClosure().GetCompiledWorkflow())
+	return &interfaces.WorkflowGraph{
+		ID:    request.Id,
+		Nodes: nodes,
+		Edges: edges,
+		Dot:   util.ToWorkflowGraphDot(request.Id.Name, nodes, edges),
+	}, nil
+}
+
+// Only errors which the workflow or its tasks are to blame for are reported as diagnostics.
+func getWorkflowCompileDiagnostic(err error, taskID *core.Identifier) (interfaces.WorkflowCompileDiagnostic, bool) {
+	adminErr, ok := err.(errors.FlyteAdminError)
+	if !ok || (adminErr.Code() != codes.InvalidArgument && adminErr.Code() != codes.NotFound) {
+		return interfaces.WorkflowCompileDiagnostic{}, false
+	}
+	return interfaces.WorkflowCompileDiagnostic{
+		Code:    adminErr.Code().String(),
+		Message: adminErr.Error(),
+		TaskID:  taskID,
+	}, true
+}
+
+func (w *WorkflowManager) CompileWorkflow(
+	ctx context.Context, request interfaces.WorkflowCompileRequest) (*interfaces.WorkflowCompileResponse, error) {
+	if request.Workflow.GetTemplate() == nil {
+		return nil, shared.GetMissingArgumentError(shared.Spec)
+	}
+	if err := validation.ValidateIdentifier(request.Workflow.Template.Id, common.Workflow); err != nil {
+		return nil, err
+	}
+	response := &interfaces.WorkflowCompileResponse{
+		Diagnostics: make([]interfaces.WorkflowCompileDiagnostic, 0),
+	}
+	addDiagnostic := func(err error, taskID *core.Identifier) error {
+		diagnostic, ok := getWorkflowCompileDiagnostic(err, taskID)
+		if !ok {
+			return err
+		}
+		response.Diagnostics = append(response.Diagnostics, diagnostic)
+		return nil
+	}
+
+	tasks := make([]*core.CompiledTask, 0, len(request.Tasks))
+	for _, task := range request.Tasks {
+		err := validation.ValidateIdentifier(task.GetId(), common.Task)
+		if err == nil {
+			var compiledTask *core.CompiledTask
+			if compiledTask, err = w.compiler.CompileTask(task); err == nil {
+				tasks = append(tasks, compiledTask)
+				continue
+			}
+		}
+		if err = addDiagnostic(err, task.GetId()); err != nil {
+			return nil, err
+		}
+	}
+	// The workflow isn't compiled against tasks which failed compilation.
+	if len(response.Diagnostics) > 0 {
+		return response, nil
+	}
+
+	closure, err := w.compileWorkflowTemplate(ctx, request.Workflow.Template, tasks)
+	if err != nil {
+		if err = addDiagnostic(err, nil); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+	response.Closure = closure
+	err = validation.ValidateCompiledWorkflow(*request.Workflow.Template.Id, admin.WorkflowClosure{
+		CompiledWorkflow: closure,
+	}, w.config.RegistrationValidationConfiguration())
+	if err != nil {
+		if err = addDiagnostic(err, nil); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
 // Returns workflows *without* a populated workflow closure.
 func (w *WorkflowManager) ListWorkflows(
 	ctx context.Context, request admin.ResourceListRequest) (*admin.WorkflowList, error) {
@@ -225,6 +386,12 @@ func (w *WorkflowManager) ListWorkflows(
 	if err := validation.ValidateResourceListRequest(request); err != nil {
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, w.config.ApplicationConfiguration(), validation.WorkflowListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project:        request.Id.Project,
 		Domain:         request.Id.Domain,
@@ -279,6 +446,12 @@ func (w *WorkflowManager) ListWorkflowIdentifiers(ctx context.Context, request a
 		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
 		return nil, err
 	}
+	limit, err := validation.ValidateLimit(
+		request.Limit, w.config.ApplicationConfiguration(), validation.WorkflowListLimits)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
 
 	filters, err := util.GetDbFilters(util.FilterSpec{
 		Project: request.Project,
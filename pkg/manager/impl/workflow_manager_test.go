@@ -11,6 +11,7 @@ import (
 	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
 	adminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
@@ -18,6 +19,7 @@ import (
 
 	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+	workflowengine "github.com/lyft/flyteadmin/pkg/workflowengine/impl"
 	workflowengineInterfaces "github.com/lyft/flyteadmin/pkg/workflowengine/interfaces"
 	workflowengineMocks "github.com/lyft/flyteadmin/pkg/workflowengine/mocks"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
@@ -152,6 +154,39 @@ func TestCreateWorkflow(t *testing.T) {
 	assert.True(t, createCalled)
 }
 
+func TestCreateWorkflow_Identical(t *testing.T) {
+	repository := getMockRepository(returnWorkflowOnGet)
+	var registeredWorkflow *models.Workflow
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Workflow, error) {
+			if registeredWorkflow == nil {
+				return models.Workflow{}, adminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+			}
+			return *registeredWorkflow, nil
+		})
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetCreateCallback(func(input models.Workflow) error {
+		assert.Nil(t, registeredWorkflow)
+		registeredWorkflow = &input
+		return nil
+	})
+	mockConfig := getMockWorkflowConfigProvider()
+	workflowManager := NewWorkflowManager(
+		repository, mockConfig, getMockWorkflowCompiler(), getMockStorage(), storagePrefix, mockScope.NewTestScope())
+	_, err := workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+	assert.NoError(t, err)
+
+	_, err = workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+	assert.Equal(t, codes.AlreadyExists, err.(adminErrors.FlyteAdminError).Code())
+
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			IdempotentRegistration: true,
+		})
+	response, err := workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, &admin.WorkflowCreateResponse{}, response)
+}
+
 func TestCreateWorkflow_ValidationError(t *testing.T) {
 	workflowManager := NewWorkflowManager(
 		repositoryMocks.NewMockRepository(),
@@ -242,6 +277,190 @@ func TestCreateWorkflow_CompileWorkflowError(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func getMockWorkflowConfigProviderVerifyingTaskReferences() runtimeInterfaces.Configuration {
+	mockWorkflowConfigProvider := runtimeMocks.NewMockConfigurationProvider(
+		testutils.GetApplicationConfigWithDefaultProjects(), nil, nil, nil, nil, nil)
+	mockWorkflowConfigProvider.(*runtimeMocks.MockConfigurationProvider).AddRegistrationValidationConfiguration(
+		&runtimeMocks.MockRegistrationValidationProvider{VerifyTaskReferences: true})
+	return mockWorkflowConfigProvider
+}
+
+func getTaskNode(name string) *core.Node {
+	return &core.Node{
+		Id: name,
+		Target: &core.Node_TaskNode{
+			TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{
+					ReferenceId: &core.Identifier{
+						ResourceType: core.ResourceType_TASK,
+						Project:      "project",
+						Domain:       "domain",
+						Name:         name,
+						Version:      "version",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCreateWorkflow_UnregisteredTaskReferences(t *testing.T) {
+	repository := getMockRepository(!returnWorkflowOnGet)
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.GetResourceInput) (models.Task, error) {
+			return models.Task{}, adminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+	request := testutils.GetWorkflowRequest()
+	request.Spec.Template.Nodes = []*core.Node{getTaskNode("task1"), getTaskNode("task2")}
+
+	workflowManager := NewWorkflowManager(
+		repository, getMockWorkflowConfigProviderVerifyingTaskReferences(), workflowengine.NewCompiler(),
+		getMockStorage(), storagePrefix, mockScope.NewTestScope())
+	response, err := workflowManager.CreateWorkflow(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "project/domain/task1/version, project/domain/task2/version")
+	assert.Nil(t, response)
+
+	workflowManager = NewWorkflowManager(
+		repository, getMockWorkflowConfigProvider(), workflowengine.NewCompiler(), getMockStorage(), storagePrefix,
+		mockScope.NewTestScope())
+	response, err = workflowManager.CreateWorkflow(context.Background(), request)
+	assert.Equal(t, codes.Internal, err.(adminErrors.FlyteAdminError).Code())
+	assert.Nil(t, response)
+}
+
+func TestCreateWorkflow_VerifyingTaskReferencesCompileWorkflowError(t *testing.T) {
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		return nil, adminErrors.NewFlyteAdminError(codes.InvalidArgument, "mismatching types")
+	})
+
+	workflowManager := NewWorkflowManager(
+		getMockRepository(!returnWorkflowOnGet), getMockWorkflowConfigProviderVerifyingTaskReferences(),
+		mockCompiler, getMockStorage(), storagePrefix, mockScope.NewTestScope())
+	response, err := workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "mismatching types")
+	assert.Nil(t, response)
+}
+
+func getWorkflowCompileTaskTemplate(name string) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         name,
+			Version:      "version",
+		},
+	}
+}
+
+func TestCompileWorkflow(t *testing.T) {
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileTaskCallback(
+		func(task *core.TaskTemplate) (*core.CompiledTask, error) {
+			return &core.CompiledTask{
+				Template: task,
+			}, nil
+		})
+	workflowManager := NewWorkflowManager(
+		getMockRepository(!returnWorkflowOnGet), getMockWorkflowConfigProvider(), mockCompiler, getMockStorage(),
+		storagePrefix, mockScope.NewTestScope())
+	request := testutils.GetWorkflowRequest()
+	response, err := workflowManager.CompileWorkflow(context.Background(), managerInterfaces.WorkflowCompileRequest{
+		Workflow: request.Spec,
+		Tasks:    []*core.TaskTemplate{getWorkflowCompileTaskTemplate("task")},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, response.Diagnostics)
+	assert.True(t, proto.Equal(request.Spec.Template, response.Closure.Primary.Template))
+}
+
+func TestCompileWorkflow_TaskDiagnostics(t *testing.T) {
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileTaskCallback(
+		func(task *core.TaskTemplate) (*core.CompiledTask, error) {
+			return nil, adminErrors.NewFlyteAdminError(codes.InvalidArgument, "task failed compilation")
+		})
+	var compiled bool
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		compiled = true
+		return &core.CompiledWorkflowClosure{}, nil
+	})
+	workflowManager := NewWorkflowManager(
+		getMockRepository(!returnWorkflowOnGet), getMockWorkflowConfigProvider(), mockCompiler, getMockStorage(),
+		storagePrefix, mockScope.NewTestScope())
+	invalidTask := getWorkflowCompileTaskTemplate("invalid")
+	response, err := workflowManager.CompileWorkflow(context.Background(), managerInterfaces.WorkflowCompileRequest{
+		Workflow: testutils.GetWorkflowRequest().Spec,
+		Tasks:    []*core.TaskTemplate{{}, invalidTask},
+	})
+	assert.NoError(t, err)
+	assert.False(t, compiled)
+	assert.Nil(t, response.Closure)
+	assert.Equal(t, []managerInterfaces.WorkflowCompileDiagnostic{
+		{
+			Code:    codes.InvalidArgument.String(),
+			Message: "missing id",
+		},
+		{
+			Code:    codes.InvalidArgument.String(),
+			Message: "task failed compilation",
+			TaskID:  invalidTask.Id,
+		},
+	}, response.Diagnostics)
+}
+
+func TestCompileWorkflow_CompilationDiagnostic(t *testing.T) {
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		return nil, adminErrors.NewFlyteAdminError(codes.InvalidArgument, "failed to compile workflow")
+	})
+	workflowManager := NewWorkflowManager(
+		getMockRepository(!returnWorkflowOnGet), getMockWorkflowConfigProvider(), mockCompiler, getMockStorage(),
+		storagePrefix, mockScope.NewTestScope())
+	response, err := workflowManager.CompileWorkflow(context.Background(), managerInterfaces.WorkflowCompileRequest{
+		Workflow: testutils.GetWorkflowRequest().Spec,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, response.Closure)
+	assert.Equal(t, []managerInterfaces.WorkflowCompileDiagnostic{
+		{
+			Code:    codes.InvalidArgument.String(),
+			Message: "failed to compile workflow",
+		},
+	}, response.Diagnostics)
+}
+
+func TestCompileWorkflow_Errors(t *testing.T) {
+	expectedErr := errors.New("expected error")
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		return nil, expectedErr
+	})
+	workflowManager := NewWorkflowManager(
+		getMockRepository(!returnWorkflowOnGet), getMockWorkflowConfigProvider(), mockCompiler, getMockStorage(),
+		storagePrefix, mockScope.NewTestScope())
+	response, err := workflowManager.CompileWorkflow(context.Background(), managerInterfaces.WorkflowCompileRequest{
+		Workflow: testutils.GetWorkflowRequest().Spec,
+	})
+	assert.EqualError(t, err, expectedErr.Error())
+	assert.Nil(t, response)
+
+	response, err = workflowManager.CompileWorkflow(context.Background(), managerInterfaces.WorkflowCompileRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Nil(t, response)
+}
+
 func TestCreateWorkflow_DatabaseError(t *testing.T) {
 	repository := getMockRepository(!returnWorkflowOnGet)
 	expectedErr := errors.New("expected error")
@@ -305,6 +524,52 @@ func TestGetWorkflow(t *testing.T) {
 		"%+v !=\n %+v", testutils.GetWorkflowClosure(), workflow.Closure)
 }
 
+func TestGetWorkflowGraph(t *testing.T) {
+	repository := getMockRepository(returnWorkflowOnGet)
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			bytes, _ := proto.Marshal(testutils.GetWorkflowClosure())
+			_ = proto.Unmarshal(bytes, msg)
+			return nil
+		}
+	workflowManager := NewWorkflowManager(
+		repository, getMockWorkflowConfigProvider(), getMockWorkflowCompiler(), mockStorageClient, storagePrefix,
+		mockScope.NewTestScope())
+	graph, err := workflowManager.GetWorkflowGraph(context.Background(), admin.ObjectGetRequest{
+		Id: &workflowIdentifier,
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&workflowIdentifier, graph.ID))
+	assert.Equal(t, []managerInterfaces.WorkflowGraphNode{
+		{
+			ID:   "node 1",
+			Kind: managerInterfaces.WorkflowGraphNodeKindTask,
+			Name: "node 1",
+		},
+		{
+			ID:   "node 2",
+			Kind: managerInterfaces.WorkflowGraphNodeKindTask,
+			Name: "node 2",
+		},
+	}, graph.Nodes)
+	assert.Empty(t, graph.Edges)
+	assert.Contains(t, graph.Dot, `"node 1" [label="node 1", shape=box];`)
+}
+
+func TestGetWorkflowGraph_ValidationError(t *testing.T) {
+	workflowManager := NewWorkflowManager(
+		getMockRepository(returnWorkflowOnGet), getMockWorkflowConfigProvider(), getMockWorkflowCompiler(),
+		getMockStorage(), storagePrefix, mockScope.NewTestScope())
+	graph, err := workflowManager.GetWorkflowGraph(context.Background(), admin.ObjectGetRequest{
+		Id: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Nil(t, graph)
+}
+
 func TestGetWorkflow_DatabaseError(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	expectedErr := errors.New("expected error")
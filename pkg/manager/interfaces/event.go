@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Interface for applying batches of workflow, node and task execution events.
+type EventInterface interface {
+	CreateWorkflowEvents(ctx context.Context, request WorkflowEventsRequest) (*WorkflowEventsResponse, error)
+}
+
+// A single workflow, node or task execution event. Exactly one of the event requests must be set.
+type WorkflowEvent struct {
+	WorkflowEvent *admin.WorkflowExecutionEventRequest
+	NodeEvent     *admin.NodeExecutionEventRequest
+	TaskEvent     *admin.TaskExecutionEventRequest
+}
+
+// Events are applied in order and all events for the same workflow execution are applied in a single transaction,
+// so that either all or none of them are recorded.
+type WorkflowEventsRequest struct {
+	Events []WorkflowEvent
+}
+
+// The outcome of applying a single event. Code is the name of a gRPC status code, e.g. "OK" or "AlreadyExists".
+type WorkflowEventStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type WorkflowEventsResponse struct {
+	// One status per requested event, in request order.
+	Statuses []WorkflowEventStatus `json:"statuses"`
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflow Executions
@@ -21,4 +22,130 @@ type ExecutionInterface interface {
 	ListExecutions(ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error)
 	TerminateExecution(
 		ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+	BackfillExecutions(ctx context.Context, request BackfillExecutionsRequest, requestedAt time.Time) (
+		*BackfillExecutionsResponse, error)
+	GetBackfill(ctx context.Context, id string) (*Backfill, error)
+	UpdateExecution(ctx context.Context, request ExecutionUpdateRequest) (*ExecutionUpdateResponse, error)
+	GetExecutionFullData(ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (
+		*FullDataResponse, error)
+	ExportExecutionTimeline(ctx context.Context, request ExecutionTimelineExportRequest) (*ExecutionTimeline, error)
+	RerunExecutionNode(ctx context.Context, request ExecutionNodeRerunRequest, requestedAt time.Time) (
+		*admin.ExecutionCreateResponse, error)
+}
+
+// Requests one execution of a scheduled launch plan for every time its schedule fires within [StartTime, EndTime).
+type BackfillExecutionsRequest struct {
+	LaunchPlan *core.Identifier
+	StartTime  time.Time
+	EndTime    time.Time
+	// The launch plan input populated with each execution's kickoff time, defaults to the one named by the schedule.
+	KickoffTimeInputArg string
+	// Additional inputs shared by every execution.
+	Inputs *core.LiteralMap
+}
+
+// The outcome of launching the execution for a single schedule tick. Exactly one of ID and Error is set.
+type BackfillExecutionResult struct {
+	KickoffTime time.Time                         `json:"kickoffTime"`
+	ID          *core.WorkflowExecutionIdentifier `json:"id,omitempty"`
+	Error       string                            `json:"error,omitempty"`
+}
+
+// Identifies a backfill launching its executions in the background, see ExecutionInterface.GetBackfill.
+type BackfillExecutionsResponse struct {
+	ID string `json:"id"`
+	// How many executions the backfill launches, one for every time the launch plan schedule fires.
+	Executions int `json:"executions"`
+}
+
+// The progress of a backfill.
+type Backfill struct {
+	ID         string           `json:"id"`
+	LaunchPlan *core.Identifier `json:"launchPlan"`
+	StartTime  time.Time        `json:"startTime"`
+	EndTime    time.Time        `json:"endTime"`
+	// How many executions the backfill launches in all.
+	Total int `json:"total"`
+	// The outcomes of the executions launched so far, in the order of their kickoff times.
+	Executions []BackfillExecutionResult `json:"executions"`
+	// Counts executions which were launched or, for retried backfills, had already been launched.
+	Succeeded int `json:"succeeded"`
+	// How many of the succeeded executions already existed.
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+	// Set once an execution was launched, or failed to launch, for every kickoff time.
+	Done bool `json:"done"`
+}
+
+// Replaces the tags of an existing execution. An empty list of tags removes all of them.
+type ExecutionUpdateRequest struct {
+	ID   *core.WorkflowExecutionIdentifier
+	Tags []string
+}
+
+type ExecutionUpdateResponse struct {
+	// The tags the execution was updated with, trimmed and without duplicates.
+	Tags []string `json:"tags"`
+}
+
+// The signed URL blobs of a data response along with the literal maps they point to, which are only set when the
+// offloaded objects are no larger than the configured inline size threshold.
+type FullDataResponse struct {
+	Inputs      *admin.UrlBlob
+	Outputs     *admin.UrlBlob
+	FullInputs  *core.LiteralMap
+	FullOutputs *core.LiteralMap
+	// Only set for failed node executions which wrote an error document, along with the error decoded from it.
+	ErrorDocument *admin.UrlBlob
+	Error         *core.ExecutionError
+	// Only set for node executions whose task registered artifacts.
+	Artifacts []SignedNodeExecutionArtifact
+}
+
+// Fetches the recorded workflow, node and task execution events of an execution in a single timeline.
+type ExecutionTimelineExportRequest struct {
+	ID *core.WorkflowExecutionIdentifier
+}
+
+// The kinds of events in an execution timeline.
+const (
+	TimelineEventKindWorkflow = "workflow"
+	TimelineEventKindNode     = "node"
+	TimelineEventKindTask     = "task"
+)
+
+// The retry attempt of a task which a task timeline event was reported for.
+type TimelineTask struct {
+	Project      string `json:"project"`
+	Domain       string `json:"domain"`
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	RetryAttempt uint32 `json:"retryAttempt"`
+}
+
+// A phase reported by the execution, one of its node executions or one of their task executions.
+type TimelineEvent struct {
+	Kind string `json:"kind"`
+	// Set for node and task events.
+	NodeID string `json:"nodeId,omitempty"`
+	// Only set for task events.
+	Task       *TimelineTask `json:"task,omitempty"`
+	Phase      string        `json:"phase"`
+	OccurredAt time.Time     `json:"occurredAt"`
+}
+
+type ExecutionTimeline struct {
+	ID *core.WorkflowExecutionIdentifier `json:"-"`
+	// Ordered by when each event occurred.
+	Events []TimelineEvent `json:"events"`
+}
+
+// Re-runs a failed node of a terminated execution, along with the nodes downstream of it, as a new execution. The nodes
+// upstream of it don't run again, their recorded outputs are bound in their place.
+type ExecutionNodeRerunRequest struct {
+	// The terminated execution to re-run the node of.
+	ID     *core.WorkflowExecutionIdentifier
+	NodeID string
+	// The name of the new execution, which is generated when unset.
+	Name string
 }
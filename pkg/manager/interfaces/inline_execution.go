@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for launching tasks and workflows which haven't been registered yet.
+type InlineExecutionInterface interface {
+	CreateInlineExecution(ctx context.Context, request InlineExecutionCreateRequest, requestedAt time.Time) (
+		*InlineExecutionCreateResponse, error)
+}
+
+// Registers the tasks and workflow of an execution, along with a launch plan for the workflow, and launches it. They
+// are registered in the execution's project and domain under a version generated from their specs, so launching the
+// same specs again reuses the registered entities. When no workflow is set, a workflow running the single task is
+// generated.
+type InlineExecutionCreateRequest struct {
+	Project string
+	Domain  string
+	// The name of the execution, which is generated when unset.
+	Name  string
+	Tasks []*admin.TaskSpec
+	// Task nodes referencing a task by the name of one of the inline tasks run that task.
+	Workflow *admin.WorkflowSpec
+	Inputs   *core.LiteralMap
+	// Optional, the launch plan of the spec is ignored.
+	Spec *admin.ExecutionSpec
+}
+
+type InlineExecutionCreateResponse struct {
+	ID *core.WorkflowExecutionIdentifier `json:"id"`
+	// The version the tasks, workflow and launch plan were registered under.
+	Version string `json:"version"`
+}
@@ -2,8 +2,19 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The states of the schedules of active launch plans. Paused schedules don't fire, however the launch plan remains
+// active and can still be launched manually.
+type LaunchPlanScheduleState int32
+
+const (
+	LaunchPlanScheduleStateActive LaunchPlanScheduleState = iota
+	LaunchPlanScheduleStatePaused
 )
 
 // Interface for managing Flyte Launch Plans
@@ -23,4 +34,108 @@ type LaunchPlanInterface interface {
 		*admin.LaunchPlanList, error)
 	ListLaunchPlanIds(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	UpdateLaunchPlanScheduleState(ctx context.Context, request LaunchPlanScheduleStateUpdateRequest) (
+		*LaunchPlanScheduleStateUpdateResponse, error)
+	ListLaunchPlanStateHistory(ctx context.Context, request LaunchPlanStateHistoryListRequest) (
+		*LaunchPlanStateHistory, error)
+	GetLaunchPlanInputs(ctx context.Context, request admin.ObjectGetRequest) (*LaunchPlanInputs, error)
+	CreateLaunchPlanVariants(ctx context.Context, request LaunchPlanVariantsCreateRequest) (
+		*LaunchPlanVariantsCreateResponse, error)
+}
+
+// Pauses or resumes the schedule of the active version of a launch plan.
+type LaunchPlanScheduleStateUpdateRequest struct {
+	ID    *admin.NamedEntityIdentifier
+	State LaunchPlanScheduleState
+}
+
+type LaunchPlanScheduleStateUpdateResponse struct{}
+
+// Lists the ACTIVE/INACTIVE transitions of all versions of a launch plan, most recent first.
+type LaunchPlanStateHistoryListRequest struct {
+	ID    *admin.NamedEntityIdentifier
+	Limit uint32
+	Token string
+}
+
+// A transition of a launch plan version to the ACTIVE or INACTIVE state.
+type LaunchPlanStateChange struct {
+	Version string `json:"version"`
+	// Either ACTIVE or INACTIVE.
+	State string `json:"state"`
+	// The email of the authenticated user who changed the state, empty when the request wasn't authenticated.
+	Principal  string    `json:"principal"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+type LaunchPlanStateHistory struct {
+	Changes []LaunchPlanStateChange `json:"changes"`
+	// Set when there may be more changes to list, which are listed by passing the token to the next request.
+	Token string `json:"token"`
+}
+
+// Where the value an input takes when an execution doesn't set it comes from.
+type LaunchPlanInputDefaultSource string
+
+const (
+	// The input has no default, executions must set required inputs.
+	LaunchPlanInputDefaultSourceNone LaunchPlanInputDefaultSource = ""
+	// The default input value of the launch plan.
+	LaunchPlanInputDefaultSourceLaunchPlan LaunchPlanInputDefaultSource = "LAUNCH_PLAN"
+	// A default_input. attribute of the launch plan's project and domain.
+	LaunchPlanInputDefaultSourceProjectDomain LaunchPlanInputDefaultSource = "PROJECT_DOMAIN"
+	// A fixed input of the launch plan, which executions can't override.
+	LaunchPlanInputDefaultSourceFixed LaunchPlanInputDefaultSource = "FIXED"
+)
+
+// An input of a launch plan along with the value it takes when an execution doesn't set it.
+type LaunchPlanInput struct {
+	Name        string
+	Description string
+	Type        *core.LiteralType
+	// Whether executions must set the input, which is the case for required inputs without a project-domain default.
+	Required bool
+	// Whether executions may set the input, which they may for all but fixed inputs.
+	Overridable bool
+	// Unset when the input has no default.
+	Default       *core.Literal
+	DefaultSource LaunchPlanInputDefaultSource
+}
+
+// The inputs of a launch plan version, resolved with the precedence applied when an execution is created, sorted by
+// name.
+type LaunchPlanInputs struct {
+	ID     *core.Identifier
+	Inputs []LaunchPlanInput
+}
+
+// Creates a launch plan for every variant of a base launch plan version, e.g. one per region, sparing clients from
+// registering many nearly identical specs.
+type LaunchPlanVariantsCreateRequest struct {
+	Base     *core.Identifier
+	Variants []LaunchPlanVariant
+	// When set, the cron schedule each variant inherits from the base launch plan fires this many minutes after the
+	// one of the preceding variant, so that the variants' executions don't all start at once.
+	StaggerMinutes int
+}
+
+// A variant is registered with the version of the base launch plan, under the base name suffixed with its key.
+type LaunchPlanVariant struct {
+	Key string
+	// Set in addition to, or in place of, the fixed inputs of the base launch plan.
+	FixedInputs *core.LiteralMap
+	// Replaces the schedule inherited from the base launch plan.
+	Schedule *admin.Schedule
+}
+
+// The outcome of creating a single variant. Error is set when it couldn't be created.
+type LaunchPlanVariantResult struct {
+	ID    *core.Identifier `json:"id"`
+	Error string           `json:"error,omitempty"`
+}
+
+type LaunchPlanVariantsCreateResponse struct {
+	LaunchPlans []LaunchPlanVariantResult `json:"launchPlans"`
+	Succeeded   int                       `json:"succeeded"`
+	Failed      int                       `json:"failed"`
 }
@@ -2,8 +2,18 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The states of named entities.
+type NamedEntityState int32
+
+const (
+	NamedEntityStateActive NamedEntityState = iota
+	NamedEntityStateArchived
 )
 
 // Interface for managing metadata associated with NamedEntityIdentifiers
@@ -11,4 +21,75 @@ type NamedEntityInterface interface {
 	GetNamedEntity(ctx context.Context, request admin.NamedEntityGetRequest) (*admin.NamedEntity, error)
 	UpdateNamedEntity(ctx context.Context, request admin.NamedEntityUpdateRequest) (*admin.NamedEntityUpdateResponse, error)
 	ListNamedEntities(ctx context.Context, request admin.NamedEntityListRequest) (*admin.NamedEntityList, error)
+	GetEntityUsage(ctx context.Context, request EntityUsageGetRequest) (*EntityUsage, error)
+	SearchEntities(ctx context.Context, request EntitySearchRequest) (*EntitySearchResults, error)
+	UpdateNamedEntityState(ctx context.Context, request NamedEntityStateUpdateRequest) (
+		*NamedEntityStateUpdateResponse, error)
+}
+
+// Requests the usage of the workflows and launch plans in a project, and optionally only those in one of its domains.
+type EntityUsageGetRequest struct {
+	Project string
+	Domain  string
+}
+
+// How recently and how often a workflow or launch plan was executed.
+type NamedEntityUsage struct {
+	ID *admin.NamedEntityIdentifier `json:"id"`
+	// Unset when the workflow or launch plan was never executed.
+	LastExecutedAt *time.Time `json:"lastExecutedAt,omitempty"`
+	// The number of executions created since the start of the usage window.
+	ExecutionCount int64 `json:"executionCount"`
+}
+
+type EntityUsage struct {
+	// The start of the window executions were counted in.
+	Since       time.Time          `json:"since"`
+	Workflows   []NamedEntityUsage `json:"workflows"`
+	LaunchPlans []NamedEntityUsage `json:"launchPlans"`
+}
+
+// Searches the tasks, workflows and launch plans of a project, and optionally only those in one of its domains, for
+// those whose name or description contains every whitespace-separated keyword of the query, ignoring case.
+type EntitySearchRequest struct {
+	Project string
+	Domain  string
+	Query   string
+	// Optional, tasks, workflows and launch plans are all searched when empty.
+	ResourceTypes []core.ResourceType
+	Limit         uint32
+	Token         string
+}
+
+type EntitySearchResult struct {
+	// One of TASK, WORKFLOW or LAUNCH_PLAN.
+	ResourceType string                       `json:"resourceType"`
+	ID           *admin.NamedEntityIdentifier `json:"id"`
+	Description  string                       `json:"description,omitempty"`
+}
+
+// Matching entities sorted by name.
+type EntitySearchResults struct {
+	Entities []EntitySearchResult `json:"entities"`
+	// Set when there may be more entities to list, which are listed by passing the token to the next request.
+	Token string `json:"token"`
+}
+
+// Archives or restores a task, workflow or launch plan. Archiving a workflow with Cascade set archives the launch plans
+// with a version referencing any version of the workflow too, deactivating their active versions and removing their
+// schedules.
+type NamedEntityStateUpdateRequest struct {
+	ResourceType core.ResourceType
+	ID           *admin.NamedEntityIdentifier
+	State        NamedEntityState
+	Cascade      bool
+	// Lists what archiving would affect without changing anything.
+	DryRun bool
+}
+
+// The launch plans affected by archiving a workflow, or which would be affected for dry runs.
+type NamedEntityStateUpdateResponse struct {
+	ArchivedLaunchPlans    []*admin.NamedEntityIdentifier `json:"archivedLaunchPlans"`
+	DeactivatedLaunchPlans []*core.Identifier             `json:"deactivatedLaunchPlans"`
+	RemovedSchedules       []*admin.NamedEntityIdentifier `json:"removedSchedules"`
 }
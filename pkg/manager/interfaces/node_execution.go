@@ -2,8 +2,10 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflow NodeExecutions
@@ -13,6 +15,194 @@ type NodeExecutionInterface interface {
 	GetNodeExecution(ctx context.Context, request admin.NodeExecutionGetRequest) (*admin.NodeExecution, error)
 	ListNodeExecutions(ctx context.Context, request admin.NodeExecutionListRequest) (*admin.NodeExecutionList, error)
 	ListNodeExecutionsForTask(ctx context.Context, request admin.NodeExecutionForTaskListRequest) (*admin.NodeExecutionList, error)
+	ListNodeExecutionChildren(ctx context.Context, request NodeExecutionChildrenListRequest) (*admin.NodeExecutionList, error)
+	ListNodeExecutionsByTask(ctx context.Context, request NodeExecutionsByTaskListRequest) (*admin.NodeExecutionList, error)
 	GetNodeExecutionData(
 		ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error)
+	CreateDynamicNodeWorkflow(ctx context.Context, request DynamicNodeWorkflowCreateRequest) (
+		*DynamicNodeWorkflowCreateResponse, error)
+	GetDynamicNodeWorkflow(ctx context.Context, request DynamicNodeWorkflowGetRequest) (*admin.WorkflowClosure, error)
+	GetNodeExecutionFullData(ctx context.Context, request admin.NodeExecutionGetDataRequest) (*FullDataResponse, error)
+	GetNodeExecutionAttemptData(ctx context.Context, request NodeExecutionAttemptDataGetRequest) (
+		*NodeExecutionAttemptDataResponse, error)
+	CreateNodeExecutionCacheMetadata(ctx context.Context, request NodeExecutionCacheMetadataCreateRequest) (
+		*NodeExecutionCacheMetadataCreateResponse, error)
+	GetNodeExecutionCacheMetadata(ctx context.Context, request NodeExecutionCacheMetadataGetRequest) (
+		*NodeExecutionCacheMetadata, error)
+	GetNodeExecutionMetrics(ctx context.Context, request NodeExecutionMetricsGetRequest) (
+		*NodeExecutionMetricsGetResponse, error)
+	CreateNodeExecutionArtifacts(ctx context.Context, request NodeExecutionArtifactsCreateRequest) (
+		*NodeExecutionArtifactsCreateResponse, error)
+	GetNodeExecutionArtifacts(ctx context.Context, request NodeExecutionArtifactsGetRequest) (
+		*NodeExecutionArtifactsGetResponse, error)
+	GetNodeExecutionChildRollup(ctx context.Context, request NodeExecutionChildRollupGetRequest) (
+		*NodeExecutionChildRollup, error)
+}
+
+// Lists the node executions directly launched by a parent node execution, such as the nodes yielded by a dynamic task.
+type NodeExecutionChildrenListRequest struct {
+	ParentNodeExecutionID *core.NodeExecutionIdentifier
+	Limit                 uint32
+	Token                 string
+	Filters               string
+	SortBy                *admin.Sort
+}
+
+// Fetches the rollup of the phases of a parent node execution's children.
+type NodeExecutionChildRollupGetRequest struct {
+	ParentNodeExecutionID *core.NodeExecutionIdentifier
+}
+
+// How many children of a parent node execution are in each phase, so that progress can be shown without listing
+// every child.
+type NodeExecutionChildRollup struct {
+	// Keyed by node execution phase, e.g. "SUCCEEDED". Phases without children are left out.
+	PhaseCounts map[string]int64 `json:"phaseCounts"`
+	Total       int64            `json:"total"`
+}
+
+// Lists the node executions which ran a task across all executions of the task's project and domain, e.g. to find all
+// runs of a task in the last week. The task version may be left empty to match all versions.
+type NodeExecutionsByTaskListRequest struct {
+	TaskID  *core.Identifier
+	Limit   uint32
+	Token   string
+	Filters string
+	SortBy  *admin.Sort
+}
+
+// Records the workflow which the task of a dynamic node compiled at runtime.
+type DynamicNodeWorkflowCreateRequest struct {
+	NodeExecutionID  *core.NodeExecutionIdentifier
+	CompiledWorkflow *core.CompiledWorkflowClosure
+}
+
+type DynamicNodeWorkflowCreateResponse struct{}
+
+// Fetches the workflow compiled at runtime by the task of a dynamic node.
+type DynamicNodeWorkflowGetRequest struct {
+	NodeExecutionID *core.NodeExecutionIdentifier
+}
+
+// Fetches the data of one retry attempt of a node execution's task rather than that of its latest attempt.
+type NodeExecutionAttemptDataGetRequest struct {
+	NodeExecutionID *core.NodeExecutionIdentifier
+	RetryAttempt    uint32
+}
+
+// The signed URL blobs of a retry attempt's data. Fields are only set when the attempt recorded the corresponding data.
+type NodeExecutionAttemptDataResponse struct {
+	Inputs  *admin.UrlBlob
+	Outputs *admin.UrlBlob
+	// The error the attempt failed with.
+	Error *core.ExecutionError
+	// The offloaded error document the attempt wrote when it failed.
+	ErrorDocument *admin.UrlBlob
+}
+
+// Catalog cache statuses a node execution's task can report.
+const (
+	// The task isn't cacheable or caching was disabled for it.
+	CacheDisabled = "CACHE_DISABLED"
+	// The task's outputs were found in the catalog and reused.
+	CacheHit = "CACHE_HIT"
+	// The task's outputs weren't found in the catalog.
+	CacheMiss = "CACHE_MISS"
+	// The task's outputs weren't found in the catalog and were written to it once computed.
+	CachePopulated = "CACHE_POPULATED"
+	// The catalog couldn't be queried for the task's outputs.
+	CacheLookupFailure = "CACHE_LOOKUP_FAILURE"
+	// The task's outputs couldn't be written to the catalog.
+	CachePutFailure = "CACHE_PUT_FAILURE"
+)
+
+// Catalog cache information about the task of a node execution.
+type NodeExecutionCacheMetadata struct {
+	// One of the cache statuses above.
+	CacheStatus string `json:"cacheStatus"`
+	// Key of the catalog artifact the node execution's outputs were read from or written to.
+	ArtifactKey string `json:"artifactKey,omitempty"`
+	// For cache hits, the node execution which originally produced the cached outputs.
+	SourceExecution *core.NodeExecutionIdentifier `json:"sourceExecution,omitempty"`
+}
+
+// Records the catalog cache information reported for the task of a node execution.
+type NodeExecutionCacheMetadataCreateRequest struct {
+	NodeExecutionID *core.NodeExecutionIdentifier
+	Metadata        NodeExecutionCacheMetadata
+}
+
+type NodeExecutionCacheMetadataCreateResponse struct{}
+
+type NodeExecutionCacheMetadataGetRequest struct {
+	NodeExecutionID *core.NodeExecutionIdentifier
+}
+
+// Kinds of auxiliary artifacts a node execution's task can register.
+const (
+	// An HTML report, such as a data validation summary.
+	ArtifactKindHTML = "HTML"
+	// A rendered deck of the task's inputs, outputs and intermediate results.
+	ArtifactKindDeck = "DECK"
+	// A profiling dump, such as a pprof or py-spy profile.
+	ArtifactKindProfile = "PROFILE"
+)
+
+// An auxiliary artifact written by the task of a node execution which is shown alongside its data.
+type NodeExecutionArtifact struct {
+	// Identifies the artifact among those of the node execution.
+	Name string `json:"name"`
+	// One of the artifact kinds above.
+	Kind string `json:"kind"`
+	URI  string `json:"uri"`
+}
+
+// A registered artifact along with a signed URL to fetch it from.
+type SignedNodeExecutionArtifact struct {
+	NodeExecutionArtifact
+	SignedURL *admin.UrlBlob `json:"signedUrl"`
+}
+
+// Registers artifacts written by the task of a node execution. Artifacts replace earlier ones of the same name.
+type NodeExecutionArtifactsCreateRequest struct {
+	NodeExecutionID *core.NodeExecutionIdentifier
+	Artifacts       []NodeExecutionArtifact
+}
+
+type NodeExecutionArtifactsCreateResponse struct{}
+
+type NodeExecutionArtifactsGetRequest struct {
+	NodeExecutionID *core.NodeExecutionIdentifier
+}
+
+type NodeExecutionArtifactsGetResponse struct {
+	Artifacts []SignedNodeExecutionArtifact `json:"artifacts"`
+}
+
+// Fetches how long each node execution of a workflow execution spent in each stage of its lifecycle.
+type NodeExecutionMetricsGetRequest struct {
+	WorkflowExecutionID *core.WorkflowExecutionIdentifier
+}
+
+// Stage durations of a node execution computed from its recorded events. A duration is only set once the node
+// execution has reported the phase which ends it.
+type NodeExecutionMetrics struct {
+	NodeID string `json:"nodeId"`
+	// The latest phase the node execution reported.
+	Phase string `json:"phase"`
+	// When the node execution's first event occurred.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time from the first event until the node execution was queued.
+	CreatedToQueued *time.Duration `json:"createdToQueued,omitempty"`
+	// Time spent queued before the node execution started running.
+	QueuedToRunning *time.Duration `json:"queuedToRunning,omitempty"`
+	// Time spent running before the node execution reached a terminal phase.
+	RunningToTerminal *time.Duration `json:"runningToTerminal,omitempty"`
+	// Time from the first event until the node execution reached a terminal phase.
+	Total *time.Duration `json:"total,omitempty"`
+}
+
+type NodeExecutionMetricsGetResponse struct {
+	// Ordered by when each node execution was created. Durations are serialized in nanoseconds.
+	NodeExecutions []NodeExecutionMetrics `json:"nodeExecutions"`
 }
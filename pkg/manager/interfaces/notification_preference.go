@@ -0,0 +1,46 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// The channels users can receive launch plan failure notifications on.
+const (
+	EmailNotificationChannel     = "email"
+	SlackNotificationChannel     = "slack"
+	PagerDutyNotificationChannel = "pagerduty"
+)
+
+// Interface for managing the notification preferences of the requesting user.
+type NotificationPreferenceInterface interface {
+	UpdateNotificationPreference(ctx context.Context, request NotificationPreferenceUpdateRequest) (
+		*NotificationPreferenceUpdateResponse, error)
+	ListNotificationPreferences(ctx context.Context, request NotificationPreferenceListRequest) (
+		*NotificationPreferenceListResponse, error)
+}
+
+// Whether a user is subscribed to the failure notifications of a launch plan's executions and on which channel.
+// Unsubscribed users are removed from the recipients the launch plan configures.
+type NotificationPreference struct {
+	LaunchPlan *admin.NamedEntityIdentifier `json:"launchPlan"`
+	Subscribed bool                         `json:"subscribed"`
+	// Defaults to email when unset.
+	Channel string `json:"channel"`
+	// The terminal phases the user is notified of, e.g. FAILED and ABORTED, defaulting to FAILED and TIMED_OUT when
+	// unset. The user is removed from the recipients the launch plan configures for any other phase.
+	Phases []string `json:"phases,omitempty"`
+}
+
+type NotificationPreferenceUpdateRequest struct {
+	Preference NotificationPreference `json:"preference"`
+}
+
+type NotificationPreferenceUpdateResponse struct{}
+
+type NotificationPreferenceListRequest struct{}
+
+type NotificationPreferenceListResponse struct {
+	Preferences []NotificationPreference `json:"preferences"`
+}
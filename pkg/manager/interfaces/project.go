@@ -2,12 +2,157 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
+// The states of projects. Archived projects are left out of project listings by default, and executions can't be
+// created in them.
+type ProjectState int32
+
+const (
+	ProjectStateActive ProjectState = iota
+	ProjectStateArchived
+)
+
 // Interface for managing projects (and domains).
 type ProjectInterface interface {
 	CreateProject(ctx context.Context, request admin.ProjectRegisterRequest) (*admin.ProjectRegisterResponse, error)
 	ListProjects(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error)
+	GetProjectOnboardingStatus(ctx context.Context, request ProjectOnboardingStatusGetRequest) (
+		*ProjectOnboardingStatus, error)
+	UpdateProject(ctx context.Context, request ProjectUpdateRequest) (*ProjectUpdateResponse, error)
+	ListProjectDetails(ctx context.Context, request ProjectDetailsListRequest) (*ProjectDetailsList, error)
+	GetProjectUsageReport(ctx context.Context, request ProjectUsageReportRequest) (*ProjectUsageReport, error)
+	RegisterDomain(ctx context.Context, request DomainRegisterRequest) (*DomainRegisterResponse, error)
+	ListDomains(ctx context.Context) (*DomainList, error)
+}
+
+// Updates a registered project. Fields which aren't set are left unchanged.
+type ProjectUpdateRequest struct {
+	ID          string
+	Description *string
+	// Replaces all labels of the project when not nil.
+	Labels map[string]string
+	State  *ProjectState
+}
+
+type ProjectUpdateResponse struct{}
+
+type ProjectDetailsListRequest struct {
+	IncludeArchived bool
+	// Filters on project fields, e.g. "contains(name,foo)+eq(state,0)", in the format used by other list requests.
+	Filters string
+	// Only projects carrying all of these labels are listed.
+	Labels map[string]string
+	// The page size, the configured default is used when unset.
+	Limit uint32
+	// The token returned along with the previous page, if any.
+	Token string
+}
+
+// A registered project, including the labels and state which the pinned flyteidl admin.Project doesn't have.
+type ProjectDetails struct {
+	ID          string
+	Name        string
+	Description string
+	Labels      map[string]string
+	State       ProjectState
+}
+
+type ProjectDetailsList struct {
+	Projects []ProjectDetails
+	// Requests the next page when set.
+	Token string
+}
+
+// Requests the usage of the executions created within a window, e.g. for chargeback.
+type ProjectUsageReportRequest struct {
+	// Optional, the usage of all projects is reported when empty.
+	Project string
+	// Optional, the usage of all domains is reported when empty.
+	Domain string
+	// Executions created at or after StartTime and before EndTime are reported.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// How much a project and domain executed within the report window.
+type ProjectDomainUsage struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// The number of (workflow) executions created.
+	ExecutionCount int64 `json:"executionCount"`
+	// The total duration of the node executions created, in seconds.
+	NodeExecutionSeconds float64 `json:"nodeExecutionSeconds"`
+}
+
+type ProjectUsageReport struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Ordered by project and domain.
+	Usage []ProjectDomainUsage `json:"usage"`
+}
+
+// Steps of the onboarding pipeline run for newly registered projects.
+const (
+	// Creates the kubernetes resources of the project's namespace in each domain.
+	OnboardingStepNamespaceResources = "NAMESPACE_RESOURCES"
+	// Assigns the project's executions in each domain to the default execution queues.
+	OnboardingStepQueueAssignments = "QUEUE_ASSIGNMENTS"
+	// Sets default attributes for each of the project's domains.
+	OnboardingStepDefaultAttributes = "DEFAULT_ATTRIBUTES"
+	// Registers a sample launch plan in each of the project's domains.
+	OnboardingStepSampleLaunchPlan = "SAMPLE_LAUNCH_PLAN"
+	// Notifies the configured recipients that the project was registered.
+	OnboardingStepWelcomeNotification = "WELCOME_NOTIFICATION"
+)
+
+// States of an onboarding step.
+const (
+	OnboardingStepPending   = "PENDING"
+	OnboardingStepSucceeded = "SUCCEEDED"
+	OnboardingStepFailed    = "FAILED"
+	// Nothing is configured for the step.
+	OnboardingStepSkipped = "SKIPPED"
+)
+
+type ProjectOnboardingStep struct {
+	Name string `json:"name"`
+	// One of the onboarding step states above.
+	State string `json:"state"`
+	// Why the step failed.
+	Message string `json:"message,omitempty"`
+}
+
+// Progress of the onboarding pipeline run when a project was registered.
+type ProjectOnboardingStatus struct {
+	Project     string                  `json:"project"`
+	Steps       []ProjectOnboardingStep `json:"steps"`
+	StartedAt   time.Time               `json:"startedAt"`
+	CompletedAt *time.Time              `json:"completedAt,omitempty"`
+}
+
+type ProjectOnboardingStatusGetRequest struct {
+	Project string
+}
+
+// Registers a domain at runtime, in addition to the domains configured for the server.
+type DomainRegisterRequest struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type DomainRegisterResponse struct{}
+
+type Domain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Whether the domain is configured for the server rather than registered at runtime.
+	Configured bool `json:"configured"`
+}
+
+type DomainList struct {
+	Domains []Domain `json:"domains"`
 }
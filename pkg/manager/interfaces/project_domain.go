@@ -10,4 +10,34 @@ import (
 type ProjectDomainInterface interface {
 	UpdateProjectDomain(ctx context.Context, request admin.ProjectDomainAttributesUpdateRequest) (
 		*admin.ProjectDomainAttributesUpdateResponse, error)
+	SyncProjectDomainAttributes(ctx context.Context, request ProjectDomainAttributesSyncRequest) (
+		*ProjectDomainAttributesSyncResponse, error)
+	AckProjectDomainAttributes(ctx context.Context, request ProjectDomainAttributesAckRequest) (
+		*ProjectDomainAttributesAckResponse, error)
+	RefreshClusterAttributesLag(ctx context.Context) error
 }
+
+// Fetches the project-domain attributes updated after the latest version a cluster has applied. Clusters start from
+// version 0 to fetch all attributes.
+type ProjectDomainAttributesSyncRequest struct {
+	SinceVersion int64
+}
+
+type VersionedProjectDomainAttributes struct {
+	Version    int64                          `json:"version"`
+	Attributes *admin.ProjectDomainAttributes `json:"attributes"`
+}
+
+type ProjectDomainAttributesSyncResponse struct {
+	// The latest version among the returned attributes, or the requested version when none were updated since.
+	Version    int64                              `json:"version"`
+	Attributes []VersionedProjectDomainAttributes `json:"attributes"`
+}
+
+// Acknowledges that a cluster has applied all project-domain attributes up to and including a version.
+type ProjectDomainAttributesAckRequest struct {
+	Cluster string `json:"cluster"`
+	Version int64  `json:"version"`
+}
+
+type ProjectDomainAttributesAckResponse struct{}
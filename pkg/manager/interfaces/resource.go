@@ -0,0 +1,150 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// The resource types whose attributes can be set for a project, for a domain of a project or for a workflow in them.
+// Feature flags can also be set for a domain across all projects. The attributes set for the most specific of these
+// levels apply.
+type MatchableResource int32
+
+const (
+	// Default and maximum resources of the tasks run by executions.
+	MatchableResourceTaskResource MatchableResource = iota
+	// Tags of the queues executions run on.
+	MatchableResourceExecutionQueue
+	// The cluster executions are launched on.
+	MatchableResourceExecutionClusterLabel
+	// Admin behaviors toggled for executions.
+	MatchableResourceFeatureFlags
+	// How executions created without a name are named.
+	MatchableResourceExecutionNamePolicy
+	// When notifications of executions are held back.
+	MatchableResourceQuietHours
+)
+
+// Interface for managing matchable resource attributes.
+type ResourceInterface interface {
+	UpdateResourceAttributes(ctx context.Context, request ResourceAttributesUpdateRequest) (
+		*ResourceAttributesUpdateResponse, error)
+	GetResourceAttributes(ctx context.Context, request ResourceAttributesGetRequest) (*ResourceAttributes, error)
+	DeleteResourceAttributes(ctx context.Context, request ResourceAttributesDeleteRequest) (
+		*ResourceAttributesDeleteResponse, error)
+	ListResourceAttributes(ctx context.Context, request ResourceAttributesListRequest) (
+		*ResourceAttributesList, error)
+}
+
+// Task resource values, in the kubernetes quantity format. Values left empty aren't overridden.
+type TaskResourceSpec struct {
+	CPU    string `json:"cpu,omitempty"`
+	GPU    string `json:"gpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+type TaskResourceAttributes struct {
+	Defaults TaskResourceSpec `json:"defaults"`
+	Limits   TaskResourceSpec `json:"limits"`
+}
+
+type ExecutionQueueAttributes struct {
+	// Executions run on a queue matching all of these tags.
+	Tags []string `json:"tags"`
+}
+
+type ExecutionClusterLabel struct {
+	// The name of a configured cluster.
+	Value string `json:"value"`
+}
+
+// Admin behaviors turned off for the matching executions, e.g. notifications in development. Flags left unset keep the
+// behaviors on.
+type FeatureFlags struct {
+	// No notifications are sent for the executions.
+	DisableNotifications bool `json:"disable_notifications,omitempty"`
+	// The tasks of the executions don't use the outputs cached for previous executions, nor cache their own.
+	DisableCaching bool `json:"disable_caching,omitempty"`
+}
+
+// Executions created without a name are named after the template, e.g. "ml-{kickoff_date}", followed by a dash and a
+// random suffix filling up the rest of the allowed execution name length. Templates reference the same variables as
+// launch plan execution name templates, see common.RenderExecutionNameTemplate, with the kickoff date and time being
+// those the execution is created at.
+type ExecutionNamePolicy struct {
+	Template string `json:"template"`
+}
+
+const (
+	// Non-critical notifications raised during quiet hours are dropped.
+	QuietHoursModeSuppress = "suppress"
+	// Non-critical notifications raised during quiet hours are held and sent as a single digest once the window ends.
+	QuietHoursModeDefer = "defer"
+)
+
+// A daily window during which the non-critical notifications of executions are held back. Start and End use the 24-hour
+// "HH:MM" format and are evaluated in Timezone, an IANA name defaulting to UTC. Windows where End precedes Start wrap
+// around midnight.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone,omitempty"`
+	// Either QuietHoursModeSuppress, the default, or QuietHoursModeDefer.
+	Mode string `json:"mode,omitempty"`
+	// Workflow execution phases whose notifications are always delivered, e.g. FAILED. PagerDuty notifications are
+	// always considered critical.
+	CriticalPhases []string `json:"critical_phases,omitempty"`
+}
+
+// Holds the attributes of exactly one resource type.
+type MatchingAttributes struct {
+	TaskResourceAttributes   *TaskResourceAttributes   `json:"task_resource_attributes,omitempty"`
+	ExecutionQueueAttributes *ExecutionQueueAttributes `json:"execution_queue_attributes,omitempty"`
+	ExecutionClusterLabel    *ExecutionClusterLabel    `json:"execution_cluster_label,omitempty"`
+	FeatureFlags             *FeatureFlags             `json:"feature_flags,omitempty"`
+	ExecutionNamePolicy      *ExecutionNamePolicy      `json:"execution_name_policy,omitempty"`
+	QuietHours               *QuietHours               `json:"quiet_hours,omitempty"`
+}
+
+// Sets the attributes of a resource type for a project when Domain is empty, for a domain of the project when Workflow
+// is empty, and otherwise for a workflow in them. Feature flags are set for a domain across all projects when Project
+// is empty, while quiet hours can't be set for a workflow.
+type ResourceAttributesUpdateRequest struct {
+	Project    string             `json:"project"`
+	Domain     string             `json:"domain"`
+	Workflow   string             `json:"workflow"`
+	Attributes MatchingAttributes `json:"attributes"`
+}
+
+type ResourceAttributesUpdateResponse struct{}
+
+// Fetches the attributes of a resource type set at exactly the identified level.
+type ResourceAttributesGetRequest struct {
+	Project      string
+	Domain       string
+	Workflow     string
+	ResourceType MatchableResource
+}
+
+type ResourceAttributesDeleteRequest struct {
+	Project      string
+	Domain       string
+	Workflow     string
+	ResourceType MatchableResource
+}
+
+type ResourceAttributesDeleteResponse struct{}
+
+type ResourceAttributesListRequest struct {
+	ResourceType MatchableResource
+}
+
+type ResourceAttributes struct {
+	Project    string             `json:"project"`
+	Domain     string             `json:"domain,omitempty"`
+	Workflow   string             `json:"workflow,omitempty"`
+	Attributes MatchingAttributes `json:"attributes"`
+}
+
+type ResourceAttributesList struct {
+	Attributes []ResourceAttributes `json:"attributes"`
+}
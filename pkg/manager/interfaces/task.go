@@ -4,6 +4,16 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The states of task versions. Archived versions are left out of task listings by default, but can still be fetched
+// and executed.
+type TaskState int32
+
+const (
+	TaskStateActive TaskState = iota
+	TaskStateArchived
 )
 
 // Interface for managing Flyte Tasks
@@ -13,4 +23,29 @@ type TaskInterface interface {
 	ListTasks(ctx context.Context, request admin.ResourceListRequest) (*admin.TaskList, error)
 	ListUniqueTaskIdentifiers(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	UpdateTaskState(ctx context.Context, request TaskStateUpdateRequest) (*TaskStateUpdateResponse, error)
+	DiffTask(ctx context.Context, request admin.TaskCreateRequest) (*TaskDiffResponse, error)
+}
+
+// Archives or restores a task version, or all versions of a task when the identifier has no version.
+type TaskStateUpdateRequest struct {
+	ID    *core.Identifier
+	State TaskState
+}
+
+type TaskStateUpdateResponse struct{}
+
+// A field which differs between two messages. The path names the field in the protobuf JSON mapping, e.g.
+// template.container.args[1], and a value is nil when the message it belongs to doesn't set the field.
+type FieldDiff struct {
+	Path     string      `json:"path"`
+	Existing interface{} `json:"existing"`
+	Incoming interface{} `json:"incoming"`
+}
+
+// Compares the task a create request compiles to against the task registered with the same identifier.
+type TaskDiffResponse struct {
+	ID        *core.Identifier `json:"id"`
+	Identical bool             `json:"identical"`
+	Diffs     []FieldDiff      `json:"diffs"`
 }
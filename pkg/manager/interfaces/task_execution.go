@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflow TaskExecutions
@@ -14,4 +15,15 @@ type TaskExecutionInterface interface {
 	ListTaskExecutions(ctx context.Context, request admin.TaskExecutionListRequest) (*admin.TaskExecutionList, error)
 	GetTaskExecutionData(
 		ctx context.Context, request admin.TaskExecutionGetDataRequest) (*admin.TaskExecutionGetDataResponse, error)
+	GetTaskExecutionLogs(ctx context.Context, request TaskExecutionLogsGetRequest) (
+		*TaskExecutionLogsGetResponse, error)
+}
+
+// Fetches up to date log links for a task execution.
+type TaskExecutionLogsGetRequest struct {
+	ID *core.TaskExecutionIdentifier
+}
+
+type TaskExecutionLogsGetResponse struct {
+	Logs []*core.TaskLog `json:"logs"`
 }
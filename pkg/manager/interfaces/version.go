@@ -0,0 +1,27 @@
+package interfaces
+
+import "context"
+
+// Interface for describing the running admin build and the environment it's configured for.
+type VersionInterface interface {
+	GetVersion(ctx context.Context, request VersionGetRequest) (*VersionGetResponse, error)
+}
+
+type VersionGetRequest struct{}
+
+// The facts support tooling needs to establish about an admin deployment while debugging.
+type VersionGetResponse struct {
+	// The release admin was built from, its git SHA and when it was built.
+	Version   string `json:"version"`
+	GitSHA    string `json:"gitSha"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+	// Whether each optional feature is enabled, keyed by feature.
+	FeatureFlags map[string]bool `json:"featureFlags"`
+	// The cloud provider configured for each external service, keyed by service, e.g. "remoteData": "aws".
+	CloudProviders map[string]string `json:"cloudProviders"`
+	// The ID of the last database migration admin knows of.
+	SchemaVersion string `json:"schemaVersion"`
+	// Whether admin serves in read-only mode because the database schema is newer than the schema version.
+	SchemaReadOnly bool `json:"schemaReadOnly"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflows
@@ -13,4 +14,65 @@ type WorkflowInterface interface {
 	ListWorkflows(ctx context.Context, request admin.ResourceListRequest) (*admin.WorkflowList, error)
 	ListWorkflowIdentifiers(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	GetWorkflowGraph(ctx context.Context, request admin.ObjectGetRequest) (*WorkflowGraph, error)
+	CompileWorkflow(ctx context.Context, request WorkflowCompileRequest) (*WorkflowCompileResponse, error)
+}
+
+// The kinds of nodes in a workflow graph.
+const (
+	WorkflowGraphNodeKindStart      = "start"
+	WorkflowGraphNodeKindEnd        = "end"
+	WorkflowGraphNodeKindTask       = "task"
+	WorkflowGraphNodeKindBranch     = "branch"
+	WorkflowGraphNodeKindWorkflow   = "workflow"
+	WorkflowGraphNodeKindLaunchPlan = "launch_plan"
+)
+
+type WorkflowGraphNode struct {
+	// Unique within the graph, nodes nested in branch and sub-workflow nodes are prefixed by the id of their parent.
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	// The id of the node as declared in its workflow.
+	Name string `json:"name"`
+	// The id of the branch or sub-workflow node this node is nested in, unset for nodes of the workflow itself.
+	Parent string `json:"parent,omitempty"`
+	// The task, sub-workflow or launch plan the node runs.
+	Target *core.Identifier `json:"target,omitempty"`
+}
+
+type WorkflowGraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// The nodes of a workflow, with branch and sub-workflow nodes flattened into the nodes they run. Dynamic nodes are
+// drawn as the task nodes which yield them, their nodes are only known once they run.
+type WorkflowGraph struct {
+	ID    *core.Identifier    `json:"-"`
+	Nodes []WorkflowGraphNode `json:"nodes"`
+	Edges []WorkflowGraphEdge `json:"edges"`
+	// The graph in the graphviz DOT language.
+	Dot string `json:"dot"`
+}
+
+// Compiles a workflow as it would be compiled when registered, without registering anything.
+type WorkflowCompileRequest struct {
+	Workflow *admin.WorkflowSpec
+	// The templates of tasks the workflow references. Referenced tasks which aren't set here are read from the
+	// registered tasks.
+	Tasks []*core.TaskTemplate
+}
+
+// A reason the workflow would fail registration. Code is the name of a gRPC status code, e.g. "InvalidArgument".
+type WorkflowCompileDiagnostic struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Set when one of the given task templates is to blame.
+	TaskID *core.Identifier `json:"taskId,omitempty"`
+}
+
+type WorkflowCompileResponse struct {
+	// Unset when the workflow failed compilation.
+	Closure     *core.CompiledWorkflowClosure
+	Diagnostics []WorkflowCompileDiagnostic
 }
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateWorkflowEventsFunc func(ctx context.Context, request interfaces.WorkflowEventsRequest) (
+	*interfaces.WorkflowEventsResponse, error)
+
+type MockEventManager struct {
+	createWorkflowEventsFunc CreateWorkflowEventsFunc
+}
+
+func (m *MockEventManager) CreateWorkflowEvents(
+	ctx context.Context, request interfaces.WorkflowEventsRequest) (*interfaces.WorkflowEventsResponse, error) {
+	if m.createWorkflowEventsFunc != nil {
+		return m.createWorkflowEventsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockEventManager) SetCreateWorkflowEventsCallback(createFunc CreateWorkflowEventsFunc) {
+	m.createWorkflowEventsFunc = createFunc
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 )
 
 type CreateExecutionFunc func(
@@ -21,6 +23,20 @@ type GetExecutionDataFunc func(ctx context.Context, request admin.WorkflowExecut
 type ListExecutionFunc func(ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error)
 type TerminateExecutionFunc func(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+type BackfillExecutionsFunc func(
+	ctx context.Context, request interfaces.BackfillExecutionsRequest, requestedAt time.Time) (
+	*interfaces.BackfillExecutionsResponse, error)
+type GetBackfillFunc func(ctx context.Context, id string) (*interfaces.Backfill, error)
+type UpdateExecutionFunc func(ctx context.Context, request interfaces.ExecutionUpdateRequest) (
+	*interfaces.ExecutionUpdateResponse, error)
+type GetExecutionFullDataFunc func(ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (
+	*interfaces.FullDataResponse, error)
+type ExportExecutionTimelineFunc func(ctx context.Context, request interfaces.ExecutionTimelineExportRequest) (
+	*interfaces.ExecutionTimeline, error)
+
+type RerunExecutionNodeFunc func(
+	ctx context.Context, request interfaces.ExecutionNodeRerunRequest, requestedAt time.Time) (
+	*admin.ExecutionCreateResponse, error)
 
 type MockExecutionManager struct {
 	createExecutionFunc      CreateExecutionFunc
@@ -30,6 +46,12 @@ type MockExecutionManager struct {
 	getExecutionDataFunc     GetExecutionDataFunc
 	listExecutionFunc        ListExecutionFunc
 	terminateExecutionFunc   TerminateExecutionFunc
+	backfillExecutionsFunc   BackfillExecutionsFunc
+	getBackfillFunc          GetBackfillFunc
+	updateExecutionFunc      UpdateExecutionFunc
+	getExecutionFullDataFunc GetExecutionFullDataFunc
+	exportTimelineFunc       ExportExecutionTimelineFunc
+	rerunNodeFunc            RerunExecutionNodeFunc
 }
 
 func (m *MockExecutionManager) SetCreateCallback(createFunction CreateExecutionFunc) {
@@ -118,3 +140,76 @@ func (m *MockExecutionManager) TerminateExecution(
 	}
 	return nil, nil
 }
+
+func (m *MockExecutionManager) SetBackfillExecutionsCallback(backfillExecutionsFunc BackfillExecutionsFunc) {
+	m.backfillExecutionsFunc = backfillExecutionsFunc
+}
+
+func (m *MockExecutionManager) BackfillExecutions(
+	ctx context.Context, request interfaces.BackfillExecutionsRequest, requestedAt time.Time) (
+	*interfaces.BackfillExecutionsResponse, error) {
+	if m.backfillExecutionsFunc != nil {
+		return m.backfillExecutionsFunc(ctx, request, requestedAt)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionManager) SetGetBackfillCallback(getBackfillFunc GetBackfillFunc) {
+	m.getBackfillFunc = getBackfillFunc
+}
+
+func (m *MockExecutionManager) GetBackfill(ctx context.Context, id string) (*interfaces.Backfill, error) {
+	if m.getBackfillFunc != nil {
+		return m.getBackfillFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionManager) SetUpdateExecutionCallback(updateExecutionFunc UpdateExecutionFunc) {
+	m.updateExecutionFunc = updateExecutionFunc
+}
+
+func (m *MockExecutionManager) UpdateExecution(
+	ctx context.Context, request interfaces.ExecutionUpdateRequest) (*interfaces.ExecutionUpdateResponse, error) {
+	if m.updateExecutionFunc != nil {
+		return m.updateExecutionFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionManager) SetGetFullDataCallback(getExecutionFullDataFunc GetExecutionFullDataFunc) {
+	m.getExecutionFullDataFunc = getExecutionFullDataFunc
+}
+
+func (m *MockExecutionManager) GetExecutionFullData(
+	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+	if m.getExecutionFullDataFunc != nil {
+		return m.getExecutionFullDataFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionManager) SetExportTimelineCallback(exportTimelineFunc ExportExecutionTimelineFunc) {
+	m.exportTimelineFunc = exportTimelineFunc
+}
+
+func (m *MockExecutionManager) ExportExecutionTimeline(
+	ctx context.Context, request interfaces.ExecutionTimelineExportRequest) (*interfaces.ExecutionTimeline, error) {
+	if m.exportTimelineFunc != nil {
+		return m.exportTimelineFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionManager) SetRerunNodeCallback(rerunNodeFunc RerunExecutionNodeFunc) {
+	m.rerunNodeFunc = rerunNodeFunc
+}
+
+func (m *MockExecutionManager) RerunExecutionNode(
+	ctx context.Context, request interfaces.ExecutionNodeRerunRequest, requestedAt time.Time) (
+	*admin.ExecutionCreateResponse, error) {
+	if m.rerunNodeFunc != nil {
+		return m.rerunNodeFunc(ctx, request, requestedAt)
+	}
+	return nil, nil
+}
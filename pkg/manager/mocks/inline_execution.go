@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateInlineExecutionFunc func(
+	ctx context.Context, request interfaces.InlineExecutionCreateRequest, requestedAt time.Time) (
+	*interfaces.InlineExecutionCreateResponse, error)
+
+type MockInlineExecutionManager struct {
+	createInlineExecutionFunc CreateInlineExecutionFunc
+}
+
+func (m *MockInlineExecutionManager) CreateInlineExecution(
+	ctx context.Context, request interfaces.InlineExecutionCreateRequest, requestedAt time.Time) (
+	*interfaces.InlineExecutionCreateResponse, error) {
+	if m.createInlineExecutionFunc != nil {
+		return m.createInlineExecutionFunc(ctx, request, requestedAt)
+	}
+	return nil, nil
+}
+
+func (m *MockInlineExecutionManager) SetCreateInlineExecutionCallback(createFunc CreateInlineExecutionFunc) {
+	m.createInlineExecutionFunc = createFunc
+}
@@ -22,6 +22,16 @@ type ListLaunchPlanIdsFunc func(ctx context.Context, request admin.NamedEntityId
 	*admin.NamedEntityIdentifierList, error)
 type ListActiveLaunchPlansFunc func(ctx context.Context, request admin.ActiveLaunchPlanListRequest) (
 	*admin.LaunchPlanList, error)
+type UpdateLaunchPlanScheduleStateFunc func(
+	ctx context.Context, request interfaces.LaunchPlanScheduleStateUpdateRequest) (
+	*interfaces.LaunchPlanScheduleStateUpdateResponse, error)
+type ListLaunchPlanStateHistoryFunc func(
+	ctx context.Context, request interfaces.LaunchPlanStateHistoryListRequest) (
+	*interfaces.LaunchPlanStateHistory, error)
+type GetLaunchPlanInputsFunc func(ctx context.Context, request admin.ObjectGetRequest) (
+	*interfaces.LaunchPlanInputs, error)
+type CreateLaunchPlanVariantsFunc func(ctx context.Context, request interfaces.LaunchPlanVariantsCreateRequest) (
+	*interfaces.LaunchPlanVariantsCreateResponse, error)
 
 type MockLaunchPlanManager struct {
 	createLaunchPlanFunc      CreateLaunchPlanFunc
@@ -31,6 +41,10 @@ type MockLaunchPlanManager struct {
 	listLaunchPlansFunc       ListLaunchPlansFunc
 	listLaunchPlanIdsFunc     ListLaunchPlanIdsFunc
 	listActiveLaunchPlansFunc ListActiveLaunchPlansFunc
+	updateScheduleStateFunc   UpdateLaunchPlanScheduleStateFunc
+	listStateHistoryFunc      ListLaunchPlanStateHistoryFunc
+	getInputsFunc             GetLaunchPlanInputsFunc
+	createVariantsFunc        CreateLaunchPlanVariantsFunc
 }
 
 func (r *MockLaunchPlanManager) SetCreateCallback(createFunction CreateLaunchPlanFunc) {
@@ -58,6 +72,10 @@ func (r *MockLaunchPlanManager) UpdateLaunchPlan(ctx context.Context, request ad
 	return nil, nil
 }
 
+func (r *MockLaunchPlanManager) SetGetCallback(getFunction GetLaunchPlanFunc) {
+	r.getLaunchPlanFunc = getFunction
+}
+
 func (r *MockLaunchPlanManager) GetLaunchPlan(ctx context.Context, request admin.ObjectGetRequest) (
 	*admin.LaunchPlan, error) {
 	if r.getLaunchPlanFunc != nil {
@@ -110,6 +128,59 @@ func (r *MockLaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request a
 	return nil, nil
 }
 
+func (r *MockLaunchPlanManager) SetUpdateLaunchPlanScheduleStateCallback(
+	updateScheduleStateFunc UpdateLaunchPlanScheduleStateFunc) {
+	r.updateScheduleStateFunc = updateScheduleStateFunc
+}
+
+func (r *MockLaunchPlanManager) UpdateLaunchPlanScheduleState(
+	ctx context.Context, request interfaces.LaunchPlanScheduleStateUpdateRequest) (
+	*interfaces.LaunchPlanScheduleStateUpdateResponse, error) {
+	if r.updateScheduleStateFunc != nil {
+		return r.updateScheduleStateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (r *MockLaunchPlanManager) SetListLaunchPlanStateHistoryCallback(
+	listStateHistoryFunc ListLaunchPlanStateHistoryFunc) {
+	r.listStateHistoryFunc = listStateHistoryFunc
+}
+
+func (r *MockLaunchPlanManager) ListLaunchPlanStateHistory(
+	ctx context.Context, request interfaces.LaunchPlanStateHistoryListRequest) (
+	*interfaces.LaunchPlanStateHistory, error) {
+	if r.listStateHistoryFunc != nil {
+		return r.listStateHistoryFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (r *MockLaunchPlanManager) SetGetLaunchPlanInputsCallback(getInputsFunc GetLaunchPlanInputsFunc) {
+	r.getInputsFunc = getInputsFunc
+}
+
+func (r *MockLaunchPlanManager) GetLaunchPlanInputs(
+	ctx context.Context, request admin.ObjectGetRequest) (*interfaces.LaunchPlanInputs, error) {
+	if r.getInputsFunc != nil {
+		return r.getInputsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (r *MockLaunchPlanManager) SetCreateLaunchPlanVariantsCallback(createVariantsFunc CreateLaunchPlanVariantsFunc) {
+	r.createVariantsFunc = createVariantsFunc
+}
+
+func (r *MockLaunchPlanManager) CreateLaunchPlanVariants(
+	ctx context.Context, request interfaces.LaunchPlanVariantsCreateRequest) (
+	*interfaces.LaunchPlanVariantsCreateResponse, error) {
+	if r.createVariantsFunc != nil {
+		return r.createVariantsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
 func NewMockLaunchPlanManager() interfaces.LaunchPlanInterface {
 	return &MockLaunchPlanManager{}
 }
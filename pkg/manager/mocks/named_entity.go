@@ -0,0 +1,103 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type GetNamedEntityFunc func(ctx context.Context, request admin.NamedEntityGetRequest) (*admin.NamedEntity, error)
+type UpdateNamedEntityFunc func(ctx context.Context, request admin.NamedEntityUpdateRequest) (
+	*admin.NamedEntityUpdateResponse, error)
+type ListNamedEntitiesFunc func(ctx context.Context, request admin.NamedEntityListRequest) (
+	*admin.NamedEntityList, error)
+type GetEntityUsageFunc func(ctx context.Context, request interfaces.EntityUsageGetRequest) (
+	*interfaces.EntityUsage, error)
+type SearchEntitiesFunc func(ctx context.Context, request interfaces.EntitySearchRequest) (
+	*interfaces.EntitySearchResults, error)
+type UpdateNamedEntityStateFunc func(ctx context.Context, request interfaces.NamedEntityStateUpdateRequest) (
+	*interfaces.NamedEntityStateUpdateResponse, error)
+
+type MockNamedEntityManager struct {
+	getNamedEntityFunc    GetNamedEntityFunc
+	updateNamedEntityFunc UpdateNamedEntityFunc
+	listNamedEntitiesFunc ListNamedEntitiesFunc
+	getEntityUsageFunc    GetEntityUsageFunc
+	searchEntitiesFunc    SearchEntitiesFunc
+	updateStateFunc       UpdateNamedEntityStateFunc
+}
+
+func (m *MockNamedEntityManager) SetGetNamedEntityCallback(getNamedEntityFunc GetNamedEntityFunc) {
+	m.getNamedEntityFunc = getNamedEntityFunc
+}
+
+func (m *MockNamedEntityManager) GetNamedEntity(
+	ctx context.Context, request admin.NamedEntityGetRequest) (*admin.NamedEntity, error) {
+	if m.getNamedEntityFunc != nil {
+		return m.getNamedEntityFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNamedEntityManager) SetUpdateNamedEntityCallback(updateNamedEntityFunc UpdateNamedEntityFunc) {
+	m.updateNamedEntityFunc = updateNamedEntityFunc
+}
+
+func (m *MockNamedEntityManager) UpdateNamedEntity(
+	ctx context.Context, request admin.NamedEntityUpdateRequest) (*admin.NamedEntityUpdateResponse, error) {
+	if m.updateNamedEntityFunc != nil {
+		return m.updateNamedEntityFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNamedEntityManager) SetListNamedEntitiesCallback(listNamedEntitiesFunc ListNamedEntitiesFunc) {
+	m.listNamedEntitiesFunc = listNamedEntitiesFunc
+}
+
+func (m *MockNamedEntityManager) ListNamedEntities(
+	ctx context.Context, request admin.NamedEntityListRequest) (*admin.NamedEntityList, error) {
+	if m.listNamedEntitiesFunc != nil {
+		return m.listNamedEntitiesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNamedEntityManager) SetGetEntityUsageCallback(getEntityUsageFunc GetEntityUsageFunc) {
+	m.getEntityUsageFunc = getEntityUsageFunc
+}
+
+func (m *MockNamedEntityManager) GetEntityUsage(
+	ctx context.Context, request interfaces.EntityUsageGetRequest) (*interfaces.EntityUsage, error) {
+	if m.getEntityUsageFunc != nil {
+		return m.getEntityUsageFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNamedEntityManager) SetSearchEntitiesCallback(searchEntitiesFunc SearchEntitiesFunc) {
+	m.searchEntitiesFunc = searchEntitiesFunc
+}
+
+func (m *MockNamedEntityManager) SearchEntities(
+	ctx context.Context, request interfaces.EntitySearchRequest) (*interfaces.EntitySearchResults, error) {
+	if m.searchEntitiesFunc != nil {
+		return m.searchEntitiesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNamedEntityManager) SetUpdateNamedEntityStateCallback(updateStateFunc UpdateNamedEntityStateFunc) {
+	m.updateStateFunc = updateStateFunc
+}
+
+func (m *MockNamedEntityManager) UpdateNamedEntityState(
+	ctx context.Context, request interfaces.NamedEntityStateUpdateRequest) (
+	*interfaces.NamedEntityStateUpdateResponse, error) {
+	if m.updateStateFunc != nil {
+		return m.updateStateFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 )
 
 type CreateNodeEventFunc func(ctx context.Context, request admin.NodeExecutionEventRequest) (
@@ -13,15 +15,56 @@ type ListNodeExecutionsFunc func(
 	ctx context.Context, request admin.NodeExecutionListRequest) (*admin.NodeExecutionList, error)
 type ListNodeExecutionsForTaskFunc func(ctx context.Context, request admin.NodeExecutionForTaskListRequest) (
 	*admin.NodeExecutionList, error)
+type ListNodeExecutionChildrenFunc func(ctx context.Context, request interfaces.NodeExecutionChildrenListRequest) (
+	*admin.NodeExecutionList, error)
+type ListNodeExecutionsByTaskFunc func(ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (
+	*admin.NodeExecutionList, error)
 type GetNodeExecutionDataFunc func(
 	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error)
+type CreateDynamicNodeWorkflowFunc func(ctx context.Context, request interfaces.DynamicNodeWorkflowCreateRequest) (
+	*interfaces.DynamicNodeWorkflowCreateResponse, error)
+type GetNodeExecutionFullDataFunc func(ctx context.Context, request admin.NodeExecutionGetDataRequest) (
+	*interfaces.FullDataResponse, error)
+type GetNodeExecutionAttemptDataFunc func(ctx context.Context, request interfaces.NodeExecutionAttemptDataGetRequest) (
+	*interfaces.NodeExecutionAttemptDataResponse, error)
+type GetDynamicNodeWorkflowFunc func(ctx context.Context, request interfaces.DynamicNodeWorkflowGetRequest) (
+	*admin.WorkflowClosure, error)
+type CreateNodeExecutionCacheMetadataFunc func(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataCreateRequest) (
+	*interfaces.NodeExecutionCacheMetadataCreateResponse, error)
+type GetNodeExecutionCacheMetadataFunc func(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataGetRequest) (
+	*interfaces.NodeExecutionCacheMetadata, error)
+type GetNodeExecutionMetricsFunc func(ctx context.Context, request interfaces.NodeExecutionMetricsGetRequest) (
+	*interfaces.NodeExecutionMetricsGetResponse, error)
+type CreateNodeExecutionArtifactsFunc func(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsCreateRequest) (
+	*interfaces.NodeExecutionArtifactsCreateResponse, error)
+type GetNodeExecutionArtifactsFunc func(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsGetRequest) (
+	*interfaces.NodeExecutionArtifactsGetResponse, error)
+type GetNodeExecutionChildRollupFunc func(
+	ctx context.Context, request interfaces.NodeExecutionChildRollupGetRequest) (
+	*interfaces.NodeExecutionChildRollup, error)
 
 type MockNodeExecutionManager struct {
 	createNodeEventFunc           CreateNodeEventFunc
 	getNodeExecutionFunc          GetNodeExecutionFunc
 	listNodeExecutionsFunc        ListNodeExecutionsFunc
 	listNodeExecutionsForTaskFunc ListNodeExecutionsForTaskFunc
+	listNodeExecutionChildrenFunc ListNodeExecutionChildrenFunc
+	listNodeExecutionsByTaskFunc  ListNodeExecutionsByTaskFunc
 	getNodeExecutionDataFunc      GetNodeExecutionDataFunc
+	createDynamicNodeWorkflowFunc CreateDynamicNodeWorkflowFunc
+	getDynamicNodeWorkflowFunc    GetDynamicNodeWorkflowFunc
+	getNodeExecutionFullDataFunc  GetNodeExecutionFullDataFunc
+	getAttemptDataFunc            GetNodeExecutionAttemptDataFunc
+	createCacheMetadataFunc       CreateNodeExecutionCacheMetadataFunc
+	getCacheMetadataFunc          GetNodeExecutionCacheMetadataFunc
+	getMetricsFunc                GetNodeExecutionMetricsFunc
+	createArtifactsFunc           CreateNodeExecutionArtifactsFunc
+	getArtifactsFunc              GetNodeExecutionArtifactsFunc
+	getChildRollupFunc            GetNodeExecutionChildRollupFunc
 }
 
 func (m *MockNodeExecutionManager) SetCreateNodeEventCallback(createNodeEventFunc CreateNodeEventFunc) {
@@ -73,6 +116,30 @@ func (m *MockNodeExecutionManager) ListNodeExecutionsForTask(
 	return nil, nil
 }
 
+func (m *MockNodeExecutionManager) SetListNodeExecutionChildrenFunc(listNodeExecutionChildrenFunc ListNodeExecutionChildrenFunc) {
+	m.listNodeExecutionChildrenFunc = listNodeExecutionChildrenFunc
+}
+
+func (m *MockNodeExecutionManager) ListNodeExecutionChildren(
+	ctx context.Context, request interfaces.NodeExecutionChildrenListRequest) (*admin.NodeExecutionList, error) {
+	if m.listNodeExecutionChildrenFunc != nil {
+		return m.listNodeExecutionChildrenFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetListNodeExecutionsByTaskFunc(listNodeExecutionsByTaskFunc ListNodeExecutionsByTaskFunc) {
+	m.listNodeExecutionsByTaskFunc = listNodeExecutionsByTaskFunc
+}
+
+func (m *MockNodeExecutionManager) ListNodeExecutionsByTask(
+	ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (*admin.NodeExecutionList, error) {
+	if m.listNodeExecutionsByTaskFunc != nil {
+		return m.listNodeExecutionsByTaskFunc(ctx, request)
+	}
+	return nil, nil
+}
+
 func (m *MockNodeExecutionManager) SetGetNodeExecutionDataFunc(getNodeExecutionDataFunc GetNodeExecutionDataFunc) {
 	m.getNodeExecutionDataFunc = getNodeExecutionDataFunc
 }
@@ -84,3 +151,138 @@ func (m *MockNodeExecutionManager) GetNodeExecutionData(
 	}
 	return nil, nil
 }
+
+func (m *MockNodeExecutionManager) SetCreateDynamicNodeWorkflowFunc(
+	createDynamicNodeWorkflowFunc CreateDynamicNodeWorkflowFunc) {
+	m.createDynamicNodeWorkflowFunc = createDynamicNodeWorkflowFunc
+}
+
+func (m *MockNodeExecutionManager) CreateDynamicNodeWorkflow(
+	ctx context.Context, request interfaces.DynamicNodeWorkflowCreateRequest) (
+	*interfaces.DynamicNodeWorkflowCreateResponse, error) {
+	if m.createDynamicNodeWorkflowFunc != nil {
+		return m.createDynamicNodeWorkflowFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetDynamicNodeWorkflowFunc(getDynamicNodeWorkflowFunc GetDynamicNodeWorkflowFunc) {
+	m.getDynamicNodeWorkflowFunc = getDynamicNodeWorkflowFunc
+}
+
+func (m *MockNodeExecutionManager) GetDynamicNodeWorkflow(
+	ctx context.Context, request interfaces.DynamicNodeWorkflowGetRequest) (*admin.WorkflowClosure, error) {
+	if m.getDynamicNodeWorkflowFunc != nil {
+		return m.getDynamicNodeWorkflowFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetNodeExecutionFullDataFunc(
+	getNodeExecutionFullDataFunc GetNodeExecutionFullDataFunc) {
+	m.getNodeExecutionFullDataFunc = getNodeExecutionFullDataFunc
+}
+
+func (m *MockNodeExecutionManager) GetNodeExecutionFullData(
+	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+	if m.getNodeExecutionFullDataFunc != nil {
+		return m.getNodeExecutionFullDataFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetNodeExecutionAttemptDataFunc(
+	getAttemptDataFunc GetNodeExecutionAttemptDataFunc) {
+	m.getAttemptDataFunc = getAttemptDataFunc
+}
+
+func (m *MockNodeExecutionManager) GetNodeExecutionAttemptData(
+	ctx context.Context, request interfaces.NodeExecutionAttemptDataGetRequest) (
+	*interfaces.NodeExecutionAttemptDataResponse, error) {
+	if m.getAttemptDataFunc != nil {
+		return m.getAttemptDataFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetCreateNodeExecutionCacheMetadataFunc(
+	createCacheMetadataFunc CreateNodeExecutionCacheMetadataFunc) {
+	m.createCacheMetadataFunc = createCacheMetadataFunc
+}
+
+func (m *MockNodeExecutionManager) CreateNodeExecutionCacheMetadata(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataCreateRequest) (
+	*interfaces.NodeExecutionCacheMetadataCreateResponse, error) {
+	if m.createCacheMetadataFunc != nil {
+		return m.createCacheMetadataFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetNodeExecutionCacheMetadataFunc(
+	getCacheMetadataFunc GetNodeExecutionCacheMetadataFunc) {
+	m.getCacheMetadataFunc = getCacheMetadataFunc
+}
+
+func (m *MockNodeExecutionManager) GetNodeExecutionCacheMetadata(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataGetRequest) (
+	*interfaces.NodeExecutionCacheMetadata, error) {
+	if m.getCacheMetadataFunc != nil {
+		return m.getCacheMetadataFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetNodeExecutionMetricsFunc(getMetricsFunc GetNodeExecutionMetricsFunc) {
+	m.getMetricsFunc = getMetricsFunc
+}
+
+func (m *MockNodeExecutionManager) GetNodeExecutionMetrics(
+	ctx context.Context, request interfaces.NodeExecutionMetricsGetRequest) (
+	*interfaces.NodeExecutionMetricsGetResponse, error) {
+	if m.getMetricsFunc != nil {
+		return m.getMetricsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetCreateNodeExecutionArtifactsFunc(
+	createArtifactsFunc CreateNodeExecutionArtifactsFunc) {
+	m.createArtifactsFunc = createArtifactsFunc
+}
+
+func (m *MockNodeExecutionManager) CreateNodeExecutionArtifacts(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsCreateRequest) (
+	*interfaces.NodeExecutionArtifactsCreateResponse, error) {
+	if m.createArtifactsFunc != nil {
+		return m.createArtifactsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetNodeExecutionArtifactsFunc(getArtifactsFunc GetNodeExecutionArtifactsFunc) {
+	m.getArtifactsFunc = getArtifactsFunc
+}
+
+func (m *MockNodeExecutionManager) GetNodeExecutionArtifacts(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsGetRequest) (
+	*interfaces.NodeExecutionArtifactsGetResponse, error) {
+	if m.getArtifactsFunc != nil {
+		return m.getArtifactsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetNodeExecutionChildRollupFunc(
+	getChildRollupFunc GetNodeExecutionChildRollupFunc) {
+	m.getChildRollupFunc = getChildRollupFunc
+}
+
+func (m *MockNodeExecutionManager) GetNodeExecutionChildRollup(
+	ctx context.Context, request interfaces.NodeExecutionChildRollupGetRequest) (
+	*interfaces.NodeExecutionChildRollup, error) {
+	if m.getChildRollupFunc != nil {
+		return m.getChildRollupFunc(ctx, request)
+	}
+	return nil, nil
+}
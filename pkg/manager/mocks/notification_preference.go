@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type UpdateNotificationPreferenceFunc func(ctx context.Context, request interfaces.NotificationPreferenceUpdateRequest) (
+	*interfaces.NotificationPreferenceUpdateResponse, error)
+type ListNotificationPreferencesFunc func(ctx context.Context, request interfaces.NotificationPreferenceListRequest) (
+	*interfaces.NotificationPreferenceListResponse, error)
+
+type MockNotificationPreferenceManager struct {
+	updateNotificationPreferenceFunc UpdateNotificationPreferenceFunc
+	listNotificationPreferencesFunc  ListNotificationPreferencesFunc
+}
+
+func (m *MockNotificationPreferenceManager) SetUpdateNotificationPreferenceFunc(
+	updateNotificationPreferenceFunc UpdateNotificationPreferenceFunc) {
+	m.updateNotificationPreferenceFunc = updateNotificationPreferenceFunc
+}
+
+func (m *MockNotificationPreferenceManager) UpdateNotificationPreference(
+	ctx context.Context, request interfaces.NotificationPreferenceUpdateRequest) (
+	*interfaces.NotificationPreferenceUpdateResponse, error) {
+	if m.updateNotificationPreferenceFunc != nil {
+		return m.updateNotificationPreferenceFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockNotificationPreferenceManager) SetListNotificationPreferencesFunc(
+	listNotificationPreferencesFunc ListNotificationPreferencesFunc) {
+	m.listNotificationPreferencesFunc = listNotificationPreferencesFunc
+}
+
+func (m *MockNotificationPreferenceManager) ListNotificationPreferences(
+	ctx context.Context, request interfaces.NotificationPreferenceListRequest) (
+	*interfaces.NotificationPreferenceListResponse, error) {
+	if m.listNotificationPreferencesFunc != nil {
+		return m.listNotificationPreferencesFunc(ctx, request)
+	}
+	return nil, nil
+}
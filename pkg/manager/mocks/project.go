@@ -4,14 +4,33 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 )
 
 type CreateProjectFunc func(ctx context.Context, request admin.ProjectRegisterRequest) (*admin.ProjectRegisterResponse, error)
 type ListProjectFunc func(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error)
+type GetProjectOnboardingStatusFunc func(ctx context.Context, request interfaces.ProjectOnboardingStatusGetRequest) (
+	*interfaces.ProjectOnboardingStatus, error)
+type UpdateProjectFunc func(ctx context.Context, request interfaces.ProjectUpdateRequest) (
+	*interfaces.ProjectUpdateResponse, error)
+type ListProjectDetailsFunc func(ctx context.Context, request interfaces.ProjectDetailsListRequest) (
+	*interfaces.ProjectDetailsList, error)
+type GetProjectUsageReportFunc func(ctx context.Context, request interfaces.ProjectUsageReportRequest) (
+	*interfaces.ProjectUsageReport, error)
+type RegisterDomainFunc func(ctx context.Context, request interfaces.DomainRegisterRequest) (
+	*interfaces.DomainRegisterResponse, error)
+type ListDomainsFunc func(ctx context.Context) (*interfaces.DomainList, error)
 
 type MockProjectManager struct {
-	listProjectFunc   ListProjectFunc
-	createProjectFunc CreateProjectFunc
+	listProjectFunc                ListProjectFunc
+	createProjectFunc              CreateProjectFunc
+	getProjectOnboardingStatusFunc GetProjectOnboardingStatusFunc
+	updateProjectFunc              UpdateProjectFunc
+	listProjectDetailsFunc         ListProjectDetailsFunc
+	getProjectUsageReportFunc      GetProjectUsageReportFunc
+	registerDomainFunc             RegisterDomainFunc
+	listDomainsFunc                ListDomainsFunc
 }
 
 func (m *MockProjectManager) SetCreateProject(createProjectFunc CreateProjectFunc) {
@@ -37,3 +56,76 @@ func (m *MockProjectManager) ListProjects(
 	}
 	return nil, nil
 }
+
+func (m *MockProjectManager) SetGetProjectOnboardingStatusFunc(
+	getProjectOnboardingStatusFunc GetProjectOnboardingStatusFunc) {
+	m.getProjectOnboardingStatusFunc = getProjectOnboardingStatusFunc
+}
+
+func (m *MockProjectManager) GetProjectOnboardingStatus(
+	ctx context.Context, request interfaces.ProjectOnboardingStatusGetRequest) (
+	*interfaces.ProjectOnboardingStatus, error) {
+	if m.getProjectOnboardingStatusFunc != nil {
+		return m.getProjectOnboardingStatusFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetUpdateProjectFunc(updateProjectFunc UpdateProjectFunc) {
+	m.updateProjectFunc = updateProjectFunc
+}
+
+func (m *MockProjectManager) UpdateProject(
+	ctx context.Context, request interfaces.ProjectUpdateRequest) (*interfaces.ProjectUpdateResponse, error) {
+	if m.updateProjectFunc != nil {
+		return m.updateProjectFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetListProjectDetailsFunc(listProjectDetailsFunc ListProjectDetailsFunc) {
+	m.listProjectDetailsFunc = listProjectDetailsFunc
+}
+
+func (m *MockProjectManager) ListProjectDetails(
+	ctx context.Context, request interfaces.ProjectDetailsListRequest) (*interfaces.ProjectDetailsList, error) {
+	if m.listProjectDetailsFunc != nil {
+		return m.listProjectDetailsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetGetProjectUsageReportFunc(getProjectUsageReportFunc GetProjectUsageReportFunc) {
+	m.getProjectUsageReportFunc = getProjectUsageReportFunc
+}
+
+func (m *MockProjectManager) GetProjectUsageReport(
+	ctx context.Context, request interfaces.ProjectUsageReportRequest) (*interfaces.ProjectUsageReport, error) {
+	if m.getProjectUsageReportFunc != nil {
+		return m.getProjectUsageReportFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetRegisterDomainFunc(registerDomainFunc RegisterDomainFunc) {
+	m.registerDomainFunc = registerDomainFunc
+}
+
+func (m *MockProjectManager) RegisterDomain(
+	ctx context.Context, request interfaces.DomainRegisterRequest) (*interfaces.DomainRegisterResponse, error) {
+	if m.registerDomainFunc != nil {
+		return m.registerDomainFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetListDomainsFunc(listDomainsFunc ListDomainsFunc) {
+	m.listDomainsFunc = listDomainsFunc
+}
+
+func (m *MockProjectManager) ListDomains(ctx context.Context) (*interfaces.DomainList, error) {
+	if m.listDomainsFunc != nil {
+		return m.listDomainsFunc(ctx)
+	}
+	return nil, nil
+}
@@ -4,13 +4,21 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 )
 
 type UpdateProjectDomainFunc func(ctx context.Context, request admin.ProjectDomainAttributesUpdateRequest) (
 	*admin.ProjectDomainAttributesUpdateResponse, error)
+type SyncProjectDomainAttributesFunc func(ctx context.Context, request interfaces.ProjectDomainAttributesSyncRequest) (
+	*interfaces.ProjectDomainAttributesSyncResponse, error)
+type AckProjectDomainAttributesFunc func(ctx context.Context, request interfaces.ProjectDomainAttributesAckRequest) (
+	*interfaces.ProjectDomainAttributesAckResponse, error)
 
 type MockProjectDomainManager struct {
 	updateProjectDomainFunc UpdateProjectDomainFunc
+	syncAttributesFunc      SyncProjectDomainAttributesFunc
+	ackAttributesFunc       AckProjectDomainAttributesFunc
 }
 
 func (m *MockProjectDomainManager) SetUpdateProjectDomainAttributes(updateProjectDomainFunc UpdateProjectDomainFunc) {
@@ -25,3 +33,33 @@ func (m *MockProjectDomainManager) UpdateProjectDomain(
 	}
 	return nil, nil
 }
+
+func (m *MockProjectDomainManager) SetSyncProjectDomainAttributesFunc(syncAttributesFunc SyncProjectDomainAttributesFunc) {
+	m.syncAttributesFunc = syncAttributesFunc
+}
+
+func (m *MockProjectDomainManager) SyncProjectDomainAttributes(
+	ctx context.Context, request interfaces.ProjectDomainAttributesSyncRequest) (
+	*interfaces.ProjectDomainAttributesSyncResponse, error) {
+	if m.syncAttributesFunc != nil {
+		return m.syncAttributesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectDomainManager) SetAckProjectDomainAttributesFunc(ackAttributesFunc AckProjectDomainAttributesFunc) {
+	m.ackAttributesFunc = ackAttributesFunc
+}
+
+func (m *MockProjectDomainManager) AckProjectDomainAttributes(
+	ctx context.Context, request interfaces.ProjectDomainAttributesAckRequest) (
+	*interfaces.ProjectDomainAttributesAckResponse, error) {
+	if m.ackAttributesFunc != nil {
+		return m.ackAttributesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectDomainManager) RefreshClusterAttributesLag(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,74 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type UpdateResourceAttributesFunc func(ctx context.Context, request interfaces.ResourceAttributesUpdateRequest) (
+	*interfaces.ResourceAttributesUpdateResponse, error)
+type GetResourceAttributesFunc func(ctx context.Context, request interfaces.ResourceAttributesGetRequest) (
+	*interfaces.ResourceAttributes, error)
+type DeleteResourceAttributesFunc func(ctx context.Context, request interfaces.ResourceAttributesDeleteRequest) (
+	*interfaces.ResourceAttributesDeleteResponse, error)
+type ListResourceAttributesFunc func(ctx context.Context, request interfaces.ResourceAttributesListRequest) (
+	*interfaces.ResourceAttributesList, error)
+
+type MockResourceManager struct {
+	updateFunc UpdateResourceAttributesFunc
+	getFunc    GetResourceAttributesFunc
+	deleteFunc DeleteResourceAttributesFunc
+	listFunc   ListResourceAttributesFunc
+}
+
+func (m *MockResourceManager) SetUpdateResourceAttributesFunc(updateFunc UpdateResourceAttributesFunc) {
+	m.updateFunc = updateFunc
+}
+
+func (m *MockResourceManager) UpdateResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesUpdateRequest) (
+	*interfaces.ResourceAttributesUpdateResponse, error) {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockResourceManager) SetGetResourceAttributesFunc(getFunc GetResourceAttributesFunc) {
+	m.getFunc = getFunc
+}
+
+func (m *MockResourceManager) GetResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesGetRequest) (*interfaces.ResourceAttributes, error) {
+	if m.getFunc != nil {
+		return m.getFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockResourceManager) SetDeleteResourceAttributesFunc(deleteFunc DeleteResourceAttributesFunc) {
+	m.deleteFunc = deleteFunc
+}
+
+func (m *MockResourceManager) DeleteResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesDeleteRequest) (
+	*interfaces.ResourceAttributesDeleteResponse, error) {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockResourceManager) SetListResourceAttributesFunc(listFunc ListResourceAttributesFunc) {
+	m.listFunc = listFunc
+}
+
+func (m *MockResourceManager) ListResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesListRequest) (
+	*interfaces.ResourceAttributesList, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, request)
+	}
+	return nil, nil
+}
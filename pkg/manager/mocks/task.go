@@ -3,15 +3,21 @@ package mocks
 import (
 	"context"
 
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
 type CreateTaskFunc func(ctx context.Context, request admin.TaskCreateRequest) (*admin.TaskCreateResponse, error)
 type ListUniqueIdsFunc func(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (*admin.NamedEntityIdentifierList, error)
+type UpdateTaskStateFunc func(ctx context.Context, request interfaces.TaskStateUpdateRequest) (
+	*interfaces.TaskStateUpdateResponse, error)
+type DiffTaskFunc func(ctx context.Context, request admin.TaskCreateRequest) (*interfaces.TaskDiffResponse, error)
 
 type MockTaskManager struct {
-	createTaskFunc    CreateTaskFunc
-	listUniqueIdsFunc ListUniqueIdsFunc
+	createTaskFunc      CreateTaskFunc
+	listUniqueIdsFunc   ListUniqueIdsFunc
+	updateTaskStateFunc UpdateTaskStateFunc
+	diffTaskFunc        DiffTaskFunc
 }
 
 func (r *MockTaskManager) SetCreateCallback(createFunction CreateTaskFunc) {
@@ -48,3 +54,27 @@ func (r *MockTaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request
 
 	return nil, nil
 }
+
+func (r *MockTaskManager) SetUpdateTaskStateCallback(updateTaskStateFunc UpdateTaskStateFunc) {
+	r.updateTaskStateFunc = updateTaskStateFunc
+}
+
+func (r *MockTaskManager) UpdateTaskState(ctx context.Context, request interfaces.TaskStateUpdateRequest) (
+	*interfaces.TaskStateUpdateResponse, error) {
+	if r.updateTaskStateFunc != nil {
+		return r.updateTaskStateFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (r *MockTaskManager) SetDiffTaskCallback(diffTaskFunc DiffTaskFunc) {
+	r.diffTaskFunc = diffTaskFunc
+}
+
+func (r *MockTaskManager) DiffTask(ctx context.Context, request admin.TaskCreateRequest) (
+	*interfaces.TaskDiffResponse, error) {
+	if r.diffTaskFunc != nil {
+		return r.diffTaskFunc(ctx, request)
+	}
+	return nil, nil
+}
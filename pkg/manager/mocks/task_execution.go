@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 )
 
 type CreateTaskExecutionEventFunc func(ctx context.Context, request admin.TaskExecutionEventRequest) (
@@ -14,12 +16,15 @@ type ListTaskExecutionsFunc func(ctx context.Context, request admin.TaskExecutio
 	*admin.TaskExecutionList, error)
 type GetTaskExecutionDataFunc func(ctx context.Context, request admin.TaskExecutionGetDataRequest) (
 	*admin.TaskExecutionGetDataResponse, error)
+type GetTaskExecutionLogsFunc func(ctx context.Context, request interfaces.TaskExecutionLogsGetRequest) (
+	*interfaces.TaskExecutionLogsGetResponse, error)
 
 type MockTaskExecutionManager struct {
 	createTaskExecutionEventFunc CreateTaskExecutionEventFunc
 	getTaskExecutionFunc         GetTaskExecutionFunc
 	listTaskExecutionsFunc       ListTaskExecutionsFunc
 	getTaskExecutionDataFunc     GetTaskExecutionDataFunc
+	getTaskExecutionLogsFunc     GetTaskExecutionLogsFunc
 }
 
 func (m *MockTaskExecutionManager) CreateTaskExecutionEvent(
@@ -73,3 +78,17 @@ func (m *MockTaskExecutionManager) SetGetTaskExecutionDataCallback(
 	getTaskExecutionDataFunc GetTaskExecutionDataFunc) {
 	m.getTaskExecutionDataFunc = getTaskExecutionDataFunc
 }
+
+func (m *MockTaskExecutionManager) GetTaskExecutionLogs(
+	ctx context.Context, request interfaces.TaskExecutionLogsGetRequest) (
+	*interfaces.TaskExecutionLogsGetResponse, error) {
+	if m.getTaskExecutionLogsFunc != nil {
+		return m.getTaskExecutionLogsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskExecutionManager) SetGetTaskExecutionLogsCallback(
+	getTaskExecutionLogsFunc GetTaskExecutionLogsFunc) {
+	m.getTaskExecutionLogsFunc = getTaskExecutionLogsFunc
+}
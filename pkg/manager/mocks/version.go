@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+type GetVersionFunc func(ctx context.Context, request interfaces.VersionGetRequest) (
+	*interfaces.VersionGetResponse, error)
+
+type MockVersionManager struct {
+	getVersionFunc GetVersionFunc
+}
+
+func (m *MockVersionManager) SetGetVersionFunc(getVersionFunc GetVersionFunc) {
+	m.getVersionFunc = getVersionFunc
+}
+
+func (m *MockVersionManager) GetVersion(ctx context.Context, request interfaces.VersionGetRequest) (
+	*interfaces.VersionGetResponse, error) {
+	if m.getVersionFunc != nil {
+		return m.getVersionFunc(ctx, request)
+	}
+	return nil, nil
+}
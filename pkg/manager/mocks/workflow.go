@@ -4,12 +4,21 @@ import (
 	"context"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 )
 
 type CreateWorkflowFunc func(ctx context.Context, request admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error)
 
+type GetWorkflowGraphFunc func(ctx context.Context, request admin.ObjectGetRequest) (*interfaces.WorkflowGraph, error)
+
+type CompileWorkflowFunc func(ctx context.Context, request interfaces.WorkflowCompileRequest) (
+	*interfaces.WorkflowCompileResponse, error)
+
 type MockWorkflowManager struct {
-	createWorkflowFunc CreateWorkflowFunc
+	createWorkflowFunc   CreateWorkflowFunc
+	getWorkflowGraphFunc GetWorkflowGraphFunc
+	compileWorkflowFunc  CompileWorkflowFunc
 }
 
 func (r *MockWorkflowManager) SetCreateCallback(createFunction CreateWorkflowFunc) {
@@ -39,3 +48,27 @@ func (r *MockWorkflowManager) ListWorkflowIdentifiers(ctx context.Context, reque
 	*admin.NamedEntityIdentifierList, error) {
 	return nil, nil
 }
+
+func (r *MockWorkflowManager) SetGetWorkflowGraphCallback(getWorkflowGraphFunc GetWorkflowGraphFunc) {
+	r.getWorkflowGraphFunc = getWorkflowGraphFunc
+}
+
+func (r *MockWorkflowManager) GetWorkflowGraph(
+	ctx context.Context, request admin.ObjectGetRequest) (*interfaces.WorkflowGraph, error) {
+	if r.getWorkflowGraphFunc != nil {
+		return r.getWorkflowGraphFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (r *MockWorkflowManager) SetCompileWorkflowCallback(compileWorkflowFunc CompileWorkflowFunc) {
+	r.compileWorkflowFunc = compileWorkflowFunc
+}
+
+func (r *MockWorkflowManager) CompileWorkflow(
+	ctx context.Context, request interfaces.WorkflowCompileRequest) (*interfaces.WorkflowCompileResponse, error) {
+	if r.compileWorkflowFunc != nil {
+		return r.compileWorkflowFunc(ctx, request)
+	}
+	return nil, nil
+}
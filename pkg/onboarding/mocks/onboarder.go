@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/onboarding"
+)
+
+type OnboardFunc func(ctx context.Context, project string) interfaces.ProjectOnboardingStatus
+
+type MockOnboarder struct {
+	OnboardFunc OnboardFunc
+	// The hooks registered by name.
+	Hooks map[string]onboarding.Hook
+}
+
+func (m *MockOnboarder) Onboard(ctx context.Context, project string) interfaces.ProjectOnboardingStatus {
+	if m.OnboardFunc != nil {
+		return m.OnboardFunc(ctx, project)
+	}
+	return interfaces.ProjectOnboardingStatus{}
+}
+
+func (m *MockOnboarder) RegisterHook(name string, hook onboarding.Hook) {
+	if m.Hooks == nil {
+		m.Hooks = make(map[string]onboarding.Hook)
+	}
+	m.Hooks[name] = hook
+}
@@ -0,0 +1,380 @@
+package onboarding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/async/notifications"
+	notificationInterfaces "github.com/lyft/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/lyft/flyteadmin/pkg/clusterresource"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// An onboarding step run for a newly registered project. Returns whether the step was skipped because there's nothing
+// configured for it.
+type Hook func(ctx context.Context, project string) (bool, error)
+
+// The onboarding Onboarder provisions what newly registered projects need so that their teams can launch executions
+// right away: namespace resources, default execution queues and attributes, a sample launch plan in each domain and a
+// welcome notification.
+type Onboarder interface {
+	// Runs each onboarding step for the project, recording its progress as the project's onboarding status. Failed
+	// steps don't stop the remaining steps from running.
+	Onboard(ctx context.Context, project string) interfaces.ProjectOnboardingStatus
+	// Adds a step run after the built-in ones, e.g. to notify systems outside of flyteadmin of new projects.
+	RegisterHook(name string, hook Hook)
+}
+
+type onboarderMetrics struct {
+	Scope             promutils.Scope
+	ProjectsOnboarded prometheus.Counter
+	StepFailures      prometheus.Counter
+	Panics            prometheus.Counter
+}
+
+type onboarder struct {
+	db                        repositories.RepositoryInterface
+	config                    runtimeInterfaces.Configuration
+	clusterResourceController clusterresource.Controller
+	launchPlanManager         interfaces.LaunchPlanInterface
+	projectDomainManager      interfaces.ProjectDomainInterface
+	publisher                 notificationInterfaces.Publisher
+	metrics                   onboarderMetrics
+
+	mutex sync.RWMutex
+	hooks []onboardingStep
+}
+
+type onboardingStep struct {
+	name string
+	run  Hook
+}
+
+func (o *onboarder) getBuiltInSteps() []onboardingStep {
+	return []onboardingStep{
+		{
+			name: interfaces.OnboardingStepNamespaceResources,
+			run:  o.createNamespaceResources,
+		},
+		{
+			name: interfaces.OnboardingStepQueueAssignments,
+			run:  o.assignQueues,
+		},
+		{
+			name: interfaces.OnboardingStepDefaultAttributes,
+			run:  o.seedDefaultAttributes,
+		},
+		{
+			name: interfaces.OnboardingStepSampleLaunchPlan,
+			run:  o.registerSampleLaunchPlan,
+		},
+		{
+			name: interfaces.OnboardingStepWelcomeNotification,
+			run:  o.sendWelcomeNotification,
+		},
+	}
+}
+
+func (o *onboarder) RegisterHook(name string, hook Hook) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.hooks = append(o.hooks, onboardingStep{
+		name: name,
+		run:  hook,
+	})
+}
+
+// Returns the built-in steps followed by the registered hooks, or only those configured in the configured order.
+func (o *onboarder) getSteps(ctx context.Context) []onboardingStep {
+	o.mutex.RLock()
+	steps := append(o.getBuiltInSteps(), o.hooks...)
+	o.mutex.RUnlock()
+	configuredSteps := o.config.ApplicationConfiguration().GetTopLevelConfig().Onboarding.Steps
+	if len(configuredSteps) == 0 {
+		return steps
+	}
+	stepsByName := make(map[string]onboardingStep, len(steps))
+	for _, step := range steps {
+		stepsByName[step.name] = step
+	}
+	selectedSteps := make([]onboardingStep, 0, len(configuredSteps))
+	for _, name := range configuredSteps {
+		step, ok := stepsByName[name]
+		if !ok {
+			logger.Warningf(ctx, "Ignoring unknown onboarding step [%s]", name)
+			continue
+		}
+		selectedSteps = append(selectedSteps, step)
+	}
+	return selectedSteps
+}
+
+func (o *onboarder) createNamespaceResources(ctx context.Context, project string) (bool, error) {
+	if len(o.config.ClusterResourceConfiguration().GetTemplatePath()) == 0 {
+		return true, nil
+	}
+	return false, o.clusterResourceController.SyncProject(ctx, project)
+}
+
+// Returns a copy of the attributes set for the project and domain, e.g. before the project was registered.
+func (o *onboarder) getProjectDomainAttributes(ctx context.Context, project, domain string) (map[string]string, error) {
+	attributes := make(map[string]string)
+	projectDomainModel, err := o.db.ProjectDomainRepo().Get(ctx, project, domain)
+	if err != nil {
+		if adminErr, ok := err.(errors.FlyteAdminError); !ok || adminErr.Code() != codes.NotFound {
+			return nil, err
+		}
+		return attributes, nil
+	}
+	existingAttributes, err := transformers.FromProjectDomainModel(projectDomainModel)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range existingAttributes.Attributes {
+		attributes[key] = value
+	}
+	return attributes, nil
+}
+
+func (o *onboarder) updateProjectDomainAttributes(
+	ctx context.Context, project, domain string, attributes map[string]string) error {
+	_, err := o.projectDomainManager.UpdateProjectDomain(ctx, admin.ProjectDomainAttributesUpdateRequest{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project:    project,
+			Domain:     domain,
+			Attributes: attributes,
+		},
+	})
+	return err
+}
+
+// Existing queue assignments, e.g. made before the project was registered, are left as is.
+func (o *onboarder) assignQueues(ctx context.Context, project string) (bool, error) {
+	queueTags := o.config.ApplicationConfiguration().GetTopLevelConfig().Onboarding.QueueTags
+	skipped := true
+	for _, domain := range *o.config.ApplicationConfiguration().GetDomainsConfig() {
+		tags, ok := queueTags[domain.ID]
+		if !ok || len(tags) == 0 {
+			continue
+		}
+		skipped = false
+		attributes, err := o.getProjectDomainAttributes(ctx, project, domain.ID)
+		if err != nil {
+			return false, err
+		}
+		if _, ok := attributes[executions.ExecutionQueueTagsAttribute]; ok {
+			logger.Infof(ctx, "Not assigning default queues to [%s/%s] which already has queues assigned",
+				project, domain.ID)
+			continue
+		}
+		attributes[executions.ExecutionQueueTagsAttribute] = strings.Join(tags, ",")
+		if err := o.updateProjectDomainAttributes(ctx, project, domain.ID, attributes); err != nil {
+			return false, err
+		}
+	}
+	return skipped, nil
+}
+
+// Attributes already set for the project and domain aren't overridden.
+func (o *onboarder) seedDefaultAttributes(ctx context.Context, project string) (bool, error) {
+	defaultAttributes := o.config.ApplicationConfiguration().GetTopLevelConfig().Onboarding.DefaultAttributes
+	skipped := true
+	for _, domain := range *o.config.ApplicationConfiguration().GetDomainsConfig() {
+		domainAttributes := defaultAttributes[domain.ID]
+		if len(domainAttributes) == 0 {
+			continue
+		}
+		skipped = false
+		attributes, err := o.getProjectDomainAttributes(ctx, project, domain.ID)
+		if err != nil {
+			return false, err
+		}
+		var seeded bool
+		for key, value := range domainAttributes {
+			if _, ok := attributes[key]; ok {
+				continue
+			}
+			attributes[key] = value
+			seeded = true
+		}
+		if !seeded {
+			continue
+		}
+		if err := o.updateProjectDomainAttributes(ctx, project, domain.ID, attributes); err != nil {
+			return false, err
+		}
+	}
+	return skipped, nil
+}
+
+// Copies the configured sample launch plan into each of the project's domains. The copies reference the sample's
+// workflow and are registered inactive.
+func (o *onboarder) registerSampleLaunchPlan(ctx context.Context, project string) (bool, error) {
+	sample := o.config.ApplicationConfiguration().GetTopLevelConfig().Onboarding.SampleLaunchPlan
+	if len(sample.Name) == 0 {
+		return true, nil
+	}
+	sampleLaunchPlan, err := o.launchPlanManager.GetLaunchPlan(ctx, admin.ObjectGetRequest{
+		Id: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      sample.Project,
+			Domain:       sample.Domain,
+			Name:         sample.Name,
+			Version:      sample.Version,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	errs := make([]error, 0)
+	for _, domain := range *o.config.ApplicationConfiguration().GetDomainsConfig() {
+		_, err := o.launchPlanManager.CreateLaunchPlan(ctx, admin.LaunchPlanCreateRequest{
+			Id: &core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      project,
+				Domain:       domain.ID,
+				Name:         sample.Name,
+				Version:      sample.Version,
+			},
+			Spec: proto.Clone(sampleLaunchPlan.Spec).(*admin.LaunchPlanSpec),
+		})
+		if err != nil {
+			if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.AlreadyExists {
+				continue
+			}
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return false, errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return false, nil
+}
+
+func substituteProject(message, project string) string {
+	message = strings.Replace(message, "{{ project }}", project, -1)
+	return strings.Replace(message, "{{project}}", project, -1)
+}
+
+// Emails the configured recipients, e.g. platform operators or a mailing list, that the project was registered.
+func (o *onboarder) sendWelcomeNotification(ctx context.Context, project string) (bool, error) {
+	welcomeNotification := o.config.ApplicationConfiguration().GetTopLevelConfig().Onboarding.WelcomeNotification
+	if len(welcomeNotification.Recipients) == 0 {
+		return true, nil
+	}
+	emailerConfig := notifications.GetEmailerConfig(
+		o.config.ApplicationConfiguration().GetNotificationsConfig().NotificationsEmailerConfig, project, "")
+	email := &admin.EmailMessage{
+		RecipientsEmail: welcomeNotification.Recipients,
+		SenderEmail:     emailerConfig.Sender,
+		SubjectLine:     substituteProject(welcomeNotification.Subject, project),
+		Body:            substituteProject(welcomeNotification.Body, project),
+	}
+	return false, o.publisher.Publish(ctx, proto.MessageName(&admin.EmailNotification{}), email)
+}
+
+func (o *onboarder) recordStatus(ctx context.Context, status interfaces.ProjectOnboardingStatus) {
+	serializedStatus, err := json.Marshal(status)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to serialize onboarding status of project [%s] with err: %v", status.Project, err)
+		return
+	}
+	if err := o.db.ProjectRepo().UpdateOnboardingStatus(ctx, status.Project, serializedStatus); err != nil {
+		logger.Warningf(ctx, "Failed to record onboarding status of project [%s] with err: %v", status.Project, err)
+	}
+}
+
+func (o *onboarder) Onboard(ctx context.Context, project string) interfaces.ProjectOnboardingStatus {
+	steps := o.getSteps(ctx)
+	status := interfaces.ProjectOnboardingStatus{
+		Project:   project,
+		Steps:     make([]interfaces.ProjectOnboardingStep, len(steps)),
+		StartedAt: time.Now(),
+	}
+	for idx, step := range steps {
+		status.Steps[idx] = interfaces.ProjectOnboardingStep{
+			Name:  step.name,
+			State: interfaces.OnboardingStepPending,
+		}
+	}
+	o.recordStatus(ctx, status)
+
+	for idx, step := range steps {
+		status.Steps[idx] = o.runStep(ctx, project, step)
+		o.recordStatus(ctx, status)
+	}
+	completedAt := time.Now()
+	status.CompletedAt = &completedAt
+	o.recordStatus(ctx, status)
+	o.metrics.ProjectsOnboarded.Inc()
+	logger.Infof(ctx, "Finished onboarding project [%s] with steps: %+v", project, status.Steps)
+	return status
+}
+
+func (o *onboarder) runStep(
+	ctx context.Context, project string, step onboardingStep) (result interfaces.ProjectOnboardingStep) {
+	result.Name = step.name
+	defer func() {
+		if err := recover(); err != nil {
+			o.metrics.Panics.Inc()
+			logger.Errorf(ctx, "caught panic onboarding project [%s] in step [%s]: %v [%+v]",
+				project, step.name, err, string(debug.Stack()))
+			result.State = interfaces.OnboardingStepFailed
+			result.Message = fmt.Sprintf("%v", err)
+		}
+	}()
+	skipped, err := step.run(ctx, project)
+	switch {
+	case err != nil:
+		logger.Warningf(ctx, "Failed onboarding step [%s] for project [%s] with err: %v", step.name, project, err)
+		o.metrics.StepFailures.Inc()
+		result.State = interfaces.OnboardingStepFailed
+		result.Message = err.Error()
+	case skipped:
+		result.State = interfaces.OnboardingStepSkipped
+	default:
+		result.State = interfaces.OnboardingStepSucceeded
+	}
+	return result
+}
+
+func NewOnboarder(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	clusterResourceController clusterresource.Controller, launchPlanManager interfaces.LaunchPlanInterface,
+	projectDomainManager interfaces.ProjectDomainInterface, publisher notificationInterfaces.Publisher,
+	scope promutils.Scope) Onboarder {
+	return &onboarder{
+		db:                        db,
+		config:                    config,
+		clusterResourceController: clusterResourceController,
+		launchPlanManager:         launchPlanManager,
+		projectDomainManager:      projectDomainManager,
+		publisher:                 publisher,
+		metrics: onboarderMetrics{
+			Scope: scope,
+			ProjectsOnboarded: scope.MustNewCounter("projects_onboarded",
+				"overall count of newly registered projects the onboarding pipeline ran for"),
+			StepFailures: scope.MustNewCounter("step_failures",
+				"overall count of onboarding steps which failed"),
+			Panics: scope.MustNewCounter("panics",
+				"overall count of panics encountered running onboarding steps"),
+		},
+	}
+}
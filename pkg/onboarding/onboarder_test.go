@@ -0,0 +1,305 @@
+package onboarding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	notificationMocks "github.com/lyft/flyteadmin/pkg/async/notifications/mocks"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/executions"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/lyft/flyteadmin/pkg/manager/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+type mockClusterResourceController struct {
+	syncedProjects []string
+}
+
+func (c *mockClusterResourceController) Sync(ctx context.Context) error {
+	return nil
+}
+
+func (c *mockClusterResourceController) SyncProject(ctx context.Context, project string) error {
+	c.syncedProjects = append(c.syncedProjects, project)
+	return nil
+}
+
+func (c *mockClusterResourceController) Run() {}
+
+func getMockConfig(templatePath string, onboardingConfig runtimeInterfaces.OnboardingConfig) runtimeInterfaces.Configuration {
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetDomainsConfig(runtimeInterfaces.DomainsConfig{
+		{
+			ID:   "development",
+			Name: "development",
+		},
+		{
+			ID:   "production",
+			Name: "production",
+		},
+	})
+	mockApplicationConfig.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		Onboarding: onboardingConfig,
+	})
+	config := runtimeMocks.NewMockConfigurationProvider(&mockApplicationConfig, nil, nil, nil, nil, nil)
+	config.(*runtimeMocks.MockConfigurationProvider).AddClusterResourceConfiguration(
+		&runtimeMocks.MockClusterResourceConfiguration{
+			TemplatePath: templatePath,
+		})
+	return config
+}
+
+// Returns the onboarding statuses recorded for projects.
+func getRecordedStatuses(t *testing.T, repository repositories.RepositoryInterface) *[]interfaces.ProjectOnboardingStatus {
+	statuses := make([]interfaces.ProjectOnboardingStatus, 0)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateOnboardingStatusFunction = func(
+		ctx context.Context, projectID string, onboardingStatus []byte) error {
+		var status interfaces.ProjectOnboardingStatus
+		assert.NoError(t, json.Unmarshal(onboardingStatus, &status))
+		assert.Equal(t, projectID, status.Project)
+		statuses = append(statuses, status)
+		return nil
+	}
+	return &statuses
+}
+
+func TestOnboard(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectDomainRepo().(*repositoryMocks.MockProjectDomainRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+		if domain == "development" {
+			return transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+				Project: project,
+				Domain:  domain,
+				Attributes: map[string]string{
+					"foo": "bar",
+				},
+			})
+		}
+		return transformers.ToProjectDomainModel(admin.ProjectDomainAttributes{
+			Project: project,
+			Domain:  domain,
+			Attributes: map[string]string{
+				executions.ExecutionQueueTagsAttribute: "assigned",
+			},
+		})
+	}
+	statuses := getRecordedStatuses(t, repository)
+
+	updatedAttributes := make(map[string]map[string]string)
+	projectDomainManager := managerMocks.MockProjectDomainManager{}
+	projectDomainManager.SetUpdateProjectDomainAttributes(func(
+		ctx context.Context, request admin.ProjectDomainAttributesUpdateRequest) (
+		*admin.ProjectDomainAttributesUpdateResponse, error) {
+		updatedAttributes[request.Attributes.Domain] = request.Attributes.Attributes
+		return &admin.ProjectDomainAttributesUpdateResponse{}, nil
+	})
+
+	launchPlanManager := managerMocks.MockLaunchPlanManager{}
+	launchPlanManager.SetGetCallback(func(ctx context.Context, request admin.ObjectGetRequest) (
+		*admin.LaunchPlan, error) {
+		assert.Equal(t, "flytesnacks", request.Id.Project)
+		assert.Equal(t, "hello_world", request.Id.Name)
+		return &admin.LaunchPlan{
+			Id: request.Id,
+			Spec: &admin.LaunchPlanSpec{
+				WorkflowId: &core.Identifier{
+					ResourceType: core.ResourceType_WORKFLOW,
+					Project:      "flytesnacks",
+					Domain:       "development",
+					Name:         "hello_world",
+					Version:      "v1",
+				},
+			},
+		}, nil
+	})
+	createdDomains := make([]string, 0)
+	launchPlanManager.SetCreateCallback(func(ctx context.Context, request admin.LaunchPlanCreateRequest) (
+		*admin.LaunchPlanCreateResponse, error) {
+		assert.Equal(t, "project", request.Id.Project)
+		assert.Equal(t, "hello_world", request.Id.Name)
+		assert.Equal(t, "v1", request.Id.Version)
+		assert.Equal(t, "flytesnacks", request.Spec.WorkflowId.Project)
+		createdDomains = append(createdDomains, request.Id.Domain)
+		if request.Id.Domain == "production" {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "launch plan already exists")
+		}
+		return &admin.LaunchPlanCreateResponse{}, nil
+	})
+
+	var publishedEmails []*admin.EmailMessage
+	publisher := notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, "flyteidl.admin.EmailNotification", key)
+		publishedEmails = append(publishedEmails, msg.(*admin.EmailMessage))
+		return nil
+	})
+
+	clusterResourceController := mockClusterResourceController{}
+	onboarder := NewOnboarder(repository, getMockConfig("/etc/templates", runtimeInterfaces.OnboardingConfig{
+		Enabled: true,
+		QueueTags: map[string][]string{
+			"development": {"gpu", "large"},
+			"production":  {"default"},
+		},
+		DefaultAttributes: map[string]map[string]string{
+			"production": {
+				executions.ExecutionQueueTagsAttribute: "default",
+				"owner":                                "team",
+			},
+		},
+		SampleLaunchPlan: runtimeInterfaces.LaunchPlanReference{
+			Project: "flytesnacks",
+			Domain:  "development",
+			Name:    "hello_world",
+			Version: "v1",
+		},
+		WelcomeNotification: runtimeInterfaces.WelcomeNotificationConfig{
+			Recipients: []string{"platform@example.com"},
+			Subject:    "Project {{ project }} is ready",
+			Body:       "Launch executions in {{project}}.",
+		},
+	}), &clusterResourceController, &launchPlanManager, &projectDomainManager, &publisher, mockScope.NewTestScope())
+
+	status := onboarder.Onboard(context.Background(), "project")
+	assert.Equal(t, []interfaces.ProjectOnboardingStep{
+		{
+			Name:  interfaces.OnboardingStepNamespaceResources,
+			State: interfaces.OnboardingStepSucceeded,
+		},
+		{
+			Name:  interfaces.OnboardingStepQueueAssignments,
+			State: interfaces.OnboardingStepSucceeded,
+		},
+		{
+			Name:  interfaces.OnboardingStepDefaultAttributes,
+			State: interfaces.OnboardingStepSucceeded,
+		},
+		{
+			Name:  interfaces.OnboardingStepSampleLaunchPlan,
+			State: interfaces.OnboardingStepSucceeded,
+		},
+		{
+			Name:  interfaces.OnboardingStepWelcomeNotification,
+			State: interfaces.OnboardingStepSucceeded,
+		},
+	}, status.Steps)
+	assert.NotNil(t, status.CompletedAt)
+	assert.Equal(t, []string{"project"}, clusterResourceController.syncedProjects)
+	// Existing attributes are kept and neither existing queue assignments nor attributes are replaced.
+	assert.Equal(t, map[string]map[string]string{
+		"development": {
+			"foo":                                  "bar",
+			executions.ExecutionQueueTagsAttribute: "gpu,large",
+		},
+		"production": {
+			executions.ExecutionQueueTagsAttribute: "assigned",
+			"owner":                                "team",
+		},
+	}, updatedAttributes)
+	assert.Equal(t, []string{"development", "production"}, createdDomains)
+	assert.Equal(t, []*admin.EmailMessage{
+		{
+			RecipientsEmail: []string{"platform@example.com"},
+			SubjectLine:     "Project project is ready",
+			Body:            "Launch executions in project.",
+		},
+	}, publishedEmails)
+
+	// The initial pending steps, each step's outcome and the completion are recorded.
+	assert.Len(t, *statuses, 7)
+	assert.Equal(t, interfaces.OnboardingStepPending, (*statuses)[0].Steps[0].State)
+	assert.Nil(t, (*statuses)[0].CompletedAt)
+	assert.Equal(t, interfaces.OnboardingStepSucceeded, (*statuses)[1].Steps[0].State)
+	assert.Equal(t, interfaces.OnboardingStepPending, (*statuses)[1].Steps[1].State)
+	assert.NotNil(t, (*statuses)[6].CompletedAt)
+}
+
+func TestOnboard_SkippedAndFailedSteps(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	statuses := getRecordedStatuses(t, repository)
+	launchPlanManager := managerMocks.MockLaunchPlanManager{}
+	launchPlanManager.SetGetCallback(func(ctx context.Context, request admin.ObjectGetRequest) (
+		*admin.LaunchPlan, error) {
+		return nil, errors.New("launch plan not found")
+	})
+	clusterResourceController := mockClusterResourceController{}
+	onboarder := NewOnboarder(repository, getMockConfig("", runtimeInterfaces.OnboardingConfig{
+		Enabled: true,
+		SampleLaunchPlan: runtimeInterfaces.LaunchPlanReference{
+			Name: "hello_world",
+		},
+	}), &clusterResourceController, &launchPlanManager, &managerMocks.MockProjectDomainManager{},
+		&notificationMocks.MockPublisher{}, mockScope.NewTestScope())
+
+	status := onboarder.Onboard(context.Background(), "project")
+	assert.Equal(t, []interfaces.ProjectOnboardingStep{
+		{
+			Name:  interfaces.OnboardingStepNamespaceResources,
+			State: interfaces.OnboardingStepSkipped,
+		},
+		{
+			Name:  interfaces.OnboardingStepQueueAssignments,
+			State: interfaces.OnboardingStepSkipped,
+		},
+		{
+			Name:  interfaces.OnboardingStepDefaultAttributes,
+			State: interfaces.OnboardingStepSkipped,
+		},
+		{
+			Name:    interfaces.OnboardingStepSampleLaunchPlan,
+			State:   interfaces.OnboardingStepFailed,
+			Message: "launch plan not found",
+		},
+		{
+			Name:  interfaces.OnboardingStepWelcomeNotification,
+			State: interfaces.OnboardingStepSkipped,
+		},
+	}, status.Steps)
+	assert.Empty(t, clusterResourceController.syncedProjects)
+	assert.Equal(t, status.Steps, (*statuses)[len(*statuses)-1].Steps)
+}
+
+func TestOnboard_RegisteredHooks(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	getRecordedStatuses(t, repository)
+	onboarder := NewOnboarder(repository, getMockConfig("", runtimeInterfaces.OnboardingConfig{
+		Enabled: true,
+		Steps:   []string{"CUSTOM", interfaces.OnboardingStepNamespaceResources, "UNKNOWN"},
+	}), &mockClusterResourceController{}, &managerMocks.MockLaunchPlanManager{},
+		&managerMocks.MockProjectDomainManager{}, &notificationMocks.MockPublisher{}, mockScope.NewTestScope())
+	var hookedProjects []string
+	onboarder.RegisterHook("CUSTOM", func(ctx context.Context, project string) (bool, error) {
+		hookedProjects = append(hookedProjects, project)
+		return false, nil
+	})
+
+	// Only the configured steps run in the configured order.
+	status := onboarder.Onboard(context.Background(), "project")
+	assert.Equal(t, []interfaces.ProjectOnboardingStep{
+		{
+			Name:  "CUSTOM",
+			State: interfaces.OnboardingStepSucceeded,
+		},
+		{
+			Name:  interfaces.OnboardingStepNamespaceResources,
+			State: interfaces.OnboardingStepSkipped,
+		},
+	}, status.Steps)
+	assert.Equal(t, []string{"project"}, hookedProjects)
+}
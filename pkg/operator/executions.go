@@ -0,0 +1,109 @@
+// Implements the operational tasks exposed as subcommands of the admin binary on top of the admin service API, so
+// they work against any running admin instance without direct database access.
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/lyft/flytestdlib/logger"
+)
+
+const listPageSize = 100
+
+type TerminateExecutionsConfig struct {
+	Project string
+	Domain  string
+	// Filters in the admin list filter syntax, e.g. eq(launch_plan.name,my.launch.plan)
+	Filters string
+	Cause   string
+	// When set, matching executions are reported but not terminated.
+	DryRun bool
+}
+
+type TerminateExecutionsResult struct {
+	// Executions which matched the filters and were still running.
+	Matched []*core.WorkflowExecutionIdentifier
+	// Executions which were successfully terminated. Always empty for dry runs.
+	Terminated []*core.WorkflowExecutionIdentifier
+	// Executions which could not be terminated along with the reason.
+	Failed map[string]error
+}
+
+func (c TerminateExecutionsConfig) validate() error {
+	if c.Project == "" || c.Domain == "" {
+		return fmt.Errorf("a project and domain are required")
+	}
+	if c.Cause == "" && !c.DryRun {
+		return fmt.Errorf("a cause is required to terminate executions")
+	}
+	return nil
+}
+
+// Terminates every non-terminal execution in a project and domain matching the configured filters.
+// Individual termination failures are collected in the result rather than aborting the remaining terminations.
+func TerminateExecutions(ctx context.Context, client service.AdminServiceClient, config TerminateExecutionsConfig) (
+	TerminateExecutionsResult, error) {
+	if err := config.validate(); err != nil {
+		return TerminateExecutionsResult{}, err
+	}
+	matched, err := listRunningExecutions(ctx, client, config)
+	if err != nil {
+		return TerminateExecutionsResult{}, err
+	}
+	result := TerminateExecutionsResult{
+		Matched: matched,
+		Failed:  make(map[string]error),
+	}
+	if config.DryRun {
+		return result, nil
+	}
+	for _, id := range matched {
+		_, err := client.TerminateExecution(ctx, &admin.ExecutionTerminateRequest{
+			Id:    id,
+			Cause: config.Cause,
+		})
+		if err != nil {
+			logger.Warningf(ctx, "Failed to terminate execution [%+v] with err: %v", id, err)
+			result.Failed[id.Name] = err
+			continue
+		}
+		result.Terminated = append(result.Terminated, id)
+	}
+	return result, nil
+}
+
+// Lists all matching executions up front so that terminations don't shift the pages being read.
+func listRunningExecutions(ctx context.Context, client service.AdminServiceClient,
+	config TerminateExecutionsConfig) ([]*core.WorkflowExecutionIdentifier, error) {
+	var ids []*core.WorkflowExecutionIdentifier
+	token := ""
+	for {
+		executions, err := client.ListExecutions(ctx, &admin.ResourceListRequest{
+			Id: &admin.NamedEntityIdentifier{
+				Project: config.Project,
+				Domain:  config.Domain,
+			},
+			Limit:   listPageSize,
+			Token:   token,
+			Filters: config.Filters,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range executions.Executions {
+			if execution.Closure != nil && common.IsExecutionTerminal(execution.Closure.Phase) {
+				continue
+			}
+			ids = append(ids, execution.Id)
+		}
+		if executions.Token == "" || len(executions.Executions) == 0 {
+			return ids, nil
+		}
+		token = executions.Token
+	}
+}
@@ -0,0 +1,178 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Only the methods used by the operator tasks are implemented, calling any other method panics.
+type mockAdminClient struct {
+	service.AdminServiceClient
+	listExecutions     func(request *admin.ResourceListRequest) (*admin.ExecutionList, error)
+	terminateExecution func(request *admin.ExecutionTerminateRequest) error
+	listProjects       func() (*admin.Projects, error)
+	registerProject    func(request *admin.ProjectRegisterRequest) error
+}
+
+func (c *mockAdminClient) ListExecutions(ctx context.Context, in *admin.ResourceListRequest,
+	opts ...grpc.CallOption) (*admin.ExecutionList, error) {
+	return c.listExecutions(in)
+}
+
+func (c *mockAdminClient) TerminateExecution(ctx context.Context, in *admin.ExecutionTerminateRequest,
+	opts ...grpc.CallOption) (*admin.ExecutionTerminateResponse, error) {
+	return &admin.ExecutionTerminateResponse{}, c.terminateExecution(in)
+}
+
+func (c *mockAdminClient) ListProjects(ctx context.Context, in *admin.ProjectListRequest,
+	opts ...grpc.CallOption) (*admin.Projects, error) {
+	return c.listProjects()
+}
+
+func (c *mockAdminClient) RegisterProject(ctx context.Context, in *admin.ProjectRegisterRequest,
+	opts ...grpc.CallOption) (*admin.ProjectRegisterResponse, error) {
+	return &admin.ProjectRegisterResponse{}, c.registerProject(in)
+}
+
+func getExecution(name string, phase core.WorkflowExecution_Phase) *admin.Execution {
+	return &admin.Execution{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    name,
+		},
+		Closure: &admin.ExecutionClosure{
+			Phase: phase,
+		},
+	}
+}
+
+func getPagedExecutionsClient(t *testing.T) *mockAdminClient {
+	return &mockAdminClient{
+		listExecutions: func(request *admin.ResourceListRequest) (*admin.ExecutionList, error) {
+			assert.Equal(t, "project", request.Id.Project)
+			assert.Equal(t, "domain", request.Id.Domain)
+			assert.Equal(t, "eq(launch_plan.name,lp)", request.Filters)
+			if request.Token == "" {
+				return &admin.ExecutionList{
+					Executions: []*admin.Execution{
+						getExecution("a", core.WorkflowExecution_RUNNING),
+						getExecution("b", core.WorkflowExecution_SUCCEEDED),
+					},
+					Token: "2",
+				}, nil
+			}
+			return &admin.ExecutionList{
+				Executions: []*admin.Execution{
+					getExecution("c", core.WorkflowExecution_QUEUED),
+				},
+			}, nil
+		},
+	}
+}
+
+func TestTerminateExecutions(t *testing.T) {
+	client := getPagedExecutionsClient(t)
+	client.terminateExecution = func(request *admin.ExecutionTerminateRequest) error {
+		assert.Equal(t, "maintenance", request.Cause)
+		if request.Id.Name == "c" {
+			return status.Error(codes.Internal, "foo")
+		}
+		return nil
+	}
+	result, err := TerminateExecutions(context.Background(), client, TerminateExecutionsConfig{
+		Project: "project",
+		Domain:  "domain",
+		Filters: "eq(launch_plan.name,lp)",
+		Cause:   "maintenance",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result.Matched, 2)
+	assert.Len(t, result.Terminated, 1)
+	assert.Equal(t, "a", result.Terminated[0].Name)
+	assert.Len(t, result.Failed, 1)
+	assert.Contains(t, result.Failed, "c")
+}
+
+func TestTerminateExecutions_DryRun(t *testing.T) {
+	client := getPagedExecutionsClient(t)
+	result, err := TerminateExecutions(context.Background(), client, TerminateExecutionsConfig{
+		Project: "project",
+		Domain:  "domain",
+		Filters: "eq(launch_plan.name,lp)",
+		DryRun:  true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result.Matched, 2)
+	assert.Empty(t, result.Terminated)
+}
+
+func TestTerminateExecutions_InvalidConfig(t *testing.T) {
+	_, err := TerminateExecutions(context.Background(), nil, TerminateExecutionsConfig{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.EqualError(t, err, "a cause is required to terminate executions")
+}
+
+func TestReadProjects(t *testing.T) {
+	projects, err := ReadProjects(strings.NewReader(
+		`{"projects": [{"id": "flytesnacks", "name": "Flyte Snacks"}, {"id": "other"}]}`))
+	assert.NoError(t, err)
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "Flyte Snacks", projects[0].Name)
+
+	_, err = ReadProjects(strings.NewReader(`{"projects": [{"id": "a"}, {"id": "a"}]}`))
+	assert.EqualError(t, err, "project [a] is listed more than once")
+
+	_, err = ReadProjects(strings.NewReader(`{"projects": [{"name": "a"}]}`))
+	assert.EqualError(t, err, "every project must have an id")
+}
+
+func TestApplyProjects(t *testing.T) {
+	var registered []string
+	client := &mockAdminClient{
+		listProjects: func() (*admin.Projects, error) {
+			return &admin.Projects{
+				Projects: []*admin.Project{{Id: "existing"}},
+			}, nil
+		},
+		registerProject: func(request *admin.ProjectRegisterRequest) error {
+			if request.Project.Id == "raced" {
+				return status.Error(codes.AlreadyExists, "already exists")
+			}
+			registered = append(registered, request.Project.Id)
+			return nil
+		},
+	}
+	result, err := ApplyProjects(context.Background(), client, []*admin.Project{
+		{Id: "existing"}, {Id: "new"}, {Id: "raced"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"new"}, result.Registered)
+	assert.Equal(t, []string{"existing", "raced"}, result.Unchanged)
+	assert.Equal(t, []string{"new"}, registered)
+}
+
+func TestApplyProjects_RegisterError(t *testing.T) {
+	client := &mockAdminClient{
+		listProjects: func() (*admin.Projects, error) {
+			return &admin.Projects{}, nil
+		},
+		registerProject: func(request *admin.ProjectRegisterRequest) error {
+			return errors.New("foo")
+		},
+	}
+	_, err := ApplyProjects(context.Background(), client, []*admin.Project{{Id: "new"}})
+	assert.EqualError(t, err, "failed to register project [new]: foo")
+}
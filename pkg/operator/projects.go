@@ -0,0 +1,76 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ApplyProjectsResult struct {
+	// Ids of the projects which were registered.
+	Registered []string
+	// Ids of the projects which already existed. The admin API has no way to update a registered project so these
+	// are left as is.
+	Unchanged []string
+}
+
+// Reads a list of projects in the JSON form of admin.Projects, for instance:
+//
+//	{"projects": [{"id": "flytesnacks", "name": "Flyte Snacks", "description": "Example workflows"}]}
+func ReadProjects(reader io.Reader) ([]*admin.Project, error) {
+	var projects admin.Projects
+	if err := jsonpb.Unmarshal(reader, &projects); err != nil {
+		return nil, fmt.Errorf("failed to parse projects: %v", err)
+	}
+	seen := make(map[string]bool, len(projects.Projects))
+	for _, project := range projects.Projects {
+		if project.Id == "" {
+			return nil, fmt.Errorf("every project must have an id")
+		}
+		if seen[project.Id] {
+			return nil, fmt.Errorf("project [%s] is listed more than once", project.Id)
+		}
+		seen[project.Id] = true
+	}
+	return projects.Projects, nil
+}
+
+// Registers each of the given projects which isn't already registered.
+func ApplyProjects(ctx context.Context, client service.AdminServiceClient, projects []*admin.Project) (
+	ApplyProjectsResult, error) {
+	existing, err := client.ListProjects(ctx, &admin.ProjectListRequest{})
+	if err != nil {
+		return ApplyProjectsResult{}, err
+	}
+	registered := make(map[string]bool, len(existing.Projects))
+	for _, project := range existing.Projects {
+		registered[project.Id] = true
+	}
+
+	var result ApplyProjectsResult
+	for _, project := range projects {
+		if registered[project.Id] {
+			result.Unchanged = append(result.Unchanged, project.Id)
+			continue
+		}
+		_, err := client.RegisterProject(ctx, &admin.ProjectRegisterRequest{
+			Project: project,
+		})
+		if status.Code(err) == codes.AlreadyExists {
+			// Registered concurrently since the projects were listed.
+			result.Unchanged = append(result.Unchanged, project.Id)
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to register project [%s]: %v", project.Id, err)
+		}
+		result.Registered = append(result.Registered, project.Id)
+	}
+	return result, nil
+}
@@ -0,0 +1,154 @@
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/lyft/flytestdlib/storage"
+
+	authConfig "github.com/lyft/flyteadmin/pkg/auth/config"
+	"github.com/lyft/flyteadmin/pkg/common"
+	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const preflightObjectName = "preflight"
+
+// Verifies the database is reachable and every known migration has been applied.
+func NewDatabaseCheck(dbConfigProvider repositoryConfig.DbConnectionConfigProvider) Check {
+	return Check{
+		Name: "database schema",
+		Remediation: "verify the database section of the config points at a reachable database and run " +
+			"`flyteadmin migrate run` to apply pending migrations",
+		Run: func(ctx context.Context) error {
+			db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			if err = db.DB().PingContext(ctx); err != nil {
+				return err
+			}
+			status, err := repositoryConfig.GetSchemaStatus(db)
+			if err != nil {
+				return err
+			}
+			if len(status.Missing) > 0 {
+				return fmt.Errorf("schema is missing migrations [%s]", strings.Join(status.Missing, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// Verifies admin can write to the configured metadata storage container.
+func NewStorageCheck(store *storage.DataStore) Check {
+	return Check{
+		Name: "storage write access",
+		Remediation: "verify the storage section of the config names an existing container and that admin's " +
+			"credentials allow writing to it",
+		Run: func(ctx context.Context) error {
+			hostname, err := os.Hostname()
+			if err != nil {
+				hostname = "unknown"
+			}
+			reference, err := store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx), preflightObjectName, hostname)
+			if err != nil {
+				return err
+			}
+			contents := []byte(hostname)
+			return store.WriteRaw(ctx, reference, int64(len(contents)), storage.Options{}, bytes.NewReader(contents))
+		},
+	}
+}
+
+// Verifies the notifications topic and queue exist for deployments that publish notifications through AWS.
+// Returns no checks for other notification types, which have no external dependencies.
+func NewNotificationsChecks(config runtimeInterfaces.NotificationsConfig) []Check {
+	if config.Type != common.AWS {
+		return nil
+	}
+	newSession := func() (*session.Session, error) {
+		return session.NewSession(aws.NewConfig().WithRegion(config.Region))
+	}
+	return []Check{
+		{
+			Name: "notifications topic",
+			Remediation: "verify notifications.publisher.topicName is the ARN of an existing SNS topic in " +
+				"notifications.region that admin is allowed to publish to",
+			Run: func(ctx context.Context) error {
+				awsSession, err := newSession()
+				if err != nil {
+					return err
+				}
+				_, err = sns.New(awsSession).GetTopicAttributesWithContext(ctx, &sns.GetTopicAttributesInput{
+					TopicArn: aws.String(config.NotificationsPublisherConfig.TopicName),
+				})
+				return err
+			},
+		},
+		{
+			Name: "notifications queue",
+			Remediation: "verify notifications.processor.queueName and accountId name an existing SQS queue in " +
+				"notifications.region that admin is allowed to consume from",
+			Run: func(ctx context.Context) error {
+				awsSession, err := newSession()
+				if err != nil {
+					return err
+				}
+				input := &sqs.GetQueueUrlInput{
+					QueueName: aws.String(config.NotificationsProcessorConfig.QueueName),
+				}
+				if config.NotificationsProcessorConfig.AccountID != "" {
+					input.QueueOwnerAWSAccountId = aws.String(config.NotificationsProcessorConfig.AccountID)
+				}
+				_, err = sqs.New(awsSession).GetQueueUrlWithContext(ctx, input)
+				return err
+			},
+		},
+	}
+}
+
+// Verifies the authorization server's metadata document can be fetched.
+func NewOAuthIssuerCheck(options authConfig.OAuthOptions, metadataPath string, client *http.Client) Check {
+	return Check{
+		Name: "oauth issuer",
+		Remediation: "verify security.oauth.baseUrl is the base url of your identity provider and that it is " +
+			"reachable from admin",
+		Run: func(ctx context.Context) error {
+			baseURL, err := url.Parse(options.BaseURL)
+			if err != nil {
+				return err
+			}
+			relativePath, err := url.Parse(metadataPath)
+			if err != nil {
+				return err
+			}
+			metadataURL := baseURL.ResolveReference(relativePath)
+			request, err := http.NewRequest(http.MethodGet, metadataURL.String(), nil)
+			if err != nil {
+				return err
+			}
+			response, err := client.Do(request.WithContext(ctx))
+			if err != nil {
+				return err
+			}
+			defer response.Body.Close()
+			if response.StatusCode != http.StatusOK {
+				return fmt.Errorf("unexpected status [%d] fetching %s", response.StatusCode, metadataURL)
+			}
+			return nil
+		},
+	}
+}
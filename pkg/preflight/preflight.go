@@ -0,0 +1,65 @@
+// Verifies that the external dependencies admin relies on are reachable and correctly configured before the server
+// starts accepting traffic, so that misconfigurations surface at once rather than lazily on the first request.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lyft/flytestdlib/logger"
+)
+
+const defaultCheckTimeout = 10 * time.Second
+
+// A single dependency verification along with a hint on how to fix it when it fails.
+type Check struct {
+	Name        string
+	Remediation string
+	Run         func(ctx context.Context) error
+}
+
+type Failure struct {
+	Check       string
+	Err         error
+	Remediation string
+}
+
+// Aggregates every failed check.
+type Error struct {
+	Failures []Failure
+}
+
+func (e *Error) Error() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%d preflight check(s) failed:", len(e.Failures))
+	for _, failure := range e.Failures {
+		fmt.Fprintf(&builder, "\n  - %s: %v\n    remediation: %s", failure.Check, failure.Err, failure.Remediation)
+	}
+	return builder.String()
+}
+
+// Runs every check, each bounded by a timeout, and returns an *Error listing all failures or nil if all checks pass.
+func Run(ctx context.Context, checks []Check) error {
+	var failures []Failure
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, defaultCheckTimeout)
+		err := check.Run(checkCtx)
+		cancel()
+		if err != nil {
+			logger.Errorf(ctx, "preflight check [%s] failed: %v", check.Name, err)
+			failures = append(failures, Failure{
+				Check:       check.Name,
+				Err:         err,
+				Remediation: check.Remediation,
+			})
+			continue
+		}
+		logger.Infof(ctx, "preflight check [%s] passed", check.Name)
+	}
+	if len(failures) > 0 {
+		return &Error{Failures: failures}
+	}
+	return nil
+}
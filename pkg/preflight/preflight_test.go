@@ -0,0 +1,68 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authConfig "github.com/lyft/flyteadmin/pkg/auth/config"
+	"github.com/lyft/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var ranChecks []string
+	newCheck := func(name string, err error) Check {
+		return Check{
+			Name:        name,
+			Remediation: "fix " + name,
+			Run: func(ctx context.Context) error {
+				ranChecks = append(ranChecks, name)
+				return err
+			},
+		}
+	}
+
+	assert.NoError(t, Run(context.Background(), []Check{newCheck("a", nil)}))
+
+	ranChecks = nil
+	err := Run(context.Background(), []Check{
+		newCheck("a", errors.New("a is broken")),
+		newCheck("b", nil),
+		newCheck("c", errors.New("c is broken")),
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, ranChecks)
+	preflightErr, ok := err.(*Error)
+	assert.True(t, ok)
+	assert.Len(t, preflightErr.Failures, 2)
+	assert.Equal(t, "2 preflight check(s) failed:\n"+
+		"  - a: a is broken\n    remediation: fix a\n"+
+		"  - c: c is broken\n    remediation: fix c", err.Error())
+}
+
+func TestNewNotificationsChecks(t *testing.T) {
+	assert.Empty(t, NewNotificationsChecks(runtimeInterfaces.NotificationsConfig{Type: common.Local}))
+	assert.Len(t, NewNotificationsChecks(runtimeInterfaces.NotificationsConfig{Type: common.AWS}), 2)
+}
+
+func TestNewOAuthIssuerCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/oauth2/.well-known/oauth-authorization-server" {
+			writer.WriteHeader(http.StatusOK)
+			return
+		}
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	check := NewOAuthIssuerCheck(authConfig.OAuthOptions{BaseURL: server.URL + "/oauth2/"},
+		".well-known/oauth-authorization-server", server.Client())
+	assert.NoError(t, check.Run(context.Background()))
+
+	check = NewOAuthIssuerCheck(authConfig.OAuthOptions{BaseURL: server.URL + "/other/"},
+		".well-known/oauth-authorization-server", server.Client())
+	assert.Error(t, check.Run(context.Background()))
+}
@@ -0,0 +1,560 @@
+// Prunes old task, workflow and launch plan versions so that names which are registered often don't accumulate
+// versions without bound.
+package pruning
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/impl/util"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const defaultBatchSize = 100
+const defaultRefreshInterval = 24 * time.Hour
+const defaultProtectionWindow = 30 * 24 * time.Hour
+
+const createdAtColumn = "created_at"
+const launchPlanIDColumn = "launch_plan_id"
+const workflowIDColumn = "workflow_id"
+
+// Launch plans are pruned first, so that workflow versions which were only referenced by pruned launch plan versions
+// can be pruned in the same run, and likewise for task versions only referenced by pruned workflow versions.
+var prunedResourceTypes = []core.ResourceType{
+	core.ResourceType_LAUNCH_PLAN,
+	core.ResourceType_WORKFLOW,
+	core.ResourceType_TASK,
+}
+
+var ascNameSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "name",
+})
+
+// Workflow versions are paged through by id, which is unique, so that none are skipped between pages.
+var ascWorkflowIDSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "workflows.id",
+})
+
+// Launch plans are listed joined with their workflows so the sort keys must be qualified.
+var descCreatedAtSortParams = map[core.ResourceType]common.SortParameter{
+	core.ResourceType_LAUNCH_PLAN: newDescCreatedAtSortParam("launch_plans"),
+	core.ResourceType_WORKFLOW:    newDescCreatedAtSortParam("workflows"),
+	core.ResourceType_TASK:        newDescCreatedAtSortParam("tasks"),
+}
+
+func newDescCreatedAtSortParam(tableName string) common.SortParameter {
+	sortParam, _ := common.NewSortParameter(admin.Sort{
+		Direction: admin.Sort_DESCENDING,
+		Key:       fmt.Sprintf("%s.%s", tableName, createdAtColumn),
+	})
+	return sortParam
+}
+
+// The pruning Controller removes the task, workflow and launch plan versions registered before the most recent ones
+// which the version retention policy of their project keeps. Versions which are still in use are never pruned: the
+// active launch plan versions, workflow versions referenced by a launch plan version which isn't pruned, task versions
+// referenced by a workflow version which isn't pruned, and any version referenced by an execution created within the
+// protection window.
+type Controller interface {
+	Prune(ctx context.Context) error
+	Run()
+}
+
+type controllerMetrics struct {
+	Scope             promutils.Scope
+	VersionsPruned    prometheus.Counter
+	VersionsPrunable  prometheus.Counter
+	VersionsProtected prometheus.Counter
+	PruneErrors       prometheus.Counter
+	Panics            prometheus.Counter
+}
+
+type controller struct {
+	db            repositories.RepositoryInterface
+	config        runtimeInterfaces.ApplicationConfiguration
+	storageClient *storage.DataStore
+	metrics       controllerMetrics
+	_clock        clock.Clock
+}
+
+// A version of a task, workflow or launch plan considered for pruning.
+type prunableVersion struct {
+	id         uint
+	identifier core.Identifier
+	// Only set for launch plans.
+	active bool
+}
+
+func (c *controller) listNames(ctx context.Context, resourceType core.ResourceType, project string, offset,
+	limit int) ([]admin.NamedEntityIdentifier, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: project,
+	}, common.ResourceTypeToEntity[resourceType])
+	if err != nil {
+		return nil, err
+	}
+	input := repoInterfaces.ListResourceInput{
+		Limit:         limit,
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: ascNameSortParam,
+	}
+	names := make([]admin.NamedEntityIdentifier, 0, limit)
+	switch resourceType {
+	case core.ResourceType_LAUNCH_PLAN:
+		output, err := c.db.LaunchPlanRepo().ListLaunchPlanIdentifiers(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, launchPlan := range output.LaunchPlans {
+			names = append(names, admin.NamedEntityIdentifier{
+				Project: launchPlan.Project,
+				Domain:  launchPlan.Domain,
+				Name:    launchPlan.Name,
+			})
+		}
+	case core.ResourceType_WORKFLOW:
+		output, err := c.db.WorkflowRepo().ListIdentifiers(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, workflow := range output.Workflows {
+			names = append(names, admin.NamedEntityIdentifier{
+				Project: workflow.Project,
+				Domain:  workflow.Domain,
+				Name:    workflow.Name,
+			})
+		}
+	case core.ResourceType_TASK:
+		output, err := c.db.TaskRepo().ListTaskIdentifiers(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range output.Tasks {
+			names = append(names, admin.NamedEntityIdentifier{
+				Project: task.Project,
+				Domain:  task.Domain,
+				Name:    task.Name,
+			})
+		}
+	}
+	return names, nil
+}
+
+// Lists the versions of a name, most recently registered first.
+func (c *controller) listVersions(ctx context.Context, resourceType core.ResourceType,
+	name admin.NamedEntityIdentifier, offset, limit int) ([]prunableVersion, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: name.Project,
+		Domain:  name.Domain,
+		Name:    name.Name,
+	}, common.ResourceTypeToEntity[resourceType])
+	if err != nil {
+		return nil, err
+	}
+	input := repoInterfaces.ListResourceInput{
+		Limit:         limit,
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: descCreatedAtSortParams[resourceType],
+	}
+	versions := make([]prunableVersion, 0, limit)
+	switch resourceType {
+	case core.ResourceType_LAUNCH_PLAN:
+		output, err := c.db.LaunchPlanRepo().List(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, launchPlan := range output.LaunchPlans {
+			versions = append(versions, prunableVersion{
+				id: launchPlan.ID,
+				identifier: core.Identifier{
+					ResourceType: resourceType,
+					Project:      launchPlan.Project,
+					Domain:       launchPlan.Domain,
+					Name:         launchPlan.Name,
+					Version:      launchPlan.Version,
+				},
+				active: launchPlan.State != nil && *launchPlan.State == int32(admin.LaunchPlanState_ACTIVE),
+			})
+		}
+	case core.ResourceType_WORKFLOW:
+		output, err := c.db.WorkflowRepo().List(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, workflow := range output.Workflows {
+			versions = append(versions, prunableVersion{
+				id: workflow.ID,
+				identifier: core.Identifier{
+					ResourceType: resourceType,
+					Project:      workflow.Project,
+					Domain:       workflow.Domain,
+					Name:         workflow.Name,
+					Version:      workflow.Version,
+				},
+			})
+		}
+	case core.ResourceType_TASK:
+		output, err := c.db.TaskRepo().List(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range output.Tasks {
+			versions = append(versions, prunableVersion{
+				id: task.ID,
+				identifier: core.Identifier{
+					ResourceType: resourceType,
+					Project:      task.Project,
+					Domain:       task.Domain,
+					Name:         task.Name,
+					Version:      task.Version,
+				},
+			})
+		}
+	}
+	return versions, nil
+}
+
+func (c *controller) hasRecentExecutions(ctx context.Context, column string, id uint, cutoff time.Time) (
+	bool, error) {
+	idFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, column, id)
+	if err != nil {
+		return false, err
+	}
+	createdAtFilter, err := common.NewSingleValueFilter(
+		common.Execution, common.GreaterThanOrEqual, createdAtColumn, cutoff)
+	if err != nil {
+		return false, err
+	}
+	output, err := c.db.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         1,
+		InlineFilters: []common.InlineFilter{idFilter, createdAtFilter},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.Executions) > 0, nil
+}
+
+func (c *controller) hasLaunchPlans(ctx context.Context, workflowID uint) (bool, error) {
+	filter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, workflowIDColumn, workflowID)
+	if err != nil {
+		return false, err
+	}
+	output, err := c.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         1,
+		InlineFilters: []common.InlineFilter{filter},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.LaunchPlans) > 0, nil
+}
+
+func (c *controller) hasRecentTaskExecutions(ctx context.Context, identifier core.Identifier, cutoff time.Time) (
+	bool, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: identifier.Project,
+		Domain:  identifier.Domain,
+		Name:    identifier.Name,
+	}, common.TaskExecution)
+	if err != nil {
+		return false, err
+	}
+	versionFilter, err := common.NewSingleValueFilter(common.TaskExecution, common.Equal, "version",
+		identifier.Version)
+	if err != nil {
+		return false, err
+	}
+	createdAtFilter, err := common.NewSingleValueFilter(
+		common.TaskExecution, common.GreaterThanOrEqual, createdAtColumn, cutoff)
+	if err != nil {
+		return false, err
+	}
+	output, err := c.db.TaskExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         1,
+		InlineFilters: append(filters, versionFilter, createdAtFilter),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.TaskExecutions) > 0, nil
+}
+
+// Returns the task versions referenced by the compiled closures of the workflow versions left in a project. Closures
+// include the tasks of subworkflows, so a task version is only left unreferenced once no workflow version runs it.
+func (c *controller) listReferencedTasks(ctx context.Context, project string, batchSize int) (
+	map[models.TaskKey]bool, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: project,
+	}, common.Workflow)
+	if err != nil {
+		return nil, err
+	}
+	referencedTasks := make(map[models.TaskKey]bool)
+	for offset := 0; ; offset += batchSize {
+		output, err := c.db.WorkflowRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         batchSize,
+			Offset:        offset,
+			InlineFilters: filters,
+			SortParameter: ascWorkflowIDSortParam,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, workflow := range output.Workflows {
+			closure, err := util.FetchAndGetWorkflowClosure(ctx, c.storageClient, workflow.RemoteClosureIdentifier)
+			if err != nil {
+				return nil, err
+			}
+			for _, task := range closure.GetCompiledWorkflow().GetTasks() {
+				id := task.GetTemplate().GetId()
+				referencedTasks[models.TaskKey{
+					Project: id.GetProject(),
+					Domain:  id.GetDomain(),
+					Name:    id.GetName(),
+					Version: id.GetVersion(),
+				}] = true
+			}
+		}
+		if len(output.Workflows) < batchSize {
+			return referencedTasks, nil
+		}
+	}
+}
+
+// Task versions are only considered after workflow versions were pruned, when referencedTasks holds the task versions
+// the workflow versions left reference.
+func (c *controller) isProtected(ctx context.Context, version prunableVersion, cutoff time.Time,
+	referencedTasks map[models.TaskKey]bool) (bool, error) {
+	switch version.identifier.ResourceType {
+	case core.ResourceType_LAUNCH_PLAN:
+		if version.active {
+			return true, nil
+		}
+		return c.hasRecentExecutions(ctx, launchPlanIDColumn, version.id, cutoff)
+	case core.ResourceType_WORKFLOW:
+		referenced, err := c.hasLaunchPlans(ctx, version.id)
+		if err != nil || referenced {
+			return referenced, err
+		}
+		return c.hasRecentExecutions(ctx, workflowIDColumn, version.id, cutoff)
+	case core.ResourceType_TASK:
+		if referencedTasks[models.TaskKey{
+			Project: version.identifier.Project,
+			Domain:  version.identifier.Domain,
+			Name:    version.identifier.Name,
+			Version: version.identifier.Version,
+		}] {
+			return true, nil
+		}
+		return c.hasRecentTaskExecutions(ctx, version.identifier, cutoff)
+	}
+	return true, nil
+}
+
+func (c *controller) deleteVersion(ctx context.Context, identifier core.Identifier, purge bool) error {
+	input := repoInterfaces.DeleteResourceInput{
+		Project: identifier.Project,
+		Domain:  identifier.Domain,
+		Name:    identifier.Name,
+		Version: identifier.Version,
+		Purge:   purge,
+	}
+	switch identifier.ResourceType {
+	case core.ResourceType_LAUNCH_PLAN:
+		return c.db.LaunchPlanRepo().Delete(ctx, input)
+	case core.ResourceType_WORKFLOW:
+		return c.db.WorkflowRepo().Delete(ctx, input)
+	case core.ResourceType_TASK:
+		return c.db.TaskRepo().Delete(ctx, input)
+	}
+	return nil
+}
+
+// Prunes the versions of a name beyond those the policy keeps. Versions which are kept, including protected ones,
+// are skipped over while paging through the older versions.
+func (c *controller) pruneName(ctx context.Context, resourceType core.ResourceType, name admin.NamedEntityIdentifier,
+	policy runtimeInterfaces.VersionRetentionPolicy, cutoff time.Time, referencedTasks map[models.TaskKey]bool,
+	batchSize int, dryRun bool) error {
+	offset := policy.KeepVersions
+	for {
+		versions, err := c.listVersions(ctx, resourceType, name, offset, batchSize)
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			protected, err := c.isProtected(ctx, version, cutoff, referencedTasks)
+			if err != nil {
+				return err
+			}
+			if protected {
+				logger.Debugf(ctx, "Keeping %s [%+v] as it is still in use", resourceType, version.identifier)
+				c.metrics.VersionsProtected.Inc()
+				offset++
+				continue
+			}
+			if dryRun {
+				logger.Infof(ctx, "Dry run: would prune %s [%+v]", resourceType, version.identifier)
+				c.metrics.VersionsPrunable.Inc()
+				offset++
+				continue
+			}
+			if err := c.deleteVersion(ctx, version.identifier, policy.Purge); err != nil {
+				return err
+			}
+			c.metrics.VersionsPruned.Inc()
+		}
+		if len(versions) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (c *controller) pruneProject(ctx context.Context, project string, policy runtimeInterfaces.VersionRetentionPolicy,
+	cutoff time.Time, batchSize int, dryRun bool) error {
+	if policy.KeepVersions <= 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"version retention policy for project [%s] must keep at least one version", project)
+	}
+	var errs = make([]error, 0)
+	for _, resourceType := range prunedResourceTypes {
+		var referencedTasks map[models.TaskKey]bool
+		if resourceType == core.ResourceType_TASK {
+			var err error
+			referencedTasks, err = c.listReferencedTasks(ctx, project, batchSize)
+			if err != nil {
+				// Task versions can't be told apart from those still in use, so none are pruned.
+				logger.Warningf(ctx, "Failed to list the tasks referenced by workflows in project [%s] with err: %v",
+					project, err)
+				errs = append(errs, err)
+				continue
+			}
+		}
+		for offset := 0; ; offset += batchSize {
+			names, err := c.listNames(ctx, resourceType, project, offset, batchSize)
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+			for _, name := range names {
+				err := c.pruneName(ctx, resourceType, name, policy, cutoff, referencedTasks, batchSize, dryRun)
+				if err != nil {
+					c.metrics.PruneErrors.Inc()
+					logger.Warningf(ctx, "Failed to prune versions of %s [%+v] with err: %v", resourceType, name, err)
+					errs = append(errs, err)
+				}
+			}
+			if len(names) < batchSize {
+				break
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (c *controller) Prune(ctx context.Context) error {
+	defer func() {
+		if err := recover(); err != nil {
+			c.metrics.Panics.Inc()
+			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+
+	versionRetentionConfig := c.config.GetVersionRetentionConfig()
+	batchSize := versionRetentionConfig.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	protectionWindow := versionRetentionConfig.ProtectionWindow.Duration
+	if protectionWindow <= 0 {
+		protectionWindow = defaultProtectionWindow
+	}
+	cutoff := c._clock.Now().Add(-protectionWindow)
+	projects := make([]string, 0, len(versionRetentionConfig.Projects))
+	for project := range versionRetentionConfig.Projects {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+
+	var errs = make([]error, 0)
+	for _, project := range projects {
+		err := c.pruneProject(ctx, project, versionRetentionConfig.Projects[project], cutoff, batchSize,
+			versionRetentionConfig.DryRun)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to prune versions in project [%s] with err: %v", project, err)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (c *controller) Run() {
+	ctx := context.Background()
+	logger.Infof(ctx, "Running PruningController")
+	interval := c.config.GetVersionRetentionConfig().RefreshInterval.Duration
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	wait.Forever(func() {
+		err := c.Prune(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed version pruning with: %v", err)
+		}
+	}, interval)
+}
+
+func newMetrics(scope promutils.Scope) controllerMetrics {
+	return controllerMetrics{
+		Scope: scope,
+		VersionsPruned: scope.MustNewCounter("versions_pruned",
+			"overall count of task, workflow and launch plan versions pruned"),
+		VersionsPrunable: scope.MustNewCounter("versions_prunable",
+			"overall count of versions which would have been pruned in dry-run mode"),
+		VersionsProtected: scope.MustNewCounter("versions_protected",
+			"overall count of versions kept beyond the retention policy because they are still in use"),
+		PruneErrors: scope.MustNewCounter("prune_errors",
+			"overall count of errors encountered pruning the versions of a name"),
+		Panics: scope.MustNewCounter("panics",
+			"overall count of panics encountered in primary PruningController loop"),
+	}
+}
+
+func NewPruningController(
+	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration,
+	storageClient *storage.DataStore, scope promutils.Scope) Controller {
+	return &controller{
+		db:            db,
+		config:        config,
+		storageClient: storageClient,
+		metrics:       newMetrics(scope),
+		_clock:        clock.New(),
+	}
+}
@@ -0,0 +1,328 @@
+package pruning
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/config"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+var pruningTime = time.Date(2019, time.December, 12, 0, 0, 0, 0, time.UTC)
+
+var activeState = int32(admin.LaunchPlanState_ACTIVE)
+var inactiveState = int32(admin.LaunchPlanState_INACTIVE)
+
+// The closures of the workflow versions reference the given task versions.
+func setWorkflowClosures(t *testing.T, storageClient *storage.DataStore, taskVersions ...string) {
+	storageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			assert.Equal(t, "s3://bucket/wf/v2", reference.String())
+			compiledWorkflow := &core.CompiledWorkflowClosure{}
+			for _, version := range taskVersions {
+				compiledWorkflow.Tasks = append(compiledWorkflow.Tasks, &core.CompiledTask{
+					Template: &core.TaskTemplate{
+						Id: &core.Identifier{
+							ResourceType: core.ResourceType_TASK,
+							Project:      "project",
+							Domain:       "domain",
+							Name:         "task",
+							Version:      version,
+						},
+					},
+				})
+			}
+			msg.(*admin.WorkflowClosure).CompiledWorkflow = compiledWorkflow
+			return nil
+		}
+}
+
+func getTestController(repository *repositoryMocks.MockRepository,
+	versionRetentionConfig runtimeInterfaces.VersionRetentionConfig) *controller {
+	applicationConfig := runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetVersionRetentionConfig(versionRetentionConfig)
+	mockClock := clock.NewMock()
+	mockClock.Set(pruningTime)
+	return &controller{
+		db:            repository,
+		config:        &applicationConfig,
+		storageClient: commonMocks.GetMockStorageClient(),
+		metrics:       newMetrics(mockScope.NewTestScope()),
+		_clock:        mockClock,
+	}
+}
+
+func getVersionRetentionConfig() runtimeInterfaces.VersionRetentionConfig {
+	return runtimeInterfaces.VersionRetentionConfig{
+		Projects: map[string]runtimeInterfaces.VersionRetentionPolicy{
+			"project": {KeepVersions: 1},
+		},
+		ProtectionWindow: config.Duration{Duration: 24 * time.Hour},
+	}
+}
+
+func getFilterArg(t *testing.T, filter common.InlineFilter) interface{} {
+	expr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	return expr.Args
+}
+
+// Registers a launch plan "lp" with versions v3 (active), v2 (recently executed) and v1, a workflow "wf" with versions
+// v2 (referenced by a launch plan) and v1, and a task "task" with a recently executed version v1. Only the versions
+// beyond the most recent one of each name are listed, except when listing the workflow versions left in the project.
+func setListCallbacks(t *testing.T, repository *repositoryMocks.MockRepository) {
+	launchPlanRepo := repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo)
+	launchPlanRepo.SetListLaunchPlanIdentifiersCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.LaunchPlanCollectionOutput, error) {
+			assert.Equal(t, "project", getFilterArg(t, input.InlineFilters[0]))
+			return repoInterfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					{LaunchPlanKey: models.LaunchPlanKey{Project: "project", Domain: "domain", Name: "lp"}},
+				},
+			}, nil
+		})
+	launchPlanRepo.SetListCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.LaunchPlanCollectionOutput, error) {
+			if input.SortParameter == nil {
+				// Looks up the launch plans referencing a workflow version.
+				if getFilterArg(t, input.InlineFilters[0]) == uint(20) {
+					return repoInterfaces.LaunchPlanCollectionOutput{
+						LaunchPlans: []models.LaunchPlan{{WorkflowID: 20}},
+					}, nil
+				}
+				return repoInterfaces.LaunchPlanCollectionOutput{}, nil
+			}
+			assert.Equal(t, 1, input.Offset)
+			assert.Equal(t, defaultBatchSize, input.Limit)
+			assert.Equal(t, "lp", getFilterArg(t, input.InlineFilters[2]))
+			launchPlans := make([]models.LaunchPlan, 0)
+			for idx, version := range []string{"v3", "v2", "v1"} {
+				state := inactiveState
+				if version == "v3" {
+					state = activeState
+				}
+				launchPlan := models.LaunchPlan{
+					LaunchPlanKey: models.LaunchPlanKey{
+						Project: "project", Domain: "domain", Name: "lp", Version: version,
+					},
+					State: &state,
+				}
+				launchPlan.ID = uint(3 - idx)
+				launchPlans = append(launchPlans, launchPlan)
+			}
+			return repoInterfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: launchPlans,
+			}, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, 1, input.Limit)
+			assert.Equal(t, pruningTime.Add(-24*time.Hour), getFilterArg(t, input.InlineFilters[1]))
+			if getFilterArg(t, input.InlineFilters[0]) == uint(2) {
+				return repoInterfaces.ExecutionCollectionOutput{
+					Executions: []models.Execution{{LaunchPlanID: 2}},
+				}, nil
+			}
+			return repoInterfaces.ExecutionCollectionOutput{}, nil
+		})
+
+	workflowRepo := repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo)
+	workflowRepo.SetListIdentifiersFunc(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.WorkflowCollectionOutput, error) {
+			return repoInterfaces.WorkflowCollectionOutput{
+				Workflows: []models.Workflow{
+					{WorkflowKey: models.WorkflowKey{Project: "project", Domain: "domain", Name: "wf"}},
+				},
+			}, nil
+		})
+	workflowRepo.SetListCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.WorkflowCollectionOutput, error) {
+			if input.SortParameter == ascWorkflowIDSortParam {
+				assert.Len(t, input.InlineFilters, 1)
+				assert.Equal(t, 0, input.Offset)
+				return repoInterfaces.WorkflowCollectionOutput{
+					Workflows: []models.Workflow{
+						{
+							WorkflowKey: models.WorkflowKey{
+								Project: "project", Domain: "domain", Name: "wf", Version: "v2",
+							},
+							RemoteClosureIdentifier: "s3://bucket/wf/v2",
+						},
+					},
+				}, nil
+			}
+			assert.Equal(t, 1, input.Offset)
+			workflows := make([]models.Workflow, 0)
+			for _, version := range []string{"v2", "v1"} {
+				workflow := models.Workflow{
+					WorkflowKey: models.WorkflowKey{
+						Project: "project", Domain: "domain", Name: "wf", Version: version,
+					},
+				}
+				workflow.ID = 20
+				if version == "v1" {
+					workflow.ID = 10
+				}
+				workflows = append(workflows, workflow)
+			}
+			return repoInterfaces.WorkflowCollectionOutput{
+				Workflows: workflows,
+			}, nil
+		})
+
+	taskRepo := repository.TaskRepo().(*repositoryMocks.MockTaskRepo)
+	taskRepo.SetListTaskIdentifiersCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.TaskCollectionOutput, error) {
+			return repoInterfaces.TaskCollectionOutput{
+				Tasks: []models.Task{
+					{TaskKey: models.TaskKey{Project: "project", Domain: "domain", Name: "task"}},
+				},
+			}, nil
+		})
+	taskRepo.SetListCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.TaskCollectionOutput, error) {
+			return repoInterfaces.TaskCollectionOutput{
+				Tasks: []models.Task{
+					{TaskKey: models.TaskKey{Project: "project", Domain: "domain", Name: "task", Version: "v1"}},
+				},
+			}, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.TaskExecutionCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 5)
+			assert.Equal(t, "v1", getFilterArg(t, input.InlineFilters[3]))
+			return repoInterfaces.TaskExecutionCollectionOutput{
+				TaskExecutions: []models.TaskExecution{{}},
+			}, nil
+		})
+}
+
+func setDeleteCallbacks(repository *repositoryMocks.MockRepository) map[string][]string {
+	deleted := make(map[string][]string)
+	record := func(kind string) func(input repoInterfaces.DeleteResourceInput) error {
+		return func(input repoInterfaces.DeleteResourceInput) error {
+			deleted[kind] = append(deleted[kind], input.Name+"/"+input.Version)
+			return nil
+		}
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetDeleteCallback(record("launchPlans"))
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetDeleteCallback(record("workflows"))
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetDeleteCallback(record("tasks"))
+	return deleted
+}
+
+func TestPrune(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallbacks(t, repository)
+	deleted := setDeleteCallbacks(repository)
+
+	pruningController := getTestController(repository, getVersionRetentionConfig())
+	setWorkflowClosures(t, pruningController.storageClient)
+	err := pruningController.Prune(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"launchPlans": {"lp/v1"},
+		"workflows":   {"wf/v1"},
+	}, deleted)
+}
+
+func TestPrune_DryRun(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallbacks(t, repository)
+	deleted := setDeleteCallbacks(repository)
+
+	versionRetentionConfig := getVersionRetentionConfig()
+	versionRetentionConfig.DryRun = true
+	pruningController := getTestController(repository, versionRetentionConfig)
+	setWorkflowClosures(t, pruningController.storageClient)
+	err := pruningController.Prune(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, deleted)
+}
+
+func TestPrune_ReferencedTasks(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallbacks(t, repository)
+	// The task version wasn't executed recently.
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.TaskExecutionCollectionOutput, error) {
+			return repoInterfaces.TaskExecutionCollectionOutput{}, nil
+		})
+	deleted := setDeleteCallbacks(repository)
+
+	// The task version is kept as long as a workflow version which isn't pruned references it.
+	pruningController := getTestController(repository, getVersionRetentionConfig())
+	setWorkflowClosures(t, pruningController.storageClient, "v1")
+	assert.NoError(t, pruningController.Prune(context.Background()))
+	assert.Empty(t, deleted["tasks"])
+
+	pruningController = getTestController(repository, getVersionRetentionConfig())
+	setWorkflowClosures(t, pruningController.storageClient, "v2")
+	assert.NoError(t, pruningController.Prune(context.Background()))
+	assert.Equal(t, []string{"task/v1"}, deleted["tasks"])
+}
+
+func TestPrune_ReferencedTasksError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallbacks(t, repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.TaskExecutionCollectionOutput, error) {
+			return repoInterfaces.TaskExecutionCollectionOutput{}, nil
+		})
+	deleted := setDeleteCallbacks(repository)
+
+	pruningController := getTestController(repository, getVersionRetentionConfig())
+	pruningController.storageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			return errors.New("not found")
+		}
+	// No task versions are pruned when it can't be told which are referenced.
+	assert.Error(t, pruningController.Prune(context.Background()))
+	assert.Empty(t, deleted["tasks"])
+	assert.Equal(t, []string{"wf/v1"}, deleted["workflows"])
+}
+
+func TestPrune_Disabled(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListLaunchPlanIdentifiersCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.LaunchPlanCollectionOutput, error) {
+			t.Fatal("unexpected list")
+			return repoInterfaces.LaunchPlanCollectionOutput{}, nil
+		})
+
+	err := getTestController(repository, runtimeInterfaces.VersionRetentionConfig{}).Prune(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestPrune_InvalidPolicy(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	deleted := setDeleteCallbacks(repository)
+
+	err := getTestController(repository, runtimeInterfaces.VersionRetentionConfig{
+		Projects: map[string]runtimeInterfaces.VersionRetentionPolicy{
+			"project": {},
+		},
+	}).Prune(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, deleted)
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/storage"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteadmin/pkg/repositories/transformers"
+)
+
+const offloadClosuresBatchSize = 100
+
+// Offloads the closures of node executions stored before a threshold was configured (or while a larger one was) to
+// blob storage, batch by batch, and returns how many were offloaded.
+func OffloadNodeExecutionClosures(ctx context.Context, db *gorm.DB, store *storage.DataStore,
+	storagePrefix []string, thresholdBytes int) (int, error) {
+	var offloaded int
+	if thresholdBytes <= 0 {
+		return offloaded, nil
+	}
+	for {
+		var nodeExecutions []models.NodeExecution
+		if err := db.Where("octet_length(closure) > ? AND "+
+			"(remote_closure_reference IS NULL OR remote_closure_reference = '')", thresholdBytes).Limit(
+			offloadClosuresBatchSize).Find(&nodeExecutions).Error; err != nil {
+			return offloaded, err
+		}
+		if len(nodeExecutions) == 0 {
+			return offloaded, nil
+		}
+		for idx := range nodeExecutions {
+			nodeExecution := &nodeExecutions[idx]
+			reference, err := transformers.GetNodeExecutionClosureReference(
+				ctx, store, storagePrefix, nodeExecution.NodeExecutionKey)
+			if err != nil {
+				return offloaded, err
+			}
+			if _, err := transformers.OffloadNodeExecutionClosure(
+				ctx, store, reference, thresholdBytes, nodeExecution); err != nil {
+				logger.Warningf(ctx, "failed to offload the closure of node execution [%+v]",
+					nodeExecution.NodeExecutionKey)
+				return offloaded, err
+			}
+			if err := db.Model(nodeExecution).Updates(map[string]interface{}{
+				"closure":                  nodeExecution.Closure,
+				"remote_closure_reference": nodeExecution.RemoteClosureReference,
+			}).Error; err != nil {
+				return offloaded, err
+			}
+			offloaded++
+		}
+	}
+}
@@ -48,6 +48,24 @@ type NodeExecution struct {
 	NodeExecutionEvents    []models.NodeExecutionEvent
 	// The task execution (if any) which launched this node execution.
 	ParentTaskExecutionID uint `sql:"default:null" gorm:"index"`
+	// The node execution (if any) whose task launched this node execution.
+	ParentID uint `sql:"default:null" gorm:"index"`
+	// The location of the closure (if any) offloaded to blob storage for exceeding the configured size.
+	RemoteClosureReference string
+	// The location of the offloaded workflow closure (if any) which this node execution's task compiled at runtime.
+	DynamicWorkflowRemoteClosureReference string
+	// Also stored in the cache metadata, but defined as a separate column because it's useful for filtering.
+	CacheStatus string `gorm:"index"`
+	// Serialized catalog cache information (if any) reported for this node execution's task.
+	CacheMetadata []byte
+	// Serialized auxiliary artifacts (if any) registered by this node execution's task.
+	Artifacts []byte
+	// The identifier of the sub-workflow (if any) which this node execution's node belongs to, either one nested in the
+	// execution's workflow or, for executions launched by launch plan nodes, the execution's own workflow.
+	SubWorkflowProject string
+	SubWorkflowDomain  string
+	SubWorkflowName    string `gorm:"index"`
+	SubWorkflowVersion string
 	// The workflow execution (if any) which this node execution launched
 	LaunchedExecution models.Execution `gorm:"foreignkey:ParentNodeExecutionID"`
 }
@@ -77,6 +95,22 @@ type TaskExecution struct {
 	// the execution was UpdatedAt, not to be confused with gorm.Model.UpdatedAt
 	TaskExecutionUpdatedAt *time.Time
 	Duration               time.Duration
+	// Locations of the outputs and error document (if any) written by this retry attempt.
+	OutputURI string
+	ErrorURI  string
+	// Resource usage reported in the custom info of the task execution's events, nil until reported.
+	MaxMemoryBytes *int64
+	CPUSeconds     *float64
+	GPUUtilization *float64
+	// The latest checkpoint reported by this retry attempt and the one it was able to resume from, which is the latest
+	// checkpoint of the attempts before it.
+	CheckpointURI         string
+	PreviousCheckpointURI string
+	// The environment this retry attempt ran in, derived from the compiled task unless reported by its events. Resources
+	// holds the serialized core.Resources.
+	ContainerImage string
+	Resources      []byte
+	Queue          string
 	// The child node executions (if any) launched by this task execution.
 	ChildNodeExecution []NodeExecution `gorm:"foreignkey:ParentTaskExecutionID"`
 }
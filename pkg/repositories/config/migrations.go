@@ -159,4 +159,405 @@ var Migrations = []*gormigrate.Migration{
 			return tx.DropTable("named_entity_metadata").Error
 		},
 	},
+	// Add event versions to executions.
+	{
+		ID: "2019-11-12-execution-event-version",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS event_version").Error
+		},
+	},
+	// Add failure classifications to executions.
+	{
+		ID: "2019-11-13-execution-failure-classification",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS failure_classification").Error
+		},
+	},
+	// Create execution_extensions table.
+	{
+		ID: "2019-11-14-execution-extensions",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ExecutionExtension{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("execution_extensions").Error
+		},
+	},
+	// Add data classifications to executions.
+	{
+		ID: "2019-11-15-execution-data-classification",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS data_classification").Error
+		},
+	},
+	// Track executions whose offloaded data was removed by retention policies.
+	{
+		ID: "2019-11-16-execution-data-removed-at",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS data_removed_at").Error
+		},
+	},
+	// Create execution_tags table.
+	{
+		ID: "2019-11-17-execution-tags",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ExecutionTag{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("execution_tags").Error
+		},
+	},
+	// Add parent node execution ids to node executions.
+	{
+		ID: "2019-11-18-node-execution-parent-id",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&NodeExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE node_executions DROP COLUMN IF EXISTS parent_id").Error
+		},
+	},
+	// Backfill parent node execution ids from the node executions of parent task executions.
+	{
+		ID: "2019-11-18-node-execution-parent-id-backfill",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec(`update node_executions set parent_id = parents.id
+				from task_executions, node_executions as parents
+				where node_executions.parent_task_execution_id = task_executions.id and
+					parents.execution_project = task_executions.execution_project and
+					parents.execution_domain = task_executions.execution_domain and
+					parents.execution_name = task_executions.execution_name and
+					parents.node_id = task_executions.node_id and
+					node_executions.parent_id is null`).Error
+		},
+	},
+	// Add offloaded dynamic workflow closure references to node executions.
+	{
+		ID: "2019-11-19-node-execution-dynamic-workflow",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&NodeExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(
+				"ALTER TABLE node_executions DROP COLUMN IF EXISTS dynamic_workflow_remote_closure_reference").Error
+		},
+	},
+	// Add catalog cache metadata to node executions.
+	{
+		ID: "2019-11-20-node-execution-cache-metadata",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&NodeExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE node_executions DROP COLUMN IF EXISTS cache_status, " +
+				"DROP COLUMN IF EXISTS cache_metadata").Error
+		},
+	},
+	// Version project-domain attributes and track the versions applied by each cluster.
+	{
+		ID: "2019-11-21-project-domain-attributes-versions",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ProjectDomain{}, &models.ProjectDomainAttributesAck{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("ALTER TABLE project_domains DROP COLUMN IF EXISTS version").Error; err != nil {
+				return err
+			}
+			return tx.DropTable("project_domain_attributes_acks").Error
+		},
+	},
+	// Add the output and error document locations of each retry attempt to task executions.
+	{
+		ID: "2019-11-22-task-execution-attempt-data",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&TaskExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE task_executions DROP COLUMN IF EXISTS output_uri, " +
+				"DROP COLUMN IF EXISTS error_uri").Error
+		},
+	},
+	// Track the progress of onboarding newly registered projects.
+	{
+		ID: "2019-11-26-project-onboarding-status",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Project{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE projects DROP COLUMN IF EXISTS onboarding_status").Error
+		},
+	},
+	// Add the resource usage reported by task executions.
+	{
+		ID: "2019-11-27-task-execution-resource-usage",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&TaskExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE task_executions DROP COLUMN IF EXISTS max_memory_bytes, " +
+				"DROP COLUMN IF EXISTS cpu_seconds, DROP COLUMN IF EXISTS gpu_utilization").Error
+		},
+	},
+	// Summarize the compacted events of executions.
+	{
+		ID: "2019-11-28-execution-event-summaries",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&models.Execution{}).Error; err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&models.ExecutionEventSummary{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS events_compacted_at").Error; err != nil {
+				return err
+			}
+			return tx.DropTable("execution_event_summaries").Error
+		},
+	},
+	// Let users manage their own launch plan notification subscriptions.
+	{
+		ID: "2019-11-29-notification-preferences",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationPreference{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("notification_preferences").Error
+		},
+	},
+	// Persist the trace context executions were created with.
+	{
+		ID: "2019-11-30-execution-trace-parent",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS trace_parent").Error
+		},
+	},
+	// Offload large node execution closures to blob storage.
+	{
+		ID: "2019-12-01-node-execution-remote-closure",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&NodeExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE node_executions DROP COLUMN IF EXISTS remote_closure_reference").Error
+		},
+	},
+	// Record the auxiliary artifacts registered by node executions.
+	{
+		ID: "2019-12-02-node-execution-artifacts",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&NodeExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE node_executions DROP COLUMN IF EXISTS artifacts").Error
+		},
+	},
+	// Roll up the phases of the children of parent node executions, counting the children recorded so far.
+	{
+		ID: "2019-12-03-node-execution-child-phase-counts",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&models.NodeExecutionChildPhaseCount{}).Error; err != nil {
+				return err
+			}
+			return tx.Exec("INSERT INTO node_execution_child_phase_counts (parent_id, phase, count) " +
+				"SELECT parent_id, phase, COUNT(*) FROM node_executions WHERE parent_id IS NOT NULL " +
+				"GROUP BY parent_id, phase ON CONFLICT DO NOTHING").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("node_execution_child_phase_counts").Error
+		},
+	},
+	// Record the checkpoints reported by task executions.
+	{
+		ID: "2019-12-04-task-execution-checkpoints",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&TaskExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE task_executions DROP COLUMN IF EXISTS checkpoint_uri, " +
+				"DROP COLUMN IF EXISTS previous_checkpoint_uri").Error
+		},
+	},
+	// Attribute node executions to the sub-workflows they originate from.
+	{
+		ID: "2019-12-05-node-execution-sub-workflows",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&NodeExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE node_executions DROP COLUMN IF EXISTS sub_workflow_project, " +
+				"DROP COLUMN IF EXISTS sub_workflow_domain, DROP COLUMN IF EXISTS sub_workflow_name, " +
+				"DROP COLUMN IF EXISTS sub_workflow_version").Error
+		},
+	},
+	// Record the environment task executions ran in.
+	{
+		ID: "2019-12-06-task-execution-environments",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&TaskExecution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE task_executions DROP COLUMN IF EXISTS container_image, " +
+				"DROP COLUMN IF EXISTS resources, DROP COLUMN IF EXISTS queue").Error
+		},
+	},
+	// Archive tasks and task versions.
+	{
+		ID: "2019-12-07-task-states",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Task{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE tasks DROP COLUMN IF EXISTS state").Error
+		},
+	},
+	// Pause and resume launch plan schedules.
+	{
+		ID: "2019-12-08-launch-plan-schedule-states",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.LaunchPlan{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE launch_plans DROP COLUMN IF EXISTS schedule_state").Error
+		},
+	},
+	// Record the audit history of launch plan state changes.
+	{
+		ID: "2019-12-09-launch-plan-state-changes",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.LaunchPlanStateChange{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("launch_plan_state_changes").Error
+		},
+	},
+	// Update and archive projects.
+	{
+		ID: "2019-12-10-project-labels-and-states",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Project{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE projects DROP COLUMN IF EXISTS labels, DROP COLUMN IF EXISTS state").Error
+		},
+	},
+	// Register domains at runtime.
+	{
+		ID: "2019-12-11-domains",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Domain{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("domains").Error
+		},
+	},
+	// Matchable resource attributes.
+	{
+		ID: "2019-12-12-resources",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Resource{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("resources").Error
+		},
+	},
+	// Archive named entities.
+	{
+		ID: "2019-12-13-named-entity-states",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NamedEntityMetadata{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE named_entity_metadata DROP COLUMN IF EXISTS state").Error
+		},
+	},
+	// Let users choose the terminal phases they're notified of.
+	{
+		ID: "2019-12-14-notification-preference-phases",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationPreference{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE notification_preferences DROP COLUMN IF EXISTS phases").Error
+		},
+	},
+	// Record who terminated executions through admin and when.
+	{
+		ID: "2019-12-15-execution-aborted-by",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS aborted_by, DROP COLUMN IF EXISTS aborted_at").Error
+		},
+	},
+	// Draw project domain attribute versions from a sequence rather than replica clocks. The sequence starts after the
+	// greatest existing version so that clusters which already acked one still see later updates.
+	{
+		ID: "2019-12-16-project-domain-attributes-version-sequence",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE SEQUENCE IF NOT EXISTS project_domain_attributes_versions").Error; err != nil {
+				return err
+			}
+			return tx.Exec("SELECT setval('project_domain_attributes_versions', " +
+				"GREATEST((SELECT COALESCE(MAX(version), 0) FROM project_domains), 1))").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("DROP SEQUENCE IF EXISTS project_domain_attributes_versions").Error
+		},
+	},
+	// Keep notifications held back by rate limits until they're published in a digest.
+	{
+		ID: "2019-12-17-spilled-notifications",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.SpilledNotification{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("spilled_notifications").Error
+		},
+	},
+	// Record which launch plan notifications were held back for by the per-recipient rate limit.
+	{
+		ID: "2019-12-18-spilled-notification-launch-plan",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.SpilledNotification{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("ALTER TABLE spilled_notifications DROP COLUMN IF EXISTS launch_plan").Error
+		},
+	},
+	// Record the progress of backfills launching their executions in the background.
+	{
+		ID: "2019-12-19-backfills",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Backfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("backfills").Error
+		},
+	},
+	// Keep notifications deferred by quiet hours until they're published in a digest.
+	{
+		ID: "2019-12-20-deferred-notifications",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.DeferredNotification{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("deferred_notifications").Error
+		},
+	},
 }
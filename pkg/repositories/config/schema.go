@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// Describes how the migrations applied to a database compare with the ones this binary knows of.
+type SchemaCompatibility int
+
+const (
+	// Exactly the known migrations have been applied.
+	SchemaCurrent SchemaCompatibility = iota
+	// Some known migrations haven't been applied, so this binary may query columns and tables which don't exist.
+	SchemaBehind
+	// Every known migration has been applied along with migrations from a newer release, so this binary may write
+	// rows which don't satisfy the newer schema's expectations.
+	SchemaAhead
+)
+
+type SchemaStatus struct {
+	Compatibility SchemaCompatibility
+	// The known migrations which haven't been applied.
+	Missing []string
+	// The applied migrations this binary doesn't know of.
+	Unknown []string
+}
+
+// Returns the schema version this binary expects, i.e. the ID of its last migration.
+func GetExpectedSchemaVersion() string {
+	return Migrations[len(Migrations)-1].ID
+}
+
+// Compares the IDs of the migrations applied to a database with the migrations this binary knows of.
+func CompareSchema(appliedIDs []string) SchemaStatus {
+	applied := make(map[string]bool, len(appliedIDs))
+	for _, id := range appliedIDs {
+		applied[id] = true
+	}
+	known := make(map[string]bool, len(Migrations))
+	var status SchemaStatus
+	for _, migration := range Migrations {
+		known[migration.ID] = true
+		if !applied[migration.ID] {
+			status.Missing = append(status.Missing, migration.ID)
+		}
+	}
+	for _, id := range appliedIDs {
+		if !known[id] {
+			status.Unknown = append(status.Unknown, id)
+		}
+	}
+	switch {
+	case len(status.Missing) > 0:
+		status.Compatibility = SchemaBehind
+	case len(status.Unknown) > 0:
+		status.Compatibility = SchemaAhead
+	default:
+		status.Compatibility = SchemaCurrent
+	}
+	return status
+}
+
+// Reads the migrations applied to a database and compares them with the ones this binary knows of.
+func GetSchemaStatus(db *gorm.DB) (SchemaStatus, error) {
+	var appliedIDs []string
+	if err := db.Table(gormigrate.DefaultOptions.TableName).Pluck(
+		gormigrate.DefaultOptions.IDColumnName, &appliedIDs).Error; err != nil {
+		return SchemaStatus{}, fmt.Errorf("failed to read applied migrations: %v", err)
+	}
+	return CompareSchema(appliedIDs), nil
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getKnownMigrationIDs() []string {
+	ids := make([]string, len(Migrations))
+	for idx, migration := range Migrations {
+		ids[idx] = migration.ID
+	}
+	return ids
+}
+
+func TestGetExpectedSchemaVersion(t *testing.T) {
+	assert.Equal(t, Migrations[len(Migrations)-1].ID, GetExpectedSchemaVersion())
+}
+
+func TestCompareSchema(t *testing.T) {
+	knownIDs := getKnownMigrationIDs()
+	assert.Equal(t, SchemaStatus{
+		Compatibility: SchemaCurrent,
+	}, CompareSchema(knownIDs))
+
+	assert.Equal(t, SchemaStatus{
+		Compatibility: SchemaBehind,
+		Missing:       []string{GetExpectedSchemaVersion()},
+	}, CompareSchema(knownIDs[:len(knownIDs)-1]))
+
+	assert.Equal(t, SchemaStatus{
+		Compatibility: SchemaAhead,
+		Unknown:       []string{"2099-01-01-from-the-future"},
+	}, CompareSchema(append(getKnownMigrationIDs(), "2099-01-01-from-the-future")))
+
+	// Missing migrations take precedence since this binary can't serve reads from an older schema either.
+	assert.Equal(t, SchemaStatus{
+		Compatibility: SchemaBehind,
+		Missing:       []string{GetExpectedSchemaVersion()},
+		Unknown:       []string{"2099-01-01-from-the-future"},
+	}, CompareSchema(append(knownIDs[:len(knownIDs)-1:len(knownIDs)-1], "2099-01-01-from-the-future")))
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/gormimpl"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Domains are listed whenever a project and domain are validated, so listings are cached. A domain registered through
+// another replica is picked up once the cache expires.
+const domainsCacheTTL = time.Minute
+
+// Serves the listings of another domain repo from a cache, which is invalidated once a domain registered through it
+// is committed.
+type cachedDomainRepo struct {
+	interfaces.DomainRepoInterface
+
+	mutex     sync.Mutex
+	domains   []models.Domain
+	expiresAt time.Time
+	_clock    clock.Clock
+}
+
+func (r *cachedDomainRepo) Create(ctx context.Context, domain models.Domain) error {
+	if err := r.DomainRepoInterface.Create(ctx, domain); err != nil {
+		return err
+	}
+	gormimpl.AfterCommit(ctx, func(ctx context.Context) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.domains = nil
+	})
+	return nil
+}
+
+func (r *cachedDomainRepo) ListAll(ctx context.Context) ([]models.Domain, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.domains != nil && r._clock.Now().Before(r.expiresAt) {
+		return r.domains, nil
+	}
+	domains, err := r.DomainRepoInterface.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if domains == nil {
+		domains = make([]models.Domain, 0)
+	}
+	r.domains = domains
+	r.expiresAt = r._clock.Now().Add(domainsCacheTTL)
+	return domains, nil
+}
+
+// Wraps a domain repo so that its listings are cached, see domainsCacheTTL.
+func NewCachedDomainRepo(repo interfaces.DomainRepoInterface) interfaces.DomainRepoInterface {
+	return &cachedDomainRepo{
+		DomainRepoInterface: repo,
+		_clock:              clock.New(),
+	}
+}
+
+// Returns the domains configured for the server followed by the domains registered at runtime.
+func GetDomains(ctx context.Context, db RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration) (
+	runtimeInterfaces.DomainsConfig, error) {
+	registeredDomains, err := db.DomainRepo().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	configDomains := config.GetDomainsConfig()
+	domains := make(runtimeInterfaces.DomainsConfig, 0, len(*configDomains)+len(registeredDomains))
+	domains = append(domains, *configDomains...)
+	for _, registeredDomain := range registeredDomains {
+		domains = append(domains, runtimeInterfaces.Domain{
+			ID:   registeredDomain.Identifier,
+			Name: registeredDomain.Name,
+		})
+	}
+	return domains, nil
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+// Stands in for the domains table, counting how often it's listed.
+type testDomainRepo struct {
+	domains  []models.Domain
+	listings int
+}
+
+func (r *testDomainRepo) Create(ctx context.Context, domain models.Domain) error {
+	r.domains = append(r.domains, domain)
+	return nil
+}
+
+func (r *testDomainRepo) ListAll(ctx context.Context) ([]models.Domain, error) {
+	r.listings++
+	return r.domains, nil
+}
+
+func TestCachedDomainRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := &testDomainRepo{domains: []models.Domain{{Identifier: "qa", Name: "QA"}}}
+	cachedRepo := NewCachedDomainRepo(repo)
+	mockClock := clock.NewMock()
+	cachedRepo.(*cachedDomainRepo)._clock = mockClock
+
+	for i := 0; i < 3; i++ {
+		domains, err := cachedRepo.ListAll(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, domains, 1)
+	}
+	assert.Equal(t, 1, repo.listings)
+
+	// Registering a domain invalidates the cache.
+	assert.NoError(t, cachedRepo.Create(ctx, models.Domain{Identifier: "uat", Name: "UAT"}))
+	domains, err := cachedRepo.ListAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, domains, 2)
+	assert.Equal(t, 2, repo.listings)
+
+	// Domains registered through other replicas are picked up once the cache expires.
+	repo.domains = append(repo.domains, models.Domain{Identifier: "staging", Name: "Staging"})
+	domains, err = cachedRepo.ListAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, domains, 2)
+	mockClock.Add(domainsCacheTTL + time.Second)
+	domains, err = cachedRepo.ListAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, domains, 3)
+	assert.Equal(t, 3, repo.listings)
+}
@@ -1,11 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/lyft/flyteadmin/pkg/logging"
 	"github.com/lyft/flyteadmin/pkg/repositories/config"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flytestdlib/logger"
 	"github.com/lyft/flytestdlib/promutils"
 )
 
@@ -32,6 +35,18 @@ type RepositoryInterface interface {
 	NodeExecutionRepo() interfaces.NodeExecutionRepoInterface
 	TaskExecutionRepo() interfaces.TaskExecutionRepoInterface
 	NamedEntityRepo() interfaces.NamedEntityRepoInterface
+	NotificationPreferenceRepo() interfaces.NotificationPreferenceRepoInterface
+	DomainRepo() interfaces.DomainRepoInterface
+	ResourceRepo() interfaces.ResourceRepoInterface
+	SpilledNotificationRepo() interfaces.SpilledNotificationRepoInterface
+	BackfillRepo() interfaces.BackfillRepoInterface
+	DeferredNotificationRepo() interfaces.DeferredNotificationRepoInterface
+	// Runs fn within a single database transaction, committed only if fn succeeds. Repo operations must be passed the
+	// context handed to fn to join the transaction.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// Defers fn until the transaction ctx was handed to commits, so that side effects such as notifications aren't
+	// triggered by writes which are rolled back. Outside of transactions fn runs right away.
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
 }
 
 func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutils.Scope) RepositoryInterface {
@@ -39,6 +54,10 @@ func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutil
 	case POSTGRES:
 		postgresScope := scope.NewSubScope("postgres")
 		db := config.OpenDbConnection(config.NewPostgresConfigProvider(dbConfig, postgresScope))
+		// Enabling debug logging for the repositories module at runtime turns on SQL statement logging.
+		logging.RegisterModuleLevelListener(logging.Repositories, func(level logger.Level) {
+			db.LogMode(dbConfig.IsDebug || level >= logger.DebugLevel)
+		})
 		return NewPostgresRepo(
 			db,
 			errors.NewPostgresErrorTransformer(postgresScope.NewSubScope("errors")),
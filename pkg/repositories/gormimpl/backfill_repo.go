@@ -0,0 +1,66 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+)
+
+type BackfillRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *BackfillRepo) Create(ctx context.Context, input models.Backfill) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := getDB(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *BackfillRepo) Get(ctx context.Context, name string) (models.Backfill, error) {
+	var backfill models.Backfill
+	timer := r.metrics.GetDuration.Start()
+	tx := getDB(ctx, r.db).Where(&models.Backfill{
+		Name: name,
+	}).First(&backfill)
+	timer.Stop()
+	if tx.Error != nil && !tx.RecordNotFound() {
+		return models.Backfill{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RecordNotFound() {
+		return models.Backfill{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "backfill [%s] not found", name)
+	}
+	return backfill, nil
+}
+
+func (r *BackfillRepo) Update(ctx context.Context, input models.Backfill) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// Backfills are looked up by name, they're created without reading back their ID.
+	tx := getDB(ctx, r.db).Model(&input).Where("name = ?", input.Name).Updates(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func NewBackfillRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.BackfillRepoInterface {
+	metrics := newMetrics(scope)
+	return &BackfillRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,75 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT  INTO "backfills"`)
+
+	err := backfillRepo.Create(context.Background(), models.Backfill{
+		Name:              "backfill",
+		LaunchPlanProject: "project",
+		LaunchPlanDomain:  "domain",
+		LaunchPlanName:    "name",
+		LaunchPlanVersion: "version",
+		Total:             2,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "backfills"  WHERE "backfills"."deleted_at" IS NULL AND ` +
+		`(("backfills"."name" = backfill))`).WithReply([]map[string]interface{}{
+		{"name": "backfill", "launch_plan_name": "name", "total": 2, "succeeded": 1},
+	})
+
+	backfill, err := backfillRepo.Get(context.Background(), "backfill")
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Equal(t, "name", backfill.LaunchPlanName)
+	assert.Equal(t, 2, backfill.Total)
+	assert.Equal(t, 1, backfill.Succeeded)
+}
+
+func TestGetBackfill_NotFound(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := backfillRepo.Get(context.Background(), "backfill")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestUpdateBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`(name = ?)`)
+
+	err := backfillRepo.Update(context.Background(), models.Backfill{
+		Name:      "backfill",
+		Succeeded: 1,
+		Done:      true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
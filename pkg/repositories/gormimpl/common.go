@@ -1,6 +1,7 @@
 package gormimpl
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jinzhu/gorm"
@@ -19,6 +20,7 @@ const Version = "version"
 const Closure = "closure"
 const Description = "description"
 const ResourceType = "resource_type"
+const State = "state"
 
 const ProjectID = "project_id"
 const ProjectName = "project_name"
@@ -26,12 +28,17 @@ const DomainID = "domain_id"
 const DomainName = "domain_name"
 
 const executionTableName = "executions"
+const executionExtensionTableName = "execution_extensions"
+const executionTagTableName = "execution_tags"
 const namedEntityMetadataTableName = "named_entity_metadata"
 const nodeExecutionTableName = "node_executions"
-const nodeExecutionEventTableName = "node_event_executions"
+const nodeExecutionEventTableName = "node_execution_events"
 const taskExecutionTableName = "task_executions"
 const taskTableName = "tasks"
 
+// Matches a single version of a task, workflow or launch plan.
+const versionKeyQuery = "project = ? AND domain = ? AND name = ? AND version = ?"
+
 const limit = "limit"
 const filters = "filters"
 
@@ -39,17 +46,37 @@ var identifierGroupBy = fmt.Sprintf("%s, %s, %s", Project, Domain, Name)
 
 var entityToModel = map[common.Entity]interface{}{
 	common.Execution:          models.Execution{},
+	common.ExecutionEvent:     models.ExecutionEvent{},
+	common.ExecutionExtension: models.ExecutionExtension{},
+	common.ExecutionTag:       models.ExecutionTag{},
 	common.LaunchPlan:         models.LaunchPlan{},
 	common.NodeExecution:      models.NodeExecution{},
 	common.NodeExecutionEvent: models.NodeExecutionEvent{},
+	common.Project:            models.Project{},
 	common.Task:               models.Task{},
 	common.TaskExecution:      models.TaskExecution{},
 	common.Workflow:           models.Workflow{},
 }
 
+var innerJoinExecToExecExtensions = fmt.Sprintf(
+	"INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
+		"%s.execution_domain = %s.execution_domain AND %s.execution_name = %s.execution_name",
+	executionExtensionTableName, executionExtensionTableName, executionTableName, executionExtensionTableName,
+	executionTableName, executionExtensionTableName, executionTableName)
+
+var innerJoinExecToExecTags = fmt.Sprintf(
+	"INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
+		"%s.execution_domain = %s.execution_domain AND %s.execution_name = %s.execution_name",
+	executionTagTableName, executionTagTableName, executionTableName, executionTagTableName,
+	executionTableName, executionTagTableName, executionTableName)
+
 var innerJoinNodeExecToNodeEvents = fmt.Sprintf(
-	"INNER JOIN %s ON %s.node_execution_id = %s.id",
-	nodeExecutionTableName, nodeExecutionEventTableName, nodeExecutionTableName)
+	"INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
+		"%s.execution_domain = %s.execution_domain AND %s.execution_name = %s.execution_name AND "+
+		"%s.node_id = %s.node_id",
+	nodeExecutionTableName, nodeExecutionEventTableName, nodeExecutionTableName, nodeExecutionEventTableName,
+	nodeExecutionTableName, nodeExecutionEventTableName, nodeExecutionTableName, nodeExecutionEventTableName,
+	nodeExecutionTableName)
 
 var innerJoinExecToNodeExec = fmt.Sprintf(
 	"INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
@@ -64,6 +91,13 @@ var innerJoinNodeExecToTaskExec = fmt.Sprintf(
 	nodeExecutionTableName, taskExecutionTableName, nodeExecutionTableName, taskExecutionTableName,
 	nodeExecutionTableName)
 
+var innerJoinTaskExecToNodeExec = fmt.Sprintf(
+	"INNER JOIN %s ON %s.node_id = %s.node_id AND %s.execution_project = %s.execution_project AND "+
+		"%s.execution_domain = %s.execution_domain AND %s.execution_name = %s.execution_name",
+	taskExecutionTableName, taskExecutionTableName, nodeExecutionTableName, taskExecutionTableName,
+	nodeExecutionTableName, taskExecutionTableName, nodeExecutionTableName, taskExecutionTableName,
+	nodeExecutionTableName)
+
 // Because dynamic tasks do NOT necessarily register static task definitions, we use a left join to not exclude
 // dynamic tasks from list queries.
 var leftJoinTaskToTaskExec = fmt.Sprintf(
@@ -116,3 +150,32 @@ func applyScopedFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFil
 	}
 	return tx, nil
 }
+
+// Soft-deletes, or purges, the version of a task, workflow or launch plan model matching the input.
+func deleteVersion(ctx context.Context, db *gorm.DB, model interface{}, input interfaces.DeleteResourceInput) *gorm.DB {
+	tx := getDB(ctx, db)
+	if input.Purge {
+		tx = tx.Unscoped()
+	}
+	return tx.Where(versionKeyQuery, input.Project, input.Domain, input.Name, input.Version).Delete(model)
+}
+
+// Aggregates the (workflow or node) executions stored in a table which were created within the input window by the
+// project and domain they ran in, ordered by project and domain.
+func listProjectUsage(ctx context.Context, db *gorm.DB, tableName, createdAtColumn string,
+	input interfaces.ListProjectUsageInput, usage *[]models.ProjectUsage) *gorm.DB {
+	tx := getDB(ctx, db).Table(tableName).Where(fmt.Sprintf("%s.deleted_at IS NULL", tableName))
+	tx = tx.Where(fmt.Sprintf("%s.%s >= ? AND %s.%s < ?", tableName, createdAtColumn, tableName, createdAtColumn),
+		input.Since, input.Until)
+	if len(input.Project) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.execution_project = ?", tableName), input.Project)
+	}
+	if len(input.Domain) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.execution_domain = ?", tableName), input.Domain)
+	}
+	tx = tx.Select(fmt.Sprintf("%s.execution_project AS project, %s.execution_domain AS domain, "+
+		"COUNT(*) AS execution_count, COALESCE(SUM(%s.duration), 0) AS duration", tableName, tableName, tableName))
+	tx = tx.Group(fmt.Sprintf("%s.execution_project, %s.execution_domain", tableName, tableName))
+	tx = tx.Order(fmt.Sprintf("%s.execution_project, %s.execution_domain", tableName, tableName))
+	return tx.Scan(usage)
+}
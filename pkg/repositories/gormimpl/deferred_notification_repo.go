@@ -0,0 +1,62 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flytestdlib/promutils"
+)
+
+type DeferredNotificationRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *DeferredNotificationRepo) Create(ctx context.Context, input models.DeferredNotification) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := getDB(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *DeferredNotificationRepo) ListForUpdate(ctx context.Context) ([]models.DeferredNotification, error) {
+	var notifications []models.DeferredNotification
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").Order("id asc").Find(&notifications)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return notifications, nil
+}
+
+func (r *DeferredNotificationRepo) Delete(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	timer := r.metrics.DeleteDuration.Start()
+	// Published notifications are removed outright rather than soft-deleted, nothing reads them anymore.
+	tx := getDB(ctx, r.db).Unscoped().Where("id IN (?)", ids).Delete(&models.DeferredNotification{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func NewDeferredNotificationRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.DeferredNotificationRepoInterface {
+	metrics := newMetrics(scope)
+	return &DeferredNotificationRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
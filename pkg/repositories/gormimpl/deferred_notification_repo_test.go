@@ -0,0 +1,64 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDeferredNotification(t *testing.T) {
+	deferredNotificationRepo := NewDeferredNotificationRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT  INTO "deferred_notifications" ` +
+		`("created_at","updated_at","deleted_at","project","domain","due_at","message") VALUES (?,?,?,?,?,?,?)`)
+
+	err := deferredNotificationRepo.Create(context.Background(), models.DeferredNotification{
+		Project: "project",
+		Domain:  "domain",
+		DueAt:   time.Date(2019, 6, 2, 7, 0, 0, 0, time.UTC),
+		Message: []byte("message"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListDeferredNotificationsForUpdate(t *testing.T) {
+	deferredNotificationRepo := NewDeferredNotificationRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "deferred_notifications"  WHERE "deferred_notifications"."deleted_at" IS NULL ` +
+		`ORDER BY id asc FOR UPDATE SKIP LOCKED`).WithReply([]map[string]interface{}{
+		{"id": 1, "project": "project", "domain": "domain", "message": []byte("first")},
+		{"id": 2, "project": "project", "domain": "domain", "message": []byte("second")},
+	})
+
+	notifications, err := deferredNotificationRepo.ListForUpdate(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, notifications, 2)
+	assert.Equal(t, []byte("first"), notifications[0].Message)
+	assert.Equal(t, []byte("second"), notifications[1].Message)
+}
+
+func TestDeleteDeferredNotifications(t *testing.T) {
+	deferredNotificationRepo := NewDeferredNotificationRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "deferred_notifications"  WHERE (id IN (?,?))`)
+
+	assert.NoError(t, deferredNotificationRepo.Delete(context.Background(), []uint{1, 2}))
+	assert.True(t, query.Triggered)
+}
@@ -0,0 +1,49 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flytestdlib/promutils"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type DomainRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *DomainRepo) Create(ctx context.Context, domain models.Domain) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := getDB(ctx, r.db).Create(&domain)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *DomainRepo) ListAll(ctx context.Context) ([]models.Domain, error) {
+	var domains []models.Domain
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Order("identifier asc").Find(&domains)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return domains, nil
+}
+
+func NewDomainRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.DomainRepoInterface {
+	metrics := newMetrics(scope)
+	return &DomainRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
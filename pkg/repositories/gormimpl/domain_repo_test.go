@@ -0,0 +1,43 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDomain(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT  INTO "domains" ("created_at","updated_at","deleted_at","identifier","name")`)
+
+	err := domainRepo.Create(context.Background(), models.Domain{
+		Identifier: "qa",
+		Name:       "QA",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListDomains(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "domains"  WHERE "domains"."deleted_at" IS NULL ORDER BY identifier asc`).
+		WithReply([]map[string]interface{}{
+			{"identifier": "qa", "name": "QA"},
+		})
+
+	domains, err := domainRepo.ListAll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, domains, 1)
+	assert.Equal(t, "qa", domains[0].Identifier)
+	assert.Equal(t, "QA", domains[0].Name)
+}
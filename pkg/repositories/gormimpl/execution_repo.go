@@ -7,12 +7,18 @@ import (
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 
 	"github.com/jinzhu/gorm"
+	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 )
 
+const executionKeyQuery = "execution_project = ? AND execution_domain = ? AND execution_name = ?"
+
 // Implementation of ExecutionInterface.
 type ExecutionRepo struct {
 	db               *gorm.DB
@@ -22,10 +28,42 @@ type ExecutionRepo struct {
 
 func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
-	timer.Stop()
-	if tx.Error != nil {
-		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	defer timer.Stop()
+	if len(input.Extensions) == 0 && len(input.Tags) == 0 {
+		if err := getDB(ctx, r.db).Create(&input).Error; err != nil {
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+		return nil
+	}
+	// Use a transaction to guarantee no partial creates.
+	tx := beginTransaction(ctx, r.db)
+	if err := tx.Create(&input).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	for _, extension := range input.Extensions {
+		extension.ExecutionKey = input.ExecutionKey
+		if err := tx.Create(&extension).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := createTags(tx.DB, input); err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func createTags(tx *gorm.DB, execution models.Execution) error {
+	for _, tag := range execution.Tags {
+		tag.ExecutionKey = execution.ExecutionKey
+		if err := tx.Create(&tag).Error; err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -33,7 +71,7 @@ func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) erro
 func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
 	var execution models.Execution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Execution{
+	tx := getDB(ctx, r.db).Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -57,7 +95,7 @@ func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.GetResourceInp
 func (r *ExecutionRepo) GetByID(ctx context.Context, id uint) (models.Execution, error) {
 	var execution models.Execution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Execution{
+	tx := getDB(ctx, r.db).Where(&models.Execution{
 		BaseModel: models.BaseModel{
 			ID: id,
 		},
@@ -76,15 +114,23 @@ func (r *ExecutionRepo) Update(ctx context.Context, event models.ExecutionEvent,
 	timer := r.metrics.UpdateDuration.Start()
 	defer timer.Stop()
 	// Use a transaction to guarantee no partial updates.
-	tx := r.db.Begin()
+	tx := beginTransaction(ctx, r.db)
 	if err := tx.Create(&event).Error; err != nil {
 		tx.Rollback()
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
-	if err := r.db.Model(&execution).Updates(execution).Error; err != nil {
+	// Only applies on top of the version the event was validated against, see ExecutionRepoInterface.Update.
+	updateTx := tx.Model(&execution).Where("event_version = ?", execution.EventVersion-1).Updates(execution)
+	if err := updateTx.Error; err != nil {
 		tx.Rollback()
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
+	if updateTx.RowsAffected == 0 {
+		tx.Rollback()
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.Aborted,
+			"execution [%s/%s/%s] was updated by another event, expected event version [%d]",
+			execution.Project, execution.Domain, execution.Name, execution.EventVersion-1)
+	}
 	if err := tx.Commit().Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
@@ -93,7 +139,7 @@ func (r *ExecutionRepo) Update(ctx context.Context, event models.ExecutionEvent,
 
 func (r *ExecutionRepo) UpdateExecution(ctx context.Context, execution models.Execution) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Model(&execution).Updates(execution)
+	tx := getDB(ctx, r.db).Model(&execution).Updates(execution)
 	timer.Stop()
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
@@ -101,6 +147,31 @@ func (r *ExecutionRepo) UpdateExecution(ctx context.Context, execution models.Ex
 	return nil
 }
 
+func (r *ExecutionRepo) UpdateTags(ctx context.Context, execution models.Execution) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// Use a transaction to guarantee no partial updates. Replaced tags are removed outright (rather than soft-deleted)
+	// so that they can be re-added later on.
+	tx := beginTransaction(ctx, r.db)
+	if err := tx.Unscoped().Where(executionKeyQuery, execution.Project, execution.Domain, execution.Name).Delete(
+		&models.ExecutionTag{}).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := createTags(tx.DB, execution); err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Model(&execution).Updates(models.Execution{Spec: execution.Spec}).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.ExecutionCollectionOutput, error) {
 	// First validate input.
@@ -108,13 +179,30 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 		return interfaces.ExecutionCollectionOutput{}, err
 	}
 	var executions []models.Execution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 	// And add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
 	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
 		launchPlanTableName, executionTableName, launchPlanTableName))
 	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.workflow_id = %s.id",
 		workflowTableName, executionTableName, workflowTableName))
+	// Extensions and tags are only joined when filtered on since an execution may have many of them.
+	var joinExtensions, joinTags bool
+	for _, filter := range input.InlineFilters {
+		switch filter.GetEntity() {
+		case common.ExecutionExtension:
+			joinExtensions = true
+		case common.ExecutionTag:
+			joinTags = true
+		}
+	}
+	if joinExtensions {
+		tx = tx.Joins(innerJoinExecToExecExtensions)
+	}
+	if joinTags {
+		// An execution matches once for every tag it has among those filtered on (e.g. with value_in).
+		tx = tx.Select(fmt.Sprintf("DISTINCT %s.*", executionTableName)).Joins(innerJoinExecToExecTags)
+	}
 
 	// Apply filters
 	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
@@ -138,6 +226,167 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 	}, nil
 }
 
+func (r *ExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int, error) {
+	tx := getDB(ctx, r.db).Model(&models.Execution{})
+	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
+		launchPlanTableName, executionTableName, launchPlanTableName))
+	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.workflow_id = %s.id",
+		workflowTableName, executionTableName, workflowTableName))
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Count(&count)
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return count, nil
+}
+
+func (r *ExecutionRepo) LockProjectDomain(ctx context.Context, project, domain string) error {
+	timer := r.metrics.GetDuration.Start()
+	defer timer.Stop()
+	// Transaction level advisory locks are released by postgres itself once the transaction ends.
+	err := getDB(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", project+"/"+domain).Error
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) Delete(ctx context.Context, key models.ExecutionKey) error {
+	timer := r.metrics.DeleteDuration.Start()
+	defer timer.Stop()
+	// Use a transaction to guarantee no partial deletes. Dependent records are removed first so that nothing ever
+	// references a deleted execution. Records are removed outright rather than soft-deleted, as they're deleted to
+	// reclaim space.
+	tx := beginTransaction(ctx, r.db)
+	if err := tx.Where("parent_id IN (SELECT id FROM node_executions WHERE "+executionKeyQuery+")",
+		key.Project, key.Domain, key.Name).Delete(&models.NodeExecutionChildPhaseCount{}).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	for _, model := range []interface{}{
+		&models.TaskExecution{},
+		&models.NodeExecutionEvent{},
+		&models.NodeExecution{},
+		&models.ExecutionExtension{},
+		&models.ExecutionTag{},
+		&models.ExecutionEvent{},
+		&models.ExecutionEventSummary{},
+		&models.Execution{},
+	} {
+		err := tx.Unscoped().Where(executionKeyQuery, key.Project, key.Domain, key.Name).Delete(model).Error
+		if err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) ListEvents(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionEventCollectionOutput, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, err
+	}
+	var executionEvents []models.ExecutionEvent
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	// Apply filters
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, err
+	}
+	// Apply sort ordering.
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&executionEvents)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.ExecutionEventCollectionOutput{
+		ExecutionEvents: executionEvents,
+	}, nil
+}
+
+func (r *ExecutionRepo) CompactEvents(ctx context.Context, summary models.ExecutionEventSummary) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// Use a transaction so that events are never removed without being summarized. The compacted events are removed
+	// for good rather than soft-deleted to reclaim their space.
+	tx := beginTransaction(ctx, r.db)
+	if err := tx.Create(&summary).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	key := summary.ExecutionKey
+	for _, model := range []interface{}{
+		&models.NodeExecutionEvent{},
+		&models.ExecutionEvent{},
+	} {
+		if err := tx.Unscoped().Where(executionKeyQuery+" AND created_at < ?", key.Project, key.Domain, key.Name,
+			summary.CompactedAt).Delete(model).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Model(&models.Execution{}).Where(executionKeyQuery, key.Project, key.Domain, key.Name).Update(
+		"events_compacted_at", summary.CompactedAt).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) GetEventSummary(
+	ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+	var summary models.ExecutionEventSummary
+	timer := r.metrics.GetDuration.Start()
+	tx := getDB(ctx, r.db).Where(&models.ExecutionEventSummary{
+		ExecutionKey: key,
+	}).First(&summary)
+	timer.Stop()
+	if tx.Error != nil {
+		return models.ExecutionEventSummary{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RecordNotFound() {
+		return models.ExecutionEventSummary{}, errors.GetMissingEntityError("execution event summary",
+			&core.Identifier{
+				Project: key.Project,
+				Domain:  key.Domain,
+				Name:    key.Name,
+			})
+	}
+	return summary, nil
+}
+
+func (r *ExecutionRepo) ListProjectUsage(
+	ctx context.Context, input interfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+	var usage []models.ProjectUsage
+	timer := r.metrics.ListDuration.Start()
+	tx := listProjectUsage(ctx, r.db, executionTableName, "execution_created_at", input, &usage)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return usage, nil
+}
+
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionRepoInterface {
@@ -2,6 +2,7 @@ package gormimpl
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,10 +14,12 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/lyft/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var createdAt = time.Date(2018, time.February, 17, 00, 00, 00, 00, time.UTC).UTC()
@@ -41,6 +44,65 @@ func TestCreateExecution(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateExecution_Extensions(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	extensionQuery := GlobalMock.NewMock()
+	extensionQuery.WithQuery(`INSERT  INTO "execution_extensions" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","key","value") VALUES (?,?,?,?,?,?,?,?)`)
+	err := executionRepo.Create(context.Background(), models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		LaunchPlanID:       uint(2),
+		Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+		Closure:            []byte{1, 2},
+		Spec:               []byte{3, 4},
+		StartedAt:          &executionStartedAt,
+		ExecutionCreatedAt: &createdAt,
+		Extensions: []models.ExecutionExtension{
+			{
+				Key:   "ticket",
+				Value: "FLYTE-123",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, extensionQuery.Triggered)
+}
+
+func TestCreateExecution_Tags(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	tagQuery := GlobalMock.NewMock()
+	tagQuery.WithQuery(`INSERT  INTO "execution_tags" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","name") VALUES (?,?,?,?,?,?,?)`)
+	err := executionRepo.Create(context.Background(), models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		LaunchPlanID:       uint(2),
+		Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+		Closure:            []byte{1, 2},
+		Spec:               []byte{3, 4},
+		StartedAt:          &executionStartedAt,
+		ExecutionCreatedAt: &createdAt,
+		Tags: []models.ExecutionTag{
+			{
+				Name: "nightly",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, tagQuery.Triggered)
+}
+
 func TestUpdate(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 	GlobalMock := mocket.Catcher.Reset()
@@ -115,6 +177,65 @@ func TestUpdateExecution(t *testing.T) {
 	assert.True(t, executionQuery.Triggered)
 }
 
+func TestUpdate(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	eventQuery := GlobalMock.NewMock()
+	eventQuery.WithQuery(`INSERT  INTO "execution_events"`)
+	executionQuery := GlobalMock.NewMock()
+	executionQuery.WithQuery(`UPDATE "executions" SET "event_version" = ?, "phase" = ?, "updated_at" = ?  ` +
+		`WHERE "executions"."deleted_at" IS NULL AND ((event_version = ?))`).WithRowsNum(1)
+	err := executionRepo.Update(context.Background(), models.ExecutionEvent{Phase: "RUNNING"}, models.Execution{
+		Phase:        "RUNNING",
+		EventVersion: 3,
+	})
+	assert.NoError(t, err)
+	assert.True(t, eventQuery.Triggered)
+	assert.True(t, executionQuery.Triggered)
+}
+
+func TestUpdate_ConcurrentEvent(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "executions" SET "event_version" = ?`).WithRowsNum(0)
+	err := executionRepo.Update(context.Background(), models.ExecutionEvent{Phase: "RUNNING"}, models.Execution{
+		Phase:        "RUNNING",
+		EventVersion: 3,
+	})
+	assert.Equal(t, codes.Aborted, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestUpdateExecutionTags(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM "execution_tags"  WHERE ` +
+		`(execution_project = ? AND execution_domain = ? AND execution_name = ?)`)
+	tagQuery := GlobalMock.NewMock()
+	tagQuery.WithQuery(`INSERT  INTO "execution_tags" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","name") VALUES (?,?,?,?,?,?,?)`)
+	specQuery := GlobalMock.NewMock()
+	specQuery.WithQuery(`UPDATE "executions" SET "spec" = ?, "updated_at" = ?  WHERE "executions"."deleted_at" IS NULL`)
+	err := executionRepo.UpdateTags(context.Background(), models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		Spec: []byte{3, 4},
+		Tags: []models.ExecutionTag{
+			{
+				Name: "nightly",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleteQuery.Triggered)
+	assert.True(t, tagQuery.Triggered)
+	assert.True(t, specQuery.Triggered)
+}
+
 func getMockExecutionResponseFromDb(expected models.Execution) map[string]interface{} {
 	execution := make(map[string]interface{})
 	execution["id"] = expected.ID
@@ -310,6 +431,109 @@ func TestListExecutions_Filters(t *testing.T) {
 	assert.Equal(t, time.Hour, result.Duration)
 }
 
+func TestCountExecutions(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT count(*)`).WithReply([]map[string]interface{}{{"count": 3}})
+
+	count, err := executionRepo.Count(context.Background(), interfaces.CountResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+			getEqualityFilter(common.Execution, "domain", domain),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestLockProjectDomain(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	lockQuery := GlobalMock.NewMock().WithQuery(`pg_advisory_xact_lock`)
+
+	err := executionRepo.LockProjectDomain(context.Background(), project, domain)
+	assert.NoError(t, err)
+	assert.True(t, lockQuery.Triggered)
+}
+
+func TestListExecutions_ExtensionFilters(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	executions := make([]map[string]interface{}, 0)
+	execution := getMockExecutionResponseFromDb(models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		LaunchPlanID: uint(2),
+		WorkflowID:   uint(3),
+		Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+	})
+	executions = append(executions, execution)
+
+	GlobalMock := mocket.Catcher.Reset()
+	query := `SELECT "executions".* FROM "executions" INNER JOIN launch_plans ON executions.launch_plan_id = ` +
+		`launch_plans.id INNER JOIN workflows ON executions.workflow_id = workflows.id INNER JOIN ` +
+		`execution_extensions ON execution_extensions.execution_project = executions.execution_project AND ` +
+		`execution_extensions.execution_domain = executions.execution_domain AND ` +
+		`execution_extensions.execution_name = executions.execution_name WHERE "executions"."deleted_at" IS NULL ` +
+		`AND ((executions.execution_project = project) AND (execution_extensions.key = ticket) AND ` +
+		`(execution_extensions.value = FLYTE-123)) LIMIT 20 OFFSET 0`
+	GlobalMock.NewMock().WithQuery(query).WithReply(executions)
+
+	collection, err := executionRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+			getEqualityFilter(common.ExecutionExtension, "key", "ticket"),
+			getEqualityFilter(common.ExecutionExtension, "value", "FLYTE-123"),
+		},
+		Limit: 20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, collection.Executions, 1)
+	assert.Equal(t, "1", collection.Executions[0].Name)
+}
+
+func TestListExecutions_TagFilters(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	executions := make([]map[string]interface{}, 0)
+	execution := getMockExecutionResponseFromDb(models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		LaunchPlanID: uint(2),
+		WorkflowID:   uint(3),
+		Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+	})
+	executions = append(executions, execution)
+
+	GlobalMock := mocket.Catcher.Reset()
+	query := `SELECT DISTINCT executions.* FROM "executions" INNER JOIN launch_plans ON executions.launch_plan_id = ` +
+		`launch_plans.id INNER JOIN workflows ON executions.workflow_id = workflows.id INNER JOIN ` +
+		`execution_tags ON execution_tags.execution_project = executions.execution_project AND ` +
+		`execution_tags.execution_domain = executions.execution_domain AND ` +
+		`execution_tags.execution_name = executions.execution_name WHERE "executions"."deleted_at" IS NULL ` +
+		`AND ((executions.execution_project = project) AND (execution_tags.name = nightly)) LIMIT 20 OFFSET 0`
+	GlobalMock.NewMock().WithQuery(query).WithReply(executions)
+
+	collection, err := executionRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+			getEqualityFilter(common.ExecutionTag, "name", "nightly"),
+		},
+		Limit: 20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, collection.Executions, 1)
+	assert.Equal(t, "1", collection.Executions[0].Name)
+}
+
 func TestListExecutions_Order(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -407,3 +631,126 @@ func TestListExecutionsForWorkflow(t *testing.T) {
 		assert.Equal(t, time.Hour, execution.Duration)
 	}
 }
+
+func TestDeleteExecution(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	var deleteQueries []*mocket.FakeResponse
+	for _, tableName := range []string{
+		"task_executions", "node_execution_events", "node_executions", "execution_extensions", "execution_tags",
+		"execution_events", "execution_event_summaries", "executions",
+	} {
+		deleteQuery := GlobalMock.NewMock()
+		deleteQuery.WithQuery(fmt.Sprintf(`DELETE FROM "%s"  WHERE `+
+			`(execution_project = ? AND execution_domain = ? AND execution_name = ?)`, tableName))
+		deleteQueries = append(deleteQueries, deleteQuery)
+	}
+	deleteChildPhaseCountsQuery := GlobalMock.NewMock()
+	deleteChildPhaseCountsQuery.WithQuery(`DELETE FROM "node_execution_child_phase_counts"  WHERE ` +
+		`(parent_id IN (SELECT id FROM node_executions WHERE ` +
+		`execution_project = ? AND execution_domain = ? AND execution_name = ?))`)
+	deleteQueries = append(deleteQueries, deleteChildPhaseCountsQuery)
+	err := executionRepo.Delete(context.Background(), models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	})
+	assert.NoError(t, err)
+	for _, deleteQuery := range deleteQueries {
+		assert.True(t, deleteQuery.Triggered, deleteQuery.Pattern)
+	}
+}
+
+func TestListExecutionEvents(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	occurredAt := time.Date(2019, time.November, 28, 0, 0, 0, 0, time.UTC)
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_events"  WHERE "execution_events"."deleted_at" IS NULL ` +
+		`AND ((execution_events.execution_project = project) AND (execution_events.execution_name = 1)) LIMIT 10 ` +
+		`OFFSET 0`).WithReply([]map[string]interface{}{
+		{
+			"execution_project": "project",
+			"execution_domain":  "domain",
+			"execution_name":    "1",
+			"phase":             "RUNNING",
+			"occurred_at":       occurredAt,
+		},
+	})
+
+	output, err := executionRepo.ListEvents(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.ExecutionEvent, "project", "project"),
+			getEqualityFilter(common.ExecutionEvent, "name", "1"),
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.ExecutionEvents, 1)
+	assert.Equal(t, "RUNNING", output.ExecutionEvents[0].Phase)
+	assert.Equal(t, occurredAt, output.ExecutionEvents[0].OccurredAt)
+}
+
+func TestCompactEvents(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	summaryQuery := GlobalMock.NewMock()
+	summaryQuery.WithQuery(`INSERT  INTO "execution_event_summaries" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","compacted_at","event_count","summary") ` +
+		`VALUES (?,?,?,?,?,?,?,?,?)`)
+	var deleteQueries []*mocket.FakeResponse
+	for _, tableName := range []string{"node_execution_events", "execution_events"} {
+		deleteQuery := GlobalMock.NewMock()
+		deleteQuery.WithQuery(fmt.Sprintf(`DELETE FROM "%s"  WHERE ((execution_project = ? AND execution_domain = ? `+
+			`AND execution_name = ? AND created_at < ?))`, tableName))
+		deleteQueries = append(deleteQueries, deleteQuery)
+	}
+	executionQuery := GlobalMock.NewMock()
+	executionQuery.WithQuery(`UPDATE "executions" SET "events_compacted_at" = ?, "updated_at" = ?  WHERE ` +
+		`"executions"."deleted_at" IS NULL AND ((execution_project = ? AND execution_domain = ? AND ` +
+		`execution_name = ?))`)
+
+	err := executionRepo.CompactEvents(context.Background(), models.ExecutionEventSummary{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		CompactedAt: time.Date(2019, time.November, 28, 0, 0, 0, 0, time.UTC),
+		EventCount:  2,
+		Summary:     []byte("{}"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, summaryQuery.Triggered)
+	for _, deleteQuery := range deleteQueries {
+		assert.True(t, deleteQuery.Triggered, deleteQuery.Pattern)
+	}
+	assert.True(t, executionQuery.Triggered)
+}
+
+func TestListExecutionProjectUsage(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT executions.execution_project AS project, executions.execution_domain AS domain, ` +
+			`COUNT(*) AS execution_count, COALESCE(SUM(executions.duration), 0) AS duration FROM "executions"  ` +
+			`WHERE (executions.deleted_at IS NULL) AND (executions.execution_created_at >= `).WithReply(
+		[]map[string]interface{}{
+			{"project": "project", "domain": "development", "execution_count": 3, "duration": time.Minute},
+			{"project": "project", "domain": "production", "execution_count": 1, "duration": time.Hour},
+		})
+
+	usage, err := executionRepo.ListProjectUsage(context.Background(), interfaces.ListProjectUsageInput{
+		Project: "project",
+		Since:   createdAt,
+		Until:   createdAt.Add(24 * time.Hour),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ProjectUsage{
+		{Project: "project", Domain: "development", ExecutionCount: 3, Duration: time.Minute},
+		{Project: "project", Domain: "production", ExecutionCount: 1, Duration: time.Hour},
+	}, usage)
+}
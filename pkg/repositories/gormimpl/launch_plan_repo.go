@@ -30,7 +30,7 @@ type LaunchPlanRepo struct {
 
 func (r *LaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := getDB(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -40,7 +40,7 @@ func (r *LaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) er
 
 func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Model(&input).Updates(input)
+	tx := getDB(ctx, r.db).Model(&input).Updates(input)
 	timer.Stop()
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
@@ -51,7 +51,7 @@ func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) er
 func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.LaunchPlan, error) {
 	var launchPlan models.LaunchPlan
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.LaunchPlan{
+	tx := getDB(ctx, r.db).Where(&models.LaunchPlan{
 		LaunchPlanKey: models.LaunchPlanKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -83,7 +83,7 @@ func (r *LaunchPlanRepo) SetActive(
 	timer := r.launchPlanMetrics.SetActiveDuration.Start()
 	defer timer.Stop()
 	// Use a transaction to guarantee no partial updates.
-	tx := r.db.Begin()
+	tx := beginTransaction(ctx, r.db)
 
 	// There is a launch plan to disable as part of this transaction
 	if toDisable != nil {
@@ -113,7 +113,7 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 	var launchPlans []models.LaunchPlan
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 
 	// Add join conditions
 	tx = tx.Joins("inner join workflows on launch_plans.workflow_id = workflows.id")
@@ -150,7 +150,7 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -177,6 +177,56 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 
 }
 
+func (r *LaunchPlanRepo) CreateStateChanges(ctx context.Context, changes []models.LaunchPlanStateChange) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	tx := beginTransaction(ctx, r.db)
+	for idx := range changes {
+		if err := tx.Create(&changes[idx]).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *LaunchPlanRepo) ListStateChanges(
+	ctx context.Context, input interfaces.ListStateChangesInput) ([]models.LaunchPlanStateChange, error) {
+	var changes []models.LaunchPlanStateChange
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Where(&models.LaunchPlanStateChange{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+	}).Order("occurred_at desc, id desc").Limit(input.Limit).Offset(input.Offset).Find(&changes)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return changes, nil
+}
+
+func (r *LaunchPlanRepo) Delete(ctx context.Context, input interfaces.DeleteResourceInput) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := deleteVersion(ctx, r.db, &models.LaunchPlan{}, input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError(core.ResourceType_LAUNCH_PLAN.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	return nil
+}
+
 // Returns an instance of LaunchPlanRepoInterface
 func NewLaunchPlanRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanRepoInterface {
@@ -492,3 +492,74 @@ func TestListLaunchPlanIds(t *testing.T) {
 		assert.True(t, launchPlan.WorkflowID == workflowID || launchPlan.WorkflowID == uint(2))
 	}
 }
+
+func TestCreateLaunchPlanStateChanges(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(
+		`INSERT  INTO "launch_plan_state_changes" ("created_at","updated_at","deleted_at","project","domain","name",` +
+			`"version","state","principal","occurred_at") VALUES (?,?,?,?,?,?,?,?,?,?)`)
+
+	err := launchPlanRepo.CreateStateChanges(context.Background(), []models.LaunchPlanStateChange{
+		{
+			Project:   project,
+			Domain:    domain,
+			Name:      name,
+			Version:   version,
+			State:     active,
+			Principal: "user@example.com",
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListLaunchPlanStateChanges(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	response := make(map[string]interface{})
+	response["project"] = project
+	response["domain"] = domain
+	response["name"] = name
+	response["version"] = version
+	response["state"] = inactive
+	response["principal"] = "user@example.com"
+
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "launch_plan_state_changes"  WHERE ` +
+		`"launch_plan_state_changes"."deleted_at" IS NULL AND (("launch_plan_state_changes"."project" = project) AND ` +
+		`("launch_plan_state_changes"."domain" = domain) AND ("launch_plan_state_changes"."name" = name)) ` +
+		`ORDER BY occurred_at desc, id desc LIMIT 10 OFFSET 20`).WithReply(
+		[]map[string]interface{}{
+			response,
+		})
+
+	changes, err := launchPlanRepo.ListStateChanges(context.Background(), interfaces.ListStateChangesInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Limit:   10,
+		Offset:  20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, version, changes[0].Version)
+	assert.Equal(t, inactive, changes[0].State)
+	assert.Equal(t, "user@example.com", changes[0].Principal)
+}
+
+func TestDeleteLaunchPlan(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock().WithQuery(`UPDATE "launch_plans" SET "deleted_at"=?`).WithRowsNum(1)
+	err := launchPlanRepo.Delete(context.Background(), interfaces.DeleteResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
@@ -3,6 +3,7 @@ package gormimpl
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"google.golang.org/grpc/codes"
@@ -43,6 +44,15 @@ var resourceTypeToMetadataJoin = map[core.ResourceType]string{
 	core.ResourceType_TASK:        leftJoinTaskNameToMetadata,
 }
 
+// The executions column referencing the resource launched by the execution.
+var resourceTypeToExecutionColumn = map[core.ResourceType]string{
+	core.ResourceType_LAUNCH_PLAN: "launch_plan_id",
+	core.ResourceType_WORKFLOW:    "workflow_id",
+}
+
+// Escapes the wildcards of LIKE patterns, so that search keywords are matched literally.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func getGroupByForNamedEntity(tableName string) string {
 	return fmt.Sprintf("%s.%s, %s.%s, %s.%s, %s.%s", tableName, Project, tableName, Domain, tableName, Name, namedEntityMetadataTableName, Description)
 }
@@ -90,7 +100,7 @@ type NamedEntityRepo struct {
 func (r *NamedEntityRepo) Update(ctx context.Context, input models.NamedEntity) error {
 	timer := r.metrics.UpdateDuration.Start()
 	var metadata models.NamedEntityMetadata
-	tx := r.db.Where(&models.NamedEntityMetadata{
+	tx := getDB(ctx, r.db).Where(&models.NamedEntityMetadata{
 		NamedEntityMetadataKey: models.NamedEntityMetadataKey{
 			ResourceType: input.ResourceType,
 			Project:      input.Project,
@@ -119,7 +129,7 @@ func (r *NamedEntityRepo) Get(ctx context.Context, input interfaces.GetNamedEnti
 		return models.NamedEntity{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "Cannot get NamedEntity for resource type: %v", input.ResourceType)
 	}
 
-	tx := r.db.Table(tableName).Joins(joinString)
+	tx := getDB(ctx, r.db).Table(tableName).Joins(joinString)
 
 	// Apply filters
 	tx, err = applyScopedFilters(tx, filters, nil)
@@ -152,7 +162,7 @@ func (r *NamedEntityRepo) List(ctx context.Context, resourceType core.ResourceTy
 		return interfaces.NamedEntityCollectionOutput{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "Cannot list entity names for resource type: %v", resourceType)
 	}
 
-	tx := r.db.Table(tableName).Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Table(tableName).Limit(input.Limit).Offset(input.Offset)
 	tx = tx.Joins(joinString)
 
 	// Apply filters
@@ -180,6 +190,88 @@ func (r *NamedEntityRepo) List(ctx context.Context, resourceType core.ResourceTy
 	}, nil
 }
 
+func (r *NamedEntityRepo) ListUsage(ctx context.Context, resourceType core.ResourceType,
+	input interfaces.ListNamedEntityUsageInput) ([]models.NamedEntityUsage, error) {
+	tableName, tableFound := resourceTypeToTableName[resourceType]
+	executionColumn, columnFound := resourceTypeToExecutionColumn[resourceType]
+	if !tableFound || !columnFound {
+		return nil, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"Cannot list entity usage for resource type: %v", resourceType)
+	}
+
+	// Left joined so that named entities which were never executed are listed too.
+	tx := getDB(ctx, r.db).Table(tableName).Joins(fmt.Sprintf(
+		"LEFT JOIN %s ON %s.%s = %s.id AND %s.deleted_at IS NULL", executionTableName, executionTableName,
+		executionColumn, tableName, executionTableName))
+	tx = tx.Where(fmt.Sprintf("%s.%s = ?", tableName, Project), input.Project)
+	if len(input.Domain) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.%s = ?", tableName, Domain), input.Domain)
+	}
+	tx = tx.Select(fmt.Sprintf("%s.%s, %s.%s, %s.%s, '%d' AS %s, "+
+		"MAX(%s.execution_created_at) AS last_executed_at, "+
+		"COUNT(CASE WHEN %s.execution_created_at >= ? THEN 1 END) AS execution_count",
+		tableName, Project, tableName, Domain, tableName, Name, resourceType, ResourceType, executionTableName,
+		executionTableName), input.Since)
+	tx = tx.Group(fmt.Sprintf("%s.%s, %s.%s, %s.%s", tableName, Project, tableName, Domain, tableName, Name))
+	tx = tx.Order(fmt.Sprintf("%s.%s, %s.%s", tableName, Domain, tableName, Name))
+
+	var usage []models.NamedEntityUsage
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Scan(&usage)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return usage, nil
+}
+
+func (r *NamedEntityRepo) Search(ctx context.Context, input interfaces.SearchNamedEntitiesInput) (
+	interfaces.NamedEntityCollectionOutput, error) {
+	if input.Limit == 0 {
+		return interfaces.NamedEntityCollectionOutput{}, errors.GetInvalidInputError(limit)
+	}
+	queries := make([]string, len(input.ResourceTypes))
+	args := make([]interface{}, 0)
+	for idx, resourceType := range input.ResourceTypes {
+		tableName, tableFound := resourceTypeToTableName[resourceType]
+		joinString, joinFound := resourceTypeToMetadataJoin[resourceType]
+		if !tableFound || !joinFound {
+			return interfaces.NamedEntityCollectionOutput{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Cannot search entity names for resource type: %v", resourceType)
+		}
+		conditions := []string{fmt.Sprintf("%s.%s = ?", tableName, Project)}
+		args = append(args, input.Project)
+		if len(input.Domain) > 0 {
+			conditions = append(conditions, fmt.Sprintf("%s.%s = ?", tableName, Domain))
+			args = append(args, input.Domain)
+		}
+		for _, keyword := range input.Keywords {
+			conditions = append(conditions, fmt.Sprintf("(%s.%s ILIKE ? OR %s.%s ILIKE ?)",
+				tableName, Name, namedEntityMetadataTableName, Description))
+			pattern := "%" + likePatternEscaper.Replace(keyword) + "%"
+			args = append(args, pattern, pattern)
+		}
+		queries[idx] = fmt.Sprintf("SELECT %s FROM %s %s WHERE %s GROUP BY %s",
+			strings.Join(getSelectForNamedEntity(tableName, resourceType), ", "), tableName, joinString,
+			strings.Join(conditions, " AND "), getGroupByForNamedEntity(tableName))
+	}
+	// The named entities of all resource types are searched, sorted and paginated in a single query.
+	query := fmt.Sprintf("%s ORDER BY %s, %s, %s, %s LIMIT ? OFFSET ?",
+		strings.Join(queries, " UNION ALL "), Name, ResourceType, Project, Domain)
+	args = append(args, input.Limit, input.Offset)
+
+	var entities []models.NamedEntity
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Raw(query, args...).Scan(&entities)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.NamedEntityCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.NamedEntityCollectionOutput{
+		Entities: entities,
+	}, nil
+}
+
 // Returns an instance of NamedEntityRepoInterface
 func NewNamedEntityRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NamedEntityRepoInterface {
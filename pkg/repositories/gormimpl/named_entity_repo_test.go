@@ -3,11 +3,13 @@ package gormimpl
 import (
 	"context"
 	"testing"
+	"time"
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/lyft/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
 )
@@ -124,3 +126,107 @@ func TestUpdateNamedEntity_CreateNew(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, mockQuery.Triggered)
 }
+
+func TestListNamedEntityUsage(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	lastExecutedAt := time.Date(2019, 12, 10, 10, 0, 0, 0, time.UTC)
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT workflows.project, workflows.domain, workflows.name, '2' AS resource_type, ` +
+			`MAX(executions.execution_created_at) AS last_executed_at, ` +
+			`COUNT(CASE WHEN executions.execution_created_at >= `).WithReply([]map[string]interface{}{
+		{
+			"project":          project,
+			"domain":           domain,
+			"name":             name,
+			"resource_type":    resourceType,
+			"last_executed_at": lastExecutedAt,
+			"execution_count":  3,
+		},
+		{
+			"project":         project,
+			"domain":          domain,
+			"name":            "unused",
+			"resource_type":   resourceType,
+			"execution_count": 0,
+		},
+	})
+
+	usage, err := metadataRepo.ListUsage(context.Background(), resourceType, interfaces.ListNamedEntityUsageInput{
+		Project: project,
+		Domain:  domain,
+		Since:   lastExecutedAt.Add(-time.Hour),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, usage, 2)
+	assert.Equal(t, name, usage[0].Name)
+	assert.True(t, lastExecutedAt.Equal(*usage[0].LastExecutedAt))
+	assert.Equal(t, int64(3), usage[0].ExecutionCount)
+	assert.Equal(t, "unused", usage[1].Name)
+	assert.Nil(t, usage[1].LastExecutedAt)
+	assert.Zero(t, usage[1].ExecutionCount)
+}
+
+func TestListNamedEntityUsage_UnsupportedResourceType(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := metadataRepo.ListUsage(context.Background(), core.ResourceType_TASK, interfaces.ListNamedEntityUsageInput{
+		Project: project,
+	})
+	assert.Error(t, err)
+}
+
+func TestSearchNamedEntities(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT tasks.project, tasks.domain, tasks.name, '1' AS resource_type, named_entity_metadata.description ` +
+			`FROM tasks LEFT JOIN named_entity_metadata ON named_entity_metadata.resource_type = 1 AND ` +
+			`named_entity_metadata.project = tasks.project AND named_entity_metadata.domain = tasks.domain AND ` +
+			`named_entity_metadata.name = tasks.name WHERE tasks.project = `).WithReply([]map[string]interface{}{
+		getMockNamedEntityResponseFromDb(models.NamedEntity{
+			NamedEntityKey: models.NamedEntityKey{
+				ResourceType: core.ResourceType_TASK,
+				Project:      project,
+				Domain:       domain,
+				Name:         "daily_report",
+			},
+			NamedEntityMetadataFields: models.NamedEntityMetadataFields{
+				Description: "Sends the 100% daily report",
+			},
+		}),
+	})
+
+	output, err := metadataRepo.Search(context.Background(), interfaces.SearchNamedEntitiesInput{
+		Project:       project,
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK, core.ResourceType_WORKFLOW},
+		Keywords:      []string{"report", "100%"},
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.Entities, 1)
+	assert.Equal(t, "daily_report", output.Entities[0].Name)
+	assert.Equal(t, core.ResourceType_TASK, output.Entities[0].ResourceType)
+	assert.Equal(t, `100\%\_\\`, likePatternEscaper.Replace(`100%_\`))
+}
+
+func TestSearchNamedEntities_InvalidInput(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := metadataRepo.Search(context.Background(), interfaces.SearchNamedEntitiesInput{
+		Project:       project,
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK},
+		Keywords:      []string{"report"},
+	})
+	assert.Error(t, err)
+
+	_, err = metadataRepo.Search(context.Background(), interfaces.SearchNamedEntitiesInput{
+		Project:       project,
+		ResourceTypes: []core.ResourceType{core.ResourceType_UNSPECIFIED},
+		Keywords:      []string{"report"},
+		Limit:         10,
+	})
+	assert.Error(t, err)
+}
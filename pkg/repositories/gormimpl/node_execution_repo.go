@@ -28,7 +28,7 @@ func (r *NodeExecutionRepo) Create(ctx context.Context, event *models.NodeExecut
 	defer timer.Stop()
 	// Use a transaction to guarantee no partial updates in
 	// creating the execution and event
-	tx := r.db.Begin()
+	tx := beginTransaction(ctx, r.db)
 	if err := tx.Create(&execution).Error; err != nil {
 		tx.Rollback()
 		return r.errorTransformer.ToFlyteAdminError(err)
@@ -47,7 +47,7 @@ func (r *NodeExecutionRepo) Create(ctx context.Context, event *models.NodeExecut
 func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.NodeExecution{
+	tx := getDB(ctx, r.db).Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.NodeId,
 			ExecutionKey: models.ExecutionKey{
@@ -81,12 +81,12 @@ func (r *NodeExecutionRepo) Update(ctx context.Context, event *models.NodeExecut
 	timer := r.metrics.UpdateDuration.Start()
 	defer timer.Stop()
 	// Use a transaction to guarantee no partial updates.
-	tx := r.db.Begin()
+	tx := beginTransaction(ctx, r.db)
 	if err := tx.Create(&event).Error; err != nil {
 		tx.Rollback()
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
-	if err := r.db.Model(nodeExecution).Updates(nodeExecution).Error; err != nil {
+	if err := getDB(ctx, r.db).Model(nodeExecution).Updates(nodeExecution).Error; err != nil {
 		tx.Rollback()
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
@@ -96,6 +96,16 @@ func (r *NodeExecutionRepo) Update(ctx context.Context, event *models.NodeExecut
 	return nil
 }
 
+func (r *NodeExecutionRepo) UpdateNodeExecution(ctx context.Context, nodeExecution models.NodeExecution) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := getDB(ctx, r.db).Model(&nodeExecution).Updates(nodeExecution)
+	timer.Stop()
+	if err := tx.Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.NodeExecutionCollectionOutput, error) {
 	// First validate input.
@@ -103,7 +113,7 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 	var nodeExecutions []models.NodeExecution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 	// And add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
 	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
@@ -132,6 +142,40 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	}, nil
 }
 
+func (r *NodeExecutionRepo) ListByTask(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.NodeExecutionCollectionOutput, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.NodeExecutionCollectionOutput{}, err
+	}
+	var nodeExecutions []models.NodeExecution
+	// A node execution is joined with each of its task executions' retry attempts, so duplicates are dropped.
+	tx := getDB(ctx, r.db).Select(fmt.Sprintf("DISTINCT %s.*", nodeExecutionTableName)).Limit(
+		input.Limit).Offset(input.Offset)
+	tx = tx.Joins(innerJoinTaskExecToNodeExec)
+	tx = tx.Joins(innerJoinExecToNodeExec)
+
+	// Apply filters
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.NodeExecutionCollectionOutput{}, err
+	}
+	// Apply sort ordering.
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&nodeExecutions)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.NodeExecutionCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.NodeExecutionCollectionOutput{
+		NodeExecutions: nodeExecutions,
+	}, nil
+}
+
 func (r *NodeExecutionRepo) ListEvents(
 	ctx context.Context, input interfaces.ListResourceInput) (interfaces.NodeExecutionEventCollectionOutput, error) {
 	// First validate input.
@@ -139,7 +183,7 @@ func (r *NodeExecutionRepo) ListEvents(
 		return interfaces.NodeExecutionEventCollectionOutput{}, err
 	}
 	var nodeExecutionEvents []models.NodeExecutionEvent
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 	// And add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
 	tx = tx.Joins(innerJoinNodeExecToNodeEvents)
@@ -166,6 +210,58 @@ func (r *NodeExecutionRepo) ListEvents(
 	}, nil
 }
 
+const incrementChildPhaseCountQuery = `INSERT INTO node_execution_child_phase_counts (parent_id, phase, count) ` +
+	`VALUES (?, ?, 1) ON CONFLICT (parent_id, phase) DO UPDATE SET count = node_execution_child_phase_counts.count + 1`
+
+// Counts are incremented and decremented in place so that concurrent events of sibling children don't overwrite one
+// another's updates.
+func (r *NodeExecutionRepo) UpdateChildPhaseCounts(
+	ctx context.Context, input interfaces.UpdateChildPhaseCountsInput) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := beginTransaction(ctx, r.db)
+	if input.PreviousPhase != "" {
+		if err := tx.Model(&models.NodeExecutionChildPhaseCount{}).Where(
+			"parent_id = ? AND phase = ?", input.ParentID, input.PreviousPhase).UpdateColumn(
+			"count", gorm.Expr("count - 1")).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Exec(incrementChildPhaseCountQuery, input.ParentID, input.Phase).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *NodeExecutionRepo) ListChildPhaseCounts(
+	ctx context.Context, parentID uint) ([]models.NodeExecutionChildPhaseCount, error) {
+	var childPhaseCounts []models.NodeExecutionChildPhaseCount
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Where("parent_id = ? AND count > 0", parentID).Find(&childPhaseCounts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return childPhaseCounts, nil
+}
+
+func (r *NodeExecutionRepo) ListProjectUsage(
+	ctx context.Context, input interfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+	var usage []models.ProjectUsage
+	timer := r.metrics.ListDuration.Start()
+	tx := listProjectUsage(ctx, r.db, nodeExecutionTableName, "node_execution_created_at", input, &usage)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return usage, nil
+}
+
 // Returns an instance of NodeExecutionRepoInterface
 func NewNodeExecutionRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer,
@@ -133,6 +133,31 @@ func TestUpdateNodeExecution(t *testing.T) {
 	assert.True(t, nodeExecutionQuery.Triggered)
 }
 
+func TestUpdateNodeExecution_WithoutEvent(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	nodeExecutionQuery := GlobalMock.NewMock()
+	nodeExecutionQuery.WithQuery(`UPDATE "node_executions" SET "dynamic_workflow_remote_closure_reference" = ?, ` +
+		`"execution_domain" = ?, "execution_name" = ?, "execution_project" = ?, "id" = ?, "node_id" = ?, ` +
+		`"updated_at" = ?  WHERE "node_executions"."deleted_at" IS NULL AND "node_executions".` +
+		`"execution_project" = ? AND "node_executions"."execution_domain" = ? AND "node_executions".` +
+		`"execution_name" = ? AND "node_executions"."node_id" = ?`)
+	err := nodeExecutionRepo.UpdateNodeExecution(context.Background(), models.NodeExecution{
+		BaseModel: models.BaseModel{ID: 1},
+		NodeExecutionKey: models.NodeExecutionKey{
+			NodeID: "1",
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+		},
+		DynamicWorkflowRemoteClosureReference: "s3://bucket/metadata/admin/dynamic",
+	})
+	assert.NoError(t, err)
+	assert.True(t, nodeExecutionQuery.Triggered)
+}
+
 func getMockNodeExecutionResponseFromDb(expected models.NodeExecution) map[string]interface{} {
 	nodeExecution := make(map[string]interface{})
 	nodeExecution["execution_project"] = expected.ExecutionKey.Project
@@ -248,6 +273,54 @@ func TestListNodeExecutions(t *testing.T) {
 	}
 }
 
+func TestListNodeExecutionsByTask(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	nodeExecutions := make([]map[string]interface{}, 0)
+	nodeExecution := getMockNodeExecutionResponseFromDb(models.NodeExecution{
+		NodeExecutionKey: models.NodeExecutionKey{
+			NodeID: "node",
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+		},
+		Phase: nodePhase,
+	})
+	nodeExecutions = append(nodeExecutions, nodeExecution)
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT DISTINCT node_executions.* FROM "node_executions" INNER JOIN ` +
+		`task_executions ON task_executions.node_id = node_executions.node_id AND task_executions.execution_project = ` +
+		`node_executions.execution_project AND task_executions.execution_domain = node_executions.execution_domain ` +
+		`AND task_executions.execution_name = node_executions.execution_name INNER JOIN executions ON ` +
+		`node_executions.execution_project = executions.execution_project AND node_executions.execution_domain = ` +
+		`executions.execution_domain AND node_executions.execution_name = executions.execution_name WHERE ` +
+		`"node_executions"."deleted_at" IS NULL AND ((task_executions.name = task)) LIMIT 20 OFFSET 0`).
+		WithReply(nodeExecutions)
+
+	collection, err := nodeExecutionRepo.ListByTask(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.TaskExecution, "name", "task"),
+		},
+		Limit: 20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, collection.NodeExecutions, 1)
+	assert.Equal(t, "node", collection.NodeExecutions[0].NodeID)
+	assert.Equal(t, "1", collection.NodeExecutions[0].Name)
+}
+
+func TestListNodeExecutionsByTask_MissingLimit(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := nodeExecutionRepo.ListByTask(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.TaskExecution, "name", "task"),
+		},
+	})
+	assert.EqualError(t, err, "missing limit")
+}
+
 func TestListNodeExecutions_Order(t *testing.T) {
 	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 	nodeExecutions := make([]map[string]interface{}, 0)
@@ -372,7 +445,10 @@ func TestListNodeExecutionEventsForNodeExecutionAndExecution(t *testing.T) {
 
 	GlobalMock := mocket.Catcher.Reset()
 	query := `SELECT "node_execution_events".* FROM "node_execution_events" INNER JOIN node_executions ON ` +
-		`node_event_executions.node_execution_id = node_executions.id INNER JOIN executions ON node_executions.` +
+		`node_execution_events.execution_project = node_executions.execution_project AND ` +
+		`node_execution_events.execution_domain = node_executions.execution_domain AND ` +
+		`node_execution_events.execution_name = node_executions.execution_name AND ` +
+		`node_execution_events.node_id = node_executions.node_id INNER JOIN executions ON node_executions.` +
 		`execution_project = executions.execution_project AND node_executions.execution_domain = executions.` +
 		`execution_domain AND node_executions.execution_name = executions.execution_name WHERE ` +
 		`"node_execution_events"."deleted_at" IS NULL AND ((node_executions.execution_id = 1) AND ` +
@@ -421,3 +497,73 @@ func TestListNodeExecutionEvents_MissingParameters(t *testing.T) {
 	})
 	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
 }
+
+func TestUpdateChildPhaseCounts(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	decrementQuery := GlobalMock.NewMock()
+	decrementQuery.WithQuery(`UPDATE "node_execution_child_phase_counts" SET "count" = count - 1`)
+	incrementQuery := GlobalMock.NewMock()
+	incrementQuery.WithQuery(`INSERT INTO node_execution_child_phase_counts (parent_id, phase, count) VALUES ` +
+		`(?, ?, 1) ON CONFLICT (parent_id, phase) DO UPDATE SET count = node_execution_child_phase_counts.count + 1`)
+
+	err := nodeExecutionRepo.UpdateChildPhaseCounts(context.Background(), interfaces.UpdateChildPhaseCountsInput{
+		ParentID: 2,
+		Phase:    core.NodeExecution_QUEUED.String(),
+	})
+	assert.NoError(t, err)
+	assert.False(t, decrementQuery.Triggered)
+	assert.True(t, incrementQuery.Triggered)
+
+	incrementQuery.Triggered = false
+	err = nodeExecutionRepo.UpdateChildPhaseCounts(context.Background(), interfaces.UpdateChildPhaseCountsInput{
+		ParentID:      2,
+		PreviousPhase: core.NodeExecution_QUEUED.String(),
+		Phase:         core.NodeExecution_RUNNING.String(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, decrementQuery.Triggered)
+	assert.True(t, incrementQuery.Triggered)
+}
+
+func TestListChildPhaseCounts(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "node_execution_child_phase_counts"  WHERE (parent_id = 2 AND count > 0)`).WithReply(
+		[]map[string]interface{}{
+			{"parent_id": 2, "phase": "SUCCEEDED", "count": 10},
+			{"parent_id": 2, "phase": "RUNNING", "count": 3},
+		})
+
+	childPhaseCounts, err := nodeExecutionRepo.ListChildPhaseCounts(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.NodeExecutionChildPhaseCount{
+		{ParentID: 2, Phase: "SUCCEEDED", Count: 10},
+		{ParentID: 2, Phase: "RUNNING", Count: 3},
+	}, childPhaseCounts)
+}
+
+func TestListNodeExecutionProjectUsage(t *testing.T) {
+	nodeExecutionRepo := NewNodeExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`COALESCE(SUM(node_executions.duration), 0) AS duration FROM "node_executions"  ` +
+			`WHERE (node_executions.deleted_at IS NULL) AND (node_executions.node_execution_created_at >= `).WithReply(
+		[]map[string]interface{}{
+			{"project": "project", "domain": "development", "execution_count": 12, "duration": time.Hour},
+		})
+
+	usage, err := nodeExecutionRepo.ListProjectUsage(context.Background(), interfaces.ListProjectUsageInput{
+		Since: nodeCreatedAt,
+		Until: nodeCreatedAt.Add(24 * time.Hour),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ProjectUsage{
+		{Project: "project", Domain: "development", ExecutionCount: 12, Duration: time.Hour},
+	}, usage)
+}
@@ -0,0 +1,83 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flytestdlib/promutils"
+)
+
+type NotificationPreferenceRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationPreferenceRepo) CreateOrUpdate(ctx context.Context, input models.NotificationPreference) error {
+	timer := r.metrics.GetDuration.Start()
+	var record models.NotificationPreference
+	tx := getDB(ctx, r.db).FirstOrCreate(&record, models.NotificationPreference{
+		UserEmail: input.UserEmail,
+		Project:   input.Project,
+		Domain:    input.Domain,
+		Name:      input.Name,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+
+	timer = r.metrics.UpdateDuration.Start()
+	record.Subscribed = input.Subscribed
+	record.Channel = input.Channel
+	record.Phases = input.Phases
+	tx = getDB(ctx, r.db).Save(&record)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationPreferenceRepo) ListForUser(
+	ctx context.Context, userEmail string) ([]models.NotificationPreference, error) {
+	var preferences []models.NotificationPreference
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Where(&models.NotificationPreference{
+		UserEmail: userEmail,
+	}).Order("project asc, domain asc, name asc").Find(&preferences)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return preferences, nil
+}
+
+func (r *NotificationPreferenceRepo) ListForLaunchPlan(
+	ctx context.Context, project, domain, name string) ([]models.NotificationPreference, error) {
+	var preferences []models.NotificationPreference
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Where(&models.NotificationPreference{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+	}).Order("user_email asc").Find(&preferences)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return preferences, nil
+}
+
+func NewNotificationPreferenceRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationPreferenceRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationPreferenceRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,90 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationPreference(t *testing.T) {
+	preferenceRepo := NewNotificationPreferenceRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(
+		`INSERT  INTO "notification_preferences" ("created_at","updated_at","deleted_at","user_email","project",` +
+			`"domain","name","subscribed","channel","phases") VALUES (?,?,?,?,?,?,?,?,?,?)`)
+
+	err := preferenceRepo.CreateOrUpdate(context.Background(), models.NotificationPreference{
+		UserEmail:  "user@example.com",
+		Project:    "project",
+		Domain:     "domain",
+		Name:       "name",
+		Subscribed: true,
+		Channel:    "slack",
+		Phases:     "FAILED,ABORTED",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListNotificationPreferencesForUser(t *testing.T) {
+	preferenceRepo := NewNotificationPreferenceRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	response := make(map[string]interface{})
+	response["user_email"] = "user@example.com"
+	response["project"] = "project"
+	response["domain"] = "domain"
+	response["name"] = "name"
+	response["subscribed"] = true
+	response["channel"] = "slack"
+
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_preferences"  WHERE ` +
+		`"notification_preferences"."deleted_at" IS NULL AND (("notification_preferences"."user_email" = ` +
+		`user@example.com)) ORDER BY project asc, domain asc, name asc`).WithReply(
+		[]map[string]interface{}{
+			response,
+		})
+
+	preferences, err := preferenceRepo.ListForUser(context.Background(), "user@example.com")
+	assert.NoError(t, err)
+	assert.Len(t, preferences, 1)
+	assert.Equal(t, "name", preferences[0].Name)
+	assert.True(t, preferences[0].Subscribed)
+	assert.Equal(t, "slack", preferences[0].Channel)
+}
+
+func TestListNotificationPreferencesForLaunchPlan(t *testing.T) {
+	preferenceRepo := NewNotificationPreferenceRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	response := make(map[string]interface{})
+	response["user_email"] = "user@example.com"
+	response["project"] = "project"
+	response["domain"] = "domain"
+	response["name"] = "name"
+	response["subscribed"] = false
+
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_preferences"  WHERE ` +
+		`"notification_preferences"."deleted_at" IS NULL AND (("notification_preferences"."project" = project) AND ` +
+		`("notification_preferences"."domain" = domain) AND ("notification_preferences"."name" = name)) ` +
+		`ORDER BY user_email asc`).WithReply(
+		[]map[string]interface{}{
+			response,
+		})
+
+	preferences, err := preferenceRepo.ListForLaunchPlan(context.Background(), "project", "domain", "name")
+	assert.NoError(t, err)
+	assert.Len(t, preferences, 1)
+	assert.Equal(t, "user@example.com", preferences[0].UserEmail)
+	assert.False(t, preferences[0].Subscribed)
+}
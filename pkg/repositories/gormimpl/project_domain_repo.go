@@ -2,6 +2,7 @@ package gormimpl
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jinzhu/gorm"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
@@ -13,6 +14,10 @@ import (
 	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 )
 
+// Shared by all replicas so that attribute versions only ever increase, see the
+// 2019-12-16-project-domain-attributes-version-sequence migration.
+const projectDomainVersionSequence = "project_domain_attributes_versions"
+
 type ProjectDomainRepo struct {
 	db               *gorm.DB
 	errorTransformer errors.ErrorTransformer
@@ -22,7 +27,7 @@ type ProjectDomainRepo struct {
 func (r *ProjectDomainRepo) CreateOrUpdate(ctx context.Context, input models.ProjectDomain) error {
 	timer := r.metrics.GetDuration.Start()
 	var record models.ProjectDomain
-	tx := r.db.FirstOrCreate(&record, models.ProjectDomain{
+	tx := getDB(ctx, r.db).FirstOrCreate(&record, models.ProjectDomain{
 		Project: input.Project,
 		Domain:  input.Domain,
 	})
@@ -33,7 +38,13 @@ func (r *ProjectDomainRepo) CreateOrUpdate(ctx context.Context, input models.Pro
 
 	timer = r.metrics.UpdateDuration.Start()
 	record.Attributes = input.Attributes
-	tx = r.db.Save(&record)
+	err := getDB(ctx, r.db).Raw(fmt.Sprintf("SELECT nextval('%s')", projectDomainVersionSequence)).Row().Scan(
+		&record.Version)
+	if err != nil {
+		timer.Stop()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	tx = getDB(ctx, r.db).Save(&record)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -44,7 +55,7 @@ func (r *ProjectDomainRepo) CreateOrUpdate(ctx context.Context, input models.Pro
 func (r *ProjectDomainRepo) Get(ctx context.Context, project, domain string) (models.ProjectDomain, error) {
 	var model models.ProjectDomain
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.ProjectDomain{
+	tx := getDB(ctx, r.db).Where(&models.ProjectDomain{
 		Project: project,
 		Domain:  domain,
 	}).First(&model)
@@ -59,6 +70,49 @@ func (r *ProjectDomainRepo) Get(ctx context.Context, project, domain string) (mo
 	return model, nil
 }
 
+func (r *ProjectDomainRepo) ListUpdatedSince(ctx context.Context, version int64) ([]models.ProjectDomain, error) {
+	var projectDomains []models.ProjectDomain
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Where("version > ?", version).Order("version asc").Find(&projectDomains)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return projectDomains, nil
+}
+
+func (r *ProjectDomainRepo) CreateOrUpdateAck(ctx context.Context, input models.ProjectDomainAttributesAck) error {
+	timer := r.metrics.GetDuration.Start()
+	var record models.ProjectDomainAttributesAck
+	tx := getDB(ctx, r.db).FirstOrCreate(&record, models.ProjectDomainAttributesAck{
+		Cluster: input.Cluster,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+
+	timer = r.metrics.UpdateDuration.Start()
+	record.Version = input.Version
+	tx = getDB(ctx, r.db).Save(&record)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ProjectDomainRepo) ListAcks(ctx context.Context) ([]models.ProjectDomainAttributesAck, error) {
+	var acks []models.ProjectDomainAttributesAck
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Find(&acks)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return acks, nil
+}
+
 func NewProjectDomainRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
 	scope promutils.Scope) interfaces.ProjectDomainRepoInterface {
 	metrics := newMetrics(scope)
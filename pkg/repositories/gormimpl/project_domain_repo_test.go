@@ -18,7 +18,10 @@ func TestCreateProjectDomain(t *testing.T) {
 	query := GlobalMock.NewMock()
 	query.WithQuery(
 		`INSERT  INTO "project_domains" ` +
-			`("created_at","updated_at","deleted_at","project","domain","attributes") VALUES (?,?,?,?,?,?)`)
+			`("created_at","updated_at","deleted_at","project","domain","attributes","version") VALUES (?,?,?,?,?,?,?)`)
+	versionQuery := GlobalMock.NewMock()
+	versionQuery.WithQuery(`SELECT nextval('project_domain_attributes_versions')`).WithReply(
+		[]map[string]interface{}{{"nextval": int64(7)}})
 
 	err := projectRepo.CreateOrUpdate(context.Background(), models.ProjectDomain{
 		Project:    "project",
@@ -27,6 +30,7 @@ func TestCreateProjectDomain(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.True(t, query.Triggered)
+	assert.True(t, versionQuery.Triggered)
 }
 
 func TestGetProjectDomain(t *testing.T) {
@@ -52,3 +56,62 @@ func TestGetProjectDomain(t *testing.T) {
 	assert.Equal(t, "domain", output.Domain)
 	assert.Equal(t, []byte("attrs"), output.Attributes)
 }
+
+func TestListProjectDomainsUpdatedSince(t *testing.T) {
+	projectRepo := NewProjectDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	response := make(map[string]interface{})
+	response["project"] = "project"
+	response["domain"] = "domain"
+	response["attributes"] = []byte("attrs")
+	response["version"] = 10
+
+	GlobalMock.NewMock().WithQuery(`(version > 5)) ORDER BY version asc`).WithReply(
+		[]map[string]interface{}{
+			response,
+		})
+
+	output, err := projectRepo.ListUpdatedSince(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, "project", output[0].Project)
+	assert.Equal(t, int64(10), output[0].Version)
+}
+
+func TestCreateOrUpdateProjectDomainAttributesAck(t *testing.T) {
+	projectRepo := NewProjectDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(
+		`INSERT  INTO "project_domain_attributes_acks" ` +
+			`("created_at","updated_at","deleted_at","cluster","version") VALUES (?,?,?,?,?)`)
+
+	err := projectRepo.CreateOrUpdateAck(context.Background(), models.ProjectDomainAttributesAck{
+		Cluster: "cluster",
+		Version: 10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListProjectDomainAttributesAcks(t *testing.T) {
+	projectRepo := NewProjectDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	response := make(map[string]interface{})
+	response["cluster"] = "cluster"
+	response["version"] = 10
+
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "project_domain_attributes_acks"`).WithReply(
+		[]map[string]interface{}{
+			response,
+		})
+
+	output, err := projectRepo.ListAcks(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, "cluster", output[0].Cluster)
+	assert.Equal(t, int64(10), output[0].Version)
+}
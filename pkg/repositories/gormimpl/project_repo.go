@@ -24,7 +24,7 @@ type ProjectRepo struct {
 
 func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&project)
+	tx := getDB(ctx, r.db).Create(&project)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -35,7 +35,7 @@ func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error
 func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project, error) {
 	var project models.Project
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Project{
+	tx := getDB(ctx, r.db).Where(&models.Project{
 		Identifier: projectID,
 	}).First(&project)
 	timer.Stop()
@@ -50,7 +50,7 @@ func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project
 
 func (r *ProjectRepo) ListAll(ctx context.Context, sortParameter common.SortParameter) ([]models.Project, error) {
 	var projects []models.Project
-	var tx = r.db
+	var tx = getDB(ctx, r.db)
 	if sortParameter != nil {
 		tx = tx.Order(sortParameter.GetGormOrderExpr())
 	}
@@ -61,6 +61,59 @@ func (r *ProjectRepo) ListAll(ctx context.Context, sortParameter common.SortPara
 	return projects, nil
 }
 
+func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
+	var projects []models.Project
+	tx := getDB(ctx, r.db)
+	if input.Limit > 0 {
+		tx = tx.Limit(input.Limit).Offset(input.Offset)
+	}
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&projects)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return projects, nil
+}
+
+func (r *ProjectRepo) UpdateOnboardingStatus(ctx context.Context, projectID string, onboardingStatus []byte) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := getDB(ctx, r.db).Model(&models.Project{
+		Identifier: projectID,
+	}).Update("onboarding_status", onboardingStatus)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ProjectRepo) Update(ctx context.Context, project models.Project) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := getDB(ctx, r.db).Model(&models.Project{
+		Identifier: project.Identifier,
+	}).Updates(map[string]interface{}{
+		"description": project.Description,
+		"labels":      project.Labels,
+		"state":       project.State,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "project [%s] not found", project.Identifier)
+	}
+	return nil
+}
+
 func NewProjectRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
 	scope promutils.Scope) interfaces.ProjectRepoInterface {
 	metrics := newMetrics(scope)
@@ -6,11 +6,14 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/lyft/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	mockScope "github.com/lyft/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 func TestCreateProject(t *testing.T) {
@@ -19,7 +22,8 @@ func TestCreateProject(t *testing.T) {
 
 	query := GlobalMock.NewMock()
 	query.WithQuery(
-		`INSERT  INTO "projects" ("created_at","updated_at","deleted_at","identifier","name","description") VALUES (?,?,?,?,?,?)`)
+		`INSERT  INTO "projects" ("created_at","updated_at","deleted_at","identifier","name","description",` +
+			`"onboarding_status","labels") VALUES (?,?,?,?,?,?,?,?)`)
 
 	err := projectRepo.Create(context.Background(), models.Project{
 		Identifier:  "proj",
@@ -87,3 +91,63 @@ func TestListProjects(t *testing.T) {
 	assert.Equal(t, "Bar", output[1].Name)
 	assert.Equal(t, "Bar description", output[1].Description)
 }
+
+func TestListProjects_Filters(t *testing.T) {
+	projectRepo := NewProjectRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	fooProject := make(map[string]interface{})
+	fooProject["identifier"] = "foo"
+	fooProject["name"] = "foo =)"
+
+	GlobalMock := mocket.Catcher.Reset()
+	// Only match on queries that append the name filter
+	GlobalMock.NewMock().WithQuery(`name LIKE %foo%`).WithReply([]map[string]interface{}{fooProject})
+
+	nameFilter, err := common.NewSingleValueFilter(common.Project, common.Contains, "name", "foo")
+	assert.NoError(t, err)
+	output, err := projectRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{nameFilter},
+		Limit:         20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, "foo", output[0].Identifier)
+}
+
+func TestUpdateProjectOnboardingStatus(t *testing.T) {
+	projectRepo := NewProjectRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "projects" SET "onboarding_status" = ?`)
+
+	err := projectRepo.UpdateOnboardingStatus(context.Background(), "proj", []byte("status"))
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateProject(t *testing.T) {
+	projectRepo := NewProjectRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "projects" SET "description" = ?, "labels" = ?, "state" = ?`).WithRowsNum(1)
+
+	state := int32(1)
+	err := projectRepo.Update(context.Background(), models.Project{
+		Identifier:  "proj",
+		Description: "description",
+		Labels:      []byte("labels"),
+		State:       &state,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateProject_NotFound(t *testing.T) {
+	projectRepo := NewProjectRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "projects" SET "description" = ?`).WithRowsNum(0)
+
+	err := projectRepo.Update(context.Background(), models.Project{Identifier: "proj"})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
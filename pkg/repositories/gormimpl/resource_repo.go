@@ -0,0 +1,132 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+// Attributes applying at a less specific level match with their empty project, domain and workflow.
+const matchingResourceQuery = "resource_type = ? AND project IN (?) AND domain IN (?) AND workflow IN (?)"
+
+type ResourceRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+// Unlike struct conditions, map conditions match empty domains and workflows too.
+func getResourceConditions(id interfaces.ResourceID) map[string]interface{} {
+	return map[string]interface{}{
+		"project":       id.Project,
+		"domain":        id.Domain,
+		"workflow":      id.Workflow,
+		"resource_type": id.ResourceType,
+	}
+}
+
+func getResourceNotFoundError(id interfaces.ResourceID) error {
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+		"attributes of resource type [%d] for [%s/%s/%s] not found", id.ResourceType, id.Project, id.Domain,
+		id.Workflow)
+}
+
+func (r *ResourceRepo) CreateOrUpdate(ctx context.Context, input models.Resource) error {
+	timer := r.metrics.GetDuration.Start()
+	var record models.Resource
+	tx := getDB(ctx, r.db).Where(getResourceConditions(interfaces.ResourceID{
+		Project:      input.Project,
+		Domain:       input.Domain,
+		Workflow:     input.Workflow,
+		ResourceType: input.ResourceType,
+	})).First(&record)
+	timer.Stop()
+	if tx.Error != nil && !tx.RecordNotFound() {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+
+	timer = r.metrics.UpdateDuration.Start()
+	record.Project = input.Project
+	record.Domain = input.Domain
+	record.Workflow = input.Workflow
+	record.ResourceType = input.ResourceType
+	record.Priority = input.Priority
+	record.Attributes = input.Attributes
+	tx = getDB(ctx, r.db).Save(&record)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ResourceRepo) Get(ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+	var resource models.Resource
+	timer := r.metrics.GetDuration.Start()
+	tx := getDB(ctx, r.db).Where(matchingResourceQuery, id.ResourceType, []string{id.Project, ""},
+		[]string{id.Domain, ""}, []string{id.Workflow, ""}).Order("priority desc").First(&resource)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Resource{}, getResourceNotFoundError(id)
+	}
+	if tx.Error != nil {
+		return models.Resource{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return resource, nil
+}
+
+func (r *ResourceRepo) GetRaw(ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+	var resource models.Resource
+	timer := r.metrics.GetDuration.Start()
+	tx := getDB(ctx, r.db).Where(getResourceConditions(id)).First(&resource)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Resource{}, getResourceNotFoundError(id)
+	}
+	if tx.Error != nil {
+		return models.Resource{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return resource, nil
+}
+
+func (r *ResourceRepo) Delete(ctx context.Context, id interfaces.ResourceID) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Deleted attributes are removed outright (rather than soft-deleted) so that they can be set again later on.
+	tx := getDB(ctx, r.db).Unscoped().Where(getResourceConditions(id)).Delete(&models.Resource{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getResourceNotFoundError(id)
+	}
+	return nil
+}
+
+func (r *ResourceRepo) ListAll(ctx context.Context, resourceType int32) ([]models.Resource, error) {
+	var resources []models.Resource
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Where("resource_type = ?", resourceType).Order("priority asc").Find(&resources)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return resources, nil
+}
+
+func NewResourceRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.ResourceRepoInterface {
+	metrics := newMetrics(scope)
+	return &ResourceRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,129 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var resourceID = interfaces.ResourceID{
+	Project:      "project",
+	Domain:       "domain",
+	Workflow:     "workflow",
+	ResourceType: 1,
+}
+
+func TestCreateOrUpdateResource(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(
+		`INSERT  INTO "resources" ("created_at","updated_at","deleted_at","project","domain","workflow",` +
+			`"resource_type","priority","attributes") VALUES (?,?,?,?,?,?,?,?,?)`)
+
+	err := resourceRepo.CreateOrUpdate(context.Background(), models.Resource{
+		Project:      "project",
+		Domain:       "domain",
+		ResourceType: 1,
+		Priority:     int32(models.ResourcePriorityProjectDomainLevel),
+		Attributes:   []byte("attrs"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestCreateOrUpdateResource_Existing(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "resources"`).WithReply([]map[string]interface{}{
+		{"id": 3, "project": "project", "domain": "domain", "resource_type": 1, "attributes": []byte("old")},
+	})
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "resources" SET`)
+
+	err := resourceRepo.CreateOrUpdate(context.Background(), models.Resource{
+		Project:      "project",
+		Domain:       "domain",
+		ResourceType: 1,
+		Priority:     int32(models.ResourcePriorityProjectDomainLevel),
+		Attributes:   []byte("attrs"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetResource(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY priority desc`).WithReply(
+		[]map[string]interface{}{
+			{"project": "project", "domain": "domain", "resource_type": 1, "attributes": []byte("attrs")},
+		})
+
+	output, err := resourceRepo.Get(context.Background(), resourceID)
+	assert.NoError(t, err)
+	assert.Equal(t, "project", output.Project)
+	assert.Equal(t, "domain", output.Domain)
+	assert.Empty(t, output.Workflow)
+	assert.Equal(t, []byte("attrs"), output.Attributes)
+}
+
+func TestGetResource_NotFound(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := resourceRepo.Get(context.Background(), resourceID)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetRawResource(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "resources"`).WithReply([]map[string]interface{}{
+		{"project": "project", "domain": "domain", "workflow": "workflow", "resource_type": 1},
+	})
+
+	output, err := resourceRepo.GetRaw(context.Background(), resourceID)
+	assert.NoError(t, err)
+	assert.Equal(t, "workflow", output.Workflow)
+}
+
+func TestDeleteResource(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "resources"`).WithRowsNum(1)
+
+	assert.NoError(t, resourceRepo.Delete(context.Background(), resourceID))
+	assert.True(t, query.Triggered)
+
+	query.WithRowsNum(0)
+	err := resourceRepo.Delete(context.Background(), resourceID)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListAllResources(t *testing.T) {
+	resourceRepo := NewResourceRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`((resource_type = 1)) ORDER BY priority asc`).WithReply(
+		[]map[string]interface{}{
+			{"project": "project", "resource_type": 1},
+			{"project": "project", "domain": "domain", "resource_type": 1},
+		})
+
+	output, err := resourceRepo.ListAll(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, output, 2)
+	assert.Equal(t, "domain", output[1].Domain)
+}
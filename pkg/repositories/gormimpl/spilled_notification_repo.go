@@ -0,0 +1,65 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	"github.com/lyft/flytestdlib/promutils"
+)
+
+type SpilledNotificationRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SpilledNotificationRepo) Create(ctx context.Context, input models.SpilledNotification) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := getDB(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *SpilledNotificationRepo) ListForUpdate(
+	ctx context.Context, notificationType string) ([]models.SpilledNotification, error) {
+	var notifications []models.SpilledNotification
+	timer := r.metrics.ListDuration.Start()
+	tx := getDB(ctx, r.db).Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").Where(&models.SpilledNotification{
+		NotificationType: notificationType,
+	}).Order("id asc").Find(&notifications)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return notifications, nil
+}
+
+func (r *SpilledNotificationRepo) Delete(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	timer := r.metrics.DeleteDuration.Start()
+	// Published notifications are removed outright rather than soft-deleted, nothing reads them anymore.
+	tx := getDB(ctx, r.db).Unscoped().Where("id IN (?)", ids).Delete(&models.SpilledNotification{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func NewSpilledNotificationRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.SpilledNotificationRepoInterface {
+	metrics := newMetrics(scope)
+	return &SpilledNotificationRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
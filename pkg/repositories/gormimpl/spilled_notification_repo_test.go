@@ -0,0 +1,63 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSpilledNotification(t *testing.T) {
+	spilledNotificationRepo := NewSpilledNotificationRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT  INTO "spilled_notifications" ` +
+		`("created_at","updated_at","deleted_at","notification_type","launch_plan","message") VALUES (?,?,?,?,?,?)`)
+
+	err := spilledNotificationRepo.Create(context.Background(), models.SpilledNotification{
+		NotificationType: "flyteidl.admin.EmailNotification",
+		Message:          []byte("message"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListSpilledNotificationsForUpdate(t *testing.T) {
+	spilledNotificationRepo := NewSpilledNotificationRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "spilled_notifications"  WHERE "spilled_notifications"."deleted_at" IS NULL AND ` +
+		`(("spilled_notifications"."notification_type" = flyteidl.admin.EmailNotification)) ORDER BY id asc ` +
+		`FOR UPDATE SKIP LOCKED`).WithReply([]map[string]interface{}{
+		{"id": 1, "notification_type": "flyteidl.admin.EmailNotification", "message": []byte("first")},
+		{"id": 2, "notification_type": "flyteidl.admin.EmailNotification", "message": []byte("second")},
+	})
+
+	notifications, err := spilledNotificationRepo.ListForUpdate(
+		context.Background(), "flyteidl.admin.EmailNotification")
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, notifications, 2)
+	assert.Equal(t, []byte("first"), notifications[0].Message)
+	assert.Equal(t, []byte("second"), notifications[1].Message)
+}
+
+func TestDeleteSpilledNotifications(t *testing.T) {
+	spilledNotificationRepo := NewSpilledNotificationRepo(
+		GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "spilled_notifications"  WHERE (id IN (?,?))`)
+
+	assert.NoError(t, spilledNotificationRepo.Delete(context.Background(), []uint{1, 2}))
+	assert.True(t, query.Triggered)
+}
@@ -22,7 +22,7 @@ type TaskExecutionRepo struct {
 
 func (r *TaskExecutionRepo) Create(ctx context.Context, input models.TaskExecution) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := getDB(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -33,7 +33,7 @@ func (r *TaskExecutionRepo) Create(ctx context.Context, input models.TaskExecuti
 func (r *TaskExecutionRepo) Get(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
 	var taskExecution models.TaskExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.TaskExecution{
+	tx := getDB(ctx, r.db).Where(&models.TaskExecution{
 		TaskExecutionKey: models.TaskExecutionKey{
 			TaskKey: models.TaskKey{
 				Project: input.TaskExecutionID.TaskId.Project,
@@ -80,7 +80,7 @@ func (r *TaskExecutionRepo) Get(ctx context.Context, input interfaces.GetTaskExe
 
 func (r *TaskExecutionRepo) Update(ctx context.Context, execution models.TaskExecution) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Save(&execution)
+	tx := getDB(ctx, r.db).Save(&execution)
 	timer.Stop()
 
 	if err := tx.Error; err != nil {
@@ -95,7 +95,7 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	}
 
 	var taskExecutions []models.TaskExecution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
 
 	// And add three join conditions (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// We are joining on task -> taskExec->NodeExec -> Exec.
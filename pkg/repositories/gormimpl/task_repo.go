@@ -22,7 +22,7 @@ type TaskRepo struct {
 
 func (r *TaskRepo) Create(ctx context.Context, input models.Task) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := getDB(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -33,7 +33,7 @@ func (r *TaskRepo) Create(ctx context.Context, input models.Task) error {
 func (r *TaskRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Task, error) {
 	var task models.Task
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Task{
+	tx := getDB(ctx, r.db).Where(&models.Task{
 		TaskKey: models.TaskKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -63,7 +63,7 @@ func (r *TaskRepo) List(
 		return interfaces.TaskCollectionOutput{}, err
 	}
 	var tasks []models.Task
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -94,7 +94,7 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.TaskCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -123,6 +123,49 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 	}, nil
 }
 
+func (r *TaskRepo) UpdateState(ctx context.Context, input interfaces.GetResourceInput, state int32) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := getDB(ctx, r.db).Model(&models.Task{}).Where(&models.Task{
+		TaskKey: models.TaskKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		},
+	}).Update(State, state)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError(core.ResourceType_TASK.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	return nil
+}
+
+func (r *TaskRepo) Delete(ctx context.Context, input interfaces.DeleteResourceInput) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := deleteVersion(ctx, r.db, &models.Task{}, input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError(core.ResourceType_TASK.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	return nil
+}
+
 // Returns an instance of TaskRepoInterface
 func NewTaskRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.TaskRepoInterface {
@@ -10,10 +10,12 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/lyft/flyteadmin/pkg/common"
+	adminErrors "github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 func TestCreateTask(t *testing.T) {
@@ -262,3 +264,67 @@ func TestListTaskIds_MissingParameters(t *testing.T) {
 	// Limit must be specified
 	assert.Equal(t, "missing and/or invalid parameters: limit", err.Error())
 }
+
+func TestUpdateTaskState(t *testing.T) {
+	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	// Archives all versions of the task.
+	GlobalMock.NewMock().WithQuery(`UPDATE "tasks" SET "state" = ?, "updated_at" = ?`).WithRowsNum(2)
+	err := taskRepo.UpdateState(context.Background(), interfaces.GetResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+	}, 1)
+	assert.NoError(t, err)
+}
+
+func TestUpdateTaskState_NotFound(t *testing.T) {
+	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "tasks" SET "state" = ?, "updated_at" = ?`).WithRowsNum(0)
+	err := taskRepo.UpdateState(context.Background(), interfaces.GetResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	}, 1)
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteTask(t *testing.T) {
+	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	softDelete := GlobalMock.NewMock().WithQuery(`UPDATE "tasks" SET "deleted_at"=?`).WithRowsNum(1)
+	err := taskRepo.Delete(context.Background(), interfaces.DeleteResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	})
+	assert.NoError(t, err)
+	assert.True(t, softDelete.Triggered)
+
+	purge := GlobalMock.NewMock().WithQuery(`DELETE FROM "tasks"`).WithRowsNum(1)
+	err = taskRepo.Delete(context.Background(), interfaces.DeleteResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+		Purge:   true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, purge.Triggered)
+}
+
+func TestDeleteTask_NotFound(t *testing.T) {
+	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "tasks" SET "deleted_at"=?`).WithRowsNum(0)
+	err := taskRepo.Delete(context.Background(), interfaces.DeleteResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	})
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
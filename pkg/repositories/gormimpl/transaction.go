@@ -0,0 +1,121 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+)
+
+type transactionContextKey struct{}
+
+// The transaction a context was created for by RunInTransaction, along with what to run once it commits.
+type transactionScope struct {
+	db *gorm.DB
+	// How deep the scope is nested within the outermost transaction, which is at depth 0.
+	depth       int
+	afterCommit []func(ctx context.Context)
+}
+
+func getTransactionScope(ctx context.Context) *transactionScope {
+	scope, _ := ctx.Value(transactionContextKey{}).(*transactionScope)
+	return scope
+}
+
+// Runs fn within a single database transaction which is committed when fn succeeds and rolled back otherwise.
+// Repo operations passed the context handed to fn join the transaction rather than using their own connection.
+// Transactions started within another one run in a savepoint of it, so that failing only rolls back their own writes.
+func RunInTransaction(
+	ctx context.Context, db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	fn func(ctx context.Context) error) error {
+	if parent := getTransactionScope(ctx); parent != nil {
+		return runInSavepoint(ctx, parent, errorTransformer, fn)
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	scope := &transactionScope{db: tx}
+	if err := fn(context.WithValue(ctx, transactionContextKey{}, scope)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return errorTransformer.ToFlyteAdminError(err)
+	}
+	// The committed transaction can't be used anymore, so whatever runs after it uses its own connection.
+	committedCtx := context.WithValue(ctx, transactionContextKey{}, (*transactionScope)(nil))
+	for _, afterCommit := range scope.afterCommit {
+		afterCommit(committedCtx)
+	}
+	return nil
+}
+
+func runInSavepoint(ctx context.Context, parent *transactionScope, errorTransformer errors.ErrorTransformer,
+	fn func(ctx context.Context) error) error {
+	scope := &transactionScope{db: parent.db, depth: parent.depth + 1}
+	savepoint := fmt.Sprintf("nested_transaction_%d", scope.depth)
+	if err := parent.db.Exec("SAVEPOINT " + savepoint).Error; err != nil {
+		return errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := fn(context.WithValue(ctx, transactionContextKey{}, scope)); err != nil {
+		if rollbackErr := parent.db.Exec("ROLLBACK TO SAVEPOINT " + savepoint).Error; rollbackErr != nil {
+			return errorTransformer.ToFlyteAdminError(rollbackErr)
+		}
+		return err
+	}
+	if err := parent.db.Exec("RELEASE SAVEPOINT " + savepoint).Error; err != nil {
+		return errorTransformer.ToFlyteAdminError(err)
+	}
+	parent.afterCommit = append(parent.afterCommit, scope.afterCommit...)
+	return nil
+}
+
+// Defers fn until the transaction the context was created for by RunInTransaction commits, dropping it when the
+// transaction (or the savepoint it was deferred in) rolls back. Outside of transactions fn runs right away.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if scope := getTransactionScope(ctx); scope != nil {
+		scope.afterCommit = append(scope.afterCommit, fn)
+		return
+	}
+	fn(ctx)
+}
+
+// Returns the transaction the context was created for by RunInTransaction, if any, and db otherwise.
+func getDB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if scope := getTransactionScope(ctx); scope != nil {
+		return scope.db
+	}
+	return db
+}
+
+// Repo operations which write several records run in a transaction of their own unless they already run within one,
+// in which case committing (or rolling back) is left to whoever started it.
+type transaction struct {
+	*gorm.DB
+	joined bool
+}
+
+func beginTransaction(ctx context.Context, db *gorm.DB) transaction {
+	db = getDB(ctx, db)
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return transaction{DB: db, joined: true}
+	}
+	return transaction{DB: db.Begin()}
+}
+
+func (t transaction) Commit() *gorm.DB {
+	if t.joined {
+		return t.DB
+	}
+	return t.DB.Commit()
+}
+
+func (t transaction) Rollback() *gorm.DB {
+	if t.joined {
+		return t.DB
+	}
+	return t.DB.Rollback()
+}
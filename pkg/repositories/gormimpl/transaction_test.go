@@ -0,0 +1,94 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	adminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/errors"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRunInTransaction(t *testing.T) {
+	db := GetDbForTest(t)
+	assert.Equal(t, db, getDB(context.Background(), db))
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	createQuery := GlobalMock.NewMock()
+	createQuery.WithQuery(`INSERT INTO "task_executions"`)
+
+	taskExecutionRepo := NewTaskExecutionRepo(db, errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	err := RunInTransaction(context.Background(), db, errors.NewTestErrorTransformer(), func(ctx context.Context) error {
+		tx := getDB(ctx, db)
+		_, ok := tx.CommonDB().(*sql.Tx)
+		assert.True(t, ok)
+		// Nested transactions join the one already running.
+		assert.True(t, beginTransaction(ctx, db).joined)
+		return RunInTransaction(ctx, db, errors.NewTestErrorTransformer(), func(nestedCtx context.Context) error {
+			assert.Equal(t, tx, getDB(nestedCtx, db))
+			return taskExecutionRepo.Create(nestedCtx, testTaskExecution)
+		})
+	})
+	assert.NoError(t, err)
+	assert.True(t, createQuery.Triggered)
+	tx := beginTransaction(context.Background(), db)
+	assert.False(t, tx.joined)
+	tx.Rollback()
+}
+
+func TestRunInTransaction_Error(t *testing.T) {
+	db := GetDbForTest(t)
+	err := RunInTransaction(context.Background(), db, errors.NewTestErrorTransformer(), func(ctx context.Context) error {
+		return adminErrors.NewFlyteAdminError(codes.FailedPrecondition, "invalid phase transition")
+	})
+	assert.EqualError(t, err, "invalid phase transition")
+}
+
+func TestRunInTransaction_Savepoint(t *testing.T) {
+	db := GetDbForTest(t)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	// Registered first as it also contains the savepoint query.
+	rollbackQuery := GlobalMock.NewMock().WithQuery(`ROLLBACK TO SAVEPOINT nested_transaction_1`)
+	savepointQuery := GlobalMock.NewMock().WithQuery(`SAVEPOINT nested_transaction_1`)
+
+	var committed []string
+	err := RunInTransaction(context.Background(), db, errors.NewTestErrorTransformer(), func(ctx context.Context) error {
+		AfterCommit(ctx, func(ctx context.Context) {
+			// Deferred functions no longer run within the committed transaction.
+			assert.Equal(t, db, getDB(ctx, db))
+			committed = append(committed, "outer")
+		})
+		err := RunInTransaction(ctx, db, errors.NewTestErrorTransformer(), func(ctx context.Context) error {
+			AfterCommit(ctx, func(ctx context.Context) {
+				committed = append(committed, "rolled back")
+			})
+			return adminErrors.NewFlyteAdminError(codes.AlreadyExists, "duplicate event")
+		})
+		assert.EqualError(t, err, "duplicate event")
+		return RunInTransaction(ctx, db, errors.NewTestErrorTransformer(), func(ctx context.Context) error {
+			AfterCommit(ctx, func(ctx context.Context) {
+				committed = append(committed, "nested")
+			})
+			assert.Empty(t, committed)
+			return nil
+		})
+	})
+	assert.NoError(t, err)
+	assert.True(t, savepointQuery.Triggered)
+	assert.True(t, rollbackQuery.Triggered)
+	assert.Equal(t, []string{"outer", "nested"}, committed)
+}
+
+func TestAfterCommit_NoTransaction(t *testing.T) {
+	var ran bool
+	AfterCommit(context.Background(), func(ctx context.Context) {
+		ran = true
+	})
+	assert.True(t, ran)
+}
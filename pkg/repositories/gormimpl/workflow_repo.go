@@ -22,7 +22,7 @@ type WorkflowRepo struct {
 
 func (r *WorkflowRepo) Create(ctx context.Context, input models.Workflow) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := getDB(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -33,7 +33,7 @@ func (r *WorkflowRepo) Create(ctx context.Context, input models.Workflow) error
 func (r *WorkflowRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Workflow, error) {
 	var workflow models.Workflow
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Workflow{
+	tx := getDB(ctx, r.db).Where(&models.Workflow{
 		WorkflowKey: models.WorkflowKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -63,7 +63,7 @@ func (r *WorkflowRepo) List(
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 	var workflows []models.Workflow
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -93,7 +93,7 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
+	tx := getDB(ctx, r.db).Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -120,6 +120,24 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 	}, nil
 }
 
+func (r *WorkflowRepo) Delete(ctx context.Context, input interfaces.DeleteResourceInput) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := deleteVersion(ctx, r.db, &models.Workflow{}, input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError(core.ResourceType_WORKFLOW.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	return nil
+}
+
 // Returns an instance of WorkflowRepoInterface
 func NewWorkflowRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.WorkflowRepoInterface {
@@ -266,3 +266,18 @@ func TestListWorkflowIds_MissingParameters(t *testing.T) {
 
 	assert.Equal(t, err.Error(), "missing and/or invalid parameters: limit")
 }
+
+func TestDeleteWorkflow(t *testing.T) {
+	workflowRepo := NewWorkflowRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock().WithQuery(`DELETE FROM "workflows"`).WithRowsNum(1)
+	err := workflowRepo.Delete(context.Background(), interfaces.DeleteResourceInput{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+		Purge:   true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
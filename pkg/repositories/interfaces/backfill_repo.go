@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type BackfillRepoInterface interface {
+	// Inserts a backfill before its executions are launched.
+	Create(ctx context.Context, input models.Backfill) error
+	// Returns the backfill with a name.
+	Get(ctx context.Context, name string) (models.Backfill, error)
+	// Records the progress of a backfill.
+	Update(ctx context.Context, input models.Backfill) error
+}
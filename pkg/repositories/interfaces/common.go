@@ -12,6 +12,16 @@ type GetResourceInput struct {
 	Version string
 }
 
+// Parameters for deleting a single version of a resource.
+type DeleteResourceInput struct {
+	Project string
+	Domain  string
+	Name    string
+	Version string
+	// Permanently removes the version rather than soft-deleting it, after which it can no longer be restored.
+	Purge bool
+}
+
 // Parameters for querying multiple resources.
 type ListResourceInput struct {
 	Limit         int
@@ -24,6 +34,12 @@ type ListResourceInput struct {
 	SortParameter common.SortParameter
 }
 
+// Parameters for counting the resources matching filters.
+type CountResourceInput struct {
+	InlineFilters []common.InlineFilter
+	MapFilters    []common.MapFilter
+}
+
 // Describes a set of resources for which to apply attribute updates.
 type UpdateResourceInput struct {
 	Filters    []common.InlineFilter
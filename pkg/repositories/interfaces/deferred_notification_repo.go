@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type DeferredNotificationRepoInterface interface {
+	// Inserts a notification held back by quiet hours.
+	Create(ctx context.Context, input models.DeferredNotification) error
+	// Returns the deferred notifications in the order they were deferred. Within a transaction they stay locked until it
+	// ends and those locked by other transactions are skipped, so that each is only published once.
+	ListForUpdate(ctx context.Context) ([]models.DeferredNotification, error)
+	// Removes deferred notifications once they've been published.
+	Delete(ctx context.Context, ids []uint) error
+}
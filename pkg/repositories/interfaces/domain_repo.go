@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type DomainRepoInterface interface {
+	// Inserts a domain model into the database store.
+	Create(ctx context.Context, domain models.Domain) error
+	// Lists the registered domains ordered by identifier. Listings may be cached for a short while.
+	ListAll(ctx context.Context) ([]models.Domain, error)
+}
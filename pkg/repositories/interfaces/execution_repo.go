@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 )
@@ -11,19 +12,55 @@ type ExecutionRepoInterface interface {
 	// Inserts a workflow execution model into the database store.
 	Create(ctx context.Context, input models.Execution) error
 	// Updates an existing execution in the database store with all non-empty fields in the input.
-	// This execution and event correspond to entire graph (workflow) executions.
+	// This execution and event correspond to entire graph (workflow) executions. The execution's EventVersion must be
+	// one greater than the stored one, otherwise another event was applied concurrently and this fails with
+	// codes.Aborted.
 	Update(ctx context.Context, event models.ExecutionEvent, execution models.Execution) error
 	// This updates only an existing execution model with all non-empty fields in the input.
 	UpdateExecution(ctx context.Context, execution models.Execution) error
+	// Replaces the tags of an existing execution with those in the input, alongside its correspondingly updated spec.
+	UpdateTags(ctx context.Context, execution models.Execution) error
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input GetResourceInput) (models.Execution, error)
 	// Return a matching execution if it exists
 	GetByID(ctx context.Context, id uint) (models.Execution, error)
 	// Returns executions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (ExecutionCollectionOutput, error)
+	// Returns the number of executions matching query parameters, which can't filter on extensions or tags.
+	Count(ctx context.Context, input CountResourceInput) (int, error)
+	// Keeps executions in a project and domain from being counted and created concurrently until the transaction the
+	// context was created for by Transaction ends. Outside of transactions the lock is released right away.
+	LockProjectDomain(ctx context.Context, project, domain string) error
+	// Permanently deletes an execution along with its events, extensions, tags and the node and task executions it ran.
+	Delete(ctx context.Context, key models.ExecutionKey) error
+	// Returns the (workflow) execution events matching query parameters. A limit must be provided for the results page
+	// size.
+	ListEvents(ctx context.Context, input ListResourceInput) (ExecutionEventCollectionOutput, error)
+	// Stores the summary of an execution's compacted events and removes the execution and node execution events it
+	// summarizes, i.e. those created before the summary's CompactedAt, marking the execution's events as compacted.
+	CompactEvents(ctx context.Context, summary models.ExecutionEventSummary) error
+	// Returns the summary of an execution's compacted events if they were compacted.
+	GetEventSummary(ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error)
+	// Aggregates the executions created within the input window by the project and domain they ran in.
+	ListProjectUsage(ctx context.Context, input ListProjectUsageInput) ([]models.ProjectUsage, error)
+}
+
+type ListProjectUsageInput struct {
+	// Optional, the usage of all projects is aggregated when empty.
+	Project string
+	// Optional, the usage of all domains is aggregated when empty.
+	Domain string
+	// Only executions created at or after Since and before Until are aggregated.
+	Since time.Time
+	Until time.Time
 }
 
 // Response format for a query on workflows.
 type ExecutionCollectionOutput struct {
 	Executions []models.Execution
 }
+
+// Response format for a query on workflow execution events.
+type ExecutionEventCollectionOutput struct {
+	ExecutionEvents []models.ExecutionEvent
+}
@@ -23,6 +23,20 @@ type LaunchPlanRepoInterface interface {
 	List(ctx context.Context, input ListResourceInput) (LaunchPlanCollectionOutput, error)
 	// Returns a list of identifiers for launch plans.  A limit must be provided for the results page size.
 	ListLaunchPlanIdentifiers(ctx context.Context, input ListResourceInput) (LaunchPlanCollectionOutput, error)
+	// Records transitions of launch plan versions to the ACTIVE or INACTIVE state.
+	CreateStateChanges(ctx context.Context, changes []models.LaunchPlanStateChange) error
+	// Returns the recorded state transitions of all versions of a launch plan, most recent first.
+	ListStateChanges(ctx context.Context, input ListStateChangesInput) ([]models.LaunchPlanStateChange, error)
+	// Deletes a single launch plan version. Its recorded state changes are kept.
+	Delete(ctx context.Context, input DeleteResourceInput) error
+}
+
+type ListStateChangesInput struct {
+	Project string
+	Domain  string
+	Name    string
+	Limit   int
+	Offset  int
 }
 
 type SetStateInput struct {
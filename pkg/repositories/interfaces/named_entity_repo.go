@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 
@@ -15,6 +16,26 @@ type GetNamedEntityInput struct {
 	Name         string
 }
 
+type ListNamedEntityUsageInput struct {
+	Project string
+	// Optional, usage is aggregated across all domains of the project when empty.
+	Domain string
+	// Only executions created at or after this time are counted.
+	Since time.Time
+}
+
+// Searches the names and descriptions of the named entities in a project.
+type SearchNamedEntitiesInput struct {
+	Project string
+	// Optional, the named entities of all domains of the project are searched when empty.
+	Domain        string
+	ResourceTypes []core.ResourceType
+	// Every keyword must occur, ignoring case, in either the name or the description of matching named entities.
+	Keywords []string
+	Limit    int
+	Offset   int
+}
+
 type NamedEntityCollectionOutput struct {
 	Entities []models.NamedEntity
 }
@@ -28,4 +49,10 @@ type NamedEntityRepoInterface interface {
 	Update(ctx context.Context, input models.NamedEntity) error
 	// Gets metadata (if available) associated with a NamedEntity
 	Get(ctx context.Context, input GetNamedEntityInput) (models.NamedEntity, error)
+	// Returns the usage of every named entity of a workflow or launch plan resource type in a project, including those
+	// which were never executed.
+	ListUsage(ctx context.Context, resourceType core.ResourceType, input ListNamedEntityUsageInput) (
+		[]models.NamedEntityUsage, error)
+	// Returns the named entities of all the given resource types matching the keywords, sorted by name.
+	Search(ctx context.Context, input SearchNamedEntitiesInput) (NamedEntityCollectionOutput, error)
 }
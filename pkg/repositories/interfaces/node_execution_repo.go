@@ -14,12 +14,33 @@ type NodeExecutionRepoInterface interface {
 	// Updates an existing node execution in the database store with all non-empty fields in the input.
 	// This execution and event correspond to entire graph (workflow) executions.
 	Update(ctx context.Context, event *models.NodeExecutionEvent, execution *models.NodeExecution) error
+	// Updates an existing node execution in the database store with all non-empty fields in the input without
+	// recording an event.
+	UpdateNodeExecution(ctx context.Context, execution models.NodeExecution) error
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input GetNodeExecutionInput) (models.NodeExecution, error)
 	// Returns node executions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (NodeExecutionCollectionOutput, error)
 	// Return node execution events matching query parameters. A limit must be provided for the results page size.
 	ListEvents(ctx context.Context, input ListResourceInput) (NodeExecutionEventCollectionOutput, error)
+	// Returns node executions joined with their task executions, which filters may reference, e.g. to find the node
+	// executions which ran a task across executions. A limit must be provided for the results page size.
+	ListByTask(ctx context.Context, input ListResourceInput) (NodeExecutionCollectionOutput, error)
+	// Moves a child node execution from its previous phase (if any) to its current phase in its parent's counts of
+	// child phases.
+	UpdateChildPhaseCounts(ctx context.Context, input UpdateChildPhaseCountsInput) error
+	// Returns how many children of a parent node execution are in each phase, leaving out phases without children.
+	ListChildPhaseCounts(ctx context.Context, parentID uint) ([]models.NodeExecutionChildPhaseCount, error)
+	// Aggregates the node executions created within the input window by the project and domain of the execution they
+	// ran in.
+	ListProjectUsage(ctx context.Context, input ListProjectUsageInput) ([]models.ProjectUsage, error)
+}
+
+type UpdateChildPhaseCountsInput struct {
+	ParentID uint
+	// Empty for newly created children.
+	PreviousPhase string
+	Phase         string
 }
 
 type GetNodeExecutionInput struct {
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type NotificationPreferenceRepoInterface interface {
+	// Inserts or updates a user's preference for the notifications of a launch plan.
+	CreateOrUpdate(ctx context.Context, input models.NotificationPreference) error
+	// Returns every preference of a user, ordered by launch plan.
+	ListForUser(ctx context.Context, userEmail string) ([]models.NotificationPreference, error)
+	// Returns the preferences of every user for the notifications of a launch plan.
+	ListForLaunchPlan(ctx context.Context, project, domain, name string) ([]models.NotificationPreference, error)
+}
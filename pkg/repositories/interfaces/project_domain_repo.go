@@ -11,4 +11,10 @@ type ProjectDomainRepoInterface interface {
 	CreateOrUpdate(ctx context.Context, input models.ProjectDomain) error
 	// Returns a matching project when it exists.
 	Get(ctx context.Context, project, domain string) (models.ProjectDomain, error)
+	// Returns all ProjectDomains updated after the given version, ordered by version.
+	ListUpdatedSince(ctx context.Context, version int64) ([]models.ProjectDomain, error)
+	// Records the latest attributes version a cluster has applied.
+	CreateOrUpdateAck(ctx context.Context, input models.ProjectDomainAttributesAck) error
+	// Returns the latest attributes version applied by every cluster which has acknowledged one.
+	ListAcks(ctx context.Context) ([]models.ProjectDomainAttributesAck, error)
 }
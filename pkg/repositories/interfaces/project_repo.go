@@ -14,4 +14,11 @@ type ProjectRepoInterface interface {
 	Get(ctx context.Context, projectID string) (models.Project, error)
 	// Lists unique projects registered as namespaces
 	ListAll(ctx context.Context, sortParameter common.SortParameter) ([]models.Project, error)
+	// Returns a page of the projects matching the input filters. The whole matching collection is returned when no
+	// limit is set.
+	List(ctx context.Context, input ListResourceInput) ([]models.Project, error)
+	// Overwrites the serialized onboarding status of a project.
+	UpdateOnboardingStatus(ctx context.Context, projectID string, onboardingStatus []byte) error
+	// Overwrites the description, labels and state of a project.
+	Update(ctx context.Context, project models.Project) error
 }
@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+// Identifies matchable attributes of a resource type. Domain and Workflow are left empty for attributes which apply
+// at the project (or project and domain) level.
+type ResourceID struct {
+	Project      string
+	Domain       string
+	Workflow     string
+	ResourceType int32
+}
+
+type ResourceRepoInterface interface {
+	// Inserts or updates the attributes of a resource type at the level they apply at.
+	CreateOrUpdate(ctx context.Context, input models.Resource) error
+	// Returns the attributes of a resource type which most specifically match a project, domain and workflow.
+	Get(ctx context.Context, id ResourceID) (models.Resource, error)
+	// Returns the attributes of a resource type applying at exactly the identified level.
+	GetRaw(ctx context.Context, id ResourceID) (models.Resource, error)
+	// Deletes the attributes of a resource type applying at exactly the identified level.
+	Delete(ctx context.Context, id ResourceID) error
+	// Returns all attributes set for a resource type.
+	ListAll(ctx context.Context, resourceType int32) ([]models.Resource, error)
+}
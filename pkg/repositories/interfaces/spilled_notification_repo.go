@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type SpilledNotificationRepoInterface interface {
+	// Inserts a notification held back by a rate limit.
+	Create(ctx context.Context, input models.SpilledNotification) error
+	// Returns the notifications spilled with a notification type in the order they were spilled. Within a transaction
+	// they stay locked until it ends and those locked by other transactions are skipped, so that each is only published
+	// once.
+	ListForUpdate(ctx context.Context, notificationType string) ([]models.SpilledNotification, error)
+	// Removes spilled notifications once they've been published.
+	Delete(ctx context.Context, ids []uint) error
+}
@@ -17,6 +17,10 @@ type TaskRepoInterface interface {
 	// Returns tasks with only the project, name, and domain filled in.
 	// A limit must be provided.
 	ListTaskIdentifiers(ctx context.Context, input ListResourceInput) (TaskCollectionOutput, error)
+	// Updates the state of a task version, or of all versions of the task when no version is specified.
+	UpdateState(ctx context.Context, input GetResourceInput, state int32) error
+	// Deletes a single task version.
+	Delete(ctx context.Context, input DeleteResourceInput) error
 }
 
 // Response format for a query on tasks.
@@ -15,6 +15,8 @@ type WorkflowRepoInterface interface {
 	// Returns workflow revisions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (WorkflowCollectionOutput, error)
 	ListIdentifiers(ctx context.Context, input ListResourceInput) (WorkflowCollectionOutput, error)
+	// Deletes a single workflow version.
+	Delete(ctx context.Context, input DeleteResourceInput) error
 }
 
 // Response format for a query on workflows.
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type CreateBackfillFunction func(ctx context.Context, input models.Backfill) error
+type GetBackfillFunction func(ctx context.Context, name string) (models.Backfill, error)
+type UpdateBackfillFunction func(ctx context.Context, input models.Backfill) error
+
+type MockBackfillRepo struct {
+	CreateFunction CreateBackfillFunction
+	GetFunction    GetBackfillFunction
+	UpdateFunction UpdateBackfillFunction
+}
+
+func (r *MockBackfillRepo) Create(ctx context.Context, input models.Backfill) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockBackfillRepo) Get(ctx context.Context, name string) (models.Backfill, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, name)
+	}
+	return models.Backfill{}, nil
+}
+
+func (r *MockBackfillRepo) Update(ctx context.Context, input models.Backfill) error {
+	if r.UpdateFunction != nil {
+		return r.UpdateFunction(ctx, input)
+	}
+	return nil
+}
+
+func NewMockBackfillRepo() interfaces.BackfillRepoInterface {
+	return &MockBackfillRepo{}
+}
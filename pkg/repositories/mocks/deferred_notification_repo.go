@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type CreateDeferredNotificationFunction func(ctx context.Context, input models.DeferredNotification) error
+type ListDeferredNotificationsForUpdateFunction func(ctx context.Context) ([]models.DeferredNotification, error)
+type DeleteDeferredNotificationsFunction func(ctx context.Context, ids []uint) error
+
+type MockDeferredNotificationRepo struct {
+	CreateFunction        CreateDeferredNotificationFunction
+	ListForUpdateFunction ListDeferredNotificationsForUpdateFunction
+	DeleteFunction        DeleteDeferredNotificationsFunction
+}
+
+func (r *MockDeferredNotificationRepo) Create(ctx context.Context, input models.DeferredNotification) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockDeferredNotificationRepo) ListForUpdate(ctx context.Context) ([]models.DeferredNotification, error) {
+	if r.ListForUpdateFunction != nil {
+		return r.ListForUpdateFunction(ctx)
+	}
+	return nil, nil
+}
+
+func (r *MockDeferredNotificationRepo) Delete(ctx context.Context, ids []uint) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, ids)
+	}
+	return nil
+}
+
+func NewMockDeferredNotificationRepo() interfaces.DeferredNotificationRepoInterface {
+	return &MockDeferredNotificationRepo{}
+}
@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type CreateDomainFunction func(ctx context.Context, domain models.Domain) error
+type ListDomainsFunction func(ctx context.Context) ([]models.Domain, error)
+
+type MockDomainRepo struct {
+	CreateFunction  CreateDomainFunction
+	ListAllFunction ListDomainsFunction
+}
+
+func (r *MockDomainRepo) Create(ctx context.Context, domain models.Domain) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, domain)
+	}
+	return nil
+}
+
+func (r *MockDomainRepo) ListAll(ctx context.Context) ([]models.Domain, error) {
+	if r.ListAllFunction != nil {
+		return r.ListAllFunction(ctx)
+	}
+	return make([]models.Domain, 0), nil
+}
+
+func NewMockDomainRepo() interfaces.DomainRepoInterface {
+	return &MockDomainRepo{}
+}
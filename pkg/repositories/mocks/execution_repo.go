@@ -14,14 +14,34 @@ type GetExecutionFunc func(ctx context.Context, input interfaces.GetResourceInpu
 type GetExecutionByIDFunc func(ctx context.Context, id uint) (models.Execution, error)
 type ListExecutionFunc func(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.ExecutionCollectionOutput, error)
+type CountExecutionFunc func(ctx context.Context, input interfaces.CountResourceInput) (int, error)
+type LockExecutionProjectDomainFunc func(ctx context.Context, project, domain string) error
+type UpdateExecutionTagsFunc func(ctx context.Context, execution models.Execution) error
+type DeleteExecutionFunc func(ctx context.Context, key models.ExecutionKey) error
+type ListExecutionEventsFunc func(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.ExecutionEventCollectionOutput, error)
+type CompactExecutionEventsFunc func(ctx context.Context, summary models.ExecutionEventSummary) error
+type GetExecutionEventSummaryFunc func(ctx context.Context, key models.ExecutionKey) (
+	models.ExecutionEventSummary, error)
+
+type ListExecutionProjectUsageFunc func(ctx context.Context, input interfaces.ListProjectUsageInput) (
+	[]models.ProjectUsage, error)
 
 type MockExecutionRepo struct {
-	createFunction      CreateExecutionFunc
-	updateFunction      UpdateFunc
-	updateExecutionFunc UpdateExecutionFunc
-	getFunction         GetExecutionFunc
-	getByIDFunction     GetExecutionByIDFunc
-	listFunction        ListExecutionFunc
+	createFunction       CreateExecutionFunc
+	updateFunction       UpdateFunc
+	updateExecutionFunc  UpdateExecutionFunc
+	updateTagsFunc       UpdateExecutionTagsFunc
+	getFunction          GetExecutionFunc
+	getByIDFunction      GetExecutionByIDFunc
+	listFunction         ListExecutionFunc
+	countFunction        CountExecutionFunc
+	lockFunction         LockExecutionProjectDomainFunc
+	deleteFunction       DeleteExecutionFunc
+	listEventsFunction   ListExecutionEventsFunc
+	compactEventsFunc    CompactExecutionEventsFunc
+	getEventSummaryFunc  GetExecutionEventSummaryFunc
+	listProjectUsageFunc ListExecutionProjectUsageFunc
 }
 
 func (r *MockExecutionRepo) Create(ctx context.Context, input models.Execution) error {
@@ -57,6 +77,17 @@ func (r *MockExecutionRepo) SetUpdateExecutionCallback(updateExecutionFunc Updat
 	r.updateExecutionFunc = updateExecutionFunc
 }
 
+func (r *MockExecutionRepo) UpdateTags(ctx context.Context, execution models.Execution) error {
+	if r.updateTagsFunc != nil {
+		return r.updateTagsFunc(ctx, execution)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) SetUpdateTagsCallback(updateTagsFunc UpdateExecutionTagsFunc) {
+	r.updateTagsFunc = updateTagsFunc
+}
+
 func (r *MockExecutionRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
 	if r.getFunction != nil {
 		return r.getFunction(ctx, input)
@@ -91,6 +122,86 @@ func (r *MockExecutionRepo) SetListCallback(listFunction ListExecutionFunc) {
 	r.listFunction = listFunction
 }
 
+func (r *MockExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int, error) {
+	if r.countFunction != nil {
+		return r.countFunction(ctx, input)
+	}
+	return 0, nil
+}
+
+func (r *MockExecutionRepo) SetCountCallback(countFunction CountExecutionFunc) {
+	r.countFunction = countFunction
+}
+
+func (r *MockExecutionRepo) LockProjectDomain(ctx context.Context, project, domain string) error {
+	if r.lockFunction != nil {
+		return r.lockFunction(ctx, project, domain)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) SetLockProjectDomainCallback(lockFunction LockExecutionProjectDomainFunc) {
+	r.lockFunction = lockFunction
+}
+
+func (r *MockExecutionRepo) Delete(ctx context.Context, key models.ExecutionKey) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(ctx, key)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) SetDeleteCallback(deleteFunction DeleteExecutionFunc) {
+	r.deleteFunction = deleteFunction
+}
+
+func (r *MockExecutionRepo) ListEvents(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionEventCollectionOutput, error) {
+	if r.listEventsFunction != nil {
+		return r.listEventsFunction(ctx, input)
+	}
+	return interfaces.ExecutionEventCollectionOutput{}, nil
+}
+
+func (r *MockExecutionRepo) SetListEventsCallback(listEventsFunction ListExecutionEventsFunc) {
+	r.listEventsFunction = listEventsFunction
+}
+
+func (r *MockExecutionRepo) CompactEvents(ctx context.Context, summary models.ExecutionEventSummary) error {
+	if r.compactEventsFunc != nil {
+		return r.compactEventsFunc(ctx, summary)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) SetCompactEventsCallback(compactEventsFunc CompactExecutionEventsFunc) {
+	r.compactEventsFunc = compactEventsFunc
+}
+
+func (r *MockExecutionRepo) GetEventSummary(
+	ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+	if r.getEventSummaryFunc != nil {
+		return r.getEventSummaryFunc(ctx, key)
+	}
+	return models.ExecutionEventSummary{}, nil
+}
+
+func (r *MockExecutionRepo) SetGetEventSummaryCallback(getEventSummaryFunc GetExecutionEventSummaryFunc) {
+	r.getEventSummaryFunc = getEventSummaryFunc
+}
+
+func (r *MockExecutionRepo) ListProjectUsage(
+	ctx context.Context, input interfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+	if r.listProjectUsageFunc != nil {
+		return r.listProjectUsageFunc(ctx, input)
+	}
+	return nil, nil
+}
+
+func (r *MockExecutionRepo) SetListProjectUsageCallback(listProjectUsageFunc ListExecutionProjectUsageFunc) {
+	r.listProjectUsageFunc = listProjectUsageFunc
+}
+
 func NewMockExecutionRepo() interfaces.ExecutionRepoInterface {
 	return &MockExecutionRepo{}
 }
@@ -15,14 +15,21 @@ type GetLaunchPlanFunc func(input interfaces.GetResourceInput) (models.LaunchPla
 type ListLaunchPlanFunc func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error)
 type ListLaunchPlanIdentifiersFunc func(input interfaces.ListResourceInput) (
 	interfaces.LaunchPlanCollectionOutput, error)
+type CreateLaunchPlanStateChangesFunc func(changes []models.LaunchPlanStateChange) error
+type ListLaunchPlanStateChangesFunc func(input interfaces.ListStateChangesInput) (
+	[]models.LaunchPlanStateChange, error)
+type DeleteLaunchPlanFunc func(input interfaces.DeleteResourceInput) error
 
 type MockLaunchPlanRepo struct {
-	createFunction    CreateLaunchPlanFunc
-	updateFunction    UpdateLaunchPlanFunc
-	setActiveFunction SetActiveLaunchPlanFunc
-	getFunction       GetLaunchPlanFunc
-	listFunction      ListLaunchPlanFunc
-	listIdsFunction   ListLaunchPlanIdentifiersFunc
+	createFunction             CreateLaunchPlanFunc
+	updateFunction             UpdateLaunchPlanFunc
+	setActiveFunction          SetActiveLaunchPlanFunc
+	getFunction                GetLaunchPlanFunc
+	listFunction               ListLaunchPlanFunc
+	listIdsFunction            ListLaunchPlanIdentifiersFunc
+	createStateChangesFunction CreateLaunchPlanStateChangesFunc
+	listStateChangesFunction   ListLaunchPlanStateChangesFunc
+	deleteFunction             DeleteLaunchPlanFunc
 }
 
 func (r *MockLaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) error {
@@ -102,6 +109,40 @@ func (r *MockLaunchPlanRepo) SetListLaunchPlanIdentifiersCallback(fn ListLaunchP
 	r.listIdsFunction = fn
 }
 
+func (r *MockLaunchPlanRepo) CreateStateChanges(ctx context.Context, changes []models.LaunchPlanStateChange) error {
+	if r.createStateChangesFunction != nil {
+		return r.createStateChangesFunction(changes)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanRepo) SetCreateStateChangesCallback(fn CreateLaunchPlanStateChangesFunc) {
+	r.createStateChangesFunction = fn
+}
+
+func (r *MockLaunchPlanRepo) ListStateChanges(ctx context.Context, input interfaces.ListStateChangesInput) (
+	[]models.LaunchPlanStateChange, error) {
+	if r.listStateChangesFunction != nil {
+		return r.listStateChangesFunction(input)
+	}
+	return nil, nil
+}
+
+func (r *MockLaunchPlanRepo) SetListStateChangesCallback(fn ListLaunchPlanStateChangesFunc) {
+	r.listStateChangesFunction = fn
+}
+
+func (r *MockLaunchPlanRepo) Delete(ctx context.Context, input interfaces.DeleteResourceInput) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(input)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanRepo) SetDeleteCallback(deleteFunction DeleteLaunchPlanFunc) {
+	r.deleteFunction = deleteFunction
+}
+
 func NewMockLaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
 	return &MockLaunchPlanRepo{}
 }
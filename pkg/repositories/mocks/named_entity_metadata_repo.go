@@ -13,11 +13,17 @@ import (
 type GetNamedEntityFunc func(input interfaces.GetNamedEntityInput) (models.NamedEntity, error)
 type ListNamedEntityFunc func(resourceType core.ResourceType, input interfaces.ListResourceInput) (interfaces.NamedEntityCollectionOutput, error)
 type UpdateNamedEntityFunc func(input models.NamedEntity) error
+type ListNamedEntityUsageFunc func(resourceType core.ResourceType, input interfaces.ListNamedEntityUsageInput) (
+	[]models.NamedEntityUsage, error)
+type SearchNamedEntitiesFunc func(input interfaces.SearchNamedEntitiesInput) (
+	interfaces.NamedEntityCollectionOutput, error)
 
 type MockNamedEntityRepo struct {
-	getFunction    GetNamedEntityFunc
-	listFunction   ListNamedEntityFunc
-	updateFunction UpdateNamedEntityFunc
+	getFunction       GetNamedEntityFunc
+	listFunction      ListNamedEntityFunc
+	updateFunction    UpdateNamedEntityFunc
+	listUsageFunction ListNamedEntityUsageFunc
+	searchFunction    SearchNamedEntitiesFunc
 }
 
 func (r *MockNamedEntityRepo) Update(ctx context.Context, NamedEntity models.NamedEntity) error {
@@ -52,6 +58,22 @@ func (r *MockNamedEntityRepo) List(ctx context.Context, resourceType core.Resour
 	return interfaces.NamedEntityCollectionOutput{}, nil
 }
 
+func (r *MockNamedEntityRepo) ListUsage(ctx context.Context, resourceType core.ResourceType,
+	input interfaces.ListNamedEntityUsageInput) ([]models.NamedEntityUsage, error) {
+	if r.listUsageFunction != nil {
+		return r.listUsageFunction(resourceType, input)
+	}
+	return nil, nil
+}
+
+func (r *MockNamedEntityRepo) Search(
+	ctx context.Context, input interfaces.SearchNamedEntitiesInput) (interfaces.NamedEntityCollectionOutput, error) {
+	if r.searchFunction != nil {
+		return r.searchFunction(input)
+	}
+	return interfaces.NamedEntityCollectionOutput{}, nil
+}
+
 func (r *MockNamedEntityRepo) SetGetCallback(getFunction GetNamedEntityFunc) {
 	r.getFunction = getFunction
 }
@@ -64,6 +86,14 @@ func (r *MockNamedEntityRepo) SetUpdateCallback(updateFunction UpdateNamedEntity
 	r.updateFunction = updateFunction
 }
 
+func (r *MockNamedEntityRepo) SetListUsageCallback(listUsageFunction ListNamedEntityUsageFunc) {
+	r.listUsageFunction = listUsageFunction
+}
+
 func NewMockNamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return &MockNamedEntityRepo{}
 }
+
+func (r *MockNamedEntityRepo) SetSearchCallback(searchFunction SearchNamedEntitiesFunc) {
+	r.searchFunction = searchFunction
+}
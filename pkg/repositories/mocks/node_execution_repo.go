@@ -9,18 +9,29 @@ import (
 
 type CreateNodeExecutionFunc func(ctx context.Context, event *models.NodeExecutionEvent, input *models.NodeExecution) error
 type UpdateNodeExecutionFunc func(ctx context.Context, event *models.NodeExecutionEvent, nodeExecution *models.NodeExecution) error
+type UpdateNodeExecutionModelFunc func(ctx context.Context, nodeExecution models.NodeExecution) error
 type GetNodeExecutionFunc func(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error)
 type ListNodeExecutionFunc func(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.NodeExecutionCollectionOutput, error)
 type ListNodeExecutionEventFunc func(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.NodeExecutionEventCollectionOutput, error)
+type UpdateChildPhaseCountsFunc func(ctx context.Context, input interfaces.UpdateChildPhaseCountsInput) error
+type ListChildPhaseCountsFunc func(ctx context.Context, parentID uint) ([]models.NodeExecutionChildPhaseCount, error)
+
+type ListNodeExecutionProjectUsageFunc func(ctx context.Context, input interfaces.ListProjectUsageInput) (
+	[]models.ProjectUsage, error)
 
 type MockNodeExecutionRepo struct {
-	createFunction    CreateNodeExecutionFunc
-	updateFunction    UpdateNodeExecutionFunc
-	getFunction       GetNodeExecutionFunc
-	listFunction      ListNodeExecutionFunc
-	listEventFunction ListNodeExecutionEventFunc
+	createFunction          CreateNodeExecutionFunc
+	updateFunction          UpdateNodeExecutionFunc
+	updateNodeExecutionFunc UpdateNodeExecutionModelFunc
+	getFunction             GetNodeExecutionFunc
+	listFunction            ListNodeExecutionFunc
+	listEventFunction       ListNodeExecutionEventFunc
+	listByTaskFunction      ListNodeExecutionFunc
+	updateChildPhaseCounts  UpdateChildPhaseCountsFunc
+	listChildPhaseCounts    ListChildPhaseCountsFunc
+	listProjectUsage        ListNodeExecutionProjectUsageFunc
 }
 
 func (r *MockNodeExecutionRepo) Create(ctx context.Context, event *models.NodeExecutionEvent, input *models.NodeExecution) error {
@@ -45,6 +56,17 @@ func (r *MockNodeExecutionRepo) SetUpdateCallback(updateFunction UpdateNodeExecu
 	r.updateFunction = updateFunction
 }
 
+func (r *MockNodeExecutionRepo) UpdateNodeExecution(ctx context.Context, nodeExecution models.NodeExecution) error {
+	if r.updateNodeExecutionFunc != nil {
+		return r.updateNodeExecutionFunc(ctx, nodeExecution)
+	}
+	return nil
+}
+
+func (r *MockNodeExecutionRepo) SetUpdateNodeExecutionCallback(updateNodeExecutionFunc UpdateNodeExecutionModelFunc) {
+	r.updateNodeExecutionFunc = updateNodeExecutionFunc
+}
+
 func (r *MockNodeExecutionRepo) Get(ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
 	if r.getFunction != nil {
 		return r.getFunction(ctx, input)
@@ -70,7 +92,7 @@ func (r *MockNodeExecutionRepo) SetListCallback(listFunction ListNodeExecutionFu
 
 func (r *MockNodeExecutionRepo) ListEvents(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.NodeExecutionEventCollectionOutput, error) {
-	if r.listFunction != nil {
+	if r.listEventFunction != nil {
 		return r.listEventFunction(ctx, input)
 	}
 	return interfaces.NodeExecutionEventCollectionOutput{}, nil
@@ -80,6 +102,54 @@ func (r *MockNodeExecutionRepo) SetListEventCallback(listEventFunction ListNodeE
 	r.listEventFunction = listEventFunction
 }
 
+func (r *MockNodeExecutionRepo) ListByTask(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.NodeExecutionCollectionOutput, error) {
+	if r.listByTaskFunction != nil {
+		return r.listByTaskFunction(ctx, input)
+	}
+	return interfaces.NodeExecutionCollectionOutput{}, nil
+}
+
+func (r *MockNodeExecutionRepo) SetListByTaskCallback(listByTaskFunction ListNodeExecutionFunc) {
+	r.listByTaskFunction = listByTaskFunction
+}
+
+func (r *MockNodeExecutionRepo) UpdateChildPhaseCounts(
+	ctx context.Context, input interfaces.UpdateChildPhaseCountsInput) error {
+	if r.updateChildPhaseCounts != nil {
+		return r.updateChildPhaseCounts(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockNodeExecutionRepo) SetUpdateChildPhaseCountsCallback(updateChildPhaseCounts UpdateChildPhaseCountsFunc) {
+	r.updateChildPhaseCounts = updateChildPhaseCounts
+}
+
+func (r *MockNodeExecutionRepo) ListChildPhaseCounts(
+	ctx context.Context, parentID uint) ([]models.NodeExecutionChildPhaseCount, error) {
+	if r.listChildPhaseCounts != nil {
+		return r.listChildPhaseCounts(ctx, parentID)
+	}
+	return nil, nil
+}
+
+func (r *MockNodeExecutionRepo) SetListChildPhaseCountsCallback(listChildPhaseCounts ListChildPhaseCountsFunc) {
+	r.listChildPhaseCounts = listChildPhaseCounts
+}
+
+func (r *MockNodeExecutionRepo) ListProjectUsage(
+	ctx context.Context, input interfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+	if r.listProjectUsage != nil {
+		return r.listProjectUsage(ctx, input)
+	}
+	return nil, nil
+}
+
+func (r *MockNodeExecutionRepo) SetListProjectUsageCallback(listProjectUsage ListNodeExecutionProjectUsageFunc) {
+	r.listProjectUsage = listProjectUsage
+}
+
 func NewMockNodeExecutionRepo() interfaces.NodeExecutionRepoInterface {
 	return &MockNodeExecutionRepo{}
 }
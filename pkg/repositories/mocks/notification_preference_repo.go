@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type CreateOrUpdateNotificationPreferenceFunction func(ctx context.Context, input models.NotificationPreference) error
+type ListNotificationPreferencesForUserFunction func(ctx context.Context, userEmail string) (
+	[]models.NotificationPreference, error)
+type ListNotificationPreferencesForLaunchPlanFunction func(ctx context.Context, project, domain, name string) (
+	[]models.NotificationPreference, error)
+
+type MockNotificationPreferenceRepo struct {
+	CreateOrUpdateFunction    CreateOrUpdateNotificationPreferenceFunction
+	ListForUserFunction       ListNotificationPreferencesForUserFunction
+	ListForLaunchPlanFunction ListNotificationPreferencesForLaunchPlanFunction
+}
+
+func (r *MockNotificationPreferenceRepo) CreateOrUpdate(ctx context.Context, input models.NotificationPreference) error {
+	if r.CreateOrUpdateFunction != nil {
+		return r.CreateOrUpdateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockNotificationPreferenceRepo) ListForUser(
+	ctx context.Context, userEmail string) ([]models.NotificationPreference, error) {
+	if r.ListForUserFunction != nil {
+		return r.ListForUserFunction(ctx, userEmail)
+	}
+	return nil, nil
+}
+
+func (r *MockNotificationPreferenceRepo) ListForLaunchPlan(
+	ctx context.Context, project, domain, name string) ([]models.NotificationPreference, error) {
+	if r.ListForLaunchPlanFunction != nil {
+		return r.ListForLaunchPlanFunction(ctx, project, domain, name)
+	}
+	return nil, nil
+}
+
+func NewMockNotificationPreferenceRepo() interfaces.NotificationPreferenceRepoInterface {
+	return &MockNotificationPreferenceRepo{}
+}
@@ -10,10 +10,17 @@ import (
 type CreateOrUpdateProjectDomainFunction func(ctx context.Context, input models.ProjectDomain) error
 type GetProjectDomainFunction func(ctx context.Context, project, domain string) (models.ProjectDomain, error)
 type UpdateProjectDomainFunction func(ctx context.Context, input models.ProjectDomain) error
+type ListProjectDomainsUpdatedSinceFunction func(ctx context.Context, version int64) ([]models.ProjectDomain, error)
+type CreateOrUpdateProjectDomainAttributesAckFunction func(
+	ctx context.Context, input models.ProjectDomainAttributesAck) error
+type ListProjectDomainAttributesAcksFunction func(ctx context.Context) ([]models.ProjectDomainAttributesAck, error)
 
 type MockProjectDomainRepo struct {
-	CreateOrUpdateFunction CreateOrUpdateProjectDomainFunction
-	GetFunction            GetProjectDomainFunction
+	CreateOrUpdateFunction    CreateOrUpdateProjectDomainFunction
+	GetFunction               GetProjectDomainFunction
+	ListUpdatedSinceFunction  ListProjectDomainsUpdatedSinceFunction
+	CreateOrUpdateAckFunction CreateOrUpdateProjectDomainAttributesAckFunction
+	ListAcksFunction          ListProjectDomainAttributesAcksFunction
 }
 
 func (r *MockProjectDomainRepo) CreateOrUpdate(ctx context.Context, input models.ProjectDomain) error {
@@ -30,6 +37,27 @@ func (r *MockProjectDomainRepo) Get(ctx context.Context, project, domain string)
 	return models.ProjectDomain{}, nil
 }
 
+func (r *MockProjectDomainRepo) ListUpdatedSince(ctx context.Context, version int64) ([]models.ProjectDomain, error) {
+	if r.ListUpdatedSinceFunction != nil {
+		return r.ListUpdatedSinceFunction(ctx, version)
+	}
+	return nil, nil
+}
+
+func (r *MockProjectDomainRepo) CreateOrUpdateAck(ctx context.Context, input models.ProjectDomainAttributesAck) error {
+	if r.CreateOrUpdateAckFunction != nil {
+		return r.CreateOrUpdateAckFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockProjectDomainRepo) ListAcks(ctx context.Context) ([]models.ProjectDomainAttributesAck, error) {
+	if r.ListAcksFunction != nil {
+		return r.ListAcksFunction(ctx)
+	}
+	return nil, nil
+}
+
 func NewMockProjectDomainRepo() interfaces.ProjectDomainRepoInterface {
 	return &MockProjectDomainRepo{}
 }
@@ -11,11 +11,18 @@ import (
 type CreateProjectFunction func(ctx context.Context, project models.Project) error
 type GetProjectFunction func(ctx context.Context, projectID string) (models.Project, error)
 type ListProjectsFunction func(ctx context.Context, sortParameter common.SortParameter) ([]models.Project, error)
+type ListProjectsWithInputFunction func(
+	ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error)
+type UpdateProjectOnboardingStatusFunction func(ctx context.Context, projectID string, onboardingStatus []byte) error
+type UpdateProjectFunction func(ctx context.Context, project models.Project) error
 
 type MockProjectRepo struct {
-	CreateFunction       CreateProjectFunction
-	GetFunction          GetProjectFunction
-	ListProjectsFunction ListProjectsFunction
+	CreateFunction                 CreateProjectFunction
+	GetFunction                    GetProjectFunction
+	ListProjectsFunction           ListProjectsFunction
+	ListFunction                   ListProjectsWithInputFunction
+	UpdateOnboardingStatusFunction UpdateProjectOnboardingStatusFunction
+	UpdateFunction                 UpdateProjectFunction
 }
 
 func (r *MockProjectRepo) Create(ctx context.Context, project models.Project) error {
@@ -39,6 +46,28 @@ func (r *MockProjectRepo) ListAll(ctx context.Context, sortParameter common.Sort
 	return make([]models.Project, 0), nil
 }
 
+func (r *MockProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx, input)
+	}
+	return make([]models.Project, 0), nil
+}
+
+func (r *MockProjectRepo) UpdateOnboardingStatus(
+	ctx context.Context, projectID string, onboardingStatus []byte) error {
+	if r.UpdateOnboardingStatusFunction != nil {
+		return r.UpdateOnboardingStatusFunction(ctx, projectID, onboardingStatus)
+	}
+	return nil
+}
+
+func (r *MockProjectRepo) Update(ctx context.Context, project models.Project) error {
+	if r.UpdateFunction != nil {
+		return r.UpdateFunction(ctx, project)
+	}
+	return nil
+}
+
 func NewMockProjectRepo() interfaces.ProjectRepoInterface {
 	return &MockProjectRepo{}
 }
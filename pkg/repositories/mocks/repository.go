@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
 )
@@ -15,6 +17,13 @@ type MockRepository struct {
 	projectDomainRepo interfaces.ProjectDomainRepoInterface
 	taskExecutionRepo interfaces.TaskExecutionRepoInterface
 	namedEntityRepo   interfaces.NamedEntityRepoInterface
+	domainRepo        interfaces.DomainRepoInterface
+	resourceRepo      interfaces.ResourceRepoInterface
+
+	notificationPreferenceRepo interfaces.NotificationPreferenceRepoInterface
+	spilledNotificationRepo    interfaces.SpilledNotificationRepoInterface
+	backfillRepo               interfaces.BackfillRepoInterface
+	deferredNotificationRepo   interfaces.DeferredNotificationRepoInterface
 }
 
 func (r *MockRepository) TaskRepo() interfaces.TaskRepoInterface {
@@ -53,6 +62,38 @@ func (r *MockRepository) NamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return r.namedEntityRepo
 }
 
+func (r *MockRepository) NotificationPreferenceRepo() interfaces.NotificationPreferenceRepoInterface {
+	return r.notificationPreferenceRepo
+}
+
+func (r *MockRepository) DomainRepo() interfaces.DomainRepoInterface {
+	return r.domainRepo
+}
+
+func (r *MockRepository) ResourceRepo() interfaces.ResourceRepoInterface {
+	return r.resourceRepo
+}
+
+func (r *MockRepository) SpilledNotificationRepo() interfaces.SpilledNotificationRepoInterface {
+	return r.spilledNotificationRepo
+}
+
+func (r *MockRepository) BackfillRepo() interfaces.BackfillRepoInterface {
+	return r.backfillRepo
+}
+
+func (r *MockRepository) DeferredNotificationRepo() interfaces.DeferredNotificationRepoInterface {
+	return r.deferredNotificationRepo
+}
+
+func (r *MockRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *MockRepository) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	fn(ctx)
+}
+
 func NewMockRepository() repositories.RepositoryInterface {
 	return &MockRepository{
 		taskRepo:          NewMockTaskRepo(),
@@ -64,5 +105,12 @@ func NewMockRepository() repositories.RepositoryInterface {
 		projectDomainRepo: NewMockProjectDomainRepo(),
 		taskExecutionRepo: NewMockTaskExecutionRepo(),
 		namedEntityRepo:   NewMockNamedEntityRepo(),
+		domainRepo:        NewMockDomainRepo(),
+		resourceRepo:      NewMockResourceRepo(),
+
+		notificationPreferenceRepo: NewMockNotificationPreferenceRepo(),
+		spilledNotificationRepo:    NewMockSpilledNotificationRepo(),
+		backfillRepo:               NewMockBackfillRepo(),
+		deferredNotificationRepo:   NewMockDeferredNotificationRepo(),
 	}
 }
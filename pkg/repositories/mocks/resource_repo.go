@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type CreateOrUpdateResourceFunction func(ctx context.Context, input models.Resource) error
+type GetResourceFunction func(ctx context.Context, id interfaces.ResourceID) (models.Resource, error)
+type DeleteResourceFunction func(ctx context.Context, id interfaces.ResourceID) error
+type ListAllResourcesFunction func(ctx context.Context, resourceType int32) ([]models.Resource, error)
+
+type MockResourceRepo struct {
+	CreateOrUpdateFunction CreateOrUpdateResourceFunction
+	GetFunction            GetResourceFunction
+	GetRawFunction         GetResourceFunction
+	DeleteFunction         DeleteResourceFunction
+	ListAllFunction        ListAllResourcesFunction
+}
+
+func (r *MockResourceRepo) CreateOrUpdate(ctx context.Context, input models.Resource) error {
+	if r.CreateOrUpdateFunction != nil {
+		return r.CreateOrUpdateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockResourceRepo) Get(ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, id)
+	}
+	return models.Resource{}, nil
+}
+
+func (r *MockResourceRepo) GetRaw(ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+	if r.GetRawFunction != nil {
+		return r.GetRawFunction(ctx, id)
+	}
+	return models.Resource{}, nil
+}
+
+func (r *MockResourceRepo) Delete(ctx context.Context, id interfaces.ResourceID) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, id)
+	}
+	return nil
+}
+
+func (r *MockResourceRepo) ListAll(ctx context.Context, resourceType int32) ([]models.Resource, error) {
+	if r.ListAllFunction != nil {
+		return r.ListAllFunction(ctx, resourceType)
+	}
+	return make([]models.Resource, 0), nil
+}
+
+func NewMockResourceRepo() interfaces.ResourceRepoInterface {
+	return &MockResourceRepo{}
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+type CreateSpilledNotificationFunction func(ctx context.Context, input models.SpilledNotification) error
+type ListSpilledNotificationsForUpdateFunction func(ctx context.Context, notificationType string) (
+	[]models.SpilledNotification, error)
+type DeleteSpilledNotificationsFunction func(ctx context.Context, ids []uint) error
+
+type MockSpilledNotificationRepo struct {
+	CreateFunction        CreateSpilledNotificationFunction
+	ListForUpdateFunction ListSpilledNotificationsForUpdateFunction
+	DeleteFunction        DeleteSpilledNotificationsFunction
+}
+
+func (r *MockSpilledNotificationRepo) Create(ctx context.Context, input models.SpilledNotification) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockSpilledNotificationRepo) ListForUpdate(
+	ctx context.Context, notificationType string) ([]models.SpilledNotification, error) {
+	if r.ListForUpdateFunction != nil {
+		return r.ListForUpdateFunction(ctx, notificationType)
+	}
+	return nil, nil
+}
+
+func (r *MockSpilledNotificationRepo) Delete(ctx context.Context, ids []uint) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, ids)
+	}
+	return nil
+}
+
+func NewMockSpilledNotificationRepo() interfaces.SpilledNotificationRepoInterface {
+	return &MockSpilledNotificationRepo{}
+}
@@ -12,12 +12,16 @@ type CreateTaskFunc func(input models.Task) error
 type GetTaskFunc func(input interfaces.GetResourceInput) (models.Task, error)
 type ListTaskFunc func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error)
 type ListTaskIdentifiersFunc func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error)
+type UpdateTaskStateFunc func(input interfaces.GetResourceInput, state int32) error
+type DeleteTaskFunc func(input interfaces.DeleteResourceInput) error
 
 type MockTaskRepo struct {
 	createFunction            CreateTaskFunc
 	getFunction               GetTaskFunc
 	listFunction              ListTaskFunc
 	listUniqueTaskIdsFunction ListTaskIdentifiersFunc
+	updateStateFunction       UpdateTaskStateFunc
+	deleteFunction            DeleteTaskFunc
 }
 
 func (r *MockTaskRepo) Create(ctx context.Context, input models.Task) error {
@@ -74,6 +78,28 @@ func (r *MockTaskRepo) SetListTaskIdentifiersCallback(listFunction ListTaskIdent
 	r.listUniqueTaskIdsFunction = listFunction
 }
 
+func (r *MockTaskRepo) UpdateState(ctx context.Context, input interfaces.GetResourceInput, state int32) error {
+	if r.updateStateFunction != nil {
+		return r.updateStateFunction(input, state)
+	}
+	return nil
+}
+
+func (r *MockTaskRepo) SetUpdateStateCallback(updateStateFunction UpdateTaskStateFunc) {
+	r.updateStateFunction = updateStateFunction
+}
+
+func (r *MockTaskRepo) Delete(ctx context.Context, input interfaces.DeleteResourceInput) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(input)
+	}
+	return nil
+}
+
+func (r *MockTaskRepo) SetDeleteCallback(deleteFunction DeleteTaskFunc) {
+	r.deleteFunction = deleteFunction
+}
+
 func NewMockTaskRepo() interfaces.TaskRepoInterface {
 	return &MockTaskRepo{}
 }
@@ -12,12 +12,14 @@ type CreateWorkflowFunc func(input models.Workflow) error
 type GetWorkflowFunc func(input interfaces.GetResourceInput) (models.Workflow, error)
 type ListWorkflowFunc func(input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error)
 type ListIdentifiersFunc func(input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error)
+type DeleteWorkflowFunc func(input interfaces.DeleteResourceInput) error
 
 type MockWorkflowRepo struct {
 	createFunction      CreateWorkflowFunc
 	getFunction         GetWorkflowFunc
 	listFunction        ListWorkflowFunc
 	listIdentifiersFunc ListIdentifiersFunc
+	deleteFunction      DeleteWorkflowFunc
 }
 
 func (r *MockWorkflowRepo) Create(ctx context.Context, input models.Workflow) error {
@@ -75,6 +77,17 @@ func (r *MockWorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces
 	return interfaces.WorkflowCollectionOutput{}, nil
 }
 
+func (r *MockWorkflowRepo) Delete(ctx context.Context, input interfaces.DeleteResourceInput) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(input)
+	}
+	return nil
+}
+
+func (r *MockWorkflowRepo) SetDeleteCallback(deleteFunction DeleteWorkflowFunc) {
+	r.deleteFunction = deleteFunction
+}
+
 func NewMockWorkflowRepo() interfaces.WorkflowRepoInterface {
 	return &MockWorkflowRepo{}
 }
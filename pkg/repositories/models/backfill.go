@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// A backfill of a scheduled launch plan. Backfills launch their executions in the background, recording their
+// progress so that it can be followed from any replica.
+type Backfill struct {
+	BaseModel
+	// The identifier handed back to the caller which requested the backfill.
+	Name              string `gorm:"primary_key"`
+	LaunchPlanProject string
+	LaunchPlanDomain  string
+	LaunchPlanName    string
+	LaunchPlanVersion string
+	StartTime         time.Time
+	EndTime           time.Time
+	// How many executions the backfill launches, one for every time the launch plan schedule fires.
+	Total     int
+	Succeeded int
+	Skipped   int
+	Failed    int
+	Done      bool
+	// The JSON serialized outcomes of the executions launched so far.
+	Results []byte
+}
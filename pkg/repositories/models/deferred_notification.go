@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// A notification held back by the quiet hours of its execution's project and domain until it's combined into a digest.
+// Deferred notifications are kept in the database so that they're still published when the process which deferred
+// them restarts, and so that every replica contributes to the same digest.
+type DeferredNotification struct {
+	BaseModel
+	Project string
+	Domain  string
+	// When the quiet window the notification was deferred by ends.
+	DueAt time.Time
+	// The serialized admin.EmailMessage.
+	Message []byte
+}
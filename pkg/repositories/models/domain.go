@@ -0,0 +1,8 @@
+package models
+
+// A domain registered at runtime, in addition to the domains configured for the server.
+type Domain struct {
+	BaseModel
+	Identifier string `gorm:"primary_key"`
+	Name       string
+}
@@ -37,6 +37,11 @@ type Execution struct {
 	// In the case of an aborted execution this string may be non-empty.
 	// It should be ignored for any other value of phase other than aborted.
 	AbortCause string
+	// The authenticated principal which terminated the execution through admin, if any.
+	AbortedBy string
+	// Set once the execution was terminated through admin, whose notifications of the abort are published then rather
+	// than once the ABORTED event is received.
+	AbortedAt *time.Time
 	// Corresponds to the execution mode used to trigger this execution
 	Mode int32
 	// The "parent" execution (if there is one) that is related to this execution.
@@ -51,4 +56,21 @@ type Execution struct {
 	InputsURI storage.DataReference
 	// User specified inputs. This map might be incomplete and not include defaults applied
 	UserInputsURI storage.DataReference
+	// Incremented each time an event transitions this execution, giving events applied to it a monotonic ordering.
+	EventVersion uint32
+	// Why the execution didn't succeed (USER, SYSTEM, ABORTED or TIMEOUT), empty otherwise.
+	// The execution closure has no corresponding field, so this is only exposed as a list filter.
+	FailureClassification string `gorm:"index"`
+	// How sensitive the execution inputs and outputs are (public, internal or restricted).
+	DataClassification string `gorm:"index"`
+	// Set once the retention policy for the execution domain has removed its offloaded inputs and outputs.
+	DataRemovedAt *time.Time `gorm:"index"`
+	// Set once the events of the execution have been compacted, see ExecutionEventSummary.
+	EventsCompactedAt *time.Time `gorm:"index"`
+	// The W3C traceparent the execution was created with, if any, so it can be joined to the original trace.
+	TraceParent string
+	// Extension metadata requested at launch. These are persisted separately, see ExecutionExtension.
+	Extensions []ExecutionExtension `gorm:"-"`
+	// Tags requested at launch or set since. These are persisted separately, see ExecutionTag.
+	Tags []ExecutionTag `gorm:"-"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Summarizes the events of an execution which were compacted, i.e. removed from the event tables once the execution
+// had been terminated for longer than the configured compaction age.
+type ExecutionEventSummary struct {
+	BaseModel
+	ExecutionKey
+	// Events created before this time were compacted.
+	CompactedAt time.Time
+	// How many execution and node execution events were removed.
+	EventCount int
+	// JSON serialized util.ExecutionEventSummary with the events preserved.
+	Summary []byte
+}
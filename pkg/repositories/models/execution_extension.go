@@ -0,0 +1,9 @@
+package models
+
+// Opaque key-value metadata attached to an execution at launch, e.g. ticket or experiment IDs.
+type ExecutionExtension struct {
+	BaseModel
+	ExecutionKey
+	Key   string `gorm:"primary_key"`
+	Value string
+}
@@ -0,0 +1,8 @@
+package models
+
+// A free-form tag attached to an execution to organize executions, e.g. by experiment or owning team.
+type ExecutionTag struct {
+	BaseModel
+	ExecutionKey
+	Name string `gorm:"primary_key;index"`
+}
@@ -32,4 +32,7 @@ type LaunchPlan struct {
 	// Hash of the launch plan
 	Digest       []byte
 	ScheduleType LaunchPlanScheduleType
+	// Whether the schedule of the active version is paused. GORM doesn't save the zero value for ints, so we use a
+	// pointer for the ScheduleState field too.
+	ScheduleState *int32 `gorm:"default:0"`
 }
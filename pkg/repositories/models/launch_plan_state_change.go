@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// A transition of a launch plan version to the ACTIVE or INACTIVE state, kept as the audit history of the launch plan.
+type LaunchPlanStateChange struct {
+	BaseModel
+	Project string `gorm:"index:lp_state_change_project_domain_name_idx"`
+	Domain  string `gorm:"index:lp_state_change_project_domain_name_idx"`
+	Name    string `gorm:"index:lp_state_change_project_domain_name_idx"`
+	Version string
+	State   int32
+	// The email of the authenticated user who changed the state, empty when the request wasn't authenticated.
+	Principal  string
+	OccurredAt time.Time
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 )
 
@@ -15,6 +17,8 @@ type NamedEntityMetadataKey struct {
 // Fields to be composed into any named entity
 type NamedEntityMetadataFields struct {
 	Description string `gorm:"type:varchar(300)"`
+	// GORM doesn't save the zero value for ints, so we use a pointer for the State field
+	State *int32 `gorm:"default:0"`
 }
 
 // Database model to encapsulate metadata associated with a NamedEntity
@@ -38,3 +42,12 @@ type NamedEntity struct {
 	NamedEntityKey
 	NamedEntityMetadataFields
 }
+
+// Aggregates the executions launched from a named entity.
+type NamedEntityUsage struct {
+	NamedEntityKey
+	// Nil when the named entity was never executed.
+	LastExecutedAt *time.Time
+	// The number of executions created since the start of the window the usage was aggregated over.
+	ExecutionCount int64
+}
@@ -31,6 +31,25 @@ type NodeExecution struct {
 	NodeExecutionEvents    []NodeExecutionEvent
 	// The task execution (if any) which launched this node execution.
 	ParentTaskExecutionID uint `sql:"default:null" gorm:"index"`
+	// The node execution (if any) whose task launched this node execution, e.g. the parent of a dynamic node's children.
+	ParentID uint `sql:"default:null" gorm:"index"`
+	// The location of the closure (if any) offloaded to blob storage for exceeding the configured size, in which case
+	// Closure only holds its phase and timestamps.
+	RemoteClosureReference string
+	// The location of the offloaded workflow closure (if any) which this node execution's task compiled at runtime.
+	DynamicWorkflowRemoteClosureReference string
+	// Also stored in the cache metadata, but defined as a separate column because it's useful for filtering.
+	CacheStatus string `gorm:"index"`
+	// Serialized catalog cache information (if any) reported for this node execution's task.
+	CacheMetadata []byte
+	// Serialized auxiliary artifacts (if any) registered by this node execution's task.
+	Artifacts []byte
+	// The identifier of the sub-workflow (if any) which this node execution's node belongs to, either one nested in the
+	// execution's workflow or, for executions launched by launch plan nodes, the execution's own workflow.
+	SubWorkflowProject string
+	SubWorkflowDomain  string
+	SubWorkflowName    string `gorm:"index"`
+	SubWorkflowVersion string
 	// The workflow execution (if any) which this node execution launched
 	LaunchedExecution Execution `gorm:"foreignkey:ParentNodeExecutionID"`
 }
@@ -0,0 +1,9 @@
+package models
+
+// How many children of a parent node execution, e.g. the nodes yielded by a dynamic task, are in a phase. Counts are
+// kept up to date as children report events so that progress can be shown without listing every child.
+type NodeExecutionChildPhaseCount struct {
+	ParentID uint   `gorm:"primary_key;AUTO_INCREMENT:FALSE"`
+	Phase    string `gorm:"primary_key"`
+	Count    int64
+}
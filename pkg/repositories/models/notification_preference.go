@@ -0,0 +1,18 @@
+package models
+
+// A user's preference for the failure notifications of a launch plan, applying to every version of the launch plan.
+type NotificationPreference struct {
+	BaseModel
+	// The email of the authenticated user the preference belongs to.
+	UserEmail string `gorm:"primary_key"`
+	Project   string `gorm:"primary_key;index:notification_preference_project_domain_name_idx"`
+	Domain    string `gorm:"primary_key;index:notification_preference_project_domain_name_idx"`
+	Name      string `gorm:"primary_key;index:notification_preference_project_domain_name_idx"`
+	// Subscribed users are notified when the launch plan's executions fail, unsubscribed users are removed from the
+	// recipients of the notifications configured for the launch plan.
+	Subscribed bool
+	// The channel subscribed users are notified through.
+	Channel string
+	// The comma-separated terminal phases the user is notified of, failures and time outs when empty.
+	Phases string
+}
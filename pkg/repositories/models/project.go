@@ -5,4 +5,10 @@ type Project struct {
 	Identifier  string `gorm:"primary_key"`
 	Name        string // Human-readable name, not a unique identifier.
 	Description string `gorm:"type:varchar(300)"`
+	// Serialized progress of the onboarding pipeline run when the project was registered, if any.
+	OnboardingStatus []byte
+	// Serialized admin.Labels of the project.
+	Labels []byte
+	// GORM doesn't save the zero value for ints, so we use a pointer for the State field
+	State *int32 `gorm:"default:0;index"`
 }
@@ -7,4 +7,14 @@ type ProjectDomain struct {
 	Domain  string `gorm:"primary_key"`
 	// Key-value pairs of substitutable resource attributes.
 	Attributes []byte
+	// Changes whenever the attributes are updated. Versions are drawn from a database sequence so that later updates
+	// have greater versions no matter which replica made them.
+	Version int64 `gorm:"index"`
+}
+
+// Records the latest project-domain attributes version a cluster has applied.
+type ProjectDomainAttributesAck struct {
+	BaseModel
+	Cluster string `gorm:"primary_key"`
+	Version int64
 }
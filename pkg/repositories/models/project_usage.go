@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Aggregates the (workflow or node) executions of a project and domain created within a window.
+type ProjectUsage struct {
+	Project string
+	Domain  string
+	// The number of executions aggregated.
+	ExecutionCount int64
+	// The total duration of the executions aggregated.
+	Duration time.Duration
+}
@@ -0,0 +1,27 @@
+package models
+
+// The levels matchable attributes apply at. Attributes applying at a more specific level have a higher priority and
+// override those applying at less specific ones.
+type ResourcePriority int32
+
+const (
+	// Only feature flags can be set for a domain across all projects.
+	ResourcePriorityDomainLevel        ResourcePriority = 3
+	ResourcePriorityProjectLevel       ResourcePriority = 5
+	ResourcePriorityProjectDomainLevel ResourcePriority = 10
+	ResourcePriorityWorkflowLevel      ResourcePriority = 15
+)
+
+// Represents the matchable attributes of a resource type which apply to a project, to a domain of the project or to a
+// workflow in them. The levels below the one the attributes apply at are left empty, as is the project of attributes
+// applying to a domain across all projects.
+type Resource struct {
+	BaseModel
+	Project      string `gorm:"unique_index:resource_idx"`
+	Domain       string `gorm:"unique_index:resource_idx"`
+	Workflow     string `gorm:"unique_index:resource_idx"`
+	ResourceType int32  `gorm:"unique_index:resource_idx"`
+	Priority     int32
+	// Serialized attributes.
+	Attributes []byte
+}
@@ -0,0 +1,14 @@
+package models
+
+// A notification held back by a channel's rate limit until it's combined into a digest. Spilled notifications are kept
+// in the database so that they're still published when the process which spilled them restarts.
+type SpilledNotification struct {
+	BaseModel
+	// The key the notification was published with, e.g. flyteidl.admin.EmailNotification.
+	NotificationType string `gorm:"index"`
+	// The project/domain/name of the launch plan the notification was held back for by the per-recipient rate limit,
+	// empty when it was held back by its channel's rate limit.
+	LaunchPlan string
+	// The serialized admin.EmailMessage.
+	Message []byte
+}
@@ -34,6 +34,22 @@ type TaskExecution struct {
 	// the execution was UpdatedAt, not to be confused with gorm.Model.UpdatedAt
 	TaskExecutionUpdatedAt *time.Time
 	Duration               time.Duration
+	// Locations of the outputs and error document (if any) written by this retry attempt.
+	OutputURI string
+	ErrorURI  string
+	// Resource usage reported in the custom info of the task execution's events, nil until reported.
+	MaxMemoryBytes *int64
+	CPUSeconds     *float64
+	GPUUtilization *float64
+	// The latest checkpoint reported by this retry attempt and the one it was able to resume from, which is the latest
+	// checkpoint of the attempts before it.
+	CheckpointURI         string
+	PreviousCheckpointURI string
+	// The environment this retry attempt ran in, derived from the compiled task unless reported by its events. Resources
+	// holds the serialized core.Resources.
+	ContainerImage string
+	Resources      []byte
+	Queue          string
 	// The child node executions (if any) launched by this task execution.
 	ChildNodeExecution []NodeExecution `gorm:"foreignkey:ParentTaskExecutionID"`
 }
@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"context"
+
 	"github.com/jinzhu/gorm"
 	"github.com/lyft/flyteadmin/pkg/repositories/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/gormimpl"
@@ -9,6 +11,8 @@ import (
 )
 
 type PostgresRepo struct {
+	db                *gorm.DB
+	errorTransformer  errors.ErrorTransformer
 	executionRepo     interfaces.ExecutionRepoInterface
 	namedEntityRepo   interfaces.NamedEntityRepoInterface
 	launchPlanRepo    interfaces.LaunchPlanRepoInterface
@@ -18,6 +22,13 @@ type PostgresRepo struct {
 	taskRepo          interfaces.TaskRepoInterface
 	taskExecutionRepo interfaces.TaskExecutionRepoInterface
 	workflowRepo      interfaces.WorkflowRepoInterface
+	domainRepo        interfaces.DomainRepoInterface
+	resourceRepo      interfaces.ResourceRepoInterface
+
+	notificationPreferenceRepo interfaces.NotificationPreferenceRepoInterface
+	spilledNotificationRepo    interfaces.SpilledNotificationRepoInterface
+	backfillRepo               interfaces.BackfillRepoInterface
+	deferredNotificationRepo   interfaces.DeferredNotificationRepoInterface
 }
 
 func (p *PostgresRepo) ExecutionRepo() interfaces.ExecutionRepoInterface {
@@ -56,8 +67,42 @@ func (p *PostgresRepo) WorkflowRepo() interfaces.WorkflowRepoInterface {
 	return p.workflowRepo
 }
 
+func (p *PostgresRepo) NotificationPreferenceRepo() interfaces.NotificationPreferenceRepoInterface {
+	return p.notificationPreferenceRepo
+}
+
+func (p *PostgresRepo) DomainRepo() interfaces.DomainRepoInterface {
+	return p.domainRepo
+}
+
+func (p *PostgresRepo) ResourceRepo() interfaces.ResourceRepoInterface {
+	return p.resourceRepo
+}
+
+func (p *PostgresRepo) SpilledNotificationRepo() interfaces.SpilledNotificationRepoInterface {
+	return p.spilledNotificationRepo
+}
+
+func (p *PostgresRepo) BackfillRepo() interfaces.BackfillRepoInterface {
+	return p.backfillRepo
+}
+
+func (p *PostgresRepo) DeferredNotificationRepo() interfaces.DeferredNotificationRepoInterface {
+	return p.deferredNotificationRepo
+}
+
+func (p *PostgresRepo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return gormimpl.RunInTransaction(ctx, p.db, p.errorTransformer, fn)
+}
+
+func (p *PostgresRepo) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	gormimpl.AfterCommit(ctx, fn)
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) RepositoryInterface {
 	return &PostgresRepo{
+		db:                db,
+		errorTransformer:  errorTransformer,
 		executionRepo:     gormimpl.NewExecutionRepo(db, errorTransformer, scope.NewSubScope("executions")),
 		launchPlanRepo:    gormimpl.NewLaunchPlanRepo(db, errorTransformer, scope.NewSubScope("launch_plans")),
 		projectRepo:       gormimpl.NewProjectRepo(db, errorTransformer, scope.NewSubScope("project")),
@@ -67,5 +112,14 @@ func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scop
 		taskRepo:          gormimpl.NewTaskRepo(db, errorTransformer, scope.NewSubScope("tasks")),
 		taskExecutionRepo: gormimpl.NewTaskExecutionRepo(db, errorTransformer, scope.NewSubScope("task_executions")),
 		workflowRepo:      gormimpl.NewWorkflowRepo(db, errorTransformer, scope.NewSubScope("workflows")),
+		domainRepo:        NewCachedDomainRepo(gormimpl.NewDomainRepo(db, errorTransformer, scope.NewSubScope("domains"))),
+		resourceRepo:      gormimpl.NewResourceRepo(db, errorTransformer, scope.NewSubScope("resources")),
+		notificationPreferenceRepo: gormimpl.NewNotificationPreferenceRepo(
+			db, errorTransformer, scope.NewSubScope("notification_preferences")),
+		spilledNotificationRepo: gormimpl.NewSpilledNotificationRepo(
+			db, errorTransformer, scope.NewSubScope("spilled_notifications")),
+		backfillRepo: gormimpl.NewBackfillRepo(db, errorTransformer, scope.NewSubScope("backfills")),
+		deferredNotificationRepo: gormimpl.NewDeferredNotificationRepo(
+			db, errorTransformer, scope.NewSubScope("deferred_notifications")),
 	}
 }
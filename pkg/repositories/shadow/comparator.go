@@ -0,0 +1,161 @@
+package shadow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"time"
+
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+const defaultTimeout = 5 * time.Second
+
+type transactionContextKey struct{}
+
+type comparatorMetrics struct {
+	Scope        promutils.Scope
+	Comparisons  *prometheus.CounterVec
+	Mismatches   *prometheus.CounterVec
+	ShadowErrors *prometheus.CounterVec
+	Skipped      prometheus.Counter
+	TimedOut     prometheus.Counter
+	Panics       prometheus.Counter
+}
+
+type readResult struct {
+	value interface{}
+	err   error
+}
+
+// Repeats sampled reads against the shadow repositories and compares their results with the primary's.
+type comparator struct {
+	samplePercentage int
+	timeout          time.Duration
+	inFlight         chan struct{}
+	metrics          comparatorMetrics
+}
+
+// Returns the canonical form results are compared by. Results read from different databases may differ in how their
+// timestamps are represented, e.g. their location, so they're compared by their JSON encoding rather than their values.
+func encode(result readResult) (string, error) {
+	if result.err != nil {
+		code := codes.Unknown
+		if adminErr, ok := result.err.(errors.FlyteAdminError); ok {
+			code = adminErr.Code()
+		}
+		return fmt.Sprintf("error with code %s", code), nil
+	}
+	encoded, err := json.Marshal(result.value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func (c *comparator) sampled() bool {
+	return c.samplePercentage >= 100 || rand.Intn(100) < c.samplePercentage
+}
+
+// Starts reading from the shadow repository, unless the read isn't sampled, and returns the func the primary result
+// must be reported to. The shadow read runs alongside the primary one and never delays it: the primary result is
+// compared with once both reads are done.
+func (c *comparator) compare(
+	ctx context.Context, operation string, shadowRead func(ctx context.Context) (interface{}, error)) func(
+	value interface{}, err error) {
+	// Reads within a transaction may observe its uncommitted writes, which the shadow database never sees.
+	if ctx.Value(transactionContextKey{}) != nil || !c.sampled() {
+		return func(value interface{}, err error) {}
+	}
+	select {
+	case c.inFlight <- struct{}{}:
+	default:
+		c.metrics.Skipped.Inc()
+		return func(value interface{}, err error) {}
+	}
+	// The shadow read outlives the request it was sampled from.
+	shadowCtx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	primaryResults := make(chan readResult, 1)
+	go func() {
+		defer func() {
+			cancel()
+			<-c.inFlight
+			if err := recover(); err != nil {
+				c.metrics.Panics.Inc()
+				logger.Errorf(ctx, "caught panic in shadow read [%s]: %v [%+v]", operation, err, string(debug.Stack()))
+			}
+		}()
+		value, err := shadowRead(shadowCtx)
+		shadowResult := readResult{value: value, err: err}
+		select {
+		case primaryResult := <-primaryResults:
+			c.compareResults(ctx, operation, primaryResult, shadowResult)
+		case <-shadowCtx.Done():
+			c.metrics.TimedOut.Inc()
+			logger.Debugf(ctx, "Timed out waiting on the primary result of shadow read [%s]", operation)
+		}
+	}()
+	return func(value interface{}, err error) {
+		primaryResults <- readResult{value: value, err: err}
+	}
+}
+
+func (c *comparator) compareResults(ctx context.Context, operation string, primary, shadow readResult) {
+	c.metrics.Comparisons.WithLabelValues(operation).Inc()
+	if shadow.err != nil && primary.err == nil {
+		c.metrics.ShadowErrors.WithLabelValues(operation).Inc()
+		logger.Warningf(ctx, "Shadow read [%s] failed with err: %v", operation, shadow.err)
+		return
+	}
+	primaryEncoded, err := encode(primary)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to encode primary result of [%s] for comparison with err: %v", operation, err)
+		return
+	}
+	shadowEncoded, err := encode(shadow)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to encode shadow result of [%s] for comparison with err: %v", operation, err)
+		return
+	}
+	if primaryEncoded != shadowEncoded {
+		c.metrics.Mismatches.WithLabelValues(operation).Inc()
+		logger.Warningf(ctx, "Shadow read [%s] returned [%s] which doesn't match the primary result [%s]",
+			operation, shadowEncoded, primaryEncoded)
+	}
+}
+
+func newComparator(samplePercentage, maxInFlight int, timeout time.Duration, scope promutils.Scope) *comparator {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if maxInFlight <= 0 {
+		maxInFlight = 1
+	}
+	return &comparator{
+		samplePercentage: samplePercentage,
+		timeout:          timeout,
+		inFlight:         make(chan struct{}, maxInFlight),
+		metrics: comparatorMetrics{
+			Scope: scope,
+			Comparisons: scope.MustNewCounterVec("comparisons",
+				"overall count of shadow read results compared with the primary's", "operation"),
+			Mismatches: scope.MustNewCounterVec("mismatches",
+				"overall count of shadow read results which didn't match the primary's", "operation"),
+			ShadowErrors: scope.MustNewCounterVec("shadow_errors",
+				"overall count of shadow reads which failed where the primary read succeeded", "operation"),
+			Skipped: scope.MustNewCounter("skipped",
+				"overall count of sampled reads which weren't repeated because too many shadow reads were in flight"),
+			TimedOut: scope.MustNewCounter("timed_out",
+				"overall count of shadow reads which timed out waiting on the primary result"),
+			Panics: scope.MustNewCounter("panics",
+				"overall count of panics encountered in shadow reads"),
+		},
+	}
+}
@@ -0,0 +1,462 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+// Each repo embeds the primary repo, which serves its writes as is, and repeats its reads against the shadow repo.
+
+type executionRepo struct {
+	interfaces.ExecutionRepoInterface
+	shadow     interfaces.ExecutionRepoInterface
+	comparator *comparator
+}
+
+func (r *executionRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+	report := r.comparator.compare(ctx, "executions.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	execution, err := r.ExecutionRepoInterface.Get(ctx, input)
+	report(execution, err)
+	return execution, err
+}
+
+func (r *executionRepo) GetByID(ctx context.Context, id uint) (models.Execution, error) {
+	report := r.comparator.compare(ctx, "executions.get_by_id", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.GetByID(ctx, id)
+	})
+	execution, err := r.ExecutionRepoInterface.GetByID(ctx, id)
+	report(execution, err)
+	return execution, err
+}
+
+func (r *executionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "executions.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	output, err := r.ExecutionRepoInterface.List(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *executionRepo) ListEvents(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionEventCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "executions.list_events", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListEvents(ctx, input)
+	})
+	output, err := r.ExecutionRepoInterface.ListEvents(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *executionRepo) GetEventSummary(
+	ctx context.Context, key models.ExecutionKey) (models.ExecutionEventSummary, error) {
+	report := r.comparator.compare(ctx, "executions.get_event_summary", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.GetEventSummary(ctx, key)
+	})
+	summary, err := r.ExecutionRepoInterface.GetEventSummary(ctx, key)
+	report(summary, err)
+	return summary, err
+}
+
+func (r *executionRepo) ListProjectUsage(
+	ctx context.Context, input interfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+	report := r.comparator.compare(ctx, "executions.list_project_usage", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListProjectUsage(ctx, input)
+	})
+	usage, err := r.ExecutionRepoInterface.ListProjectUsage(ctx, input)
+	report(usage, err)
+	return usage, err
+}
+
+type launchPlanRepo struct {
+	interfaces.LaunchPlanRepoInterface
+	shadow     interfaces.LaunchPlanRepoInterface
+	comparator *comparator
+}
+
+func (r *launchPlanRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.LaunchPlan, error) {
+	report := r.comparator.compare(ctx, "launch_plans.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	launchPlan, err := r.LaunchPlanRepoInterface.Get(ctx, input)
+	report(launchPlan, err)
+	return launchPlan, err
+}
+
+func (r *launchPlanRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "launch_plans.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	output, err := r.LaunchPlanRepoInterface.List(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *launchPlanRepo) ListLaunchPlanIdentifiers(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "launch_plans.list_identifiers", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListLaunchPlanIdentifiers(ctx, input)
+	})
+	output, err := r.LaunchPlanRepoInterface.ListLaunchPlanIdentifiers(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *launchPlanRepo) ListStateChanges(
+	ctx context.Context, input interfaces.ListStateChangesInput) ([]models.LaunchPlanStateChange, error) {
+	report := r.comparator.compare(ctx, "launch_plans.list_state_changes", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListStateChanges(ctx, input)
+	})
+	changes, err := r.LaunchPlanRepoInterface.ListStateChanges(ctx, input)
+	report(changes, err)
+	return changes, err
+}
+
+type namedEntityRepo struct {
+	interfaces.NamedEntityRepoInterface
+	shadow     interfaces.NamedEntityRepoInterface
+	comparator *comparator
+}
+
+func (r *namedEntityRepo) Get(ctx context.Context, input interfaces.GetNamedEntityInput) (models.NamedEntity, error) {
+	report := r.comparator.compare(ctx, "named_entities.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	namedEntity, err := r.NamedEntityRepoInterface.Get(ctx, input)
+	report(namedEntity, err)
+	return namedEntity, err
+}
+
+func (r *namedEntityRepo) List(ctx context.Context, resourceType core.ResourceType,
+	input interfaces.ListResourceInput) (interfaces.NamedEntityCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "named_entities.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, resourceType, input)
+	})
+	output, err := r.NamedEntityRepoInterface.List(ctx, resourceType, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *namedEntityRepo) ListUsage(ctx context.Context, resourceType core.ResourceType,
+	input interfaces.ListNamedEntityUsageInput) ([]models.NamedEntityUsage, error) {
+	report := r.comparator.compare(ctx, "named_entities.list_usage", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListUsage(ctx, resourceType, input)
+	})
+	usage, err := r.NamedEntityRepoInterface.ListUsage(ctx, resourceType, input)
+	report(usage, err)
+	return usage, err
+}
+
+func (r *namedEntityRepo) Search(ctx context.Context, input interfaces.SearchNamedEntitiesInput) (
+	interfaces.NamedEntityCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "named_entities.search", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Search(ctx, input)
+	})
+	output, err := r.NamedEntityRepoInterface.Search(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+type nodeExecutionRepo struct {
+	interfaces.NodeExecutionRepoInterface
+	shadow     interfaces.NodeExecutionRepoInterface
+	comparator *comparator
+}
+
+func (r *nodeExecutionRepo) Get(
+	ctx context.Context, input interfaces.GetNodeExecutionInput) (models.NodeExecution, error) {
+	report := r.comparator.compare(ctx, "node_executions.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	nodeExecution, err := r.NodeExecutionRepoInterface.Get(ctx, input)
+	report(nodeExecution, err)
+	return nodeExecution, err
+}
+
+func (r *nodeExecutionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.NodeExecutionCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "node_executions.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	output, err := r.NodeExecutionRepoInterface.List(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *nodeExecutionRepo) ListByTask(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.NodeExecutionCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "node_executions.list_by_task", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListByTask(ctx, input)
+	})
+	output, err := r.NodeExecutionRepoInterface.ListByTask(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *nodeExecutionRepo) ListEvents(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.NodeExecutionEventCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "node_executions.list_events", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListEvents(ctx, input)
+	})
+	output, err := r.NodeExecutionRepoInterface.ListEvents(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *nodeExecutionRepo) ListChildPhaseCounts(
+	ctx context.Context, parentID uint) ([]models.NodeExecutionChildPhaseCount, error) {
+	report := r.comparator.compare(ctx, "node_executions.list_child_phase_counts", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListChildPhaseCounts(ctx, parentID)
+	})
+	childPhaseCounts, err := r.NodeExecutionRepoInterface.ListChildPhaseCounts(ctx, parentID)
+	report(childPhaseCounts, err)
+	return childPhaseCounts, err
+}
+
+func (r *nodeExecutionRepo) ListProjectUsage(
+	ctx context.Context, input interfaces.ListProjectUsageInput) ([]models.ProjectUsage, error) {
+	report := r.comparator.compare(ctx, "node_executions.list_project_usage", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListProjectUsage(ctx, input)
+	})
+	usage, err := r.NodeExecutionRepoInterface.ListProjectUsage(ctx, input)
+	report(usage, err)
+	return usage, err
+}
+
+type projectRepo struct {
+	interfaces.ProjectRepoInterface
+	shadow     interfaces.ProjectRepoInterface
+	comparator *comparator
+}
+
+func (r *projectRepo) Get(ctx context.Context, projectID string) (models.Project, error) {
+	report := r.comparator.compare(ctx, "projects.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, projectID)
+	})
+	project, err := r.ProjectRepoInterface.Get(ctx, projectID)
+	report(project, err)
+	return project, err
+}
+
+func (r *projectRepo) ListAll(ctx context.Context, sortParameter common.SortParameter) ([]models.Project, error) {
+	report := r.comparator.compare(ctx, "projects.list_all", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListAll(ctx, sortParameter)
+	})
+	projects, err := r.ProjectRepoInterface.ListAll(ctx, sortParameter)
+	report(projects, err)
+	return projects, err
+}
+
+func (r *projectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
+	report := r.comparator.compare(ctx, "projects.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	projects, err := r.ProjectRepoInterface.List(ctx, input)
+	report(projects, err)
+	return projects, err
+}
+
+type projectDomainRepo struct {
+	interfaces.ProjectDomainRepoInterface
+	shadow     interfaces.ProjectDomainRepoInterface
+	comparator *comparator
+}
+
+func (r *projectDomainRepo) Get(ctx context.Context, project, domain string) (models.ProjectDomain, error) {
+	report := r.comparator.compare(ctx, "project_domains.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, project, domain)
+	})
+	projectDomain, err := r.ProjectDomainRepoInterface.Get(ctx, project, domain)
+	report(projectDomain, err)
+	return projectDomain, err
+}
+
+func (r *projectDomainRepo) ListUpdatedSince(ctx context.Context, version int64) ([]models.ProjectDomain, error) {
+	report := r.comparator.compare(ctx, "project_domains.list_updated_since", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListUpdatedSince(ctx, version)
+	})
+	projectDomains, err := r.ProjectDomainRepoInterface.ListUpdatedSince(ctx, version)
+	report(projectDomains, err)
+	return projectDomains, err
+}
+
+func (r *projectDomainRepo) ListAcks(ctx context.Context) ([]models.ProjectDomainAttributesAck, error) {
+	report := r.comparator.compare(ctx, "project_domains.list_acks", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListAcks(ctx)
+	})
+	acks, err := r.ProjectDomainRepoInterface.ListAcks(ctx)
+	report(acks, err)
+	return acks, err
+}
+
+type taskRepo struct {
+	interfaces.TaskRepoInterface
+	shadow     interfaces.TaskRepoInterface
+	comparator *comparator
+}
+
+func (r *taskRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Task, error) {
+	report := r.comparator.compare(ctx, "tasks.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	task, err := r.TaskRepoInterface.Get(ctx, input)
+	report(task, err)
+	return task, err
+}
+
+func (r *taskRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "tasks.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	output, err := r.TaskRepoInterface.List(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *taskRepo) ListTaskIdentifiers(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "tasks.list_identifiers", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListTaskIdentifiers(ctx, input)
+	})
+	output, err := r.TaskRepoInterface.ListTaskIdentifiers(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+type taskExecutionRepo struct {
+	interfaces.TaskExecutionRepoInterface
+	shadow     interfaces.TaskExecutionRepoInterface
+	comparator *comparator
+}
+
+func (r *taskExecutionRepo) Get(
+	ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+	report := r.comparator.compare(ctx, "task_executions.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	taskExecution, err := r.TaskExecutionRepoInterface.Get(ctx, input)
+	report(taskExecution, err)
+	return taskExecution, err
+}
+
+func (r *taskExecutionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "task_executions.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	output, err := r.TaskExecutionRepoInterface.List(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+type workflowRepo struct {
+	interfaces.WorkflowRepoInterface
+	shadow     interfaces.WorkflowRepoInterface
+	comparator *comparator
+}
+
+func (r *workflowRepo) Get(ctx context.Context, input interfaces.GetResourceInput) (models.Workflow, error) {
+	report := r.comparator.compare(ctx, "workflows.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, input)
+	})
+	workflow, err := r.WorkflowRepoInterface.Get(ctx, input)
+	report(workflow, err)
+	return workflow, err
+}
+
+func (r *workflowRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "workflows.list", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.List(ctx, input)
+	})
+	output, err := r.WorkflowRepoInterface.List(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+func (r *workflowRepo) ListIdentifiers(
+	ctx context.Context, input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error) {
+	report := r.comparator.compare(ctx, "workflows.list_identifiers", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListIdentifiers(ctx, input)
+	})
+	output, err := r.WorkflowRepoInterface.ListIdentifiers(ctx, input)
+	report(output, err)
+	return output, err
+}
+
+type notificationPreferenceRepo struct {
+	interfaces.NotificationPreferenceRepoInterface
+	shadow     interfaces.NotificationPreferenceRepoInterface
+	comparator *comparator
+}
+
+func (r *notificationPreferenceRepo) ListForUser(
+	ctx context.Context, userEmail string) ([]models.NotificationPreference, error) {
+	report := r.comparator.compare(ctx, "notification_preferences.list_for_user", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListForUser(ctx, userEmail)
+	})
+	preferences, err := r.NotificationPreferenceRepoInterface.ListForUser(ctx, userEmail)
+	report(preferences, err)
+	return preferences, err
+}
+
+func (r *notificationPreferenceRepo) ListForLaunchPlan(
+	ctx context.Context, project, domain, name string) ([]models.NotificationPreference, error) {
+	report := r.comparator.compare(ctx, "notification_preferences.list_for_launch_plan", func(ctx context.Context) (
+		interface{}, error) {
+		return r.shadow.ListForLaunchPlan(ctx, project, domain, name)
+	})
+	preferences, err := r.NotificationPreferenceRepoInterface.ListForLaunchPlan(ctx, project, domain, name)
+	report(preferences, err)
+	return preferences, err
+}
+
+type resourceRepo struct {
+	interfaces.ResourceRepoInterface
+	shadow     interfaces.ResourceRepoInterface
+	comparator *comparator
+}
+
+func (r *resourceRepo) Get(ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+	report := r.comparator.compare(ctx, "resources.get", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.Get(ctx, id)
+	})
+	resource, err := r.ResourceRepoInterface.Get(ctx, id)
+	report(resource, err)
+	return resource, err
+}
+
+func (r *resourceRepo) GetRaw(ctx context.Context, id interfaces.ResourceID) (models.Resource, error) {
+	report := r.comparator.compare(ctx, "resources.get_raw", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.GetRaw(ctx, id)
+	})
+	resource, err := r.ResourceRepoInterface.GetRaw(ctx, id)
+	report(resource, err)
+	return resource, err
+}
+
+func (r *resourceRepo) ListAll(ctx context.Context, resourceType int32) ([]models.Resource, error) {
+	report := r.comparator.compare(ctx, "resources.list_all", func(ctx context.Context) (interface{}, error) {
+		return r.shadow.ListAll(ctx, resourceType)
+	})
+	resources, err := r.ResourceRepoInterface.ListAll(ctx, resourceType)
+	report(resources, err)
+	return resources, err
+}
@@ -0,0 +1,154 @@
+// Shadow reads validate an alternate repository implementation, e.g. one with a new pagination scheme or backed by a
+// new database engine, with production traffic: reads are served by the primary repository and repeated against the
+// shadow one, whose results are compared with the primary's and logged but never returned.
+package shadow
+
+import (
+	"context"
+	"time"
+
+	"github.com/lyft/flytestdlib/promutils"
+
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+)
+
+type Config struct {
+	// Percentage of reads repeated against the shadow repository.
+	SamplePercentage int
+	// Limits the shadow reads in flight at once.
+	MaxInFlight int
+	// How long a shadow read may take, including waiting on the primary read it's compared with.
+	Timeout time.Duration
+}
+
+// Only reads are repeated, writes (and transactions) go to the primary repository alone.
+type Repository struct {
+	primary           repositories.RepositoryInterface
+	executionRepo     interfaces.ExecutionRepoInterface
+	launchPlanRepo    interfaces.LaunchPlanRepoInterface
+	namedEntityRepo   interfaces.NamedEntityRepoInterface
+	nodeExecutionRepo interfaces.NodeExecutionRepoInterface
+	projectRepo       interfaces.ProjectRepoInterface
+	projectDomainRepo interfaces.ProjectDomainRepoInterface
+	taskRepo          interfaces.TaskRepoInterface
+	taskExecutionRepo interfaces.TaskExecutionRepoInterface
+	workflowRepo      interfaces.WorkflowRepoInterface
+	domainRepo        interfaces.DomainRepoInterface
+	resourceRepo      interfaces.ResourceRepoInterface
+
+	notificationPreferenceRepo interfaces.NotificationPreferenceRepoInterface
+	spilledNotificationRepo    interfaces.SpilledNotificationRepoInterface
+	backfillRepo               interfaces.BackfillRepoInterface
+	deferredNotificationRepo   interfaces.DeferredNotificationRepoInterface
+}
+
+func (r *Repository) ExecutionRepo() interfaces.ExecutionRepoInterface {
+	return r.executionRepo
+}
+
+func (r *Repository) LaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
+	return r.launchPlanRepo
+}
+
+func (r *Repository) NamedEntityRepo() interfaces.NamedEntityRepoInterface {
+	return r.namedEntityRepo
+}
+
+func (r *Repository) ProjectRepo() interfaces.ProjectRepoInterface {
+	return r.projectRepo
+}
+
+func (r *Repository) ProjectDomainRepo() interfaces.ProjectDomainRepoInterface {
+	return r.projectDomainRepo
+}
+
+func (r *Repository) NodeExecutionRepo() interfaces.NodeExecutionRepoInterface {
+	return r.nodeExecutionRepo
+}
+
+func (r *Repository) TaskRepo() interfaces.TaskRepoInterface {
+	return r.taskRepo
+}
+
+func (r *Repository) TaskExecutionRepo() interfaces.TaskExecutionRepoInterface {
+	return r.taskExecutionRepo
+}
+
+func (r *Repository) WorkflowRepo() interfaces.WorkflowRepoInterface {
+	return r.workflowRepo
+}
+
+func (r *Repository) NotificationPreferenceRepo() interfaces.NotificationPreferenceRepoInterface {
+	return r.notificationPreferenceRepo
+}
+
+func (r *Repository) DomainRepo() interfaces.DomainRepoInterface {
+	return r.domainRepo
+}
+
+func (r *Repository) ResourceRepo() interfaces.ResourceRepoInterface {
+	return r.resourceRepo
+}
+
+func (r *Repository) SpilledNotificationRepo() interfaces.SpilledNotificationRepoInterface {
+	return r.spilledNotificationRepo
+}
+
+func (r *Repository) BackfillRepo() interfaces.BackfillRepoInterface {
+	return r.backfillRepo
+}
+
+func (r *Repository) DeferredNotificationRepo() interfaces.DeferredNotificationRepoInterface {
+	return r.deferredNotificationRepo
+}
+
+// Reads within the transaction aren't repeated against the shadow repository.
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.primary.Transaction(ctx, func(ctx context.Context) error {
+		return fn(context.WithValue(ctx, transactionContextKey{}, true))
+	})
+}
+
+func (r *Repository) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	r.primary.AfterCommit(ctx, fn)
+}
+
+func NewRepository(
+	primary, shadow repositories.RepositoryInterface, config Config, scope promutils.Scope) repositories.RepositoryInterface {
+	c := newComparator(config.SamplePercentage, config.MaxInFlight, config.Timeout, scope)
+	return &Repository{
+		primary: primary,
+		executionRepo: &executionRepo{
+			ExecutionRepoInterface: primary.ExecutionRepo(), shadow: shadow.ExecutionRepo(), comparator: c},
+		launchPlanRepo: &launchPlanRepo{
+			LaunchPlanRepoInterface: primary.LaunchPlanRepo(), shadow: shadow.LaunchPlanRepo(), comparator: c},
+		namedEntityRepo: &namedEntityRepo{
+			NamedEntityRepoInterface: primary.NamedEntityRepo(), shadow: shadow.NamedEntityRepo(), comparator: c},
+		nodeExecutionRepo: &nodeExecutionRepo{
+			NodeExecutionRepoInterface: primary.NodeExecutionRepo(), shadow: shadow.NodeExecutionRepo(), comparator: c},
+		projectRepo: &projectRepo{
+			ProjectRepoInterface: primary.ProjectRepo(), shadow: shadow.ProjectRepo(), comparator: c},
+		projectDomainRepo: &projectDomainRepo{
+			ProjectDomainRepoInterface: primary.ProjectDomainRepo(), shadow: shadow.ProjectDomainRepo(), comparator: c},
+		taskRepo: &taskRepo{
+			TaskRepoInterface: primary.TaskRepo(), shadow: shadow.TaskRepo(), comparator: c},
+		taskExecutionRepo: &taskExecutionRepo{
+			TaskExecutionRepoInterface: primary.TaskExecutionRepo(), shadow: shadow.TaskExecutionRepo(), comparator: c},
+		workflowRepo: &workflowRepo{
+			WorkflowRepoInterface: primary.WorkflowRepo(), shadow: shadow.WorkflowRepo(), comparator: c},
+		notificationPreferenceRepo: &notificationPreferenceRepo{
+			NotificationPreferenceRepoInterface: primary.NotificationPreferenceRepo(),
+			shadow:                              shadow.NotificationPreferenceRepo(), comparator: c},
+		resourceRepo: &resourceRepo{
+			ResourceRepoInterface: primary.ResourceRepo(), shadow: shadow.ResourceRepo(), comparator: c},
+		// Domain listings are mostly served from a cache, so comparing them tells little about the shadow database.
+		domainRepo: primary.DomainRepo(),
+		// Spilled notifications are only read to be published, locking them, so the reads can't be repeated.
+		spilledNotificationRepo: primary.SpilledNotificationRepo(),
+		// Backfills are read to follow the progress of one running in the background, which the shadow database lags.
+		backfillRepo: primary.BackfillRepo(),
+		// Like spilled notifications, deferred notifications are only read to be published.
+		deferredNotificationRepo: primary.DeferredNotificationRepo(),
+	}
+}
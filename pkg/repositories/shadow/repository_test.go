@@ -0,0 +1,146 @@
+package shadow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+var getInput = interfaces.GetResourceInput{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func getCount(t *testing.T, c *comparator, operation string) (comparisons, mismatches float64) {
+	var metric dto.Metric
+	assert.NoError(t, c.metrics.Comparisons.WithLabelValues(operation).Write(&metric))
+	comparisons = metric.Counter.GetValue()
+	assert.NoError(t, c.metrics.Mismatches.WithLabelValues(operation).Write(&metric))
+	return comparisons, metric.Counter.GetValue()
+}
+
+// Shadow reads are compared asynchronously, so this waits until the expected number of comparisons were made.
+func waitForComparisons(t *testing.T, c *comparator, operation string, expected float64) float64 {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		comparisons, mismatches := getCount(t, c, operation)
+		if comparisons >= expected || time.Now().After(deadline) {
+			assert.Equal(t, expected, comparisons)
+			return mismatches
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestShadowReads(t *testing.T) {
+	primary := repositoryMocks.NewMockRepository()
+	primary.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			return models.Execution{Phase: "RUNNING"}, nil
+		})
+	shadowRepo := repositoryMocks.NewMockRepository()
+	shadowPhase := "RUNNING"
+	shadowReads := make(chan interfaces.GetResourceInput, 2)
+	shadowRepo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			phase := shadowPhase
+			shadowReads <- input
+			return models.Execution{Phase: phase}, nil
+		})
+	shadowRepo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			t.Fatal("writes must not be repeated against the shadow repository")
+			return nil
+		})
+
+	repo := NewRepository(primary, shadowRepo, Config{
+		SamplePercentage: 100,
+		MaxInFlight:      10,
+	}, mockScope.NewTestScope())
+	c := repo.ExecutionRepo().(*executionRepo).comparator
+
+	execution, err := repo.ExecutionRepo().Get(context.Background(), getInput)
+	assert.NoError(t, err)
+	assert.Equal(t, "RUNNING", execution.Phase)
+	assert.Equal(t, getInput, <-shadowReads)
+	assert.Equal(t, float64(0), waitForComparisons(t, c, "executions.get", 1))
+
+	shadowPhase = "SUCCEEDED"
+	execution, err = repo.ExecutionRepo().Get(context.Background(), getInput)
+	assert.NoError(t, err)
+	// The primary result is returned regardless of the shadow's.
+	assert.Equal(t, "RUNNING", execution.Phase)
+	<-shadowReads
+	assert.Equal(t, float64(1), waitForComparisons(t, c, "executions.get", 2))
+
+	assert.NoError(t, repo.ExecutionRepo().Create(context.Background(), models.Execution{}))
+}
+
+func TestShadowReads_Transaction(t *testing.T) {
+	shadowRepo := repositoryMocks.NewMockRepository()
+	shadowRepo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			t.Fatal("reads within transactions must not be repeated against the shadow repository")
+			return models.Execution{}, nil
+		})
+	repo := NewRepository(repositoryMocks.NewMockRepository(), shadowRepo, Config{
+		SamplePercentage: 100,
+		MaxInFlight:      10,
+	}, mockScope.NewTestScope())
+
+	err := repo.Transaction(context.Background(), func(ctx context.Context) error {
+		_, err := repo.ExecutionRepo().Get(ctx, getInput)
+		return err
+	})
+	assert.NoError(t, err)
+}
+
+func TestShadowReads_NotSampled(t *testing.T) {
+	shadowRepo := repositoryMocks.NewMockRepository()
+	shadowRepo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetResourceInput) (models.Execution, error) {
+			t.Fatal("reads which aren't sampled must not be repeated against the shadow repository")
+			return models.Execution{}, nil
+		})
+	repo := NewRepository(repositoryMocks.NewMockRepository(), shadowRepo, Config{
+		MaxInFlight: 10,
+	}, mockScope.NewTestScope())
+
+	_, err := repo.ExecutionRepo().Get(context.Background(), getInput)
+	assert.NoError(t, err)
+}
+
+func TestCompareResults(t *testing.T) {
+	c := newComparator(100, 1, time.Second, mockScope.NewTestScope())
+	notFound := flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "missing")
+
+	c.compareResults(context.Background(), "op", readResult{err: notFound}, readResult{
+		err: flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "also missing")})
+	comparisons, mismatches := getCount(t, c, "op")
+	assert.Equal(t, float64(1), comparisons)
+	assert.Equal(t, float64(0), mismatches)
+
+	c.compareResults(context.Background(), "op", readResult{err: notFound}, readResult{value: models.Task{}})
+	_, mismatches = getCount(t, c, "op")
+	assert.Equal(t, float64(1), mismatches)
+
+	// Failed shadow reads are counted as such rather than as mismatches.
+	c.compareResults(context.Background(), "op", readResult{value: models.Task{}}, readResult{err: errors.New("foo")})
+	_, mismatches = getCount(t, c, "op")
+	assert.Equal(t, float64(1), mismatches)
+	var metric dto.Metric
+	assert.NoError(t, c.metrics.ShadowErrors.WithLabelValues("op").Write(&metric))
+	assert.Equal(t, float64(1), metric.Counter.GetValue())
+}
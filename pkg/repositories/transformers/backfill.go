@@ -0,0 +1,63 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+func ToBackfillModel(backfill interfaces.Backfill) (models.Backfill, error) {
+	results, err := json.Marshal(backfill.Executions)
+	if err != nil {
+		return models.Backfill{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Failed to encode results of backfill [%s] with err: %v", backfill.ID, err)
+	}
+	return models.Backfill{
+		Name:              backfill.ID,
+		LaunchPlanProject: backfill.LaunchPlan.GetProject(),
+		LaunchPlanDomain:  backfill.LaunchPlan.GetDomain(),
+		LaunchPlanName:    backfill.LaunchPlan.GetName(),
+		LaunchPlanVersion: backfill.LaunchPlan.GetVersion(),
+		StartTime:         backfill.StartTime,
+		EndTime:           backfill.EndTime,
+		Total:             backfill.Total,
+		Succeeded:         backfill.Succeeded,
+		Skipped:           backfill.Skipped,
+		Failed:            backfill.Failed,
+		Done:              backfill.Done,
+		Results:           results,
+	}, nil
+}
+
+func FromBackfillModel(model models.Backfill) (*interfaces.Backfill, error) {
+	var executions []interfaces.BackfillExecutionResult
+	if len(model.Results) > 0 {
+		if err := json.Unmarshal(model.Results, &executions); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"Failed to decode results of backfill [%s] with err: %v", model.Name, err)
+		}
+	}
+	return &interfaces.Backfill{
+		ID: model.Name,
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      model.LaunchPlanProject,
+			Domain:       model.LaunchPlanDomain,
+			Name:         model.LaunchPlanName,
+			Version:      model.LaunchPlanVersion,
+		},
+		StartTime:  model.StartTime,
+		EndTime:    model.EndTime,
+		Total:      model.Total,
+		Executions: executions,
+		Succeeded:  model.Succeeded,
+		Skipped:    model.Skipped,
+		Failed:     model.Failed,
+		Done:       model.Done,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+)
+
+func TestBackfillModel(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	backfill := interfaces.Backfill{
+		ID: "backfill",
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "name",
+			Version:      "version",
+		},
+		StartTime: start,
+		EndTime:   start.Add(2 * time.Hour),
+		Total:     2,
+		Executions: []interfaces.BackfillExecutionResult{
+			{
+				KickoffTime: start,
+				ID: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "execution",
+				},
+			},
+			{
+				KickoffTime: start.Add(time.Hour),
+				Error:       "launch failed",
+			},
+		},
+		Succeeded: 1,
+		Failed:    1,
+		Done:      true,
+	}
+	model, err := ToBackfillModel(backfill)
+	assert.NoError(t, err)
+	assert.Equal(t, "backfill", model.Name)
+	assert.Equal(t, "name", model.LaunchPlanName)
+	assert.Equal(t, 2, model.Total)
+
+	fromModel, err := FromBackfillModel(model)
+	assert.NoError(t, err)
+	assert.Equal(t, backfill, *fromModel)
+}
@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -30,6 +31,7 @@ type CreateExecutionModelInput struct {
 	Cluster               string
 	InputsURI             storage.DataReference
 	UserInputsURI         storage.DataReference
+	TraceParent           string
 }
 
 // Transforms a ExecutionCreateRequest to a Execution model
@@ -76,15 +78,49 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 		Cluster:               input.Cluster,
 		InputsURI:             input.InputsURI,
 		UserInputsURI:         input.UserInputsURI,
+		DataClassification:    common.GetDataClassification(input.RequestSpec),
+		TraceParent:           input.TraceParent,
 	}
 	if input.RequestSpec.Metadata != nil {
 		executionModel.Mode = int32(input.RequestSpec.Metadata.Mode)
 	}
+	extensions := common.GetExtensions(input.RequestSpec)
+	if len(extensions) > 0 {
+		keys := make([]string, 0, len(extensions))
+		for key := range extensions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		executionModel.Extensions = make([]models.ExecutionExtension, len(keys))
+		for i, key := range keys {
+			executionModel.Extensions[i] = models.ExecutionExtension{
+				ExecutionKey: executionModel.ExecutionKey,
+				Key:          key,
+				Value:        extensions[key],
+			}
+		}
+	}
+	executionModel.Tags = CreateExecutionTagModels(executionModel.ExecutionKey, common.GetTags(input.RequestSpec))
 
 	return executionModel, nil
 }
 
 // Updates an existing model given a WorkflowExecution event.
+// Transforms the tags of an execution into their database models.
+func CreateExecutionTagModels(key models.ExecutionKey, tags []string) []models.ExecutionTag {
+	if len(tags) == 0 {
+		return nil
+	}
+	tagModels := make([]models.ExecutionTag, len(tags))
+	for i, tag := range tags {
+		tagModels[i] = models.ExecutionTag{
+			ExecutionKey: key,
+			Name:         tag,
+		}
+	}
+	return tagModels
+}
+
 func UpdateExecutionModelState(
 	execution *models.Execution, request admin.WorkflowExecutionEventRequest, abortCause *string) error {
 	var executionClosure admin.ExecutionClosure
@@ -95,6 +131,7 @@ func UpdateExecutionModelState(
 	executionClosure.Phase = request.Event.Phase
 	executionClosure.UpdatedAt = request.Event.OccurredAt
 	execution.Phase = request.Event.Phase.String()
+	execution.FailureClassification = common.GetFailureClassification(request.Event.Phase, request.Event.GetError())
 
 	occurredAtTimestamp, err := ptypes.Timestamp(request.Event.OccurredAt)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/manager/impl/testutils"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
@@ -89,6 +90,80 @@ func TestCreateExecutionModel(t *testing.T) {
 	assert.Equal(t, expectedClosure, execution.Closure)
 }
 
+func TestCreateExecutionModel_Extensions(t *testing.T) {
+	execRequest := testutils.GetExecutionRequest()
+	execRequest.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			"team": "flyte",
+			common.ExtensionAnnotationPrefix + "ticket":     "FLYTE-123",
+			common.ExtensionAnnotationPrefix + "experiment": "exp-1",
+		},
+	}
+	execution, err := CreateExecutionModel(CreateExecutionModelInput{
+		WorkflowExecutionID: core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		RequestSpec: execRequest.Spec,
+		Phase:       core.WorkflowExecution_UNDEFINED,
+		CreatedAt:   time.Now(),
+	})
+	assert.NoError(t, err)
+	executionKey := models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.Equal(t, []models.ExecutionExtension{
+		{
+			ExecutionKey: executionKey,
+			Key:          "experiment",
+			Value:        "exp-1",
+		},
+		{
+			ExecutionKey: executionKey,
+			Key:          "ticket",
+			Value:        "FLYTE-123",
+		},
+	}, execution.Extensions)
+}
+
+func TestCreateExecutionModel_Tags(t *testing.T) {
+	execRequest := testutils.GetExecutionRequest()
+	execRequest.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.TagsAnnotation: "nightly,team: data",
+		},
+	}
+	execution, err := CreateExecutionModel(CreateExecutionModelInput{
+		WorkflowExecutionID: core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		RequestSpec: execRequest.Spec,
+		Phase:       core.WorkflowExecution_UNDEFINED,
+		CreatedAt:   time.Now(),
+	})
+	assert.NoError(t, err)
+	executionKey := models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.Equal(t, []models.ExecutionTag{
+		{
+			ExecutionKey: executionKey,
+			Name:         "nightly",
+		},
+		{
+			ExecutionKey: executionKey,
+			Name:         "team: data",
+		},
+	}, execution.Tags)
+}
+
 func TestUpdateModelState_UnknownToRunning(t *testing.T) {
 
 	createdAt := time.Date(2018, 10, 29, 16, 0, 0, 0, time.UTC)
@@ -205,16 +280,17 @@ func TestUpdateModelState_RunningToFailed(t *testing.T) {
 			Domain:  "domain",
 			Name:    "name",
 		},
-		Spec:               specBytes,
-		Phase:              core.WorkflowExecution_ABORTED.String(),
-		Closure:            expectedClosureBytes,
-		LaunchPlanID:       uint(1),
-		WorkflowID:         uint(2),
-		StartedAt:          &startedAt,
-		Duration:           duration,
-		ExecutionCreatedAt: executionModel.ExecutionCreatedAt,
-		ExecutionUpdatedAt: &occurredAt,
-		AbortCause:         abortCause,
+		Spec:                  specBytes,
+		Phase:                 core.WorkflowExecution_ABORTED.String(),
+		Closure:               expectedClosureBytes,
+		LaunchPlanID:          uint(1),
+		WorkflowID:            uint(2),
+		StartedAt:             &startedAt,
+		Duration:              duration,
+		ExecutionCreatedAt:    executionModel.ExecutionCreatedAt,
+		ExecutionUpdatedAt:    &occurredAt,
+		AbortCause:            abortCause,
+		FailureClassification: common.FailureClassificationAborted,
 	}
 	assert.EqualValues(t, expectedModel, executionModel)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/storage"
 
 	"github.com/golang/protobuf/proto"
 
@@ -20,6 +21,10 @@ import (
 type ToNodeExecutionModelInput struct {
 	Request               *admin.NodeExecutionEventRequest
 	ParentTaskExecutionID uint
+	// The node execution which the parent task execution belongs to.
+	ParentID uint
+	// The sub-workflow (if any) which the node belongs to.
+	SubWorkflowID *core.Identifier
 }
 
 func addNodeRunningState(request *admin.NodeExecutionEventRequest, nodeExecutionModel *models.NodeExecution,
@@ -113,18 +118,90 @@ func CreateNodeExecutionModel(input ToNodeExecutionModelInput) (*models.NodeExec
 	nodeExecution.NodeExecutionUpdatedAt = &nodeExecutionCreatedAt
 	if input.Request.Event.ParentTaskMetadata != nil {
 		nodeExecution.ParentTaskExecutionID = input.ParentTaskExecutionID
+		nodeExecution.ParentID = input.ParentID
+	}
+	if input.SubWorkflowID != nil {
+		nodeExecution.SubWorkflowProject = input.SubWorkflowID.Project
+		nodeExecution.SubWorkflowDomain = input.SubWorkflowID.Domain
+		nodeExecution.SubWorkflowName = input.SubWorkflowID.Name
+		nodeExecution.SubWorkflowVersion = input.SubWorkflowID.Version
 	}
 	return nodeExecution, nil
 }
 
+// Reads the closure of a node execution from blob storage when it was offloaded, otherwise from the model itself.
+func getNodeExecutionClosure(ctx context.Context, store *storage.DataStore,
+	nodeExecutionModel models.NodeExecution) (admin.NodeExecutionClosure, error) {
+	var closure admin.NodeExecutionClosure
+	if len(nodeExecutionModel.RemoteClosureReference) > 0 {
+		err := store.ReadProtobuf(ctx, storage.DataReference(nodeExecutionModel.RemoteClosureReference), &closure)
+		if err != nil {
+			return admin.NodeExecutionClosure{}, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to read offloaded node execution closure from [%s] with error: %v",
+				nodeExecutionModel.RemoteClosureReference, err)
+		}
+		return closure, nil
+	}
+	if err := proto.Unmarshal(nodeExecutionModel.Closure, &closure); err != nil {
+		return admin.NodeExecutionClosure{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal node execution closure with error: %+v", err)
+	}
+	return closure, nil
+}
+
+// Returns where the closure of a node execution is written when it's offloaded.
+func GetNodeExecutionClosureReference(ctx context.Context, store *storage.DataStore, storagePrefix []string,
+	key models.NodeExecutionKey) (storage.DataReference, error) {
+	nestedKeys := make([]string, 0, len(storagePrefix)+5)
+	nestedKeys = append(nestedKeys, storagePrefix...)
+	nestedKeys = append(nestedKeys, key.Project, key.Domain, key.Name, "closure", key.NodeID)
+	return store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx), nestedKeys...)
+}
+
+// Closures of node executions with many children, e.g. large dynamic nodes, can exceed comfortable row sizes. Those
+// larger than the threshold are written to the reference in blob storage and only a stub with the closure's phase and
+// timestamps is kept in the model. Once offloaded, a node execution's closure stays offloaded when it's updated.
+// Closures are never offloaded when the threshold is unset. Returns whether the closure was offloaded.
+func OffloadNodeExecutionClosure(ctx context.Context, store *storage.DataStore, reference storage.DataReference,
+	thresholdBytes int, nodeExecutionModel *models.NodeExecution) (bool, error) {
+	if len(nodeExecutionModel.RemoteClosureReference) == 0 &&
+		(thresholdBytes <= 0 || len(nodeExecutionModel.Closure) <= thresholdBytes) {
+		return false, nil
+	}
+	if len(nodeExecutionModel.RemoteClosureReference) > 0 {
+		reference = storage.DataReference(nodeExecutionModel.RemoteClosureReference)
+	}
+	var closure admin.NodeExecutionClosure
+	if err := proto.Unmarshal(nodeExecutionModel.Closure, &closure); err != nil {
+		return false, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal node execution closure with error: %+v", err)
+	}
+	if err := store.WriteProtobuf(ctx, reference, storage.Options{}, &closure); err != nil {
+		return false, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to offload node execution closure to [%s] with error: %v", reference, err)
+	}
+	stub, err := proto.Marshal(&admin.NodeExecutionClosure{
+		Phase:     closure.Phase,
+		StartedAt: closure.StartedAt,
+		Duration:  closure.Duration,
+		CreatedAt: closure.CreatedAt,
+		UpdatedAt: closure.UpdatedAt,
+	})
+	if err != nil {
+		return false, errors.NewFlyteAdminErrorf(
+			codes.Internal, "failed to marshal node execution closure with error: %v", err)
+	}
+	nodeExecutionModel.Closure = stub
+	nodeExecutionModel.RemoteClosureReference = reference.String()
+	return true, nil
+}
+
 func UpdateNodeExecutionModel(
-	request *admin.NodeExecutionEventRequest, nodeExecutionModel *models.NodeExecution,
-	targetExecution *core.WorkflowExecutionIdentifier) error {
-	var nodeExecutionClosure admin.NodeExecutionClosure
-	err := proto.Unmarshal(nodeExecutionModel.Closure, &nodeExecutionClosure)
+	ctx context.Context, store *storage.DataStore, request *admin.NodeExecutionEventRequest,
+	nodeExecutionModel *models.NodeExecution, targetExecution *core.WorkflowExecutionIdentifier) error {
+	nodeExecutionClosure, err := getNodeExecutionClosure(ctx, store, *nodeExecutionModel)
 	if err != nil {
-		return errors.NewFlyteAdminErrorf(codes.Internal,
-			"failed to unmarshal node execution closure with error: %+v", err)
+		return err
 	}
 	nodeExecutionModel.Phase = request.Event.Phase.String()
 	nodeExecutionClosure.Phase = request.Event.Phase
@@ -165,11 +242,35 @@ func UpdateNodeExecutionModel(
 	return nil
 }
 
-func FromNodeExecutionModel(nodeExecutionModel models.NodeExecution) (*admin.NodeExecution, error) {
-	var closure admin.NodeExecutionClosure
-	err := proto.Unmarshal(nodeExecutionModel.Closure, &closure)
+// Adds the output URI of an event which arrived after the node execution reached a terminal phase to its closure when
+// the closure has no output result yet, without changing the recorded phase. Returns whether the closure changed.
+func EnrichNodeExecutionModel(ctx context.Context, store *storage.DataStore,
+	request *admin.NodeExecutionEventRequest, nodeExecutionModel *models.NodeExecution) (bool, error) {
+	nodeExecutionClosure, err := getNodeExecutionClosure(ctx, store, *nodeExecutionModel)
+	if err != nil {
+		return false, err
+	}
+	if nodeExecutionClosure.OutputResult != nil || request.Event.GetOutputUri() == "" {
+		return false, nil
+	}
+	nodeExecutionClosure.OutputResult = &admin.NodeExecutionClosure_OutputUri{
+		OutputUri: request.Event.GetOutputUri(),
+	}
+	marshaledClosure, err := proto.Marshal(&nodeExecutionClosure)
+	if err != nil {
+		return false, errors.NewFlyteAdminErrorf(
+			codes.Internal, "failed to marshal node execution closure with error: %v", err)
+	}
+	nodeExecutionModel.Closure = marshaledClosure
+	return true, nil
+}
+
+// Offloaded closures are read from blob storage.
+func FromNodeExecutionModel(ctx context.Context, store *storage.DataStore, nodeExecutionModel models.NodeExecution) (
+	*admin.NodeExecution, error) {
+	closure, err := getNodeExecutionClosure(ctx, store, nodeExecutionModel)
 	if err != nil {
-		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal closure")
+		return nil, err
 	}
 
 	return &admin.NodeExecution{
@@ -186,11 +287,11 @@ func FromNodeExecutionModel(nodeExecutionModel models.NodeExecution) (*admin.Nod
 	}, nil
 }
 
-func FromNodeExecutionModels(
+func FromNodeExecutionModels(ctx context.Context, store *storage.DataStore,
 	nodeExecutionModels []models.NodeExecution) ([]*admin.NodeExecution, error) {
 	nodeExecutions := make([]*admin.NodeExecution, len(nodeExecutionModels))
 	for idx, nodeExecutionModel := range nodeExecutionModels {
-		nodeExecution, err := FromNodeExecutionModel(nodeExecutionModel)
+		nodeExecution, err := FromNodeExecutionModel(ctx, store, nodeExecutionModel)
 		if err != nil {
 			return nil, err
 		}
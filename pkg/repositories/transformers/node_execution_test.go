@@ -1,16 +1,20 @@
 package transformers
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 
 	"github.com/golang/protobuf/proto"
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/lyft/flytestdlib/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -128,6 +132,7 @@ func TestCreateNodeExecutionModel(t *testing.T) {
 			},
 		},
 		ParentTaskExecutionID: 8,
+		ParentID:              2,
 	})
 	assert.Nil(t, err)
 
@@ -154,6 +159,7 @@ func TestCreateNodeExecutionModel(t *testing.T) {
 		NodeExecutionCreatedAt: &occurredAt,
 		NodeExecutionUpdatedAt: &occurredAt,
 		ParentTaskExecutionID:  8,
+		ParentID:               2,
 	}, nodeExecutionModel)
 }
 
@@ -172,7 +178,7 @@ func TestUpdateNodeExecutionModel(t *testing.T) {
 	nodeExecutionModel := models.NodeExecution{
 		Phase: core.NodeExecution_UNDEFINED.String(),
 	}
-	err := UpdateNodeExecutionModel(&request, &nodeExecutionModel, childExecutionID)
+	err := UpdateNodeExecutionModel(context.Background(), nil, &request, &nodeExecutionModel, childExecutionID)
 	assert.Nil(t, err)
 	assert.Equal(t, core.NodeExecution_RUNNING.String(), nodeExecutionModel.Phase)
 	assert.Equal(t, occurredAt, *nodeExecutionModel.StartedAt)
@@ -201,7 +207,7 @@ func TestFromNodeExecutionModel(t *testing.T) {
 			Name:    "name",
 		},
 	}
-	nodeExecution, err := FromNodeExecutionModel(models.NodeExecution{
+	nodeExecution, err := FromNodeExecutionModel(context.Background(), nil, models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: "nodey",
 			ExecutionKey: models.ExecutionKey{
@@ -224,7 +230,7 @@ func TestFromNodeExecutionModel(t *testing.T) {
 }
 
 func TestFromNodeExecutionModels(t *testing.T) {
-	nodeExecutions, err := FromNodeExecutionModels([]models.NodeExecution{
+	nodeExecutions, err := FromNodeExecutionModels(context.Background(), nil, []models.NodeExecution{
 		{
 			NodeExecutionKey: models.NodeExecutionKey{
 				NodeID: "node id",
@@ -255,3 +261,121 @@ func TestFromNodeExecutionModels(t *testing.T) {
 		Closure:  closure,
 	}, nodeExecutions[0]))
 }
+
+func TestEnrichNodeExecutionModel(t *testing.T) {
+	succeededClosure, _ := proto.Marshal(&admin.NodeExecutionClosure{
+		Phase: core.NodeExecution_SUCCEEDED,
+	})
+	nodeExecutionModel := models.NodeExecution{
+		Phase:   core.NodeExecution_SUCCEEDED.String(),
+		Closure: succeededClosure,
+	}
+	request := admin.NodeExecutionEventRequest{
+		Event: &event.NodeExecutionEvent{
+			Phase: core.NodeExecution_RUNNING,
+			OutputResult: &event.NodeExecutionEvent_OutputUri{
+				OutputUri: "output uri",
+			},
+		},
+	}
+	enriched, err := EnrichNodeExecutionModel(context.Background(), nil, &request, &nodeExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, enriched)
+	assert.Equal(t, core.NodeExecution_SUCCEEDED.String(), nodeExecutionModel.Phase)
+	var enrichedClosure admin.NodeExecutionClosure
+	assert.Nil(t, proto.Unmarshal(nodeExecutionModel.Closure, &enrichedClosure))
+	assert.Equal(t, core.NodeExecution_SUCCEEDED, enrichedClosure.Phase)
+	assert.Equal(t, "output uri", enrichedClosure.GetOutputUri())
+
+	// Recorded output results are never replaced.
+	request.Event.OutputResult = &event.NodeExecutionEvent_OutputUri{
+		OutputUri: "other output uri",
+	}
+	enriched, err = EnrichNodeExecutionModel(context.Background(), nil, &request, &nodeExecutionModel)
+	assert.Nil(t, err)
+	assert.False(t, enriched)
+}
+
+func getMockStorageForNodeExecutionTest() *storage.DataStore {
+	mockStorage := commonMocks.GetMockStorageClient()
+	testDataStore := mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore)
+	testDataStore.ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		bytes, ok := testDataStore.Store[reference]
+		if !ok {
+			return fmt.Errorf("could not find value in storage [%v]", reference.String())
+		}
+		return proto.Unmarshal(bytes, msg)
+	}
+	testDataStore.WriteProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, opts storage.Options, msg proto.Message) error {
+		bytes, err := proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		testDataStore.Store[reference] = bytes
+		return nil
+	}
+	return mockStorage
+}
+
+func TestOffloadNodeExecutionClosure(t *testing.T) {
+	store := getMockStorageForNodeExecutionTest()
+	key := models.NodeExecutionKey{
+		NodeID: "node id",
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}
+	reference, err := GetNodeExecutionClosureReference(context.Background(), store, []string{"metadata"}, key)
+	assert.Nil(t, err)
+	assert.Equal(t, storage.DataReference("s3://bucket/metadata/project/domain/name/closure/node id"), reference)
+
+	nodeExecutionModel := models.NodeExecution{
+		NodeExecutionKey: key,
+		Closure:          closureBytes,
+		StartedAt:        &occurredAt,
+	}
+	offloaded, err := OffloadNodeExecutionClosure(context.Background(), store, reference, 0, &nodeExecutionModel)
+	assert.Nil(t, err)
+	assert.False(t, offloaded)
+	offloaded, err = OffloadNodeExecutionClosure(
+		context.Background(), store, reference, len(closureBytes), &nodeExecutionModel)
+	assert.Nil(t, err)
+	assert.False(t, offloaded)
+	assert.Equal(t, closureBytes, nodeExecutionModel.Closure)
+
+	offloaded, err = OffloadNodeExecutionClosure(context.Background(), store, reference, 1, &nodeExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, offloaded)
+	assert.Equal(t, reference.String(), nodeExecutionModel.RemoteClosureReference)
+	var stub admin.NodeExecutionClosure
+	assert.Nil(t, proto.Unmarshal(nodeExecutionModel.Closure, &stub))
+	assert.Equal(t, core.NodeExecution_SUCCEEDED, stub.Phase)
+	assert.Nil(t, stub.OutputResult)
+
+	nodeExecution, err := FromNodeExecutionModel(context.Background(), store, nodeExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(closure, nodeExecution.Closure))
+
+	// Offloaded closures are still read when they're updated.
+	request := admin.NodeExecutionEventRequest{
+		Event: &event.NodeExecutionEvent{
+			Phase:      core.NodeExecution_FAILED,
+			OccurredAt: occurredAtProto,
+		},
+	}
+	assert.Nil(t, UpdateNodeExecutionModel(context.Background(), store, &request, &nodeExecutionModel, nil))
+	var updatedClosure admin.NodeExecutionClosure
+	assert.Nil(t, proto.Unmarshal(nodeExecutionModel.Closure, &updatedClosure))
+	assert.Equal(t, "output uri", updatedClosure.GetOutputUri())
+}
+
+func TestFromNodeExecutionModel_MissingOffloadedClosure(t *testing.T) {
+	_, err := FromNodeExecutionModel(context.Background(), getMockStorageForNodeExecutionTest(), models.NodeExecution{
+		RemoteClosureReference: "s3://bucket/missing",
+	})
+	assert.NotNil(t, err)
+}
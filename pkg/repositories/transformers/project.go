@@ -1,8 +1,11 @@
 package transformers
 
 import (
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"google.golang.org/grpc/codes"
 )
 
 type CreateProjectModelInput struct {
@@ -37,3 +40,25 @@ func FromProjectModels(projectModels []models.Project, domains []*admin.Domain)
 	}
 	return projects
 }
+
+// Serializes project labels, which the pinned flyteidl admin.Project has no field for.
+func CreateProjectLabels(projectID string, labels map[string]string) ([]byte, error) {
+	serializedLabels, err := proto.Marshal(&admin.Labels{Values: labels})
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to marshal labels of project [%s] with err %v", projectID, err)
+	}
+	return serializedLabels, nil
+}
+
+func FromProjectModelLabels(projectModel models.Project) (map[string]string, error) {
+	if len(projectModel.Labels) == 0 {
+		return nil, nil
+	}
+	var labels admin.Labels
+	if err := proto.Unmarshal(projectModel.Labels, &labels); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal labels of project [%s] with err %v", projectModel.Identifier, err)
+	}
+	return labels.Values, nil
+}
@@ -82,3 +82,18 @@ func TestFromProjectModels(t *testing.T) {
 		assert.EqualValues(t, domains, project.Domains)
 	}
 }
+
+func TestProjectLabels(t *testing.T) {
+	serializedLabels, err := CreateProjectLabels("project_id", map[string]string{"team": "flyte"})
+	assert.NoError(t, err)
+	labels, err := FromProjectModelLabels(models.Project{
+		Identifier: "project_id",
+		Labels:     serializedLabels,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "flyte"}, labels)
+
+	labels, err = FromProjectModelLabels(models.Project{Identifier: "project_id"})
+	assert.NoError(t, err)
+	assert.Nil(t, labels)
+}
@@ -0,0 +1,74 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+// Returns the type of the resource whose attributes are set, assuming exactly one is.
+func GetMatchableResourceType(attributes interfaces.MatchingAttributes) interfaces.MatchableResource {
+	switch {
+	case attributes.ExecutionQueueAttributes != nil:
+		return interfaces.MatchableResourceExecutionQueue
+	case attributes.ExecutionClusterLabel != nil:
+		return interfaces.MatchableResourceExecutionClusterLabel
+	case attributes.FeatureFlags != nil:
+		return interfaces.MatchableResourceFeatureFlags
+	case attributes.ExecutionNamePolicy != nil:
+		return interfaces.MatchableResourceExecutionNamePolicy
+	case attributes.QuietHours != nil:
+		return interfaces.MatchableResourceQuietHours
+	default:
+		return interfaces.MatchableResourceTaskResource
+	}
+}
+
+func getResourcePriority(project, domain, workflow string) models.ResourcePriority {
+	if len(workflow) > 0 {
+		return models.ResourcePriorityWorkflowLevel
+	}
+	if len(project) == 0 {
+		return models.ResourcePriorityDomainLevel
+	}
+	if len(domain) > 0 {
+		return models.ResourcePriorityProjectDomainLevel
+	}
+	return models.ResourcePriorityProjectLevel
+}
+
+func ToResourceModel(request interfaces.ResourceAttributesUpdateRequest) (models.Resource, error) {
+	attributeBytes, err := json.Marshal(request.Attributes)
+	if err != nil {
+		return models.Resource{}, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Failed to encode resource attributes with err: %v", err)
+	}
+	return models.Resource{
+		Project:      request.Project,
+		Domain:       request.Domain,
+		Workflow:     request.Workflow,
+		ResourceType: int32(GetMatchableResourceType(request.Attributes)),
+		Priority:     int32(getResourcePriority(request.Project, request.Domain, request.Workflow)),
+		Attributes:   attributeBytes,
+	}, nil
+}
+
+func FromResourceModel(model models.Resource) (interfaces.ResourceAttributes, error) {
+	var attributes interfaces.MatchingAttributes
+	if len(model.Attributes) > 0 {
+		if err := json.Unmarshal(model.Attributes, &attributes); err != nil {
+			return interfaces.ResourceAttributes{}, errors.NewFlyteAdminErrorf(codes.Internal,
+				"Failed to decode resource attributes with err: %v", err)
+		}
+	}
+	return interfaces.ResourceAttributes{
+		Project:    model.Project,
+		Domain:     model.Domain,
+		Workflow:   model.Workflow,
+		Attributes: attributes,
+	}, nil
+}
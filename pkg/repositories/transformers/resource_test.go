@@ -0,0 +1,73 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+)
+
+func TestResourceModel(t *testing.T) {
+	attributes := interfaces.MatchingAttributes{
+		ExecutionQueueAttributes: &interfaces.ExecutionQueueAttributes{
+			Tags: []string{"gpu"},
+		},
+	}
+	model, err := ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Workflow:   "workflow",
+		Attributes: attributes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(interfaces.MatchableResourceExecutionQueue), model.ResourceType)
+	assert.Equal(t, int32(models.ResourcePriorityWorkflowLevel), model.Priority)
+
+	resourceAttributes, err := FromResourceModel(model)
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ResourceAttributes{
+		Project:    "project",
+		Domain:     "domain",
+		Workflow:   "workflow",
+		Attributes: attributes,
+	}, resourceAttributes)
+}
+
+func TestToResourceModel_Priority(t *testing.T) {
+	attributes := interfaces.MatchingAttributes{
+		TaskResourceAttributes: &interfaces.TaskResourceAttributes{},
+	}
+	model, err := ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Project:    "project",
+		Attributes: attributes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(interfaces.MatchableResourceTaskResource), model.ResourceType)
+	assert.Equal(t, int32(models.ResourcePriorityProjectLevel), model.Priority)
+
+	model, err = ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Attributes: attributes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(models.ResourcePriorityProjectDomainLevel), model.Priority)
+
+	model, err = ToResourceModel(interfaces.ResourceAttributesUpdateRequest{
+		Domain: "domain",
+		Attributes: interfaces.MatchingAttributes{
+			FeatureFlags: &interfaces.FeatureFlags{DisableCaching: true},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(interfaces.MatchableResourceFeatureFlags), model.ResourceType)
+	assert.Equal(t, int32(models.ResourcePriorityDomainLevel), model.Priority)
+}
+
+func TestFromResourceModel_Empty(t *testing.T) {
+	resourceAttributes, err := FromResourceModel(models.Resource{})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ResourceAttributes{}, resourceAttributes)
+}
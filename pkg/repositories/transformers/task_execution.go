@@ -2,9 +2,13 @@ package transformers
 
 import (
 	"context"
+	"math"
+	"sort"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	ptypesStruct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/errors"
 	"github.com/lyft/flyteadmin/pkg/repositories/models"
@@ -14,8 +18,53 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+// Task execution events may report the resource usage of the task execution in their custom info under the
+// resource_usage key, e.g. {"max_memory_bytes": 1073741824, "cpu_seconds": 120.5, "gpu_utilization": 0.8} where the
+// gpu utilization is the fraction of the gpus' capacity used.
+const (
+	ResourceUsageKey            = "resource_usage"
+	ResourceUsageMaxMemoryBytes = "max_memory_bytes"
+	ResourceUsageCPUSeconds     = "cpu_seconds"
+	ResourceUsageGPUUtilization = "gpu_utilization"
+)
+
+// Task execution events of resumable tasks may report the URI of their latest checkpoint in their custom info under
+// the checkpoint key. Retry attempts are told where to resume from by the previous_checkpoint key of their custom info,
+// which holds the latest checkpoint of the attempts before them.
+const (
+	CheckpointKey         = "checkpoint"
+	PreviousCheckpointKey = "previous_checkpoint"
+)
+
+// Task execution events may report the environment the task execution actually ran in under the environment key of
+// their custom info, e.g. {"image": "repo/image:tag", "queue": "gpu", "resources": {"requests": {"cpu": "2"},
+// "limits": {"memory": "4Gi"}}}, which overrides the environment derived from the compiled task. Resources are keyed by
+// their lower case names.
+const (
+	EnvironmentKey       = "environment"
+	EnvironmentImage     = "image"
+	EnvironmentQueue     = "queue"
+	EnvironmentResources = "resources"
+	EnvironmentRequests  = "requests"
+	EnvironmentLimits    = "limits"
+)
+
+// The largest integer which custom info numbers, being float64s, represent exactly.
+const maxResourceUsageMemoryBytes = 1 << 53
+
+// The container image, resources and queue which a task execution ran with.
+type TaskExecutionEnvironment struct {
+	Image     string
+	Resources *core.Resources
+	Queue     string
+}
+
 type CreateTaskExecutionModelInput struct {
 	Request *admin.TaskExecutionEventRequest
+	// The latest checkpoint of the earlier retry attempts, if any.
+	PreviousCheckpointURI string
+	// The environment derived from the compiled task, which events may override.
+	Environment TaskExecutionEnvironment
 }
 
 func addTaskStartedState(request *admin.TaskExecutionEventRequest, taskExecutionModel *models.TaskExecution,
@@ -53,14 +102,278 @@ func addTaskTerminalState(
 		closure.OutputResult = &admin.TaskExecutionClosure_OutputUri{
 			OutputUri: request.Event.GetOutputUri(),
 		}
+		taskExecutionModel.OutputURI = request.Event.GetOutputUri()
 	} else if request.Event.GetError() != nil {
 		closure.OutputResult = &admin.TaskExecutionClosure_Error{
 			Error: request.Event.GetError(),
 		}
+		taskExecutionModel.ErrorURI = request.Event.GetError().ErrorUri
+	}
+	return nil
+}
+
+func getResourceUsageValue(resourceUsage *ptypesStruct.Struct, key string, max float64) (float64, bool) {
+	value, ok := resourceUsage.Fields[key].GetKind().(*ptypesStruct.Value_NumberValue)
+	if !ok || math.IsNaN(value.NumberValue) || value.NumberValue < 0 || value.NumberValue > max {
+		return 0, false
+	}
+	return value.NumberValue, true
+}
+
+// Records the resource usage reported in the custom info of a task execution event. Memory and cpu usage only grow over
+// the course of a task execution, so the largest values reported are kept, whereas the gpu utilization is the one
+// reported last. Values which aren't non-negative numbers (or fractions, for the gpu utilization) are ignored. Returns
+// whether the recorded resource usage changed.
+func addResourceUsage(customInfo *ptypesStruct.Struct, taskExecutionModel *models.TaskExecution) bool {
+	resourceUsage := customInfo.GetFields()[ResourceUsageKey].GetStructValue()
+	if resourceUsage == nil {
+		return false
+	}
+	var changed bool
+	if value, ok := getResourceUsageValue(resourceUsage, ResourceUsageMaxMemoryBytes, maxResourceUsageMemoryBytes); ok {
+		maxMemoryBytes := int64(value)
+		if taskExecutionModel.MaxMemoryBytes == nil || *taskExecutionModel.MaxMemoryBytes < maxMemoryBytes {
+			taskExecutionModel.MaxMemoryBytes = &maxMemoryBytes
+			changed = true
+		}
+	}
+	if cpuSeconds, ok := getResourceUsageValue(resourceUsage, ResourceUsageCPUSeconds, math.MaxFloat64); ok {
+		if taskExecutionModel.CPUSeconds == nil || *taskExecutionModel.CPUSeconds < cpuSeconds {
+			taskExecutionModel.CPUSeconds = &cpuSeconds
+			changed = true
+		}
+	}
+	if gpuUtilization, ok := getResourceUsageValue(resourceUsage, ResourceUsageGPUUtilization, 1); ok {
+		if taskExecutionModel.GPUUtilization == nil || *taskExecutionModel.GPUUtilization != gpuUtilization {
+			taskExecutionModel.GPUUtilization = &gpuUtilization
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Records the checkpoint reported in the custom info of a task execution event. Returns whether the recorded checkpoint
+// changed.
+func addCheckpoint(customInfo *ptypesStruct.Struct, taskExecutionModel *models.TaskExecution) bool {
+	checkpointURI := customInfo.GetFields()[CheckpointKey].GetStringValue()
+	if len(checkpointURI) == 0 || checkpointURI == taskExecutionModel.CheckpointURI {
+		return false
+	}
+	taskExecutionModel.CheckpointURI = checkpointURI
+	return true
+}
+
+// Returns the custom info with the recorded checkpoints of the task execution, so that the latest checkpoint is served
+// even when later events didn't report it again.
+func withCheckpoints(
+	customInfo *ptypesStruct.Struct, taskExecutionModel models.TaskExecution) *ptypesStruct.Struct {
+	checkpoints := map[string]string{
+		CheckpointKey:         taskExecutionModel.CheckpointURI,
+		PreviousCheckpointKey: taskExecutionModel.PreviousCheckpointURI,
+	}
+	for key, uri := range checkpoints {
+		if len(uri) == 0 {
+			continue
+		}
+		if customInfo == nil {
+			customInfo = &ptypesStruct.Struct{}
+		}
+		if customInfo.Fields == nil {
+			customInfo.Fields = make(map[string]*ptypesStruct.Value)
+		}
+		customInfo.Fields[key] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_StringValue{StringValue: uri},
+		}
+	}
+	return customInfo
+}
+
+// Returns the custom info with its resource usage replaced by the one recorded for the task execution, which also
+// accounts for the usage reported by earlier events.
+func withResourceUsage(
+	customInfo *ptypesStruct.Struct, taskExecutionModel models.TaskExecution) *ptypesStruct.Struct {
+	resourceUsage := make(map[string]*ptypesStruct.Value)
+	if taskExecutionModel.MaxMemoryBytes != nil {
+		resourceUsage[ResourceUsageMaxMemoryBytes] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_NumberValue{NumberValue: float64(*taskExecutionModel.MaxMemoryBytes)},
+		}
+	}
+	if taskExecutionModel.CPUSeconds != nil {
+		resourceUsage[ResourceUsageCPUSeconds] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_NumberValue{NumberValue: *taskExecutionModel.CPUSeconds},
+		}
+	}
+	if taskExecutionModel.GPUUtilization != nil {
+		resourceUsage[ResourceUsageGPUUtilization] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_NumberValue{NumberValue: *taskExecutionModel.GPUUtilization},
+		}
+	}
+	if len(resourceUsage) == 0 {
+		return customInfo
+	}
+	if customInfo == nil {
+		customInfo = &ptypesStruct.Struct{}
+	}
+	if customInfo.Fields == nil {
+		customInfo.Fields = make(map[string]*ptypesStruct.Value)
+	}
+	customInfo.Fields[ResourceUsageKey] = &ptypesStruct.Value{
+		Kind: &ptypesStruct.Value_StructValue{
+			StructValue: &ptypesStruct.Struct{
+				Fields: resourceUsage,
+			},
+		},
+	}
+	return customInfo
+}
+
+func getReportedResourceEntries(resources *ptypesStruct.Struct) []*core.Resources_ResourceEntry {
+	entries := make([]*core.Resources_ResourceEntry, 0, len(resources.GetFields()))
+	for key, value := range resources.GetFields() {
+		name := core.Resources_ResourceName(core.Resources_ResourceName_value[strings.ToUpper(key)])
+		if name == core.Resources_UNKNOWN || len(value.GetStringValue()) == 0 {
+			continue
+		}
+		entries = append(entries, &core.Resources_ResourceEntry{
+			Name:  name,
+			Value: value.GetStringValue(),
+		})
 	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+func setEnvironmentResources(resources *core.Resources, taskExecutionModel *models.TaskExecution) error {
+	if resources == nil {
+		return nil
+	}
+	marshaledResources, err := proto.Marshal(resources)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal task execution resources with error: %v", err)
+	}
+	taskExecutionModel.Resources = marshaledResources
 	return nil
 }
 
+func getEnvironmentResources(taskExecutionModel models.TaskExecution) *core.Resources {
+	if len(taskExecutionModel.Resources) == 0 {
+		return nil
+	}
+	var resources core.Resources
+	if err := proto.Unmarshal(taskExecutionModel.Resources, &resources); err != nil {
+		logger.Warningf(context.Background(), "failed to unmarshal resources of task execution [%+v] with err: %v",
+			taskExecutionModel.TaskExecutionKey, err)
+		return nil
+	}
+	return &resources
+}
+
+// Records the environment reported in the custom info of a task execution event, in place of the one recorded so far.
+// Resource requests and limits are replaced separately, and only when reported. Returns whether the recorded
+// environment changed.
+func addEnvironment(customInfo *ptypesStruct.Struct, taskExecutionModel *models.TaskExecution) (bool, error) {
+	environment := customInfo.GetFields()[EnvironmentKey].GetStructValue()
+	if environment == nil {
+		return false, nil
+	}
+	var changed bool
+	if image := environment.Fields[EnvironmentImage].GetStringValue(); len(image) > 0 &&
+		image != taskExecutionModel.ContainerImage {
+		taskExecutionModel.ContainerImage = image
+		changed = true
+	}
+	if queue := environment.Fields[EnvironmentQueue].GetStringValue(); len(queue) > 0 &&
+		queue != taskExecutionModel.Queue {
+		taskExecutionModel.Queue = queue
+		changed = true
+	}
+	reportedResources := environment.Fields[EnvironmentResources].GetStructValue()
+	requests := getReportedResourceEntries(reportedResources.GetFields()[EnvironmentRequests].GetStructValue())
+	limits := getReportedResourceEntries(reportedResources.GetFields()[EnvironmentLimits].GetStructValue())
+	if len(requests) == 0 && len(limits) == 0 {
+		return changed, nil
+	}
+	resources := getEnvironmentResources(*taskExecutionModel)
+	if resources == nil {
+		resources = &core.Resources{}
+	}
+	updatedResources := proto.Clone(resources).(*core.Resources)
+	if len(requests) > 0 {
+		updatedResources.Requests = requests
+	}
+	if len(limits) > 0 {
+		updatedResources.Limits = limits
+	}
+	if proto.Equal(resources, updatedResources) {
+		return changed, nil
+	}
+	return true, setEnvironmentResources(updatedResources, taskExecutionModel)
+}
+
+func getResourceEntriesValue(entries []*core.Resources_ResourceEntry) *ptypesStruct.Value {
+	fields := make(map[string]*ptypesStruct.Value, len(entries))
+	for _, entry := range entries {
+		fields[strings.ToLower(entry.Name.String())] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_StringValue{StringValue: entry.Value},
+		}
+	}
+	return &ptypesStruct.Value{
+		Kind: &ptypesStruct.Value_StructValue{
+			StructValue: &ptypesStruct.Struct{
+				Fields: fields,
+			},
+		},
+	}
+}
+
+// Returns the custom info with its environment replaced by the one recorded for the task execution, which also accounts
+// for the environment derived from the compiled task and reported by earlier events.
+func withEnvironment(
+	customInfo *ptypesStruct.Struct, taskExecutionModel models.TaskExecution) *ptypesStruct.Struct {
+	environment := make(map[string]*ptypesStruct.Value)
+	if len(taskExecutionModel.ContainerImage) > 0 {
+		environment[EnvironmentImage] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_StringValue{StringValue: taskExecutionModel.ContainerImage},
+		}
+	}
+	if len(taskExecutionModel.Queue) > 0 {
+		environment[EnvironmentQueue] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_StringValue{StringValue: taskExecutionModel.Queue},
+		}
+	}
+	if resources := getEnvironmentResources(taskExecutionModel); resources != nil {
+		environment[EnvironmentResources] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_StructValue{
+				StructValue: &ptypesStruct.Struct{
+					Fields: map[string]*ptypesStruct.Value{
+						EnvironmentRequests: getResourceEntriesValue(resources.Requests),
+						EnvironmentLimits:   getResourceEntriesValue(resources.Limits),
+					},
+				},
+			},
+		}
+	}
+	if len(environment) == 0 {
+		return customInfo
+	}
+	if customInfo == nil {
+		customInfo = &ptypesStruct.Struct{}
+	}
+	if customInfo.Fields == nil {
+		customInfo.Fields = make(map[string]*ptypesStruct.Value)
+	}
+	customInfo.Fields[EnvironmentKey] = &ptypesStruct.Value{
+		Kind: &ptypesStruct.Value_StructValue{
+			StructValue: &ptypesStruct.Struct{
+				Fields: environment,
+			},
+		},
+	}
+	return customInfo
+}
+
 func CreateTaskExecutionModel(input CreateTaskExecutionModelInput) (*models.TaskExecution, error) {
 	taskExecution := &models.TaskExecution{
 		TaskExecutionKey: models.TaskExecutionKey{
@@ -81,9 +394,15 @@ func CreateTaskExecutionModel(input CreateTaskExecutionModelInput) (*models.Task
 			RetryAttempt: &input.Request.Event.RetryAttempt,
 		},
 
-		Phase:        input.Request.Event.Phase.String(),
-		PhaseVersion: input.Request.Event.PhaseVersion,
-		InputURI:     input.Request.Event.InputUri,
+		Phase:                 input.Request.Event.Phase.String(),
+		PhaseVersion:          input.Request.Event.PhaseVersion,
+		InputURI:              input.Request.Event.InputUri,
+		PreviousCheckpointURI: input.PreviousCheckpointURI,
+		ContainerImage:        input.Environment.Image,
+		Queue:                 input.Environment.Queue,
+	}
+	if err := setEnvironmentResources(input.Environment.Resources, taskExecution); err != nil {
+		return nil, err
 	}
 
 	closure := &admin.TaskExecutionClosure{
@@ -93,6 +412,11 @@ func CreateTaskExecutionModel(input CreateTaskExecutionModelInput) (*models.Task
 		Logs:       input.Request.Event.Logs,
 		CustomInfo: input.Request.Event.CustomInfo,
 	}
+	addResourceUsage(input.Request.Event.CustomInfo, taskExecution)
+	addCheckpoint(input.Request.Event.CustomInfo, taskExecution)
+	if _, err := addEnvironment(input.Request.Event.CustomInfo, taskExecution); err != nil {
+		return nil, err
+	}
 
 	eventPhase := input.Request.Event.Phase
 
@@ -179,6 +503,11 @@ func UpdateTaskExecutionModel(request *admin.TaskExecutionEventRequest, taskExec
 		}
 	}
 	taskExecutionClosure.CustomInfo = request.Event.CustomInfo
+	addResourceUsage(request.Event.CustomInfo, taskExecutionModel)
+	addCheckpoint(request.Event.CustomInfo, taskExecutionModel)
+	if _, err := addEnvironment(request.Event.CustomInfo, taskExecutionModel); err != nil {
+		return err
+	}
 	marshaledClosure, err := proto.Marshal(&taskExecutionClosure)
 	if err != nil {
 		return errors.NewFlyteAdminErrorf(
@@ -193,12 +522,70 @@ func UpdateTaskExecutionModel(request *admin.TaskExecutionEventRequest, taskExec
 	return nil
 }
 
+// Adds metadata from an event which arrived after the task execution reached a terminal phase to its closure without
+// changing the recorded phase: an output URI when the closure has no output result yet, logs which weren't recorded
+// and custom info when there is none. Resource usage, checkpoints and the environment reported by the event are
+// recorded too. Returns whether the task execution changed.
+func EnrichTaskExecutionModel(
+	request *admin.TaskExecutionEventRequest, taskExecutionModel *models.TaskExecution) (bool, error) {
+	var taskExecutionClosure admin.TaskExecutionClosure
+	err := proto.Unmarshal(taskExecutionModel.Closure, &taskExecutionClosure)
+	if err != nil {
+		return false, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal task execution closure with error: %+v", err)
+	}
+	var enriched bool
+	if taskExecutionClosure.OutputResult == nil && request.Event.GetOutputUri() != "" {
+		taskExecutionClosure.OutputResult = &admin.TaskExecutionClosure_OutputUri{
+			OutputUri: request.Event.GetOutputUri(),
+		}
+		taskExecutionModel.OutputURI = request.Event.GetOutputUri()
+		enriched = true
+	}
+	// Recorded logs take precedence so that only previously unseen logs are added.
+	logs := mergeLogs(request.Event.Logs, taskExecutionClosure.Logs)
+	if len(logs) > len(taskExecutionClosure.Logs) {
+		taskExecutionClosure.Logs = logs
+		enriched = true
+	}
+	if taskExecutionClosure.CustomInfo == nil && request.Event.CustomInfo != nil {
+		taskExecutionClosure.CustomInfo = request.Event.CustomInfo
+		enriched = true
+	}
+	if addResourceUsage(request.Event.CustomInfo, taskExecutionModel) {
+		enriched = true
+	}
+	if addCheckpoint(request.Event.CustomInfo, taskExecutionModel) {
+		enriched = true
+	}
+	environmentChanged, err := addEnvironment(request.Event.CustomInfo, taskExecutionModel)
+	if err != nil {
+		return false, err
+	}
+	if environmentChanged {
+		enriched = true
+	}
+	if !enriched {
+		return false, nil
+	}
+	marshaledClosure, err := proto.Marshal(&taskExecutionClosure)
+	if err != nil {
+		return false, errors.NewFlyteAdminErrorf(
+			codes.Internal, "failed to marshal task execution closure with error: %v", err)
+	}
+	taskExecutionModel.Closure = marshaledClosure
+	return true, nil
+}
+
 func FromTaskExecutionModel(taskExecutionModel models.TaskExecution) (*admin.TaskExecution, error) {
 	var closure admin.TaskExecutionClosure
 	err := proto.Unmarshal(taskExecutionModel.Closure, &closure)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal closure")
 	}
+	closure.CustomInfo = withResourceUsage(closure.CustomInfo, taskExecutionModel)
+	closure.CustomInfo = withCheckpoints(closure.CustomInfo, taskExecutionModel)
+	closure.CustomInfo = withEnvironment(closure.CustomInfo, taskExecutionModel)
 
 	taskExecution := &admin.TaskExecution{
 		Id: &core.TaskExecutionIdentifier{
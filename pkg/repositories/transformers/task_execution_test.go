@@ -122,6 +122,7 @@ func TestAddTaskTerminalState_OutputURI(t *testing.T) {
 	assert.EqualValues(t, time.Minute, duration)
 
 	assert.Equal(t, time.Minute, taskExecutionModel.Duration)
+	assert.Equal(t, outputURI, taskExecutionModel.OutputURI)
 }
 
 func TestCreateTaskExecutionModelQueued(t *testing.T) {
@@ -385,6 +386,7 @@ func TestUpdateTaskExecutionModelRunningToFailed(t *testing.T) {
 		TaskExecutionUpdatedAt: &occuredAt,
 		TaskExecutionCreatedAt: &taskEventOccurredAt,
 		Duration:               time.Minute,
+		ErrorURI:               "error.pb",
 	}, existingTaskExecution)
 
 }
@@ -606,3 +608,351 @@ func TestMergeLogs(t *testing.T) {
 		}
 	}
 }
+
+func TestEnrichTaskExecutionModel(t *testing.T) {
+	failedClosure, _ := proto.Marshal(&admin.TaskExecutionClosure{
+		Phase: core.TaskExecution_FAILED,
+		OutputResult: &admin.TaskExecutionClosure_Error{
+			Error: &core.ExecutionError{
+				Message: "failed",
+			},
+		},
+		Logs: []*core.TaskLog{
+			{
+				Uri:  "uri_a",
+				Name: "log a",
+			},
+		},
+	})
+	taskExecutionModel := models.TaskExecution{
+		Phase:   core.TaskExecution_FAILED.String(),
+		Closure: failedClosure,
+	}
+	request := admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			Phase: core.TaskExecution_RUNNING,
+			OutputResult: &event.TaskExecutionEvent_OutputUri{
+				OutputUri: "output uri",
+			},
+			Logs: []*core.TaskLog{
+				{
+					Uri:  "uri_a",
+					Name: "renamed log a",
+				},
+				{
+					Uri: "uri_b",
+				},
+			},
+			CustomInfo: &customInfo,
+		},
+	}
+	enriched, err := EnrichTaskExecutionModel(&request, &taskExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, enriched)
+	assert.Equal(t, core.TaskExecution_FAILED.String(), taskExecutionModel.Phase)
+	var enrichedClosure admin.TaskExecutionClosure
+	assert.Nil(t, proto.Unmarshal(taskExecutionModel.Closure, &enrichedClosure))
+	assert.Equal(t, core.TaskExecution_FAILED, enrichedClosure.Phase)
+	assert.Equal(t, "failed", enrichedClosure.GetError().Message)
+	assert.Len(t, enrichedClosure.Logs, 2)
+	assert.Equal(t, "log a", enrichedClosure.Logs[0].Name)
+	assert.Equal(t, "uri_b", enrichedClosure.Logs[1].Uri)
+	assert.True(t, proto.Equal(&customInfo, enrichedClosure.CustomInfo))
+
+	// Events repeating recorded metadata have nothing to add.
+	enriched, err = EnrichTaskExecutionModel(&request, &taskExecutionModel)
+	assert.Nil(t, err)
+	assert.False(t, enriched)
+}
+
+func getResourceUsageCustomInfo(resourceUsage map[string]*ptypesStruct.Value) *ptypesStruct.Struct {
+	return &ptypesStruct.Struct{
+		Fields: map[string]*ptypesStruct.Value{
+			"phase": {
+				Kind: &ptypesStruct.Value_StringValue{
+					StringValue: "value",
+				},
+			},
+			ResourceUsageKey: {
+				Kind: &ptypesStruct.Value_StructValue{
+					StructValue: &ptypesStruct.Struct{
+						Fields: resourceUsage,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getNumberValue(value float64) *ptypesStruct.Value {
+	return &ptypesStruct.Value{
+		Kind: &ptypesStruct.Value_NumberValue{
+			NumberValue: value,
+		},
+	}
+}
+
+func TestAddResourceUsage(t *testing.T) {
+	taskExecutionModel := models.TaskExecution{}
+	assert.False(t, addResourceUsage(&customInfo, &taskExecutionModel))
+	assert.False(t, addResourceUsage(nil, &taskExecutionModel))
+
+	assert.True(t, addResourceUsage(getResourceUsageCustomInfo(map[string]*ptypesStruct.Value{
+		ResourceUsageMaxMemoryBytes: getNumberValue(2048),
+		ResourceUsageCPUSeconds:     getNumberValue(10.5),
+		ResourceUsageGPUUtilization: getNumberValue(0.5),
+	}), &taskExecutionModel))
+	assert.Equal(t, int64(2048), *taskExecutionModel.MaxMemoryBytes)
+	assert.Equal(t, 10.5, *taskExecutionModel.CPUSeconds)
+	assert.Equal(t, 0.5, *taskExecutionModel.GPUUtilization)
+
+	// The largest memory and cpu usage reported are kept.
+	assert.True(t, addResourceUsage(getResourceUsageCustomInfo(map[string]*ptypesStruct.Value{
+		ResourceUsageMaxMemoryBytes: getNumberValue(1024),
+		ResourceUsageCPUSeconds:     getNumberValue(20),
+		ResourceUsageGPUUtilization: getNumberValue(0.25),
+	}), &taskExecutionModel))
+	assert.Equal(t, int64(2048), *taskExecutionModel.MaxMemoryBytes)
+	assert.Equal(t, float64(20), *taskExecutionModel.CPUSeconds)
+	assert.Equal(t, 0.25, *taskExecutionModel.GPUUtilization)
+
+	// Invalid values are ignored.
+	assert.False(t, addResourceUsage(getResourceUsageCustomInfo(map[string]*ptypesStruct.Value{
+		ResourceUsageMaxMemoryBytes: {
+			Kind: &ptypesStruct.Value_StringValue{
+				StringValue: "4Gi",
+			},
+		},
+		ResourceUsageCPUSeconds:     getNumberValue(-1),
+		ResourceUsageGPUUtilization: getNumberValue(2),
+	}), &taskExecutionModel))
+	assert.Equal(t, int64(2048), *taskExecutionModel.MaxMemoryBytes)
+	assert.Equal(t, float64(20), *taskExecutionModel.CPUSeconds)
+	assert.Equal(t, 0.25, *taskExecutionModel.GPUUtilization)
+}
+
+func TestUpdateTaskExecutionModel_ResourceUsage(t *testing.T) {
+	closureBytes, err := proto.Marshal(&admin.TaskExecutionClosure{
+		Phase: core.TaskExecution_RUNNING,
+	})
+	assert.Nil(t, err)
+	maxMemoryBytes := int64(4096)
+	taskExecutionModel := models.TaskExecution{
+		Phase:          core.TaskExecution_RUNNING.String(),
+		Closure:        closureBytes,
+		MaxMemoryBytes: &maxMemoryBytes,
+	}
+	err = UpdateTaskExecutionModel(&admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			Phase:      core.TaskExecution_RUNNING,
+			OccurredAt: taskEventOccurredAtProto,
+			CustomInfo: getResourceUsageCustomInfo(map[string]*ptypesStruct.Value{
+				ResourceUsageMaxMemoryBytes: getNumberValue(1024),
+				ResourceUsageCPUSeconds:     getNumberValue(30),
+			}),
+		},
+	}, &taskExecutionModel)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4096), *taskExecutionModel.MaxMemoryBytes)
+	assert.Equal(t, float64(30), *taskExecutionModel.CPUSeconds)
+	assert.Nil(t, taskExecutionModel.GPUUtilization)
+
+	// The recorded resource usage replaces the one in the custom info of the latest event.
+	taskExecution, err := FromTaskExecutionModel(models.TaskExecution{
+		TaskExecutionKey: models.TaskExecutionKey{
+			RetryAttempt: &retryAttemptValue,
+		},
+		Closure:        taskExecutionModel.Closure,
+		MaxMemoryBytes: taskExecutionModel.MaxMemoryBytes,
+		CPUSeconds:     taskExecutionModel.CPUSeconds,
+	})
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(getResourceUsageCustomInfo(map[string]*ptypesStruct.Value{
+		ResourceUsageMaxMemoryBytes: getNumberValue(4096),
+		ResourceUsageCPUSeconds:     getNumberValue(30),
+	}), taskExecution.Closure.CustomInfo))
+}
+
+func getCheckpointCustomInfo(checkpointURI string) *ptypesStruct.Struct {
+	return &ptypesStruct.Struct{
+		Fields: map[string]*ptypesStruct.Value{
+			CheckpointKey: {
+				Kind: &ptypesStruct.Value_StringValue{
+					StringValue: checkpointURI,
+				},
+			},
+		},
+	}
+}
+
+func TestAddCheckpoint(t *testing.T) {
+	taskExecutionModel := models.TaskExecution{}
+	assert.False(t, addCheckpoint(&customInfo, &taskExecutionModel))
+	assert.False(t, addCheckpoint(nil, &taskExecutionModel))
+
+	assert.True(t, addCheckpoint(getCheckpointCustomInfo("s3://bucket/checkpoints/1"), &taskExecutionModel))
+	assert.Equal(t, "s3://bucket/checkpoints/1", taskExecutionModel.CheckpointURI)
+	assert.False(t, addCheckpoint(getCheckpointCustomInfo("s3://bucket/checkpoints/1"), &taskExecutionModel))
+
+	assert.True(t, addCheckpoint(getCheckpointCustomInfo("s3://bucket/checkpoints/2"), &taskExecutionModel))
+	assert.Equal(t, "s3://bucket/checkpoints/2", taskExecutionModel.CheckpointURI)
+}
+
+func TestCreateTaskExecutionModel_Checkpoints(t *testing.T) {
+	taskExecutionModel, err := CreateTaskExecutionModel(CreateTaskExecutionModelInput{
+		Request: &admin.TaskExecutionEventRequest{
+			Event: &event.TaskExecutionEvent{
+				TaskId:                sampleTaskID,
+				ParentNodeExecutionId: sampleNodeExecID,
+				Phase:                 core.TaskExecution_RUNNING,
+				RetryAttempt:          retryAttemptValue,
+				OccurredAt:            taskEventOccurredAtProto,
+				CustomInfo:            getCheckpointCustomInfo("s3://bucket/checkpoints/2"),
+			},
+		},
+		PreviousCheckpointURI: "s3://bucket/checkpoints/1",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://bucket/checkpoints/2", taskExecutionModel.CheckpointURI)
+	assert.Equal(t, "s3://bucket/checkpoints/1", taskExecutionModel.PreviousCheckpointURI)
+
+	// The latest checkpoint is served even when the latest event didn't report it.
+	err = UpdateTaskExecutionModel(&admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			Phase:      core.TaskExecution_RUNNING,
+			OccurredAt: taskEventOccurredAtProto,
+			CustomInfo: &customInfo,
+		},
+	}, taskExecutionModel)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://bucket/checkpoints/2", taskExecutionModel.CheckpointURI)
+	taskExecution, err := FromTaskExecutionModel(*taskExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&ptypesStruct.Struct{
+		Fields: map[string]*ptypesStruct.Value{
+			"phase": {
+				Kind: &ptypesStruct.Value_StringValue{
+					StringValue: "value",
+				},
+			},
+			CheckpointKey: {
+				Kind: &ptypesStruct.Value_StringValue{
+					StringValue: "s3://bucket/checkpoints/2",
+				},
+			},
+			PreviousCheckpointKey: {
+				Kind: &ptypesStruct.Value_StringValue{
+					StringValue: "s3://bucket/checkpoints/1",
+				},
+			},
+		},
+	}, taskExecution.Closure.CustomInfo))
+}
+
+func getStringValue(value string) *ptypesStruct.Value {
+	return &ptypesStruct.Value{
+		Kind: &ptypesStruct.Value_StringValue{
+			StringValue: value,
+		},
+	}
+}
+
+func getStructValue(fields map[string]*ptypesStruct.Value) *ptypesStruct.Value {
+	return &ptypesStruct.Value{
+		Kind: &ptypesStruct.Value_StructValue{
+			StructValue: &ptypesStruct.Struct{
+				Fields: fields,
+			},
+		},
+	}
+}
+
+func TestAddEnvironment(t *testing.T) {
+	taskExecutionModel := models.TaskExecution{
+		ContainerImage: "repo/image:derived",
+	}
+	changed, err := addEnvironment(&customInfo, &taskExecutionModel)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	changed, err = addEnvironment(&ptypesStruct.Struct{
+		Fields: map[string]*ptypesStruct.Value{
+			EnvironmentKey: getStructValue(map[string]*ptypesStruct.Value{
+				EnvironmentQueue: getStringValue("gpu"),
+				EnvironmentResources: getStructValue(map[string]*ptypesStruct.Value{
+					EnvironmentRequests: getStructValue(map[string]*ptypesStruct.Value{
+						"memory":  getStringValue("1Gi"),
+						"cpu":     getStringValue("2"),
+						"unknown": getStringValue("1"),
+					}),
+				}),
+			}),
+		},
+	}, &taskExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "repo/image:derived", taskExecutionModel.ContainerImage)
+	assert.Equal(t, "gpu", taskExecutionModel.Queue)
+	assert.True(t, proto.Equal(&core.Resources{
+		Requests: []*core.Resources_ResourceEntry{
+			{Name: core.Resources_CPU, Value: "2"},
+			{Name: core.Resources_MEMORY, Value: "1Gi"},
+		},
+	}, getEnvironmentResources(taskExecutionModel)))
+}
+
+func TestCreateTaskExecutionModel_Environment(t *testing.T) {
+	taskExecutionModel, err := CreateTaskExecutionModel(CreateTaskExecutionModelInput{
+		Request: &admin.TaskExecutionEventRequest{
+			Event: &event.TaskExecutionEvent{
+				TaskId:                sampleTaskID,
+				ParentNodeExecutionId: sampleNodeExecID,
+				Phase:                 core.TaskExecution_RUNNING,
+				RetryAttempt:          retryAttemptValue,
+				OccurredAt:            taskEventOccurredAtProto,
+				CustomInfo: &ptypesStruct.Struct{
+					Fields: map[string]*ptypesStruct.Value{
+						EnvironmentKey: getStructValue(map[string]*ptypesStruct.Value{
+							EnvironmentImage: getStringValue("repo/image:reported"),
+							EnvironmentResources: getStructValue(map[string]*ptypesStruct.Value{
+								EnvironmentLimits: getStructValue(map[string]*ptypesStruct.Value{
+									"cpu": getStringValue("4"),
+								}),
+							}),
+						}),
+					},
+				},
+			},
+		},
+		Environment: TaskExecutionEnvironment{
+			Image: "repo/image:derived",
+			Resources: &core.Resources{
+				Requests: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_CPU, Value: "1"},
+				},
+				Limits: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_CPU, Value: "2"},
+				},
+			},
+			Queue: "default",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "repo/image:reported", taskExecutionModel.ContainerImage)
+	assert.Equal(t, "default", taskExecutionModel.Queue)
+
+	taskExecution, err := FromTaskExecutionModel(*taskExecutionModel)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(getStructValue(map[string]*ptypesStruct.Value{
+		EnvironmentImage: getStringValue("repo/image:reported"),
+		EnvironmentQueue: getStringValue("default"),
+		EnvironmentResources: getStructValue(map[string]*ptypesStruct.Value{
+			EnvironmentRequests: getStructValue(map[string]*ptypesStruct.Value{
+				"cpu": getStringValue("1"),
+			}),
+			EnvironmentLimits: getStructValue(map[string]*ptypesStruct.Value{
+				"cpu": getStringValue("4"),
+			}),
+		}),
+	}), taskExecution.Closure.CustomInfo.Fields[EnvironmentKey]))
+}
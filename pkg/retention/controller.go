@@ -0,0 +1,327 @@
+// Garbage-collects the offloaded data of executions which have outlived the retention policy of their domain.
+package retention
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lyft/flyteadmin/pkg/common"
+	dataInterfaces "github.com/lyft/flyteadmin/pkg/data/interfaces"
+	"github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const defaultBatchSize = 100
+const defaultRefreshInterval = time.Hour
+
+const createdAtColumn = "created_at"
+const domainColumn = "domain"
+const phaseColumn = "phase"
+const dataRemovedAtColumn = "data_removed_at"
+const inputsURIColumn = "inputs_uri"
+const userInputsURIColumn = "user_inputs_uri"
+
+var terminalExecutionPhases = []string{
+	core.WorkflowExecution_SUCCEEDED.String(),
+	core.WorkflowExecution_FAILED.String(),
+	core.WorkflowExecution_ABORTED.String(),
+	core.WorkflowExecution_TIMED_OUT.String(),
+}
+
+// Executions are listed joined with their launch plans and workflows so the sort key must be qualified.
+var ascCreatedAtSortParam, _ = common.NewSortParameter(admin.Sort{
+	Direction: admin.Sort_ASCENDING,
+	Key:       "executions.created_at",
+})
+
+// The retention Controller removes the offloaded inputs and outputs of terminated executions once they are older
+// than the retention policy configured for their domain.
+type Controller interface {
+	Sweep(ctx context.Context) error
+	Run()
+}
+
+type controllerMetrics struct {
+	Scope             promutils.Scope
+	ExecutionsExpired prometheus.Counter
+	RecordsDeleted    prometheus.Counter
+	BlobsRemoved      prometheus.Counter
+	BytesReclaimed    prometheus.Counter
+	BytesReclaimable  prometheus.Counter
+	SharedBlobsKept   prometheus.Counter
+	RemoveErrors      prometheus.Counter
+	Panics            prometheus.Counter
+}
+
+type controller struct {
+	db            repositories.RepositoryInterface
+	config        runtimeInterfaces.ApplicationConfiguration
+	storageClient *storage.DataStore
+	remover       dataInterfaces.RemoteDataRemover
+	metrics       controllerMetrics
+	_clock        clock.Clock
+}
+
+// Returns the offloaded data written for an execution: its inputs and the outputs referenced by its closure.
+func getDataReferences(execution models.Execution) ([]storage.DataReference, error) {
+	references := make([]storage.DataReference, 0, 3)
+	for _, reference := range []storage.DataReference{execution.InputsURI, execution.UserInputsURI} {
+		if len(reference) > 0 {
+			references = append(references, reference)
+		}
+	}
+	var closure admin.ExecutionClosure
+	if err := proto.Unmarshal(execution.Closure, &closure); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal closure for execution [%s/%s/%s] with err: %v",
+			execution.Project, execution.Domain, execution.Name, err)
+	}
+	if outputsURI := closure.GetOutputs().GetUri(); len(outputsURI) > 0 {
+		references = append(references, storage.DataReference(outputsURI))
+	}
+	return references, nil
+}
+
+// Offloaded inputs are expected to be written per execution, but they're reference-counted before removal so that
+// data still in use by an unexpired execution is never deleted.
+func (c *controller) isShared(ctx context.Context, execution models.Execution, reference storage.DataReference) (
+	bool, error) {
+	for _, column := range []string{inputsURIColumn, userInputsURIColumn} {
+		filter, err := common.NewSingleValueFilter(common.Execution, common.Equal, column, reference.String())
+		if err != nil {
+			return false, err
+		}
+		output, err := c.db.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         2,
+			InlineFilters: []common.InlineFilter{filter},
+			MapFilters: []common.MapFilter{
+				common.NewMapFilter(map[string]interface{}{dataRemovedAtColumn: nil}),
+			},
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, referencingExecution := range output.Executions {
+			if referencingExecution.ExecutionKey != execution.ExecutionKey {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Removes the offloaded data for a single expired execution. The execution is only marked (or deleted) once all of
+// its data was removed so that failed removals are retried on the next sweep.
+func (c *controller) expireExecution(
+	ctx context.Context, execution models.Execution, policy runtimeInterfaces.RetentionPolicy, dryRun bool) error {
+	references, err := getDataReferences(execution)
+	if err != nil {
+		return err
+	}
+	for _, reference := range references {
+		shared, err := c.isShared(ctx, execution, reference)
+		if err != nil {
+			return err
+		}
+		if shared {
+			logger.Debugf(ctx, "Keeping [%s] for expired execution [%s/%s/%s] as it is still referenced",
+				reference, execution.Project, execution.Domain, execution.Name)
+			c.metrics.SharedBlobsKept.Inc()
+			continue
+		}
+		metadata, err := c.storageClient.Head(ctx, reference)
+		if err != nil {
+			return err
+		}
+		if !metadata.Exists() {
+			continue
+		}
+		if dryRun {
+			logger.Infof(ctx, "Dry run: would remove [%s] (%d bytes) for expired execution [%s/%s/%s]",
+				reference, metadata.Size(), execution.Project, execution.Domain, execution.Name)
+			c.metrics.BytesReclaimable.Add(float64(metadata.Size()))
+			continue
+		}
+		if err := c.remover.Remove(ctx, reference.String()); err != nil {
+			c.metrics.RemoveErrors.Inc()
+			return err
+		}
+		c.metrics.BlobsRemoved.Inc()
+		c.metrics.BytesReclaimed.Add(float64(metadata.Size()))
+	}
+	if dryRun {
+		return nil
+	}
+	c.metrics.ExecutionsExpired.Inc()
+	if policy.DeleteRecords {
+		if err := c.db.ExecutionRepo().Delete(ctx, execution.ExecutionKey); err != nil {
+			return err
+		}
+		c.metrics.RecordsDeleted.Inc()
+		return nil
+	}
+	removedAt := c._clock.Now()
+	execution.DataRemovedAt = &removedAt
+	return c.db.ExecutionRepo().UpdateExecution(ctx, execution)
+}
+
+func (c *controller) sweepDomain(
+	ctx context.Context, domain string, policy runtimeInterfaces.RetentionPolicy, batchSize int, dryRun bool) error {
+	if policy.MaxAge.Duration <= 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"retention policy for domain [%s] must specify a positive max age", domain)
+	}
+	cutoff := c._clock.Now().Add(-policy.MaxAge.Duration)
+	domainFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, domainColumn, domain)
+	if err != nil {
+		return err
+	}
+	createdAtFilter, err := common.NewSingleValueFilter(common.Execution, common.LessThan, createdAtColumn, cutoff)
+	if err != nil {
+		return err
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, phaseColumn,
+		terminalExecutionPhases)
+	if err != nil {
+		return err
+	}
+	var errs = make([]error, 0)
+	// Expired executions drop out of the listing once their data is removed, so the offset only skips past those left
+	// behind: every execution in a dry run and those whose data failed to be removed. Otherwise they would fill every
+	// page and keep the executions after them from ever expiring.
+	var offset int
+	for {
+		output, err := c.db.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         batchSize,
+			Offset:        offset,
+			InlineFilters: []common.InlineFilter{domainFilter, createdAtFilter, phaseFilter},
+			MapFilters: []common.MapFilter{
+				common.NewMapFilter(map[string]interface{}{dataRemovedAtColumn: nil}),
+			},
+			SortParameter: ascCreatedAtSortParam,
+		})
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		logger.Debugf(ctx, "Found %d executions in domain [%s] created before %v at offset %d",
+			len(output.Executions), domain, cutoff, offset)
+		for _, execution := range output.Executions {
+			if err := c.expireExecution(ctx, execution, policy, dryRun); err != nil {
+				logger.Warningf(ctx, "Failed to remove data for expired execution [%s/%s/%s] with err: %v",
+					execution.Project, execution.Domain, execution.Name, err)
+				errs = append(errs, err)
+				offset++
+			} else if dryRun {
+				offset++
+			}
+		}
+		if len(output.Executions) < batchSize {
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (c *controller) Sweep(ctx context.Context) error {
+	defer func() {
+		if err := recover(); err != nil {
+			c.metrics.Panics.Inc()
+			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+
+	retentionConfig := c.config.GetRetentionConfig()
+	batchSize := retentionConfig.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	domains := make([]string, 0, len(retentionConfig.Domains))
+	for domain := range retentionConfig.Domains {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	var errs = make([]error, 0)
+	for _, domain := range domains {
+		err := c.sweepDomain(ctx, domain, retentionConfig.Domains[domain], batchSize, retentionConfig.DryRun)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to sweep expired executions in domain [%s] with err: %v", domain, err)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (c *controller) Run() {
+	ctx := context.Background()
+	logger.Infof(ctx, "Running RetentionController")
+	interval := c.config.GetRetentionConfig().RefreshInterval.Duration
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	wait.Forever(func() {
+		err := c.Sweep(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed retention sweep with: %v", err)
+		}
+	}, interval)
+}
+
+func newMetrics(scope promutils.Scope) controllerMetrics {
+	return controllerMetrics{
+		Scope: scope,
+		ExecutionsExpired: scope.MustNewCounter("executions_expired",
+			"overall count of executions whose offloaded data was removed"),
+		RecordsDeleted: scope.MustNewCounter("records_deleted",
+			"overall count of expired executions deleted from the database"),
+		BlobsRemoved: scope.MustNewCounter("blobs_removed",
+			"overall count of offloaded data blobs removed"),
+		BytesReclaimed: scope.MustNewCounter("bytes_reclaimed",
+			"overall size in bytes of removed offloaded data"),
+		BytesReclaimable: scope.MustNewCounter("bytes_reclaimable",
+			"overall size in bytes of offloaded data which would have been removed in dry-run mode"),
+		SharedBlobsKept: scope.MustNewCounter("shared_blobs_kept",
+			"overall count of offloaded data blobs kept because an unexpired execution references them"),
+		RemoveErrors: scope.MustNewCounter("remove_errors",
+			"overall count of errors encountered removing offloaded data"),
+		Panics: scope.MustNewCounter("panics",
+			"overall count of panics encountered in primary RetentionController loop"),
+	}
+}
+
+func NewRetentionController(
+	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration,
+	storageClient *storage.DataStore, remover dataInterfaces.RemoteDataRemover, scope promutils.Scope) Controller {
+	return &controller{
+		db:            db,
+		config:        config,
+		storageClient: storageClient,
+		remover:       remover,
+		metrics:       newMetrics(scope),
+		_clock:        clock.New(),
+	}
+}
@@ -0,0 +1,323 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flytestdlib/config"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/stretchr/testify/assert"
+
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
+	dataMocks "github.com/lyft/flyteadmin/pkg/data/mocks"
+	repoInterfaces "github.com/lyft/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/lyft/flyteadmin/pkg/repositories/mocks"
+	"github.com/lyft/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/lyft/flyteadmin/pkg/runtime/mocks"
+)
+
+const inputsURI = "s3://bucket/metadata/project/development/name/inputs"
+const userInputsURI = "s3://bucket/metadata/project/development/name/user_inputs"
+const outputsURI = "s3://bucket/metadata/project/development/name/outputs"
+const blobSize = int64(100)
+
+var sweepTime = time.Date(2019, time.November, 16, 0, 0, 0, 0, time.UTC)
+
+var expiredExecutionKey = models.ExecutionKey{
+	Project: "project",
+	Domain:  "development",
+	Name:    "name",
+}
+
+type mockMetadata struct{}
+
+func (m mockMetadata) Exists() bool {
+	return true
+}
+
+func (m mockMetadata) Size() int64 {
+	return blobSize
+}
+
+func getExpiredExecution(t *testing.T) models.Execution {
+	closure, err := proto.Marshal(&admin.ExecutionClosure{
+		OutputResult: &admin.ExecutionClosure_Outputs{
+			Outputs: &admin.LiteralMapBlob{
+				Data: &admin.LiteralMapBlob_Uri{
+					Uri: outputsURI,
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	return models.Execution{
+		ExecutionKey:  expiredExecutionKey,
+		Closure:       closure,
+		InputsURI:     inputsURI,
+		UserInputsURI: userInputsURI,
+	}
+}
+
+// Returns the expired execution when sweeping and only the expired execution itself for reference counts unless
+// sharedReference is referenced by another execution too.
+func setListCallback(t *testing.T, repository *repositoryMocks.MockRepository, sharedReference string) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionCollectionOutput, error) {
+			assert.Len(t, input.MapFilters, 1)
+			if len(input.InlineFilters) == 3 {
+				assert.Equal(t, defaultBatchSize, input.Limit)
+				createdAtQuery, err := input.InlineFilters[1].GetGormQueryExpr()
+				assert.NoError(t, err)
+				assert.Equal(t, sweepTime.Add(-24*time.Hour), createdAtQuery.Args)
+				return repoInterfaces.ExecutionCollectionOutput{
+					Executions: []models.Execution{getExpiredExecution(t)},
+				}, nil
+			}
+			query, err := input.InlineFilters[0].GetGormQueryExpr()
+			assert.NoError(t, err)
+			executions := []models.Execution{getExpiredExecution(t)}
+			if query.Args == sharedReference {
+				executions = append(executions, models.Execution{
+					ExecutionKey: models.ExecutionKey{
+						Project: "project",
+						Domain:  "development",
+						Name:    "relaunched",
+					},
+				})
+			}
+			return repoInterfaces.ExecutionCollectionOutput{
+				Executions: executions,
+			}, nil
+		})
+}
+
+func getTestController(
+	repository *repositoryMocks.MockRepository, remover *dataMocks.MockRemoteDataRemover,
+	retentionConfig runtimeInterfaces.RetentionConfig) Controller {
+	applicationConfig := runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetRetentionConfig(retentionConfig)
+	storageClient := commonMocks.GetMockStorageClient()
+	storageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).HeadCb =
+		func(ctx context.Context, reference storage.DataReference) (storage.Metadata, error) {
+			return mockMetadata{}, nil
+		}
+	mockClock := clock.NewMock()
+	mockClock.Set(sweepTime)
+	return &controller{
+		db:            repository,
+		config:        &applicationConfig,
+		storageClient: storageClient,
+		remover:       remover,
+		metrics:       newMetrics(mockScope.NewTestScope()),
+		_clock:        mockClock,
+	}
+}
+
+func getRetentionConfig(dryRun, deleteRecords bool) runtimeInterfaces.RetentionConfig {
+	return runtimeInterfaces.RetentionConfig{
+		Domains: map[string]runtimeInterfaces.RetentionPolicy{
+			"development": {
+				MaxAge:        config.Duration{Duration: 24 * time.Hour},
+				DeleteRecords: deleteRecords,
+			},
+		},
+		DryRun: dryRun,
+	}
+}
+
+func TestSweep(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository, "")
+	var updated bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			assert.Equal(t, expiredExecutionKey, execution.ExecutionKey)
+			assert.Equal(t, sweepTime, *execution.DataRemovedAt)
+			updated = true
+			return nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetDeleteCallback(
+		func(ctx context.Context, key models.ExecutionKey) error {
+			t.Fatal("unexpected delete")
+			return nil
+		})
+	var removed []string
+	remover := &dataMocks.MockRemoteDataRemover{
+		RemoveCallback: func(ctx context.Context, uri string) error {
+			removed = append(removed, uri)
+			return nil
+		},
+	}
+
+	err := getTestController(repository, remover, getRetentionConfig(false, false)).Sweep(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{inputsURI, userInputsURI, outputsURI}, removed)
+	assert.True(t, updated)
+}
+
+func TestSweep_DryRun(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository, "")
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			t.Fatal("unexpected update")
+			return nil
+		})
+	remover := &dataMocks.MockRemoteDataRemover{
+		RemoveCallback: func(ctx context.Context, uri string) error {
+			t.Fatalf("unexpected removal of [%s]", uri)
+			return nil
+		},
+	}
+
+	err := getTestController(repository, remover, getRetentionConfig(true, false)).Sweep(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestSweep_SharedInputs(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository, userInputsURI)
+	var removed []string
+	remover := &dataMocks.MockRemoteDataRemover{
+		RemoveCallback: func(ctx context.Context, uri string) error {
+			removed = append(removed, uri)
+			return nil
+		},
+	}
+
+	err := getTestController(repository, remover, getRetentionConfig(false, false)).Sweep(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{inputsURI, outputsURI}, removed)
+}
+
+func TestSweep_DeleteRecords(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository, "")
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			t.Fatal("unexpected update")
+			return nil
+		})
+	var deleted bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetDeleteCallback(
+		func(ctx context.Context, key models.ExecutionKey) error {
+			assert.Equal(t, expiredExecutionKey, key)
+			deleted = true
+			return nil
+		})
+
+	err := getTestController(repository, &dataMocks.MockRemoteDataRemover{}, getRetentionConfig(false, true)).Sweep(
+		context.Background())
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestSweep_RemoveError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setListCallback(t, repository, "")
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			t.Fatal("unexpected update")
+			return nil
+		})
+	remover := &dataMocks.MockRemoteDataRemover{
+		RemoveCallback: func(ctx context.Context, uri string) error {
+			return errors.New("access denied")
+		},
+	}
+
+	err := getTestController(repository, remover, getRetentionConfig(false, false)).Sweep(context.Background())
+	assert.Error(t, err)
+}
+
+// Stands in for the executions table, listing the expired executions whose data wasn't removed yet.
+func setPagedListCallback(repository *repositoryMocks.MockRepository, names []string,
+	removed map[string]bool, offsets *[]int) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) (
+			repoInterfaces.ExecutionCollectionOutput, error) {
+			if len(input.InlineFilters) != 3 {
+				// No data is shared between the executions.
+				return repoInterfaces.ExecutionCollectionOutput{}, nil
+			}
+			*offsets = append(*offsets, input.Offset)
+			var executions []models.Execution
+			for _, name := range names {
+				if !removed[name] {
+					executions = append(executions, models.Execution{
+						ExecutionKey: models.ExecutionKey{Project: "project", Domain: "development", Name: name},
+						InputsURI:    storage.DataReference("s3://bucket/" + name),
+					})
+				}
+			}
+			if input.Offset >= len(executions) {
+				return repoInterfaces.ExecutionCollectionOutput{}, nil
+			}
+			executions = executions[input.Offset:]
+			if len(executions) > input.Limit {
+				executions = executions[:input.Limit]
+			}
+			return repoInterfaces.ExecutionCollectionOutput{Executions: executions}, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			removed[execution.Name] = true
+			return nil
+		})
+}
+
+func TestSweep_PagesPastFailures(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	removed := make(map[string]bool)
+	var offsets []int
+	setPagedListCallback(repository, []string{"a", "b", "c", "d", "e"}, removed, &offsets)
+	remover := &dataMocks.MockRemoteDataRemover{
+		RemoveCallback: func(ctx context.Context, uri string) error {
+			if uri == "s3://bucket/a" || uri == "s3://bucket/b" {
+				return errors.New("access denied")
+			}
+			return nil
+		},
+	}
+	retentionConfig := getRetentionConfig(false, false)
+	retentionConfig.BatchSize = 2
+
+	err := getTestController(repository, remover, retentionConfig).Sweep(context.Background())
+	assert.Error(t, err)
+	// The executions failing to expire don't keep those after them from expiring.
+	assert.Equal(t, map[string]bool{"c": true, "d": true, "e": true}, removed)
+	assert.Equal(t, []int{0, 2, 2}, offsets)
+}
+
+func TestSweep_DryRunPages(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	removed := make(map[string]bool)
+	var offsets []int
+	setPagedListCallback(repository, []string{"a", "b", "c"}, removed, &offsets)
+	retentionConfig := getRetentionConfig(true, false)
+	retentionConfig.BatchSize = 2
+
+	err := getTestController(repository, &dataMocks.MockRemoteDataRemover{}, retentionConfig).Sweep(
+		context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, removed)
+	assert.Equal(t, []int{0, 2}, offsets)
+}
+
+func TestSweep_InvalidPolicy(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	retentionConfig := getRetentionConfig(false, false)
+	retentionConfig.Domains["development"] = runtimeInterfaces.RetentionPolicy{}
+
+	err := getTestController(repository, &dataMocks.MockRemoteDataRemover{}, retentionConfig).Sweep(
+		context.Background())
+	assert.Error(t, err)
+}
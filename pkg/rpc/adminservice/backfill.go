@@ -0,0 +1,107 @@
+package adminservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.BackfillExecutionsRequest. Inputs use the protobuf JSON mapping.
+type backfillExecutionsRequest struct {
+	LaunchPlan          *core.Identifier `json:"launchPlan"`
+	StartTime           time.Time        `json:"startTime"`
+	EndTime             time.Time        `json:"endTime"`
+	KickoffTimeInputArg string           `json:"kickoffTimeInputArg"`
+	Inputs              json.RawMessage  `json:"inputs"`
+}
+
+func (m *AdminService) BackfillExecutions(
+	ctx context.Context, request interfaces.BackfillExecutionsRequest) (*interfaces.BackfillExecutionsResponse, error) {
+	if err := authorizeProject(ctx, request.LaunchPlan.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.backfill)
+	}
+	requestedAt := time.Now()
+	var response *interfaces.BackfillExecutionsResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.backfill.Time(func() {
+		response, err = m.ExecutionManager.BackfillExecutions(ctx, request, requestedAt)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.backfill)
+	}
+	m.Metrics.executionEndpointMetrics.backfill.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetBackfill(ctx context.Context, id string) (*interfaces.Backfill, error) {
+	var response *interfaces.Backfill
+	var err error
+	m.Metrics.executionEndpointMetrics.getBackfill.Time(func() {
+		response, err = m.ExecutionManager.GetBackfill(ctx, id)
+	})
+	if err == nil {
+		err = authorizeProject(ctx, response.LaunchPlan.GetProject())
+	}
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.getBackfill)
+	}
+	m.Metrics.executionEndpointMetrics.getBackfill.Success()
+	return response, nil
+}
+
+// POSTing a JSON backfillExecutionsRequest to this handler starts a backfill and returns its ID right away, the
+// executions are launched in the background. GETting the handler with that ID as the id query param returns the
+// backfill's progress as an interfaces.Backfill.
+func (m *AdminService) GetBackfillExecutionsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodPost:
+			var body backfillExecutionsRequest
+			if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid backfill request: %v", err), http.StatusBadRequest)
+				return
+			}
+			if body.LaunchPlan != nil && body.LaunchPlan.ResourceType == core.ResourceType_UNSPECIFIED {
+				body.LaunchPlan.ResourceType = core.ResourceType_LAUNCH_PLAN
+			}
+			var inputs *core.LiteralMap
+			if len(body.Inputs) > 0 {
+				inputs = &core.LiteralMap{}
+				if err := jsonpb.Unmarshal(bytes.NewReader(body.Inputs), inputs); err != nil {
+					http.Error(writer, fmt.Sprintf("invalid backfill inputs: %v", err), http.StatusBadRequest)
+					return
+				}
+			}
+			response, err := m.BackfillExecutions(request.Context(), interfaces.BackfillExecutionsRequest{
+				LaunchPlan:          body.LaunchPlan,
+				StartTime:           body.StartTime,
+				EndTime:             body.EndTime,
+				KickoffTimeInputArg: body.KickoffTimeInputArg,
+				Inputs:              inputs,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		case http.MethodGet:
+			response, err := m.GetBackfill(request.Context(), request.URL.Query().Get("id"))
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
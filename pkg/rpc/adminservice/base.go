@@ -4,35 +4,52 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteadmin/pkg/async/cloudevent"
 	"github.com/lyft/flyteadmin/pkg/async/notifications"
 	"github.com/lyft/flyteadmin/pkg/async/schedule"
+	"github.com/lyft/flyteadmin/pkg/clusterresource"
+	"github.com/lyft/flyteadmin/pkg/config"
 	"github.com/lyft/flyteadmin/pkg/data"
 	executionCluster "github.com/lyft/flyteadmin/pkg/executioncluster/impl"
+	"github.com/lyft/flyteadmin/pkg/logging"
 	manager "github.com/lyft/flyteadmin/pkg/manager/impl"
 	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/onboarding"
 	"github.com/lyft/flyteadmin/pkg/repositories"
 	repositoryConfig "github.com/lyft/flyteadmin/pkg/repositories/config"
+	"github.com/lyft/flyteadmin/pkg/repositories/shadow"
 	"github.com/lyft/flyteadmin/pkg/runtime"
+	"github.com/lyft/flyteadmin/pkg/server"
+	"github.com/lyft/flyteadmin/pkg/tasklog"
+	"github.com/lyft/flyteadmin/pkg/tasktype"
 	workflowengine "github.com/lyft/flyteadmin/pkg/workflowengine/impl"
 	"github.com/lyft/flytestdlib/logger"
 	"github.com/lyft/flytestdlib/profutils"
 	"github.com/lyft/flytestdlib/promutils"
 	"github.com/lyft/flytestdlib/storage"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type AdminService struct {
-	TaskManager          interfaces.TaskInterface
-	WorkflowManager      interfaces.WorkflowInterface
-	LaunchPlanManager    interfaces.LaunchPlanInterface
-	ExecutionManager     interfaces.ExecutionInterface
-	NodeExecutionManager interfaces.NodeExecutionInterface
-	TaskExecutionManager interfaces.TaskExecutionInterface
-	ProjectManager       interfaces.ProjectInterface
-	ProjectDomainManager interfaces.ProjectDomainInterface
-	NamedEntityManager   interfaces.NamedEntityInterface
-	Metrics              AdminMetrics
+	TaskManager                   interfaces.TaskInterface
+	WorkflowManager               interfaces.WorkflowInterface
+	LaunchPlanManager             interfaces.LaunchPlanInterface
+	ExecutionManager              interfaces.ExecutionInterface
+	InlineExecutionManager        interfaces.InlineExecutionInterface
+	NodeExecutionManager          interfaces.NodeExecutionInterface
+	TaskExecutionManager          interfaces.TaskExecutionInterface
+	ProjectManager                interfaces.ProjectInterface
+	ProjectDomainManager          interfaces.ProjectDomainInterface
+	ResourceManager               interfaces.ResourceInterface
+	NamedEntityManager            interfaces.NamedEntityInterface
+	NotificationPreferenceManager interfaces.NotificationPreferenceInterface
+	EventManager                  interfaces.EventInterface
+	VersionManager                interfaces.VersionInterface
+	ReadOnlyMode                  *server.ReadOnlyMode
+	Metrics                       AdminMetrics
 }
 
 // Intercepts all admin requests to handle panics during execution.
@@ -47,12 +64,17 @@ func (m *AdminService) interceptPanic(ctx context.Context, request proto.Message
 }
 
 const defaultRetries = 3
+const clusterAttributesLagRefreshInterval = 30 * time.Second
 
-func NewAdminServer(kubeConfig, master string) *AdminService {
+// In read-only mode, background processes which write to the database, such as the scheduled workflow executor, aren't
+// started.
+func NewAdminServer(kubeConfig, master string, readOnly bool) *AdminService {
 	configuration := runtime.NewConfigurationProvider()
 	applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
 
 	adminScope := promutils.NewScope(applicationConfiguration.MetricsScope).NewSubScope("admin")
+	logging.SetRedactionRules(applicationConfiguration.Redaction.SensitiveInputs,
+		applicationConfiguration.Redaction.SensitiveAnnotations)
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -73,6 +95,23 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 	}
 	db := repositories.GetRepository(
 		repositories.POSTGRES, dbConfig, adminScope.NewSubScope("database"))
+	if shadowReadsConfig := applicationConfiguration.ShadowReads; shadowReadsConfig.Enabled {
+		shadowDb := repositories.GetRepository(repositories.POSTGRES, repositoryConfig.DbConfig{
+			Host:         shadowReadsConfig.Database.Host,
+			Port:         shadowReadsConfig.Database.Port,
+			DbName:       shadowReadsConfig.Database.DbName,
+			User:         shadowReadsConfig.Database.User,
+			Password:     shadowReadsConfig.Database.Password,
+			ExtraOptions: shadowReadsConfig.Database.ExtraOptions,
+		}, adminScope.NewSubScope("shadow_database"))
+		db = shadow.NewRepository(db, shadowDb, shadow.Config{
+			SamplePercentage: shadowReadsConfig.SamplePercentage,
+			MaxInFlight:      shadowReadsConfig.MaxInFlight,
+			Timeout:          shadowReadsConfig.Timeout.Duration,
+		}, adminScope.NewSubScope("shadow_reads"))
+		logger.Infof(context.Background(), "Repeating %d%% of reads against the shadow database",
+			shadowReadsConfig.SamplePercentage)
+	}
 	storeConfig := storage.GetConfig()
 	executionCluster := executionCluster.GetExecutionCluster(
 		adminScope.NewSubScope("executor").NewSubScope("cluster"),
@@ -90,8 +129,14 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		logger.Error(context.Background(), "Failed to initialize storage config")
 		panic(err)
 	}
+	logProvider, err := tasklog.NewLogProvider(applicationConfiguration.TaskLogs)
+	if err != nil {
+		logger.Error(context.Background(), "Failed to initialize task log provider")
+		panic(err)
+	}
 
-	publisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
+	publisher := notifications.NewNotificationsPublisher(
+		*configuration.ApplicationConfiguration().GetNotificationsConfig(), db, adminScope)
 	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
 	go func() {
 		err = processor.StartProcessing()
@@ -125,16 +170,23 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		RemoteDataStoreClient:    dataStorageClient,
 	}).GetRemoteURLInterface()
 
+	cloudEventPublisher := cloudevent.NewCloudEventPublisher(
+		*configuration.ApplicationConfiguration().GetCloudEventsConfig(), adminScope)
 	executionManager := manager.NewExecutionManager(
 		db, configuration, dataStorageClient, workflowExecutor, adminScope.NewSubScope("execution_manager"),
-		adminScope.NewSubScope("user_execution_metrics"), publisher, urlData)
+		adminScope.NewSubScope("user_execution_metrics"), publisher, urlData,
+		cloudEventPublisher)
 
-	scheduledWorkflowExecutor := workflowScheduler.GetWorkflowExecutor(executionManager, launchPlanManager)
-	logger.Info(context.Background(), "Successfully initialized a new scheduled workflow executor")
-	go func() {
-		scheduledWorkflowExecutor.Run()
-		logger.Info(context.Background(), "Successfully started running the scheduled workflow executor")
-	}()
+	if readOnly {
+		logger.Warning(context.Background(), "Not running the scheduled workflow executor in read-only mode")
+	} else {
+		scheduledWorkflowExecutor := workflowScheduler.GetWorkflowExecutor(executionManager, launchPlanManager)
+		logger.Info(context.Background(), "Successfully initialized a new scheduled workflow executor")
+		go func() {
+			scheduledWorkflowExecutor.Run()
+			logger.Info(context.Background(), "Successfully started running the scheduled workflow executor")
+		}()
+	}
 
 	// Serve profiling endpoints.
 	go func() {
@@ -145,22 +197,62 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		}
 	}()
 
+	nodeExecutionManager := manager.NewNodeExecutionManager(
+		db, configuration, applicationConfiguration.MetadataStoragePrefix, dataStorageClient,
+		adminScope.NewSubScope("node_execution_manager"), urlData, cloudEventPublisher)
+	taskExecutionManager := manager.NewTaskExecutionManager(
+		db, configuration, adminScope.NewSubScope("task_execution_manager"), urlData, logProvider,
+		cloudEventPublisher)
+	projectDomainManager := manager.NewProjectDomainManager(
+		db, configuration, adminScope.NewSubScope("project_domain_manager"))
+	// Every replica reports the lag of all clusters, recomputed from the database.
+	go wait.Forever(func() {
+		if err := projectDomainManager.RefreshClusterAttributesLag(context.Background()); err != nil {
+			logger.Warningf(context.Background(), "Failed to refresh cluster attributes lag with err: %v", err)
+		}
+	}, clusterAttributesLagRefreshInterval)
+	onboardingScope := adminScope.NewSubScope("onboarding")
+	onboarder := onboarding.NewOnboarder(db, configuration,
+		clusterresource.NewClusterResourceController(
+			db, executionCluster, onboardingScope.NewSubScope("cluster_resources")),
+		launchPlanManager, projectDomainManager, publisher, onboardingScope)
+
+	readOnlyMode, err := server.NewReadOnlyMode(context.Background(), applicationConfiguration.ReadOnly,
+		dataStorageClient, applicationConfiguration.MetadataStoragePrefix, adminScope.NewSubScope("read_only_mode"))
+	if err != nil {
+		logger.Fatalf(context.Background(), "Failed to initialize read-only mode with err: %v", err)
+	}
+	if err := readOnlyMode.Refresh(context.Background()); err != nil {
+		logger.Warningf(context.Background(), "Failed to load the persisted read-only mode with err: %v", err)
+	}
+	go readOnlyMode.Run()
+
+	taskManager := manager.NewTaskManager(db, configuration, workflowengine.NewCompiler(),
+		tasktype.NewRegistry(applicationConfiguration.TaskTypes), adminScope.NewSubScope("task_manager"))
+	workflowManager := manager.NewWorkflowManager(
+		db, configuration, workflowengine.NewCompiler(), dataStorageClient, applicationConfiguration.MetadataStoragePrefix,
+		adminScope.NewSubScope("workflow_manager"))
+
 	logger.Info(context.Background(), "Initializing a new AdminService")
 	return &AdminService{
-		TaskManager: manager.NewTaskManager(db, configuration, workflowengine.NewCompiler(),
-			adminScope.NewSubScope("task_manager")),
-		WorkflowManager: manager.NewWorkflowManager(
-			db, configuration, workflowengine.NewCompiler(), dataStorageClient, applicationConfiguration.MetadataStoragePrefix,
-			adminScope.NewSubScope("workflow_manager")),
-		LaunchPlanManager:  launchPlanManager,
-		ExecutionManager:   executionManager,
-		NamedEntityManager: manager.NewNamedEntityManager(db, configuration, adminScope.NewSubScope("named_entity_manager")),
-		NodeExecutionManager: manager.NewNodeExecutionManager(
-			db, adminScope.NewSubScope("node_execution_manager"), urlData),
-		TaskExecutionManager: manager.NewTaskExecutionManager(
-			db, adminScope.NewSubScope("task_execution_manager"), urlData),
-		ProjectManager:       manager.NewProjectManager(db, configuration),
-		ProjectDomainManager: manager.NewProjectDomainManager(db, configuration),
-		Metrics:              InitMetrics(adminScope),
+		TaskManager:       taskManager,
+		WorkflowManager:   workflowManager,
+		LaunchPlanManager: launchPlanManager,
+		ExecutionManager:  executionManager,
+		InlineExecutionManager: manager.NewInlineExecutionManager(taskManager, workflowManager, launchPlanManager,
+			executionManager, adminScope.NewSubScope("inline_execution_manager")),
+		NamedEntityManager: manager.NewNamedEntityManager(
+			db, configuration, eventScheduler, adminScope.NewSubScope("named_entity_manager")),
+		NodeExecutionManager:          nodeExecutionManager,
+		TaskExecutionManager:          taskExecutionManager,
+		ProjectManager:                manager.NewProjectManager(db, configuration, onboarder),
+		ProjectDomainManager:          projectDomainManager,
+		ResourceManager:               manager.NewResourceManager(db, configuration),
+		NotificationPreferenceManager: manager.NewNotificationPreferenceManager(db, configuration),
+		EventManager: manager.NewEventManager(db, executionManager, nodeExecutionManager, taskExecutionManager,
+			adminScope.NewSubScope("event_manager")),
+		VersionManager: manager.NewVersionManager(configuration, config.GetConfig().Security.UseAuth, readOnly),
+		ReadOnlyMode:   readOnlyMode,
+		Metrics:        InitMetrics(adminScope),
 	}
 }
@@ -0,0 +1,98 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.WorkflowCompileRequest. The workflow spec and task templates use the
+// protobuf JSON mapping.
+type workflowCompileRequest struct {
+	Workflow json.RawMessage   `json:"workflow"`
+	Tasks    []json.RawMessage `json:"tasks"`
+}
+
+// The HTTP representation of an interfaces.WorkflowCompileResponse. The closure uses the protobuf JSON mapping.
+type workflowCompileResponse struct {
+	Closure     json.RawMessage                        `json:"closure,omitempty"`
+	Diagnostics []interfaces.WorkflowCompileDiagnostic `json:"diagnostics"`
+}
+
+func (r workflowCompileRequest) toWorkflowCompileRequest() (interfaces.WorkflowCompileRequest, error) {
+	request := interfaces.WorkflowCompileRequest{
+		Tasks: make([]*core.TaskTemplate, len(r.Tasks)),
+	}
+	if len(r.Workflow) > 0 {
+		request.Workflow = &admin.WorkflowSpec{}
+		if err := unmarshalJSONProto(r.Workflow, request.Workflow); err != nil {
+			return interfaces.WorkflowCompileRequest{}, fmt.Errorf("invalid workflow: %v", err)
+		}
+	}
+	for idx, task := range r.Tasks {
+		request.Tasks[idx] = &core.TaskTemplate{}
+		if err := unmarshalJSONProto(task, request.Tasks[idx]); err != nil {
+			return interfaces.WorkflowCompileRequest{}, fmt.Errorf("invalid task at index %d: %v", idx, err)
+		}
+	}
+	return request, nil
+}
+
+func (m *AdminService) CompileWorkflow(
+	ctx context.Context, request interfaces.WorkflowCompileRequest) (*interfaces.WorkflowCompileResponse, error) {
+	var response *interfaces.WorkflowCompileResponse
+	var err error
+	m.Metrics.workflowEndpointMetrics.compile.Time(func() {
+		response, err = m.WorkflowManager.CompileWorkflow(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.workflowEndpointMetrics.compile)
+	}
+	m.Metrics.workflowEndpointMetrics.compile.Success()
+	return response, nil
+}
+
+// Workflows are validated before they are registered by POSTing a JSON workflowCompileRequest to this handler. Nothing
+// is registered. The response holds the compiled closure, and the reasons registering the workflow would fail if there
+// are any.
+func (m *AdminService) GetCompileWorkflowHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body workflowCompileRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid workflow compile request: %v", err), http.StatusBadRequest)
+			return
+		}
+		compileRequest, err := body.toWorkflowCompileRequest()
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response, err := m.CompileWorkflow(request.Context(), compileRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		compileResponse := workflowCompileResponse{
+			Diagnostics: response.Diagnostics,
+		}
+		if response.Closure != nil {
+			compileResponse.Closure, err = marshalProtoJSON(response.Closure)
+		}
+		var responseBytes []byte
+		if err == nil {
+			responseBytes, err = json.Marshal(compileResponse)
+		}
+		writeMarshaledResponse(ctx, writer, responseBytes, err)
+	}
+}
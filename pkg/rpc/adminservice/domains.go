@@ -0,0 +1,69 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) RegisterDomain(
+	ctx context.Context, request interfaces.DomainRegisterRequest) (*interfaces.DomainRegisterResponse, error) {
+	var response *interfaces.DomainRegisterResponse
+	var err error
+	m.Metrics.projectEndpointMetrics.registerDomain.Time(func() {
+		response, err = m.ProjectManager.RegisterDomain(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.registerDomain)
+	}
+	m.Metrics.projectEndpointMetrics.registerDomain.Success()
+	return response, nil
+}
+
+func (m *AdminService) ListDomains(ctx context.Context) (*interfaces.DomainList, error) {
+	var response *interfaces.DomainList
+	var err error
+	m.Metrics.projectEndpointMetrics.listDomains.Time(func() {
+		response, err = m.ProjectManager.ListDomains(ctx)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.listDomains)
+	}
+	m.Metrics.projectEndpointMetrics.listDomains.Success()
+	return response, nil
+}
+
+// Domains are registered at runtime by POSTing a JSON interfaces.DomainRegisterRequest to this handler. GETting it
+// returns an interfaces.DomainList of the configured and registered domains. Since the cluster resource controller
+// provisions every project in registered domains, registering them is only served to operators when auth is enabled,
+// see auth.RequireOperator.
+func (m *AdminService) GetDomainsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodPost:
+			var body interfaces.DomainRegisterRequest
+			if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid domain register request: %v", err), http.StatusBadRequest)
+				return
+			}
+			if _, err := m.RegisterDomain(request.Context(), body); err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			response, err := m.ListDomains(request.Context())
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) CreateDynamicNodeWorkflow(
+	ctx context.Context, request interfaces.DynamicNodeWorkflowCreateRequest) (
+	*interfaces.DynamicNodeWorkflowCreateResponse, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.createDynamicWorkflow)
+	}
+	var response *interfaces.DynamicNodeWorkflowCreateResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.createDynamicWorkflow.Time(func() {
+		response, err = m.NodeExecutionManager.CreateDynamicNodeWorkflow(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.createDynamicWorkflow)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.createDynamicWorkflow.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetDynamicNodeWorkflow(
+	ctx context.Context, request interfaces.DynamicNodeWorkflowGetRequest) (*admin.WorkflowClosure, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getDynamicWorkflow)
+	}
+	var response *admin.WorkflowClosure
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getDynamicWorkflow.Time(func() {
+		response, err = m.NodeExecutionManager.GetDynamicNodeWorkflow(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getDynamicWorkflow)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getDynamicWorkflow.Success()
+	return response, nil
+}
+
+// Reads the identifier of the node execution named by the project, domain, name and node_id query params.
+func getNodeExecutionIdentifierFromQuery(request *http.Request) *core.NodeExecutionIdentifier {
+	query := request.URL.Query()
+	return &core.NodeExecutionIdentifier{
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: query.Get("project"),
+			Domain:  query.Get("domain"),
+			Name:    query.Get("name"),
+		},
+		NodeId: query.Get("node_id"),
+	}
+}
+
+// Dynamically compiled workflows go through this handler for the node execution named by the project, domain, name and
+// node_id query params. POSTing a core.CompiledWorkflowClosure records the workflow a dynamic node's task compiled, and
+// GETting returns the recorded admin.WorkflowClosure. Bodies use the protobuf JSON mapping.
+func (m *AdminService) GetDynamicNodeWorkflowHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		nodeExecutionID := getNodeExecutionIdentifierFromQuery(request)
+		switch request.Method {
+		case http.MethodPost:
+			var compiledWorkflow core.CompiledWorkflowClosure
+			if err := jsonpb.Unmarshal(request.Body, &compiledWorkflow); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid dynamic node workflow: %v", err), http.StatusBadRequest)
+				return
+			}
+			_, err := m.CreateDynamicNodeWorkflow(request.Context(), interfaces.DynamicNodeWorkflowCreateRequest{
+				NodeExecutionID:  nodeExecutionID,
+				CompiledWorkflow: &compiledWorkflow,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			response, err := m.GetDynamicNodeWorkflow(request.Context(), interfaces.DynamicNodeWorkflowGetRequest{
+				NodeExecutionID: nodeExecutionID,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeProtoJSONResponse(ctx, writer, response)
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
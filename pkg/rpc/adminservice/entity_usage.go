@@ -0,0 +1,47 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetEntityUsage(
+	ctx context.Context, request interfaces.EntityUsageGetRequest) (*interfaces.EntityUsage, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.namedEntityEndpointMetrics.getUsage)
+	}
+	var response *interfaces.EntityUsage
+	var err error
+	m.Metrics.namedEntityEndpointMetrics.getUsage.Time(func() {
+		response, err = m.NamedEntityManager.GetEntityUsage(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.namedEntityEndpointMetrics.getUsage)
+	}
+	m.Metrics.namedEntityEndpointMetrics.getUsage.Success()
+	return response, nil
+}
+
+// The usage of the workflows and launch plans in the project (and optionally domain) named by the query params is
+// fetched by GETting this handler. The response is an interfaces.EntityUsage.
+func (m *AdminService) GetEntityUsageHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		response, err := m.GetEntityUsage(request.Context(), interfaces.EntityUsageGetRequest{
+			Project: query.Get("project"),
+			Domain:  query.Get("domain"),
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
@@ -0,0 +1,207 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// Timelines are served in the chrome trace event format when requested with format=chrome.
+const chromeTraceFormat = "chrome"
+
+// An event in the chrome trace event format, see
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+type chromeTraceEvent struct {
+	Name     string `json:"name"`
+	Category string `json:"cat,omitempty"`
+	Phase    string `json:"ph"`
+	// In microseconds.
+	Timestamp int64  `json:"ts"`
+	Duration  *int64 `json:"dur,omitempty"`
+	ProcessID int    `json:"pid"`
+	ThreadID  int    `json:"tid"`
+	// The scope of instant events.
+	Scope string            `json:"s,omitempty"`
+	Args  map[string]string `json:"args,omitempty"`
+}
+
+type chromeTrace struct {
+	TraceEvents     []chromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+// The events of the execution, a node execution or a task execution attempt.
+type timelineSpan struct {
+	name     string
+	category string
+	threadID int
+	first    interfaces.TimelineEvent
+	last     interfaces.TimelineEvent
+}
+
+func getTimelineSpanKey(event interfaces.TimelineEvent) string {
+	if event.Task == nil {
+		return fmt.Sprintf("%s/%s", event.Kind, event.NodeID)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", event.Kind, event.NodeID, event.Task.Project, event.Task.Domain,
+		event.Task.Name, event.Task.Version, event.Task.RetryAttempt)
+}
+
+func getTimelineSpanName(timeline *interfaces.ExecutionTimeline, event interfaces.TimelineEvent) string {
+	switch {
+	case event.Task != nil:
+		return fmt.Sprintf("%s (attempt %d)", event.Task.Name, event.Task.RetryAttempt)
+	case event.Kind == interfaces.TimelineEventKindWorkflow:
+		return timeline.ID.GetName()
+	default:
+		return event.NodeID
+	}
+}
+
+// Converts a timeline into the chrome trace event format. The execution and each of its node executions are drawn as a
+// thread, on which the execution, node executions and their task execution attempts span from their first to their
+// latest event, and each event is marked where it occurred.
+func toChromeTrace(timeline *interfaces.ExecutionTimeline) chromeTrace {
+	const processID = 1
+	traceEvents := []chromeTraceEvent{
+		{
+			Name:      "process_name",
+			Phase:     "M",
+			ProcessID: processID,
+			Args: map[string]string{
+				"name": timeline.ID.GetName(),
+			},
+		},
+		{
+			Name:      "thread_name",
+			Phase:     "M",
+			ProcessID: processID,
+			Args: map[string]string{
+				"name": interfaces.TimelineEventKindWorkflow,
+			},
+		},
+	}
+	threadIDs := make(map[string]int)
+	spanKeys := make([]string, 0)
+	spans := make(map[string]*timelineSpan)
+	for _, event := range timeline.Events {
+		threadID := 0
+		if len(event.NodeID) > 0 {
+			var ok bool
+			if threadID, ok = threadIDs[event.NodeID]; !ok {
+				threadID = len(threadIDs) + 1
+				threadIDs[event.NodeID] = threadID
+				traceEvents = append(traceEvents, chromeTraceEvent{
+					Name:      "thread_name",
+					Phase:     "M",
+					ProcessID: processID,
+					ThreadID:  threadID,
+					Args: map[string]string{
+						"name": event.NodeID,
+					},
+				})
+			}
+		}
+		spanKey := getTimelineSpanKey(event)
+		span, ok := spans[spanKey]
+		if !ok {
+			span = &timelineSpan{
+				name:     getTimelineSpanName(timeline, event),
+				category: event.Kind,
+				threadID: threadID,
+				first:    event,
+			}
+			spans[spanKey] = span
+			spanKeys = append(spanKeys, spanKey)
+		}
+		span.last = event
+		traceEvents = append(traceEvents, chromeTraceEvent{
+			Name:      event.Phase,
+			Category:  event.Kind,
+			Phase:     "i",
+			Timestamp: event.OccurredAt.UnixNano() / 1000,
+			ProcessID: processID,
+			ThreadID:  threadID,
+			Scope:     "t",
+			Args: map[string]string{
+				"span": span.name,
+			},
+		})
+	}
+	for _, spanKey := range spanKeys {
+		span := spans[spanKey]
+		duration := span.last.OccurredAt.Sub(span.first.OccurredAt).Nanoseconds() / 1000
+		traceEvents = append(traceEvents, chromeTraceEvent{
+			Name:      span.name,
+			Category:  span.category,
+			Phase:     "X",
+			Timestamp: span.first.OccurredAt.UnixNano() / 1000,
+			Duration:  &duration,
+			ProcessID: processID,
+			ThreadID:  span.threadID,
+			Args: map[string]string{
+				"phase": span.last.Phase,
+			},
+		})
+	}
+	return chromeTrace{
+		TraceEvents:     traceEvents,
+		DisplayTimeUnit: "ms",
+	}
+}
+
+func (m *AdminService) ExportExecutionTimeline(
+	ctx context.Context, request interfaces.ExecutionTimelineExportRequest) (*interfaces.ExecutionTimeline, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.exportTimeline)
+	}
+	var response *interfaces.ExecutionTimeline
+	var err error
+	m.Metrics.executionEndpointMetrics.exportTimeline.Time(func() {
+		response, err = m.ExecutionManager.ExportExecutionTimeline(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.exportTimeline)
+	}
+	m.Metrics.executionEndpointMetrics.exportTimeline.Success()
+	return response, nil
+}
+
+// The timeline of the execution named by the project, domain and name query params is fetched by GETting this handler.
+// The response is an interfaces.ExecutionTimeline, or a chrome trace which trace viewers such as chrome://tracing and
+// Perfetto load when the format query param is chrome.
+func (m *AdminService) GetExportExecutionTimelineHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		format := query.Get("format")
+		if len(format) > 0 && format != chromeTraceFormat {
+			http.Error(writer, fmt.Sprintf("unsupported timeline format [%s]", format), http.StatusBadRequest)
+			return
+		}
+		response, err := m.ExportExecutionTimeline(request.Context(), interfaces.ExecutionTimelineExportRequest{
+			ID: &core.WorkflowExecutionIdentifier{
+				Project: query.Get("project"),
+				Domain:  query.Get("domain"),
+				Name:    query.Get("name"),
+			},
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		if format == chromeTraceFormat {
+			writeJSONResponse(ctx, writer, toChromeTrace(response))
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
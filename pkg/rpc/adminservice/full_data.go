@@ -0,0 +1,145 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.FullDataResponse. Fields use the protobuf JSON mapping and unset fields
+// are omitted.
+type fullDataResponse struct {
+	Inputs      json.RawMessage `json:"inputs,omitempty"`
+	Outputs     json.RawMessage `json:"outputs,omitempty"`
+	FullInputs  json.RawMessage `json:"fullInputs,omitempty"`
+	FullOutputs json.RawMessage `json:"fullOutputs,omitempty"`
+	// Only set for failed node executions.
+	ErrorDocument json.RawMessage `json:"errorDocument,omitempty"`
+	Error         json.RawMessage `json:"error,omitempty"`
+	// Only set for node executions whose task registered artifacts.
+	Artifacts []interfaces.SignedNodeExecutionArtifact `json:"artifacts,omitempty"`
+}
+
+func marshalFullDataResponse(response *interfaces.FullDataResponse) ([]byte, error) {
+	body := fullDataResponse{
+		Artifacts: response.Artifacts,
+	}
+	var err error
+	if response.Inputs != nil {
+		if body.Inputs, err = marshalProtoJSON(response.Inputs); err != nil {
+			return nil, err
+		}
+	}
+	if response.Outputs != nil {
+		if body.Outputs, err = marshalProtoJSON(response.Outputs); err != nil {
+			return nil, err
+		}
+	}
+	if response.FullInputs != nil {
+		if body.FullInputs, err = marshalProtoJSON(response.FullInputs); err != nil {
+			return nil, err
+		}
+	}
+	if response.FullOutputs != nil {
+		if body.FullOutputs, err = marshalProtoJSON(response.FullOutputs); err != nil {
+			return nil, err
+		}
+	}
+	if response.ErrorDocument != nil {
+		if body.ErrorDocument, err = marshalProtoJSON(response.ErrorDocument); err != nil {
+			return nil, err
+		}
+	}
+	if response.Error != nil {
+		if body.Error, err = marshalProtoJSON(response.Error); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(body)
+}
+
+func writeFullDataResponse(
+	ctx context.Context, writer http.ResponseWriter, response *interfaces.FullDataResponse, err error) {
+	if err != nil {
+		writeErrorResponse(writer, err)
+		return
+	}
+	responseBytes, err := marshalFullDataResponse(response)
+	writeMarshaledResponse(ctx, writer, responseBytes, err)
+}
+
+func (m *AdminService) GetExecutionFullData(
+	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+	if err := authorizeProject(ctx, request.GetId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.getFullData)
+	}
+	var response *interfaces.FullDataResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.getFullData.Time(func() {
+		response, err = m.ExecutionManager.GetExecutionFullData(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.getFullData)
+	}
+	m.Metrics.executionEndpointMetrics.getFullData.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetNodeExecutionFullData(
+	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+	if err := authorizeProject(ctx, request.GetId().GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getFullData)
+	}
+	var response *interfaces.FullDataResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getFullData.Time(func() {
+		response, err = m.NodeExecutionManager.GetNodeExecutionFullData(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getFullData)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getFullData.Success()
+	return response, nil
+}
+
+// An execution's data is fetched along with its inputs and outputs inlined, when they're no larger than the configured
+// inline size threshold, by GETting this handler with the execution's project, domain and name query params.
+func (m *AdminService) GetExecutionFullDataHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		response, err := m.GetExecutionFullData(request.Context(), admin.WorkflowExecutionGetDataRequest{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: query.Get("project"),
+				Domain:  query.Get("domain"),
+				Name:    query.Get("name"),
+			},
+		})
+		writeFullDataResponse(ctx, writer, response, err)
+	}
+}
+
+// Like GetExecutionFullDataHandler, for the node execution named by the project, domain, name and node_id query
+// params. Failed node executions also return the signed URL of their error document and the error decoded from it,
+// and the artifacts registered by the node execution's task are returned along with their signed URLs.
+func (m *AdminService) GetNodeExecutionFullDataHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		response, err := m.GetNodeExecutionFullData(request.Context(), admin.NodeExecutionGetDataRequest{
+			Id: getNodeExecutionIdentifierFromQuery(request),
+		})
+		writeFullDataResponse(ctx, writer, response, err)
+	}
+}
@@ -0,0 +1,54 @@
+package adminservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/lyft/flytestdlib/logger"
+	"google.golang.org/grpc/status"
+)
+
+// The pinned flyteidl version has neither the RPCs nor the message fields for much of what admin serves today, so
+// those endpoints are plain HTTP handlers, registered next to the grpc-gateway, rather than AdminServiceServer
+// methods. Their requests and responses are JSON, using the protobuf JSON mapping for the flyteidl messages within.
+
+func marshalProtoJSON(message proto.Message) (json.RawMessage, error) {
+	var buffer bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buffer, message); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Writes the error returned by an AdminService method with the HTTP status matching its code.
+func writeErrorResponse(writer http.ResponseWriter, err error) {
+	http.Error(writer, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+}
+
+// Writes a response which was marshaled into JSON, failing the request when marshaling it returned an error.
+func writeMarshaledResponse(ctx context.Context, writer http.ResponseWriter, responseBytes []byte, err error) {
+	if err != nil {
+		logger.Errorf(ctx, "Error marshaling response into JSON %s", err)
+		http.Error(writer, "Error marshaling response into JSON", http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	if _, err := writer.Write(responseBytes); err != nil {
+		logger.Errorf(ctx, "failed to write response, error: %s", err)
+	}
+}
+
+func writeJSONResponse(ctx context.Context, writer http.ResponseWriter, response interface{}) {
+	responseBytes, err := json.Marshal(response)
+	writeMarshaledResponse(ctx, writer, responseBytes, err)
+}
+
+func writeProtoJSONResponse(ctx context.Context, writer http.ResponseWriter, response proto.Message) {
+	responseBytes, err := marshalProtoJSON(response)
+	writeMarshaledResponse(ctx, writer, responseBytes, err)
+}
@@ -0,0 +1,109 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.InlineExecutionCreateRequest. Specs and inputs use the protobuf JSON
+// mapping.
+type inlineExecutionCreateRequest struct {
+	Project  string            `json:"project"`
+	Domain   string            `json:"domain"`
+	Name     string            `json:"name"`
+	Tasks    []json.RawMessage `json:"tasks"`
+	Workflow json.RawMessage   `json:"workflow"`
+	Inputs   json.RawMessage   `json:"inputs"`
+	Spec     json.RawMessage   `json:"spec"`
+}
+
+func (r inlineExecutionCreateRequest) toInlineExecutionCreateRequest() (
+	interfaces.InlineExecutionCreateRequest, error) {
+	request := interfaces.InlineExecutionCreateRequest{
+		Project: r.Project,
+		Domain:  r.Domain,
+		Name:    r.Name,
+		Tasks:   make([]*admin.TaskSpec, len(r.Tasks)),
+	}
+	for idx, task := range r.Tasks {
+		request.Tasks[idx] = &admin.TaskSpec{}
+		if err := unmarshalJSONProto(task, request.Tasks[idx]); err != nil {
+			return interfaces.InlineExecutionCreateRequest{}, fmt.Errorf("invalid task at index %d: %v", idx, err)
+		}
+	}
+	if len(r.Workflow) > 0 {
+		request.Workflow = &admin.WorkflowSpec{}
+		if err := unmarshalJSONProto(r.Workflow, request.Workflow); err != nil {
+			return interfaces.InlineExecutionCreateRequest{}, fmt.Errorf("invalid workflow: %v", err)
+		}
+	}
+	if len(r.Inputs) > 0 {
+		request.Inputs = &core.LiteralMap{}
+		if err := unmarshalJSONProto(r.Inputs, request.Inputs); err != nil {
+			return interfaces.InlineExecutionCreateRequest{}, fmt.Errorf("invalid inputs: %v", err)
+		}
+	}
+	if len(r.Spec) > 0 {
+		request.Spec = &admin.ExecutionSpec{}
+		if err := unmarshalJSONProto(r.Spec, request.Spec); err != nil {
+			return interfaces.InlineExecutionCreateRequest{}, fmt.Errorf("invalid spec: %v", err)
+		}
+	}
+	return request, nil
+}
+
+func (m *AdminService) CreateInlineExecution(
+	ctx context.Context, request interfaces.InlineExecutionCreateRequest) (
+	*interfaces.InlineExecutionCreateResponse, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.createInline)
+	}
+	requestedAt := time.Now()
+	var response *interfaces.InlineExecutionCreateResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.createInline.Time(func() {
+		response, err = m.InlineExecutionManager.CreateInlineExecution(ctx, request, requestedAt)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.createInline)
+	}
+	m.Metrics.executionEndpointMetrics.createInline.Success()
+	return response, nil
+}
+
+// Unregistered tasks or workflows are registered and launched in one call by POSTing a JSON
+// inlineExecutionCreateRequest to this handler. The response is an interfaces.InlineExecutionCreateResponse with the
+// new execution's identifier.
+func (m *AdminService) GetCreateInlineExecutionHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body inlineExecutionCreateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid inline execution request: %v", err), http.StatusBadRequest)
+			return
+		}
+		createRequest, err := body.toInlineExecutionCreateRequest()
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response, err := m.CreateInlineExecution(request.Context(), createRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
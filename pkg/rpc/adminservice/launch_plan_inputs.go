@@ -0,0 +1,102 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.LaunchPlanInput. The type and default use the protobuf JSON mapping.
+type launchPlanInput struct {
+	Name          string                                  `json:"name"`
+	Description   string                                  `json:"description,omitempty"`
+	Type          json.RawMessage                         `json:"type"`
+	Required      bool                                    `json:"required"`
+	Overridable   bool                                    `json:"overridable"`
+	Default       json.RawMessage                         `json:"default,omitempty"`
+	DefaultSource interfaces.LaunchPlanInputDefaultSource `json:"defaultSource,omitempty"`
+}
+
+type launchPlanInputsResponse struct {
+	ID     json.RawMessage   `json:"id"`
+	Inputs []launchPlanInput `json:"inputs"`
+}
+
+func marshalLaunchPlanInputs(response *interfaces.LaunchPlanInputs) ([]byte, error) {
+	id, err := marshalProtoJSON(response.ID)
+	if err != nil {
+		return nil, err
+	}
+	body := launchPlanInputsResponse{
+		ID:     id,
+		Inputs: make([]launchPlanInput, len(response.Inputs)),
+	}
+	for idx, input := range response.Inputs {
+		body.Inputs[idx] = launchPlanInput{
+			Name:          input.Name,
+			Description:   input.Description,
+			Required:      input.Required,
+			Overridable:   input.Overridable,
+			DefaultSource: input.DefaultSource,
+		}
+		if body.Inputs[idx].Type, err = marshalProtoJSON(input.Type); err != nil {
+			return nil, err
+		}
+		if input.Default != nil {
+			if body.Inputs[idx].Default, err = marshalProtoJSON(input.Default); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return json.Marshal(body)
+}
+
+func (m *AdminService) GetLaunchPlanInputs(
+	ctx context.Context, request admin.ObjectGetRequest) (*interfaces.LaunchPlanInputs, error) {
+	if err := authorizeProject(ctx, request.GetId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.getInputs)
+	}
+	var response *interfaces.LaunchPlanInputs
+	var err error
+	m.Metrics.launchPlanEndpointMetrics.getInputs.Time(func() {
+		response, err = m.LaunchPlanManager.GetLaunchPlanInputs(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.getInputs)
+	}
+	m.Metrics.launchPlanEndpointMetrics.getInputs.Success()
+	return response, nil
+}
+
+// The inputs of the launch plan version named by the project, domain, name and version query params, along with their
+// types, the defaults they take and whether executions may override them, are fetched by GETting this handler.
+func (m *AdminService) GetLaunchPlanInputsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		response, err := m.GetLaunchPlanInputs(request.Context(), admin.ObjectGetRequest{
+			Id: &core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      query.Get("project"),
+				Domain:       query.Get("domain"),
+				Name:         query.Get("name"),
+				Version:      query.Get("version"),
+			},
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		responseBytes, err := marshalLaunchPlanInputs(response)
+		writeMarshaledResponse(ctx, writer, responseBytes, err)
+	}
+}
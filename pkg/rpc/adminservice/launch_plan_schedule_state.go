@@ -0,0 +1,74 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+var launchPlanScheduleStates = map[string]interfaces.LaunchPlanScheduleState{
+	"ACTIVE": interfaces.LaunchPlanScheduleStateActive,
+	"PAUSED": interfaces.LaunchPlanScheduleStatePaused,
+}
+
+// The HTTP representation of an interfaces.LaunchPlanScheduleStateUpdateRequest.
+type launchPlanScheduleStateUpdateRequest struct {
+	ID *admin.NamedEntityIdentifier `json:"id"`
+	// Either ACTIVE or PAUSED.
+	State string `json:"state"`
+}
+
+func (m *AdminService) UpdateLaunchPlanScheduleState(
+	ctx context.Context, request interfaces.LaunchPlanScheduleStateUpdateRequest) (
+	*interfaces.LaunchPlanScheduleStateUpdateResponse, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.updateScheduleState)
+	}
+	var response *interfaces.LaunchPlanScheduleStateUpdateResponse
+	var err error
+	m.Metrics.launchPlanEndpointMetrics.updateScheduleState.Time(func() {
+		response, err = m.LaunchPlanManager.UpdateLaunchPlanScheduleState(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.updateScheduleState)
+	}
+	m.Metrics.launchPlanEndpointMetrics.updateScheduleState.Success()
+	return response, nil
+}
+
+// The schedule of the active version of a launch plan is paused or resumed by POSTing a JSON
+// launchPlanScheduleStateUpdateRequest to this handler.
+func (m *AdminService) GetUpdateLaunchPlanScheduleStateHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body launchPlanScheduleStateUpdateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid launch plan schedule state update request: %v", err),
+				http.StatusBadRequest)
+			return
+		}
+		state, ok := launchPlanScheduleStates[body.State]
+		if !ok {
+			http.Error(writer, fmt.Sprintf("unknown launch plan schedule state [%s]", body.State), http.StatusBadRequest)
+			return
+		}
+		_, err := m.UpdateLaunchPlanScheduleState(request.Context(), interfaces.LaunchPlanScheduleStateUpdateRequest{
+			ID:    body.ID,
+			State: state,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
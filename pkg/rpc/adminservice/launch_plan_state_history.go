@@ -0,0 +1,66 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) ListLaunchPlanStateHistory(
+	ctx context.Context, request interfaces.LaunchPlanStateHistoryListRequest) (
+	*interfaces.LaunchPlanStateHistory, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.listStateHistory)
+	}
+	var response *interfaces.LaunchPlanStateHistory
+	var err error
+	m.Metrics.launchPlanEndpointMetrics.listStateHistory.Time(func() {
+		response, err = m.LaunchPlanManager.ListLaunchPlanStateHistory(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.listStateHistory)
+	}
+	m.Metrics.launchPlanEndpointMetrics.listStateHistory.Success()
+	return response, nil
+}
+
+// The state changes of all versions of the launch plan named by the project, domain and name query params are listed,
+// most recent first, by GETting this handler. The limit and token query params page through the changes like they do
+// for other list endpoints, and the response is an interfaces.LaunchPlanStateHistory.
+func (m *AdminService) GetListLaunchPlanStateHistoryHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		listRequest := interfaces.LaunchPlanStateHistoryListRequest{
+			ID: &admin.NamedEntityIdentifier{
+				Project: query.Get("project"),
+				Domain:  query.Get("domain"),
+				Name:    query.Get("name"),
+			},
+			Token: query.Get("token"),
+		}
+		if limit := query.Get("limit"); limit != "" {
+			parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+			if err != nil {
+				http.Error(writer, fmt.Sprintf("invalid limit [%s]", limit), http.StatusBadRequest)
+				return
+			}
+			listRequest.Limit = uint32(parsedLimit)
+		}
+		response, err := m.ListLaunchPlanStateHistory(request.Context(), listRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
@@ -0,0 +1,104 @@
+package adminservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.LaunchPlanVariant. Fixed inputs and schedules use the protobuf JSON mapping.
+type launchPlanVariant struct {
+	Key         string          `json:"key"`
+	FixedInputs json.RawMessage `json:"fixedInputs"`
+	Schedule    json.RawMessage `json:"schedule"`
+}
+
+// The HTTP representation of an interfaces.LaunchPlanVariantsCreateRequest.
+type launchPlanVariantsCreateRequest struct {
+	Base           *core.Identifier    `json:"base"`
+	Variants       []launchPlanVariant `json:"variants"`
+	StaggerMinutes int                 `json:"staggerMinutes"`
+}
+
+func unmarshalLaunchPlanVariant(body launchPlanVariant) (interfaces.LaunchPlanVariant, error) {
+	variant := interfaces.LaunchPlanVariant{
+		Key: body.Key,
+	}
+	if len(body.FixedInputs) > 0 {
+		variant.FixedInputs = &core.LiteralMap{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(body.FixedInputs), variant.FixedInputs); err != nil {
+			return interfaces.LaunchPlanVariant{}, fmt.Errorf("invalid fixed inputs of variant [%s]: %v", body.Key, err)
+		}
+	}
+	if len(body.Schedule) > 0 {
+		variant.Schedule = &admin.Schedule{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(body.Schedule), variant.Schedule); err != nil {
+			return interfaces.LaunchPlanVariant{}, fmt.Errorf("invalid schedule of variant [%s]: %v", body.Key, err)
+		}
+	}
+	return variant, nil
+}
+
+func (m *AdminService) CreateLaunchPlanVariants(
+	ctx context.Context, request interfaces.LaunchPlanVariantsCreateRequest) (
+	*interfaces.LaunchPlanVariantsCreateResponse, error) {
+	if err := authorizeProject(ctx, request.Base.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.createVariants)
+	}
+	var response *interfaces.LaunchPlanVariantsCreateResponse
+	var err error
+	m.Metrics.launchPlanEndpointMetrics.createVariants.Time(func() {
+		response, err = m.LaunchPlanManager.CreateLaunchPlanVariants(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.createVariants)
+	}
+	m.Metrics.launchPlanEndpointMetrics.createVariants.Success()
+	return response, nil
+}
+
+// Variants of a base launch plan version are created by POSTing a JSON launchPlanVariantsCreateRequest to this handler.
+// The response lists the outcome for every variant.
+func (m *AdminService) GetCreateLaunchPlanVariantsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body launchPlanVariantsCreateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid launch plan variants request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if body.Base != nil && body.Base.ResourceType == core.ResourceType_UNSPECIFIED {
+			body.Base.ResourceType = core.ResourceType_LAUNCH_PLAN
+		}
+		variants := make([]interfaces.LaunchPlanVariant, len(body.Variants))
+		for idx, variant := range body.Variants {
+			var err error
+			if variants[idx], err = unmarshalLaunchPlanVariant(variant); err != nil {
+				http.Error(writer, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		response, err := m.CreateLaunchPlanVariants(request.Context(), interfaces.LaunchPlanVariantsCreateRequest{
+			Base:           body.Base,
+			Variants:       variants,
+			StaggerMinutes: body.StaggerMinutes,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
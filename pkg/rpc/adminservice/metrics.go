@@ -10,65 +10,118 @@ import (
 type executionEndpointMetrics struct {
 	scope promutils.Scope
 
-	create      util.RequestMetrics
-	relaunch    util.RequestMetrics
-	createEvent util.RequestMetrics
-	get         util.RequestMetrics
-	getData     util.RequestMetrics
-	list        util.RequestMetrics
-	terminate   util.RequestMetrics
+	create         util.RequestMetrics
+	createInline   util.RequestMetrics
+	relaunch       util.RequestMetrics
+	createEvent    util.RequestMetrics
+	createEvents   util.RequestMetrics
+	get            util.RequestMetrics
+	getData        util.RequestMetrics
+	getFullData    util.RequestMetrics
+	exportTimeline util.RequestMetrics
+	rerunNode      util.RequestMetrics
+	list           util.RequestMetrics
+	terminate      util.RequestMetrics
+	backfill       util.RequestMetrics
+	getBackfill    util.RequestMetrics
+	update         util.RequestMetrics
 }
 
 type launchPlanEndpointMetrics struct {
 	scope promutils.Scope
 
-	create     util.RequestMetrics
-	update     util.RequestMetrics
-	get        util.RequestMetrics
-	getActive  util.RequestMetrics
-	list       util.RequestMetrics
-	listActive util.RequestMetrics
-	listIds    util.RequestMetrics
+	create              util.RequestMetrics
+	update              util.RequestMetrics
+	get                 util.RequestMetrics
+	getActive           util.RequestMetrics
+	list                util.RequestMetrics
+	listActive          util.RequestMetrics
+	listIds             util.RequestMetrics
+	updateScheduleState util.RequestMetrics
+	listStateHistory    util.RequestMetrics
+	getInputs           util.RequestMetrics
+	createVariants      util.RequestMetrics
 }
 
 type namedEntityEndpointMetrics struct {
 	scope promutils.Scope
 
-	list   util.RequestMetrics
-	update util.RequestMetrics
-	get    util.RequestMetrics
+	list        util.RequestMetrics
+	update      util.RequestMetrics
+	get         util.RequestMetrics
+	getUsage    util.RequestMetrics
+	search      util.RequestMetrics
+	updateState util.RequestMetrics
 }
 
 type nodeExecutionEndpointMetrics struct {
 	scope promutils.Scope
 
-	createEvent  util.RequestMetrics
-	get          util.RequestMetrics
-	getData      util.RequestMetrics
-	list         util.RequestMetrics
-	listChildren util.RequestMetrics
+	createEvent           util.RequestMetrics
+	createArtifacts       util.RequestMetrics
+	createCacheMetadata   util.RequestMetrics
+	createDynamicWorkflow util.RequestMetrics
+	get                   util.RequestMetrics
+	getArtifacts          util.RequestMetrics
+	getAttemptData        util.RequestMetrics
+	getCacheMetadata      util.RequestMetrics
+	getChildRollup        util.RequestMetrics
+	getData               util.RequestMetrics
+	getDynamicWorkflow    util.RequestMetrics
+	getFullData           util.RequestMetrics
+	getMetrics            util.RequestMetrics
+	list                  util.RequestMetrics
+	listChildren          util.RequestMetrics
+	listForParent         util.RequestMetrics
+	listByTask            util.RequestMetrics
+}
+
+type notificationPreferenceEndpointMetrics struct {
+	scope promutils.Scope
+
+	list   util.RequestMetrics
+	update util.RequestMetrics
 }
 
 type projectEndpointMetrics struct {
 	scope promutils.Scope
 
-	register util.RequestMetrics
-	list     util.RequestMetrics
+	register            util.RequestMetrics
+	list                util.RequestMetrics
+	getOnboardingStatus util.RequestMetrics
+	update              util.RequestMetrics
+	listDetails         util.RequestMetrics
+	getUsageReport      util.RequestMetrics
+	registerDomain      util.RequestMetrics
+	listDomains         util.RequestMetrics
 }
 
 type projectDomainEndpointMetrics struct {
 	scope promutils.Scope
 
+	ackAttributes  util.RequestMetrics
+	syncAttributes util.RequestMetrics
+	update         util.RequestMetrics
+}
+
+type resourceEndpointMetrics struct {
+	scope promutils.Scope
+
 	update util.RequestMetrics
+	get    util.RequestMetrics
+	delete util.RequestMetrics
+	list   util.RequestMetrics
 }
 
 type taskEndpointMetrics struct {
 	scope promutils.Scope
 
-	create  util.RequestMetrics
-	get     util.RequestMetrics
-	list    util.RequestMetrics
-	listIds util.RequestMetrics
+	create      util.RequestMetrics
+	get         util.RequestMetrics
+	list        util.RequestMetrics
+	listIds     util.RequestMetrics
+	updateState util.RequestMetrics
+	diff        util.RequestMetrics
 }
 
 type taskExecutionEndpointMetrics struct {
@@ -77,31 +130,43 @@ type taskExecutionEndpointMetrics struct {
 	createEvent util.RequestMetrics
 	get         util.RequestMetrics
 	getData     util.RequestMetrics
+	getLogs     util.RequestMetrics
 	list        util.RequestMetrics
 }
 
+type versionEndpointMetrics struct {
+	scope promutils.Scope
+
+	get util.RequestMetrics
+}
+
 type workflowEndpointMetrics struct {
 	scope promutils.Scope
 
-	create  util.RequestMetrics
-	get     util.RequestMetrics
-	list    util.RequestMetrics
-	listIds util.RequestMetrics
+	create   util.RequestMetrics
+	compile  util.RequestMetrics
+	get      util.RequestMetrics
+	getGraph util.RequestMetrics
+	list     util.RequestMetrics
+	listIds  util.RequestMetrics
 }
 
 type AdminMetrics struct {
 	Scope        promutils.Scope
 	PanicCounter prometheus.Counter
 
-	executionEndpointMetrics     executionEndpointMetrics
-	launchPlanEndpointMetrics    launchPlanEndpointMetrics
-	namedEntityEndpointMetrics   namedEntityEndpointMetrics
-	nodeExecutionEndpointMetrics nodeExecutionEndpointMetrics
-	projectEndpointMetrics       projectEndpointMetrics
-	projectDomainEndpointMetrics projectDomainEndpointMetrics
-	taskEndpointMetrics          taskEndpointMetrics
-	taskExecutionEndpointMetrics taskExecutionEndpointMetrics
-	workflowEndpointMetrics      workflowEndpointMetrics
+	executionEndpointMetrics              executionEndpointMetrics
+	launchPlanEndpointMetrics             launchPlanEndpointMetrics
+	namedEntityEndpointMetrics            namedEntityEndpointMetrics
+	nodeExecutionEndpointMetrics          nodeExecutionEndpointMetrics
+	notificationPreferenceEndpointMetrics notificationPreferenceEndpointMetrics
+	projectEndpointMetrics                projectEndpointMetrics
+	projectDomainEndpointMetrics          projectDomainEndpointMetrics
+	resourceEndpointMetrics               resourceEndpointMetrics
+	taskEndpointMetrics                   taskEndpointMetrics
+	taskExecutionEndpointMetrics          taskExecutionEndpointMetrics
+	versionEndpointMetrics                versionEndpointMetrics
+	workflowEndpointMetrics               workflowEndpointMetrics
 }
 
 func InitMetrics(adminScope promutils.Scope) AdminMetrics {
@@ -111,68 +176,124 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			"panics encountered while handling requests to the admin service"),
 
 		executionEndpointMetrics: executionEndpointMetrics{
-			scope:       adminScope,
-			create:      util.NewRequestMetrics(adminScope, "create_execution"),
-			relaunch:    util.NewRequestMetrics(adminScope, "relaunch_execution"),
-			createEvent: util.NewRequestMetrics(adminScope, "create_execution_event"),
-			get:         util.NewRequestMetrics(adminScope, "get_execution"),
-			getData:     util.NewRequestMetrics(adminScope, "get_execution_data"),
-			list:        util.NewRequestMetrics(adminScope, "list_execution"),
-			terminate:   util.NewRequestMetrics(adminScope, "terminate_execution"),
+			scope:          adminScope,
+			create:         util.NewRequestMetrics(adminScope, "create_execution"),
+			createInline:   util.NewRequestMetrics(adminScope, "create_inline_execution"),
+			relaunch:       util.NewRequestMetrics(adminScope, "relaunch_execution"),
+			createEvent:    util.NewRequestMetrics(adminScope, "create_execution_event"),
+			createEvents:   util.NewRequestMetrics(adminScope, "create_workflow_events"),
+			get:            util.NewRequestMetrics(adminScope, "get_execution"),
+			getData:        util.NewRequestMetrics(adminScope, "get_execution_data"),
+			getFullData:    util.NewRequestMetrics(adminScope, "get_execution_full_data"),
+			exportTimeline: util.NewRequestMetrics(adminScope, "export_execution_timeline"),
+			rerunNode:      util.NewRequestMetrics(adminScope, "rerun_execution_node"),
+			list:           util.NewRequestMetrics(adminScope, "list_execution"),
+			terminate:      util.NewRequestMetrics(adminScope, "terminate_execution"),
+			backfill:       util.NewRequestMetrics(adminScope, "backfill_executions"),
+			getBackfill:    util.NewRequestMetrics(adminScope, "get_backfill"),
+			update:         util.NewRequestMetrics(adminScope, "update_execution"),
 		},
 		launchPlanEndpointMetrics: launchPlanEndpointMetrics{
-			scope:      adminScope,
-			create:     util.NewRequestMetrics(adminScope, "create_launch_plan"),
-			update:     util.NewRequestMetrics(adminScope, "update_launch_plan"),
-			get:        util.NewRequestMetrics(adminScope, "get_launch_plan"),
-			getActive:  util.NewRequestMetrics(adminScope, "get_active_launch_plan"),
-			list:       util.NewRequestMetrics(adminScope, "list_launch_plan"),
-			listActive: util.NewRequestMetrics(adminScope, "list_active_launch_plans"),
-			listIds:    util.NewRequestMetrics(adminScope, "list_launch_plan_ids"),
+			scope:               adminScope,
+			create:              util.NewRequestMetrics(adminScope, "create_launch_plan"),
+			update:              util.NewRequestMetrics(adminScope, "update_launch_plan"),
+			get:                 util.NewRequestMetrics(adminScope, "get_launch_plan"),
+			getActive:           util.NewRequestMetrics(adminScope, "get_active_launch_plan"),
+			list:                util.NewRequestMetrics(adminScope, "list_launch_plan"),
+			listActive:          util.NewRequestMetrics(adminScope, "list_active_launch_plans"),
+			listIds:             util.NewRequestMetrics(adminScope, "list_launch_plan_ids"),
+			updateScheduleState: util.NewRequestMetrics(adminScope, "update_launch_plan_schedule_state"),
+			listStateHistory:    util.NewRequestMetrics(adminScope, "list_launch_plan_state_history"),
+			getInputs:           util.NewRequestMetrics(adminScope, "get_launch_plan_inputs"),
+			createVariants:      util.NewRequestMetrics(adminScope, "create_launch_plan_variants"),
 		},
 		namedEntityEndpointMetrics: namedEntityEndpointMetrics{
-			scope:  adminScope,
-			get:    util.NewRequestMetrics(adminScope, "get_named_entity"),
-			list:   util.NewRequestMetrics(adminScope, "list_named_entities"),
-			update: util.NewRequestMetrics(adminScope, "update_named_entity"),
+			scope:       adminScope,
+			get:         util.NewRequestMetrics(adminScope, "get_named_entity"),
+			list:        util.NewRequestMetrics(adminScope, "list_named_entities"),
+			update:      util.NewRequestMetrics(adminScope, "update_named_entity"),
+			getUsage:    util.NewRequestMetrics(adminScope, "get_entity_usage"),
+			search:      util.NewRequestMetrics(adminScope, "search_entities"),
+			updateState: util.NewRequestMetrics(adminScope, "update_named_entity_state"),
 		},
 		nodeExecutionEndpointMetrics: nodeExecutionEndpointMetrics{
-			scope:        adminScope,
-			createEvent:  util.NewRequestMetrics(adminScope, "create_node_execution_event"),
-			get:          util.NewRequestMetrics(adminScope, "get_node_execution"),
-			getData:      util.NewRequestMetrics(adminScope, "get_node_execution_data"),
-			list:         util.NewRequestMetrics(adminScope, "list_node_execution"),
-			listChildren: util.NewRequestMetrics(adminScope, "list_children_node_executions"),
+			scope:                 adminScope,
+			createEvent:           util.NewRequestMetrics(adminScope, "create_node_execution_event"),
+			createArtifacts:       util.NewRequestMetrics(adminScope, "create_node_execution_artifacts"),
+			createCacheMetadata:   util.NewRequestMetrics(adminScope, "create_node_execution_cache_metadata"),
+			createDynamicWorkflow: util.NewRequestMetrics(adminScope, "create_dynamic_node_workflow"),
+			get:                   util.NewRequestMetrics(adminScope, "get_node_execution"),
+			getArtifacts:          util.NewRequestMetrics(adminScope, "get_node_execution_artifacts"),
+			getAttemptData:        util.NewRequestMetrics(adminScope, "get_node_execution_attempt_data"),
+			getCacheMetadata:      util.NewRequestMetrics(adminScope, "get_node_execution_cache_metadata"),
+			getChildRollup:        util.NewRequestMetrics(adminScope, "get_node_execution_child_rollup"),
+			getData:               util.NewRequestMetrics(adminScope, "get_node_execution_data"),
+			getDynamicWorkflow:    util.NewRequestMetrics(adminScope, "get_dynamic_node_workflow"),
+			getFullData:           util.NewRequestMetrics(adminScope, "get_node_execution_full_data"),
+			getMetrics:            util.NewRequestMetrics(adminScope, "get_node_execution_metrics"),
+			list:                  util.NewRequestMetrics(adminScope, "list_node_execution"),
+			listChildren:          util.NewRequestMetrics(adminScope, "list_children_node_executions"),
+			listForParent:         util.NewRequestMetrics(adminScope, "list_node_executions_for_parent"),
+			listByTask:            util.NewRequestMetrics(adminScope, "list_node_executions_by_task"),
+		},
+		notificationPreferenceEndpointMetrics: notificationPreferenceEndpointMetrics{
+			scope:  adminScope,
+			list:   util.NewRequestMetrics(adminScope, "list_notification_preferences"),
+			update: util.NewRequestMetrics(adminScope, "update_notification_preference"),
 		},
 		projectEndpointMetrics: projectEndpointMetrics{
-			scope:    adminScope,
-			register: util.NewRequestMetrics(adminScope, "register_project"),
-			list:     util.NewRequestMetrics(adminScope, "list_projects"),
+			scope:               adminScope,
+			register:            util.NewRequestMetrics(adminScope, "register_project"),
+			list:                util.NewRequestMetrics(adminScope, "list_projects"),
+			getOnboardingStatus: util.NewRequestMetrics(adminScope, "get_project_onboarding_status"),
+			update:              util.NewRequestMetrics(adminScope, "update_project"),
+			listDetails:         util.NewRequestMetrics(adminScope, "list_project_details"),
+			getUsageReport:      util.NewRequestMetrics(adminScope, "get_project_usage_report"),
+			registerDomain:      util.NewRequestMetrics(adminScope, "register_domain"),
+			listDomains:         util.NewRequestMetrics(adminScope, "list_domains"),
 		},
 		projectDomainEndpointMetrics: projectDomainEndpointMetrics{
+			scope:          adminScope,
+			ackAttributes:  util.NewRequestMetrics(adminScope, "ack_project_domain_attributes"),
+			syncAttributes: util.NewRequestMetrics(adminScope, "sync_project_domain_attributes"),
+			update:         util.NewRequestMetrics(adminScope, "update_project_domain"),
+		},
+		resourceEndpointMetrics: resourceEndpointMetrics{
 			scope:  adminScope,
-			update: util.NewRequestMetrics(adminScope, "update_project_domain"),
+			update: util.NewRequestMetrics(adminScope, "update_resource_attributes"),
+			get:    util.NewRequestMetrics(adminScope, "get_resource_attributes"),
+			delete: util.NewRequestMetrics(adminScope, "delete_resource_attributes"),
+			list:   util.NewRequestMetrics(adminScope, "list_resource_attributes"),
 		},
 		taskEndpointMetrics: taskEndpointMetrics{
-			scope:   adminScope,
-			create:  util.NewRequestMetrics(adminScope, "create_task"),
-			get:     util.NewRequestMetrics(adminScope, "get_task"),
-			list:    util.NewRequestMetrics(adminScope, "list_task"),
-			listIds: util.NewRequestMetrics(adminScope, "list_task_ids"),
+			scope:       adminScope,
+			create:      util.NewRequestMetrics(adminScope, "create_task"),
+			get:         util.NewRequestMetrics(adminScope, "get_task"),
+			list:        util.NewRequestMetrics(adminScope, "list_task"),
+			listIds:     util.NewRequestMetrics(adminScope, "list_task_ids"),
+			updateState: util.NewRequestMetrics(adminScope, "update_task_state"),
+			diff:        util.NewRequestMetrics(adminScope, "diff_task"),
 		},
 		taskExecutionEndpointMetrics: taskExecutionEndpointMetrics{
 			scope:       adminScope,
 			createEvent: util.NewRequestMetrics(adminScope, "create_task_execution_event"),
 			get:         util.NewRequestMetrics(adminScope, "get_task_execution"),
 			getData:     util.NewRequestMetrics(adminScope, "get_task_execution_data"),
+			getLogs:     util.NewRequestMetrics(adminScope, "get_task_execution_logs"),
 			list:        util.NewRequestMetrics(adminScope, "list_task_execution"),
 		},
+		versionEndpointMetrics: versionEndpointMetrics{
+			scope: adminScope,
+			get:   util.NewRequestMetrics(adminScope, "get_version"),
+		},
 		workflowEndpointMetrics: workflowEndpointMetrics{
-			scope:   adminScope,
-			create:  util.NewRequestMetrics(adminScope, "create_workflow"),
-			get:     util.NewRequestMetrics(adminScope, "get_workflow"),
-			list:    util.NewRequestMetrics(adminScope, "list_workflow"),
-			listIds: util.NewRequestMetrics(adminScope, "list_workflow_ids"),
+			scope:    adminScope,
+			create:   util.NewRequestMetrics(adminScope, "create_workflow"),
+			compile:  util.NewRequestMetrics(adminScope, "compile_workflow"),
+			get:      util.NewRequestMetrics(adminScope, "get_workflow"),
+			getGraph: util.NewRequestMetrics(adminScope, "get_workflow_graph"),
+			list:     util.NewRequestMetrics(adminScope, "list_workflow"),
+			listIds:  util.NewRequestMetrics(adminScope, "list_workflow_ids"),
 		},
 	}
 }
@@ -0,0 +1,89 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+var namedEntityStates = map[string]interfaces.NamedEntityState{
+	"ACTIVE":   interfaces.NamedEntityStateActive,
+	"ARCHIVED": interfaces.NamedEntityStateArchived,
+}
+
+// The HTTP representation of an interfaces.NamedEntityStateUpdateRequest.
+type namedEntityStateUpdateRequest struct {
+	// One of TASK, WORKFLOW or LAUNCH_PLAN.
+	ResourceType string                       `json:"resourceType"`
+	ID           *admin.NamedEntityIdentifier `json:"id"`
+	// Either ACTIVE or ARCHIVED.
+	State   string `json:"state"`
+	Cascade bool   `json:"cascade"`
+	DryRun  bool   `json:"dryRun"`
+}
+
+func (m *AdminService) UpdateNamedEntityState(
+	ctx context.Context, request interfaces.NamedEntityStateUpdateRequest) (
+	*interfaces.NamedEntityStateUpdateResponse, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.namedEntityEndpointMetrics.updateState)
+	}
+	var response *interfaces.NamedEntityStateUpdateResponse
+	var err error
+	m.Metrics.namedEntityEndpointMetrics.updateState.Time(func() {
+		response, err = m.NamedEntityManager.UpdateNamedEntityState(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.namedEntityEndpointMetrics.updateState)
+	}
+	m.Metrics.namedEntityEndpointMetrics.updateState.Success()
+	return response, nil
+}
+
+// A task, workflow or launch plan is archived or restored by POSTing a JSON namedEntityStateUpdateRequest to this
+// handler. Archiving a workflow with cascade set archives the launch plans referencing it too, and the response is an
+// interfaces.NamedEntityStateUpdateResponse listing the affected launch plans, which are left untouched when dryRun is
+// set.
+func (m *AdminService) GetUpdateNamedEntityStateHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body namedEntityStateUpdateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid named entity state update request: %v", err),
+				http.StatusBadRequest)
+			return
+		}
+		resourceType, ok := core.ResourceType_value[body.ResourceType]
+		if !ok {
+			http.Error(writer, fmt.Sprintf("invalid resource type [%s]", body.ResourceType), http.StatusBadRequest)
+			return
+		}
+		state, ok := namedEntityStates[body.State]
+		if !ok {
+			http.Error(writer, fmt.Sprintf("unknown named entity state [%s]", body.State), http.StatusBadRequest)
+			return
+		}
+		response, err := m.UpdateNamedEntityState(request.Context(), interfaces.NamedEntityStateUpdateRequest{
+			ResourceType: core.ResourceType(resourceType),
+			ID:           body.ID,
+			State:        state,
+			Cascade:      body.Cascade,
+			DryRun:       body.DryRun,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
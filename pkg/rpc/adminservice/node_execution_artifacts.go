@@ -0,0 +1,85 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) CreateNodeExecutionArtifacts(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsCreateRequest) (
+	*interfaces.NodeExecutionArtifactsCreateResponse, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.createArtifacts)
+	}
+	var response *interfaces.NodeExecutionArtifactsCreateResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.createArtifacts.Time(func() {
+		response, err = m.NodeExecutionManager.CreateNodeExecutionArtifacts(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.createArtifacts)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.createArtifacts.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetNodeExecutionArtifacts(
+	ctx context.Context, request interfaces.NodeExecutionArtifactsGetRequest) (
+	*interfaces.NodeExecutionArtifactsGetResponse, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getArtifacts)
+	}
+	var response *interfaces.NodeExecutionArtifactsGetResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getArtifacts.Time(func() {
+		response, err = m.NodeExecutionManager.GetNodeExecutionArtifacts(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getArtifacts)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getArtifacts.Success()
+	return response, nil
+}
+
+// Auxiliary artifacts such as HTML reports, rendered decks and profiling dumps go through this handler for the node
+// execution named by the project, domain, name and node_id query params. POSTing a list of
+// interfaces.NodeExecutionArtifact registers what the node's task wrote, and GETting returns an
+// interfaces.NodeExecutionArtifactsGetResponse with a signed URL for each.
+func (m *AdminService) GetNodeExecutionArtifactsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		nodeExecutionID := getNodeExecutionIdentifierFromQuery(request)
+		switch request.Method {
+		case http.MethodPost:
+			var artifacts []interfaces.NodeExecutionArtifact
+			if err := json.NewDecoder(request.Body).Decode(&artifacts); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid artifacts: %v", err), http.StatusBadRequest)
+				return
+			}
+			_, err := m.CreateNodeExecutionArtifacts(request.Context(), interfaces.NodeExecutionArtifactsCreateRequest{
+				NodeExecutionID: nodeExecutionID,
+				Artifacts:       artifacts,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			response, err := m.GetNodeExecutionArtifacts(request.Context(), interfaces.NodeExecutionArtifactsGetRequest{
+				NodeExecutionID: nodeExecutionID,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.NodeExecutionAttemptDataResponse. Fields use the protobuf JSON mapping and
+// unset fields are omitted.
+type nodeExecutionAttemptDataResponse struct {
+	Inputs        json.RawMessage `json:"inputs,omitempty"`
+	Outputs       json.RawMessage `json:"outputs,omitempty"`
+	Error         json.RawMessage `json:"error,omitempty"`
+	ErrorDocument json.RawMessage `json:"errorDocument,omitempty"`
+}
+
+func marshalNodeExecutionAttemptDataResponse(response *interfaces.NodeExecutionAttemptDataResponse) ([]byte, error) {
+	var body nodeExecutionAttemptDataResponse
+	var err error
+	if response.Inputs != nil {
+		if body.Inputs, err = marshalProtoJSON(response.Inputs); err != nil {
+			return nil, err
+		}
+	}
+	if response.Outputs != nil {
+		if body.Outputs, err = marshalProtoJSON(response.Outputs); err != nil {
+			return nil, err
+		}
+	}
+	if response.Error != nil {
+		if body.Error, err = marshalProtoJSON(response.Error); err != nil {
+			return nil, err
+		}
+	}
+	if response.ErrorDocument != nil {
+		if body.ErrorDocument, err = marshalProtoJSON(response.ErrorDocument); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(body)
+}
+
+func (m *AdminService) GetNodeExecutionAttemptData(
+	ctx context.Context, request interfaces.NodeExecutionAttemptDataGetRequest) (
+	*interfaces.NodeExecutionAttemptDataResponse, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getAttemptData)
+	}
+	var response *interfaces.NodeExecutionAttemptDataResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getAttemptData.Time(func() {
+		response, err = m.NodeExecutionManager.GetNodeExecutionAttemptData(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getAttemptData)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getAttemptData.Success()
+	return response, nil
+}
+
+// The data of one retry attempt of the node execution named by the project, domain, name and node_id query params is
+// fetched by GETting this handler with the attempt's retry_attempt query param.
+func (m *AdminService) GetNodeExecutionAttemptDataHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		retryAttempt := request.URL.Query().Get("retry_attempt")
+		parsedRetryAttempt, err := strconv.ParseUint(retryAttempt, 10, 32)
+		if err != nil {
+			http.Error(writer, fmt.Sprintf("invalid retry_attempt [%s]", retryAttempt), http.StatusBadRequest)
+			return
+		}
+		response, err := m.GetNodeExecutionAttemptData(request.Context(), interfaces.NodeExecutionAttemptDataGetRequest{
+			NodeExecutionID: getNodeExecutionIdentifierFromQuery(request),
+			RetryAttempt:    uint32(parsedRetryAttempt),
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		responseBytes, err := marshalNodeExecutionAttemptDataResponse(response)
+		writeMarshaledResponse(ctx, writer, responseBytes, err)
+	}
+}
@@ -0,0 +1,86 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) CreateNodeExecutionCacheMetadata(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataCreateRequest) (
+	*interfaces.NodeExecutionCacheMetadataCreateResponse, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.createCacheMetadata)
+	}
+	var response *interfaces.NodeExecutionCacheMetadataCreateResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.createCacheMetadata.Time(func() {
+		response, err = m.NodeExecutionManager.CreateNodeExecutionCacheMetadata(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.createCacheMetadata)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.createCacheMetadata.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetNodeExecutionCacheMetadata(
+	ctx context.Context, request interfaces.NodeExecutionCacheMetadataGetRequest) (
+	*interfaces.NodeExecutionCacheMetadata, error) {
+	if err := authorizeProject(ctx, request.NodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getCacheMetadata)
+	}
+	var response *interfaces.NodeExecutionCacheMetadata
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getCacheMetadata.Time(func() {
+		response, err = m.NodeExecutionManager.GetNodeExecutionCacheMetadata(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getCacheMetadata)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getCacheMetadata.Success()
+	return response, nil
+}
+
+// Cache information goes through this handler for the node execution named by the project, domain, name and node_id
+// query params. POSTing an interfaces.NodeExecutionCacheMetadata records what the node's task reported, and GETting
+// returns it. Node executions can be listed by cache status with the usual filters, e.g. eq(cache_status,CACHE_HIT).
+func (m *AdminService) GetNodeExecutionCacheMetadataHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		nodeExecutionID := getNodeExecutionIdentifierFromQuery(request)
+		switch request.Method {
+		case http.MethodPost:
+			var cacheMetadata interfaces.NodeExecutionCacheMetadata
+			if err := json.NewDecoder(request.Body).Decode(&cacheMetadata); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid cache metadata: %v", err), http.StatusBadRequest)
+				return
+			}
+			_, err := m.CreateNodeExecutionCacheMetadata(request.Context(),
+				interfaces.NodeExecutionCacheMetadataCreateRequest{
+					NodeExecutionID: nodeExecutionID,
+					Metadata:        cacheMetadata,
+				})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			response, err := m.GetNodeExecutionCacheMetadata(request.Context(),
+				interfaces.NodeExecutionCacheMetadataGetRequest{
+					NodeExecutionID: nodeExecutionID,
+				})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
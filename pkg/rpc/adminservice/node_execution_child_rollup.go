@@ -0,0 +1,46 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetNodeExecutionChildRollup(
+	ctx context.Context, request interfaces.NodeExecutionChildRollupGetRequest) (
+	*interfaces.NodeExecutionChildRollup, error) {
+	if err := authorizeProject(ctx, request.ParentNodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getChildRollup)
+	}
+	var response *interfaces.NodeExecutionChildRollup
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getChildRollup.Time(func() {
+		response, err = m.NodeExecutionManager.GetNodeExecutionChildRollup(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getChildRollup)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getChildRollup.Success()
+	return response, nil
+}
+
+// The counts of a parent node execution's children by phase are fetched by GETting this handler with the parent's
+// project, domain, name and node_id query params. The response is an interfaces.NodeExecutionChildRollup.
+func (m *AdminService) GetNodeExecutionChildRollupHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		response, err := m.GetNodeExecutionChildRollup(request.Context(), interfaces.NodeExecutionChildRollupGetRequest{
+			ParentNodeExecutionID: getNodeExecutionIdentifierFromQuery(request),
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
@@ -0,0 +1,83 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) ListNodeExecutionChildren(
+	ctx context.Context, request interfaces.NodeExecutionChildrenListRequest) (*admin.NodeExecutionList, error) {
+	if err := authorizeProject(ctx, request.ParentNodeExecutionID.GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.listForParent)
+	}
+	var response *admin.NodeExecutionList
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.listForParent.Time(func() {
+		response, err = m.NodeExecutionManager.ListNodeExecutionChildren(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.listForParent)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.listForParent.Success()
+	return response, nil
+}
+
+// Reads a NodeExecutionChildrenListRequest from query params named like the gateway's list request params.
+func getNodeExecutionChildrenListRequest(request *http.Request) (interfaces.NodeExecutionChildrenListRequest, error) {
+	query := request.URL.Query()
+	listRequest := interfaces.NodeExecutionChildrenListRequest{
+		ParentNodeExecutionID: getNodeExecutionIdentifierFromQuery(request),
+		Token:                 query.Get("token"),
+		Filters:               query.Get("filters"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return interfaces.NodeExecutionChildrenListRequest{}, fmt.Errorf("invalid limit [%s]", limit)
+		}
+		listRequest.Limit = uint32(parsedLimit)
+	}
+	if sortKey := query.Get("sort_by.key"); sortKey != "" {
+		listRequest.SortBy = &admin.Sort{
+			Key: sortKey,
+		}
+		if direction := query.Get("sort_by.direction"); direction != "" {
+			sortDirection, ok := admin.Sort_Direction_value[direction]
+			if !ok {
+				return interfaces.NodeExecutionChildrenListRequest{}, fmt.Errorf("invalid sort direction [%s]", direction)
+			}
+			listRequest.SortBy.Direction = admin.Sort_Direction(sortDirection)
+		}
+	}
+	return listRequest, nil
+}
+
+// The children of a node execution are listed by GETting this handler with the parent node execution's project, domain,
+// name and node_id query params. List options use the same params as other list endpoints and the response is an
+// admin.NodeExecutionList in the protobuf JSON mapping.
+func (m *AdminService) GetListNodeExecutionChildrenHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		listRequest, err := getNodeExecutionChildrenListRequest(request)
+		if err != nil {
+			http.Error(writer, fmt.Sprintf("invalid node execution children request: %v", err), http.StatusBadRequest)
+			return
+		}
+		response, err := m.ListNodeExecutionChildren(request.Context(), listRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeProtoJSONResponse(ctx, writer, response)
+	}
+}
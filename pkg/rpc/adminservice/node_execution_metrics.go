@@ -0,0 +1,53 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetNodeExecutionMetrics(
+	ctx context.Context, request interfaces.NodeExecutionMetricsGetRequest) (
+	*interfaces.NodeExecutionMetricsGetResponse, error) {
+	if err := authorizeProject(ctx, request.WorkflowExecutionID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getMetrics)
+	}
+	var response *interfaces.NodeExecutionMetricsGetResponse
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.getMetrics.Time(func() {
+		response, err = m.NodeExecutionManager.GetNodeExecutionMetrics(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.getMetrics)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.getMetrics.Success()
+	return response, nil
+}
+
+// The node execution stage durations of the execution named by the project, domain and name query params are fetched by
+// GETting this handler. The response is an interfaces.NodeExecutionMetricsGetResponse.
+func (m *AdminService) GetNodeExecutionMetricsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		response, err := m.GetNodeExecutionMetrics(request.Context(), interfaces.NodeExecutionMetricsGetRequest{
+			WorkflowExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: query.Get("project"),
+				Domain:  query.Get("domain"),
+				Name:    query.Get("name"),
+			},
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
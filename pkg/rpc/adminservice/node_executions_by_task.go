@@ -0,0 +1,92 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) ListNodeExecutionsByTask(
+	ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (*admin.NodeExecutionList, error) {
+	if err := authorizeProject(ctx, request.TaskID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.listByTask)
+	}
+	var response *admin.NodeExecutionList
+	var err error
+	m.Metrics.nodeExecutionEndpointMetrics.listByTask.Time(func() {
+		response, err = m.NodeExecutionManager.ListNodeExecutionsByTask(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.nodeExecutionEndpointMetrics.listByTask)
+	}
+	m.Metrics.nodeExecutionEndpointMetrics.listByTask.Success()
+	return response, nil
+}
+
+// Reads a NodeExecutionsByTaskListRequest from the task's project, domain, name and optional version query params and
+// list options named like the gateway's list request params.
+func getNodeExecutionsByTaskListRequest(request *http.Request) (interfaces.NodeExecutionsByTaskListRequest, error) {
+	query := request.URL.Query()
+	listRequest := interfaces.NodeExecutionsByTaskListRequest{
+		TaskID: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      query.Get("project"),
+			Domain:       query.Get("domain"),
+			Name:         query.Get("name"),
+			Version:      query.Get("version"),
+		},
+		Token:   query.Get("token"),
+		Filters: query.Get("filters"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return interfaces.NodeExecutionsByTaskListRequest{}, fmt.Errorf("invalid limit [%s]", limit)
+		}
+		listRequest.Limit = uint32(parsedLimit)
+	}
+	if sortKey := query.Get("sort_by.key"); sortKey != "" {
+		listRequest.SortBy = &admin.Sort{
+			Key: sortKey,
+		}
+		if direction := query.Get("sort_by.direction"); direction != "" {
+			sortDirection, ok := admin.Sort_Direction_value[direction]
+			if !ok {
+				return interfaces.NodeExecutionsByTaskListRequest{}, fmt.Errorf("invalid sort direction [%s]", direction)
+			}
+			listRequest.SortBy.Direction = admin.Sort_Direction(sortDirection)
+		}
+	}
+	return listRequest, nil
+}
+
+// The node executions which ran a task across all executions of its project and domain are listed by GETting this
+// handler with the task's project, domain, name and (optionally) version query params. List options use the same params
+// as other list endpoints, e.g. filters=gte(created_at,2020-01-01T00:00:00Z) for recent runs, and the response is an
+// admin.NodeExecutionList in the protobuf JSON mapping.
+func (m *AdminService) GetListNodeExecutionsByTaskHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		listRequest, err := getNodeExecutionsByTaskListRequest(request)
+		if err != nil {
+			http.Error(writer, fmt.Sprintf("invalid node executions by task request: %v", err), http.StatusBadRequest)
+			return
+		}
+		response, err := m.ListNodeExecutionsByTask(request.Context(), listRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeProtoJSONResponse(ctx, writer, response)
+	}
+}
@@ -0,0 +1,71 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) UpdateNotificationPreference(
+	ctx context.Context, request interfaces.NotificationPreferenceUpdateRequest) (
+	*interfaces.NotificationPreferenceUpdateResponse, error) {
+	var response *interfaces.NotificationPreferenceUpdateResponse
+	var err error
+	m.Metrics.notificationPreferenceEndpointMetrics.update.Time(func() {
+		response, err = m.NotificationPreferenceManager.UpdateNotificationPreference(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationPreferenceEndpointMetrics.update)
+	}
+	m.Metrics.notificationPreferenceEndpointMetrics.update.Success()
+	return response, nil
+}
+
+func (m *AdminService) ListNotificationPreferences(
+	ctx context.Context, request interfaces.NotificationPreferenceListRequest) (
+	*interfaces.NotificationPreferenceListResponse, error) {
+	var response *interfaces.NotificationPreferenceListResponse
+	var err error
+	m.Metrics.notificationPreferenceEndpointMetrics.list.Time(func() {
+		response, err = m.NotificationPreferenceManager.ListNotificationPreferences(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.notificationPreferenceEndpointMetrics.list)
+	}
+	m.Metrics.notificationPreferenceEndpointMetrics.list.Success()
+	return response, nil
+}
+
+// Authenticated users GET this handler to list their notification preferences as JSON and POST an
+// interfaces.NotificationPreferenceUpdateRequest to it to subscribe to or unsubscribe from the failure notifications of
+// a launch plan.
+func (m *AdminService) GetNotificationPreferencesHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet:
+			response, err := m.ListNotificationPreferences(request.Context(), interfaces.NotificationPreferenceListRequest{})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		case http.MethodPost:
+			var updateRequest interfaces.NotificationPreferenceUpdateRequest
+			if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid notification preference: %v", err), http.StatusBadRequest)
+				return
+			}
+			if _, err := m.UpdateNotificationPreference(request.Context(), updateRequest); err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
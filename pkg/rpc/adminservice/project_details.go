@@ -0,0 +1,176 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+var projectStates = map[string]interfaces.ProjectState{
+	"ACTIVE":   interfaces.ProjectStateActive,
+	"ARCHIVED": interfaces.ProjectStateArchived,
+}
+
+var projectStateNames = map[interfaces.ProjectState]string{
+	interfaces.ProjectStateActive:   "ACTIVE",
+	interfaces.ProjectStateArchived: "ARCHIVED",
+}
+
+// The HTTP representation of an interfaces.ProjectUpdateRequest.
+type projectUpdateRequest struct {
+	ID          string            `json:"id"`
+	Description *string           `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	// Either ACTIVE or ARCHIVED.
+	State *string `json:"state"`
+}
+
+// The HTTP representation of an interfaces.ProjectDetails.
+type projectDetails struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	State       string            `json:"state"`
+}
+
+// The HTTP representation of an interfaces.ProjectDetailsList.
+type projectDetailsList struct {
+	Projects []projectDetails `json:"projects"`
+	Token    string           `json:"token,omitempty"`
+}
+
+func (m *AdminService) UpdateProject(
+	ctx context.Context, request interfaces.ProjectUpdateRequest) (*interfaces.ProjectUpdateResponse, error) {
+	if err := authorizeProject(ctx, request.ID); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.update)
+	}
+	var response *interfaces.ProjectUpdateResponse
+	var err error
+	m.Metrics.projectEndpointMetrics.update.Time(func() {
+		response, err = m.ProjectManager.UpdateProject(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.update)
+	}
+	m.Metrics.projectEndpointMetrics.update.Success()
+	return response, nil
+}
+
+func (m *AdminService) ListProjectDetails(
+	ctx context.Context, request interfaces.ProjectDetailsListRequest) (*interfaces.ProjectDetailsList, error) {
+	var response *interfaces.ProjectDetailsList
+	var err error
+	m.Metrics.projectEndpointMetrics.listDetails.Time(func() {
+		response, err = m.ProjectManager.ListProjectDetails(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.listDetails)
+	}
+	m.Metrics.projectEndpointMetrics.listDetails.Success()
+	return response, nil
+}
+
+// The description, labels and state of a project are updated by POSTing a JSON projectUpdateRequest to this handler.
+// Fields left out of the request are left unchanged.
+func (m *AdminService) GetUpdateProjectHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body projectUpdateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid project update request: %v", err), http.StatusBadRequest)
+			return
+		}
+		updateRequest := interfaces.ProjectUpdateRequest{
+			ID:          body.ID,
+			Description: body.Description,
+			Labels:      body.Labels,
+		}
+		if body.State != nil {
+			state, ok := projectStates[*body.State]
+			if !ok {
+				http.Error(writer, fmt.Sprintf("unknown project state [%s]", *body.State), http.StatusBadRequest)
+				return
+			}
+			updateRequest.State = &state
+		}
+		if _, err := m.UpdateProject(request.Context(), updateRequest); err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Registered projects, including their labels and states, are listed by GETting this handler. Archived projects are
+// only listed when the include_archived query param is true. The filters query param filters on project fields like it
+// does for other list endpoints, repeated label=key:value query params only list projects carrying all of the labels
+// and the limit and token query params page through the results. The response is a JSON projectDetailsList.
+func (m *AdminService) GetListProjectDetailsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		listRequest := interfaces.ProjectDetailsListRequest{
+			Filters: query.Get("filters"),
+			Token:   query.Get("token"),
+		}
+		if value := query.Get("include_archived"); len(value) > 0 {
+			var err error
+			listRequest.IncludeArchived, err = strconv.ParseBool(value)
+			if err != nil {
+				http.Error(writer, fmt.Sprintf("invalid include_archived [%s]", value), http.StatusBadRequest)
+				return
+			}
+		}
+		for _, label := range query["label"] {
+			parts := strings.SplitN(label, ":", 2)
+			if len(parts) != 2 || len(parts[0]) == 0 {
+				http.Error(writer, fmt.Sprintf("invalid label [%s]", label), http.StatusBadRequest)
+				return
+			}
+			if listRequest.Labels == nil {
+				listRequest.Labels = make(map[string]string)
+			}
+			listRequest.Labels[parts[0]] = parts[1]
+		}
+		if limit := query.Get("limit"); limit != "" {
+			parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+			if err != nil {
+				http.Error(writer, fmt.Sprintf("invalid limit [%s]", limit), http.StatusBadRequest)
+				return
+			}
+			listRequest.Limit = uint32(parsedLimit)
+		}
+		response, err := m.ListProjectDetails(request.Context(), listRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		projects := make([]projectDetails, len(response.Projects))
+		for idx, project := range response.Projects {
+			projects[idx] = projectDetails{
+				ID:          project.ID,
+				Name:        project.Name,
+				Description: project.Description,
+				Labels:      project.Labels,
+				State:       projectStateNames[project.State],
+			}
+		}
+		writeJSONResponse(ctx, writer, projectDetailsList{
+			Projects: projects,
+			Token:    response.Token,
+		})
+	}
+}
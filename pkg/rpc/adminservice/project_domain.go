@@ -2,7 +2,12 @@ package adminservice
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
 
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"google.golang.org/grpc/codes"
@@ -26,3 +31,82 @@ func (m *AdminService) UpdateProjectDomainAttributes(ctx context.Context, reques
 
 	return response, nil
 }
+
+func (m *AdminService) SyncProjectDomainAttributes(
+	ctx context.Context, request interfaces.ProjectDomainAttributesSyncRequest) (
+	*interfaces.ProjectDomainAttributesSyncResponse, error) {
+	var response *interfaces.ProjectDomainAttributesSyncResponse
+	var err error
+	m.Metrics.projectDomainEndpointMetrics.syncAttributes.Time(func() {
+		response, err = m.ProjectDomainManager.SyncProjectDomainAttributes(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectDomainEndpointMetrics.syncAttributes)
+	}
+	m.Metrics.projectDomainEndpointMetrics.syncAttributes.Success()
+	return response, nil
+}
+
+func (m *AdminService) AckProjectDomainAttributes(
+	ctx context.Context, request interfaces.ProjectDomainAttributesAckRequest) (
+	*interfaces.ProjectDomainAttributesAckResponse, error) {
+	var response *interfaces.ProjectDomainAttributesAckResponse
+	var err error
+	m.Metrics.projectDomainEndpointMetrics.ackAttributes.Time(func() {
+		response, err = m.ProjectDomainManager.AckProjectDomainAttributes(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectDomainEndpointMetrics.ackAttributes)
+	}
+	m.Metrics.projectDomainEndpointMetrics.ackAttributes.Success()
+	return response, nil
+}
+
+// Propeller follows project-domain attribute changes by polling this handler with the since_version query param set to
+// the version returned by its previous poll (0 to start), getting back the attributes updated since as JSON.
+func (m *AdminService) GetSyncProjectDomainAttributesHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var sinceVersion int64
+		if value := request.URL.Query().Get("since_version"); value != "" {
+			parsedVersion, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				http.Error(writer, fmt.Sprintf("invalid since_version: %s", value), http.StatusBadRequest)
+				return
+			}
+			sinceVersion = parsedVersion
+		}
+		response, err := m.SyncProjectDomainAttributes(request.Context(), interfaces.ProjectDomainAttributesSyncRequest{
+			SinceVersion: sinceVersion,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
+
+// Clusters POST an interfaces.ProjectDomainAttributesAckRequest here once they've applied the attributes returned
+// by a sync, which tracks how far behind the latest attributes each cluster is.
+func (m *AdminService) GetAckProjectDomainAttributesHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var ackRequest interfaces.ProjectDomainAttributesAckRequest
+		if err := json.NewDecoder(request.Body).Decode(&ackRequest); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid ack: %v", err), http.StatusBadRequest)
+			return
+		}
+		if _, err := m.AckProjectDomainAttributes(request.Context(), ackRequest); err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,46 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetProjectOnboardingStatus(
+	ctx context.Context, request interfaces.ProjectOnboardingStatusGetRequest) (
+	*interfaces.ProjectOnboardingStatus, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.getOnboardingStatus)
+	}
+	var response *interfaces.ProjectOnboardingStatus
+	var err error
+	m.Metrics.projectEndpointMetrics.getOnboardingStatus.Time(func() {
+		response, err = m.ProjectManager.GetProjectOnboardingStatus(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.getOnboardingStatus)
+	}
+	m.Metrics.projectEndpointMetrics.getOnboardingStatus.Success()
+	return response, nil
+}
+
+// The onboarding progress of the project named by the project query param is fetched by GETting this handler. The
+// response is an interfaces.ProjectOnboardingStatus.
+func (m *AdminService) GetProjectOnboardingStatusHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		response, err := m.GetProjectOnboardingStatus(request.Context(), interfaces.ProjectOnboardingStatusGetRequest{
+			Project: request.URL.Query().Get("project"),
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
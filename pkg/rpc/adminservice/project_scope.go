@@ -0,0 +1,20 @@
+package adminservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	"github.com/lyft/flyteadmin/pkg/errors"
+)
+
+// The plain HTTP handlers aren't served through server.ProjectScopeUnaryServerInterceptor, so every service method
+// they call that's scoped to a project checks the caller is entitled to it here before calling its manager.
+func authorizeProject(ctx context.Context, project string) error {
+	if !auth.IsProjectAuthorized(ctx, project) {
+		return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"caller isn't entitled to resources of project [%s]", project)
+	}
+	return nil
+}
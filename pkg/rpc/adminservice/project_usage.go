@@ -0,0 +1,64 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetProjectUsageReport(
+	ctx context.Context, request interfaces.ProjectUsageReportRequest) (*interfaces.ProjectUsageReport, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.getUsageReport)
+	}
+	var response *interfaces.ProjectUsageReport
+	var err error
+	m.Metrics.projectEndpointMetrics.getUsageReport.Time(func() {
+		response, err = m.ProjectManager.GetProjectUsageReport(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectEndpointMetrics.getUsageReport)
+	}
+	m.Metrics.projectEndpointMetrics.getUsageReport.Success()
+	return response, nil
+}
+
+// The executions created between the RFC 3339 start_time and end_time query params are aggregated by project and
+// domain, e.g. for chargeback, by GETting this handler. The optional project and domain query params narrow the report
+// down. The response is an interfaces.ProjectUsageReport.
+func (m *AdminService) GetProjectUsageReportHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		reportRequest := interfaces.ProjectUsageReportRequest{
+			Project: query.Get("project"),
+			Domain:  query.Get("domain"),
+		}
+		for param, value := range map[string]*time.Time{
+			"start_time": &reportRequest.StartTime,
+			"end_time":   &reportRequest.EndTime,
+		} {
+			if rawValue := query.Get(param); len(rawValue) > 0 {
+				parsedValue, err := time.Parse(time.RFC3339, rawValue)
+				if err != nil {
+					http.Error(writer, fmt.Sprintf("invalid %s [%s]", param, rawValue), http.StatusBadRequest)
+					return
+				}
+				*value = parsedValue
+			}
+		}
+		response, err := m.GetProjectUsageReport(request.Context(), reportRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
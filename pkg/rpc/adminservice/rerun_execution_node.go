@@ -0,0 +1,66 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.ExecutionNodeRerunRequest.
+type executionNodeRerunRequest struct {
+	ID     *core.WorkflowExecutionIdentifier `json:"id"`
+	NodeID string                            `json:"nodeId"`
+	Name   string                            `json:"name"`
+}
+
+func (m *AdminService) RerunExecutionNode(
+	ctx context.Context, request interfaces.ExecutionNodeRerunRequest) (*admin.ExecutionCreateResponse, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.rerunNode)
+	}
+	requestedAt := time.Now()
+	var response *admin.ExecutionCreateResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.rerunNode.Time(func() {
+		response, err = m.ExecutionManager.RerunExecutionNode(ctx, request, requestedAt)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.rerunNode)
+	}
+	m.Metrics.executionEndpointMetrics.rerunNode.Success()
+	return response, nil
+}
+
+// A failed node of a terminated execution is re-run, along with the nodes downstream of it, by POSTing a JSON
+// executionNodeRerunRequest to this handler. The response is the identifier of the new execution.
+func (m *AdminService) GetRerunExecutionNodeHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body executionNodeRerunRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid node re-run request: %v", err), http.StatusBadRequest)
+			return
+		}
+		response, err := m.RerunExecutionNode(request.Context(), interfaces.ExecutionNodeRerunRequest{
+			ID:     body.ID,
+			NodeID: body.NodeID,
+			Name:   body.Name,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response.GetId())
+	}
+}
@@ -0,0 +1,168 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+var resourceTypes = map[string]interfaces.MatchableResource{
+	"TASK_RESOURCE":           interfaces.MatchableResourceTaskResource,
+	"EXECUTION_QUEUE":         interfaces.MatchableResourceExecutionQueue,
+	"EXECUTION_CLUSTER_LABEL": interfaces.MatchableResourceExecutionClusterLabel,
+	"FEATURE_FLAGS":           interfaces.MatchableResourceFeatureFlags,
+	"EXECUTION_NAME_POLICY":   interfaces.MatchableResourceExecutionNamePolicy,
+	"QUIET_HOURS":             interfaces.MatchableResourceQuietHours,
+}
+
+func (m *AdminService) UpdateResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesUpdateRequest) (
+	*interfaces.ResourceAttributesUpdateResponse, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.update)
+	}
+	var response *interfaces.ResourceAttributesUpdateResponse
+	var err error
+	m.Metrics.resourceEndpointMetrics.update.Time(func() {
+		response, err = m.ResourceManager.UpdateResourceAttributes(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.update)
+	}
+	m.Metrics.resourceEndpointMetrics.update.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesGetRequest) (*interfaces.ResourceAttributes, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.get)
+	}
+	var response *interfaces.ResourceAttributes
+	var err error
+	m.Metrics.resourceEndpointMetrics.get.Time(func() {
+		response, err = m.ResourceManager.GetResourceAttributes(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.get)
+	}
+	m.Metrics.resourceEndpointMetrics.get.Success()
+	return response, nil
+}
+
+func (m *AdminService) DeleteResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesDeleteRequest) (
+	*interfaces.ResourceAttributesDeleteResponse, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.delete)
+	}
+	var response *interfaces.ResourceAttributesDeleteResponse
+	var err error
+	m.Metrics.resourceEndpointMetrics.delete.Time(func() {
+		response, err = m.ResourceManager.DeleteResourceAttributes(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.delete)
+	}
+	m.Metrics.resourceEndpointMetrics.delete.Success()
+	return response, nil
+}
+
+func (m *AdminService) ListResourceAttributes(
+	ctx context.Context, request interfaces.ResourceAttributesListRequest) (*interfaces.ResourceAttributesList, error) {
+	var response *interfaces.ResourceAttributesList
+	var err error
+	m.Metrics.resourceEndpointMetrics.list.Time(func() {
+		response, err = m.ResourceManager.ListResourceAttributes(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.resourceEndpointMetrics.list)
+	}
+	// Listings span every project, so drop the attributes of projects the caller isn't entitled to.
+	authorized := make([]interfaces.ResourceAttributes, 0, len(response.Attributes))
+	for _, attributes := range response.Attributes {
+		if auth.IsProjectAuthorized(ctx, attributes.Project) {
+			authorized = append(authorized, attributes)
+		}
+	}
+	response.Attributes = authorized
+	m.Metrics.resourceEndpointMetrics.list.Success()
+	return response, nil
+}
+
+// Matchable resource attributes are managed through this handler. POSTing a JSON
+// interfaces.ResourceAttributesUpdateRequest sets the attributes of a project, a domain of it or a workflow in them.
+// GET and DELETE requests identify the attributes by the project, domain, workflow and resource_type query params,
+// where resource_type is one of TASK_RESOURCE, EXECUTION_QUEUE, EXECUTION_CLUSTER_LABEL, FEATURE_FLAGS,
+// EXECUTION_NAME_POLICY or QUIET_HOURS. GETting without a project nor domain lists all the attributes set for the
+// resource type.
+func (m *AdminService) GetResourceAttributesHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodPost {
+			var body interfaces.ResourceAttributesUpdateRequest
+			if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid resource attributes update request: %v", err),
+					http.StatusBadRequest)
+				return
+			}
+			if _, err := m.UpdateResourceAttributes(request.Context(), body); err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if request.Method != http.MethodGet && request.Method != http.MethodDelete {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		resourceType, ok := resourceTypes[query.Get("resource_type")]
+		if !ok {
+			http.Error(writer, fmt.Sprintf("unknown resource_type [%s]", query.Get("resource_type")),
+				http.StatusBadRequest)
+			return
+		}
+		project, domain, workflow := query.Get("project"), query.Get("domain"), query.Get("workflow")
+		switch {
+		case request.Method == http.MethodDelete:
+			_, err := m.DeleteResourceAttributes(request.Context(), interfaces.ResourceAttributesDeleteRequest{
+				Project:      project,
+				Domain:       domain,
+				Workflow:     workflow,
+				ResourceType: resourceType,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writer.WriteHeader(http.StatusNoContent)
+		case len(project) == 0 && len(domain) == 0:
+			response, err := m.ListResourceAttributes(request.Context(), interfaces.ResourceAttributesListRequest{
+				ResourceType: resourceType,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		default:
+			response, err := m.GetResourceAttributes(request.Context(), interfaces.ResourceAttributesGetRequest{
+				Project:      project,
+				Domain:       domain,
+				Workflow:     workflow,
+				ResourceType: resourceType,
+			})
+			if err != nil {
+				writeErrorResponse(writer, err)
+				return
+			}
+			writeJSONResponse(ctx, writer, response)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) SearchEntities(
+	ctx context.Context, request interfaces.EntitySearchRequest) (*interfaces.EntitySearchResults, error) {
+	if err := authorizeProject(ctx, request.Project); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.namedEntityEndpointMetrics.search)
+	}
+	var response *interfaces.EntitySearchResults
+	var err error
+	m.Metrics.namedEntityEndpointMetrics.search.Time(func() {
+		response, err = m.NamedEntityManager.SearchEntities(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.namedEntityEndpointMetrics.search)
+	}
+	m.Metrics.namedEntityEndpointMetrics.search.Success()
+	return response, nil
+}
+
+// The tasks, workflows and launch plans of the project (and optionally domain) named by the query params whose name or
+// description matches the query param are searched by GETting this handler. Repeated resource_type params, e.g. TASK,
+// restrict the search to those resource types. The limit and token query params page through the results like they do
+// for other list endpoints, and the response is an interfaces.EntitySearchResults.
+func (m *AdminService) GetSearchEntitiesHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		searchRequest := interfaces.EntitySearchRequest{
+			Project: query.Get("project"),
+			Domain:  query.Get("domain"),
+			Query:   query.Get("query"),
+			Token:   query.Get("token"),
+		}
+		for _, resourceType := range query["resource_type"] {
+			value, ok := core.ResourceType_value[resourceType]
+			if !ok {
+				http.Error(writer, fmt.Sprintf("invalid resource type [%s]", resourceType), http.StatusBadRequest)
+				return
+			}
+			searchRequest.ResourceTypes = append(searchRequest.ResourceTypes, core.ResourceType(value))
+		}
+		if limit := query.Get("limit"); limit != "" {
+			parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+			if err != nil {
+				http.Error(writer, fmt.Sprintf("invalid limit [%s]", limit), http.StatusBadRequest)
+				return
+			}
+			searchRequest.Limit = uint32(parsedLimit)
+		}
+		response, err := m.SearchEntities(request.Context(), searchRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
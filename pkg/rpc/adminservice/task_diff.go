@@ -0,0 +1,53 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) DiffTask(
+	ctx context.Context, request admin.TaskCreateRequest) (*interfaces.TaskDiffResponse, error) {
+	if err := authorizeProject(ctx, request.GetId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskEndpointMetrics.diff)
+	}
+	var response *interfaces.TaskDiffResponse
+	var err error
+	m.Metrics.taskEndpointMetrics.diff.Time(func() {
+		response, err = m.TaskManager.DiffTask(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskEndpointMetrics.diff)
+	}
+	m.Metrics.taskEndpointMetrics.diff.Success()
+	return response, nil
+}
+
+// Users whose task registration is rejected because a task with a different structure exists POST the same
+// admin.TaskCreateRequest, in the protobuf JSON mapping, to this handler. The response is an
+// interfaces.TaskDiffResponse listing the fields which differ.
+func (m *AdminService) GetDiffTaskHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var createRequest admin.TaskCreateRequest
+		if err := jsonpb.Unmarshal(request.Body, &createRequest); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid task create request: %v", err), http.StatusBadRequest)
+			return
+		}
+		response, err := m.DiffTask(request.Context(), createRequest)
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
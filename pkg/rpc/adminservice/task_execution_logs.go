@@ -0,0 +1,79 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetTaskExecutionLogs(
+	ctx context.Context, request interfaces.TaskExecutionLogsGetRequest) (
+	*interfaces.TaskExecutionLogsGetResponse, error) {
+	if err := authorizeProject(ctx, request.ID.GetNodeExecutionId().GetExecutionId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskExecutionEndpointMetrics.getLogs)
+	}
+	var response *interfaces.TaskExecutionLogsGetResponse
+	var err error
+	m.Metrics.taskExecutionEndpointMetrics.getLogs.Time(func() {
+		response, err = m.TaskExecutionManager.GetTaskExecutionLogs(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskExecutionEndpointMetrics.getLogs)
+	}
+	m.Metrics.taskExecutionEndpointMetrics.getLogs.Success()
+	return response, nil
+}
+
+// Reads a task execution identifier from the node execution query params plus the task's task_project, task_domain,
+// task_name and task_version and the retry_attempt.
+func getTaskExecutionIdentifierFromQuery(request *http.Request) (*core.TaskExecutionIdentifier, error) {
+	query := request.URL.Query()
+	id := &core.TaskExecutionIdentifier{
+		TaskId: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      query.Get("task_project"),
+			Domain:       query.Get("task_domain"),
+			Name:         query.Get("task_name"),
+			Version:      query.Get("task_version"),
+		},
+		NodeExecutionId: getNodeExecutionIdentifierFromQuery(request),
+	}
+	if retryAttempt := query.Get("retry_attempt"); retryAttempt != "" {
+		parsedRetryAttempt, err := strconv.ParseUint(retryAttempt, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry_attempt [%s]", retryAttempt)
+		}
+		id.RetryAttempt = uint32(parsedRetryAttempt)
+	}
+	return id, nil
+}
+
+// Up to date log links for the task execution named by the query params are fetched by GETting this handler. The
+// response is an interfaces.TaskExecutionLogsGetResponse.
+func (m *AdminService) GetTaskExecutionLogsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		taskExecutionID, err := getTaskExecutionIdentifierFromQuery(request)
+		if err != nil {
+			http.Error(writer, fmt.Sprintf("invalid task execution logs request: %v", err), http.StatusBadRequest)
+			return
+		}
+		response, err := m.GetTaskExecutionLogs(request.Context(), interfaces.TaskExecutionLogsGetRequest{
+			ID: taskExecutionID,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
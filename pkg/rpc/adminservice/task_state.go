@@ -0,0 +1,72 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+var taskStates = map[string]interfaces.TaskState{
+	"ACTIVE":   interfaces.TaskStateActive,
+	"ARCHIVED": interfaces.TaskStateArchived,
+}
+
+// The HTTP representation of an interfaces.TaskStateUpdateRequest.
+type taskStateUpdateRequest struct {
+	ID *core.Identifier `json:"id"`
+	// Either ACTIVE or ARCHIVED.
+	State string `json:"state"`
+}
+
+func (m *AdminService) UpdateTaskState(
+	ctx context.Context, request interfaces.TaskStateUpdateRequest) (*interfaces.TaskStateUpdateResponse, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskEndpointMetrics.updateState)
+	}
+	var response *interfaces.TaskStateUpdateResponse
+	var err error
+	m.Metrics.taskEndpointMetrics.updateState.Time(func() {
+		response, err = m.TaskManager.UpdateTaskState(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskEndpointMetrics.updateState)
+	}
+	m.Metrics.taskEndpointMetrics.updateState.Success()
+	return response, nil
+}
+
+// A task version, or all versions of a task when the id has no version, is archived or restored by POSTing a JSON
+// taskStateUpdateRequest to this handler.
+func (m *AdminService) GetUpdateTaskStateHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body taskStateUpdateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid task state update request: %v", err), http.StatusBadRequest)
+			return
+		}
+		state, ok := taskStates[body.State]
+		if !ok {
+			http.Error(writer, fmt.Sprintf("unknown task state [%s]", body.State), http.StatusBadRequest)
+			return
+		}
+		_, err := m.UpdateTaskState(request.Context(), interfaces.TaskStateUpdateRequest{
+			ID:    body.ID,
+			State: state,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const backfillRequestBody = `{
+	"launchPlan": {"project": "project", "domain": "domain", "name": "name", "version": "version"},
+	"startTime": "2019-11-01T00:00:00Z",
+	"endTime": "2019-11-02T00:00:00Z",
+	"inputs": {"literals": {"region": {"scalar": {"primitive": {"string_value": "us-east-1"}}}}}
+}`
+
+func TestBackfillExecutionsHandler(t *testing.T) {
+	kickoffTime := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetBackfillExecutionsCallback(
+		func(ctx context.Context, request interfaces.BackfillExecutionsRequest, requestedAt time.Time) (
+			*interfaces.BackfillExecutionsResponse, error) {
+			assert.Equal(t, core.ResourceType_LAUNCH_PLAN, request.LaunchPlan.ResourceType)
+			assert.Equal(t, "name", request.LaunchPlan.Name)
+			assert.Equal(t, kickoffTime, request.StartTime)
+			assert.Equal(t, kickoffTime.Add(24*time.Hour), request.EndTime)
+			assert.Equal(t, "us-east-1",
+				request.Inputs.Literals["region"].GetScalar().GetPrimitive().GetStringValue())
+			return &interfaces.BackfillExecutionsResponse{
+				ID:         "backfill",
+				Executions: 24,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+	handler := mockServer.GetBackfillExecutionsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(backfillRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.BackfillExecutionsResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "backfill", response.ID)
+	assert.Equal(t, 24, response.Executions)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPut, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestBackfillExecutionsHandlerError(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetBackfillExecutionsCallback(
+		func(ctx context.Context, request interfaces.BackfillExecutionsRequest, requestedAt time.Time) (
+			*interfaces.BackfillExecutionsResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "launch plan has no schedule")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetBackfillExecutionsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(backfillRequestBody)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "launch plan has no schedule")
+}
+
+func TestGetBackfillHandler(t *testing.T) {
+	kickoffTime := time.Date(2019, time.November, 1, 0, 0, 0, 0, time.UTC)
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetGetBackfillCallback(
+		func(ctx context.Context, id string) (*interfaces.Backfill, error) {
+			assert.Equal(t, "backfill", id)
+			return &interfaces.Backfill{
+				ID: id,
+				LaunchPlan: &core.Identifier{
+					ResourceType: core.ResourceType_LAUNCH_PLAN,
+					Project:      "project",
+					Domain:       "domain",
+					Name:         "name",
+					Version:      "version",
+				},
+				Total: 2,
+				Executions: []interfaces.BackfillExecutionResult{
+					{
+						KickoffTime: kickoffTime,
+						ID:          &workflowExecutionIdentifier,
+					},
+				},
+				Succeeded: 1,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetBackfillExecutionsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/?id=backfill", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.Backfill
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Total)
+	assert.Equal(t, 1, response.Succeeded)
+	assert.False(t, response.Done)
+	assert.Equal(t, "Name", response.Executions[0].ID.Name)
+}
+
+func TestGetBackfillHandlerError(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetGetBackfillCallback(
+		func(ctx context.Context, id string) (*interfaces.Backfill, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "backfill [%s] not found", id)
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetBackfillExecutionsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/?id=missing", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "backfill [missing] not found")
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const workflowCompileRequestBody = `{
+	"workflow": {"template": {"id": {"resourceType": "WORKFLOW", "name": "name"}, "nodes": [{"id": "node"}]}},
+	"tasks": [{"id": {"resourceType": "TASK", "name": "task"}, "type": "python"}]
+}`
+
+func TestCompileWorkflowHandler(t *testing.T) {
+	mockWorkflowManager := mocks.MockWorkflowManager{}
+	mockWorkflowManager.SetCompileWorkflowCallback(
+		func(ctx context.Context, request interfaces.WorkflowCompileRequest) (
+			*interfaces.WorkflowCompileResponse, error) {
+			assert.Equal(t, "name", request.Workflow.Template.Id.Name)
+			assert.Len(t, request.Tasks, 1)
+			assert.Equal(t, "python", request.Tasks[0].Type)
+			return &interfaces.WorkflowCompileResponse{
+				Closure: &core.CompiledWorkflowClosure{
+					Primary: &core.CompiledWorkflow{
+						Template: request.Workflow.Template,
+					},
+				},
+				Diagnostics: []interfaces.WorkflowCompileDiagnostic{
+					{
+						Code:    codes.InvalidArgument.String(),
+						Message: "workflow exceeds the size limit",
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		workflowManager: &mockWorkflowManager,
+	})
+	handler := mockServer.GetCompileWorkflowHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(workflowCompileRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response struct {
+		Closure struct {
+			Primary struct {
+				Template struct {
+					Nodes []struct {
+						ID string `json:"id"`
+					} `json:"nodes"`
+				} `json:"template"`
+			} `json:"primary"`
+		} `json:"closure"`
+		Diagnostics []interfaces.WorkflowCompileDiagnostic `json:"diagnostics"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "node", response.Closure.Primary.Template.Nodes[0].ID)
+	assert.Equal(t, "workflow exceeds the size limit", response.Diagnostics[0].Message)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"workflow": {"unknown": 1}}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCompileWorkflowHandlerError(t *testing.T) {
+	mockWorkflowManager := mocks.MockWorkflowManager{}
+	mockWorkflowManager.SetCompileWorkflowCallback(
+		func(ctx context.Context, request interfaces.WorkflowCompileRequest) (
+			*interfaces.WorkflowCompileResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing spec")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		workflowManager: &mockWorkflowManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetCompileWorkflowHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const domainsURL = "/api/v1/domains"
+
+func TestGetDomainsHandler(t *testing.T) {
+	mockProjectManager := mocks.MockProjectManager{}
+	var registerRequest interfaces.DomainRegisterRequest
+	mockProjectManager.SetRegisterDomainFunc(
+		func(ctx context.Context, request interfaces.DomainRegisterRequest) (*interfaces.DomainRegisterResponse, error) {
+			registerRequest = request
+			return &interfaces.DomainRegisterResponse{}, nil
+		})
+	mockProjectManager.SetListDomainsFunc(func(ctx context.Context) (*interfaces.DomainList, error) {
+		return &interfaces.DomainList{
+			Domains: []interfaces.Domain{
+				{ID: "development", Name: "development", Configured: true},
+				{ID: "qa", Name: "QA"},
+			},
+		}, nil
+	})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+	handler := mockServer.GetDomainsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, domainsURL, strings.NewReader(`{"id": "qa", "name": "QA"}`)))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, interfaces.DomainRegisterRequest{ID: "qa", Name: "QA"}, registerRequest)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, domainsURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.DomainList
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Len(t, response.Domains, 2)
+	assert.True(t, response.Domains[0].Configured)
+	assert.Equal(t, "qa", response.Domains[1].ID)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodDelete, domainsURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetDomainsHandlerError(t *testing.T) {
+	mockProjectManager := mocks.MockProjectManager{}
+	mockProjectManager.SetRegisterDomainFunc(
+		func(ctx context.Context, request interfaces.DomainRegisterRequest) (*interfaces.DomainRegisterResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.AlreadyExists, "domain [qa] already exists")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetDomainsHandler(context.Background())(recorder, httptest.NewRequest(
+		http.MethodPost, domainsURL, strings.NewReader(`{"id": "qa", "name": "QA"}`)))
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "domain [qa] already exists")
+}
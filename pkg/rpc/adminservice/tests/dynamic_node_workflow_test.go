@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const dynamicNodeWorkflowURL = "/api/v1/dynamic_node_workflow?project=project&domain=domain&name=name" +
+	"&node_id=dynamic-node"
+
+var dynamicNodeWorkflow = core.CompiledWorkflowClosure{
+	Primary: &core.CompiledWorkflow{
+		Template: &core.WorkflowTemplate{
+			Id: &core.Identifier{
+				ResourceType: core.ResourceType_WORKFLOW,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "dynamic-workflow",
+			},
+		},
+	},
+}
+
+func TestCreateDynamicNodeWorkflowHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetCreateDynamicNodeWorkflowFunc(
+		func(ctx context.Context, request interfaces.DynamicNodeWorkflowCreateRequest) (
+			*interfaces.DynamicNodeWorkflowCreateResponse, error) {
+			assert.Equal(t, "dynamic-node", request.NodeExecutionID.NodeId)
+			assert.Equal(t, "name", request.NodeExecutionID.ExecutionId.Name)
+			assert.True(t, proto.Equal(&dynamicNodeWorkflow, request.CompiledWorkflow))
+			return &interfaces.DynamicNodeWorkflowCreateResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetDynamicNodeWorkflowHandler(context.Background())
+
+	body, err := (&jsonpb.Marshaler{}).MarshalToString(&dynamicNodeWorkflow)
+	assert.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, dynamicNodeWorkflowURL, strings.NewReader(body)))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, dynamicNodeWorkflowURL, strings.NewReader("not json")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodDelete, dynamicNodeWorkflowURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetDynamicNodeWorkflowHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetDynamicNodeWorkflowFunc(
+		func(ctx context.Context, request interfaces.DynamicNodeWorkflowGetRequest) (*admin.WorkflowClosure, error) {
+			assert.Equal(t, "dynamic-node", request.NodeExecutionID.NodeId)
+			return &admin.WorkflowClosure{
+				CompiledWorkflow: &dynamicNodeWorkflow,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetDynamicNodeWorkflowHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, dynamicNodeWorkflowURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response admin.WorkflowClosure
+	assert.NoError(t, jsonpb.Unmarshal(recorder.Body, &response))
+	assert.True(t, proto.Equal(&dynamicNodeWorkflow, response.CompiledWorkflow))
+}
+
+func TestGetDynamicNodeWorkflowHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetDynamicNodeWorkflowFunc(
+		func(ctx context.Context, request interfaces.DynamicNodeWorkflowGetRequest) (*admin.WorkflowClosure, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "node execution has no dynamic workflow")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetDynamicNodeWorkflowHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, dynamicNodeWorkflowURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no dynamic workflow")
+}
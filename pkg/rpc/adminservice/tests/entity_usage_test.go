@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+func TestGetEntityUsageHandler(t *testing.T) {
+	lastExecutedAt := time.Date(2019, 12, 10, 10, 0, 0, 0, time.UTC)
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetGetEntityUsageCallback(
+		func(ctx context.Context, request interfaces.EntityUsageGetRequest) (*interfaces.EntityUsage, error) {
+			assert.Equal(t, "project", request.Project)
+			assert.Equal(t, "domain", request.Domain)
+			return &interfaces.EntityUsage{
+				Since: lastExecutedAt.Add(-30 * 24 * time.Hour),
+				Workflows: []interfaces.NamedEntityUsage{
+					{
+						ID: &admin.NamedEntityIdentifier{
+							Project: "project",
+							Domain:  "domain",
+							Name:    "workflow",
+						},
+						LastExecutedAt: &lastExecutedAt,
+						ExecutionCount: 3,
+					},
+				},
+				LaunchPlans: []interfaces.NamedEntityUsage{},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+	handler := mockServer.GetEntityUsageHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/?project=project&domain=domain", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var usage interfaces.EntityUsage
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &usage))
+	assert.Len(t, usage.Workflows, 1)
+	assert.Equal(t, "workflow", usage.Workflows[0].ID.Name)
+	assert.True(t, lastExecutedAt.Equal(*usage.Workflows[0].LastExecutedAt))
+	assert.Equal(t, int64(3), usage.Workflows[0].ExecutionCount)
+	assert.Empty(t, usage.LaunchPlans)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetEntityUsageHandlerError(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetGetEntityUsageCallback(
+		func(ctx context.Context, request interfaces.EntityUsageGetRequest) (*interfaces.EntityUsage, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing project")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetEntityUsageHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/?domain=domain", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing project")
+}
+
+func TestGetEntityUsageHandlerUnauthorizedProject(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetGetEntityUsageCallback(
+		func(ctx context.Context, request interfaces.EntityUsageGetRequest) (*interfaces.EntityUsage, error) {
+			assert.Fail(t, "usage of a project the caller isn't entitled to shouldn't be read")
+			return nil, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	ctx := auth.WithAuthorizedProjects(context.Background(), []string{"other"})
+	mockServer.GetEntityUsageHandler(context.Background())(recorder, httptest.NewRequest(
+		http.MethodGet, "/?project=project&domain=domain", nil).WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const executionTimelineURL = "/api/v1/execution_timeline?project=project&domain=domain&name=name"
+
+func getMockTimelineExecutionManager(t *testing.T) *mocks.MockExecutionManager {
+	startedAt := time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetExportTimelineCallback(
+		func(ctx context.Context, request interfaces.ExecutionTimelineExportRequest) (
+			*interfaces.ExecutionTimeline, error) {
+			assert.Equal(t, "project", request.ID.Project)
+			assert.Equal(t, "domain", request.ID.Domain)
+			assert.Equal(t, "name", request.ID.Name)
+			task := &interfaces.TimelineTask{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task",
+				Version: "version",
+			}
+			return &interfaces.ExecutionTimeline{
+				ID: request.ID,
+				Events: []interfaces.TimelineEvent{
+					{
+						Kind:       interfaces.TimelineEventKindWorkflow,
+						Phase:      core.WorkflowExecution_RUNNING.String(),
+						OccurredAt: startedAt,
+					},
+					{
+						Kind:       interfaces.TimelineEventKindNode,
+						NodeID:     "node",
+						Phase:      core.NodeExecution_RUNNING.String(),
+						OccurredAt: startedAt.Add(time.Second),
+					},
+					{
+						Kind:       interfaces.TimelineEventKindTask,
+						NodeID:     "node",
+						Task:       task,
+						Phase:      core.TaskExecution_RUNNING.String(),
+						OccurredAt: startedAt.Add(2 * time.Second),
+					},
+					{
+						Kind:       interfaces.TimelineEventKindTask,
+						NodeID:     "node",
+						Task:       task,
+						Phase:      core.TaskExecution_SUCCEEDED.String(),
+						OccurredAt: startedAt.Add(5 * time.Second),
+					},
+				},
+			}, nil
+		})
+	return &mockExecutionManager
+}
+
+func TestGetExportExecutionTimelineHandler(t *testing.T) {
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: getMockTimelineExecutionManager(t),
+	})
+	handler := mockServer.GetExportExecutionTimelineHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, executionTimelineURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var timeline interfaces.ExecutionTimeline
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&timeline))
+	assert.Len(t, timeline.Events, 4)
+	assert.Equal(t, interfaces.TimelineEventKindTask, timeline.Events[3].Kind)
+	assert.Equal(t, "task", timeline.Events[3].Task.Name)
+	assert.Equal(t, core.TaskExecution_SUCCEEDED.String(), timeline.Events[3].Phase)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, executionTimelineURL+"&format=svg", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, executionTimelineURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetExportExecutionTimelineHandler_ChromeTrace(t *testing.T) {
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: getMockTimelineExecutionManager(t),
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetExportExecutionTimelineHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, executionTimelineURL+"&format=chrome", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var trace struct {
+		TraceEvents []struct {
+			Name     string            `json:"name"`
+			Phase    string            `json:"ph"`
+			ThreadID int               `json:"tid"`
+			Duration *int64            `json:"dur"`
+			Args     map[string]string `json:"args"`
+		} `json:"traceEvents"`
+	}
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&trace))
+	spans := make(map[string]int64)
+	var threadNames []string
+	for _, event := range trace.TraceEvents {
+		switch event.Phase {
+		case "X":
+			spans[event.Name] = *event.Duration
+		case "M":
+			if event.Name == "thread_name" {
+				threadNames = append(threadNames, event.Args["name"])
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"name":             0,
+		"node":             0,
+		"task (attempt 0)": 3000000,
+	}, spans)
+	assert.Equal(t, []string{interfaces.TimelineEventKindWorkflow, "node"}, threadNames)
+}
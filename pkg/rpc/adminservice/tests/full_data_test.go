@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+var fullInputs = core.LiteralMap{
+	Literals: map[string]*core.Literal{
+		"foo": {
+			Value: &core.Literal_Scalar{
+				Scalar: &core.Scalar{
+					Value: &core.Scalar_Primitive{
+						Primitive: &core.Primitive{
+							Value: &core.Primitive_Integer{
+								Integer: 4,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestGetExecutionFullDataHandler(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetGetFullDataCallback(
+		func(ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+			assert.True(t, proto.Equal(&core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			}, request.Id))
+			return &interfaces.FullDataResponse{
+				Inputs: &admin.UrlBlob{
+					Url:   "inputs",
+					Bytes: 100,
+				},
+				Outputs: &admin.UrlBlob{
+					Url:   "outputs",
+					Bytes: 200,
+				},
+				FullInputs: &fullInputs,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+	handler := mockServer.GetExecutionFullDataHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodGet, "/api/v1/full_data/executions?project=project&domain=domain&name=name", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	var inputs admin.UrlBlob
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["inputs"]), &inputs))
+	assert.Equal(t, "inputs", inputs.Url)
+	var literalMap core.LiteralMap
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["fullInputs"]), &literalMap))
+	assert.True(t, proto.Equal(&fullInputs, &literalMap))
+	assert.NotContains(t, response, "fullOutputs")
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodPost, "/api/v1/full_data/executions", strings.NewReader("{}")))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetNodeExecutionFullDataHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionFullDataFunc(
+		func(ctx context.Context, request admin.NodeExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+			assert.Equal(t, "node-id", request.Id.NodeId)
+			assert.Equal(t, "name", request.Id.ExecutionId.Name)
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.PermissionDenied, "restricted data")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionFullDataHandler(context.Background())(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/full_data/node_executions?project=project&domain=domain&name=name&node_id=node-id", nil))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "restricted data")
+}
+
+func TestGetNodeExecutionFullDataHandler_ErrorDocument(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionFullDataFunc(
+		func(ctx context.Context, request admin.NodeExecutionGetDataRequest) (*interfaces.FullDataResponse, error) {
+			return &interfaces.FullDataResponse{
+				Inputs: &admin.UrlBlob{
+					Url: "inputs",
+				},
+				ErrorDocument: &admin.UrlBlob{
+					Url:   "error",
+					Bytes: 300,
+				},
+				Error: &core.ExecutionError{
+					Code:     "USER:ValueError",
+					Message:  "stack trace",
+					ErrorUri: "s3://bucket/error.pb",
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionFullDataHandler(context.Background())(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/full_data/node_executions?project=project&domain=domain&name=name&node_id=node-id", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	var errorDocument admin.UrlBlob
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["errorDocument"]), &errorDocument))
+	assert.Equal(t, "error", errorDocument.Url)
+	var executionError core.ExecutionError
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["error"]), &executionError))
+	assert.Equal(t, "stack trace", executionError.Message)
+	assert.NotContains(t, response, "outputs")
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const inlineExecutionRequestBody = `{
+	"project": "project",
+	"domain": "domain",
+	"name": "name",
+	"tasks": [{"template": {"id": {"name": "t"}, "type": "python", "container": {"image": "image"}}}],
+	"inputs": {"literals": {"x": {"scalar": {"primitive": {"integer": "1"}}}}},
+	"spec": {"labels": {"values": {"foo": "bar"}}}
+}`
+
+func TestCreateInlineExecutionHandler(t *testing.T) {
+	mockInlineExecutionManager := mocks.MockInlineExecutionManager{}
+	mockInlineExecutionManager.SetCreateInlineExecutionCallback(
+		func(ctx context.Context, request interfaces.InlineExecutionCreateRequest, requestedAt time.Time) (
+			*interfaces.InlineExecutionCreateResponse, error) {
+			assert.Equal(t, "project", request.Project)
+			assert.Equal(t, "domain", request.Domain)
+			assert.Equal(t, "name", request.Name)
+			assert.Len(t, request.Tasks, 1)
+			assert.Equal(t, "image", request.Tasks[0].Template.GetContainer().Image)
+			assert.Nil(t, request.Workflow)
+			assert.Equal(t, int64(1), request.Inputs.Literals["x"].GetScalar().GetPrimitive().GetInteger())
+			assert.Equal(t, "bar", request.Spec.Labels.Values["foo"])
+			return &interfaces.InlineExecutionCreateResponse{
+				ID: &core.WorkflowExecutionIdentifier{
+					Project: request.Project,
+					Domain:  request.Domain,
+					Name:    request.Name,
+				},
+				Version: "inline-version",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		inlineExecutionManager: &mockInlineExecutionManager,
+	})
+	handler := mockServer.GetCreateInlineExecutionHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(inlineExecutionRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.InlineExecutionCreateResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "name", response.ID.Name)
+	assert.Equal(t, "inline-version", response.Version)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"tasks": [{"unknown": 1}]}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateInlineExecutionHandlerError(t *testing.T) {
+	mockInlineExecutionManager := mocks.MockInlineExecutionManager{}
+	mockInlineExecutionManager.SetCreateInlineExecutionCallback(
+		func(ctx context.Context, request interfaces.InlineExecutionCreateRequest, requestedAt time.Time) (
+			*interfaces.InlineExecutionCreateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "workflow has no name")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		inlineExecutionManager: &mockInlineExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetCreateInlineExecutionHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(inlineExecutionRequestBody)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
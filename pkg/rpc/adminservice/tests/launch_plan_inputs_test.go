@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+func TestGetLaunchPlanInputsHandler(t *testing.T) {
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetGetLaunchPlanInputsCallback(
+		func(ctx context.Context, request admin.ObjectGetRequest) (*interfaces.LaunchPlanInputs, error) {
+			assert.Equal(t, core.ResourceType_LAUNCH_PLAN, request.Id.ResourceType)
+			assert.Equal(t, "project", request.Id.Project)
+			assert.Equal(t, "domain", request.Id.Domain)
+			assert.Equal(t, "name", request.Id.Name)
+			assert.Equal(t, "version", request.Id.Version)
+			return &interfaces.LaunchPlanInputs{
+				ID: request.Id,
+				Inputs: []interfaces.LaunchPlanInput{
+					{
+						Name: "bar",
+						Type: &core.LiteralType{
+							Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER},
+						},
+						Required:    true,
+						Overridable: true,
+					},
+					{
+						Name: "foo",
+						Type: &core.LiteralType{
+							Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING},
+						},
+						Default: &core.Literal{
+							Value: &core.Literal_Scalar{
+								Scalar: &core.Scalar{
+									Value: &core.Scalar_Primitive{
+										Primitive: &core.Primitive{
+											Value: &core.Primitive_StringValue{StringValue: "fixed"},
+										},
+									},
+								},
+							},
+						},
+						DefaultSource: interfaces.LaunchPlanInputDefaultSourceFixed,
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+	handler := mockServer.GetLaunchPlanInputsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet,
+		"/?project=project&domain=domain&name=name&version=version", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "version", response["id"].(map[string]interface{})["version"])
+	inputs := response["inputs"].([]interface{})
+	assert.Len(t, inputs, 2)
+	assert.Equal(t, map[string]interface{}{
+		"name":        "bar",
+		"type":        map[string]interface{}{"simple": "INTEGER"},
+		"required":    true,
+		"overridable": true,
+	}, inputs[0])
+	fixedInput := inputs[1].(map[string]interface{})
+	assert.Equal(t, "FIXED", fixedInput["defaultSource"])
+	assert.Equal(t, false, fixedInput["overridable"])
+	assert.Equal(t, map[string]interface{}{
+		"scalar": map[string]interface{}{
+			"primitive": map[string]interface{}{"stringValue": "fixed"},
+		},
+	}, fixedInput["default"])
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetLaunchPlanInputsHandlerError(t *testing.T) {
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetGetLaunchPlanInputsCallback(
+		func(ctx context.Context, request admin.ObjectGetRequest) (*interfaces.LaunchPlanInputs, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "missing launch plan")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetLaunchPlanInputsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/?project=project&domain=domain&name=name", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing launch plan")
+}
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const launchPlanScheduleStateRequestBody = `{
+	"id": {"project": "project", "domain": "domain", "name": "name"},
+	"state": "PAUSED"
+}`
+
+func TestUpdateLaunchPlanScheduleStateHandler(t *testing.T) {
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetUpdateLaunchPlanScheduleStateCallback(
+		func(ctx context.Context, request interfaces.LaunchPlanScheduleStateUpdateRequest) (
+			*interfaces.LaunchPlanScheduleStateUpdateResponse, error) {
+			assert.Equal(t, "name", request.ID.Name)
+			assert.Equal(t, interfaces.LaunchPlanScheduleStatePaused, request.State)
+			return &interfaces.LaunchPlanScheduleStateUpdateResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+	handler := mockServer.GetUpdateLaunchPlanScheduleStateHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(launchPlanScheduleStateRequestBody)))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"state": "INACTIVE"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateLaunchPlanScheduleStateHandlerError(t *testing.T) {
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetUpdateLaunchPlanScheduleStateCallback(
+		func(ctx context.Context, request interfaces.LaunchPlanScheduleStateUpdateRequest) (
+			*interfaces.LaunchPlanScheduleStateUpdateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.FailedPrecondition, "no schedule")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetUpdateLaunchPlanScheduleStateHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(launchPlanScheduleStateRequestBody)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no schedule")
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+func TestListLaunchPlanStateHistoryHandler(t *testing.T) {
+	occurredAt := time.Date(2019, 12, 9, 10, 0, 0, 0, time.UTC)
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetListLaunchPlanStateHistoryCallback(
+		func(ctx context.Context, request interfaces.LaunchPlanStateHistoryListRequest) (
+			*interfaces.LaunchPlanStateHistory, error) {
+			assert.Equal(t, "project", request.ID.Project)
+			assert.Equal(t, "domain", request.ID.Domain)
+			assert.Equal(t, "name", request.ID.Name)
+			assert.Equal(t, uint32(10), request.Limit)
+			assert.Equal(t, "20", request.Token)
+			return &interfaces.LaunchPlanStateHistory{
+				Changes: []interfaces.LaunchPlanStateChange{
+					{
+						Version:    "version",
+						State:      "INACTIVE",
+						Principal:  "user@example.com",
+						OccurredAt: occurredAt,
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+	handler := mockServer.GetListLaunchPlanStateHistoryHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet,
+		"/?project=project&domain=domain&name=name&limit=10&token=20", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var history interfaces.LaunchPlanStateHistory
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &history))
+	assert.Len(t, history.Changes, 1)
+	assert.Equal(t, "user@example.com", history.Changes[0].Principal)
+	assert.True(t, occurredAt.Equal(history.Changes[0].OccurredAt))
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/?limit=ten", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestListLaunchPlanStateHistoryHandlerError(t *testing.T) {
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetListLaunchPlanStateHistoryCallback(
+		func(ctx context.Context, request interfaces.LaunchPlanStateHistoryListRequest) (
+			*interfaces.LaunchPlanStateHistory, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing name")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetListLaunchPlanStateHistoryHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/?project=project&domain=domain", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing name")
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+func TestCreateLaunchPlanVariantsHandler(t *testing.T) {
+	mockLaunchPlanManager := mocks.MockLaunchPlanManager{}
+	mockLaunchPlanManager.SetCreateLaunchPlanVariantsCallback(
+		func(ctx context.Context, request interfaces.LaunchPlanVariantsCreateRequest) (
+			*interfaces.LaunchPlanVariantsCreateResponse, error) {
+			assert.Equal(t, core.ResourceType_LAUNCH_PLAN, request.Base.ResourceType)
+			assert.Equal(t, "name", request.Base.Name)
+			assert.Equal(t, 15, request.StaggerMinutes)
+			assert.Len(t, request.Variants, 2)
+			assert.Equal(t, "us", request.Variants[0].Key)
+			assert.Equal(t, "us-east-1",
+				request.Variants[0].FixedInputs.Literals["region"].GetScalar().GetPrimitive().GetStringValue())
+			assert.Nil(t, request.Variants[0].Schedule)
+			assert.Equal(t, "eu", request.Variants[1].Key)
+			assert.Nil(t, request.Variants[1].FixedInputs)
+			assert.Equal(t, "0 3 * * ? *", request.Variants[1].Schedule.GetCronExpression())
+			return &interfaces.LaunchPlanVariantsCreateResponse{
+				LaunchPlans: []interfaces.LaunchPlanVariantResult{
+					{ID: &core.Identifier{Name: "name-us"}},
+					{ID: &core.Identifier{Name: "name-eu"}, Error: "failed"},
+				},
+				Succeeded: 1,
+				Failed:    1,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		launchPlanManager: &mockLaunchPlanManager,
+	})
+	handler := mockServer.GetCreateLaunchPlanVariantsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{
+		"base": {"project": "project", "domain": "domain", "name": "name", "version": "version"},
+		"variants": [
+			{"key": "us", "fixedInputs": {"literals": {"region": {"scalar": {"primitive": {"stringValue": "us-east-1"}}}}}},
+			{"key": "eu", "schedule": {"cronExpression": "0 3 * * ? *"}}
+		],
+		"staggerMinutes": 15
+	}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var response interfaces.LaunchPlanVariantsCreateResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Succeeded)
+	assert.Equal(t, 1, response.Failed)
+	assert.Equal(t, "name-eu", response.LaunchPlans[1].ID.Name)
+	assert.Equal(t, "failed", response.LaunchPlans[1].Error)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(
+		`{"variants": [{"key": "us", "schedule": {"cronExpression": 42}}]}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
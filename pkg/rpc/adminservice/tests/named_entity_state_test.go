@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const namedEntityStateRequestBody = `{
+	"resourceType": "WORKFLOW",
+	"id": {"project": "project", "domain": "domain", "name": "name"},
+	"state": "ARCHIVED",
+	"cascade": true,
+	"dryRun": true
+}`
+
+func TestUpdateNamedEntityStateHandler(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetUpdateNamedEntityStateCallback(
+		func(ctx context.Context, request interfaces.NamedEntityStateUpdateRequest) (
+			*interfaces.NamedEntityStateUpdateResponse, error) {
+			assert.Equal(t, core.ResourceType_WORKFLOW, request.ResourceType)
+			assert.Equal(t, "name", request.ID.Name)
+			assert.Equal(t, interfaces.NamedEntityStateArchived, request.State)
+			assert.True(t, request.Cascade)
+			assert.True(t, request.DryRun)
+			return &interfaces.NamedEntityStateUpdateResponse{
+				ArchivedLaunchPlans: []*admin.NamedEntityIdentifier{
+					{Project: "project", Domain: "domain", Name: "lp"},
+				},
+				DeactivatedLaunchPlans: []*core.Identifier{},
+				RemovedSchedules:       []*admin.NamedEntityIdentifier{},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+	handler := mockServer.GetUpdateNamedEntityStateHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(namedEntityStateRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var response interfaces.NamedEntityStateUpdateResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.ArchivedLaunchPlans, 1)
+	assert.Equal(t, "lp", response.ArchivedLaunchPlans[0].Name)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/",
+		strings.NewReader(`{"resourceType": "DATASET", "state": "ARCHIVED"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/",
+		strings.NewReader(`{"resourceType": "WORKFLOW", "state": "DELETED"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateNamedEntityStateHandlerError(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetUpdateNamedEntityStateCallback(
+		func(ctx context.Context, request interfaces.NamedEntityStateUpdateRequest) (
+			*interfaces.NamedEntityStateUpdateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "workflow not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetUpdateNamedEntityStateHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(namedEntityStateRequestBody)))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "workflow not found")
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionArtifactsURL = "/api/v1/node_execution_artifacts?project=project&domain=domain&name=name" +
+	"&node_id=node"
+
+func TestCreateNodeExecutionArtifactsHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetCreateNodeExecutionArtifactsFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionArtifactsCreateRequest) (
+			*interfaces.NodeExecutionArtifactsCreateResponse, error) {
+			assert.Equal(t, "node", request.NodeExecutionID.NodeId)
+			assert.Equal(t, "name", request.NodeExecutionID.ExecutionId.Name)
+			assert.Equal(t, []interfaces.NodeExecutionArtifact{
+				{
+					Name: "report",
+					Kind: interfaces.ArtifactKindHTML,
+					URI:  "s3://bucket/report.html",
+				},
+			}, request.Artifacts)
+			return &interfaces.NodeExecutionArtifactsCreateResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetNodeExecutionArtifactsHandler(context.Background())
+
+	body := `[{"name": "report", "kind": "HTML", "uri": "s3://bucket/report.html"}]`
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionArtifactsURL, strings.NewReader(body)))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionArtifactsURL, strings.NewReader("not json")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodDelete, nodeExecutionArtifactsURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetNodeExecutionArtifactsHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionArtifactsFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionArtifactsGetRequest) (
+			*interfaces.NodeExecutionArtifactsGetResponse, error) {
+			assert.Equal(t, "node", request.NodeExecutionID.NodeId)
+			return &interfaces.NodeExecutionArtifactsGetResponse{
+				Artifacts: []interfaces.SignedNodeExecutionArtifact{
+					{
+						NodeExecutionArtifact: interfaces.NodeExecutionArtifact{
+							Name: "profile",
+							Kind: interfaces.ArtifactKindProfile,
+							URI:  "s3://bucket/profile.pprof",
+						},
+						SignedURL: &admin.UrlBlob{
+							Url:   "https://signed/profile.pprof",
+							Bytes: 1024,
+						},
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionArtifactsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionArtifactsURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NodeExecutionArtifactsGetResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Artifacts, 1)
+	assert.Equal(t, "profile", response.Artifacts[0].Name)
+	assert.Equal(t, "https://signed/profile.pprof", response.Artifacts[0].SignedURL.Url)
+}
+
+func TestCreateNodeExecutionArtifactsHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetCreateNodeExecutionArtifactsFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionArtifactsCreateRequest) (
+			*interfaces.NodeExecutionArtifactsCreateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid value for kind")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	body := `[{"name": "report", "kind": "PDF", "uri": "s3://bucket/report.pdf"}]`
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionArtifactsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, nodeExecutionArtifactsURL, strings.NewReader(body)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "invalid value for kind")
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionAttemptDataURL = "/api/v1/node_execution_attempt_data?project=project&domain=domain&name=name" +
+	"&node_id=node&retry_attempt=1"
+
+func TestGetNodeExecutionAttemptDataHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionAttemptDataFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionAttemptDataGetRequest) (
+			*interfaces.NodeExecutionAttemptDataResponse, error) {
+			assert.Equal(t, "node", request.NodeExecutionID.NodeId)
+			assert.Equal(t, "name", request.NodeExecutionID.ExecutionId.Name)
+			assert.Equal(t, uint32(1), request.RetryAttempt)
+			return &interfaces.NodeExecutionAttemptDataResponse{
+				Inputs: &admin.UrlBlob{
+					Url:   "inputs",
+					Bytes: 100,
+				},
+				Error: &core.ExecutionError{
+					Code:     "OOMKilled",
+					ErrorUri: "error.pb",
+				},
+				ErrorDocument: &admin.UrlBlob{
+					Url:   "error",
+					Bytes: 10,
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetNodeExecutionAttemptDataHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, nodeExecutionAttemptDataURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	var inputs admin.UrlBlob
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["inputs"]), &inputs))
+	assert.Equal(t, "inputs", inputs.Url)
+	var executionError core.ExecutionError
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["error"]), &executionError))
+	assert.Equal(t, "OOMKilled", executionError.Code)
+	var errorDocument admin.UrlBlob
+	assert.NoError(t, jsonpb.UnmarshalString(string(response["errorDocument"]), &errorDocument))
+	assert.Equal(t, "error", errorDocument.Url)
+	assert.NotContains(t, response, "outputs")
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionAttemptDataURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/node_execution_attempt_data?project=project&domain=domain&name=name&node_id=node", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGetNodeExecutionAttemptDataHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionAttemptDataFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionAttemptDataGetRequest) (
+			*interfaces.NodeExecutionAttemptDataResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "node execution has no retry attempt 1")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionAttemptDataHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionAttemptDataURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no retry attempt 1")
+}
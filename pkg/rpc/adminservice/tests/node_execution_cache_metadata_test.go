@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionCacheMetadataURL = "/api/v1/node_execution_cache_metadata?project=project&domain=domain" +
+	"&name=name&node_id=cached-node"
+
+func TestCreateNodeExecutionCacheMetadataHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetCreateNodeExecutionCacheMetadataFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionCacheMetadataCreateRequest) (
+			*interfaces.NodeExecutionCacheMetadataCreateResponse, error) {
+			assert.Equal(t, "cached-node", request.NodeExecutionID.NodeId)
+			assert.Equal(t, interfaces.CacheHit, request.Metadata.CacheStatus)
+			assert.Equal(t, "key", request.Metadata.ArtifactKey)
+			assert.Equal(t, "source-node", request.Metadata.SourceExecution.NodeId)
+			assert.Equal(t, "source", request.Metadata.SourceExecution.ExecutionId.Name)
+			return &interfaces.NodeExecutionCacheMetadataCreateResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetNodeExecutionCacheMetadataHandler(context.Background())
+
+	body := `{"cacheStatus": "CACHE_HIT", "artifactKey": "key", "sourceExecution": {"node_id": "source-node",
+		"execution_id": {"project": "project", "domain": "domain", "name": "source"}}}`
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionCacheMetadataURL, strings.NewReader(body)))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodPost, nodeExecutionCacheMetadataURL, strings.NewReader("not json")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodDelete, nodeExecutionCacheMetadataURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetNodeExecutionCacheMetadataHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionCacheMetadataFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionCacheMetadataGetRequest) (
+			*interfaces.NodeExecutionCacheMetadata, error) {
+			assert.Equal(t, "cached-node", request.NodeExecutionID.NodeId)
+			return &interfaces.NodeExecutionCacheMetadata{
+				CacheStatus: interfaces.CachePopulated,
+				ArtifactKey: "key",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionCacheMetadataHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionCacheMetadataURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NodeExecutionCacheMetadata
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, interfaces.CachePopulated, response.CacheStatus)
+	assert.Equal(t, "key", response.ArtifactKey)
+}
+
+func TestGetNodeExecutionCacheMetadataHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionCacheMetadataFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionCacheMetadataGetRequest) (
+			*interfaces.NodeExecutionCacheMetadata, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "node execution has no cache metadata")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionCacheMetadataHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionCacheMetadataURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
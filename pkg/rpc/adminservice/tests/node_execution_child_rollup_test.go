@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionChildRollupURL = "/api/v1/node_execution_child_rollup?project=project&domain=domain&name=name" +
+	"&node_id=dynamic-node"
+
+func TestGetNodeExecutionChildRollupHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionChildRollupFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionChildRollupGetRequest) (
+			*interfaces.NodeExecutionChildRollup, error) {
+			assert.Equal(t, "dynamic-node", request.ParentNodeExecutionID.NodeId)
+			assert.Equal(t, "name", request.ParentNodeExecutionID.ExecutionId.Name)
+			return &interfaces.NodeExecutionChildRollup{
+				PhaseCounts: map[string]int64{
+					"SUCCEEDED": 10,
+					"RUNNING":   3,
+				},
+				Total: 13,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetNodeExecutionChildRollupHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, nodeExecutionChildRollupURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NodeExecutionChildRollup
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, int64(10), response.PhaseCounts["SUCCEEDED"])
+	assert.Equal(t, int64(13), response.Total)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionChildRollupURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetNodeExecutionChildRollupHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionChildRollupFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionChildRollupGetRequest) (
+			*interfaces.NodeExecutionChildRollup, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "missing node execution")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionChildRollupHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionChildRollupURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
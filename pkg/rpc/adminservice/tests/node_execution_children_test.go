@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionChildrenURL = "/api/v1/node_execution_children?project=project&domain=domain&name=name" +
+	"&node_id=dynamic-node&limit=20&token=40&sort_by.key=created_at&sort_by.direction=ASCENDING"
+
+func TestListNodeExecutionChildrenHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetListNodeExecutionChildrenFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionChildrenListRequest) (
+			*admin.NodeExecutionList, error) {
+			assert.Equal(t, "dynamic-node", request.ParentNodeExecutionID.NodeId)
+			assert.Equal(t, "name", request.ParentNodeExecutionID.ExecutionId.Name)
+			assert.Equal(t, uint32(20), request.Limit)
+			assert.Equal(t, "40", request.Token)
+			assert.Equal(t, "created_at", request.SortBy.Key)
+			assert.Equal(t, admin.Sort_ASCENDING, request.SortBy.Direction)
+			return &admin.NodeExecutionList{
+				NodeExecutions: []*admin.NodeExecution{
+					{
+						Id: &core.NodeExecutionIdentifier{
+							NodeId:      "child-node",
+							ExecutionId: request.ParentNodeExecutionID.ExecutionId,
+						},
+					},
+				},
+				Token: "60",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetListNodeExecutionChildrenHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, nodeExecutionChildrenURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response admin.NodeExecutionList
+	assert.NoError(t, jsonpb.Unmarshal(recorder.Body, &response))
+	assert.Len(t, response.NodeExecutions, 1)
+	assert.Equal(t, "child-node", response.NodeExecutions[0].Id.NodeId)
+	assert.Equal(t, "60", response.Token)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionChildrenURL, strings.NewReader("{}")))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodGet, "/api/v1/node_execution_children?node_id=dynamic-node&limit=many", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodGet, "/api/v1/node_execution_children?node_id=dynamic-node&sort_by.key=name&sort_by.direction=UP", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestListNodeExecutionChildrenHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetListNodeExecutionChildrenFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionChildrenListRequest) (
+			*admin.NodeExecutionList, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "node execution not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetListNodeExecutionChildrenHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionChildrenURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "node execution not found")
+}
@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionMetricsURL = "/api/v1/node_execution_metrics?project=project&domain=domain&name=name"
+
+func TestGetNodeExecutionMetricsHandler(t *testing.T) {
+	queuedToRunning := 5 * time.Second
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionMetricsFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionMetricsGetRequest) (
+			*interfaces.NodeExecutionMetricsGetResponse, error) {
+			assert.Equal(t, "project", request.WorkflowExecutionID.Project)
+			assert.Equal(t, "domain", request.WorkflowExecutionID.Domain)
+			assert.Equal(t, "name", request.WorkflowExecutionID.Name)
+			return &interfaces.NodeExecutionMetricsGetResponse{
+				NodeExecutions: []interfaces.NodeExecutionMetrics{
+					{
+						NodeID:          "node",
+						Phase:           "RUNNING",
+						QueuedToRunning: &queuedToRunning,
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetNodeExecutionMetricsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, nodeExecutionMetricsURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NodeExecutionMetricsGetResponse
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Len(t, response.NodeExecutions, 1)
+	assert.Equal(t, "node", response.NodeExecutions[0].NodeID)
+	assert.Equal(t, queuedToRunning, *response.NodeExecutions[0].QueuedToRunning)
+	assert.Nil(t, response.NodeExecutions[0].Total)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionMetricsURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetNodeExecutionMetricsHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetGetNodeExecutionMetricsFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionMetricsGetRequest) (
+			*interfaces.NodeExecutionMetricsGetResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing project")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNodeExecutionMetricsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/api/v1/node_execution_metrics", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing project")
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const nodeExecutionsByTaskURL = "/api/v1/node_executions_by_task?project=project&domain=domain&name=task" +
+	"&limit=20&token=40&filters=gte(created_at,2020-01-01T00:00:00Z)&sort_by.key=created_at" +
+	"&sort_by.direction=DESCENDING"
+
+func TestListNodeExecutionsByTaskHandler(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetListNodeExecutionsByTaskFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (
+			*admin.NodeExecutionList, error) {
+			assert.Equal(t, "project", request.TaskID.Project)
+			assert.Equal(t, "domain", request.TaskID.Domain)
+			assert.Equal(t, "task", request.TaskID.Name)
+			assert.Empty(t, request.TaskID.Version)
+			assert.Equal(t, uint32(20), request.Limit)
+			assert.Equal(t, "40", request.Token)
+			assert.Equal(t, "gte(created_at,2020-01-01T00:00:00Z)", request.Filters)
+			assert.Equal(t, "created_at", request.SortBy.Key)
+			assert.Equal(t, admin.Sort_DESCENDING, request.SortBy.Direction)
+			return &admin.NodeExecutionList{
+				NodeExecutions: []*admin.NodeExecution{
+					{
+						Id: &core.NodeExecutionIdentifier{
+							NodeId: "node",
+							ExecutionId: &core.WorkflowExecutionIdentifier{
+								Project: "project",
+								Domain:  "domain",
+								Name:    "execution",
+							},
+						},
+					},
+				},
+				Token: "60",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+	handler := mockServer.GetListNodeExecutionsByTaskHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, nodeExecutionsByTaskURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response admin.NodeExecutionList
+	assert.NoError(t, jsonpb.Unmarshal(recorder.Body, &response))
+	assert.Len(t, response.NodeExecutions, 1)
+	assert.Equal(t, "execution", response.NodeExecutions[0].Id.ExecutionId.Name)
+	assert.Equal(t, "60", response.Token)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, nodeExecutionsByTaskURL, strings.NewReader("{}")))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodGet, "/api/v1/node_executions_by_task?name=task&limit=many", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(
+		http.MethodGet, "/api/v1/node_executions_by_task?name=task&sort_by.key=name&sort_by.direction=UP", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestListNodeExecutionsByTaskHandlerError(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetListNodeExecutionsByTaskFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (
+			*admin.NodeExecutionList, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing project")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetListNodeExecutionsByTaskHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionsByTaskURL, nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing project")
+}
+
+func TestListNodeExecutionsByTaskHandlerUnauthorizedProject(t *testing.T) {
+	mockNodeExecutionManager := mocks.MockNodeExecutionManager{}
+	mockNodeExecutionManager.SetListNodeExecutionsByTaskFunc(
+		func(ctx context.Context, request interfaces.NodeExecutionsByTaskListRequest) (
+			*admin.NodeExecutionList, error) {
+			assert.Fail(t, "node executions of a project the caller isn't entitled to shouldn't be listed")
+			return nil, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		nodeExecutionManager: &mockNodeExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	ctx := auth.WithAuthorizedProjects(context.Background(), []string{"other"})
+	mockServer.GetListNodeExecutionsByTaskHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, nodeExecutionsByTaskURL, nil).WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNotificationPreferencesHandler(t *testing.T) {
+	mockNotificationPreferenceManager := mocks.MockNotificationPreferenceManager{}
+	var updated bool
+	mockNotificationPreferenceManager.SetUpdateNotificationPreferenceFunc(
+		func(ctx context.Context, request interfaces.NotificationPreferenceUpdateRequest) (
+			*interfaces.NotificationPreferenceUpdateResponse, error) {
+			assert.Equal(t, "name", request.Preference.LaunchPlan.Name)
+			assert.False(t, request.Preference.Subscribed)
+			assert.Equal(t, interfaces.SlackNotificationChannel, request.Preference.Channel)
+			updated = true
+			return &interfaces.NotificationPreferenceUpdateResponse{}, nil
+		})
+	mockNotificationPreferenceManager.SetListNotificationPreferencesFunc(
+		func(ctx context.Context, request interfaces.NotificationPreferenceListRequest) (
+			*interfaces.NotificationPreferenceListResponse, error) {
+			return &interfaces.NotificationPreferenceListResponse{
+				Preferences: []interfaces.NotificationPreference{
+					{
+						LaunchPlan: &admin.NamedEntityIdentifier{
+							Project: "project",
+							Domain:  "domain",
+							Name:    "name",
+						},
+						Subscribed: true,
+						Channel:    interfaces.EmailNotificationChannel,
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		notificationPreferenceManager: &mockNotificationPreferenceManager,
+	})
+	handler := mockServer.GetNotificationPreferencesHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_preferences", strings.NewReader(
+		`{"preference": {"launchPlan": {"project": "project", "domain": "domain", "name": "name"}, `+
+			`"subscribed": false, "channel": "slack"}}`)))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.True(t, updated)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/notification_preferences", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.NotificationPreferenceListResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Preferences, 1)
+	assert.True(t, response.Preferences[0].Subscribed)
+	assert.Equal(t, "project", response.Preferences[0].LaunchPlan.Project)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/notification_preferences",
+		strings.NewReader("not json")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodDelete, "/api/v1/notification_preferences", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestNotificationPreferencesHandler_Unauthenticated(t *testing.T) {
+	mockNotificationPreferenceManager := mocks.MockNotificationPreferenceManager{}
+	mockNotificationPreferenceManager.SetListNotificationPreferencesFunc(
+		func(ctx context.Context, request interfaces.NotificationPreferenceListRequest) (
+			*interfaces.NotificationPreferenceListResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.Unauthenticated, "not authenticated")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		notificationPreferenceManager: &mockNotificationPreferenceManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetNotificationPreferencesHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/api/v1/notification_preferences", nil))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const projectUpdateURL = "/api/v1/project_update"
+const projectDetailsURL = "/api/v1/project_details"
+
+func TestGetUpdateProjectHandler(t *testing.T) {
+	mockProjectManager := mocks.MockProjectManager{}
+	var updateRequest interfaces.ProjectUpdateRequest
+	mockProjectManager.SetUpdateProjectFunc(
+		func(ctx context.Context, request interfaces.ProjectUpdateRequest) (*interfaces.ProjectUpdateResponse, error) {
+			updateRequest = request
+			return &interfaces.ProjectUpdateResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+	handler := mockServer.GetUpdateProjectHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, projectUpdateURL, strings.NewReader(
+		`{"id": "project", "labels": {"team": "flyte"}, "state": "ARCHIVED"}`)))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	archived := interfaces.ProjectStateArchived
+	assert.Equal(t, interfaces.ProjectUpdateRequest{
+		ID:     "project",
+		Labels: map[string]string{"team": "flyte"},
+		State:  &archived,
+	}, updateRequest)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, projectUpdateURL, strings.NewReader(
+		`{"id": "project", "state": "DELETED"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, projectUpdateURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetUpdateProjectHandlerError(t *testing.T) {
+	mockProjectManager := mocks.MockProjectManager{}
+	mockProjectManager.SetUpdateProjectFunc(
+		func(ctx context.Context, request interfaces.ProjectUpdateRequest) (*interfaces.ProjectUpdateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "project [project] not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetUpdateProjectHandler(context.Background())(recorder, httptest.NewRequest(
+		http.MethodPost, projectUpdateURL, strings.NewReader(`{"id": "project", "description": "description"}`)))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "project [project] not found")
+}
+
+func TestGetListProjectDetailsHandler(t *testing.T) {
+	mockProjectManager := mocks.MockProjectManager{}
+	mockProjectManager.SetListProjectDetailsFunc(
+		func(ctx context.Context, request interfaces.ProjectDetailsListRequest) (
+			*interfaces.ProjectDetailsList, error) {
+			assert.True(t, request.IncludeArchived)
+			assert.Equal(t, "contains(name,proj)", request.Filters)
+			assert.Equal(t, map[string]string{"team": "flyte", "env": "prod"}, request.Labels)
+			assert.Equal(t, uint32(10), request.Limit)
+			assert.Equal(t, "20", request.Token)
+			return &interfaces.ProjectDetailsList{
+				Projects: []interfaces.ProjectDetails{
+					{
+						ID:     "project",
+						Name:   "project",
+						Labels: map[string]string{"team": "flyte"},
+						State:  interfaces.ProjectStateArchived,
+					},
+				},
+				Token: "30",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+	handler := mockServer.GetListProjectDetailsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, projectDetailsURL+"?include_archived=true"+
+		"&filters=contains(name,proj)&label=team:flyte&label=env:prod&limit=10&token=20", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response struct {
+		Projects []map[string]interface{} `json:"projects"`
+		Token    string                   `json:"token"`
+	}
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Len(t, response.Projects, 1)
+	assert.Equal(t, "project", response.Projects[0]["id"])
+	assert.Equal(t, "ARCHIVED", response.Projects[0]["state"])
+	assert.Equal(t, map[string]interface{}{"team": "flyte"}, response.Projects[0]["labels"])
+	assert.Equal(t, "30", response.Token)
+
+	for _, query := range []string{"?include_archived=maybe", "?label=team", "?limit=many"} {
+		recorder = httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodGet, projectDetailsURL+query, nil))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, projectDetailsURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
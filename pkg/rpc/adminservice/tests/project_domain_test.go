@@ -2,11 +2,18 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
 	"github.com/lyft/flyteadmin/pkg/manager/mocks"
 	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 func TestUpdateProjectDomain(t *testing.T) {
@@ -30,3 +37,79 @@ func TestUpdateProjectDomain(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, updateCalled)
 }
+
+func TestSyncProjectDomainAttributesHandler(t *testing.T) {
+	mockProjectDomainManager := mocks.MockProjectDomainManager{}
+	mockProjectDomainManager.SetSyncProjectDomainAttributesFunc(
+		func(ctx context.Context, request interfaces.ProjectDomainAttributesSyncRequest) (
+			*interfaces.ProjectDomainAttributesSyncResponse, error) {
+			assert.Equal(t, int64(10), request.SinceVersion)
+			return &interfaces.ProjectDomainAttributesSyncResponse{
+				Version: 20,
+				Attributes: []interfaces.VersionedProjectDomainAttributes{
+					{
+						Version: 20,
+						Attributes: &admin.ProjectDomainAttributes{
+							Project:    "project",
+							Domain:     "domain",
+							Attributes: map[string]string{"cpu": "100"},
+						},
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectDomainManager: &mockProjectDomainManager,
+	})
+	handler := mockServer.GetSyncProjectDomainAttributesHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/project_domain_attributes/sync?since_version=10", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ProjectDomainAttributesSyncResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, int64(20), response.Version)
+	assert.Len(t, response.Attributes, 1)
+	assert.Equal(t, "100", response.Attributes[0].Attributes.Attributes["cpu"])
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/project_domain_attributes/sync?since_version=x", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/project_domain_attributes/sync", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestAckProjectDomainAttributesHandler(t *testing.T) {
+	mockProjectDomainManager := mocks.MockProjectDomainManager{}
+	mockProjectDomainManager.SetAckProjectDomainAttributesFunc(
+		func(ctx context.Context, request interfaces.ProjectDomainAttributesAckRequest) (
+			*interfaces.ProjectDomainAttributesAckResponse, error) {
+			if request.Cluster == "" {
+				return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing cluster")
+			}
+			assert.Equal(t, "cluster", request.Cluster)
+			assert.Equal(t, int64(20), request.Version)
+			return &interfaces.ProjectDomainAttributesAckResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectDomainManager: &mockProjectDomainManager,
+	})
+	handler := mockServer.GetAckProjectDomainAttributesHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/project_domain_attributes/ack",
+		strings.NewReader(`{"cluster": "cluster", "version": 20}`)))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/project_domain_attributes/ack",
+		strings.NewReader(`{"version": 20}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing cluster")
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/project_domain_attributes/ack", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
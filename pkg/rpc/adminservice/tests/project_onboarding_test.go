@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const projectOnboardingURL = "/api/v1/project_onboarding?project=project"
+
+func TestGetProjectOnboardingStatusHandler(t *testing.T) {
+	startedAt := time.Date(2019, time.November, 26, 0, 0, 0, 0, time.UTC)
+	mockProjectManager := mocks.MockProjectManager{}
+	mockProjectManager.SetGetProjectOnboardingStatusFunc(
+		func(ctx context.Context, request interfaces.ProjectOnboardingStatusGetRequest) (
+			*interfaces.ProjectOnboardingStatus, error) {
+			assert.Equal(t, "project", request.Project)
+			return &interfaces.ProjectOnboardingStatus{
+				Project: "project",
+				Steps: []interfaces.ProjectOnboardingStep{
+					{
+						Name:  interfaces.OnboardingStepNamespaceResources,
+						State: interfaces.OnboardingStepSucceeded,
+					},
+				},
+				StartedAt: startedAt,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+	handler := mockServer.GetProjectOnboardingStatusHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, projectOnboardingURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ProjectOnboardingStatus
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Equal(t, "project", response.Project)
+	assert.Len(t, response.Steps, 1)
+	assert.Equal(t, interfaces.OnboardingStepSucceeded, response.Steps[0].State)
+	assert.True(t, startedAt.Equal(response.StartedAt))
+	assert.Nil(t, response.CompletedAt)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, projectOnboardingURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetProjectOnboardingStatusHandlerError(t *testing.T) {
+	mockProjectManager := mocks.MockProjectManager{}
+	mockProjectManager.SetGetProjectOnboardingStatusFunc(
+		func(ctx context.Context, request interfaces.ProjectOnboardingStatusGetRequest) (
+			*interfaces.ProjectOnboardingStatus, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "no onboarding status for project")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetProjectOnboardingStatusHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, projectOnboardingURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no onboarding status for project")
+}
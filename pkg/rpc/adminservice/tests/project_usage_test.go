@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const projectUsageURL = "/api/v1/project_usage"
+
+func TestGetProjectUsageReportHandler(t *testing.T) {
+	startTime := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(24 * time.Hour)
+	mockProjectManager := mocks.MockProjectManager{}
+	mockProjectManager.SetGetProjectUsageReportFunc(
+		func(ctx context.Context, request interfaces.ProjectUsageReportRequest) (
+			*interfaces.ProjectUsageReport, error) {
+			if request.Project == "unknown" {
+				return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "invalid")
+			}
+			assert.Equal(t, interfaces.ProjectUsageReportRequest{
+				Project:   "project",
+				Domain:    "development",
+				StartTime: startTime,
+				EndTime:   endTime,
+			}, request)
+			return &interfaces.ProjectUsageReport{
+				StartTime: request.StartTime,
+				EndTime:   request.EndTime,
+				Usage: []interfaces.ProjectDomainUsage{
+					{Project: "project", Domain: "development", ExecutionCount: 3, NodeExecutionSeconds: 90},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectManager: &mockProjectManager,
+	})
+	handler := mockServer.GetProjectUsageReportHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, projectUsageURL+
+		"?project=project&domain=development&start_time=2020-03-01T00:00:00Z&end_time=2020-03-02T00:00:00Z", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ProjectUsageReport
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.True(t, startTime.Equal(response.StartTime))
+	assert.Equal(t, []interfaces.ProjectDomainUsage{
+		{Project: "project", Domain: "development", ExecutionCount: 3, NodeExecutionSeconds: 90},
+	}, response.Usage)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, projectUsageURL+"?start_time=yesterday", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, projectUsageURL+"?project=unknown", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, projectUsageURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
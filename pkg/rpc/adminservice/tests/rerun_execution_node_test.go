@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const rerunNodeRequestBody = `{
+	"id": {"project": "project", "domain": "domain", "name": "name"},
+	"nodeId": "node",
+	"name": "rerun"
+}`
+
+func TestRerunExecutionNodeHandler(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetRerunNodeCallback(
+		func(ctx context.Context, request interfaces.ExecutionNodeRerunRequest, requestedAt time.Time) (
+			*admin.ExecutionCreateResponse, error) {
+			assert.Equal(t, "name", request.ID.Name)
+			assert.Equal(t, "node", request.NodeID)
+			assert.Equal(t, "rerun", request.Name)
+			return &admin.ExecutionCreateResponse{
+				Id: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "rerun",
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+	handler := mockServer.GetRerunExecutionNodeHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(rerunNodeRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var id core.WorkflowExecutionIdentifier
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &id))
+	assert.Equal(t, "rerun", id.Name)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestRerunExecutionNodeHandlerError(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetRerunNodeCallback(
+		func(ctx context.Context, request interfaces.ExecutionNodeRerunRequest, requestedAt time.Time) (
+			*admin.ExecutionCreateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.FailedPrecondition, "node didn't fail")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetRerunExecutionNodeHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(rerunNodeRequestBody)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "node didn't fail")
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const resourceAttributesURL = "/api/v1/resource_attributes"
+
+func TestGetResourceAttributesHandler(t *testing.T) {
+	mockResourceManager := mocks.MockResourceManager{}
+	var updateRequest interfaces.ResourceAttributesUpdateRequest
+	mockResourceManager.SetUpdateResourceAttributesFunc(
+		func(ctx context.Context, request interfaces.ResourceAttributesUpdateRequest) (
+			*interfaces.ResourceAttributesUpdateResponse, error) {
+			updateRequest = request
+			return &interfaces.ResourceAttributesUpdateResponse{}, nil
+		})
+	mockResourceManager.SetGetResourceAttributesFunc(
+		func(ctx context.Context, request interfaces.ResourceAttributesGetRequest) (
+			*interfaces.ResourceAttributes, error) {
+			assert.Equal(t, interfaces.ResourceAttributesGetRequest{
+				Project:      "project",
+				Domain:       "domain",
+				ResourceType: interfaces.MatchableResourceExecutionQueue,
+			}, request)
+			return &interfaces.ResourceAttributes{
+				Project: "project",
+				Domain:  "domain",
+				Attributes: interfaces.MatchingAttributes{
+					ExecutionQueueAttributes: &interfaces.ExecutionQueueAttributes{Tags: []string{"gpu"}},
+				},
+			}, nil
+		})
+	mockResourceManager.SetListResourceAttributesFunc(
+		func(ctx context.Context, request interfaces.ResourceAttributesListRequest) (
+			*interfaces.ResourceAttributesList, error) {
+			assert.Equal(t, interfaces.MatchableResourceExecutionClusterLabel, request.ResourceType)
+			return &interfaces.ResourceAttributesList{
+				Attributes: []interfaces.ResourceAttributes{{Project: "project"}, {Project: "other"}},
+			}, nil
+		})
+	var deleteRequest interfaces.ResourceAttributesDeleteRequest
+	mockResourceManager.SetDeleteResourceAttributesFunc(
+		func(ctx context.Context, request interfaces.ResourceAttributesDeleteRequest) (
+			*interfaces.ResourceAttributesDeleteResponse, error) {
+			deleteRequest = request
+			return &interfaces.ResourceAttributesDeleteResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		resourceManager: &mockResourceManager,
+	})
+	handler := mockServer.GetResourceAttributesHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, resourceAttributesURL, strings.NewReader(
+		`{"project": "project", "domain": "domain", "attributes": {"execution_queue_attributes": {"tags": ["gpu"]}}}`)))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "domain", updateRequest.Domain)
+	assert.Equal(t, []string{"gpu"}, updateRequest.Attributes.ExecutionQueueAttributes.Tags)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet,
+		resourceAttributesURL+"?project=project&domain=domain&resource_type=EXECUTION_QUEUE", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var attributes interfaces.ResourceAttributes
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&attributes))
+	assert.Equal(t, []string{"gpu"}, attributes.Attributes.ExecutionQueueAttributes.Tags)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet,
+		resourceAttributesURL+"?resource_type=EXECUTION_CLUSTER_LABEL", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var list interfaces.ResourceAttributesList
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&list))
+	assert.Len(t, list.Attributes, 2)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodDelete,
+		resourceAttributesURL+"?project=project&domain=domain&workflow=workflow&resource_type=TASK_RESOURCE", nil))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, interfaces.ResourceAttributesDeleteRequest{
+		Project:      "project",
+		Domain:       "domain",
+		Workflow:     "workflow",
+		ResourceType: interfaces.MatchableResourceTaskResource,
+	}, deleteRequest)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPut, resourceAttributesURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetResourceAttributesHandlerError(t *testing.T) {
+	mockResourceManager := mocks.MockResourceManager{}
+	mockResourceManager.SetGetResourceAttributesFunc(
+		func(ctx context.Context, request interfaces.ResourceAttributesGetRequest) (
+			*interfaces.ResourceAttributes, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "resource attributes not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		resourceManager: &mockResourceManager,
+	})
+	handler := mockServer.GetResourceAttributesHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet,
+		resourceAttributesURL+"?project=project&resource_type=TASK_RESOURCE", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, resourceAttributesURL+"?project=project&resource_type=x", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "unknown resource_type [x]")
+}
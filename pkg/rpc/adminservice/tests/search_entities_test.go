@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+func TestGetSearchEntitiesHandler(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetSearchEntitiesCallback(
+		func(ctx context.Context, request interfaces.EntitySearchRequest) (*interfaces.EntitySearchResults, error) {
+			assert.Equal(t, "project", request.Project)
+			assert.Equal(t, "domain", request.Domain)
+			assert.Equal(t, "daily report", request.Query)
+			assert.Equal(t, []core.ResourceType{core.ResourceType_TASK, core.ResourceType_WORKFLOW},
+				request.ResourceTypes)
+			assert.Equal(t, uint32(10), request.Limit)
+			assert.Equal(t, "20", request.Token)
+			return &interfaces.EntitySearchResults{
+				Entities: []interfaces.EntitySearchResult{
+					{
+						ResourceType: "TASK",
+						ID: &admin.NamedEntityIdentifier{
+							Project: "project",
+							Domain:  "domain",
+							Name:    "daily_report",
+						},
+						Description: "Sends the daily report",
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+	handler := mockServer.GetSearchEntitiesHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/?project=project&domain=domain&query=daily+report"+
+		"&resource_type=TASK&resource_type=WORKFLOW&limit=10&token=20", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var results interfaces.EntitySearchResults
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &results))
+	assert.Len(t, results.Entities, 1)
+	assert.Equal(t, "TASK", results.Entities[0].ResourceType)
+	assert.Equal(t, "daily_report", results.Entities[0].ID.Name)
+	assert.Equal(t, "Sends the daily report", results.Entities[0].Description)
+	assert.Empty(t, results.Token)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/?project=project&query=report&resource_type=NODE", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetSearchEntitiesHandlerError(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetSearchEntitiesCallback(
+		func(ctx context.Context, request interfaces.EntitySearchRequest) (*interfaces.EntitySearchResults, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing query")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetSearchEntitiesHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, "/?project=project", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing query")
+}
+
+func TestGetSearchEntitiesHandlerUnauthorizedProject(t *testing.T) {
+	mockNamedEntityManager := mocks.MockNamedEntityManager{}
+	mockNamedEntityManager.SetSearchEntitiesCallback(
+		func(ctx context.Context, request interfaces.EntitySearchRequest) (*interfaces.EntitySearchResults, error) {
+			assert.Fail(t, "entities of a project the caller isn't entitled to shouldn't be searched")
+			return nil, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		namedEntityManager: &mockNamedEntityManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	ctx := auth.WithAuthorizedProjects(context.Background(), []string{"other"})
+	mockServer.GetSearchEntitiesHandler(context.Background())(recorder, httptest.NewRequest(
+		http.MethodGet, "/?project=project&query=report", nil).WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "caller isn't entitled to resources of project [project]")
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const taskDiffRequestBody = `{
+	"id": {"resourceType": "TASK", "project": "project", "domain": "domain", "name": "name", "version": "version"},
+	"spec": {"template": {"type": "python", "container": {"image": "image:2"}}}
+}`
+
+func TestDiffTaskHandler(t *testing.T) {
+	mockTaskManager := mocks.MockTaskManager{}
+	mockTaskManager.SetDiffTaskCallback(
+		func(ctx context.Context, request admin.TaskCreateRequest) (*interfaces.TaskDiffResponse, error) {
+			assert.Equal(t, "version", request.Id.Version)
+			assert.Equal(t, "image:2", request.Spec.Template.GetContainer().Image)
+			return &interfaces.TaskDiffResponse{
+				ID: request.Id,
+				Diffs: []interfaces.FieldDiff{
+					{
+						Path:     "template.container.image",
+						Existing: "image:1",
+						Incoming: "image:2",
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskManager: &mockTaskManager,
+	})
+	handler := mockServer.GetDiffTaskHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(taskDiffRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.TaskDiffResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.False(t, response.Identical)
+	assert.Equal(t, "template.container.image", response.Diffs[0].Path)
+	assert.Equal(t, "image:1", response.Diffs[0].Existing)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestDiffTaskHandlerError(t *testing.T) {
+	mockTaskManager := mocks.MockTaskManager{}
+	mockTaskManager.SetDiffTaskCallback(
+		func(ctx context.Context, request admin.TaskCreateRequest) (*interfaces.TaskDiffResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "task not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskManager: &mockTaskManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetDiffTaskHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(taskDiffRequestBody)))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const taskExecutionLogsURL = "/api/v1/task_execution_logs?project=project&domain=domain&name=name&node_id=node" +
+	"&task_project=project&task_domain=domain&task_name=task&task_version=v1&retry_attempt=2"
+
+func TestGetTaskExecutionLogsHandler(t *testing.T) {
+	mockTaskExecutionManager := mocks.MockTaskExecutionManager{}
+	mockTaskExecutionManager.SetGetTaskExecutionLogsCallback(
+		func(ctx context.Context, request interfaces.TaskExecutionLogsGetRequest) (
+			*interfaces.TaskExecutionLogsGetResponse, error) {
+			assert.Equal(t, "node", request.ID.NodeExecutionId.NodeId)
+			assert.Equal(t, "name", request.ID.NodeExecutionId.ExecutionId.Name)
+			assert.Equal(t, "task", request.ID.TaskId.Name)
+			assert.Equal(t, "v1", request.ID.TaskId.Version)
+			assert.Equal(t, core.ResourceType_TASK, request.ID.TaskId.ResourceType)
+			assert.Equal(t, uint32(2), request.ID.RetryAttempt)
+			return &interfaces.TaskExecutionLogsGetResponse{
+				Logs: []*core.TaskLog{
+					{
+						Name: "logs",
+						Uri:  "https://logs/node",
+					},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskExecutionManager: &mockTaskExecutionManager,
+	})
+	handler := mockServer.GetTaskExecutionLogsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, taskExecutionLogsURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.TaskExecutionLogsGetResponse
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Len(t, response.Logs, 1)
+	assert.Equal(t, "https://logs/node", response.Logs[0].Uri)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, taskExecutionLogsURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/task_execution_logs?retry_attempt=first", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGetTaskExecutionLogsHandlerError(t *testing.T) {
+	mockTaskExecutionManager := mocks.MockTaskExecutionManager{}
+	mockTaskExecutionManager.SetGetTaskExecutionLogsCallback(
+		func(ctx context.Context, request interfaces.TaskExecutionLogsGetRequest) (
+			*interfaces.TaskExecutionLogsGetResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "task execution not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskExecutionManager: &mockTaskExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetTaskExecutionLogsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, taskExecutionLogsURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "task execution not found")
+}
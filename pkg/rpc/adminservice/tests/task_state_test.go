@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const taskStateRequestBody = `{
+	"id": {"project": "project", "domain": "domain", "name": "name"},
+	"state": "ARCHIVED"
+}`
+
+func TestUpdateTaskStateHandler(t *testing.T) {
+	mockTaskManager := mocks.MockTaskManager{}
+	mockTaskManager.SetUpdateTaskStateCallback(
+		func(ctx context.Context, request interfaces.TaskStateUpdateRequest) (
+			*interfaces.TaskStateUpdateResponse, error) {
+			assert.Equal(t, "name", request.ID.Name)
+			assert.Empty(t, request.ID.Version)
+			assert.Equal(t, interfaces.TaskStateArchived, request.State)
+			return &interfaces.TaskStateUpdateResponse{}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskManager: &mockTaskManager,
+	})
+	handler := mockServer.GetUpdateTaskStateHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(taskStateRequestBody)))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"state": "DELETED"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateTaskStateHandlerError(t *testing.T) {
+	mockTaskManager := mocks.MockTaskManager{}
+	mockTaskManager.SetUpdateTaskStateCallback(
+		func(ctx context.Context, request interfaces.TaskStateUpdateRequest) (
+			*interfaces.TaskStateUpdateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "task not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskManager: &mockTaskManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetUpdateTaskStateHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(taskStateRequestBody)))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "task not found")
+}
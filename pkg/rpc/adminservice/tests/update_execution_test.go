@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const updateExecutionRequestBody = `{
+	"id": {"project": "project", "domain": "domain", "name": "name"},
+	"tags": ["nightly", "team: data"]
+}`
+
+func TestUpdateExecutionHandler(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetUpdateExecutionCallback(
+		func(ctx context.Context, request interfaces.ExecutionUpdateRequest) (
+			*interfaces.ExecutionUpdateResponse, error) {
+			assert.Equal(t, "name", request.ID.Name)
+			assert.Equal(t, []string{"nightly", "team: data"}, request.Tags)
+			return &interfaces.ExecutionUpdateResponse{
+				Tags: request.Tags,
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+	handler := mockServer.GetUpdateExecutionHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(updateExecutionRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.ExecutionUpdateResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, []string{"nightly", "team: data"}, response.Tags)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(updateExecutionRequestBody)))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPut, "/", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateExecutionHandlerError(t *testing.T) {
+	mockExecutionManager := mocks.MockExecutionManager{}
+	mockExecutionManager.SetUpdateExecutionCallback(
+		func(ctx context.Context, request interfaces.ExecutionUpdateRequest) (
+			*interfaces.ExecutionUpdateResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "execution not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetUpdateExecutionHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(updateExecutionRequestBody)))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "execution not found")
+}
@@ -7,27 +7,39 @@ import (
 )
 
 type NewMockAdminServerInput struct {
-	executionManager     *mocks.MockExecutionManager
-	launchPlanManager    *mocks.MockLaunchPlanManager
-	nodeExecutionManager *mocks.MockNodeExecutionManager
-	projectManager       *mocks.MockProjectManager
-	projectDomainManager *mocks.MockProjectDomainManager
-	taskManager          *mocks.MockTaskManager
-	workflowManager      *mocks.MockWorkflowManager
-	taskExecutionManager *mocks.MockTaskExecutionManager
+	executionManager              *mocks.MockExecutionManager
+	inlineExecutionManager        *mocks.MockInlineExecutionManager
+	launchPlanManager             *mocks.MockLaunchPlanManager
+	namedEntityManager            *mocks.MockNamedEntityManager
+	nodeExecutionManager          *mocks.MockNodeExecutionManager
+	projectManager                *mocks.MockProjectManager
+	projectDomainManager          *mocks.MockProjectDomainManager
+	resourceManager               *mocks.MockResourceManager
+	taskManager                   *mocks.MockTaskManager
+	workflowManager               *mocks.MockWorkflowManager
+	taskExecutionManager          *mocks.MockTaskExecutionManager
+	eventManager                  *mocks.MockEventManager
+	notificationPreferenceManager *mocks.MockNotificationPreferenceManager
+	versionManager                *mocks.MockVersionManager
 }
 
 func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminService {
 	var testScope = mockScope.NewTestScope()
 	return &adminservice.AdminService{
-		ExecutionManager:     input.executionManager,
-		LaunchPlanManager:    input.launchPlanManager,
-		NodeExecutionManager: input.nodeExecutionManager,
-		TaskManager:          input.taskManager,
-		ProjectManager:       input.projectManager,
-		ProjectDomainManager: input.projectDomainManager,
-		WorkflowManager:      input.workflowManager,
-		TaskExecutionManager: input.taskExecutionManager,
-		Metrics:              adminservice.InitMetrics(testScope),
+		ExecutionManager:              input.executionManager,
+		InlineExecutionManager:        input.inlineExecutionManager,
+		LaunchPlanManager:             input.launchPlanManager,
+		NamedEntityManager:            input.namedEntityManager,
+		NodeExecutionManager:          input.nodeExecutionManager,
+		TaskManager:                   input.taskManager,
+		ProjectManager:                input.projectManager,
+		ProjectDomainManager:          input.projectDomainManager,
+		ResourceManager:               input.resourceManager,
+		WorkflowManager:               input.workflowManager,
+		TaskExecutionManager:          input.taskExecutionManager,
+		EventManager:                  input.eventManager,
+		NotificationPreferenceManager: input.notificationPreferenceManager,
+		VersionManager:                input.versionManager,
+		Metrics:                       adminservice.InitMetrics(testScope),
 	}
 }
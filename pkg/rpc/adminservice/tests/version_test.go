@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const versionURL = "/api/v1/version"
+
+func TestGetVersionHandler(t *testing.T) {
+	mockVersionManager := mocks.MockVersionManager{}
+	mockVersionManager.SetGetVersionFunc(
+		func(ctx context.Context, request interfaces.VersionGetRequest) (*interfaces.VersionGetResponse, error) {
+			return &interfaces.VersionGetResponse{
+				Version: "v0.2.1",
+				GitSHA:  "abc123",
+				FeatureFlags: map[string]bool{
+					"auth": true,
+				},
+				CloudProviders: map[string]string{
+					"remoteData": "aws",
+				},
+				SchemaVersion: "2020-01-01",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		versionManager: &mockVersionManager,
+	})
+	handler := mockServer.GetVersionHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, versionURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.VersionGetResponse
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Equal(t, "v0.2.1", response.Version)
+	assert.Equal(t, "abc123", response.GitSHA)
+	assert.True(t, response.FeatureFlags["auth"])
+	assert.Equal(t, "aws", response.CloudProviders["remoteData"])
+	assert.Equal(t, "2020-01-01", response.SchemaVersion)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, versionURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
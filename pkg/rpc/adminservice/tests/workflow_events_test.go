@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const workflowEventsRequestBody = `{
+	"events": [
+		{"workflowEvent": {"requestId": "1", "event": {
+			"executionId": {"project": "project", "domain": "domain", "name": "name"}, "phase": "RUNNING"}}},
+		{"nodeEvent": {"requestId": "2", "event": {
+			"id": {"nodeId": "node", "executionId": {"project": "project", "domain": "domain", "name": "name"}},
+			"phase": "SUCCEEDED"}}}
+	]
+}`
+
+func TestCreateWorkflowEventsHandler(t *testing.T) {
+	mockEventManager := mocks.MockEventManager{}
+	mockEventManager.SetCreateWorkflowEventsCallback(
+		func(ctx context.Context, request interfaces.WorkflowEventsRequest) (
+			*interfaces.WorkflowEventsResponse, error) {
+			assert.Len(t, request.Events, 2)
+			assert.Equal(t, core.WorkflowExecution_RUNNING, request.Events[0].WorkflowEvent.Event.Phase)
+			assert.Nil(t, request.Events[0].NodeEvent)
+			assert.Equal(t, "node", request.Events[1].NodeEvent.Event.Id.NodeId)
+			assert.Equal(t, core.NodeExecution_SUCCEEDED, request.Events[1].NodeEvent.Event.Phase)
+			return &interfaces.WorkflowEventsResponse{
+				Statuses: []interfaces.WorkflowEventStatus{
+					{Code: codes.OK.String()},
+					{Code: codes.AlreadyExists.String(), Message: "already recorded"},
+				},
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		eventManager: &mockEventManager,
+	})
+	handler := mockServer.GetCreateWorkflowEventsHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(workflowEventsRequestBody)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var response interfaces.WorkflowEventsResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, []interfaces.WorkflowEventStatus{
+		{Code: "OK"},
+		{Code: "AlreadyExists", Message: "already recorded"},
+	}, response.Statuses)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(
+		`{"events": [{"taskEvent": {"event": {"phase": "UNKNOWN_PHASE"}}}]}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "invalid event at index 0")
+}
+
+func TestCreateWorkflowEventsHandlerError(t *testing.T) {
+	mockEventManager := mocks.MockEventManager{}
+	mockEventManager.SetCreateWorkflowEventsCallback(
+		func(ctx context.Context, request interfaces.WorkflowEventsRequest) (
+			*interfaces.WorkflowEventsResponse, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "missing events")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		eventManager: &mockEventManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetCreateWorkflowEventsHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"events": []}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "missing events")
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/lyft/flyteadmin/pkg/errors"
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/manager/mocks"
+)
+
+const workflowGraphURL = "/api/v1/workflow_graph?project=project&domain=domain&name=name&version=version"
+
+func TestGetWorkflowGraphHandler(t *testing.T) {
+	mockWorkflowManager := mocks.MockWorkflowManager{}
+	mockWorkflowManager.SetGetWorkflowGraphCallback(
+		func(ctx context.Context, request admin.ObjectGetRequest) (*interfaces.WorkflowGraph, error) {
+			assert.Equal(t, "project", request.Id.Project)
+			assert.Equal(t, "domain", request.Id.Domain)
+			assert.Equal(t, "name", request.Id.Name)
+			assert.Equal(t, "version", request.Id.Version)
+			return &interfaces.WorkflowGraph{
+				ID: request.Id,
+				Nodes: []interfaces.WorkflowGraphNode{
+					{
+						ID:   "start-node",
+						Kind: interfaces.WorkflowGraphNodeKindStart,
+						Name: "start-node",
+					},
+					{
+						ID:   "end-node",
+						Kind: interfaces.WorkflowGraphNodeKindEnd,
+						Name: "end-node",
+					},
+				},
+				Edges: []interfaces.WorkflowGraphEdge{
+					{
+						From: "start-node",
+						To:   "end-node",
+					},
+				},
+				Dot: "digraph \"name\" {}\n",
+			}, nil
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		workflowManager: &mockWorkflowManager,
+	})
+	handler := mockServer.GetWorkflowGraphHandler(context.Background())
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, workflowGraphURL, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var graph interfaces.WorkflowGraph
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&graph))
+	assert.Len(t, graph.Nodes, 2)
+	assert.Equal(t, "end-node", graph.Edges[0].To)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, workflowGraphURL+"&format=dot", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/vnd.graphviz", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "digraph \"name\" {}\n", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, workflowGraphURL+"&format=svg", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, workflowGraphURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestGetWorkflowGraphHandlerError(t *testing.T) {
+	mockWorkflowManager := mocks.MockWorkflowManager{}
+	mockWorkflowManager.SetGetWorkflowGraphCallback(
+		func(ctx context.Context, request admin.ObjectGetRequest) (*interfaces.WorkflowGraph, error) {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "workflow not found")
+		})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		workflowManager: &mockWorkflowManager,
+	})
+
+	recorder := httptest.NewRecorder()
+	mockServer.GetWorkflowGraphHandler(context.Background())(
+		recorder, httptest.NewRequest(http.MethodGet, workflowGraphURL, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
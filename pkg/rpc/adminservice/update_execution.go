@@ -0,0 +1,60 @@
+package adminservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.ExecutionUpdateRequest.
+type executionUpdateRequest struct {
+	ID   *core.WorkflowExecutionIdentifier `json:"id"`
+	Tags []string                          `json:"tags"`
+}
+
+func (m *AdminService) UpdateExecution(
+	ctx context.Context, request interfaces.ExecutionUpdateRequest) (*interfaces.ExecutionUpdateResponse, error) {
+	if err := authorizeProject(ctx, request.ID.GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.update)
+	}
+	var response *interfaces.ExecutionUpdateResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.update.Time(func() {
+		response, err = m.ExecutionManager.UpdateExecution(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.update)
+	}
+	m.Metrics.executionEndpointMetrics.update.Success()
+	return response, nil
+}
+
+// Execution tags are replaced by PUTting a JSON executionUpdateRequest to this handler.
+func (m *AdminService) GetUpdateExecutionHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPut {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body executionUpdateRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid update execution request: %v", err), http.StatusBadRequest)
+			return
+		}
+		response, err := m.UpdateExecution(request.Context(), interfaces.ExecutionUpdateRequest{
+			ID:   body.ID,
+			Tags: body.Tags,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
@@ -0,0 +1,40 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+func (m *AdminService) GetVersion(ctx context.Context, request interfaces.VersionGetRequest) (
+	*interfaces.VersionGetResponse, error) {
+	var response *interfaces.VersionGetResponse
+	var err error
+	m.Metrics.versionEndpointMetrics.get.Time(func() {
+		response, err = m.VersionManager.GetVersion(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.versionEndpointMetrics.get)
+	}
+	m.Metrics.versionEndpointMetrics.get.Success()
+	return response, nil
+}
+
+// The build, feature flags, cloud providers and schema version of this admin are fetched by GETting this handler. The
+// response is an interfaces.VersionGetResponse.
+func (m *AdminService) GetVersionHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		response, err := m.GetVersion(request.Context(), interfaces.VersionGetRequest{})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
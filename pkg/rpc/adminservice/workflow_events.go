@@ -0,0 +1,121 @@
+package adminservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// The HTTP representation of an interfaces.WorkflowEvent. Event requests use the protobuf JSON mapping.
+type workflowEvent struct {
+	WorkflowEvent json.RawMessage `json:"workflowEvent"`
+	NodeEvent     json.RawMessage `json:"nodeEvent"`
+	TaskEvent     json.RawMessage `json:"taskEvent"`
+}
+
+// The HTTP representation of an interfaces.WorkflowEventsRequest.
+type workflowEventsRequest struct {
+	Events []workflowEvent `json:"events"`
+}
+
+func unmarshalJSONProto(data json.RawMessage, request proto.Message) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(data), request)
+}
+
+func (e workflowEvent) toWorkflowEvent() (interfaces.WorkflowEvent, error) {
+	var event interfaces.WorkflowEvent
+	if len(e.WorkflowEvent) > 0 {
+		event.WorkflowEvent = &admin.WorkflowExecutionEventRequest{}
+		if err := unmarshalJSONProto(e.WorkflowEvent, event.WorkflowEvent); err != nil {
+			return interfaces.WorkflowEvent{}, err
+		}
+	}
+	if len(e.NodeEvent) > 0 {
+		event.NodeEvent = &admin.NodeExecutionEventRequest{}
+		if err := unmarshalJSONProto(e.NodeEvent, event.NodeEvent); err != nil {
+			return interfaces.WorkflowEvent{}, err
+		}
+	}
+	if len(e.TaskEvent) > 0 {
+		event.TaskEvent = &admin.TaskExecutionEventRequest{}
+		if err := unmarshalJSONProto(e.TaskEvent, event.TaskEvent); err != nil {
+			return interfaces.WorkflowEvent{}, err
+		}
+	}
+	return event, nil
+}
+
+// Returns the project of the execution an event belongs to.
+func getWorkflowEventProject(event interfaces.WorkflowEvent) string {
+	switch {
+	case event.WorkflowEvent != nil:
+		return event.WorkflowEvent.GetEvent().GetExecutionId().GetProject()
+	case event.NodeEvent != nil:
+		return event.NodeEvent.GetEvent().GetId().GetExecutionId().GetProject()
+	default:
+		return event.TaskEvent.GetEvent().GetParentNodeExecutionId().GetExecutionId().GetProject()
+	}
+}
+
+func (m *AdminService) CreateWorkflowEvents(
+	ctx context.Context, request interfaces.WorkflowEventsRequest) (*interfaces.WorkflowEventsResponse, error) {
+	for _, event := range request.Events {
+		if err := authorizeProject(ctx, getWorkflowEventProject(event)); err != nil {
+			return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.createEvents)
+		}
+	}
+	var response *interfaces.WorkflowEventsResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.createEvents.Time(func() {
+		response, err = m.EventManager.CreateWorkflowEvents(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.createEvents)
+	}
+	m.Metrics.executionEndpointMetrics.createEvents.Success()
+	return response, nil
+}
+
+// Workflow, node and task execution events are sent in bulk by POSTing a JSON workflowEventsRequest to this handler.
+// The response holds a status for every event.
+func (m *AdminService) GetCreateWorkflowEventsHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body workflowEventsRequest
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, fmt.Sprintf("invalid workflow events request: %v", err), http.StatusBadRequest)
+			return
+		}
+		events := make([]interfaces.WorkflowEvent, len(body.Events))
+		for index, event := range body.Events {
+			var err error
+			if events[index], err = event.toWorkflowEvent(); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid event at index %d: %v", index, err), http.StatusBadRequest)
+				return
+			}
+		}
+		response, err := m.CreateWorkflowEvents(request.Context(), interfaces.WorkflowEventsRequest{
+			Events: events,
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
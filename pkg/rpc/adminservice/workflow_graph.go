@@ -0,0 +1,73 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/lyft/flytestdlib/logger"
+
+	"github.com/lyft/flyteadmin/pkg/manager/interfaces"
+	"github.com/lyft/flyteadmin/pkg/rpc/adminservice/util"
+)
+
+// Graphs are served in the graphviz DOT language when requested with format=dot.
+const dotGraphFormat = "dot"
+
+func (m *AdminService) GetWorkflowGraph(
+	ctx context.Context, request admin.ObjectGetRequest) (*interfaces.WorkflowGraph, error) {
+	if err := authorizeProject(ctx, request.GetId().GetProject()); err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.workflowEndpointMetrics.getGraph)
+	}
+	var response *interfaces.WorkflowGraph
+	var err error
+	m.Metrics.workflowEndpointMetrics.getGraph.Time(func() {
+		response, err = m.WorkflowManager.GetWorkflowGraph(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.workflowEndpointMetrics.getGraph)
+	}
+	m.Metrics.workflowEndpointMetrics.getGraph.Success()
+	return response, nil
+}
+
+// The graph of the workflow named by the project, domain, name and version query params is fetched by GETting this
+// handler. The response is an interfaces.WorkflowGraph, or the graph in the DOT language when the format query param is
+// dot.
+func (m *AdminService) GetWorkflowGraphHandler(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := request.URL.Query()
+		format := query.Get("format")
+		if len(format) > 0 && format != dotGraphFormat {
+			http.Error(writer, fmt.Sprintf("unsupported graph format [%s]", format), http.StatusBadRequest)
+			return
+		}
+		response, err := m.GetWorkflowGraph(request.Context(), admin.ObjectGetRequest{
+			Id: &core.Identifier{
+				ResourceType: core.ResourceType_WORKFLOW,
+				Project:      query.Get("project"),
+				Domain:       query.Get("domain"),
+				Name:         query.Get("name"),
+				Version:      query.Get("version"),
+			},
+		})
+		if err != nil {
+			writeErrorResponse(writer, err)
+			return
+		}
+		if format == dotGraphFormat {
+			writer.Header().Set("Content-Type", "text/vnd.graphviz")
+			if _, err := writer.Write([]byte(response.Dot)); err != nil {
+				logger.Errorf(ctx, "failed to write workflow graph, error: %s", err)
+			}
+			return
+		}
+		writeJSONResponse(ctx, writer, response)
+	}
+}
@@ -16,6 +16,11 @@ const scheduler = "scheduler"
 const remoteData = "remoteData"
 const notifications = "notifications"
 const domains = "domains"
+const retention = "retention"
+const listLimits = "listLimits"
+const eventCompaction = "eventCompaction"
+const cloudEvents = "cloudEvents"
+const versionRetention = "versionRetention"
 
 var databaseConfig = config.MustRegisterSection(database, &interfaces.DbConfigSection{})
 var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.ApplicationConfig{})
@@ -23,6 +28,11 @@ var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.Schedule
 var remoteDataConfig = config.MustRegisterSection(remoteData, &interfaces.RemoteDataConfig{})
 var notificationsConfig = config.MustRegisterSection(notifications, &interfaces.NotificationsConfig{})
 var domainsConfig = config.MustRegisterSection(domains, &interfaces.DomainsConfig{})
+var retentionConfig = config.MustRegisterSection(retention, &interfaces.RetentionConfig{})
+var listLimitsConfig = config.MustRegisterSection(listLimits, &interfaces.ListLimitsConfig{})
+var eventCompactionConfig = config.MustRegisterSection(eventCompaction, &interfaces.EventCompactionConfig{})
+var cloudEventsConfig = config.MustRegisterSection(cloudEvents, &interfaces.CloudEventsConfig{})
+var versionRetentionConfig = config.MustRegisterSection(versionRetention, &interfaces.VersionRetentionConfig{})
 
 // Implementation of an interfaces.ApplicationConfiguration
 type ApplicationConfigurationProvider struct{}
@@ -71,6 +81,27 @@ func (p *ApplicationConfigurationProvider) GetNotificationsConfig() *interfaces.
 func (p *ApplicationConfigurationProvider) GetDomainsConfig() *interfaces.DomainsConfig {
 	return domainsConfig.GetConfig().(*interfaces.DomainsConfig)
 }
+
+func (p *ApplicationConfigurationProvider) GetRetentionConfig() *interfaces.RetentionConfig {
+	return retentionConfig.GetConfig().(*interfaces.RetentionConfig)
+}
+
 func NewApplicationConfigurationProvider() interfaces.ApplicationConfiguration {
 	return &ApplicationConfigurationProvider{}
 }
+
+func (p *ApplicationConfigurationProvider) GetListLimitsConfig() *interfaces.ListLimitsConfig {
+	return listLimitsConfig.GetConfig().(*interfaces.ListLimitsConfig)
+}
+
+func (p *ApplicationConfigurationProvider) GetEventCompactionConfig() *interfaces.EventCompactionConfig {
+	return eventCompactionConfig.GetConfig().(*interfaces.EventCompactionConfig)
+}
+
+func (p *ApplicationConfigurationProvider) GetCloudEventsConfig() *interfaces.CloudEventsConfig {
+	return cloudEventsConfig.GetConfig().(*interfaces.CloudEventsConfig)
+}
+
+func (p *ApplicationConfigurationProvider) GetVersionRetentionConfig() *interfaces.VersionRetentionConfig {
+	return versionRetentionConfig.GetConfig().(*interfaces.VersionRetentionConfig)
+}
@@ -1,5 +1,7 @@
 package interfaces
 
+import "github.com/lyft/flytestdlib/config"
+
 type DbConfigSection struct {
 	Host   string `json:"host"`
 	Port   int    `json:"port"`
@@ -28,6 +30,159 @@ type ApplicationConfig struct {
 	MetricsScope          string   `json:"metricsScope"`
 	ProfilerPort          int      `json:"profilerPort"`
 	MetadataStoragePrefix []string `json:"metadataStoragePrefix"`
+	// Storage prefixes (e.g. team-owned buckets) that executions may request their data be written under instead of the
+	// default storage container. When empty, per-execution output data prefix overrides are rejected.
+	AllowedOutputDataPrefixes []string `json:"allowedOutputDataPrefixes"`
+	// Sensitive values masked in logs and error messages.
+	Redaction RedactionConfig `json:"redaction"`
+	// Authenticated identities (token subjects) allowed to fetch the inputs and outputs of restricted executions.
+	RestrictedDataReaders []string `json:"restrictedDataReaders"`
+	// Events received after node and task executions reached a terminal phase.
+	LateEvents LateEventsConfig `json:"lateEvents"`
+	// Blob storage locations which execution inputs may reference.
+	InputReferences InputReferencesConfig `json:"inputReferences"`
+	// How the log links of task executions are computed when they're fetched.
+	TaskLogs TaskLogsConfig `json:"taskLogs"`
+	// Checks and defaults applied when tasks of custom types are registered, keyed by task type.
+	TaskTypes map[string]TaskTypeConfig `json:"taskTypes"`
+	// Defaults provisioned for newly registered projects.
+	Onboarding OnboardingConfig `json:"onboarding"`
+	// Repeats reads against a second database to validate it with production traffic.
+	ShadowReads ShadowReadsConfig `json:"shadowReads"`
+	// Keeps large closures out of database rows.
+	ClosureOffloading ClosureOffloadingConfig `json:"closureOffloading"`
+	// Rejects requests which write to the database, e.g. during database failovers and restores.
+	ReadOnly ReadOnlyConfig `json:"readOnly"`
+	// Projects whose launch plans are activated as soon as a new version is registered, unless the launch plan opts out.
+	AutoActivateLaunchPlanProjects []string `json:"autoActivateLaunchPlanProjects"`
+	// Whether registering a task, workflow or launch plan version which is identical to the registered one succeeds,
+	// rather than failing with AlreadyExists, so that registration pipelines can be safely retried.
+	IdempotentRegistration bool `json:"idempotentRegistration"`
+	// Projects whose registered tasks may be referenced by the workflows of other projects, keyed by the project of the
+	// tasks and listing the projects allowed to reference them. "*" allows every project. When unset, workflows may
+	// reference the tasks of every project.
+	SharedTaskProjects map[string][]string `json:"sharedTaskProjects"`
+}
+
+// Identifies values which must never be written to logs or error messages verbatim.
+type RedactionConfig struct {
+	// Names of execution and launch plan inputs which hold sensitive values.
+	SensitiveInputs []string `json:"sensitiveInputs"`
+	// Annotation keys which hold sensitive values, "*" marks all annotation values as sensitive.
+	SensitiveAnnotations []string `json:"sensitiveAnnotations"`
+}
+
+// Configures whether events which arrive after a node or task execution reached a terminal phase, e.g. carrying final
+// output URIs or log links, may still add that metadata to its closure. Such events never change the recorded phase.
+type LateEventsConfig struct {
+	// How long after the terminal phase was recorded late events may have occurred. Late events are always rejected
+	// when unset.
+	MaxDelay config.Duration `json:"maxDelay"`
+}
+
+// Restricts the blob storage locations (e.g. "s3://team-bucket/") which the blob and schema inputs of executions may
+// reference, so that inaccessible references are rejected at launch rather than failing tasks at runtime. References
+// aren't checked unless prefixes are allowed for at least one project.
+type InputReferencesConfig struct {
+	// URI prefixes which the inputs of executions in every project may reference.
+	AllowedPrefixes []string `json:"allowedPrefixes"`
+	// Additional URI prefixes which the inputs of executions in a project may reference, keyed by project.
+	ProjectAllowedPrefixes map[string][]string `json:"projectAllowedPrefixes"`
+	// Whether referenced objects must also exist, which is checked with a metadata request to blob storage.
+	CheckExistence bool `json:"checkExistence"`
+}
+
+// A log link computed for every task execution.
+type TaskLogTemplate struct {
+	// Display name of the log link.
+	Name string `json:"name"`
+	// Go template for the link, rendered with the task execution's fields, e.g.
+	// https://console.cloud.google.com/logs/viewer?advancedFilter=resource.labels.namespace_name%3D{{ .Namespace }}
+	URITemplate string `json:"uriTemplate"`
+	// Name of the core.TaskLog_MessageFormat of the linked logs, e.g. JSON.
+	MessageFormat string `json:"messageFormat"`
+}
+
+// When templates are configured, task execution log links are computed from them whenever they're fetched instead of
+// returning the possibly stale links captured from task execution events.
+type TaskLogsConfig struct {
+	Templates []TaskLogTemplate `json:"templates"`
+}
+
+// Configures the checks and defaults applied to the tasks of a custom type, e.g. spark, when they're registered.
+type TaskTypeConfig struct {
+	// Whether tasks of the type must define a container.
+	RequireContainer bool `json:"requireContainer"`
+	// Fields the custom struct of tasks of the type must set, e.g. mainApplicationFile.
+	RequiredCustomFields []string `json:"requiredCustomFields"`
+	// Resources requested for the container of tasks of the type when they don't request them.
+	DefaultResources TaskResourceSet `json:"defaultResources"`
+}
+
+// Identifies a registered launch plan version.
+type LaunchPlanReference struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Configures the pipeline run after a project is registered which provisions its namespace resources in each domain,
+// assigns it default execution queues and registers a sample launch plan, so that new teams can launch executions
+// right away. Each step is skipped when there's nothing configured for it.
+type OnboardingConfig struct {
+	Enabled bool `json:"enabled"`
+	// Execution queue tags assigned to onboarded projects, keyed by domain id.
+	QueueTags map[string][]string `json:"queueTags"`
+	// An existing launch plan which is copied into each domain of onboarded projects.
+	SampleLaunchPlan LaunchPlanReference `json:"sampleLaunchPlan"`
+	// Project-domain attributes set for onboarded projects, keyed by domain id.
+	DefaultAttributes map[string]map[string]string `json:"defaultAttributes"`
+	// Sent once onboarded projects are provisioned.
+	WelcomeNotification WelcomeNotificationConfig `json:"welcomeNotification"`
+	// The names of the onboarding steps to run, in order. All built-in steps and registered hooks run when unset.
+	Steps []string `json:"steps"`
+}
+
+// An email sent when a project is registered. The subject and body may reference the project as {{ project }}.
+type WelcomeNotificationConfig struct {
+	Recipients []string `json:"recipients"`
+	Subject    string   `json:"subject"`
+	Body       string   `json:"body"`
+}
+
+// Reads served from the primary database may also be repeated against a shadow database, e.g. one running a new
+// engine or schema, before switching over to it. Shadow results are compared with the primary's and mismatches are
+// logged, but they're never returned.
+type ShadowReadsConfig struct {
+	Enabled  bool     `json:"enabled"`
+	Database DbConfig `json:"database"`
+	// Percentage of reads repeated against the shadow database.
+	SamplePercentage int `json:"samplePercentage"`
+	// Limits the shadow reads in flight at once, reads sampled beyond it aren't repeated.
+	MaxInFlight int `json:"maxInFlight"`
+	// How long a shadow read may take, including waiting on the primary read it's compared with.
+	Timeout config.Duration `json:"timeout"`
+}
+
+// Node execution closures larger than the threshold, e.g. those of dynamic nodes with many children, are written to
+// blob storage under the metadata storage prefix and only referenced from their rows. Rows stored before a threshold
+// was set can be offloaded with the "migrate offload-node-execution-closures" command.
+type ClosureOffloadingConfig struct {
+	// Closures are never offloaded when unset.
+	NodeExecutionThresholdBytes int `json:"nodeExecutionThresholdBytes"`
+}
+
+// Read-only mode is switched on either here or through the read-only mode endpoint, which persists the switch to blob
+// storage under the metadata storage prefix so it outlives restarts and applies to every replica. Reads and data URLs
+// are still served while mutating requests fail with FailedPrecondition.
+type ReadOnlyConfig struct {
+	// Forces read-only mode regardless of the persisted switch.
+	Enabled bool `json:"enabled"`
+	// Returned to callers of rejected requests unless the persisted switch sets its own.
+	Message string `json:"message"`
+	// How often each replica reloads the persisted switch, defaults to 30s.
+	RefreshInterval config.Duration `json:"refreshInterval"`
 }
 
 type EventSchedulerConfig struct {
@@ -44,10 +199,17 @@ type WorkflowExecutorConfig struct {
 	AccountID         string `json:"accountId"`
 }
 
+// Limits how many executions a single backfill request may create and how quickly they're launched.
+type BackfillConfig struct {
+	MaxExecutions       int     `json:"maxExecutions"`
+	ExecutionsPerSecond float64 `json:"executionsPerSecond"`
+}
+
 // This configuration is the base configuration for all scheduler-related set-up.
 type SchedulerConfig struct {
 	EventSchedulerConfig   EventSchedulerConfig   `json:"eventScheduler"`
 	WorkflowExecutorConfig WorkflowExecutorConfig `json:"workflowExecutor"`
+	BackfillConfig         BackfillConfig         `json:"backfill"`
 }
 
 // Configuration specific to setting up signed urls.
@@ -60,6 +222,9 @@ type RemoteDataConfig struct {
 	Scheme    string    `json:"scheme"`
 	Region    string    `json:"region"`
 	SignedURL SignedURL `json:"signedUrls"`
+	// Offloaded input and output literal maps no larger than this are also returned inline by full data requests.
+	// Inlining is disabled when unset.
+	MaxInlineSizeBytes int64 `json:"maxInlineSizeBytes"`
 }
 
 type NotificationsPublisherConfig struct {
@@ -69,21 +234,145 @@ type NotificationsPublisherConfig struct {
 type NotificationsProcessorConfig struct {
 	QueueName string `json:"queueName"`
 	AccountID string `json:"accountId"`
+	// The GCP Pub/Sub subscription to the publisher topic which notifications are consumed from.
+	SubscriptionName string `json:"subscriptionName"`
+	// The number of workers sending the consumed notifications concurrently, 1 when unset. Consuming blocks while
+	// each worker has a batch of notifications waiting to be sent.
+	Workers int `json:"workers"`
+	// The most notifications a worker takes from those waiting to be sent at once, 1 when unset. Notifications of a
+	// batch with the same sender, subject and body are sent as a single email to all of their recipients.
+	BatchSize int `json:"batchSize"`
+}
+
+// Configuration for notifications published to and consumed from GCP Pub/Sub.
+type GCPNotificationsConfig struct {
+	// The GCP project owning the publisher topic and the processor subscription.
+	ProjectID string `json:"projectId"`
+}
+
+// Configuration for notifications published to and consumed from Kafka. The publisher topic is the one notifications
+// are published to and consumed from.
+type KafkaNotificationsConfig struct {
+	Brokers []string `json:"brokers"`
+	// The Kafka version of the brokers, e.g. 2.1.0. Defaults to the oldest version the client supports.
+	Version string `json:"version"`
+	// The consumer group processors join to consume notifications.
+	ConsumerGroup string `json:"consumerGroup"`
+}
+
+// Configuration for notifications published to and processed from a queue within the process.
+type InProcessNotificationsConfig struct {
+	// The number of notifications which can wait to be processed before publishing fails, 1000 when unset.
+	QueueSize int `json:"queueSize"`
 }
 
 type NotificationsEmailerConfig struct {
 	Subject string `json:"subject"`
-	Sender  string `json:"sender"`
-	Body    string `json:"body"`
+	// May reference the {{ project }} and {{ domain }} of the execution, e.g. flyte+{{ project }}@example.com.
+	Sender string `json:"sender"`
+	// The addresses replies to emails are sent to, the sender when unset.
+	ReplyTo []string `json:"replyTo"`
+	Body    string   `json:"body"`
+	// The base URL of the Flyte console, which templates link executions to. May reference {{ project }} and
+	// {{ domain }} like the sender.
+	ConsoleURL string `json:"consoleUrl"`
+	// Go templates rendering the emails of a project, which take precedence over the subject and body above.
+	Templates []NotificationTemplateConfig `json:"templates"`
+	// Overrides of the sender, reply-to and console URL above for the emails of projects and domains.
+	Overrides []NotificationEmailerOverrideConfig `json:"overrides"`
+}
+
+// Overrides the emailer config for the emails of a project, or of one of its domains. Unset fields aren't overridden
+// and domain overrides take precedence over those of their project. As the email message has no reply-to, the emailer
+// resolves the reply-to addresses from the sender so overrides which set them should set a distinct sender too.
+type NotificationEmailerOverrideConfig struct {
+	Project string `json:"project"`
+	// The domain whose emails are overridden, every domain of the project when empty.
+	Domain string `json:"domain"`
+	// The sender and console URL may reference the {{ project }} and {{ domain }} of the execution.
+	Sender     string   `json:"sender"`
+	ReplyTo    []string `json:"replyTo"`
+	ConsoleURL string   `json:"consoleUrl"`
+}
+
+// Go templates referencing the fields of notifications.EmailTemplateData, e.g. {{ .Name }} or {{ .ConsoleURL }}. The
+// subject and text body are text/template templates while the HTML body is an html/template one, which escapes the
+// fields it renders. As the email message carries a single body, the HTML body is sent when both are set. Unset
+// templates fall back to the subject and body of the emailer config.
+type NotificationTemplateConfig struct {
+	// The project whose emails are rendered with the templates, every project without templates of its own when empty.
+	Project  string `json:"project"`
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"htmlBody"`
+	TextBody string `json:"textBody"`
+}
+
+// Periodically emails a summary of the executions of a project which terminated within the period, as an alternative
+// to per-execution notifications for high-volume schedules. Digests are sent by the digest controller.
+type NotificationDigestConfig struct {
+	Project string `json:"project"`
+	// The domain whose executions are summarized, every domain of the project when empty.
+	Domain string `json:"domain"`
+	// The names of the launch plans whose executions are summarized, every launch plan when empty.
+	LaunchPlans []string `json:"launchPlans"`
+	// The length of the summarized periods, which are aligned to UTC, e.g. 24h summarizes each day from midnight UTC.
+	Interval   config.Duration `json:"interval"`
+	Recipients []string        `json:"recipients"`
+}
+
+// An endpoint notifications are POSTed to as JSON. Webhooks are notified of the executions of the projects and domains
+// whose notifications.teams or notifications.webhook attribute lists their name.
+type NotificationWebhookConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Headers sent with every request, e.g. to authenticate with the endpoint.
+	Headers map[string]string `json:"headers"`
+}
+
+// Webhook notifications are queued and delivered in the background by a pool of workers.
+type NotificationWebhookDeliveryConfig struct {
+	// The number of workers POSTing notifications to webhooks concurrently, 4 when unset.
+	Workers int `json:"workers"`
+	// The number of notifications which can wait to be delivered before publishing fails, 1000 when unset.
+	QueueSize int `json:"queueSize"`
+}
+
+// Limits how many notifications are published within each interval.
+type RateLimit struct {
+	// Notifications published per interval, unlimited when unset.
+	Limit    int             `json:"limit"`
+	Interval config.Duration `json:"interval"`
+}
+
+// Rate limits applied to notifications before they're published. Notifications raised once a limit is reached are
+// kept in the database and spill over into a single digest per set of recipients, which is published once the oldest
+// of them was held back for the limit's interval. The pinned flyteidl version only supports email, Slack and PagerDuty
+// notifications.
+type NotificationRateLimitsConfig struct {
+	Email     RateLimit `json:"email"`
+	Slack     RateLimit `json:"slack"`
+	PagerDuty RateLimit `json:"pagerDuty"`
+	// Limits how many notifications of a launch plan each recipient gets per channel, so that a mass failure, e.g.
+	// scheduled executions failing after a bad deploy, results in a digest rather than an email per execution.
+	// Notifications identical to one a recipient already got within the interval are dropped. This applies before
+	// the channel limits above.
+	PerRecipient RateLimit `json:"perRecipient"`
 }
 
 // Configuration specific to notifications handling
 type NotificationsConfig struct {
-	Type                         string                       `json:"type"`
-	Region                       string                       `json:"region"`
-	NotificationsPublisherConfig NotificationsPublisherConfig `json:"publisher"`
-	NotificationsProcessorConfig NotificationsProcessorConfig `json:"processor"`
-	NotificationsEmailerConfig   NotificationsEmailerConfig   `json:"emailer"`
+	Type                         string                            `json:"type"`
+	Region                       string                            `json:"region"`
+	NotificationsPublisherConfig NotificationsPublisherConfig      `json:"publisher"`
+	NotificationsProcessorConfig NotificationsProcessorConfig      `json:"processor"`
+	NotificationsEmailerConfig   NotificationsEmailerConfig        `json:"emailer"`
+	GCPConfig                    GCPNotificationsConfig            `json:"gcp"`
+	KafkaConfig                  KafkaNotificationsConfig          `json:"kafka"`
+	InProcessConfig              InProcessNotificationsConfig      `json:"inProcess"`
+	RateLimits                   NotificationRateLimitsConfig      `json:"rateLimits"`
+	Webhooks                     []NotificationWebhookConfig       `json:"webhooks"`
+	WebhookDelivery              NotificationWebhookDeliveryConfig `json:"webhookDelivery"`
+	Digests                      []NotificationDigestConfig        `json:"digests"`
 }
 
 type Domain struct {
@@ -93,6 +382,96 @@ type Domain struct {
 
 type DomainsConfig = []Domain
 
+// Determines how long the data of terminated executions in a domain is kept.
+type RetentionPolicy struct {
+	// Offloaded inputs and outputs are removed for executions created longer ago than this.
+	MaxAge config.Duration `json:"maxAge"`
+	// When set, expired executions are also deleted from the database once their data is removed.
+	DeleteRecords bool `json:"deleteRecords"`
+}
+
+// Configures the retention controller which garbage-collects the offloaded data of old executions.
+type RetentionConfig struct {
+	// Retention policies keyed by domain id. Data in domains without a policy is retained indefinitely.
+	Domains map[string]RetentionPolicy `json:"domains"`
+	// When set, the data which would be removed is only logged and counted in metrics.
+	DryRun bool `json:"dryRun"`
+	// How often the retention controller sweeps for expired executions.
+	RefreshInterval config.Duration `json:"refreshInterval"`
+	// The number of expired executions listed at a time while sweeping a domain.
+	BatchSize int `json:"batchSize"`
+}
+
+// Configures the compaction controller which summarizes the events of old executions and prunes the raw events.
+type EventCompactionConfig struct {
+	// The events of terminated executions last updated longer ago than this are compacted. Unset disables compaction.
+	MaxAge config.Duration `json:"maxAge"`
+	// How often the compaction controller looks for executions to compact.
+	RefreshInterval config.Duration `json:"refreshInterval"`
+	// The maximum number of executions compacted in each run.
+	BatchSize int `json:"batchSize"`
+}
+
+// Determines how many versions of each task, workflow and launch plan in a project are kept.
+type VersionRetentionPolicy struct {
+	// The number of most recently registered versions kept per name. Older versions are pruned.
+	KeepVersions int `json:"keepVersions"`
+	// When set, pruned versions are permanently removed from the database rather than soft-deleted (archived), from
+	// which they can still be restored.
+	Purge bool `json:"purge"`
+}
+
+// Configures the pruning controller which removes old task, workflow and launch plan versions.
+type VersionRetentionConfig struct {
+	// Version retention policies keyed by project id. All versions are kept in projects without a policy.
+	Projects map[string]VersionRetentionPolicy `json:"projects"`
+	// Versions referenced by executions created within this window are never pruned. Defaults to 30 days.
+	ProtectionWindow config.Duration `json:"protectionWindow"`
+	// When set, the versions which would be pruned are only logged and counted in metrics.
+	DryRun bool `json:"dryRun"`
+	// How often the pruning controller looks for versions to prune.
+	RefreshInterval config.Duration `json:"refreshInterval"`
+	// The number of versions listed at a time while pruning.
+	BatchSize int `json:"batchSize"`
+}
+
+// The endpoint CloudEvents are POSTed to when the webhook transport is configured.
+type CloudEventsWebhookConfig struct {
+	URL string `json:"url"`
+	// Headers sent with every request, e.g. Authorization.
+	Headers map[string]string `json:"headers"`
+}
+
+// Configures mirroring workflow, node and task execution events to an external topic as CloudEvents.
+type CloudEventsConfig struct {
+	Enable bool `json:"enable"`
+	// The transport events are published with: "aws" for SNS, "gcp" for Pub/Sub, "kafka" or "webhook".
+	Type      string `json:"type"`
+	Region    string `json:"region"`
+	TopicName string `json:"topicName"`
+	// The CloudEvents source attribute of published events, defaults to "flyteadmin".
+	Source      string                   `json:"source"`
+	GCPConfig   GCPNotificationsConfig   `json:"gcp"`
+	KafkaConfig KafkaNotificationsConfig `json:"kafka"`
+	Webhook     CloudEventsWebhookConfig `json:"webhook"`
+}
+
+// Bounds the number of results returned in a single page of a list request.
+type PageSizeLimits struct {
+	// Applied to list requests which don't specify a limit.
+	Default uint32 `json:"default"`
+	// List requests for larger pages are rejected.
+	Max uint32 `json:"max"`
+}
+
+// Configures the page sizes enforced for list requests.
+type ListLimitsConfig struct {
+	// Applies to entities without limits of their own.
+	Default PageSizeLimits `json:"default"`
+	// Overrides keyed by the listed entity, e.g. "executions" or "nodeExecutions". Unset fields fall back to Default.
+	Entities map[string]PageSizeLimits `json:"entities"`
+}
+
 // Defines the interface to return top-level config structs necessary to start up a flyteadmin application.
 type ApplicationConfiguration interface {
 	GetDbConfig() DbConfig
@@ -101,4 +480,9 @@ type ApplicationConfiguration interface {
 	GetRemoteDataConfig() *RemoteDataConfig
 	GetNotificationsConfig() *NotificationsConfig
 	GetDomainsConfig() *DomainsConfig
+	GetRetentionConfig() *RetentionConfig
+	GetListLimitsConfig() *ListLimitsConfig
+	GetEventCompactionConfig() *EventCompactionConfig
+	GetCloudEventsConfig() *CloudEventsConfig
+	GetVersionRetentionConfig() *VersionRetentionConfig
 }
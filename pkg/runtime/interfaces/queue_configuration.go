@@ -11,6 +11,8 @@ type ExecutionQueue struct {
 type ExecutionQueues []ExecutionQueue
 
 // Defines the specific resource attributes (tags) a workflow requires to run.
+// Deprecated: set execution queue attributes for the project, domain or workflow instead, which can be updated without
+// a deployment and take precedence over workflow configs.
 type WorkflowConfig struct {
 	Project      string   `json:"project"`
 	Domain       string   `json:"domain"`
@@ -5,6 +5,9 @@ type RegistrationValidationConfig struct {
 	MaxLabelEntries      int    `json:"maxLabelEntries"`
 	MaxAnnotationEntries int    `json:"maxAnnotationEntries"`
 	WorkflowSizeLimit    string `json:"workflowSizeLimit"`
+	// Whether registering a workflow verifies up front that the tasks it references are registered, and reports the
+	// workflow failing to compile against them, e.g. because of mismatching interface types, as an invalid argument.
+	VerifyTaskReferences bool `json:"verifyTaskReferences"`
 }
 
 // Provides validation limits used at entity registration
@@ -13,4 +16,5 @@ type RegistrationValidationConfiguration interface {
 	GetMaxLabelEntries() int
 	GetMaxAnnotationEntries() int
 	GetWorkflowSizeLimit() string
+	GetVerifyTaskReferences() bool
 }
@@ -5,12 +5,17 @@ import (
 )
 
 type MockApplicationProvider struct {
-	dbConfig            interfaces.DbConfig
-	topLevelConfig      interfaces.ApplicationConfig
-	schedulerConfig     interfaces.SchedulerConfig
-	remoteDataConfig    interfaces.RemoteDataConfig
-	notificationsConfig interfaces.NotificationsConfig
-	domainsConfig       interfaces.DomainsConfig
+	dbConfig               interfaces.DbConfig
+	topLevelConfig         interfaces.ApplicationConfig
+	schedulerConfig        interfaces.SchedulerConfig
+	remoteDataConfig       interfaces.RemoteDataConfig
+	notificationsConfig    interfaces.NotificationsConfig
+	domainsConfig          interfaces.DomainsConfig
+	retentionConfig        interfaces.RetentionConfig
+	listLimitsConfig       interfaces.ListLimitsConfig
+	compactionConfig       interfaces.EventCompactionConfig
+	cloudEventsConfig      interfaces.CloudEventsConfig
+	versionRetentionConfig interfaces.VersionRetentionConfig
 }
 
 func (p *MockApplicationProvider) GetDbConfig() interfaces.DbConfig {
@@ -60,3 +65,43 @@ func (p *MockApplicationProvider) GetDomainsConfig() *interfaces.DomainsConfig {
 func (p *MockApplicationProvider) SetDomainsConfig(domainsConfig interfaces.DomainsConfig) {
 	p.domainsConfig = domainsConfig
 }
+
+func (p *MockApplicationProvider) GetRetentionConfig() *interfaces.RetentionConfig {
+	return &p.retentionConfig
+}
+
+func (p *MockApplicationProvider) SetRetentionConfig(retentionConfig interfaces.RetentionConfig) {
+	p.retentionConfig = retentionConfig
+}
+
+func (p *MockApplicationProvider) GetListLimitsConfig() *interfaces.ListLimitsConfig {
+	return &p.listLimitsConfig
+}
+
+func (p *MockApplicationProvider) SetListLimitsConfig(listLimitsConfig interfaces.ListLimitsConfig) {
+	p.listLimitsConfig = listLimitsConfig
+}
+
+func (p *MockApplicationProvider) GetEventCompactionConfig() *interfaces.EventCompactionConfig {
+	return &p.compactionConfig
+}
+
+func (p *MockApplicationProvider) SetEventCompactionConfig(compactionConfig interfaces.EventCompactionConfig) {
+	p.compactionConfig = compactionConfig
+}
+
+func (p *MockApplicationProvider) GetCloudEventsConfig() *interfaces.CloudEventsConfig {
+	return &p.cloudEventsConfig
+}
+
+func (p *MockApplicationProvider) SetCloudEventsConfig(cloudEventsConfig interfaces.CloudEventsConfig) {
+	p.cloudEventsConfig = cloudEventsConfig
+}
+
+func (p *MockApplicationProvider) GetVersionRetentionConfig() *interfaces.VersionRetentionConfig {
+	return &p.versionRetentionConfig
+}
+
+func (p *MockApplicationProvider) SetVersionRetentionConfig(versionRetentionConfig interfaces.VersionRetentionConfig) {
+	p.versionRetentionConfig = versionRetentionConfig
+}
@@ -7,6 +7,7 @@ type MockRegistrationValidationProvider struct {
 	MaxLabelEntries      int
 	MaxAnnotationEntries int
 	WorkflowSizeLimit    string
+	VerifyTaskReferences bool
 }
 
 func (c *MockRegistrationValidationProvider) GetWorkflowNodeLimit() int {
@@ -25,6 +26,10 @@ func (c *MockRegistrationValidationProvider) GetWorkflowSizeLimit() string {
 	return c.WorkflowSizeLimit
 }
 
+func (c *MockRegistrationValidationProvider) GetVerifyTaskReferences() bool {
+	return c.VerifyTaskReferences
+}
+
 func NewMockRegistrationValidationProvider() interfaces.RegistrationValidationConfiguration {
 	return &MockRegistrationValidationProvider{}
 }
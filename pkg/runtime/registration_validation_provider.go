@@ -48,6 +48,14 @@ func (p *RegistrationValidationProvider) GetWorkflowSizeLimit() string {
 	return ""
 }
 
+func (p *RegistrationValidationProvider) GetVerifyTaskReferences() bool {
+	if registrationValidationConfig != nil {
+		return registrationValidationConfig.GetConfig().(*interfaces.RegistrationValidationConfig).VerifyTaskReferences
+	}
+	logger.Warning(context.Background(), "failed to find verify task references in config. Returning false")
+	return false
+}
+
 func NewRegistrationValidationProvider() interfaces.RegistrationValidationConfiguration {
 	return &RegistrationValidationProvider{}
 }
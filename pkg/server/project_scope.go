@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+)
+
+// Returns the project a list request is scoped to, if it's one.
+func getListRequestProject(req interface{}) (string, bool) {
+	switch request := req.(type) {
+	case *admin.ResourceListRequest:
+		return request.GetId().GetProject(), true
+	case *admin.NamedEntityIdentifierListRequest:
+		return request.GetProject(), true
+	case *admin.NamedEntityListRequest:
+		return request.GetProject(), true
+	case *admin.ActiveLaunchPlanListRequest:
+		return request.GetProject(), true
+	case *admin.NodeExecutionListRequest:
+		return request.GetWorkflowExecutionId().GetProject(), true
+	case *admin.NodeExecutionForTaskListRequest:
+		return request.GetTaskExecutionId().GetNodeExecutionId().GetExecutionId().GetProject(), true
+	case *admin.TaskExecutionListRequest:
+		return request.GetNodeExecutionId().GetExecutionId().GetProject(), true
+	}
+	return "", false
+}
+
+// Restricts list RPCs such as ListExecutions and ListTasks to the projects the caller is entitled to, rejecting those
+// scoped to any other project with codes.PermissionDenied. Project listings are scoped by the project manager itself.
+func ProjectScopeUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if project, ok := getListRequestProject(req); ok && !auth.IsProjectAuthorized(ctx, project) {
+		return nil, status.Errorf(codes.PermissionDenied, "caller isn't entitled to list resources of project [%s]",
+			project)
+	}
+	return handler(ctx, req)
+}
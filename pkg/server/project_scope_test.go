@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+)
+
+func TestProjectScopeUnaryServerInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/flyteidl.service.AdminService/ListExecutions"}
+	request := &admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{Project: "project", Domain: "domain"},
+	}
+
+	response, err := ProjectScopeUnaryServerInterceptor(context.Background(), request, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "handled", response)
+
+	ctx := auth.WithAuthorizedProjects(context.Background(), []string{"project"})
+	response, err = ProjectScopeUnaryServerInterceptor(ctx, request, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "handled", response)
+
+	ctx = auth.WithAuthorizedProjects(context.Background(), []string{"other"})
+	_, err = ProjectScopeUnaryServerInterceptor(ctx, request, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ProjectScopeUnaryServerInterceptor(ctx, &admin.TaskExecutionListRequest{
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			ExecutionId: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+		},
+	}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	response, err = ProjectScopeUnaryServerInterceptor(ctx, &admin.ProjectListRequest{}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "handled", response)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const readOnlyMessage = "admin is serving in read-only mode until it's upgraded to match the database schema"
+
+// Admin RPCs which only read from the database are named like these prefixes.
+var readOnlyMethodPrefixes = []string{"Get", "List"}
+
+func isReadOnlyMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Rejects RPCs which write to the database with codes.Unavailable so that clients retry them against replicas which
+// aren't in read-only mode, e.g. ones already upgraded during a rolling upgrade.
+func ReadOnlyUnaryServerInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !isReadOnlyMethod(info.FullMethod) {
+		return nil, status.Error(codes.Unavailable, readOnlyMessage)
+	}
+	return handler(ctx, req)
+}
+
+// Like ReadOnlyUnaryServerInterceptor for plain HTTP requests, which are rejected unless they're safe methods or
+// addressed to one of the exempt paths, such as handlers which don't touch the database.
+func GetReadOnlyHandler(handler http.Handler, exemptPaths ...string) http.Handler {
+	exempt := make(map[string]bool, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = true
+	}
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			if !exempt[request.URL.Path] {
+				http.Error(writer, readOnlyMessage, http.StatusServiceUnavailable)
+				return
+			}
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
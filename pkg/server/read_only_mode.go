@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	ptypesStruct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const defaultReadOnlyModeMessage = "admin is serving in read-only mode while its database is unavailable"
+const defaultReadOnlyModeRefreshInterval = 30 * time.Second
+const readOnlyModeObjectName = "read_only_mode"
+
+const enabledField = "enabled"
+const messageField = "message"
+const updatedByField = "updatedBy"
+
+// The effective read-only mode as served by the read-only mode endpoint.
+type ReadOnlyModeStatus struct {
+	Enabled bool   `json:"enabled"`
+	Message string `json:"message,omitempty"`
+	// Whether read-only mode is forced by configuration, in which case it can't be switched off through the endpoint.
+	Configured bool `json:"configured"`
+	// Who last switched read-only mode through the endpoint, if authenticated.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+}
+
+// Switches read-only mode through the endpoint. The message defaults to the configured one.
+type ReadOnlyModeUpdate struct {
+	Enabled bool   `json:"enabled"`
+	Message string `json:"message"`
+}
+
+type persistedReadOnlyMode struct {
+	enabled   bool
+	message   string
+	updatedBy string
+}
+
+type readOnlyModeMetrics struct {
+	Enabled       prometheus.Gauge
+	RefreshErrors prometheus.Counter
+}
+
+// Operators switch admin into read-only mode for disaster scenarios such as database failovers and restores. The switch
+// is persisted to blob storage rather than the database so it can be flipped while the database is unavailable, and
+// every replica picks it up when it next refreshes.
+type ReadOnlyMode struct {
+	config    runtimeInterfaces.ReadOnlyConfig
+	store     *storage.DataStore
+	reference storage.DataReference
+	metrics   readOnlyModeMetrics
+
+	mutex     sync.RWMutex
+	persisted persistedReadOnlyMode
+}
+
+func (m *ReadOnlyMode) set(persisted persistedReadOnlyMode) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.persisted = persisted
+	if m.config.Enabled || persisted.enabled {
+		m.metrics.Enabled.Set(1)
+	} else {
+		m.metrics.Enabled.Set(0)
+	}
+}
+
+// Reloads the persisted switch, which is off until first set.
+func (m *ReadOnlyMode) Refresh(ctx context.Context) error {
+	var persisted ptypesStruct.Struct
+	if err := m.store.ReadProtobuf(ctx, m.reference, &persisted); err != nil {
+		if storage.IsNotFound(err) {
+			m.set(persistedReadOnlyMode{})
+			return nil
+		}
+		m.metrics.RefreshErrors.Inc()
+		return err
+	}
+	m.set(persistedReadOnlyMode{
+		enabled:   persisted.Fields[enabledField].GetBoolValue(),
+		message:   persisted.Fields[messageField].GetStringValue(),
+		updatedBy: persisted.Fields[updatedByField].GetStringValue(),
+	})
+	return nil
+}
+
+func (m *ReadOnlyMode) Run() {
+	ctx := context.Background()
+	interval := m.config.RefreshInterval.Duration
+	if interval <= 0 {
+		interval = defaultReadOnlyModeRefreshInterval
+	}
+	wait.Forever(func() {
+		if err := m.Refresh(ctx); err != nil {
+			logger.Warningf(ctx, "Failed to refresh read-only mode from [%s] with: %v", m.reference, err)
+		}
+	}, interval)
+}
+
+// Persists the switch and applies it to this replica right away.
+func (m *ReadOnlyMode) Update(ctx context.Context, update ReadOnlyModeUpdate) error {
+	persisted := persistedReadOnlyMode{
+		enabled:   update.Enabled,
+		message:   update.Message,
+		updatedBy: auth.GetUserEmail(ctx),
+	}
+	if err := m.store.WriteProtobuf(ctx, m.reference, storage.Options{}, &ptypesStruct.Struct{
+		Fields: map[string]*ptypesStruct.Value{
+			enabledField:   {Kind: &ptypesStruct.Value_BoolValue{BoolValue: persisted.enabled}},
+			messageField:   {Kind: &ptypesStruct.Value_StringValue{StringValue: persisted.message}},
+			updatedByField: {Kind: &ptypesStruct.Value_StringValue{StringValue: persisted.updatedBy}},
+		},
+	}); err != nil {
+		return err
+	}
+	m.set(persisted)
+	logger.Infof(ctx, "Switched read-only mode to [%v] by [%s]", persisted.enabled, persisted.updatedBy)
+	return nil
+}
+
+func (m *ReadOnlyMode) GetStatus() ReadOnlyModeStatus {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	readOnlyModeStatus := ReadOnlyModeStatus{
+		Enabled:    m.config.Enabled || m.persisted.enabled,
+		Configured: m.config.Enabled,
+		UpdatedBy:  m.persisted.updatedBy,
+	}
+	if !readOnlyModeStatus.Enabled {
+		return readOnlyModeStatus
+	}
+	switch {
+	case m.persisted.enabled && len(m.persisted.message) > 0:
+		readOnlyModeStatus.Message = m.persisted.message
+	case len(m.config.Message) > 0:
+		readOnlyModeStatus.Message = m.config.Message
+	default:
+		readOnlyModeStatus.Message = defaultReadOnlyModeMessage
+	}
+	return readOnlyModeStatus
+}
+
+func NewReadOnlyMode(ctx context.Context, config runtimeInterfaces.ReadOnlyConfig, store *storage.DataStore,
+	storagePrefix []string, scope promutils.Scope) (*ReadOnlyMode, error) {
+	nestedKeys := append(append(make([]string, 0, len(storagePrefix)+1), storagePrefix...), readOnlyModeObjectName)
+	reference, err := store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx), nestedKeys...)
+	if err != nil {
+		return nil, err
+	}
+	readOnlyMode := &ReadOnlyMode{
+		config:    config,
+		store:     store,
+		reference: reference,
+		metrics: readOnlyModeMetrics{
+			Enabled: scope.MustNewGauge("enabled",
+				"whether admin is serving in read-only mode"),
+			RefreshErrors: scope.MustNewCounter("refresh_errors",
+				"overall count of errors encountered reloading the persisted read-only mode"),
+		},
+	}
+	readOnlyMode.set(persistedReadOnlyMode{})
+	return readOnlyMode, nil
+}
+
+// While read-only mode is on, rejects RPCs which write to the database with codes.FailedPrecondition. Unlike during
+// rolling upgrades, no other replica can serve them, so clients shouldn't retry them right away.
+func GetReadOnlyModeUnaryServerInterceptor(readOnlyMode *ReadOnlyMode) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if readOnlyModeStatus := readOnlyMode.GetStatus(); readOnlyModeStatus.Enabled &&
+			!isReadOnlyMethod(info.FullMethod) {
+			return nil, status.Error(codes.FailedPrecondition, readOnlyModeStatus.Message)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Like GetReadOnlyModeUnaryServerInterceptor for plain HTTP requests, which are rejected unless they're safe methods or
+// addressed to one of the exempt paths, such as the read-only mode endpoint itself.
+func GetReadOnlyModeHandler(handler http.Handler, readOnlyMode *ReadOnlyMode, exemptPaths ...string) http.Handler {
+	exempt := make(map[string]bool, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = true
+	}
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			if readOnlyModeStatus := readOnlyMode.GetStatus(); readOnlyModeStatus.Enabled &&
+				!exempt[request.URL.Path] {
+				http.Error(writer, readOnlyModeStatus.Message, runtime.HTTPStatusFromCode(codes.FailedPrecondition))
+				return
+			}
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
+
+// Returns a handler that serves the read-only mode on GET and persists a ReadOnlyModeUpdate on PUT or POST. Since the
+// update applies to every replica, it's only served to operators when auth is enabled, see auth.RequireOperator.
+func GetReadOnlyModeSwitchHandler(ctx context.Context, readOnlyMode *ReadOnlyMode) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var update ReadOnlyModeUpdate
+			if err := json.NewDecoder(request.Body).Decode(&update); err != nil {
+				http.Error(writer, fmt.Sprintf("invalid read-only mode update: %v", err), http.StatusBadRequest)
+				return
+			}
+			if err := readOnlyMode.Update(request.Context(), update); err != nil {
+				logger.Errorf(ctx, "Failed to persist read-only mode with err: %v", err)
+				http.Error(writer, "failed to persist read-only mode", http.StatusInternalServerError)
+				return
+			}
+		default:
+			http.Error(writer, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		bytes, err := json.Marshal(readOnlyMode.GetStatus())
+		if err != nil {
+			logger.Errorf(ctx, "Error marshaling read-only mode into JSON %s", err)
+			http.Error(writer, "Error marshaling response into JSON", http.StatusInternalServerError)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		if _, err := writer.Write(bytes); err != nil {
+			logger.Errorf(ctx, "failed to write read-only mode, error: %s", err)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	flyteErrors "github.com/lyft/flytestdlib/errors"
+	mockScope "github.com/lyft/flytestdlib/promutils"
+	"github.com/lyft/flytestdlib/storage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/flyteadmin/pkg/auth"
+	commonMocks "github.com/lyft/flyteadmin/pkg/common/mocks"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+const readOnlyModeReference = "s3://bucket/metadata/admin/read_only_mode"
+
+// Returns a read-only mode backed by an in-memory store, which is empty until the mode is first switched.
+func getReadOnlyModeForTest(t *testing.T, config runtimeInterfaces.ReadOnlyConfig) (
+	*ReadOnlyMode, map[storage.DataReference]proto.Message) {
+	persisted := make(map[storage.DataReference]proto.Message)
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		message, ok := persisted[reference]
+		if !ok {
+			return flyteErrors.Wrapf(storage.ErrNotFound, errors.New("missing"), "no object at [%s]", reference)
+		}
+		proto.Merge(msg, message)
+		return nil
+	}
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).WriteProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, opts storage.Options, msg proto.Message) error {
+		persisted[reference] = proto.Clone(msg)
+		return nil
+	}
+	readOnlyMode, err := NewReadOnlyMode(
+		context.Background(), config, mockStorage, []string{"metadata", "admin"}, mockScope.NewTestScope())
+	assert.NoError(t, err)
+	return readOnlyMode, persisted
+}
+
+func TestReadOnlyMode(t *testing.T) {
+	readOnlyMode, persisted := getReadOnlyModeForTest(t, runtimeInterfaces.ReadOnlyConfig{})
+	assert.NoError(t, readOnlyMode.Refresh(context.Background()))
+	assert.Equal(t, ReadOnlyModeStatus{}, readOnlyMode.GetStatus())
+
+	ctx := auth.WithUserEmail(context.Background(), "operator@example.com")
+	assert.NoError(t, readOnlyMode.Update(ctx, ReadOnlyModeUpdate{
+		Enabled: true,
+		Message: "restoring the database",
+	}))
+	assert.Contains(t, persisted, storage.DataReference(readOnlyModeReference))
+	expectedStatus := ReadOnlyModeStatus{
+		Enabled:   true,
+		Message:   "restoring the database",
+		UpdatedBy: "operator@example.com",
+	}
+	assert.Equal(t, expectedStatus, readOnlyMode.GetStatus())
+
+	// Other replicas pick up the persisted switch when they refresh.
+	otherReadOnlyMode, _ := getReadOnlyModeForTest(t, runtimeInterfaces.ReadOnlyConfig{})
+	otherReadOnlyMode.store = readOnlyMode.store
+	assert.NoError(t, otherReadOnlyMode.Refresh(context.Background()))
+	assert.Equal(t, expectedStatus, otherReadOnlyMode.GetStatus())
+
+	assert.NoError(t, readOnlyMode.Update(ctx, ReadOnlyModeUpdate{}))
+	assert.False(t, readOnlyMode.GetStatus().Enabled)
+}
+
+func TestReadOnlyMode_Configured(t *testing.T) {
+	readOnlyMode, _ := getReadOnlyModeForTest(t, runtimeInterfaces.ReadOnlyConfig{
+		Enabled: true,
+	})
+	assert.NoError(t, readOnlyMode.Refresh(context.Background()))
+	assert.Equal(t, ReadOnlyModeStatus{
+		Enabled:    true,
+		Message:    defaultReadOnlyModeMessage,
+		Configured: true,
+	}, readOnlyMode.GetStatus())
+
+	// The configured switch can't be turned off through the endpoint.
+	assert.NoError(t, readOnlyMode.Update(context.Background(), ReadOnlyModeUpdate{}))
+	assert.True(t, readOnlyMode.GetStatus().Enabled)
+}
+
+func TestReadOnlyMode_RefreshError(t *testing.T) {
+	readOnlyMode, _ := getReadOnlyModeForTest(t, runtimeInterfaces.ReadOnlyConfig{})
+	readOnlyMode.store.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		return errors.New("unavailable")
+	}
+	assert.EqualError(t, readOnlyMode.Refresh(context.Background()), "unavailable")
+}
+
+func TestGetReadOnlyModeUnaryServerInterceptor(t *testing.T) {
+	readOnlyMode, _ := getReadOnlyModeForTest(t, runtimeInterfaces.ReadOnlyConfig{
+		Message: "failing over",
+	})
+	interceptor := GetReadOnlyModeUnaryServerInterceptor(readOnlyMode)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+	createExecution := &grpc.UnaryServerInfo{FullMethod: "/flyteidl.service.AdminService/CreateExecution"}
+	response, err := interceptor(context.Background(), nil, createExecution, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "handled", response)
+
+	assert.NoError(t, readOnlyMode.Update(context.Background(), ReadOnlyModeUpdate{Enabled: true}))
+	_, err = interceptor(context.Background(), nil, createExecution, handler)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "failing over", status.Convert(err).Message())
+
+	for _, method := range []string{
+		"/flyteidl.service.AdminService/GetExecution", "/flyteidl.service.AdminService/GetExecutionData"} {
+		response, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", response)
+	}
+}
+
+func TestGetReadOnlyModeHandler(t *testing.T) {
+	readOnlyMode, _ := getReadOnlyModeForTest(t, runtimeInterfaces.ReadOnlyConfig{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/executions", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/api/v1/read_only_mode", GetReadOnlyModeSwitchHandler(context.Background(), readOnlyMode))
+	handler := GetReadOnlyModeHandler(mux, readOnlyMode, "/api/v1/read_only_mode")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/executions", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPut, "/api/v1/read_only_mode", bytes.NewBufferString(`{"enabled": true, "message": "restoring"}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var readOnlyModeStatus ReadOnlyModeStatus
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &readOnlyModeStatus))
+	assert.Equal(t, ReadOnlyModeStatus{
+		Enabled: true,
+		Message: "restoring",
+	}, readOnlyModeStatus)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/executions", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "restoring\n", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/executions", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPut, "/api/v1/read_only_mode", bytes.NewBufferString(`{"enabled": false}`)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/executions", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPut, "/api/v1/read_only_mode", bytes.NewBufferString(`not json`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
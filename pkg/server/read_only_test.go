@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadOnlyUnaryServerInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+	for _, method := range []string{
+		"/flyteidl.service.AdminService/GetExecution", "/flyteidl.service.AdminService/ListTasks"} {
+		response, err := ReadOnlyUnaryServerInterceptor(
+			context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", response)
+	}
+	for _, method := range []string{
+		"/flyteidl.service.AdminService/CreateExecution", "/flyteidl.service.AdminService/UpdateLaunchPlan"} {
+		_, err := ReadOnlyUnaryServerInterceptor(
+			context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+}
+
+func TestGetReadOnlyHandler(t *testing.T) {
+	handler := GetReadOnlyHandler(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}), "/logging")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/executions", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/executions", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/logging", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
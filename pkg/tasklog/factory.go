@@ -0,0 +1,17 @@
+// Computes the log links returned for task executions.
+package tasklog
+
+import (
+	"github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteadmin/pkg/tasklog/implementations"
+	logInterfaces "github.com/lyft/flyteadmin/pkg/tasklog/interfaces"
+)
+
+// Returns a provider rendering the configured log link templates, or one returning the links captured from task
+// execution events when no templates are configured.
+func NewLogProvider(config interfaces.TaskLogsConfig) (logInterfaces.LogProvider, error) {
+	if len(config.Templates) == 0 {
+		return implementations.NewEventLogProvider(), nil
+	}
+	return implementations.NewTemplateLogProvider(config.Templates)
+}
@@ -0,0 +1,21 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/lyft/flyteadmin/pkg/tasklog/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Returns the log links captured from the task execution's events as is.
+type EventLogProvider struct{}
+
+func (p *EventLogProvider) GetTaskLogs(ctx context.Context, taskExecution *admin.TaskExecution) (
+	[]*core.TaskLog, error) {
+	return taskExecution.GetClosure().GetLogs(), nil
+}
+
+func NewEventLogProvider() interfaces.LogProvider {
+	return &EventLogProvider{}
+}
@@ -0,0 +1,122 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"text/template"
+
+	"github.com/golang/protobuf/ptypes"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteadmin/pkg/tasklog/interfaces"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Fields of a task execution which log link templates may reference, e.g. {{ .ExecutionName }}.
+type TemplateInput struct {
+	Project       string
+	Domain        string
+	ExecutionName string
+	NodeID        string
+	TaskProject   string
+	TaskDomain    string
+	TaskName      string
+	TaskVersion   string
+	RetryAttempt  uint32
+	// The namespace task pods run in, by convention {project}-{domain}.
+	Namespace string
+	// Unix time in seconds at which the task execution started, or 0 if it hasn't.
+	StartedAt int64
+	// Unix time in seconds at which the task execution was last updated.
+	UpdatedAt int64
+}
+
+type logTemplate struct {
+	name          string
+	uri           *template.Template
+	messageFormat core.TaskLog_MessageFormat
+}
+
+// Renders configured templates into log links so that links can be pointed at a new log backend, or at queries which
+// survive pod restarts, without re-running tasks.
+type TemplateLogProvider struct {
+	templates []logTemplate
+}
+
+func getTemplateInput(taskExecution *admin.TaskExecution) TemplateInput {
+	id := taskExecution.GetId()
+	executionID := id.GetNodeExecutionId().GetExecutionId()
+	input := TemplateInput{
+		Project:       executionID.GetProject(),
+		Domain:        executionID.GetDomain(),
+		ExecutionName: executionID.GetName(),
+		NodeID:        id.GetNodeExecutionId().GetNodeId(),
+		TaskProject:   id.GetTaskId().GetProject(),
+		TaskDomain:    id.GetTaskId().GetDomain(),
+		TaskName:      id.GetTaskId().GetName(),
+		TaskVersion:   id.GetTaskId().GetVersion(),
+		RetryAttempt:  id.GetRetryAttempt(),
+		Namespace:     fmt.Sprintf("%s-%s", executionID.GetProject(), executionID.GetDomain()),
+	}
+	if startedAt, err := ptypes.Timestamp(taskExecution.GetClosure().GetStartedAt()); err == nil {
+		input.StartedAt = startedAt.Unix()
+	}
+	if updatedAt, err := ptypes.Timestamp(taskExecution.GetClosure().GetUpdatedAt()); err == nil {
+		input.UpdatedAt = updatedAt.Unix()
+	}
+	return input
+}
+
+func (p *TemplateLogProvider) GetTaskLogs(ctx context.Context, taskExecution *admin.TaskExecution) (
+	[]*core.TaskLog, error) {
+	input := getTemplateInput(taskExecution)
+	logs := make([]*core.TaskLog, len(p.templates))
+	for idx, logTemplate := range p.templates {
+		var uri bytes.Buffer
+		if err := logTemplate.uri.Execute(&uri, input); err != nil {
+			return nil, fmt.Errorf("failed to render log link [%s] with err: %v", logTemplate.name, err)
+		}
+		logs[idx] = &core.TaskLog{
+			Name:          logTemplate.name,
+			Uri:           uri.String(),
+			MessageFormat: logTemplate.messageFormat,
+		}
+	}
+	return logs, nil
+}
+
+func NewTemplateLogProvider(config []runtimeInterfaces.TaskLogTemplate) (interfaces.LogProvider, error) {
+	templates := make([]logTemplate, len(config))
+	for idx, templateConfig := range config {
+		if templateConfig.Name == "" {
+			return nil, fmt.Errorf("task log template [%d] must have a name", idx)
+		}
+		uri, err := template.New(templateConfig.Name).Parse(templateConfig.URITemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uri template for task log [%s]: %v", templateConfig.Name, err)
+		}
+		// Catch references to unknown fields up front rather than when serving links.
+		if err := uri.Execute(ioutil.Discard, TemplateInput{}); err != nil {
+			return nil, fmt.Errorf("invalid uri template for task log [%s]: %v", templateConfig.Name, err)
+		}
+		messageFormat := core.TaskLog_UNKNOWN
+		if templateConfig.MessageFormat != "" {
+			value, ok := core.TaskLog_MessageFormat_value[templateConfig.MessageFormat]
+			if !ok {
+				return nil, fmt.Errorf("invalid message format [%s] for task log [%s]",
+					templateConfig.MessageFormat, templateConfig.Name)
+			}
+			messageFormat = core.TaskLog_MessageFormat(value)
+		}
+		templates[idx] = logTemplate{
+			name:          templateConfig.Name,
+			uri:           uri,
+			messageFormat: messageFormat,
+		}
+	}
+	return &TemplateLogProvider{
+		templates: templates,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+var taskExecution = &admin.TaskExecution{
+	Id: &core.TaskExecutionIdentifier{
+		TaskId: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      "flytekit",
+			Domain:       "production",
+			Name:         "task",
+			Version:      "v1",
+		},
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId: "node",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "development",
+				Name:    "name",
+			},
+		},
+		RetryAttempt: 3,
+	},
+	Closure: &admin.TaskExecutionClosure{
+		StartedAt: &timestamp.Timestamp{
+			Seconds: 100,
+		},
+		UpdatedAt: &timestamp.Timestamp{
+			Seconds: 200,
+		},
+		Logs: []*core.TaskLog{
+			{
+				Name: "stale",
+				Uri:  "https://logs/stale",
+			},
+		},
+	},
+}
+
+func TestTemplateLogProvider(t *testing.T) {
+	provider, err := NewTemplateLogProvider([]runtimeInterfaces.TaskLogTemplate{
+		{
+			Name: "pod",
+			URITemplate: "https://logs/{{ .Namespace }}/{{ .ExecutionName }}-{{ .NodeID }}-{{ .RetryAttempt }}" +
+				"?task={{ .TaskName }}:{{ .TaskVersion }}&from={{ .StartedAt }}&to={{ .UpdatedAt }}",
+			MessageFormat: "JSON",
+		},
+		{
+			Name:        "project",
+			URITemplate: "https://logs/{{ .Project }}",
+		},
+	})
+	assert.Nil(t, err)
+	logs, err := provider.GetTaskLogs(context.Background(), taskExecution)
+	assert.Nil(t, err)
+	assert.Equal(t, []*core.TaskLog{
+		{
+			Name:          "pod",
+			Uri:           "https://logs/project-development/name-node-3?task=task:v1&from=100&to=200",
+			MessageFormat: core.TaskLog_JSON,
+		},
+		{
+			Name:          "project",
+			Uri:           "https://logs/project",
+			MessageFormat: core.TaskLog_UNKNOWN,
+		},
+	}, logs)
+}
+
+func TestNewTemplateLogProvider_InvalidConfig(t *testing.T) {
+	_, err := NewTemplateLogProvider([]runtimeInterfaces.TaskLogTemplate{
+		{
+			URITemplate: "https://logs/{{ .Project }}",
+		},
+	})
+	assert.EqualError(t, err, "task log template [0] must have a name")
+
+	_, err = NewTemplateLogProvider([]runtimeInterfaces.TaskLogTemplate{
+		{
+			Name:        "logs",
+			URITemplate: "https://logs/{{ .Project ",
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = NewTemplateLogProvider([]runtimeInterfaces.TaskLogTemplate{
+		{
+			Name:        "logs",
+			URITemplate: "https://logs/{{ .PodName }}",
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = NewTemplateLogProvider([]runtimeInterfaces.TaskLogTemplate{
+		{
+			Name:          "logs",
+			URITemplate:   "https://logs/{{ .Project }}",
+			MessageFormat: "XML",
+		},
+	})
+	assert.EqualError(t, err, "invalid message format [XML] for task log [logs]")
+}
+
+func TestEventLogProvider(t *testing.T) {
+	logs, err := NewEventLogProvider().GetTaskLogs(context.Background(), taskExecution)
+	assert.Nil(t, err)
+	assert.Equal(t, taskExecution.Closure.Logs, logs)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Defines an interface for computing the log links of a task execution at read time, since the links captured from
+// task execution events can expire or go stale, e.g. once the task's pod is restarted.
+type LogProvider interface {
+	GetTaskLogs(ctx context.Context, taskExecution *admin.TaskExecution) ([]*core.TaskLog, error)
+}
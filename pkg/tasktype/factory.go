@@ -0,0 +1,18 @@
+// Validates and assigns defaults to the tasks of custom types when they're registered.
+package tasktype
+
+import (
+	"github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteadmin/pkg/tasktype/implementations"
+	taskTypeInterfaces "github.com/lyft/flyteadmin/pkg/tasktype/interfaces"
+)
+
+// Returns a registry holding a plugin for each configured task type. Further plugins can be registered with it before
+// it's handed to the task manager.
+func NewRegistry(config map[string]interfaces.TaskTypeConfig) taskTypeInterfaces.Registry {
+	registry := implementations.NewRegistry()
+	for taskType, taskTypeConfig := range config {
+		registry.Register(taskType, implementations.NewConfigPlugin(taskType, taskTypeConfig))
+	}
+	return registry
+}
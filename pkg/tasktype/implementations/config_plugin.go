@@ -0,0 +1,81 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+	"github.com/lyft/flyteadmin/pkg/tasktype/interfaces"
+)
+
+// Applies the checks and defaults configured for a task type.
+type ConfigPlugin struct {
+	taskType         string
+	config           runtimeInterfaces.TaskTypeConfig
+	defaultResources []*core.Resources_ResourceEntry
+}
+
+func (p *ConfigPlugin) Validate(ctx context.Context, template *core.TaskTemplate) error {
+	if p.config.RequireContainer && template.GetContainer() == nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"tasks of type [%s] must define a container", p.taskType)
+	}
+	for _, field := range p.config.RequiredCustomFields {
+		if _, ok := template.GetCustom().GetFields()[field]; !ok {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"tasks of type [%s] must set the custom field [%s]", p.taskType, field)
+		}
+	}
+	return nil
+}
+
+func (p *ConfigPlugin) SetDefaults(ctx context.Context, template *core.TaskTemplate) error {
+	container := template.GetContainer()
+	if container == nil || len(p.defaultResources) == 0 {
+		return nil
+	}
+	if container.Resources == nil {
+		container.Resources = &core.Resources{}
+	}
+	requested := make(map[core.Resources_ResourceName]bool)
+	for _, entry := range container.Resources.Requests {
+		requested[entry.Name] = true
+	}
+	for _, entry := range p.defaultResources {
+		if !requested[entry.Name] {
+			container.Resources.Requests = append(container.Resources.Requests, &core.Resources_ResourceEntry{
+				Name:  entry.Name,
+				Value: entry.Value,
+			})
+		}
+	}
+	return nil
+}
+
+func NewConfigPlugin(taskType string, config runtimeInterfaces.TaskTypeConfig) interfaces.TaskTypePlugin {
+	defaultResources := make([]*core.Resources_ResourceEntry, 0)
+	for _, entry := range []struct {
+		name  core.Resources_ResourceName
+		value string
+	}{
+		{core.Resources_CPU, config.DefaultResources.CPU},
+		{core.Resources_GPU, config.DefaultResources.GPU},
+		{core.Resources_MEMORY, config.DefaultResources.Memory},
+		{core.Resources_STORAGE, config.DefaultResources.Storage},
+	} {
+		if len(entry.value) > 0 {
+			defaultResources = append(defaultResources, &core.Resources_ResourceEntry{
+				Name:  entry.name,
+				Value: entry.value,
+			})
+		}
+	}
+	return &ConfigPlugin{
+		taskType:         taskType,
+		config:           config,
+		defaultResources: defaultResources,
+	}
+}
@@ -0,0 +1,96 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+
+	ptypesStruct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/lyft/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/lyft/flyteadmin/pkg/runtime/interfaces"
+)
+
+var sparkConfig = runtimeInterfaces.TaskTypeConfig{
+	RequireContainer:     true,
+	RequiredCustomFields: []string{"mainApplicationFile"},
+	DefaultResources: runtimeInterfaces.TaskResourceSet{
+		CPU:    "1",
+		Memory: "1Gi",
+	},
+}
+
+func getSparkTaskTemplate(customFields ...string) *core.TaskTemplate {
+	custom := &ptypesStruct.Struct{
+		Fields: make(map[string]*ptypesStruct.Value),
+	}
+	for _, field := range customFields {
+		custom.Fields[field] = &ptypesStruct.Value{
+			Kind: &ptypesStruct.Value_StringValue{
+				StringValue: "value",
+			},
+		}
+	}
+	return &core.TaskTemplate{
+		Type:   "spark",
+		Custom: custom,
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Image: "image",
+				Resources: &core.Resources{
+					Requests: []*core.Resources_ResourceEntry{
+						{
+							Name:  core.Resources_CPU,
+							Value: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestConfigPlugin_Validate(t *testing.T) {
+	plugin := NewConfigPlugin("spark", sparkConfig)
+	assert.NoError(t, plugin.Validate(context.Background(), getSparkTaskTemplate("mainApplicationFile")))
+
+	err := plugin.Validate(context.Background(), getSparkTaskTemplate())
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	assert.EqualError(t, err, "tasks of type [spark] must set the custom field [mainApplicationFile]")
+
+	template := getSparkTaskTemplate("mainApplicationFile")
+	template.Target = nil
+	err = plugin.Validate(context.Background(), template)
+	assert.EqualError(t, err, "tasks of type [spark] must define a container")
+}
+
+func TestConfigPlugin_SetDefaults(t *testing.T) {
+	template := getSparkTaskTemplate()
+	assert.NoError(t, NewConfigPlugin("spark", sparkConfig).SetDefaults(context.Background(), template))
+	assert.Equal(t, []*core.Resources_ResourceEntry{
+		{
+			Name:  core.Resources_CPU,
+			Value: "2",
+		},
+		{
+			Name:  core.Resources_MEMORY,
+			Value: "1Gi",
+		},
+	}, template.GetContainer().Resources.Requests)
+
+	template.Target = nil
+	assert.NoError(t, NewConfigPlugin("spark", sparkConfig).SetDefaults(context.Background(), template))
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	first := NewConfigPlugin("spark", sparkConfig)
+	second := NewConfigPlugin("spark", runtimeInterfaces.TaskTypeConfig{})
+	registry.Register("spark", first)
+	registry.Register("spark", second)
+	assert.Len(t, registry.GetPlugins("spark"), 2)
+	assert.Equal(t, first, registry.GetPlugins("spark")[0])
+	assert.Empty(t, registry.GetPlugins("presto"))
+}
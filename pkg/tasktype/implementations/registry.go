@@ -0,0 +1,24 @@
+package implementations
+
+import (
+	"github.com/lyft/flyteadmin/pkg/tasktype/interfaces"
+)
+
+// Plugins are registered while the server is constructed and only read afterwards, so the registry isn't guarded.
+type Registry struct {
+	plugins map[string][]interfaces.TaskTypePlugin
+}
+
+func (r *Registry) Register(taskType string, plugin interfaces.TaskTypePlugin) {
+	r.plugins[taskType] = append(r.plugins[taskType], plugin)
+}
+
+func (r *Registry) GetPlugins(taskType string) []interfaces.TaskTypePlugin {
+	return r.plugins[taskType]
+}
+
+func NewRegistry() interfaces.Registry {
+	return &Registry{
+		plugins: make(map[string][]interfaces.TaskTypePlugin),
+	}
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/lyft/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Validates and assigns defaults to the tasks of a type, e.g. spark, when they're registered. The structure of custom
+// task types is opaque to the generic task validation, which only understands containers.
+type TaskTypePlugin interface {
+	// Validates the structure of a task template of the plugin's type.
+	Validate(ctx context.Context, template *core.TaskTemplate) error
+	// Assigns defaults, e.g. container resources, to a task template of the plugin's type before it's compiled.
+	SetDefaults(ctx context.Context, template *core.TaskTemplate) error
+}
+
+// Holds the plugins run for the tasks of each type.
+type Registry interface {
+	// Registers a plugin for the tasks of a type, which runs after the plugins registered for the type before it.
+	Register(taskType string, plugin TaskTypePlugin)
+	// Returns the plugins registered for the tasks of a type in the order they were registered.
+	GetPlugins(taskType string) []TaskTypePlugin
+}
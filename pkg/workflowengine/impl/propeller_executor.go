@@ -115,9 +115,19 @@ func (c *FlytePropeller) ExecuteWorkflow(ctx context.Context, input interfaces.E
 	labels := addMapValues(input.Labels, flyteWf.Labels)
 	flyteWf.Labels = labels
 	annotations := addMapValues(input.Annotations, flyteWf.Annotations)
+	if len(input.OutputDataPrefix) > 0 {
+		annotations[common.OutputDataPrefixAnnotation] = input.OutputDataPrefix
+	}
+	if len(input.TraceParent) > 0 {
+		annotations[common.TraceParentAnnotation] = input.TraceParent
+	}
+	if len(input.OnFailurePolicy) > 0 {
+		annotations[common.OnFailureAnnotation] = input.OnFailurePolicy
+	}
 	flyteWf.Annotations = annotations
 
 	executionTargetSpec := executioncluster.ExecutionTargetSpec{
+		TargetID:    input.Cluster,
 		ExecutionID: input.ExecutionID,
 	}
 	targetCluster, err := c.executionCluster.GetTarget(&executionTargetSpec)
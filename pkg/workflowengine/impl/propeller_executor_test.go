@@ -8,6 +8,7 @@ import (
 
 	interfaces2 "github.com/lyft/flyteadmin/pkg/executioncluster/interfaces"
 
+	"github.com/lyft/flyteadmin/pkg/common"
 	"github.com/lyft/flyteadmin/pkg/executioncluster"
 	cluster_mock "github.com/lyft/flyteadmin/pkg/executioncluster/mocks"
 	"github.com/lyft/flyteadmin/pkg/runtime"
@@ -187,6 +188,141 @@ func TestExecuteWorkflowHappyCase(t *testing.T) {
 	assert.Equal(t, clusterName, execInfo.Cluster)
 }
 
+func TestExecuteWorkflowClusterAssignment(t *testing.T) {
+	cluster := cluster_mock.MockCluster{}
+	cluster.SetGetTargetCallback(func(spec *executioncluster.ExecutionTargetSpec) (target *executioncluster.ExecutionTarget, e error) {
+		assert.Equal(t, "C2", spec.TargetID)
+		return &executioncluster.ExecutionTarget{
+			ID:          "C2",
+			FlyteClient: &FakeK8FlyteClient{},
+		}, nil
+	})
+	fakeFlyteWorkflow := FakeFlyteWorkflow{
+		createCallback: func(workflow *v1alpha1.FlyteWorkflow) (*v1alpha1.FlyteWorkflow, error) {
+			return nil, nil
+		},
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(namespace string) v1alpha12.FlyteWorkflowInterface {
+		return &fakeFlyteWorkflow
+	}
+	propeller := getFlytePropellerForTest(&cluster, &FlyteWorkflowBuilderTest{})
+
+	execInfo, err := propeller.ExecuteWorkflow(
+		context.Background(),
+		interfaces.ExecuteWorkflowInput{
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "p",
+				Domain:  "d",
+				Name:    "n",
+			},
+			WfClosure: core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{},
+				},
+			},
+			Reference: admin.LaunchPlan{
+				Spec: &admin.LaunchPlanSpec{},
+			},
+			AcceptedAt: acceptedAt,
+			Cluster:    "C2",
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "C2", execInfo.Cluster)
+}
+
+func TestExecuteWorkflowTraceParent(t *testing.T) {
+	cluster := cluster_mock.MockCluster{}
+	cluster.SetGetTargetCallback(func(spec *executioncluster.ExecutionTargetSpec) (target *executioncluster.ExecutionTarget, e error) {
+		return &executioncluster.ExecutionTarget{
+			ID:          "C1",
+			FlyteClient: &FakeK8FlyteClient{},
+		}, nil
+	})
+	var created bool
+	fakeFlyteWorkflow := FakeFlyteWorkflow{
+		createCallback: func(workflow *v1alpha1.FlyteWorkflow) (*v1alpha1.FlyteWorkflow, error) {
+			assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+				workflow.Annotations[common.TraceParentAnnotation])
+			created = true
+			return nil, nil
+		},
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(namespace string) v1alpha12.FlyteWorkflowInterface {
+		return &fakeFlyteWorkflow
+	}
+	propeller := getFlytePropellerForTest(&cluster, &FlyteWorkflowBuilderTest{})
+
+	_, err := propeller.ExecuteWorkflow(
+		context.Background(),
+		interfaces.ExecuteWorkflowInput{
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "p",
+				Domain:  "d",
+				Name:    "n",
+			},
+			WfClosure: core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{},
+				},
+			},
+			Reference: admin.LaunchPlan{
+				Spec: &admin.LaunchPlanSpec{},
+			},
+			AcceptedAt:  acceptedAt,
+			TraceParent: "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		})
+	assert.Nil(t, err)
+	assert.True(t, created)
+}
+
+func TestExecuteWorkflowOnFailurePolicy(t *testing.T) {
+	cluster := cluster_mock.MockCluster{}
+	cluster.SetGetTargetCallback(func(spec *executioncluster.ExecutionTargetSpec) (target *executioncluster.ExecutionTarget, e error) {
+		return &executioncluster.ExecutionTarget{
+			ID:          "C1",
+			FlyteClient: &FakeK8FlyteClient{},
+		}, nil
+	})
+	var created bool
+	fakeFlyteWorkflow := FakeFlyteWorkflow{
+		createCallback: func(workflow *v1alpha1.FlyteWorkflow) (*v1alpha1.FlyteWorkflow, error) {
+			assert.Equal(t, "FAIL_AFTER_EXECUTABLE_NODES_COMPLETE", workflow.Annotations[common.OnFailureAnnotation])
+			assert.Equal(t, "value", workflow.Annotations["key"])
+			created = true
+			return nil, nil
+		},
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(namespace string) v1alpha12.FlyteWorkflowInterface {
+		return &fakeFlyteWorkflow
+	}
+	propeller := getFlytePropellerForTest(&cluster, &FlyteWorkflowBuilderTest{})
+
+	_, err := propeller.ExecuteWorkflow(
+		context.Background(),
+		interfaces.ExecuteWorkflowInput{
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "p",
+				Domain:  "d",
+				Name:    "n",
+			},
+			WfClosure: core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{},
+				},
+			},
+			Reference: admin.LaunchPlan{
+				Spec: &admin.LaunchPlanSpec{},
+			},
+			AcceptedAt: acceptedAt,
+			Annotations: map[string]string{
+				"key": "value",
+			},
+			OnFailurePolicy: "FAIL_AFTER_EXECUTABLE_NODES_COMPLETE",
+		})
+	assert.Nil(t, err)
+	assert.True(t, created)
+}
+
 func TestExecuteWorkflowCallFailed(t *testing.T) {
 	cluster := getFakeExecutionCluster()
 	fakeFlyteWorkflow := FakeFlyteWorkflow{
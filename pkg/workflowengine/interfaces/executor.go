@@ -17,6 +17,14 @@ type ExecuteWorkflowInput struct {
 	AcceptedAt  time.Time
 	Labels      map[string]string
 	Annotations map[string]string
+	// When set, execution data should be written under this prefix rather than the default storage container.
+	OutputDataPrefix string
+	// When set, the execution is launched on this cluster rather than one selected for the execution domain.
+	Cluster string
+	// When set, the W3C traceparent the execution was created with, which is annotated on the workflow.
+	TraceParent string
+	// When set, how the execution proceeds once one of its nodes fails.
+	OnFailurePolicy string
 }
 
 type TerminateWorkflowInput struct {